@@ -18,7 +18,7 @@
  * The latest code can be found at <https://pydio.com>.
  */
 
-// Package actions provides a scheduler action for generating mail digests
+// Package actions provides scheduler actions for generating mail digests and purging activities
 package actions
 
 import "github.com/pydio/cells/scheduler/actions"
@@ -29,5 +29,8 @@ func init() {
 	manager.Register(digestActionName, func() actions.ConcreteAction {
 		return &MailDigestAction{}
 	})
+	manager.Register(purgeActionName, func() actions.ConcreteAction {
+		return &PurgeAction{}
+	})
 
 }
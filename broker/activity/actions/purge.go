@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package actions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/errors"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/proto/activity"
+	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/cells/scheduler/actions"
+)
+
+const (
+	purgeActionName = "broker.activity.actions.purge"
+)
+
+// PurgeAction applies retention rules to the activities inboxes and outboxes, and compacts the database.
+// Parameters are:
+//   - ownerType: user, node or all (default all)
+//   - boxName: inbox, outbox or all (default all)
+//   - maxAge: remove activities older than this duration, e.g. "2160h" or "90d"
+//   - maxCount: keep at most this number of activities in each box
+//   - minCount: always keep at least this number of activities in each box
+//   - compact: compact the database after purging, true by default
+type PurgeAction struct {
+	activityClient activity.ActivityServiceClient
+	ownerTypes     []activity.OwnerType
+	boxNames       []string
+	maxAge         time.Duration
+	maxCount       int32
+	minCount       int32
+	compact        bool
+}
+
+// GetName returns the Unique Identifier of the PurgeAction.
+func (p *PurgeAction) GetName() string {
+	return purgeActionName
+}
+
+// Init passes parameters to a newly created instance.
+func (p *PurgeAction) Init(job *jobs.Job, cl client.Client, action *jobs.Action) error {
+
+	switch action.Parameters["ownerType"] {
+	case "user":
+		p.ownerTypes = []activity.OwnerType{activity.OwnerType_USER}
+	case "node":
+		p.ownerTypes = []activity.OwnerType{activity.OwnerType_NODE}
+	case "", "all":
+		p.ownerTypes = []activity.OwnerType{activity.OwnerType_USER, activity.OwnerType_NODE}
+	default:
+		return errors.BadRequest(purgeActionName, "invalid ownerType parameter, use user, node or all")
+	}
+	switch action.Parameters["boxName"] {
+	case "inbox", "outbox":
+		p.boxNames = []string{action.Parameters["boxName"]}
+	case "", "all":
+		p.boxNames = []string{"inbox", "outbox"}
+	default:
+		return errors.BadRequest(purgeActionName, "invalid boxName parameter, use inbox, outbox or all")
+	}
+	if age, ok := action.Parameters["maxAge"]; ok && age != "" {
+		d, e := ParseRetention(age)
+		if e != nil {
+			return errors.BadRequest(purgeActionName, "invalid maxAge parameter: %s", e.Error())
+		}
+		p.maxAge = d
+	}
+	for param, target := range map[string]*int32{"maxCount": &p.maxCount, "minCount": &p.minCount} {
+		if v, ok := action.Parameters[param]; ok && v != "" {
+			i, e := strconv.ParseInt(v, 10, 32)
+			if e != nil || i < 0 {
+				return errors.BadRequest(purgeActionName, "invalid %s parameter, must be a positive integer", param)
+			}
+			*target = int32(i)
+		}
+	}
+	p.compact = action.Parameters["compact"] != "false"
+	p.activityClient = activity.NewActivityServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_ACTIVITY, cl)
+	return nil
+
+}
+
+// Run processes the actual action code
+func (p *PurgeAction) Run(ctx context.Context, channels *actions.RunnableChannels, input jobs.ActionMessage) (jobs.ActionMessage, error) {
+
+	var report []string
+	if p.maxAge > 0 || p.maxCount > 0 {
+		var before int32
+		if p.maxAge > 0 {
+			before = int32(time.Now().Add(-p.maxAge).Unix())
+		}
+		for _, ownerType := range p.ownerTypes {
+			for _, boxName := range p.boxNames {
+				resp, e := p.activityClient.PurgeActivities(ctx, &activity.PurgeActivitiesRequest{
+					OwnerType:              ownerType,
+					BoxName:                boxName,
+					MinCount:               p.minCount,
+					MaxCount:               p.maxCount,
+					UpdatedBeforeTimestamp: before,
+				})
+				if e != nil {
+					return input.WithError(e), e
+				}
+				report = append(report, fmt.Sprintf("Removed %d activities from %d %s %s(es)", resp.DeletedCount, resp.BoxesCount, strings.ToLower(ownerType.String()), boxName))
+			}
+		}
+	} else {
+		report = append(report, "No retention rule defined, no activities removed")
+	}
+
+	if p.compact {
+		resp, e := p.activityClient.PurgeActivities(ctx, &activity.PurgeActivitiesRequest{CompactDB: true})
+		if e != nil {
+			return input.WithError(e), e
+		}
+		report = append(report, fmt.Sprintf("Compacted database from %d to %d bytes", resp.DbSizeBefore, resp.DbSizeAfter))
+	}
+
+	input.AppendOutput(&jobs.ActionOutput{
+		Success:    true,
+		StringBody: strings.Join(report, "\n"),
+	})
+	return input, nil
+
+}
+
+// ParseRetention parses a Go duration, also accepting a number of days like "90d".
+func ParseRetention(s string) (time.Duration, error) {
+	if strings.HasSuffix(s, "d") {
+		days, e := strconv.Atoi(strings.TrimSuffix(s, "d"))
+		if e != nil || days < 0 {
+			return 0, fmt.Errorf("cannot parse %s as a number of days", s)
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(s)
+}
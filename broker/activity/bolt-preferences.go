@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package activity
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/boltdb/bolt"
+
+	"github.com/pydio/cells/common/proto/activity"
+)
+
+var (
+	preferencesKey = []byte("preferences")
+)
+
+// storedSubscription is the value stored in subscriptions boxes when a delivery is set.
+// Subscriptions without delivery are stored as a simple list of events, as before.
+type storedSubscription struct {
+	Events   []string
+	Delivery activity.NotificationDelivery
+}
+
+func marshalSubscription(subscription *activity.Subscription) []byte {
+	var data []byte
+	if subscription.Delivery == activity.NotificationDelivery_DEFAULT {
+		data, _ = json.Marshal(subscription.Events)
+	} else {
+		data, _ = json.Marshal(&storedSubscription{Events: subscription.Events, Delivery: subscription.Delivery})
+	}
+	return data
+}
+
+func unmarshalSubscription(data []byte, subscription *activity.Subscription) error {
+	if len(data) > 0 && data[0] == '[' {
+		return json.Unmarshal(data, &subscription.Events)
+	}
+	var stored storedSubscription
+	if e := json.Unmarshal(data, &stored); e != nil {
+		return e
+	}
+	subscription.Events = stored.Events
+	subscription.Delivery = stored.Delivery
+	return nil
+}
+
+// SkipDigest flags an inbox activity so that it is ignored by the next digest.
+func (dao *boltdbimpl) SkipDigest(userId string, activityId string) error {
+
+	id, err := strconv.ParseUint(strings.TrimPrefix(activityId, "/activity-"), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	dao.compactLock.RLock()
+	defer dao.compactLock.RUnlock()
+
+	return dao.DB().Update(func(tx *bolt.Tx) error {
+		bucket, err := dao.getBucket(tx, true, activity.OwnerType_USER, userId, BoxSkipDigest)
+		if err != nil {
+			return err
+		}
+		return bucket.Put(dao.uintToBytes(id), []byte{1})
+	})
+
+}
+
+// clearSkipDigest removes the flags of activities up to the last one sent in a digest.
+func (dao *boltdbimpl) clearSkipDigest(tx *bolt.Tx, userId string, last uint64) error {
+
+	bucket, _ := dao.getBucket(tx, false, activity.OwnerType_USER, userId, BoxSkipDigest)
+	if bucket == nil {
+		return nil
+	}
+	var keys [][]byte
+	c := bucket.Cursor()
+	for k, _ := c.First(); k != nil && dao.bytesToUint(k) <= last; k, _ = c.Next() {
+		keys = append(keys, k)
+	}
+	for _, k := range keys {
+		if e := bucket.Delete(k); e != nil {
+			return e
+		}
+	}
+	return nil
+
+}
+
+// LoadPreferences reads the notification preferences of a user.
+func (dao *boltdbimpl) LoadPreferences(userId string) (*activity.NotificationPreferences, error) {
+
+	dao.compactLock.RLock()
+	defer dao.compactLock.RUnlock()
+
+	prefs := &activity.NotificationPreferences{UserId: userId}
+	err := dao.DB().View(func(tx *bolt.Tx) error {
+		bucket, _ := dao.getBucket(tx, false, activity.OwnerType_USER, userId, BoxPreferences)
+		if bucket == nil {
+			return nil
+		}
+		if data := bucket.Get(preferencesKey); data != nil {
+			return json.Unmarshal(data, prefs)
+		}
+		return nil
+	})
+	return prefs, err
+
+}
+
+// StorePreferences replaces the notification preferences of a user.
+func (dao *boltdbimpl) StorePreferences(preferences *activity.NotificationPreferences) error {
+
+	data, err := json.Marshal(preferences)
+	if err != nil {
+		return err
+	}
+
+	dao.compactLock.RLock()
+	defer dao.compactLock.RUnlock()
+
+	return dao.DB().Update(func(tx *bolt.Tx) error {
+		bucket, err := dao.getBucket(tx, true, activity.OwnerType_USER, preferences.UserId, BoxPreferences)
+		if err != nil {
+			return err
+		}
+		return bucket.Put(preferencesKey, data)
+	})
+
+}
@@ -0,0 +1,265 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package activity
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/boltdb/bolt"
+
+	"github.com/pydio/cells/common/proto/activity"
+)
+
+var (
+	// CompactTxMaxSize is the amount of data copied in a single transaction while compacting the database
+	CompactTxMaxSize = 64 * 1024 * 1024
+)
+
+// Purge removes the oldest activities of the inbox or outbox of one or all owners.
+func (dao *boltdbimpl) Purge(ownerType activity.OwnerType, ownerId string, boxName BoxName, minCount, maxCount int, updatedBefore time.Time) (deleted int, boxes int, err error) {
+
+	if boxName != BoxInbox && boxName != BoxOutbox {
+		return 0, 0, fmt.Errorf("cannot purge box %s, only inbox and outbox can be purged", boxName)
+	}
+	dao.compactLock.RLock()
+	defer dao.compactLock.RUnlock()
+
+	// List owners first, then purge them one by one to keep transactions short
+	var owners []string
+	if ownerId != "" {
+		owners = append(owners, ownerId)
+	} else {
+		dao.DB().View(func(tx *bolt.Tx) error {
+			mainBucket := tx.Bucket([]byte(ownerType.String()))
+			if mainBucket == nil {
+				return nil
+			}
+			return mainBucket.ForEach(func(k, v []byte) error {
+				if v == nil {
+					owners = append(owners, string(k))
+				}
+				return nil
+			})
+		})
+	}
+
+	for _, owner := range owners {
+		var count int
+		err = dao.DB().Update(func(tx *bolt.Tx) error {
+			bucket, _ := dao.getBucket(tx, false, ownerType, owner, boxName)
+			if bucket == nil {
+				return nil
+			}
+			var e error
+			if count, e = dao.purgeBucket(bucket, minCount, maxCount, updatedBefore); e != nil || count == 0 {
+				return e
+			}
+			if ownerType == activity.OwnerType_USER && boxName == BoxInbox {
+				// Drop digest flags of the deleted activities
+				last := ^uint64(0)
+				if k, _ := bucket.Cursor().First(); k != nil {
+					last = dao.bytesToUint(k) - 1
+				}
+				return dao.clearSkipDigest(tx, owner, last)
+			}
+			return nil
+		})
+		if err != nil {
+			return
+		}
+		if count > 0 {
+			deleted += count
+			boxes++
+		}
+	}
+	return
+
+}
+
+// purgeBucket deletes activities from the oldest ones, as long as they match one of the rules.
+func (dao *boltdbimpl) purgeBucket(bucket *bolt.Bucket, minCount, maxCount int, updatedBefore time.Time) (int, error) {
+
+	total := bucket.Stats().KeyN
+	var keys [][]byte
+	c := bucket.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		remaining := total - len(keys)
+		if minCount > 0 && remaining <= minCount {
+			break
+		}
+		if maxCount > 0 && remaining > maxCount {
+			keys = append(keys, k)
+			continue
+		}
+		if updatedBefore.IsZero() {
+			break
+		}
+		ac := &activity.Object{}
+		if e := json.Unmarshal(v, ac); e != nil || ac.Updated == nil || ac.Updated.Seconds >= updatedBefore.Unix() {
+			// Activities are sorted by date, stop at the first recent one
+			break
+		}
+		keys = append(keys, k)
+	}
+	for _, k := range keys {
+		if e := bucket.Delete(k); e != nil {
+			return 0, e
+		}
+	}
+	return len(keys), nil
+
+}
+
+// Compact copies all buckets to a new database file, then replaces the current file with it.
+// All other operations are blocked while the copy is running.
+func (dao *boltdbimpl) Compact() (before int64, after int64, err error) {
+
+	dao.compactLock.Lock()
+	defer dao.compactLock.Unlock()
+
+	db := dao.DB()
+	p := db.Path()
+	if st, e := os.Stat(p); e == nil {
+		before = st.Size()
+	}
+	copyPath := p + ".compact"
+	os.Remove(copyPath)
+	copyDB, err := bolt.Open(copyPath, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return
+	}
+	err = db.View(func(tx *bolt.Tx) error {
+		return compactCopy(tx, copyDB)
+	})
+	copyDB.Close()
+	if err != nil {
+		os.Remove(copyPath)
+		return
+	}
+
+	if err = db.Close(); err != nil {
+		os.Remove(copyPath)
+		return
+	}
+	// Keep the original file aside until the compacted one is successfully reopened
+	backupPath := p + ".orig"
+	os.Remove(backupPath)
+	renameErr := os.Rename(p, backupPath)
+	if renameErr == nil {
+		if renameErr = os.Rename(copyPath, p); renameErr != nil {
+			os.Rename(backupPath, p)
+		}
+	}
+	newDB, err := bolt.Open(p, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil && renameErr == nil {
+		// Compacted file cannot be opened, restore the original one
+		openErr := err
+		os.Remove(p)
+		if err = os.Rename(backupPath, p); err != nil {
+			return
+		}
+		if newDB, err = bolt.Open(p, 0600, &bolt.Options{Timeout: 5 * time.Second}); err != nil {
+			return
+		}
+		dao.SetConn(newDB)
+		return before, before, fmt.Errorf("cannot open compacted database, original file restored: %v", openErr)
+	} else if err != nil {
+		return
+	}
+	dao.SetConn(newDB)
+	if renameErr != nil {
+		os.Remove(copyPath)
+		return before, before, renameErr
+	}
+	os.Remove(backupPath)
+	if st, e := os.Stat(p); e == nil {
+		after = st.Size()
+	}
+	return
+
+}
+
+// compactCopy walks all buckets of the source transaction and writes them to the destination database,
+// committing every CompactTxMaxSize bytes. Buckets sequences are preserved, as they are used to generate activities Ids.
+func compactCopy(src *bolt.Tx, dst *bolt.DB) error {
+
+	tx, err := dst.Begin(true)
+	if err != nil {
+		return err
+	}
+	var size int
+
+	// bucketFor recreates the bucket path in the current destination transaction
+	bucketFor := func(path [][]byte) (*bolt.Bucket, error) {
+		b, e := tx.CreateBucketIfNotExists(path[0])
+		for _, name := range path[1:] {
+			if e != nil {
+				return nil, e
+			}
+			b, e = b.CreateBucketIfNotExists(name)
+		}
+		return b, e
+	}
+
+	var walk func(b *bolt.Bucket, path [][]byte) error
+	walk = func(b *bolt.Bucket, path [][]byte) error {
+		target, e := bucketFor(path)
+		if e != nil {
+			return e
+		}
+		if e := target.SetSequence(b.Sequence()); e != nil {
+			return e
+		}
+		return b.ForEach(func(k, v []byte) error {
+			if v == nil {
+				return walk(b.Bucket(k), append(append([][]byte{}, path...), k))
+			}
+			if size > CompactTxMaxSize {
+				if e := tx.Commit(); e != nil {
+					return e
+				}
+				if tx, e = dst.Begin(true); e != nil {
+					return e
+				}
+				size = 0
+			}
+			target, e := bucketFor(path)
+			if e != nil {
+				return e
+			}
+			size += len(k) + len(v)
+			return target.Put(k, v)
+		})
+	}
+
+	err = src.ForEach(func(name []byte, b *bolt.Bucket) error {
+		return walk(b, [][]byte{name})
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+
+}
@@ -28,6 +28,7 @@ import (
 	"bytes"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/boltdb/bolt"
 	"github.com/pydio/cells/common/boltdb"
@@ -40,6 +41,9 @@ type boltdbimpl struct {
 
 	InboxMaxSize int64
 	db           *bolt.DB
+
+	// compactLock is held for writing while the database file is swapped by Compact
+	compactLock sync.RWMutex
 }
 
 // Init the storage
@@ -105,6 +109,9 @@ func (dao *boltdbimpl) getBucket(tx *bolt.Tx, createIfNotExist bool, ownerType a
 
 func (dao *boltdbimpl) PostActivity(ownerType activity.OwnerType, ownerId string, boxName BoxName, object *activity.Object) error {
 
+	dao.compactLock.RLock()
+	defer dao.compactLock.RUnlock()
+
 	err := dao.DB().Update(func(tx *bolt.Tx) error {
 
 		bucket, err := dao.getBucket(tx, true, ownerType, ownerId, boxName)
@@ -127,6 +134,9 @@ func (dao *boltdbimpl) PostActivity(ownerType activity.OwnerType, ownerId string
 
 func (dao *boltdbimpl) UpdateSubscription(subscription *activity.Subscription) error {
 
+	dao.compactLock.RLock()
+	defer dao.compactLock.RUnlock()
+
 	err := dao.DB().Update(func(tx *bolt.Tx) error {
 
 		bucket, err := dao.getBucket(tx, true, subscription.ObjectType, subscription.ObjectId, BoxSubscriptions)
@@ -139,14 +149,16 @@ func (dao *boltdbimpl) UpdateSubscription(subscription *activity.Subscription) e
 			return bucket.Delete([]byte(subscription.UserId))
 		}
 
-		eventsData, _ := json.Marshal(events)
-		return bucket.Put([]byte(subscription.UserId), eventsData)
+		return bucket.Put([]byte(subscription.UserId), marshalSubscription(subscription))
 	})
 	return err
 }
 
 func (dao *boltdbimpl) ListSubscriptions(objectType activity.OwnerType, objectIds []string) (subs []*activity.Subscription, err error) {
 
+	dao.compactLock.RLock()
+	defer dao.compactLock.RUnlock()
+
 	userIds := make(map[string]bool)
 	e := dao.DB().View(func(tx *bolt.Tx) error {
 
@@ -160,17 +172,15 @@ func (dao *boltdbimpl) ListSubscriptions(objectType activity.OwnerType, objectId
 				if _, exists := userIds[uId]; exists {
 					return nil // Already listed
 				}
-				var events []string
-				uE := json.Unmarshal(v, &events)
-				if uE != nil {
-					return uE
-				}
-				subs = append(subs, &activity.Subscription{
+				sub := &activity.Subscription{
 					UserId:     uId,
-					Events:     events,
 					ObjectType: objectType,
 					ObjectId:   objectId,
-				})
+				}
+				if uE := unmarshalSubscription(v, sub); uE != nil {
+					return uE
+				}
+				subs = append(subs, sub)
 				userIds[uId] = true
 				return nil
 			})
@@ -187,6 +197,8 @@ func (dao *boltdbimpl) ActivitiesFor(ownerType activity.OwnerType, ownerId strin
 	defer func() {
 		done <- true
 	}()
+	dao.compactLock.RLock()
+	defer dao.compactLock.RUnlock()
 	if boxName == "" {
 		boxName = BoxOutbox
 	}
@@ -197,7 +209,7 @@ func (dao *boltdbimpl) ActivitiesFor(ownerType activity.OwnerType, ownerId strin
 
 	var uintOffset uint64
 	if refBoxOffset != "" {
-		uintOffset = dao.ReadLastUserInbox(ownerId, refBoxOffset)
+		uintOffset = dao.readLastUserInbox(ownerId, refBoxOffset)
 	}
 
 	dao.DB().View(func(tx *bolt.Tx) error {
@@ -207,6 +219,11 @@ func (dao *boltdbimpl) ActivitiesFor(ownerType activity.OwnerType, ownerId strin
 			// Does not exists, just return
 			return nil
 		}
+		// Digests ignore activities already sent or only shown in-app
+		var skipBucket *bolt.Bucket
+		if refBoxOffset == BoxLastSent && ownerType == activity.OwnerType_USER && boxName == BoxInbox {
+			skipBucket, _ = dao.getBucket(tx, false, ownerType, ownerId, BoxSkipDigest)
+		}
 		c := bucket.Cursor()
 		i := int64(0)
 		total := int64(0)
@@ -215,6 +232,9 @@ func (dao *boltdbimpl) ActivitiesFor(ownerType activity.OwnerType, ownerId strin
 			if uintOffset > 0 && dao.bytesToUint(k) <= uintOffset {
 				break
 			}
+			if skipBucket != nil && skipBucket.Get(k) != nil {
+				continue
+			}
 			if len(lastRead) == 0 {
 				lastRead = k
 			}
@@ -255,6 +275,12 @@ func (dao *boltdbimpl) ActivitiesFor(ownerType activity.OwnerType, ownerId strin
 }
 
 func (dao *boltdbimpl) ReadLastUserInbox(userId string, boxName BoxName) uint64 {
+	dao.compactLock.RLock()
+	defer dao.compactLock.RUnlock()
+	return dao.readLastUserInbox(userId, boxName)
+}
+
+func (dao *boltdbimpl) readLastUserInbox(userId string, boxName BoxName) uint64 {
 
 	var last []byte
 	dao.DB().View(func(tx *bolt.Tx) error {
@@ -280,19 +306,31 @@ func (dao *boltdbimpl) StoreLastUserInbox(userId string, boxName BoxName, last [
 		last = dao.uintToBytes(uintId)
 	}
 
+	dao.compactLock.RLock()
+	defer dao.compactLock.RUnlock()
+
 	return dao.DB().Update(func(tx *bolt.Tx) error {
 		bucket, err := dao.getBucket(tx, true, activity.OwnerType_USER, userId, boxName)
 		if err != nil {
 			return err
 		}
+		if boxName == BoxLastSent {
+			// Flags of activities covered by this digest are not needed anymore
+			if err := dao.clearSkipDigest(tx, userId, dao.bytesToUint(last)); err != nil {
+				return err
+			}
+		}
 		return bucket.Put([]byte("last"), last)
 	})
 }
 
 func (dao *boltdbimpl) CountUnreadForUser(userId string) int {
 
+	dao.compactLock.RLock()
+	defer dao.compactLock.RUnlock()
+
 	var unread int
-	lastRead := dao.ReadLastUserInbox(userId, BoxLastRead)
+	lastRead := dao.readLastUserInbox(userId, BoxLastRead)
 
 	dao.DB().View(func(tx *bolt.Tx) error {
 
@@ -317,6 +355,9 @@ func (dao *boltdbimpl) CountUnreadForUser(userId string) int {
 // to remove (or archive?) deprecated queues
 func (dao *boltdbimpl) Delete(ownerType activity.OwnerType, ownerId string) error {
 
+	dao.compactLock.RLock()
+	defer dao.compactLock.RUnlock()
+
 	err := dao.DB().Update(func(tx *bolt.Tx) error {
 
 		b := tx.Bucket([]byte(ownerType.String()))
@@ -27,6 +27,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/pborman/uuid"
 	. "github.com/smartystreets/goconvey/convey"
 
@@ -423,3 +425,168 @@ func TestSubscriptions(t *testing.T) {
 
 	})
 }
+
+func TestPurge(t *testing.T) {
+
+	defer os.Remove(tmpDbFilePath)
+	tmpdao := boltdb.NewDAO("boltdb", tmpDbFilePath, "")
+	dao := NewDAO(tmpdao).(*boltdbimpl)
+	dao.Init(*conf)
+	defer func() {
+		dao.DB().Close()
+	}()
+
+	now := time.Now()
+	post := func(owner string, boxName BoxName, age time.Duration) {
+		ac := &activity.Object{
+			Type:    activity.ObjectType_Update,
+			Updated: &timestamp.Timestamp{Seconds: now.Add(-age).Unix()},
+		}
+		So(dao.PostActivity(activity.OwnerType_USER, owner, boxName, ac), ShouldBeNil)
+	}
+	count := func(owner string, boxName BoxName) int {
+		results := make(chan *activity.Object)
+		done := make(chan bool)
+		var n int
+		go func() {
+			for {
+				select {
+				case <-results:
+					n++
+				case <-done:
+					return
+				}
+			}
+		}()
+		dao.ActivitiesFor(activity.OwnerType_USER, owner, boxName, "", 0, 1000, results, done)
+		return n
+	}
+
+	Convey("Test purge by max count", t, func() {
+		for i := 0; i < 8; i++ {
+			post("john", BoxOutbox, 0)
+		}
+		for i := 0; i < 3; i++ {
+			post("jane", BoxOutbox, 0)
+		}
+		deleted, boxes, err := dao.Purge(activity.OwnerType_USER, "", BoxOutbox, 0, 5, time.Time{})
+		So(err, ShouldBeNil)
+		So(deleted, ShouldEqual, 3)
+		So(boxes, ShouldEqual, 1)
+		So(count("john", BoxOutbox), ShouldEqual, 5)
+		So(count("jane", BoxOutbox), ShouldEqual, 3)
+
+		_, _, err = dao.Purge(activity.OwnerType_USER, "", BoxLastRead, 0, 5, time.Time{})
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Test purge by age, keeping a minimum count", t, func() {
+		for i := 0; i < 6; i++ {
+			post("paul", BoxInbox, 100*24*time.Hour)
+		}
+		post("paul", BoxInbox, time.Hour)
+		deleted, _, err := dao.Purge(activity.OwnerType_USER, "paul", BoxInbox, 3, 0, now.Add(-90*24*time.Hour))
+		So(err, ShouldBeNil)
+		So(deleted, ShouldEqual, 4)
+		So(count("paul", BoxInbox), ShouldEqual, 3)
+
+		deleted, _, err = dao.Purge(activity.OwnerType_USER, "paul", BoxInbox, 0, 0, now.Add(-90*24*time.Hour))
+		So(err, ShouldBeNil)
+		So(deleted, ShouldEqual, 2)
+		So(count("paul", BoxInbox), ShouldEqual, 1)
+	})
+
+	Convey("Test compact preserves data and sequences", t, func() {
+		before, after, err := dao.Compact()
+		So(err, ShouldBeNil)
+		So(before, ShouldBeGreaterThan, 0)
+		So(after, ShouldBeGreaterThan, 0)
+		So(count("john", BoxOutbox), ShouldEqual, 5)
+		So(count("paul", BoxInbox), ShouldEqual, 1)
+
+		ac := &activity.Object{Type: activity.ObjectType_Update}
+		So(dao.PostActivity(activity.OwnerType_USER, "john", BoxOutbox, ac), ShouldBeNil)
+		So(ac.Id, ShouldEqual, "/activity-9")
+	})
+}
+
+func TestNotifications(t *testing.T) {
+
+	defer os.Remove(tmpDbFilePath)
+	tmpdao := boltdb.NewDAO("boltdb", tmpDbFilePath, "")
+	dao := NewDAO(tmpdao).(*boltdbimpl)
+	dao.Init(*conf)
+	defer dao.DB().Close()
+
+	Convey("Test subscription delivery", t, func() {
+		So(dao.UpdateSubscription(&activity.Subscription{UserId: "john", ObjectType: activity.OwnerType_NODE, ObjectId: "n1", Events: []string{"change"}}), ShouldBeNil)
+		So(dao.UpdateSubscription(&activity.Subscription{UserId: "jane", ObjectType: activity.OwnerType_NODE, ObjectId: "n1", Events: []string{"delete"}, Delivery: activity.NotificationDelivery_INSTANT}), ShouldBeNil)
+		subs, err := dao.ListSubscriptions(activity.OwnerType_NODE, []string{"n1"})
+		So(err, ShouldBeNil)
+		So(subs, ShouldHaveLength, 2)
+		for _, s := range subs {
+			if s.UserId == "jane" {
+				So(s.Events, ShouldResemble, []string{"delete"})
+				So(s.Delivery, ShouldEqual, activity.NotificationDelivery_INSTANT)
+			} else {
+				So(s.Events, ShouldResemble, []string{"change"})
+				So(s.Delivery, ShouldEqual, activity.NotificationDelivery_DEFAULT)
+			}
+		}
+	})
+
+	Convey("Test preferences", t, func() {
+		prefs, err := dao.LoadPreferences("john")
+		So(err, ShouldBeNil)
+		So(prefs.UserId, ShouldEqual, "john")
+		So(prefs.DefaultDelivery, ShouldEqual, activity.NotificationDelivery_DEFAULT)
+
+		prefs.DefaultDelivery = activity.NotificationDelivery_INSTANT
+		prefs.EventsDelivery = map[string]activity.NotificationDelivery{"read": activity.NotificationDelivery_IN_APP}
+		prefs.QuietHours = &activity.QuietHours{StartMinute: 1320, EndMinute: 420}
+		So(dao.StorePreferences(prefs), ShouldBeNil)
+		loaded, err := dao.LoadPreferences("john")
+		So(err, ShouldBeNil)
+		So(loaded.DefaultDelivery, ShouldEqual, activity.NotificationDelivery_INSTANT)
+		So(loaded.EventsDelivery["read"], ShouldEqual, activity.NotificationDelivery_IN_APP)
+		So(loaded.QuietHours.StartMinute, ShouldEqual, 1320)
+	})
+
+	Convey("Test digest skips flagged activities", t, func() {
+		digest := func() (ids []string) {
+			results := make(chan *activity.Object)
+			done := make(chan bool)
+			finished := make(chan bool)
+			go func() {
+				defer close(finished)
+				for {
+					select {
+					case ac := <-results:
+						ids = append(ids, ac.Id)
+					case <-done:
+						return
+					}
+				}
+			}()
+			dao.ActivitiesFor(activity.OwnerType_USER, "paul", BoxInbox, BoxLastSent, 0, 0, results, done)
+			<-finished
+			return
+		}
+		var posted []string
+		for i := 0; i < 3; i++ {
+			ac := &activity.Object{Type: activity.ObjectType_Update, Object: &activity.Object{Id: uuid.New()}}
+			So(dao.PostActivity(activity.OwnerType_USER, "paul", BoxInbox, ac), ShouldBeNil)
+			posted = append(posted, ac.Id)
+		}
+		So(dao.SkipDigest("paul", posted[1]), ShouldBeNil)
+		So(digest(), ShouldResemble, []string{posted[2], posted[0]})
+
+		So(dao.StoreLastUserInbox("paul", BoxLastSent, nil, posted[2]), ShouldBeNil)
+		So(digest(), ShouldBeEmpty)
+		dao.DB().View(func(tx *bolt.Tx) error {
+			b, _ := dao.getBucket(tx, false, activity.OwnerType_USER, "paul", BoxSkipDigest)
+			So(b.Stats().KeyN, ShouldEqual, 0)
+			return nil
+		})
+	})
+}
@@ -25,6 +25,8 @@
 package activity
 
 import (
+	"time"
+
 	"github.com/pydio/cells/common/boltdb"
 	"github.com/pydio/cells/common/dao"
 	"github.com/pydio/cells/common/proto/activity"
@@ -38,6 +40,8 @@ const (
 	BoxSubscriptions BoxName = "subscriptions"
 	BoxLastRead      BoxName = "lastread"
 	BoxLastSent      BoxName = "lastsent"
+	BoxSkipDigest    BoxName = "skipdigest"
+	BoxPreferences   BoxName = "preferences"
 )
 
 type DAO interface {
@@ -65,6 +69,25 @@ type DAO interface {
 	// Should be wired to "USER_DELETE" and "NODE_DELETE" events
 	// to remove (or archive?) deprecated queues
 	Delete(ownerType activity.OwnerType, ownerId string) error
+
+	// Purge removes the oldest activities of a box, for one owner or all owners of this type (if ownerId is empty).
+	// Activities are removed if they were updated before updatedBefore or if there are more than maxCount of them,
+	// but minCount activities are always kept. Zero values disable the corresponding rule.
+	// It returns the number of deleted activities and the number of boxes where activities were deleted.
+	Purge(ownerType activity.OwnerType, ownerId string, boxName BoxName, minCount, maxCount int, updatedBefore time.Time) (deleted int, boxes int, err error)
+
+	// Compact rewrites the database to release the space freed by deletions, and returns its size before and after.
+	Compact() (before int64, after int64, err error)
+
+	// SkipDigest flags an activity of the user inbox so that it is not sent in the next digest,
+	// either because it was already sent by email or because it must only be shown in-app.
+	SkipDigest(userId string, activityId string) error
+
+	// Load the notification preferences of a user, or empty preferences if none were stored
+	LoadPreferences(userId string) (*activity.NotificationPreferences, error)
+
+	// Store the notification preferences of a user
+	StorePreferences(preferences *activity.NotificationPreferences) error
 }
 
 func NewDAO(o dao.DAO) dao.DAO {
@@ -22,7 +22,11 @@ package grpc
 
 import (
 	"fmt"
+	"io"
 	"sync"
+	"time"
+
+	"github.com/micro/go-micro/errors"
 
 	activity "github.com/pydio/cells/broker/activity"
 	"github.com/pydio/cells/common/log"
@@ -36,8 +40,42 @@ type Handler struct {
 	db activity.DAO
 }
 
+// PostActivity receives activities produced by other services: they are posted to the object outbox,
+// to the actor outbox and to the recipient inbox if any.
 func (h *Handler) PostActivity(ctx context.Context, stream proto.ActivityService_PostActivityStream) error {
-	return nil
+
+	dao := servicecontext.GetDAO(ctx).(activity.DAO)
+	defer stream.Close()
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		ac := req.GetObject()
+		if ac == nil {
+			continue
+		}
+		if ac.Object != nil && ac.Object.Id != "" {
+			if e := dao.PostActivity(proto.OwnerType_NODE, ac.Object.Id, activity.BoxOutbox, ac); e != nil {
+				return e
+			}
+			publishActivityEvent(ctx, proto.OwnerType_NODE, ac.Object.Id, activity.BoxOutbox, ac)
+		}
+		if ac.Actor != nil && ac.Actor.Type == proto.ObjectType_Person && ac.Actor.Id != "" {
+			if e := dao.PostActivity(proto.OwnerType_USER, ac.Actor.Id, activity.BoxOutbox, ac); e != nil {
+				return e
+			}
+			publishActivityEvent(ctx, proto.OwnerType_USER, ac.Actor.Id, activity.BoxOutbox, ac)
+		}
+		if ac.To != nil && ac.To.Type == proto.ObjectType_Person && ac.To.Id != "" {
+			if e := dao.PostActivity(proto.OwnerType_USER, ac.To.Id, activity.BoxInbox, ac); e != nil {
+				return e
+			}
+			publishActivityEvent(ctx, proto.OwnerType_USER, ac.To.Id, activity.BoxInbox, ac)
+		}
+	}
 }
 
 func (h *Handler) StreamActivities(ctx context.Context, request *proto.StreamActivitiesRequest, stream proto.ActivityService_StreamActivitiesStream) error {
@@ -158,3 +196,89 @@ func (h *Handler) SetUserLastActivity(ctx context.Context, request *proto.UserLa
 	}
 
 }
+
+// PurgeActivities applies a retention rule to the inbox or outbox of one or all owners, and optionally compacts the database.
+func (h *Handler) PurgeActivities(ctx context.Context, request *proto.PurgeActivitiesRequest, response *proto.PurgeActivitiesResponse) error {
+
+	dao := servicecontext.GetDAO(ctx).(activity.DAO)
+
+	if request.BoxName != "" {
+		var boxName activity.BoxName
+		if request.BoxName == "inbox" {
+			boxName = activity.BoxInbox
+		} else if request.BoxName == "outbox" {
+			boxName = activity.BoxOutbox
+		} else {
+			return fmt.Errorf("Invalid box name")
+		}
+		var updatedBefore time.Time
+		if request.UpdatedBeforeTimestamp > 0 {
+			updatedBefore = time.Unix(int64(request.UpdatedBeforeTimestamp), 0)
+		}
+		deleted, boxes, err := dao.Purge(request.OwnerType, request.OwnerID, boxName, int(request.MinCount), int(request.MaxCount), updatedBefore)
+		if err != nil {
+			return err
+		}
+		log.Logger(ctx).Info("Purged activities", zap.String("box", request.BoxName), zap.Int("deleted", deleted), zap.Int("boxes", boxes))
+		response.DeletedCount = int32(deleted)
+		response.BoxesCount = int32(boxes)
+	}
+
+	if request.CompactDB {
+		before, after, err := dao.Compact()
+		if err != nil {
+			return err
+		}
+		log.Logger(ctx).Info("Compacted activities database", zap.Int64("before", before), zap.Int64("after", after))
+		response.DbSizeBefore = before
+		response.DbSizeAfter = after
+	}
+
+	response.Success = true
+	return nil
+
+}
+
+// GetNotificationPreferences loads the notification preferences of a user.
+func (h *Handler) GetNotificationPreferences(ctx context.Context, request *proto.GetNotificationPreferencesRequest, response *proto.GetNotificationPreferencesResponse) error {
+
+	dao := servicecontext.GetDAO(ctx).(activity.DAO)
+
+	prefs, err := dao.LoadPreferences(request.UserId)
+	if err != nil {
+		return err
+	}
+	response.Preferences = prefs
+	return nil
+
+}
+
+// PutNotificationPreferences validates and stores the notification preferences of a user.
+func (h *Handler) PutNotificationPreferences(ctx context.Context, request *proto.PutNotificationPreferencesRequest, response *proto.PutNotificationPreferencesResponse) error {
+
+	dao := servicecontext.GetDAO(ctx).(activity.DAO)
+
+	prefs := request.Preferences
+	if prefs == nil || prefs.UserId == "" {
+		return errors.BadRequest(Name, "missing user in preferences")
+	}
+	for eventType := range prefs.EventsDelivery {
+		if !activity.IsEventType(eventType) {
+			return errors.BadRequest(Name, "unknown event type %s", eventType)
+		}
+	}
+	if q := prefs.QuietHours; q != nil {
+		if q.StartMinute < 0 || q.StartMinute >= 24*60 || q.EndMinute < 0 || q.EndMinute >= 24*60 {
+			return errors.BadRequest(Name, "quiet hours must be expressed in minutes between 0 and 1439")
+		}
+		if _, e := time.LoadLocation(q.TimeZone); e != nil {
+			return errors.BadRequest(Name, "unknown time zone %s", q.TimeZone)
+		}
+	}
+	if err := dao.StorePreferences(prefs); err != nil {
+		return err
+	}
+	response.Preferences = prefs
+	return nil
+
+}
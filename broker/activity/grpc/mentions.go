@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package grpc
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/broker/activity"
+	"github.com/pydio/cells/common/auth"
+	"github.com/pydio/cells/common/log"
+	activity2 "github.com/pydio/cells/common/proto/activity"
+	"github.com/pydio/cells/common/proto/chat"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/service/context"
+	"github.com/pydio/cells/common/utils"
+)
+
+// HandleChatEvent posts an activity in the inbox of the users newly mentioned in a chat message,
+// provided they are allowed to access the object the chat room is attached to.
+func (e *MicroEventsSubscriber) HandleChatEvent(ctx context.Context, msg *chat.ChatEvent) error {
+
+	if msg.Message == nil || msg.Room == nil || len(msg.NewMentions) == 0 {
+		return nil
+	}
+	dao := servicecontext.GetDAO(ctx).(activity.DAO)
+
+	var node *tree.Node
+	if msg.Room.Type == chat.RoomType_NODE {
+		resp, err := e.client.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: msg.Room.RoomTypeObject}})
+		if err != nil || resp.Node == nil {
+			log.Logger(ctx).Debug("cannot find chat room node, ignoring mentions", zap.String("room", msg.Room.Uuid), zap.Error(err))
+			return nil
+		}
+		node = resp.Node
+	}
+	ac := activity.MentionActivity(msg.Message, msg.Room, node)
+	subscription := &activity2.Subscription{
+		ObjectType: activity2.OwnerType_USER,
+		Events:     []string{activity.EventTypeMention},
+	}
+
+	for _, login := range msg.NewMentions {
+		if login == msg.Message.Author {
+			continue
+		}
+		user, err := utils.SearchUniqueUser(ctx, login, "")
+		if err != nil || user == nil {
+			continue
+		}
+		if !e.rooms.CanAccess(auth.WithImpersonate(ctx, user), login, msg.Room) {
+			log.Logger(ctx).Debug("mentioned user cannot access chat room", zap.String("login", login), zap.String("room", msg.Room.Uuid))
+			continue
+		}
+		subscription.UserId = login
+		subscription.ObjectId = login
+		e.notifyFollower(ctx, dao, subscription, activity.EventTypeMention, ac)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/broker/activity"
+	"github.com/pydio/cells/broker/activity/render"
+	"github.com/pydio/cells/common/log"
+	activity2 "github.com/pydio/cells/common/proto/activity"
+	"github.com/pydio/cells/common/proto/mailer"
+	"github.com/pydio/cells/common/utils"
+)
+
+// notifyFollower posts an activity to a follower inbox, then sends it immediately by email or keeps it
+// out of the digest, depending on the subscription and on the follower notification preferences.
+func (e *MicroEventsSubscriber) notifyFollower(ctx context.Context, dao activity.DAO, subscription *activity2.Subscription, eventType string, ac *activity2.Object) {
+
+	userId := subscription.UserId
+	if err := dao.PostActivity(activity2.OwnerType_USER, userId, activity.BoxInbox, ac); err != nil {
+		log.Logger(ctx).Error("cannot post activity to follower inbox", zap.String("user", userId), zap.Error(err))
+		return
+	}
+	publishActivityEvent(ctx, activity2.OwnerType_USER, userId, activity.BoxInbox, ac)
+
+	prefs, err := dao.LoadPreferences(userId)
+	if err != nil {
+		log.Logger(ctx).Error("cannot load notification preferences", zap.String("user", userId), zap.Error(err))
+	}
+	switch activity.ResolveDelivery(prefs, subscription, eventType) {
+	case activity2.NotificationDelivery_IN_APP:
+		dao.SkipDigest(userId, ac.Id)
+	case activity2.NotificationDelivery_INSTANT:
+		if prefs != nil && activity.InQuietHours(prefs.QuietHours, time.Now()) {
+			// Deferred to the next digest
+			return
+		}
+		if err := e.sendInstant(ctx, userId, ac); err != nil {
+			log.Logger(ctx).Error("cannot send instant notification, it will be sent in the digest", zap.String("user", userId), zap.Error(err))
+			return
+		}
+		dao.SkipDigest(userId, ac.Id)
+	}
+
+}
+
+// sendInstant queues an email for a single activity in the mailer.
+func (e *MicroEventsSubscriber) sendInstant(ctx context.Context, userId string, ac *activity2.Object) error {
+
+	if e.mailer == nil {
+		return fmt.Errorf("mailer client not configured")
+	}
+	user, err := utils.SearchUniqueUser(ctx, userId, "")
+	if err != nil {
+		return err
+	}
+	email, ok := user.Attributes["email"]
+	if !ok || email == "" {
+		return fmt.Errorf("user has no email address")
+	}
+	displayName, ok := user.Attributes["displayName"]
+	if !ok {
+		displayName = user.Login
+	}
+	lang := utils.UserLanguage(ctx, user)
+
+	_, err = e.mailer.SendMail(ctx, &mailer.SendMailRequest{
+		InQueue: true,
+		Mail: &mailer.Mail{
+			TemplateId:      "Notification",
+			TemplateData:    map[string]string{"Title": render.PlainText(ac, activity2.SummaryPointOfView_GENERIC, lang)},
+			ContentMarkdown: render.Markdown(ac, activity2.SummaryPointOfView_GENERIC, lang),
+			To: []*mailer.User{{
+				Uuid:    user.Uuid,
+				Address: email,
+				Name:    displayName,
+			}},
+		},
+	})
+	return err
+
+}
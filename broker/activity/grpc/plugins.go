@@ -30,13 +30,16 @@ import (
 
 	"github.com/micro/go-micro"
 	"github.com/pydio/cells/broker/activity"
+	chat2 "github.com/pydio/cells/broker/chat"
 	"github.com/pydio/cells/common"
 	"github.com/pydio/cells/common/log"
 	proto "github.com/pydio/cells/common/proto/activity"
 	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/cells/common/proto/mailer"
 	"github.com/pydio/cells/common/proto/tree"
 	"github.com/pydio/cells/common/service"
 	"github.com/pydio/cells/common/service/defaults"
+	"github.com/pydio/cells/common/views"
 )
 
 var (
@@ -55,6 +58,10 @@ func init() {
 				TargetVersion: service.FirstRun(),
 				Up:            RegisterDigestJob,
 			},
+			{
+				TargetVersion: service.ValidVersion("0.2.0"),
+				Up:            RegisterPurgeJob,
+			},
 		}),
 		service.WithStorage(activity.NewDAO, "broker_activity"),
 		service.WithMicro(func(m micro.Service) error {
@@ -63,13 +70,22 @@ func init() {
 			)
 
 			// Register Subscribers
+			treeClient := tree.NewNodeProviderClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_TREE, defaults.NewClient())
 			subscriber := &MicroEventsSubscriber{
-				client: tree.NewNodeProviderClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_TREE, defaults.NewClient()),
+				client: treeClient,
+				mailer: mailer.NewMailerServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_MAILER, defaults.NewClient()),
+				rooms: &chat2.RoomAccessChecker{
+					Router:     views.NewRouterEventFilter(views.RouterOptions{WatchRegistry: true}),
+					TreeClient: treeClient,
+				},
 			}
 
 			if err := m.Options().Server.Subscribe(m.Options().Server.NewSubscriber(common.TOPIC_TREE_CHANGES, subscriber)); err != nil {
 				return err
 			}
+			if err := m.Options().Server.Subscribe(m.Options().Server.NewSubscriber(common.TOPIC_CHAT_EVENT, subscriber.HandleChatEvent)); err != nil {
+				return err
+			}
 
 			proto.RegisterActivityServiceHandler(m.Options().Server, new(Handler))
 			tree.RegisterNodeProviderStreamerHandler(m.Options().Server, new(MetaProvider))
@@ -106,3 +122,39 @@ func RegisterDigestJob(ctx context.Context) error {
 	return e
 
 }
+
+// RegisterPurgeJob creates a job purging old activities and compacting the database. The job is inactive:
+// activities are kept forever until an administrator reviews the retention parameters and enables it.
+func RegisterPurgeJob(ctx context.Context) error {
+
+	log.Logger(ctx).Info("Registering default job for purging activities")
+	job := &jobs.Job{
+		ID:             "activities-purge",
+		Label:          "Purge old activities and compact database",
+		Owner:          common.PYDIO_SYSTEM_USERNAME,
+		Inactive:       true,
+		MaxConcurrency: 1,
+		AutoStart:      false,
+		Schedule: &jobs.Schedule{
+			Iso8601Schedule: "R/2012-06-04T02:00:00.000000+00:00/P1D", // every day
+		},
+		Actions: []*jobs.Action{
+			{
+				ID: "broker.activity.actions.purge",
+				Parameters: map[string]string{
+					"ownerType": "all",
+					"boxName":   "all",
+					"maxAge":    "90d",
+					"maxCount":  "1000",
+					"minCount":  "10",
+					"compact":   "true",
+				},
+			},
+		},
+	}
+
+	cliJob := jobs.NewJobServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_JOBS, defaults.NewClient())
+	_, e := cliJob.PutJob(ctx, &jobs.PutJobRequest{Job: job})
+	return e
+
+}
@@ -30,9 +30,11 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/pydio/cells/broker/activity"
+	chat2 "github.com/pydio/cells/broker/chat"
 	"github.com/pydio/cells/common"
 	"github.com/pydio/cells/common/log"
 	activity2 "github.com/pydio/cells/common/proto/activity"
+	"github.com/pydio/cells/common/proto/mailer"
 	"github.com/pydio/cells/common/proto/tree"
 	"github.com/pydio/cells/common/service/context"
 	"github.com/pydio/cells/common/utils"
@@ -40,6 +42,8 @@ import (
 
 type MicroEventsSubscriber struct {
 	client tree.NodeProviderClient
+	mailer mailer.MailerServiceClient
+	rooms  *chat2.RoomAccessChecker
 }
 
 func publishActivityEvent(ctx context.Context, ownerType activity2.OwnerType, ownerId string, boxName activity.BoxName, activity *activity2.Object) {
@@ -154,18 +158,17 @@ func (e *MicroEventsSubscriber) Handle(ctx context.Context, msg *tree.NodeChange
 		if err != nil {
 			return err
 		}
+		eventType := activity.EventType(ac)
 		for _, subscription := range subscriptions {
 
-			// TODO : COMPARE SUBSCRIBED EVENTS TO CURRENT EVENT ?
-			if len(subscription.Events) == 0 {
+			if !activity.SubscriptionMatches(subscription.Events, eventType) {
 				continue
 			}
 			// Ignore if author is user
 			if subscription.UserId == author {
 				continue
 			}
-			dao.PostActivity(activity2.OwnerType_USER, subscription.UserId, activity.BoxInbox, ac)
-			publishActivityEvent(ctx, activity2.OwnerType_USER, subscription.UserId, activity.BoxInbox, ac)
+			e.notifyFollower(ctx, dao, subscription, eventType, ac)
 
 		}
 
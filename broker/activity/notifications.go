@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package activity
+
+import (
+	"time"
+
+	"github.com/pydio/cells/common/proto/activity"
+)
+
+// Event types used in subscriptions and notification preferences.
+const (
+	EventTypeUpload  = "upload"
+	EventTypeUpdate  = "update"
+	EventTypeDelete  = "delete"
+	EventTypeMove    = "move"
+	EventTypeRead    = "read"
+	EventTypeShare   = "share"
+	EventTypeComment = "comment"
+	EventTypeMention = "mention"
+
+	// EventTypeChange is the legacy subscription event covering all modifications
+	EventTypeChange = "change"
+)
+
+var (
+	// EventTypes lists the event types that can be configured in notification preferences
+	EventTypes = []string{EventTypeUpload, EventTypeUpdate, EventTypeDelete, EventTypeMove, EventTypeRead, EventTypeShare, EventTypeComment, EventTypeMention}
+
+	changeEventTypes = map[string]bool{EventTypeUpload: true, EventTypeUpdate: true, EventTypeDelete: true, EventTypeMove: true}
+)
+
+// EventType finds the notification event type of an activity, or an empty string if it cannot be notified.
+func EventType(ac *activity.Object) string {
+	switch ac.Type {
+	case activity.ObjectType_Create:
+		if ac.Object != nil && ac.Object.Type == activity.ObjectType_Note {
+			return EventTypeComment
+		}
+		return EventTypeUpload
+	case activity.ObjectType_Update:
+		return EventTypeUpdate
+	case activity.ObjectType_Delete:
+		return EventTypeDelete
+	case activity.ObjectType_Move:
+		return EventTypeMove
+	case activity.ObjectType_Read, activity.ObjectType_View:
+		return EventTypeRead
+	case activity.ObjectType_Announce, activity.ObjectType_Invite:
+		return EventTypeShare
+	case activity.ObjectType_Mention:
+		return EventTypeMention
+	}
+	return ""
+}
+
+// IsEventType checks if the event type can be configured in notification preferences.
+func IsEventType(eventType string) bool {
+	for _, e := range EventTypes {
+		if e == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// SubscriptionMatches checks if the events of a subscription include the event type.
+// Legacy "change" subscriptions match all modifications.
+func SubscriptionMatches(events []string, eventType string) bool {
+	for _, e := range events {
+		if e == eventType || (e == EventTypeChange && changeEventTypes[eventType]) {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveDelivery computes how an event is delivered to a user. The subscription delivery takes precedence,
+// then the user preference for this event type, then the user default. Digest is used if none is defined.
+func ResolveDelivery(prefs *activity.NotificationPreferences, sub *activity.Subscription, eventType string) activity.NotificationDelivery {
+	if sub != nil && sub.Delivery != activity.NotificationDelivery_DEFAULT {
+		return sub.Delivery
+	}
+	if prefs != nil {
+		if d, ok := prefs.EventsDelivery[eventType]; ok && d != activity.NotificationDelivery_DEFAULT {
+			return d
+		}
+		if prefs.DefaultDelivery != activity.NotificationDelivery_DEFAULT {
+			return prefs.DefaultDelivery
+		}
+	}
+	return activity.NotificationDelivery_DIGEST
+}
+
+// InQuietHours checks if the time falls in the quiet hours range, in the configured time zone.
+func InQuietHours(q *activity.QuietHours, t time.Time) bool {
+	if q == nil || q.StartMinute == q.EndMinute {
+		return false
+	}
+	if q.TimeZone != "" {
+		if loc, e := time.LoadLocation(q.TimeZone); e == nil {
+			t = t.In(loc)
+		}
+	}
+	m := int32(t.Hour()*60 + t.Minute())
+	if q.StartMinute < q.EndMinute {
+		return m >= q.StartMinute && m < q.EndMinute
+	}
+	// Range wraps around midnight
+	return m >= q.StartMinute || m < q.EndMinute
+}
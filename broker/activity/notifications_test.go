@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package activity
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/activity"
+)
+
+func TestNotificationRules(t *testing.T) {
+
+	Convey("Test event types", t, func() {
+		So(EventType(&activity.Object{Type: activity.ObjectType_Create, Object: &activity.Object{Type: activity.ObjectType_Document}}), ShouldEqual, EventTypeUpload)
+		So(EventType(&activity.Object{Type: activity.ObjectType_Create, Object: &activity.Object{Type: activity.ObjectType_Note}}), ShouldEqual, EventTypeComment)
+		So(EventType(&activity.Object{Type: activity.ObjectType_Delete}), ShouldEqual, EventTypeDelete)
+		So(EventType(&activity.Object{Type: activity.ObjectType_Mention}), ShouldEqual, EventTypeMention)
+		So(EventType(&activity.Object{Type: activity.ObjectType_Travel}), ShouldEqual, "")
+		So(IsEventType(EventTypeShare), ShouldBeTrue)
+		So(IsEventType(EventTypeChange), ShouldBeFalse)
+	})
+
+	Convey("Test subscriptions events", t, func() {
+		So(SubscriptionMatches([]string{"change"}, EventTypeUpload), ShouldBeTrue)
+		So(SubscriptionMatches([]string{"change"}, EventTypeRead), ShouldBeFalse)
+		So(SubscriptionMatches([]string{"read", "change"}, EventTypeRead), ShouldBeTrue)
+		So(SubscriptionMatches([]string{"delete"}, EventTypeDelete), ShouldBeTrue)
+		So(SubscriptionMatches([]string{"delete"}, EventTypeUpdate), ShouldBeFalse)
+		So(SubscriptionMatches(nil, EventTypeUpdate), ShouldBeFalse)
+	})
+
+	Convey("Test delivery resolution", t, func() {
+		So(ResolveDelivery(nil, nil, EventTypeUpload), ShouldEqual, activity.NotificationDelivery_DIGEST)
+		prefs := &activity.NotificationPreferences{
+			DefaultDelivery: activity.NotificationDelivery_IN_APP,
+			EventsDelivery:  map[string]activity.NotificationDelivery{EventTypeDelete: activity.NotificationDelivery_INSTANT},
+		}
+		So(ResolveDelivery(prefs, &activity.Subscription{}, EventTypeUpload), ShouldEqual, activity.NotificationDelivery_IN_APP)
+		So(ResolveDelivery(prefs, &activity.Subscription{}, EventTypeDelete), ShouldEqual, activity.NotificationDelivery_INSTANT)
+		So(ResolveDelivery(prefs, &activity.Subscription{Delivery: activity.NotificationDelivery_DIGEST}, EventTypeDelete), ShouldEqual, activity.NotificationDelivery_DIGEST)
+	})
+
+	Convey("Test quiet hours", t, func() {
+		at := func(h, m int) time.Time {
+			return time.Date(2018, 6, 1, h, m, 0, 0, time.UTC)
+		}
+		So(InQuietHours(nil, at(23, 0)), ShouldBeFalse)
+		day := &activity.QuietHours{StartMinute: 12 * 60, EndMinute: 14 * 60, TimeZone: "UTC"}
+		So(InQuietHours(day, at(13, 30)), ShouldBeTrue)
+		So(InQuietHours(day, at(14, 0)), ShouldBeFalse)
+		night := &activity.QuietHours{StartMinute: 22 * 60, EndMinute: 7 * 60, TimeZone: "UTC"}
+		So(InQuietHours(night, at(23, 0)), ShouldBeTrue)
+		So(InQuietHours(night, at(6, 59)), ShouldBeTrue)
+		So(InQuietHours(night, at(12, 0)), ShouldBeFalse)
+		paris := &activity.QuietHours{StartMinute: 22 * 60, EndMinute: 7 * 60, TimeZone: "Europe/Paris"}
+		So(InQuietHours(paris, at(20, 30)), ShouldBeTrue)
+	})
+
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/pydio/cells/common/proto/activity"
+	"github.com/pydio/cells/common/proto/chat"
 	"github.com/pydio/cells/common/proto/tree"
 )
 
@@ -126,3 +127,44 @@ func DocumentActivity(author string, event *tree.NodeChangeEvent) (ac *activity.
 	return ac, detectedNode
 
 }
+
+// MentionActivity describes a chat message mentioning a user. The object is the node or workspace the
+// chat room is attached to, and the message itself is kept as a Note in the activity content.
+func MentionActivity(msg *chat.ChatMessage, room *chat.ChatRoom, node *tree.Node) *activity.Object {
+
+	ac := createObject()
+	ac.Type = activity.ObjectType_Mention
+	ac.Name = "Chat Mention"
+	ac.Actor = &activity.Object{
+		Type: activity.ObjectType_Person,
+		Name: msg.Author,
+		Id:   msg.Author,
+	}
+	if node != nil {
+		ac.Object = &activity.Object{
+			Type: activity.ObjectType_Document,
+			Name: node.Path,
+			Id:   node.Uuid,
+		}
+		if !node.IsLeaf() {
+			ac.Object.Type = activity.ObjectType_Folder
+		}
+	} else if room != nil {
+		ac.Object = &activity.Object{
+			Type: activity.ObjectType_Workspace,
+			Name: room.RoomLabel,
+			Id:   room.RoomTypeObject,
+		}
+	}
+	ac.Content = &activity.Object{
+		Type:    activity.ObjectType_Note,
+		Id:      msg.Uuid,
+		Summary: msg.Message,
+	}
+	ac.Updated = &timestamp.Timestamp{
+		Seconds: time.Now().Unix(),
+	}
+
+	return ac
+
+}
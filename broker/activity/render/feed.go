@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package render
+
+import (
+	"encoding/xml"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pydio/cells/common/proto/activity"
+)
+
+const (
+	// AtomContentType is the MIME type of feeds produced by Atom
+	AtomContentType = "application/atom+xml; charset=utf-8"
+)
+
+var (
+	markdownLinks    = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+	markdownEmphasis = strings.NewReplacer("**", "", "__", "", "*", "", "## ", "")
+)
+
+// Feed describes the channel of an activities feed.
+type Feed struct {
+	ID       string
+	Title    string
+	Link     string
+	SelfLink string
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	Xmlns   string      `xml:"xmlns,attr"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+	URI  string `xml:"uri,omitempty"`
+}
+
+type atomEntry struct {
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Updated  string      `xml:"updated"`
+	Author   *atomAuthor `xml:"author,omitempty"`
+	Links    []atomLink  `xml:"link"`
+	Summary  string      `xml:"summary,omitempty"`
+	Category []atomCat   `xml:"category"`
+}
+
+type atomCat struct {
+	Term string `xml:"term,attr"`
+}
+
+// PlainText renders the activity summary without markdown markup, e.g. for feed entries titles.
+func PlainText(object *activity.Object, pointOfView activity.SummaryPointOfView, language string) string {
+	text := markdownLinks.ReplaceAllString(Markdown(object, pointOfView, language), "$1")
+	return strings.TrimSpace(markdownEmphasis.Replace(text))
+}
+
+// Atom renders a list of activities, most recent first, as an Atom 1.0 document.
+// The entryLink function, if not nil, computes the alternate link of each entry.
+func Atom(feed *Feed, items []*activity.Object, language string, entryLink func(*activity.Object) string) ([]byte, error) {
+
+	doc := &atomFeed{
+		Xmlns: "http://www.w3.org/2005/Atom",
+		ID:    feed.ID,
+		Title: feed.Title,
+	}
+	if feed.Link != "" {
+		doc.Links = append(doc.Links, atomLink{Rel: "alternate", Type: "text/html", Href: feed.Link})
+	}
+	if feed.SelfLink != "" {
+		doc.Links = append(doc.Links, atomLink{Rel: "self", Type: "application/atom+xml", Href: feed.SelfLink})
+	}
+
+	var updated time.Time
+	for _, ac := range items {
+		at := time.Unix(0, 0)
+		if ac.Updated != nil {
+			at = time.Unix(ac.Updated.Seconds, 0)
+		}
+		if at.After(updated) {
+			updated = at
+		}
+		entry := atomEntry{
+			ID:       feed.ID + "/" + strings.TrimLeft(ac.Id, "/"),
+			Title:    PlainText(ac, activity.SummaryPointOfView_GENERIC, language),
+			Updated:  at.UTC().Format(time.RFC3339),
+			Summary:  ac.Summary,
+			Category: []atomCat{{Term: ac.Type.String()}},
+		}
+		if ac.Actor != nil {
+			entry.Author = &atomAuthor{Name: ac.Actor.Name}
+		}
+		if entryLink != nil {
+			if link := entryLink(ac); link != "" {
+				entry.Links = append(entry.Links, atomLink{Rel: "alternate", Type: "text/html", Href: link})
+			}
+		}
+		doc.Entries = append(doc.Entries, entry)
+	}
+	if updated.IsZero() {
+		updated = time.Now()
+	}
+	doc.Updated = updated.UTC().Format(time.RFC3339)
+
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package render
+
+import (
+	"encoding/xml"
+	"net/url"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/activity"
+)
+
+func TestAtom(t *testing.T) {
+
+	Convey("Test Atom feed rendering", t, func() {
+
+		items := []*activity.Object{
+			{
+				Id:      "/activity-2",
+				Type:    activity.ObjectType_Update,
+				Updated: &timestamp.Timestamp{Seconds: 1500000100},
+				Actor:   &activity.Object{Type: activity.ObjectType_Person, Id: "john", Name: "John Doe"},
+				Object:  &activity.Object{Type: activity.ObjectType_Document, Id: "doc1", Name: "projects/report.pdf"},
+			},
+			{
+				Id:      "/activity-1",
+				Type:    activity.ObjectType_Create,
+				Updated: &timestamp.Timestamp{Seconds: 1500000000},
+				Actor:   &activity.Object{Type: activity.ObjectType_Person, Id: "john", Name: "John Doe"},
+				Object:  &activity.Object{Type: activity.ObjectType_Document, Id: "doc1", Name: "projects/report.pdf"},
+			},
+		}
+		feed := &Feed{ID: "urn:pydio:feeds:node:folder1", Title: "Projects", SelfLink: "https://cells.example.com/a/activity/feeds/node/folder1"}
+		data, err := Atom(feed, items, "en-us", func(ac *activity.Object) string {
+			return "https://cells.example.com/ws-projects/" + ac.Object.Name
+		})
+		So(err, ShouldBeNil)
+
+		var parsed atomFeed
+		So(xml.Unmarshal(data, &parsed), ShouldBeNil)
+		So(parsed.Title, ShouldEqual, "Projects")
+		So(parsed.Updated, ShouldEqual, "2017-07-14T02:41:40Z")
+		So(parsed.Links, ShouldHaveLength, 1)
+		So(parsed.Links[0].Rel, ShouldEqual, "self")
+		So(parsed.Entries, ShouldHaveLength, 2)
+		So(parsed.Entries[0].ID, ShouldEqual, "urn:pydio:feeds:node:folder1/activity-2")
+		So(parsed.Entries[0].Author.Name, ShouldEqual, "John Doe")
+		So(parsed.Entries[0].Title, ShouldContainSubstring, "report.pdf")
+		So(parsed.Entries[0].Title, ShouldNotContainSubstring, "*")
+		So(parsed.Entries[0].Links[0].Href, ShouldEqual, "https://cells.example.com/ws-projects/projects/report.pdf")
+	})
+
+	Convey("Test plain text removes markdown links", t, func() {
+		links := NewServerLinks()
+		links.URLS[ServerUrlTypeUsers], _ = url.Parse("user://")
+		ac := &activity.Object{
+			Type:   activity.ObjectType_Create,
+			Actor:  &activity.Object{Type: activity.ObjectType_Person, Id: "john", Name: "John Doe"},
+			Object: &activity.Object{Type: activity.ObjectType_Folder, Id: "f1", Name: "projects"},
+		}
+		So(Markdown(ac, activity.SummaryPointOfView_GENERIC, "en-us", links), ShouldContainSubstring, "[John Doe](user://john)")
+		So(markdownLinks.ReplaceAllString(Markdown(ac, activity.SummaryPointOfView_GENERIC, "en-us", links), "$1"), ShouldEqual, PlainText(ac, activity.SummaryPointOfView_GENERIC, "en-us"))
+		So(PlainText(ac, activity.SummaryPointOfView_GENERIC, "en-us"), ShouldContainSubstring, "John Doe")
+	})
+
+}
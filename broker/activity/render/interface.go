@@ -18,7 +18,7 @@
  * The latest code can be found at <https://pydio.com>.
  */
 
-// Package render provides helper for rendering activies into various formats (currently markdown).
+// Package render provides helper for rendering activies into various formats (markdown and Atom feeds).
 package render
 
 import "github.com/pydio/cells/common/proto/activity"
@@ -161,6 +161,27 @@ func Markdown(object *activity.Object, pointOfView activity.SummaryPointOfView,
 			return T("AccessedObjectBy", templateData)
 		}
 
+	case activity.ObjectType_Flag:
+
+		templateData["Summary"] = object.Summary
+		if pointOfView == activity.SummaryPointOfView_ACTOR {
+			return T("FlaggedObject", templateData)
+		} else if pointOfView == activity.SummaryPointOfView_SUBJECT {
+			return T("FlaggedBy", templateData)
+		} else {
+			return T("FlaggedObjectBy", templateData)
+		}
+
+	case activity.ObjectType_Mention:
+
+		if pointOfView == activity.SummaryPointOfView_ACTOR {
+			return T("MentionedObject", templateData)
+		} else if pointOfView == activity.SummaryPointOfView_SUBJECT {
+			return T("MentionedBy", templateData)
+		} else {
+			return T("MentionedObjectBy", templateData)
+		}
+
 	case activity.ObjectType_Folder:
 
 		var docIdentifier string
@@ -202,3 +202,25 @@ func TestMarkdown(t *testing.T) {
 	})
 
 }
+
+func TestMentionMarkdown(t *testing.T) {
+
+	Convey("Test mention rendering", t, func() {
+
+		mention := &activity.Object{
+			Type:  activity.ObjectType_Mention,
+			Actor: &activity.Object{Type: activity.ObjectType_Person, Id: "john", Name: "John Doe"},
+			Object: &activity.Object{
+				Type: activity.ObjectType_Document,
+				Name: "path/to/document.txt",
+				Id:   "doc1",
+			},
+			Content: &activity.Object{Type: activity.ObjectType_Note, Summary: "Hello @jane"},
+		}
+		So(Markdown(mention, activity.SummaryPointOfView_GENERIC, ""), ShouldEqual, "John Doe mentioned you on Document document.txt")
+		So(Markdown(mention, activity.SummaryPointOfView_ACTOR, ""), ShouldEqual, "Mentioned someone on document document.txt")
+		So(Markdown(mention, activity.SummaryPointOfView_SUBJECT, ""), ShouldEqual, "Mentioned by John Doe")
+
+	})
+
+}
@@ -0,0 +1,230 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/emicklei/go-restful"
+	"go.uber.org/zap"
+
+	activity2 "github.com/pydio/cells/broker/activity"
+	"github.com/pydio/cells/broker/activity/render"
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth"
+	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/activity"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/registry"
+	"github.com/pydio/cells/common/utils"
+)
+
+const (
+	feedDefaultLimit = 50
+	feedMaxLimit     = 200
+	// feedTokenHeader carries a personal access token or a JWT for clients that can set headers
+	feedTokenHeader = "X-Pydio-Feed-Token"
+)
+
+// Feed renders the activities of a folder or of a user outbox as an Atom or ActivityStreams JSON feed.
+// Clients may authenticate with the usual Authorization header, or send a personal access token or a JWT
+// in the X-Pydio-Feed-Token header. As Atom feed readers usually cannot set headers, the Token query parameter
+// is also accepted for the Atom format only: such URLs may end up in logs and should use short-lived tokens.
+// Anonymous requests run impersonated as the user configured in the "feeds/anonymousUser" key of this service,
+// with all the ACLs of this user, and are rejected if there is none.
+func (a *ActivityHandler) Feed(req *restful.Request, rsp *restful.Response) {
+
+	ctx, err := a.feedContext(req)
+	if err != nil {
+		rsp.AddHeader("WWW-Authenticate", `Bearer realm="feeds"`)
+		rsp.WriteErrorString(401, "Unauthorized.\n")
+		return
+	}
+
+	accessList, err := utils.AccessListFromContextClaims(ctx)
+	if err != nil {
+		rsp.WriteError(500, err)
+		return
+	}
+
+	contextData := req.PathParameter("ContextData")
+	language := req.QueryParameter("Language")
+	if language == "" {
+		language = utils.UserLanguagesFromRestRequest(req)[0]
+	}
+	baseURL := strings.TrimRight(config.Get("defaults", "url").String(""), "/")
+	feed := &render.Feed{}
+	query := &activity.StreamActivitiesRequest{
+		ContextData: contextData,
+		BoxName:     "outbox",
+		Language:    language,
+		Limit:       feedDefaultLimit,
+	}
+
+	switch req.PathParameter("Context") {
+	case "node":
+		node, ok := a.readableNode(ctx, accessList.Workspaces, contextData)
+		if !ok {
+			rsp.WriteErrorString(404, "Cannot find node "+contextData)
+			return
+		}
+		query.Context = activity.StreamContext_NODE_ID
+		feed.Title = "Pydio - " + path.Base(node.Path)
+	case "user":
+		query.Context = activity.StreamContext_USER_ID
+		feed.Title = "Pydio - " + contextData
+	default:
+		rsp.WriteErrorString(400, "Context must be either node or user")
+		return
+	}
+	selfPath := fmt.Sprintf("/a/activity/feeds/%s/%s", req.PathParameter("Context"), url.PathEscape(contextData))
+	feed.ID = "urn:pydio:feeds:" + req.PathParameter("Context") + ":" + contextData
+	feed.Link = baseURL
+	feed.SelfLink = baseURL + selfPath
+
+	if limit, e := strconv.ParseInt(req.QueryParameter("Limit"), 10, 64); e == nil && limit > 0 {
+		if limit > feedMaxLimit {
+			limit = feedMaxLimit
+		}
+		query.Limit = limit
+	}
+
+	var collection []*activity.Object
+	if len(accessList.Workspaces) > 0 {
+		streamer, err := a.getClient().StreamActivities(ctx, query)
+		if err != nil {
+			log.Logger(ctx).Error("cannot get activity stream", zap.Error(err))
+			rsp.WriteError(500, err)
+			return
+		}
+		defer streamer.Close()
+		for {
+			resp, e := streamer.Recv()
+			if e != nil {
+				break
+			}
+			if resp == nil {
+				continue
+			}
+			if a.FilterActivity(ctx, accessList.Workspaces, resp.Activity) {
+				resp.Activity.Summary = render.Markdown(resp.Activity, activity.SummaryPointOfView_GENERIC, language)
+				collection = append(collection, resp.Activity)
+			}
+		}
+	}
+
+	if req.QueryParameter("Format") == "json" {
+		rsp.WriteEntity(activity2.Collection(collection))
+		return
+	}
+
+	data, err := render.Atom(feed, collection, language, func(ac *activity.Object) string {
+		return feedEntryLink(baseURL, accessList.Workspaces, ac)
+	})
+	if err != nil {
+		rsp.WriteError(500, err)
+		return
+	}
+	rsp.AddHeader("Content-Type", render.AtomContentType)
+	rsp.Write(data)
+
+}
+
+// feedContext returns the request context with claims, either from the Authorization header,
+// from the feed token header or query parameter, or impersonating the anonymous feeds user.
+func (a *ActivityHandler) feedContext(req *restful.Request) (context.Context, error) {
+
+	ctx := req.Request.Context()
+	if _, ok := ctx.Value(claim.ContextKey).(claim.Claims); ok {
+		return ctx, nil
+	}
+	token := req.HeaderParameter(feedTokenHeader)
+	if token == "" && req.QueryParameter("Format") != "json" {
+		token = req.QueryParameter("Token")
+	}
+	if token != "" {
+		c, claims, err := auth.DefaultJWTVerifier().Verify(ctx, token)
+		if err != nil || claims.Name == "" {
+			log.Auditer(ctx).Error("invalid token for activity feed", log.GetAuditId(common.AUDIT_INVALID_JWT))
+			return nil, fmt.Errorf("invalid token")
+		}
+		return c, nil
+	}
+	login := config.Get("services", common.SERVICE_REST_NAMESPACE_+common.SERVICE_ACTIVITY, "feeds", "anonymousUser").String("")
+	if login == "" {
+		return nil, fmt.Errorf("anonymous feeds are disabled")
+	}
+	user, err := utils.SearchUniqueUser(ctx, login, "")
+	if err != nil || user == nil {
+		log.Logger(ctx).Error("cannot load anonymous feeds user", zap.String("login", login), zap.Error(err))
+		return nil, fmt.Errorf("anonymous feeds are disabled")
+	}
+	return auth.WithImpersonate(ctx, user), nil
+
+}
+
+// readableNode loads a node by its Uuid and checks that it is visible in one of the accessible workspaces.
+func (a *ActivityHandler) readableNode(ctx context.Context, workspaces map[string]*idm.Workspace, nodeId string) (*tree.Node, bool) {
+
+	cli := tree.NewNodeProviderClient(registry.GetClient(common.SERVICE_TREE))
+	resp, err := cli.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: nodeId}})
+	if err != nil || resp.Node == nil {
+		return nil, false
+	}
+	for _, workspace := range workspaces {
+		if filtered, ok := a.router.WorkspaceCanSeeNode(ctx, workspace, resp.Node, false); ok {
+			return filtered, true
+		}
+	}
+	return nil, false
+
+}
+
+// feedEntryLink points to the activity document or folder in the first workspace it was found in by FilterActivity.
+func feedEntryLink(baseURL string, workspaces map[string]*idm.Workspace, ac *activity.Object) string {
+
+	if ac.Object == nil || ac.Object.PartOf == nil || len(ac.Object.PartOf.Items) == 0 {
+		return ""
+	}
+	wsObject := ac.Object.PartOf.Items[0]
+	workspace, ok := workspaces[wsObject.Id]
+	if !ok || workspace.Slug == "" {
+		return ""
+	}
+	link := baseURL + "/ws-" + workspace.Slug + "/"
+	if wsObject.Rel != "" {
+		segments := strings.Split(strings.Trim(wsObject.Rel, "/"), "/")
+		for i, s := range segments {
+			segments[i] = url.PathEscape(s)
+		}
+		link += strings.Join(segments, "/")
+	}
+	return link
+
+}
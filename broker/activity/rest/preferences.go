@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"github.com/emicklei/go-restful"
+	"github.com/micro/go-micro/errors"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/proto/activity"
+	"github.com/pydio/cells/common/service"
+)
+
+// GetNotificationPreferences loads the notification preferences of the current user.
+// Admins can load the preferences of another user by passing its login.
+func (a *ActivityHandler) GetNotificationPreferences(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	userId, ok := preferencesUser(req, rsp, req.QueryParameter("UserId"))
+	if !ok {
+		return
+	}
+	resp, err := a.getClient().GetNotificationPreferences(ctx, &activity.GetNotificationPreferencesRequest{UserId: userId})
+	if err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	rsp.WriteEntity(resp.Preferences)
+
+}
+
+// PutNotificationPreferences stores the notification preferences of the current user.
+// Admins can store the preferences of another user by passing its login.
+func (a *ActivityHandler) PutNotificationPreferences(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	var prefs activity.NotificationPreferences
+	if err := req.ReadEntity(&prefs); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	userId, ok := preferencesUser(req, rsp, prefs.UserId)
+	if !ok {
+		return
+	}
+	prefs.UserId = userId
+	resp, err := a.getClient().PutNotificationPreferences(ctx, &activity.PutNotificationPreferencesRequest{Preferences: &prefs})
+	if err != nil {
+		if errors.Parse(err.Error()).Code == 400 {
+			rsp.WriteError(400, err)
+		} else {
+			service.RestError500(req, rsp, err)
+		}
+		return
+	}
+	rsp.WriteEntity(resp.Preferences)
+
+}
+
+// preferencesUser finds the login whose preferences are managed: the current user, or any user for admins.
+func preferencesUser(req *restful.Request, rsp *restful.Response, login string) (string, bool) {
+	claims, ok := req.Request.Context().Value(claim.ContextKey).(claim.Claims)
+	if !ok || claims.Name == "" {
+		rsp.WriteError(401, errors.Unauthorized(common.SERVICE_ACTIVITY, "user not found in context"))
+		return "", false
+	}
+	if login != "" && login != claims.Name {
+		if claims.Profile != common.PYDIO_PROFILE_ADMIN {
+			rsp.WriteError(403, errors.Forbidden(common.SERVICE_ACTIVITY, "only admins can manage other users preferences"))
+			return "", false
+		}
+		return login, true
+	}
+	return claims.Name, true
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package chat
+
+import (
+	"context"
+
+	"github.com/pydio/cells/common/proto/chat"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/utils"
+)
+
+// NodeRouter computes how a node is seen from inside a workspace, see views.RouterEventFilter.
+type NodeRouter interface {
+	WorkspaceCanSeeNode(ctx context.Context, workspace *idm.Workspace, node *tree.Node, refresh bool) (*tree.Node, bool)
+}
+
+// RoomAccessChecker verifies that a user can read the object a chat room is attached to.
+type RoomAccessChecker struct {
+	Router     NodeRouter
+	TreeClient tree.NodeProviderClient
+}
+
+// CanAccess checks the room against the ACLs found in the context claims, that must be the ones of the given user.
+// Global rooms are open to everyone, user rooms only to their participants.
+func (r *RoomAccessChecker) CanAccess(ctx context.Context, login string, room *chat.ChatRoom) bool {
+
+	switch room.Type {
+	case chat.RoomType_GLOBAL:
+		return true
+	case chat.RoomType_USER:
+		for _, u := range room.Users {
+			if u == login {
+				return true
+			}
+		}
+		return false
+	}
+
+	accessList, err := utils.AccessListFromContextClaims(ctx)
+	if err != nil {
+		return false
+	}
+	if room.Type == chat.RoomType_WORKSPACE {
+		_, ok := accessList.Workspaces[room.RoomTypeObject]
+		return ok
+	}
+	resp, err := r.TreeClient.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: room.RoomTypeObject}})
+	if err != nil || resp.Node == nil {
+		return false
+	}
+	for _, workspace := range accessList.Workspaces {
+		if _, ok := r.Router.WorkspaceCanSeeNode(ctx, workspace, resp.Node, false); ok {
+			return true
+		}
+	}
+	return false
+
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package actions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/errors"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/proto/chat"
+	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/cells/scheduler/actions"
+)
+
+const (
+	purgeActionName = "broker.chat.actions.purge"
+)
+
+// PurgeAction removes chat messages older than a retention period defined per room type.
+// Parameters are named after the room types, in lower case: global, workspace, user and node.
+// Each one is a number of days, messages of this room type are kept forever if it is empty or zero.
+type PurgeAction struct {
+	chatClient chat.ChatServiceClient
+	retentions map[chat.RoomType]int
+}
+
+// GetName returns the Unique Identifier of the PurgeAction.
+func (p *PurgeAction) GetName() string {
+	return purgeActionName
+}
+
+// Init passes parameters to a newly created instance.
+func (p *PurgeAction) Init(job *jobs.Job, cl client.Client, action *jobs.Action) error {
+
+	p.retentions = make(map[chat.RoomType]int)
+	for name, value := range chat.RoomType_value {
+		param := action.Parameters[strings.ToLower(name)]
+		if param == "" {
+			continue
+		}
+		days, e := strconv.Atoi(param)
+		if e != nil || days < 0 {
+			return errors.BadRequest(purgeActionName, "invalid %s parameter, must be a positive number of days", strings.ToLower(name))
+		}
+		if days > 0 {
+			p.retentions[chat.RoomType(value)] = days
+		}
+	}
+	p.chatClient = chat.NewChatServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_CHAT, cl)
+	return nil
+
+}
+
+// Run processes the actual action code
+func (p *PurgeAction) Run(ctx context.Context, channels *actions.RunnableChannels, input jobs.ActionMessage) (jobs.ActionMessage, error) {
+
+	var report []string
+	for roomType := chat.RoomType_GLOBAL; roomType <= chat.RoomType_NODE; roomType++ {
+		days, ok := p.retentions[roomType]
+		if !ok {
+			continue
+		}
+		resp, e := p.chatClient.PurgeMessages(ctx, &chat.PurgeMessagesRequest{
+			RoomType:        roomType,
+			BeforeTimestamp: time.Now().Add(-time.Duration(days) * 24 * time.Hour).Unix(),
+		})
+		if e != nil {
+			return input.WithError(e), e
+		}
+		report = append(report, fmt.Sprintf("Removed %d messages older than %d days from %d %s room(s)", resp.DeletedCount, days, resp.RoomsCount, strings.ToLower(roomType.String())))
+	}
+	if len(report) == 0 {
+		report = append(report, "No retention period defined, no messages removed")
+	}
+
+	input.AppendOutput(&jobs.ActionOutput{
+		Success:    true,
+		StringBody: strings.Join(report, "\n"),
+	})
+	return input, nil
+
+}
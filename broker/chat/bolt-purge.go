@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package chat
+
+import (
+	"encoding/json"
+
+	"github.com/boltdb/bolt"
+
+	"github.com/pydio/cells/common/proto/chat"
+)
+
+// PurgeMessages removes the messages posted before a given timestamp in all rooms of the given type,
+// along with their index entries. Messages left behind by deleted rooms are purged as well, and their
+// buckets are removed once empty.
+func (h *boltdbimpl) PurgeMessages(roomType chat.RoomType, beforeTimestamp int64) (deleted int64, roomsCount int64, e error) {
+
+	e = h.DB().Update(func(tx *bolt.Tx) error {
+
+		registered := make(map[string]bool)
+		var roomUuids []string
+		for t := range chat.RoomType_name {
+			typeBucket, _ := h.getRoomsBucket(tx, false, chat.RoomType(t), "")
+			if typeBucket == nil {
+				continue
+			}
+			typeBucket.ForEach(func(k, v []byte) error {
+				if v != nil {
+					return nil
+				}
+				return typeBucket.Bucket(k).ForEach(func(uuid, _ []byte) error {
+					registered[string(uuid)] = true
+					if chat.RoomType(t) == roomType {
+						roomUuids = append(roomUuids, string(uuid))
+					}
+					return nil
+				})
+			})
+		}
+
+		for _, roomUuid := range roomUuids {
+			bucket, _ := h.getMessagesBucket(tx, false, roomUuid)
+			if bucket == nil {
+				continue
+			}
+			roomsCount++
+			count, err := h.purgeBucket(tx, roomUuid, bucket, beforeTimestamp)
+			if err != nil {
+				return err
+			}
+			deleted += count
+		}
+
+		// Rooms that were deleted are not registered anymore but their messages remain
+		main := tx.Bucket([]byte(messages))
+		var orphans []string
+		main.ForEach(func(k, v []byte) error {
+			if v != nil || registered[string(k)] {
+				return nil
+			}
+			if _, ok := chat.RoomType_value[string(k)]; ok {
+				return nil
+			}
+			orphans = append(orphans, string(k))
+			return nil
+		})
+		for _, roomUuid := range orphans {
+			bucket := main.Bucket([]byte(roomUuid))
+			roomsCount++
+			count, err := h.purgeBucket(tx, roomUuid, bucket, beforeTimestamp)
+			if err != nil {
+				return err
+			}
+			deleted += count
+			if k, _ := bucket.Cursor().First(); k == nil {
+				if err := main.DeleteBucket([]byte(roomUuid)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+
+	return
+}
+
+// purgeBucket removes the messages of a room posted before a given timestamp.
+func (h *boltdbimpl) purgeBucket(tx *bolt.Tx, roomUuid string, bucket *bolt.Bucket, beforeTimestamp int64) (deleted int64, e error) {
+	var keys [][]byte
+	var expired []*chat.ChatMessage
+	bucket.ForEach(func(k, v []byte) error {
+		var msg chat.ChatMessage
+		if err := json.Unmarshal(v, &msg); err == nil && msg.Timestamp < beforeTimestamp {
+			keys = append(keys, k)
+			expired = append(expired, &msg)
+		}
+		return nil
+	})
+	for i, k := range keys {
+		if err := h.unindexMessage(tx, roomUuid, k, expired[i]); err != nil {
+			return deleted, err
+		}
+		if err := bucket.Delete(k); err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+	return
+}
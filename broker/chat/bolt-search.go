@@ -0,0 +1,206 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package chat
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/boltdb/bolt"
+	"github.com/micro/go-micro/errors"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/proto/chat"
+)
+
+const (
+	// search bucket stores the full-text index as token\x00roomUuid\x00messageKey => nil
+	search = "search"
+	// maxTokenLength truncates indexed words, in runes
+	maxTokenLength = 32
+	// DefaultSearchLimit is used when the search request does not set a Limit
+	DefaultSearchLimit = 100
+	// MaxSearchLimit caps the number of results returned by a search
+	MaxSearchLimit = 1000
+)
+
+// Tokenize splits a text into unique lower-cased words of at least two characters.
+func Tokenize(text string) (tokens []string) {
+	seen := make(map[string]bool)
+	for _, f := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		runes := []rune(f)
+		if len(runes) < 2 {
+			continue
+		}
+		if len(runes) > maxTokenLength {
+			f = string(runes[:maxTokenLength])
+		}
+		if !seen[f] {
+			seen[f] = true
+			tokens = append(tokens, f)
+		}
+	}
+	return
+}
+
+func indexKey(token, roomUuid string, msgKey []byte) []byte {
+	k := make([]byte, 0, len(token)+len(roomUuid)+len(msgKey)+2)
+	k = append(k, token...)
+	k = append(k, 0)
+	k = append(k, roomUuid...)
+	k = append(k, 0)
+	return append(k, msgKey...)
+}
+
+func (h *boltdbimpl) indexMessage(tx *bolt.Tx, roomUuid string, msgKey []byte, msg *chat.ChatMessage) error {
+	bucket := tx.Bucket([]byte(search))
+	for _, t := range Tokenize(msg.Message) {
+		if err := bucket.Put(indexKey(t, roomUuid, msgKey), nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *boltdbimpl) unindexMessage(tx *bolt.Tx, roomUuid string, msgKey []byte, msg *chat.ChatMessage) error {
+	bucket := tx.Bucket([]byte(search))
+	for _, t := range Tokenize(msg.Message) {
+		if err := bucket.Delete(indexKey(t, roomUuid, msgKey)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reindexAll builds the search index for messages posted before it existed.
+func (h *boltdbimpl) reindexAll(tx *bolt.Tx) error {
+	main := tx.Bucket([]byte(messages))
+	return main.ForEach(func(roomUuid, v []byte) error {
+		if v != nil {
+			return nil
+		}
+		if _, ok := chat.RoomType_value[string(roomUuid)]; ok {
+			// Rooms definitions are stored in the same bucket
+			return nil
+		}
+		bucket := main.Bucket(roomUuid)
+		return bucket.ForEach(func(k, v []byte) error {
+			var msg chat.ChatMessage
+			if v == nil || json.Unmarshal(v, &msg) != nil {
+				return nil
+			}
+			return h.indexMessage(tx, string(roomUuid), k, &msg)
+		})
+	})
+}
+
+// SearchMessages finds the messages containing all the words of the query, the last word being matched as a prefix.
+func (h *boltdbimpl) SearchMessages(request *chat.SearchMessagesRequest) (results []*chat.ChatMessage, e error) {
+
+	terms := Tokenize(request.Query)
+	if len(terms) == 0 {
+		return nil, errors.BadRequest(common.SERVICE_CHAT, "Please provide at least one word of two characters")
+	}
+	limit := int(request.Limit)
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	} else if limit > MaxSearchLimit {
+		limit = MaxSearchLimit
+	}
+	rooms := make(map[string]bool, len(request.RoomUuids))
+	for _, r := range request.RoomUuids {
+		rooms[r] = true
+	}
+
+	e = h.DB().View(func(tx *bolt.Tx) error {
+
+		index := tx.Bucket([]byte(search))
+		var refs map[string]bool
+		for i, term := range terms {
+			prefix := []byte(term)
+			if i < len(terms)-1 {
+				prefix = append(prefix, 0)
+			}
+			found := make(map[string]bool)
+			c := index.Cursor()
+			for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+				parts := bytes.SplitN(k, []byte{0}, 2)
+				if len(parts) < 2 {
+					continue
+				}
+				ref := string(parts[1])
+				if refs == nil || refs[ref] {
+					found[ref] = true
+				}
+			}
+			refs = found
+			if len(refs) == 0 {
+				return nil
+			}
+		}
+
+		for ref := range refs {
+			parts := strings.SplitN(ref, "\x00", 2)
+			if len(rooms) > 0 && !rooms[parts[0]] {
+				continue
+			}
+			bucket, _ := h.getMessagesBucket(tx, false, parts[0])
+			if bucket == nil {
+				continue
+			}
+			data := bucket.Get([]byte(parts[1]))
+			if data == nil {
+				continue
+			}
+			var msg chat.ChatMessage
+			if err := json.Unmarshal(data, &msg); err != nil {
+				continue
+			}
+			if request.Author != "" && msg.Author != request.Author {
+				continue
+			}
+			if (request.AfterTimestamp > 0 && msg.Timestamp < request.AfterTimestamp) || (request.BeforeTimestamp > 0 && msg.Timestamp > request.BeforeTimestamp) {
+				continue
+			}
+			results = append(results, &msg)
+		}
+		return nil
+	})
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Timestamp > results[j].Timestamp
+	})
+	if request.Offset > 0 {
+		if int(request.Offset) >= len(results) {
+			return nil, e
+		}
+		results = results[request.Offset:]
+	}
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, e
+}
@@ -23,6 +23,8 @@ package chat
 import (
 	"encoding/binary"
 	"encoding/json"
+	"strings"
+	"time"
 
 	"github.com/boltdb/bolt"
 	"github.com/micro/go-micro/errors"
@@ -34,6 +36,13 @@ import (
 	"github.com/pydio/cells/common/proto/chat"
 )
 
+const (
+	// MaxEditHistory is the number of previous versions kept for an edited message
+	MaxEditHistory = 50
+	// MaxEmojiLength limits the size of a reaction
+	MaxEmojiLength = 32
+)
+
 type boltdbimpl struct {
 	boltdb.DAO
 	HistorySize int64
@@ -55,6 +64,12 @@ func (h *boltdbimpl) Init(config config.Map) error {
 		if err != nil {
 			return err
 		}
+		if tx.Bucket([]byte(search)) == nil {
+			if _, err := tx.CreateBucket([]byte(search)); err != nil {
+				return err
+			}
+			return h.reindexAll(tx)
+		}
 		return nil
 	})
 
@@ -214,6 +229,37 @@ func (h *boltdbimpl) ListRooms(request *chat.ListRoomsRequest) (rooms []*chat.Ch
 
 	return rooms, e
 }
+
+// RoomByUuid looks up a room in all room types, returning nil if it does not exist.
+func (h *boltdbimpl) RoomByUuid(roomUuid string) (room *chat.ChatRoom, e error) {
+
+	e = h.DB().View(func(tx *bolt.Tx) error {
+		for t := range chat.RoomType_name {
+			bucket, _ := h.getRoomsBucket(tx, false, chat.RoomType(t), "")
+			if bucket == nil {
+				continue
+			}
+			c := bucket.Cursor()
+			for k, v := c.First(); k != nil; k, v = c.Next() {
+				if v != nil {
+					continue
+				}
+				if data := bucket.Bucket(k).Get([]byte(roomUuid)); data != nil {
+					var r chat.ChatRoom
+					if err := json.Unmarshal(data, &r); err != nil {
+						return err
+					}
+					room = &r
+					return nil
+				}
+			}
+		}
+		return nil
+	})
+
+	return room, e
+}
+
 func (h *boltdbimpl) ListMessages(request *chat.ListMessagesRequest) (messages []*chat.ChatMessage, e error) {
 
 	e = h.DB().View(func(tx *bolt.Tx) error {
@@ -228,6 +274,9 @@ func (h *boltdbimpl) ListMessages(request *chat.ListMessagesRequest) (messages [
 			if err != nil {
 				return err
 			}
+			if request.ThreadUuid != "" && msg.ThreadUuid != request.ThreadUuid {
+				return nil
+			}
 			messages = append(messages, &msg)
 			return nil
 		})
@@ -248,11 +297,25 @@ func (h *boltdbimpl) PostMessage(msg *chat.ChatMessage) (*chat.ChatMessage, erro
 			return nil
 		}
 
+		if msg.ThreadUuid != "" {
+			// Replies are always attached to the root message of the thread
+			_, parent := h.findMessage(bucket, msg.ThreadUuid)
+			if parent == nil {
+				return errors.BadRequest(common.SERVICE_CHAT, "Cannot find thread message %s in this room", msg.ThreadUuid)
+			}
+			if parent.ThreadUuid != "" {
+				msg.ThreadUuid = parent.ThreadUuid
+			}
+		}
+
 		objectKey, _ := bucket.NextSequence()
 		k := make([]byte, 8)
 		binary.BigEndian.PutUint64(k, objectKey)
 		serial, _ := json.Marshal(msg)
-		return bucket.Put(k, serial)
+		if err := bucket.Put(k, serial); err != nil {
+			return err
+		}
+		return h.indexMessage(tx, msg.RoomUuid, k, msg)
 	})
 
 	return msg, err
@@ -272,6 +335,9 @@ func (h *boltdbimpl) DeleteMessage(message *chat.ChatMessage) error {
 		return bucket.ForEach(func(k, v []byte) error {
 			var msg chat.ChatMessage
 			if err := json.Unmarshal(v, &msg); err == nil && msg.Uuid == message.Uuid {
+				if err := h.unindexMessage(tx, message.RoomUuid, k, &msg); err != nil {
+					return err
+				}
 				return bucket.Delete(k)
 			}
 			return nil
@@ -280,3 +346,115 @@ func (h *boltdbimpl) DeleteMessage(message *chat.ChatMessage) error {
 
 	return err
 }
+
+// findMessage scans a room bucket for a message by its Uuid, returning its key.
+func (h *boltdbimpl) findMessage(bucket *bolt.Bucket, msgUuid string) (key []byte, message *chat.ChatMessage) {
+	c := bucket.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		var msg chat.ChatMessage
+		if err := json.Unmarshal(v, &msg); err == nil && msg.Uuid == msgUuid {
+			return k, &msg
+		}
+	}
+	return nil, nil
+}
+
+// updateMessage loads a message, applies the callback and stores it back in place.
+func (h *boltdbimpl) updateMessage(roomUuid, msgUuid string, callback func(msg *chat.ChatMessage) error) (*chat.ChatMessage, error) {
+
+	if roomUuid == "" || msgUuid == "" {
+		return nil, errors.BadRequest(common.SERVICE_CHAT, "Please provide both room and message Uuid")
+	}
+	var updated *chat.ChatMessage
+	err := h.DB().Update(func(tx *bolt.Tx) error {
+		bucket, _ := h.getMessagesBucket(tx, false, roomUuid)
+		if bucket == nil {
+			return errors.NotFound(common.SERVICE_CHAT, "Cannot find room %s", roomUuid)
+		}
+		k, msg := h.findMessage(bucket, msgUuid)
+		if msg == nil {
+			return errors.NotFound(common.SERVICE_CHAT, "Cannot find message %s", msgUuid)
+		}
+		previous := &chat.ChatMessage{Message: msg.Message}
+		if err := callback(msg); err != nil {
+			return err
+		}
+		serial, _ := json.Marshal(msg)
+		updated = msg
+		if err := bucket.Put(k, serial); err != nil {
+			return err
+		}
+		if previous.Message == msg.Message {
+			return nil
+		}
+		if err := h.unindexMessage(tx, roomUuid, k, previous); err != nil {
+			return err
+		}
+		return h.indexMessage(tx, roomUuid, k, msg)
+	})
+
+	return updated, err
+}
+
+// EditMessage replaces the text of a message and pushes the previous version to its history.
+func (h *boltdbimpl) EditMessage(message *chat.ChatMessage) (edited *chat.ChatMessage, addedMentions []string, err error) {
+
+	edited, err = h.updateMessage(message.RoomUuid, message.Uuid, func(msg *chat.ChatMessage) error {
+		if msg.Author != message.Author {
+			return errors.Forbidden(common.SERVICE_CHAT, "Only the author of a message can edit it")
+		}
+		if msg.Message == message.Message {
+			return nil
+		}
+		ts := msg.EditedAt
+		if ts == 0 {
+			ts = msg.Timestamp
+		}
+		msg.History = append(msg.History, &chat.ChatMessageRevision{Message: msg.Message, Timestamp: ts})
+		if len(msg.History) > MaxEditHistory {
+			msg.History = msg.History[len(msg.History)-MaxEditHistory:]
+		}
+		addedMentions = NewMentions(msg.Mentions, message.Mentions)
+		msg.Message = message.Message
+		msg.Mentions = message.Mentions
+		msg.EditedAt = time.Now().Unix()
+		return nil
+	})
+
+	return
+}
+
+// ToggleReaction adds the user to the given emoji reaction, or removes it if it was already there.
+func (h *boltdbimpl) ToggleReaction(roomUuid, messageUuid, emoji, user string) (*chat.ChatMessage, error) {
+
+	emoji = strings.TrimSpace(emoji)
+	if emoji == "" || len(emoji) > MaxEmojiLength || user == "" {
+		return nil, errors.BadRequest(common.SERVICE_CHAT, "Invalid reaction")
+	}
+	return h.updateMessage(roomUuid, messageUuid, func(msg *chat.ChatMessage) error {
+		var reaction *chat.ChatReaction
+		var rIdx int
+		for i, r := range msg.Reactions {
+			if r.Emoji == emoji {
+				reaction, rIdx = r, i
+				break
+			}
+		}
+		if reaction == nil {
+			msg.Reactions = append(msg.Reactions, &chat.ChatReaction{Emoji: emoji, Users: []string{user}})
+			return nil
+		}
+		for i, u := range reaction.Users {
+			if u == user {
+				reaction.Users = append(reaction.Users[:i], reaction.Users[i+1:]...)
+				if len(reaction.Users) == 0 {
+					msg.Reactions = append(msg.Reactions[:rIdx], msg.Reactions[rIdx+1:]...)
+				}
+				return nil
+			}
+		}
+		reaction.Users = append(reaction.Users, user)
+		return nil
+	})
+
+}
@@ -0,0 +1,186 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package chat
+
+import (
+	"os"
+	"testing"
+
+	"github.com/boltdb/bolt"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/boltdb"
+	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/proto/chat"
+)
+
+func TestParseMentions(t *testing.T) {
+
+	Convey("Test parsing mentions", t, func() {
+		So(ParseMentions("Hello world"), ShouldBeEmpty)
+		So(ParseMentions("Hi @jane, ask @john.doe. And @jane again"), ShouldResemble, []string{"jane", "john.doe"})
+		So(ParseMentions("@admin first"), ShouldResemble, []string{"admin"})
+		So(ParseMentions("mail me at jane@example.com"), ShouldBeEmpty)
+		So(NewMentions([]string{"jane"}, []string{"jane", "john"}), ShouldResemble, []string{"john"})
+	})
+
+}
+
+func TestMessagesEdition(t *testing.T) {
+
+	dbFile := os.TempDir() + "/bolt-chat-test.db"
+	defer os.Remove(dbFile)
+	db := boltdb.NewDAO("boltdb", dbFile, "")
+	dao := NewDAO(db).(*boltdbimpl)
+	dao.Init(config.Map{})
+	defer dao.DB().Close()
+
+	Convey("Test rooms, threads, edits and reactions", t, func() {
+
+		room, err := dao.PutRoom(&chat.ChatRoom{Type: chat.RoomType_NODE, RoomTypeObject: "node-uuid", RoomLabel: "doc.txt"})
+		So(err, ShouldBeNil)
+		found, err := dao.RoomByUuid(room.Uuid)
+		So(err, ShouldBeNil)
+		So(found, ShouldNotBeNil)
+		So(found.RoomTypeObject, ShouldEqual, "node-uuid")
+		notFound, _ := dao.RoomByUuid("unknown")
+		So(notFound, ShouldBeNil)
+
+		root, err := dao.PostMessage(&chat.ChatMessage{RoomUuid: room.Uuid, Author: "jane", Message: "Hello", Timestamp: 10})
+		So(err, ShouldBeNil)
+		reply, err := dao.PostMessage(&chat.ChatMessage{RoomUuid: room.Uuid, Author: "john", Message: "Hi", ThreadUuid: root.Uuid})
+		So(err, ShouldBeNil)
+		// Replies to a reply are attached to the thread root
+		sub, err := dao.PostMessage(&chat.ChatMessage{RoomUuid: room.Uuid, Author: "jane", Message: "Sub", ThreadUuid: reply.Uuid})
+		So(err, ShouldBeNil)
+		So(sub.ThreadUuid, ShouldEqual, root.Uuid)
+		_, err = dao.PostMessage(&chat.ChatMessage{RoomUuid: room.Uuid, Author: "jane", Message: "Lost", ThreadUuid: "unknown"})
+		So(err, ShouldNotBeNil)
+
+		all, _ := dao.ListMessages(&chat.ListMessagesRequest{RoomUuid: room.Uuid})
+		So(all, ShouldHaveLength, 3)
+		thread, _ := dao.ListMessages(&chat.ListMessagesRequest{RoomUuid: room.Uuid, ThreadUuid: root.Uuid})
+		So(thread, ShouldHaveLength, 2)
+
+		_, _, err = dao.EditMessage(&chat.ChatMessage{RoomUuid: room.Uuid, Uuid: root.Uuid, Author: "john", Message: "Hacked"})
+		So(err, ShouldNotBeNil)
+		edited, added, err := dao.EditMessage(&chat.ChatMessage{RoomUuid: room.Uuid, Uuid: root.Uuid, Author: "jane", Message: "Hello @john", Mentions: []string{"john"}})
+		So(err, ShouldBeNil)
+		So(added, ShouldResemble, []string{"john"})
+		So(edited.Message, ShouldEqual, "Hello @john")
+		So(edited.EditedAt, ShouldBeGreaterThan, 0)
+		So(edited.History, ShouldHaveLength, 1)
+		So(edited.History[0].Message, ShouldEqual, "Hello")
+		So(edited.History[0].Timestamp, ShouldEqual, 10)
+		_, added, _ = dao.EditMessage(&chat.ChatMessage{RoomUuid: room.Uuid, Uuid: root.Uuid, Author: "jane", Message: "Hello again @john", Mentions: []string{"john"}})
+		So(added, ShouldBeEmpty)
+
+		reacted, err := dao.ToggleReaction(room.Uuid, root.Uuid, "👍", "john")
+		So(err, ShouldBeNil)
+		So(reacted.Reactions, ShouldHaveLength, 1)
+		reacted, _ = dao.ToggleReaction(room.Uuid, root.Uuid, "👍", "jane")
+		So(reacted.Reactions[0].Users, ShouldResemble, []string{"john", "jane"})
+		reacted, _ = dao.ToggleReaction(room.Uuid, root.Uuid, "👍", "john")
+		reacted, _ = dao.ToggleReaction(room.Uuid, root.Uuid, "👍", "jane")
+		So(reacted.Reactions, ShouldBeEmpty)
+		_, err = dao.ToggleReaction(room.Uuid, root.Uuid, "", "jane")
+		So(err, ShouldNotBeNil)
+
+	})
+
+}
+
+func TestSearchAndPurge(t *testing.T) {
+
+	dbFile := os.TempDir() + "/bolt-chat-search-test.db"
+	defer os.Remove(dbFile)
+	db := boltdb.NewDAO("boltdb", dbFile, "")
+	dao := NewDAO(db).(*boltdbimpl)
+	dao.Init(config.Map{})
+	defer dao.DB().Close()
+
+	Convey("Test messages search index and retention", t, func() {
+
+		So(Tokenize("Hello, WORLD! a l'été 2018"), ShouldResemble, []string{"hello", "world", "été", "2018"})
+
+		nodeRoom, _ := dao.PutRoom(&chat.ChatRoom{Type: chat.RoomType_NODE, RoomTypeObject: "node-uuid"})
+		wsRoom, _ := dao.PutRoom(&chat.ChatRoom{Type: chat.RoomType_WORKSPACE, RoomTypeObject: "ws-uuid"})
+
+		old, _ := dao.PostMessage(&chat.ChatMessage{RoomUuid: nodeRoom.Uuid, Author: "jane", Message: "Quarterly report is ready", Timestamp: 100})
+		dao.PostMessage(&chat.ChatMessage{RoomUuid: nodeRoom.Uuid, Author: "john", Message: "Thanks for the report", Timestamp: 200})
+		dao.PostMessage(&chat.ChatMessage{RoomUuid: wsRoom.Uuid, Author: "jane", Message: "Reporting a bug", Timestamp: 150})
+
+		_, err := dao.SearchMessages(&chat.SearchMessagesRequest{Query: "?"})
+		So(err, ShouldNotBeNil)
+
+		results, err := dao.SearchMessages(&chat.SearchMessagesRequest{Query: "report"})
+		So(err, ShouldBeNil)
+		So(results, ShouldHaveLength, 3)
+		So(results[0].Timestamp, ShouldEqual, 200)
+
+		results, _ = dao.SearchMessages(&chat.SearchMessagesRequest{Query: "report ready"})
+		So(results, ShouldHaveLength, 1)
+		results, _ = dao.SearchMessages(&chat.SearchMessagesRequest{Query: "report", Author: "jane"})
+		So(results, ShouldHaveLength, 2)
+		results, _ = dao.SearchMessages(&chat.SearchMessagesRequest{Query: "report", RoomUuids: []string{wsRoom.Uuid}})
+		So(results, ShouldHaveLength, 1)
+		results, _ = dao.SearchMessages(&chat.SearchMessagesRequest{Query: "report", AfterTimestamp: 120, Limit: 1})
+		So(results, ShouldHaveLength, 1)
+		So(results[0].Author, ShouldEqual, "john")
+		results, _ = dao.SearchMessages(&chat.SearchMessagesRequest{Query: "report", Offset: 1, Limit: 1})
+		So(results, ShouldHaveLength, 1)
+		So(results[0].Timestamp, ShouldEqual, 150)
+		results, _ = dao.SearchMessages(&chat.SearchMessagesRequest{Query: "report", Offset: 3})
+		So(results, ShouldBeEmpty)
+
+		// Index follows edits and deletions
+		dao.EditMessage(&chat.ChatMessage{RoomUuid: nodeRoom.Uuid, Uuid: old.Uuid, Author: "jane", Message: "Summary is ready"})
+		results, _ = dao.SearchMessages(&chat.SearchMessagesRequest{Query: "ready"})
+		So(results, ShouldHaveLength, 1)
+		So(results[0].Message, ShouldEqual, "Summary is ready")
+		results, _ = dao.SearchMessages(&chat.SearchMessagesRequest{Query: "quarterly"})
+		So(results, ShouldBeEmpty)
+
+		deleted, rooms, err := dao.PurgeMessages(chat.RoomType_NODE, 150)
+		So(err, ShouldBeNil)
+		So(deleted, ShouldEqual, 1)
+		So(rooms, ShouldEqual, 1)
+		results, _ = dao.SearchMessages(&chat.SearchMessagesRequest{Query: "summary"})
+		So(results, ShouldBeEmpty)
+		all, _ := dao.ListMessages(&chat.ListMessagesRequest{RoomUuid: wsRoom.Uuid})
+		So(all, ShouldHaveLength, 1)
+
+		// Messages of deleted rooms are purged too
+		dao.DeleteRoom(wsRoom)
+		deleted, rooms, err = dao.PurgeMessages(chat.RoomType_NODE, 300)
+		So(err, ShouldBeNil)
+		So(deleted, ShouldEqual, 2)
+		So(rooms, ShouldEqual, 2)
+		results, _ = dao.SearchMessages(&chat.SearchMessagesRequest{Query: "bug"})
+		So(results, ShouldBeEmpty)
+		dao.DB().View(func(tx *bolt.Tx) error {
+			So(tx.Bucket([]byte(messages)).Bucket([]byte(wsRoom.Uuid)), ShouldBeNil)
+			return nil
+		})
+
+	})
+
+}
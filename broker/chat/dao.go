@@ -32,9 +32,15 @@ type DAO interface {
 	PutRoom(room *chat.ChatRoom) (*chat.ChatRoom, error)
 	DeleteRoom(room *chat.ChatRoom) (bool, error)
 	ListRooms(request *chat.ListRoomsRequest) ([]*chat.ChatRoom, error)
+	RoomByUuid(roomUuid string) (*chat.ChatRoom, error)
 	ListMessages(request *chat.ListMessagesRequest) ([]*chat.ChatMessage, error)
 	PostMessage(request *chat.ChatMessage) (*chat.ChatMessage, error)
 	DeleteMessage(message *chat.ChatMessage) error
+	// EditMessage updates the text and mentions of a message, returning the users that were not mentioned before
+	EditMessage(message *chat.ChatMessage) (edited *chat.ChatMessage, addedMentions []string, err error)
+	ToggleReaction(roomUuid, messageUuid, emoji, user string) (*chat.ChatMessage, error)
+	SearchMessages(request *chat.SearchMessagesRequest) ([]*chat.ChatMessage, error)
+	PurgeMessages(roomType chat.RoomType, beforeTimestamp int64) (deleted int64, roomsCount int64, e error)
 }
 
 func NewDAO(o dao.DAO) dao.DAO {
@@ -22,9 +22,9 @@ package grpc
 
 import (
 	"context"
-	"errors"
 
 	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/errors"
 	"go.uber.org/zap"
 
 	chat2 "github.com/pydio/cells/broker/chat"
@@ -59,7 +59,7 @@ func (c *ChatHandler) DeleteRoom(ctx context.Context, req *chat.DeleteRoomReques
 		return err
 	} else if !ok {
 		// should never happen
-		return errors.New("cannot delete room, but DeleteRoom method returned no error")
+		return errors.InternalServerError(common.SERVICE_CHAT, "cannot delete room, but DeleteRoom method returned no error")
 	}
 
 	response.Success = true
@@ -108,14 +108,13 @@ func (c *ChatHandler) PostMessage(ctx context.Context, req *chat.PostMessageRequ
 	db := servicecontext.GetDAO(ctx).(chat2.DAO)
 
 	for _, m := range req.Messages {
+		m.Mentions = chat2.ParseMentions(m.Message)
 		newMessage, err := db.PostMessage(m)
 		if err != nil {
 			return err
 		}
 		resp.Messages = append(resp.Messages, newMessage)
-		client.Publish(ctx, client.NewPublication(common.TOPIC_CHAT_EVENT, &chat.ChatEvent{
-			Message: newMessage,
-		}))
+		c.publishWithMentions(ctx, db, newMessage, "", newMessage.Mentions)
 	}
 	resp.Success = true
 	return nil
@@ -139,3 +138,113 @@ func (c *ChatHandler) DeleteMessage(ctx context.Context, req *chat.DeleteMessage
 	resp.Success = true
 	return nil
 }
+
+func (c *ChatHandler) EditMessage(ctx context.Context, req *chat.EditMessageRequest, resp *chat.EditMessageResponse) error {
+
+	log.Logger(ctx).Debug("Edit Message", zap.Any(common.KEY_CHAT_POST_MSG_REQ, req))
+	db := servicecontext.GetDAO(ctx).(chat2.DAO)
+
+	if req.Message == nil {
+		return errors.BadRequest(common.SERVICE_CHAT, "please provide a message")
+	}
+	req.Message.Mentions = chat2.ParseMentions(req.Message.Message)
+	edited, added, err := db.EditMessage(req.Message)
+	if err != nil {
+		return err
+	}
+	resp.Message = edited
+	c.publishWithMentions(ctx, db, edited, "EDIT", added)
+	return nil
+}
+
+func (c *ChatHandler) ReactToMessage(ctx context.Context, req *chat.ReactToMessageRequest, resp *chat.ReactToMessageResponse) error {
+
+	log.Logger(ctx).Debug("React to Message", zap.Any(common.KEY_CHAT_POST_MSG_REQ, req))
+	db := servicecontext.GetDAO(ctx).(chat2.DAO)
+
+	msg, err := db.ToggleReaction(req.RoomUuid, req.MessageUuid, req.Emoji, req.User)
+	if err != nil {
+		return err
+	}
+	resp.Message = msg
+	client.Publish(ctx, client.NewPublication(common.TOPIC_CHAT_EVENT, &chat.ChatEvent{
+		Message: msg,
+		Details: "REACT",
+	}))
+	return nil
+}
+
+// publishWithMentions sends the chat event along with the room, so that subscribers can check
+// that mentioned users are allowed to read it. Self-mentions are ignored.
+func (c *ChatHandler) publishWithMentions(ctx context.Context, db chat2.DAO, msg *chat.ChatMessage, details string, mentions []string) {
+
+	event := &chat.ChatEvent{
+		Message: msg,
+		Details: details,
+	}
+	for _, login := range mentions {
+		if login != msg.Author {
+			event.NewMentions = append(event.NewMentions, login)
+		}
+	}
+	if len(event.NewMentions) > 0 {
+		if room, err := db.RoomByUuid(msg.RoomUuid); err == nil && room != nil {
+			event.Room = room
+		} else {
+			log.Logger(ctx).Debug("cannot find room for mentions, ignoring them", zap.String("room", msg.RoomUuid), zap.Error(err))
+			event.NewMentions = nil
+		}
+	}
+	client.Publish(ctx, client.NewPublication(common.TOPIC_CHAT_EVENT, event))
+}
+
+func (c *ChatHandler) GetRoom(ctx context.Context, req *chat.GetRoomRequest, resp *chat.GetRoomResponse) error {
+
+	db := servicecontext.GetDAO(ctx).(chat2.DAO)
+	room, err := db.RoomByUuid(req.Uuid)
+	if err != nil {
+		return err
+	} else if room == nil {
+		return errors.NotFound(common.SERVICE_CHAT, "Cannot find room %s", req.Uuid)
+	}
+	resp.Room = room
+	return nil
+}
+
+func (c *ChatHandler) SearchMessages(ctx context.Context, req *chat.SearchMessagesRequest, streamer chat.ChatService_SearchMessagesStream) error {
+
+	log.Logger(ctx).Debug("Search Messages", zap.Any("request", req))
+	db := servicecontext.GetDAO(ctx).(chat2.DAO)
+	messages, err := db.SearchMessages(req)
+	if err != nil {
+		return err
+	}
+	defer streamer.Close()
+	rooms := make(map[string]*chat.ChatRoom)
+	for _, m := range messages {
+		room, ok := rooms[m.RoomUuid]
+		if !ok {
+			room, _ = db.RoomByUuid(m.RoomUuid)
+			rooms[m.RoomUuid] = room
+		}
+		if room == nil {
+			continue
+		}
+		streamer.Send(&chat.SearchMessagesResponse{Message: m, Room: room})
+	}
+
+	return nil
+}
+
+func (c *ChatHandler) PurgeMessages(ctx context.Context, req *chat.PurgeMessagesRequest, resp *chat.PurgeMessagesResponse) error {
+
+	db := servicecontext.GetDAO(ctx).(chat2.DAO)
+	deleted, rooms, err := db.PurgeMessages(req.RoomType, req.BeforeTimestamp)
+	if err != nil {
+		return err
+	}
+	log.Logger(ctx).Info("Purged chat messages", zap.String("type", req.RoomType.String()), zap.Int64("deleted", deleted), zap.Int64("rooms", rooms))
+	resp.DeletedCount = deleted
+	resp.RoomsCount = rooms
+	return nil
+}
@@ -22,12 +22,17 @@
 package grpc
 
 import (
+	"context"
+
 	"github.com/micro/go-micro"
 
 	"github.com/pydio/cells/broker/chat"
 	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/jobs"
 	proto "github.com/pydio/cells/common/proto/chat"
 	"github.com/pydio/cells/common/service"
+	"github.com/pydio/cells/common/service/defaults"
 )
 
 func init() {
@@ -35,6 +40,13 @@ func init() {
 		service.Name(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_CHAT),
 		service.Tag(common.SERVICE_TAG_BROKER),
 		service.Description("Chat Service to attach real-time chats to various object. Coupled with WebSocket"),
+		service.Dependency(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_JOBS, []string{}),
+		service.Migrations([]*service.Migration{
+			{
+				TargetVersion: service.FirstRun(),
+				Up:            RegisterPurgeJob,
+			},
+		}),
 		service.WithStorage(chat.NewDAO, "broker_chat"),
 		service.WithMicro(func(m micro.Service) error {
 			proto.RegisterChatServiceHandler(m.Options().Server, new(ChatHandler))
@@ -43,3 +55,36 @@ func init() {
 		}),
 	)
 }
+
+// RegisterPurgeJob creates a daily job applying chat retention periods. No retention is defined by default,
+// administrators set the number of days to keep for each room type in the job parameters.
+func RegisterPurgeJob(ctx context.Context) error {
+
+	log.Logger(ctx).Info("Registering default job for purging chat messages")
+	job := &jobs.Job{
+		ID:             "chat-messages-purge",
+		Label:          "Purge chat messages according to retention periods",
+		Owner:          common.PYDIO_SYSTEM_USERNAME,
+		MaxConcurrency: 1,
+		AutoStart:      false,
+		Schedule: &jobs.Schedule{
+			Iso8601Schedule: "R/2012-06-04T03:00:00.000000+00:00/P1D", // every day
+		},
+		Actions: []*jobs.Action{
+			{
+				ID: "broker.chat.actions.purge",
+				Parameters: map[string]string{
+					"global":    "",
+					"workspace": "",
+					"user":      "",
+					"node":      "",
+				},
+			},
+		},
+	}
+
+	cliJob := jobs.NewJobServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_JOBS, defaults.NewClient())
+	_, e := cliJob.PutJob(ctx, &jobs.PutJobRequest{Job: job})
+	return e
+
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package chat
+
+import (
+	"regexp"
+	"strings"
+)
+
+var mentionRegexp = regexp.MustCompile(`(?:^|[^\w@])@([\w][\w.\-]*)`)
+
+// ParseMentions extracts the unique logins mentioned with @login in a message text.
+func ParseMentions(text string) (logins []string) {
+	seen := make(map[string]bool)
+	for _, m := range mentionRegexp.FindAllStringSubmatch(text, -1) {
+		login := strings.TrimRight(m[1], ".-")
+		if login == "" || seen[login] {
+			continue
+		}
+		seen[login] = true
+		logins = append(logins, login)
+	}
+	return
+}
+
+// NewMentions returns the mentions of the current list that were not in the previous one.
+func NewMentions(previous, current []string) (added []string) {
+	known := make(map[string]bool, len(previous))
+	for _, p := range previous {
+		known[p] = true
+	}
+	for _, c := range current {
+		if !known[c] {
+			added = append(added, c)
+		}
+	}
+	return
+}
@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"context"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	"github.com/micro/go-micro/errors"
+	"go.uber.org/zap"
+
+	chat2 "github.com/pydio/cells/broker/chat"
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/chat"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/service"
+	"github.com/pydio/cells/common/service/defaults"
+	"github.com/pydio/cells/common/utils"
+	"github.com/pydio/cells/common/views"
+)
+
+const (
+	searchDefaultLimit = 50
+)
+
+// ChatHandler implements the REST API for searching and exporting chat rooms.
+// Results are restricted to the rooms attached to objects the current user can read.
+type ChatHandler struct {
+	rooms *chat2.RoomAccessChecker
+}
+
+// NewChatHandler creates a handler checking rooms ACLs with a registry-aware router.
+func NewChatHandler() *ChatHandler {
+	return &ChatHandler{
+		rooms: &chat2.RoomAccessChecker{
+			Router:     views.NewRouterEventFilter(views.RouterOptions{WatchRegistry: true}),
+			TreeClient: tree.NewNodeProviderClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_TREE, defaults.NewClient()),
+		},
+	}
+}
+
+// SwaggerTags list the names of the service tags declared in the swagger json implemented by this service
+func (h *ChatHandler) SwaggerTags() []string {
+	return []string{"ChatService"}
+}
+
+// Filter returns a function to filter the swagger path
+func (h *ChatHandler) Filter() func(string) string {
+	return nil
+}
+
+func (h *ChatHandler) client() chat.ChatServiceClient {
+	return chat.NewChatServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_CHAT, defaults.NewClient())
+}
+
+// SearchChatMessages looks up the messages index, restricted to the rooms the current user can access.
+// Total counts the matching messages, up to chat.MaxSearchLimit.
+func (h *ChatHandler) SearchChatMessages(req *restful.Request, rsp *restful.Response) {
+
+	var input chat.SearchMessagesRequest
+	if err := req.ReadEntity(&input); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	ctx := req.Request.Context()
+	login, _ := utils.FindUserNameInContext(ctx)
+	limit := int(input.Limit)
+	if limit <= 0 {
+		limit = searchDefaultLimit
+	}
+	offset := int(input.Offset)
+
+	collection := &rest.ChatSearchCollection{}
+	rooms, err := h.accessibleRooms(ctx, login, input.RoomUuids)
+	if err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	if len(rooms) == 0 {
+		rsp.WriteEntity(collection)
+		return
+	}
+	input.RoomUuids = nil
+	for uuid := range rooms {
+		input.RoomUuids = append(input.RoomUuids, uuid)
+	}
+	// Load all results to count them, then page on the accessible ones
+	input.Limit = chat2.MaxSearchLimit
+	input.Offset = 0
+
+	streamer, err := h.client().SearchMessages(ctx, &input)
+	if err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	defer streamer.Close()
+	for {
+		resp, e := streamer.Recv()
+		if e != nil {
+			break
+		}
+		if resp == nil || resp.Room == nil || !rooms[resp.Room.Uuid] {
+			continue
+		}
+		if int(collection.Total) >= offset && len(collection.Results) < limit {
+			collection.Results = append(collection.Results, resp)
+		}
+		collection.Total++
+	}
+	rsp.WriteEntity(collection)
+
+}
+
+// accessibleRooms lists the rooms the user can access, among the requested ones if any.
+func (h *ChatHandler) accessibleRooms(ctx context.Context, login string, requested []string) (map[string]bool, error) {
+	filter := make(map[string]bool, len(requested))
+	for _, r := range requested {
+		filter[r] = true
+	}
+	accessible := make(map[string]bool)
+	for t := range chat.RoomType_name {
+		streamer, err := h.client().ListRooms(ctx, &chat.ListRoomsRequest{ByType: chat.RoomType(t)})
+		if err != nil {
+			return nil, err
+		}
+		for {
+			resp, e := streamer.Recv()
+			if e != nil {
+				break
+			}
+			if resp == nil || resp.Room == nil || (len(filter) > 0 && !filter[resp.Room.Uuid]) {
+				continue
+			}
+			if h.rooms.CanAccess(ctx, login, resp.Room) {
+				accessible[resp.Room.Uuid] = true
+			}
+		}
+		streamer.Close()
+	}
+	return accessible, nil
+}
+
+// ExportChatRoom sends the full transcript of a room, as JSON or as an HTML document for printing.
+func (h *ChatHandler) ExportChatRoom(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	roomUuid := req.PathParameter("RoomUuid")
+	format := req.QueryParameter("Format")
+	if format != "" && format != "json" && format != "html" {
+		rsp.WriteErrorString(400, "Format must be either json or html")
+		return
+	}
+	login, _ := utils.FindUserNameInContext(ctx)
+
+	roomResp, err := h.client().GetRoom(ctx, &chat.GetRoomRequest{Uuid: roomUuid})
+	if err != nil {
+		service.RestError404(req, rsp, err)
+		return
+	}
+	if !h.rooms.CanAccess(ctx, login, roomResp.Room) {
+		service.RestError403(req, rsp, errors.Forbidden(common.SERVICE_CHAT, "You are not allowed to access this chat room"))
+		return
+	}
+
+	transcript := &chat.ChatTranscript{
+		Room:       roomResp.Room,
+		ExportedBy: login,
+		ExportedAt: time.Now().Unix(),
+	}
+	streamer, err := h.client().ListMessages(ctx, &chat.ListMessagesRequest{RoomUuid: roomUuid})
+	if err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	defer streamer.Close()
+	for {
+		resp, e := streamer.Recv()
+		if e != nil {
+			break
+		}
+		if resp != nil && resp.Message != nil {
+			transcript.Messages = append(transcript.Messages, resp.Message)
+		}
+	}
+	log.Auditer(ctx).Info("Exported chat room transcript", zap.String("room", roomUuid), zap.Int("messages", len(transcript.Messages)))
+
+	if format == "html" {
+		data, err := chat2.TranscriptHTML(transcript)
+		if err != nil {
+			service.RestError500(req, rsp, err)
+			return
+		}
+		rsp.AddHeader("Content-Type", "text/html; charset=utf-8")
+		rsp.AddHeader("Content-Disposition", `attachment; filename="chat-`+roomUuid+`.html"`)
+		rsp.Write(data)
+		return
+	}
+	rsp.AddHeader("Content-Disposition", `attachment; filename="chat-`+roomUuid+`.json"`)
+	rsp.WriteEntity(transcript)
+
+}
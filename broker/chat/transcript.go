@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package chat
+
+import (
+	"bytes"
+	"html/template"
+	"time"
+
+	"github.com/pydio/cells/common/proto/chat"
+)
+
+var transcriptTemplate = template.Must(template.New("transcript").Funcs(template.FuncMap{
+	"date": func(ts int64) string {
+		return time.Unix(ts, 0).UTC().Format("2006-01-02 15:04:05 MST")
+	},
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Room.RoomLabel}}</title>
+<style>
+@page { size: A4; margin: 20mm; }
+body { font-family: sans-serif; font-size: 11pt; color: #222; }
+h1 { font-size: 16pt; margin-bottom: 0; }
+.meta { color: #777; font-size: 9pt; margin-bottom: 12pt; }
+.message { page-break-inside: avoid; border-bottom: 1px solid #eee; padding: 6pt 0; }
+.message.reply { margin-left: 18pt; }
+.author { font-weight: bold; }
+.date, .edited, .reactions { color: #777; font-size: 9pt; }
+.text { white-space: pre-wrap; margin-top: 3pt; }
+</style>
+</head>
+<body>
+<h1>{{.Room.RoomLabel}}</h1>
+<div class="meta">{{.Room.Type}} {{.Room.RoomTypeObject}} - Exported by {{.ExportedBy}} on {{date .ExportedAt}} - {{len .Messages}} message(s)</div>
+{{range .Messages}}<div class="message{{if .ThreadUuid}} reply{{end}}">
+<span class="author">{{.Author}}</span> <span class="date">{{date .Timestamp}}</span>{{if .EditedAt}} <span class="edited">(edited {{date .EditedAt}})</span>{{end}}
+<div class="text">{{.Message}}</div>
+{{if .Reactions}}<div class="reactions">{{range .Reactions}}{{.Emoji}} {{len .Users}} {{end}}</div>{{end}}
+</div>
+{{end}}</body>
+</html>
+`))
+
+// TranscriptHTML renders a room transcript as a standalone HTML document, ready to be printed to PDF.
+// Replies are displayed right after the first message of their thread.
+func TranscriptHTML(transcript *chat.ChatTranscript) ([]byte, error) {
+
+	var roots []*chat.ChatMessage
+	replies := make(map[string][]*chat.ChatMessage)
+	for _, m := range transcript.Messages {
+		if m.ThreadUuid != "" {
+			replies[m.ThreadUuid] = append(replies[m.ThreadUuid], m)
+		} else {
+			roots = append(roots, m)
+		}
+	}
+	ordered := &chat.ChatTranscript{
+		Room:       transcript.Room,
+		ExportedBy: transcript.ExportedBy,
+		ExportedAt: transcript.ExportedAt,
+	}
+	for _, m := range roots {
+		ordered.Messages = append(ordered.Messages, m)
+		ordered.Messages = append(ordered.Messages, replies[m.Uuid]...)
+		delete(replies, m.Uuid)
+	}
+	// Replies whose thread was deleted
+	for _, m := range transcript.Messages {
+		if _, ok := replies[m.ThreadUuid]; ok && m.ThreadUuid != "" {
+			ordered.Messages = append(ordered.Messages, m)
+		}
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if err := transcriptTemplate.Execute(buf, ordered); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package chat
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/chat"
+)
+
+func TestTranscriptHTML(t *testing.T) {
+
+	Convey("Test HTML transcript", t, func() {
+
+		data, err := TranscriptHTML(&chat.ChatTranscript{
+			Room:       &chat.ChatRoom{Uuid: "room", Type: chat.RoomType_NODE, RoomLabel: "report.pdf"},
+			ExportedBy: "admin",
+			ExportedAt: 1500000000,
+			Messages: []*chat.ChatMessage{
+				{Uuid: "m1", Author: "jane", Message: "First <b>post</b>", Timestamp: 1500000000},
+				{Uuid: "m2", Author: "john", Message: "Second post", Timestamp: 1500000100},
+				{Uuid: "m3", Author: "john", Message: "Reply", Timestamp: 1500000200, ThreadUuid: "m1", EditedAt: 1500000300},
+			},
+		})
+		So(err, ShouldBeNil)
+		html := string(data)
+		So(html, ShouldContainSubstring, "<title>report.pdf</title>")
+		So(html, ShouldContainSubstring, "First &lt;b&gt;post&lt;/b&gt;")
+		So(html, ShouldContainSubstring, "3 message(s)")
+		So(html, ShouldContainSubstring, "(edited 2017-07-14 02:45:00 UTC)")
+		// Reply is displayed right after its thread
+		So(strings.Index(html, "Reply"), ShouldBeLessThan, strings.Index(html, "Second post"))
+
+	})
+
+}
@@ -35,6 +35,9 @@ import (
 	"github.com/pydio/cells/common/service/context"
 )
 
+// auditContextPrefix prefixes the audit context keys, both in received lines and in indexed documents
+const auditContextPrefix = common.KEY_AUDIT_CONTEXT + "."
+
 // IndexableLog extends default log.LogMessage struct to add index specific methods
 type IndexableLog struct {
 	log.LogMessage
@@ -61,21 +64,82 @@ func BlevePutLog(idx bleve.Index, line map[string]string) error {
 // for each corresponding hit.
 // Results are ordered by descending timestamp rather than by score.
 func BleveListLogs(idx bleve.Index, str string, page int32, size int32) (chan log.ListLogResponse, error) {
+	return bleveSearchLogs(idx, func(string) bleve.Index {
+		return idx
+	}, &log.ListLogRequest{Query: str, Page: page, Size: size})
+}
 
-	//fmt.Printf("## [DEBUG] ## Query [%s] should execute \n", str)
+// BuildLogQuery combines the query string and the typed filters of a ListLogRequest in a single bleve query.
+func BuildLogQuery(r *log.ListLogRequest) query.Query {
 
-	var q query.Query
-	if str == "" {
-		q = bleve.NewMatchAllQuery()
-	} else {
+	var queries []query.Query
+	if r.Query != "" {
 		// re := regexp.MustCompile("\\+msg\\:")
 		// str = re.ReplaceAllString(str, "")
-		q = bleve.NewQueryStringQuery(str)
+		queries = append(queries, bleve.NewQueryStringQuery(r.Query))
+	}
+	phrase := func(field, value string) {
+		if value == "" {
+			return
+		}
+		q := bleve.NewMatchPhraseQuery(value)
+		q.SetField(field)
+		queries = append(queries, q)
+	}
+	phrase(common.KEY_USERNAME, r.UserName)
+	phrase(common.KEY_USER_UUID, r.UserUuid)
+	phrase(common.KEY_NODE_UUID, r.NodeUuid)
+	phrase(common.KEY_NODE_PATH, r.NodePath)
+	phrase("WsUuid", r.WsUuid)
+	for k, v := range r.AuditContext {
+		phrase(auditContextPrefix+k, v)
+	}
+	if len(r.MsgIds) > 0 {
+		ids := bleve.NewDisjunctionQuery()
+		for _, id := range r.MsgIds {
+			q := bleve.NewTermQuery(id)
+			q.SetField(common.KEY_MSG_ID)
+			ids.AddQuery(q)
+		}
+		queries = append(queries, ids)
+	}
+	if r.From > 0 || r.To > 0 {
+		var min, max *float64
+		if r.From > 0 {
+			f := float64(r.From)
+			min = &f
+		}
+		if r.To > 0 {
+			t := float64(r.To)
+			max = &t
+		}
+		inclusive := true
+		q := bleve.NewNumericRangeInclusiveQuery(min, max, &inclusive, &inclusive)
+		q.SetField(common.KEY_TS)
+		queries = append(queries, q)
+	}
+
+	switch len(queries) {
+	case 0:
+		return bleve.NewMatchAllQuery()
+	case 1:
+		return queries[0]
+	default:
+		return bleve.NewConjunctionQuery(queries...)
 	}
-	req := bleve.NewSearchRequest(q)
-	req.SortBy([]string{"-" + common.KEY_TS})
-	req.Size = int(size)
-	req.From = int(page * size)
+}
+
+// bleveSearchLogs runs the query against idx, that may be an alias over many shards, and uses
+// lookup to find the index that holds each hit to load the corresponding document.
+func bleveSearchLogs(idx bleve.Index, lookup func(name string) bleve.Index, r *log.ListLogRequest) (chan log.ListLogResponse, error) {
+
+	//fmt.Printf("## [DEBUG] ## Query [%s] should execute \n", str)
+
+	req := bleve.NewSearchRequest(BuildLogQuery(r))
+	// Ties are broken by id so that consecutive searches return logs in the same order
+	req.SortBy([]string{"-" + common.KEY_TS, "-_id"})
+	req.Size = int(r.Size)
+	req.From = int(r.Page * r.Size)
 
 	sr, err := idx.Search(req)
 	if err != nil {
@@ -88,13 +152,17 @@ func BleveListLogs(idx bleve.Index, str string, page int32, size int32) (chan lo
 	go func() {
 		defer close(res)
 
-		//fmt.Printf("## [DEBUG] ## Query [%s] successfully executed: found %d matches in %s\n", str, sr.Total, sr.Took)
+		//fmt.Printf("## [DEBUG] ## Query [%s] successfully executed: found %d matches in %s\n", r.Query, sr.Total, sr.Took)
 
 		for _, hit := range sr.Hits {
 			// fmt.Printf("## Hit#%d:\n", i)
 			// fmt.Printf("%v\n", *hit)
 
-			doc, err := idx.Document(hit.ID)
+			hitIndex := lookup(hit.Index)
+			if hitIndex == nil {
+				continue
+			}
+			doc, err := hitIndex.Document(hit.ID)
 			if err != nil {
 				continue
 			}
@@ -148,6 +216,8 @@ func MarshallLogMsg(line map[string]string) (*IndexableLog, error) {
 			msg.RoleUuids = strings.Split(val, ",")
 		case common.KEY_PROFILE:
 			msg.Profile = val
+		case common.KEY_IMPERSONATOR:
+			msg.Impersonator = val
 		case servicecontext.HttpMetaRemoteAddress:
 			msg.RemoteAddress = val
 		case servicecontext.HttpMetaUserAgent:
@@ -161,7 +231,12 @@ func MarshallLogMsg(line map[string]string) (*IndexableLog, error) {
 		case common.KEY_SPAN_ROOT_UUID:
 			msg.SpanRootUuid = val
 		default:
-			break
+			if strings.HasPrefix(k, auditContextPrefix) {
+				if msg.AuditContext == nil {
+					msg.AuditContext = make(map[string]string)
+				}
+				msg.AuditContext[strings.TrimPrefix(k, auditContextPrefix)] = val
+			}
 		}
 	}
 
@@ -201,10 +276,22 @@ func UnmarshallLogMsgFromDoc(doc *document.Document, msg *log.LogMessage) {
 		msg.UserUuid = val.(string)
 	}
 
+	if val, ok := m["Profile"]; ok {
+		msg.Profile = val.(string)
+	}
+
+	if val, ok := m["RoleUuids"]; ok {
+		msg.RoleUuids = val.([]string)
+	}
+
 	if val, ok := m["GroupPath"]; ok {
 		msg.GroupPath = val.(string)
 	}
 
+	if val, ok := m["Impersonator"]; ok {
+		msg.Impersonator = val.(string)
+	}
+
 	if val, ok := m["RemoteAddress"]; ok {
 		msg.RemoteAddress = val.(string)
 	}
@@ -229,6 +316,10 @@ func UnmarshallLogMsgFromDoc(doc *document.Document, msg *log.LogMessage) {
 		msg.WsUuid = val.(string)
 	}
 
+	if val, ok := m["WsScope"]; ok {
+		msg.WsScope = val.(string)
+	}
+
 	if val, ok := m[common.KEY_SPAN_UUID]; ok {
 		msg.SpanUuid = val.(string)
 	}
@@ -239,6 +330,25 @@ func UnmarshallLogMsgFromDoc(doc *document.Document, msg *log.LogMessage) {
 		msg.SpanParentUuid = val.(string)
 	}
 
+	if val, ok := m["ChainSeq"]; ok {
+		msg.ChainSeq = val.(int64)
+	}
+	if val, ok := m["ChainPrevHash"]; ok {
+		msg.ChainPrevHash = val.(string)
+	}
+	if val, ok := m["ChainHash"]; ok {
+		msg.ChainHash = val.(string)
+	}
+
+	for k, val := range m {
+		if strings.HasPrefix(k, auditContextPrefix) {
+			if msg.AuditContext == nil {
+				msg.AuditContext = make(map[string]string)
+			}
+			msg.AuditContext[strings.TrimPrefix(k, auditContextPrefix)] = val.(string)
+		}
+	}
+
 }
 
 func fromBleveDocToMap(doc *document.Document, m map[string]interface{}) {
@@ -246,12 +356,18 @@ func fromBleveDocToMap(doc *document.Document, m map[string]interface{}) {
 		// fmt.Printf("Mapping %s of type %s\n", field.Name(), reflect.TypeOf(field))
 		switch field := field.(type) {
 		case *document.TextField:
-			m[field.Name()] = string(field.Value())
+			if field.Name() == "RoleUuids" {
+				roles, _ := m[field.Name()].([]string)
+				m[field.Name()] = append(roles, string(field.Value()))
+			} else {
+				m[field.Name()] = string(field.Value())
+			}
 		case *document.NumericField:
 			fNb, err := field.Number()
-			iNb := int32(fNb)
-			if err == nil {
-				m[field.Name()] = iNb // fmt.Sprintf("%f", n)
+			if err == nil && field.Name() == "ChainSeq" {
+				m[field.Name()] = int64(fNb)
+			} else if err == nil {
+				m[field.Name()] = int32(fNb) // fmt.Sprintf("%f", n)
 			}
 		case *document.DateTimeField:
 			d, err := field.DateTime()
@@ -0,0 +1,618 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package log
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/boltdb/bolt"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/crypto"
+	"github.com/pydio/cells/common/proto/log"
+)
+
+var (
+	// CheckpointEvery is the number of audit messages after which a signed checkpoint is written
+	CheckpointEvery int64 = 1000
+	// CheckpointPeriod is the maximum delay between two signed checkpoints
+	CheckpointPeriod = 1 * time.Hour
+
+	chainStateBucket      = []byte("state")
+	chainCheckpointBucket = []byte("checkpoints")
+	chainStateKey         = []byte("head")
+	chainWatermarkKey     = []byte("watermark")
+)
+
+// Checkpoint is a signed snapshot of the chain head, used to detect the removal or the rewriting
+// of the most recent messages. Each checkpoint is linked to the digest of the previous one, so that
+// checkpoints cannot be removed without breaking the checkpoints chain.
+type Checkpoint struct {
+	Sequence  int64  `json:"seq"`
+	Hash      string `json:"hash"`
+	Ts        int32  `json:"ts"`
+	Prev      string `json:"prev,omitempty"`
+	Signature string `json:"signature"`
+}
+
+func (c *Checkpoint) payload() []byte {
+	if c.Prev == "" {
+		// Checkpoints written before they were linked together
+		return []byte(fmt.Sprintf("%d:%s:%d", c.Sequence, c.Hash, c.Ts))
+	}
+	return []byte(fmt.Sprintf("%d:%s:%d:%s", c.Sequence, c.Hash, c.Ts, c.Prev))
+}
+
+// digest is the value referenced by the Prev field of the next checkpoint.
+func (c *Checkpoint) digest() string {
+	h := sha256.New()
+	h.Write(c.payload())
+	h.Write([]byte(c.Signature))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Watermark is a signed reference to the first message kept after retention dropped the oldest shards,
+// used to detect the removal of the oldest messages. Without watermark, the chain must start at sequence 1.
+type Watermark struct {
+	Sequence  int64  `json:"seq"`
+	PrevHash  string `json:"prevHash"`
+	Ts        int32  `json:"ts"`
+	Signature string `json:"signature"`
+}
+
+func (w *Watermark) payload() []byte {
+	return []byte(fmt.Sprintf("watermark:%d:%s:%d", w.Sequence, w.PrevHash, w.Ts))
+}
+
+type chainHead struct {
+	Sequence       int64  `json:"seq"`
+	Hash           string `json:"hash"`
+	CheckpointSeq  int64  `json:"checkpointSeq"`
+	CheckpointTime int64  `json:"checkpointTime"`
+	LastCheckpoint string `json:"lastCheckpoint,omitempty"`
+}
+
+// AuditChain links audit messages together by hash: each message stores the hash of the previous one,
+// and its own hash covers its content and this link. The chain head and the signed checkpoints are
+// persisted in a bolt file, next to the index.
+type AuditChain struct {
+	sync.Mutex
+	db   *bolt.DB
+	key  *ecdsa.PrivateKey
+	head chainHead
+}
+
+// NewAuditChain opens or creates the chain state at the given path. If key is nil, no checkpoints are signed.
+func NewAuditChain(statePath string, key *ecdsa.PrivateKey) (*AuditChain, error) {
+	db, err := bolt.Open(statePath, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+	c := &AuditChain{db: db, key: key}
+	err = db.Update(func(tx *bolt.Tx) error {
+		b, e := tx.CreateBucketIfNotExists(chainStateBucket)
+		if e != nil {
+			return e
+		}
+		if _, e := tx.CreateBucketIfNotExists(chainCheckpointBucket); e != nil {
+			return e
+		}
+		if data := b.Get(chainStateKey); data != nil {
+			return json.Unmarshal(data, &c.head)
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// LoadChainKey reads the checkpoints signing key stored at keyPath, protected by password.
+// A new key is generated and stored if the file does not exist yet.
+func LoadChainKey(keyPath string, password []byte) (*ecdsa.PrivateKey, error) {
+	if data, err := ioutil.ReadFile(keyPath); err == nil {
+		key, err := crypto.ParsePrivate(password, data)
+		if err != nil {
+			return nil, err
+		}
+		ecKey, ok := key.(*ecdsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("unexpected key type in %s", keyPath)
+		}
+		return ecKey, nil
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	key, err := crypto.NewEcdsaPrivateKey("p256")
+	if err != nil {
+		return nil, err
+	}
+	data, err := crypto.EncodePrivate(password, key)
+	if err != nil {
+		return nil, err
+	}
+	return key, ioutil.WriteFile(keyPath, data, 0600)
+}
+
+// Append links msg to the chain head and calls store to persist it. The head only moves forward
+// if store succeeds.
+func (c *AuditChain) Append(msg *IndexableLog, store func(*IndexableLog) error) error {
+	c.Lock()
+	defer c.Unlock()
+
+	msg.ChainSeq = c.head.Sequence + 1
+	msg.ChainPrevHash = c.head.Hash
+	msg.ChainHash = ChainHash(&msg.LogMessage)
+	if err := store(msg); err != nil {
+		return err
+	}
+
+	head := c.head
+	head.Sequence = msg.ChainSeq
+	head.Hash = msg.ChainHash
+	var checkpoint *Checkpoint
+	if c.key != nil && (head.Sequence-head.CheckpointSeq >= CheckpointEvery || time.Since(time.Unix(head.CheckpointTime, 0)) >= CheckpointPeriod) {
+		var err error
+		if checkpoint, err = c.sign(head.Sequence, head.Hash, head.LastCheckpoint); err != nil {
+			return err
+		}
+		head.CheckpointSeq = checkpoint.Sequence
+		head.CheckpointTime = int64(checkpoint.Ts)
+		head.LastCheckpoint = checkpoint.digest()
+	}
+	if err := c.save(head, checkpoint); err != nil {
+		return err
+	}
+	c.head = head
+	return nil
+}
+
+// Checkpoint signs the current head, if it was not signed yet.
+func (c *AuditChain) Checkpoint() error {
+	c.Lock()
+	defer c.Unlock()
+	if c.key == nil || c.head.Sequence == 0 || c.head.Sequence == c.head.CheckpointSeq {
+		return nil
+	}
+	checkpoint, err := c.sign(c.head.Sequence, c.head.Hash, c.head.LastCheckpoint)
+	if err != nil {
+		return err
+	}
+	head := c.head
+	head.CheckpointSeq = checkpoint.Sequence
+	head.CheckpointTime = int64(checkpoint.Ts)
+	head.LastCheckpoint = checkpoint.digest()
+	if err := c.save(head, checkpoint); err != nil {
+		return err
+	}
+	c.head = head
+	return nil
+}
+
+// Head returns the last sequence and hash of the chain.
+func (c *AuditChain) Head() (int64, string) {
+	c.Lock()
+	defer c.Unlock()
+	return c.head.Sequence, c.head.Hash
+}
+
+// resume moves the head to the given position, used when the state file was lost but the index was not.
+func (c *AuditChain) resume(seq int64, hash string) error {
+	c.Lock()
+	defer c.Unlock()
+	head := c.head
+	head.Sequence, head.Hash = seq, hash
+	if err := c.save(head, nil); err != nil {
+		return err
+	}
+	c.head = head
+	return nil
+}
+
+// Checkpoints lists the checkpoints whose sequence is between from and to, inclusive.
+func (c *AuditChain) Checkpoints(from, to int64) (cps []*Checkpoint, err error) {
+	err = c.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(chainCheckpointBucket).Cursor()
+		for k, v := cursor.Seek(seqKey(from)); k != nil && int64(binary.BigEndian.Uint64(k)) <= to; k, v = cursor.Next() {
+			cp := &Checkpoint{}
+			if e := json.Unmarshal(v, cp); e != nil {
+				return e
+			}
+			cps = append(cps, cp)
+		}
+		return nil
+	})
+	return
+}
+
+// VerifyCheckpoint checks the checkpoint signature.
+func (c *AuditChain) VerifyCheckpoint(cp *Checkpoint) bool {
+	if c.key == nil {
+		return false
+	}
+	return crypto.VerifySignature(cp.payload(), &c.key.PublicKey, cp.Signature)
+}
+
+// VerifyCheckpoints walks all checkpoints and returns the first one whose signature is invalid or which
+// does not link to the previous checkpoint, or nil if the checkpoints chain is valid. Checkpoints written
+// before they were linked together are only checked for their signature.
+func (c *AuditChain) VerifyCheckpoints() (*Checkpoint, error) {
+	c.Lock()
+	last := c.head.LastCheckpoint
+	c.Unlock()
+	cps, err := c.Checkpoints(1, math.MaxInt64)
+	if err != nil {
+		return nil, err
+	}
+	var prev string
+	linked := false
+	for _, cp := range cps {
+		if !c.VerifyCheckpoint(cp) {
+			return cp, nil
+		}
+		if cp.Prev != "" {
+			linked = true
+		}
+		if linked && cp.Prev != prev {
+			return cp, nil
+		}
+		prev = cp.digest()
+	}
+	if last != "" && last != prev {
+		// Most recent checkpoints were removed
+		if len(cps) > 0 {
+			return cps[len(cps)-1], nil
+		}
+		return &Checkpoint{}, nil
+	}
+	return nil, nil
+}
+
+// SetWatermark signs the position of the first message kept after retention dropped the oldest shards.
+// The watermark only moves forward. Nothing is stored if there is no signing key.
+func (c *AuditChain) SetWatermark(seq int64, prevHash string) error {
+	if c.key == nil {
+		return nil
+	}
+	if current, err := c.Watermark(); err != nil {
+		return err
+	} else if current != nil && current.Sequence >= seq {
+		return nil
+	}
+	w := &Watermark{Sequence: seq, PrevHash: prevHash, Ts: convertTimeToTs(time.Now())}
+	signature, err := crypto.GetSignature(c.key, w.payload())
+	if err != nil {
+		return err
+	}
+	w.Signature = signature
+	data, _ := json.Marshal(w)
+	return c.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainStateBucket).Put(chainWatermarkKey, data)
+	})
+}
+
+// Watermark returns the current watermark, or nil if no shard of chained messages was ever dropped.
+func (c *AuditChain) Watermark() (w *Watermark, err error) {
+	err = c.db.View(func(tx *bolt.Tx) error {
+		if data := tx.Bucket(chainStateBucket).Get(chainWatermarkKey); data != nil {
+			w = &Watermark{}
+			return json.Unmarshal(data, w)
+		}
+		return nil
+	})
+	return
+}
+
+// VerifyWatermark checks the watermark signature.
+func (c *AuditChain) VerifyWatermark(w *Watermark) bool {
+	if c.key == nil {
+		return false
+	}
+	return crypto.VerifySignature(w.payload(), &c.key.PublicKey, w.Signature)
+}
+
+// Close closes the state file.
+func (c *AuditChain) Close() error {
+	return c.db.Close()
+}
+
+func (c *AuditChain) sign(seq int64, hash string, prev string) (*Checkpoint, error) {
+	cp := &Checkpoint{Sequence: seq, Hash: hash, Ts: convertTimeToTs(time.Now()), Prev: prev}
+	signature, err := crypto.GetSignature(c.key, cp.payload())
+	if err != nil {
+		return nil, err
+	}
+	cp.Signature = signature
+	return cp, nil
+}
+
+func (c *AuditChain) save(head chainHead, checkpoint *Checkpoint) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		data, _ := json.Marshal(head)
+		if err := tx.Bucket(chainStateBucket).Put(chainStateKey, data); err != nil {
+			return err
+		}
+		if checkpoint != nil {
+			data, _ := json.Marshal(checkpoint)
+			return tx.Bucket(chainCheckpointBucket).Put(seqKey(checkpoint.Sequence), data)
+		}
+		return nil
+	})
+}
+
+// ChainHash computes the hash of an audit message, covering all its fields and the previous hash.
+func ChainHash(msg *log.LogMessage) string {
+	clone := *msg
+	clone.ChainHash = ""
+	data, _ := json.Marshal(&clone)
+	h := sha256.New()
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func seqKey(seq int64) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, uint64(seq))
+	return k
+}
+
+// VerifyAuditChain checks all chained audit messages between the first and the last ones logged
+// in the [from, to] time range (zero values are unbounded) and reports the first break found.
+// Signed checkpoints must be linked together and, when the range is not bounded by from, the first
+// message must match the watermark left by retention.
+func (s *SyslogServer) VerifyAuditChain(from, to int32) (*log.VerifyAuditChainResponse, error) {
+	if s.chain == nil {
+		return nil, fmt.Errorf("audit chain is not enabled")
+	}
+	idx, lookup := s.familyIndex(FamilyAudit)
+	resp := &log.VerifyAuditChainResponse{Valid: true}
+	fail := func(seq int64, ts int32, reason string, args ...interface{}) (*log.VerifyAuditChainResponse, error) {
+		resp.Valid = false
+		resp.Break = &log.AuditChainBreak{Sequence: seq, Ts: ts, Reason: fmt.Sprintf(reason, args...)}
+		return resp, nil
+	}
+	head, _ := s.chain.Head()
+	if broken, err := s.chain.VerifyCheckpoints(); err != nil {
+		return nil, err
+	} else if broken != nil {
+		return fail(broken.Sequence, broken.Ts, "signed checkpoint is invalid or was removed from the checkpoints chain")
+	}
+
+	// Oldest message must be the first message of the chain, or the one referenced by the watermark
+	var expectSeq int64 = 1
+	var expectPrev string
+	if from == 0 {
+		wm, err := s.chain.Watermark()
+		if err != nil {
+			return nil, err
+		}
+		if wm != nil {
+			if !s.chain.VerifyWatermark(wm) {
+				return fail(wm.Sequence, wm.Ts, "retention watermark signature is invalid")
+			}
+			expectSeq, expectPrev = wm.Sequence, wm.PrevHash
+		}
+	}
+
+	var first, last int64
+	if idx != nil {
+		var err error
+		if first, err = chainBound(idx, from, to, true); err != nil {
+			return nil, err
+		}
+		if last, err = chainBound(idx, from, to, false); err != nil {
+			return nil, err
+		}
+	}
+
+	// Checkpoints signed within the range must reference messages that are still present
+	lower := first
+	if first == 0 {
+		lower = 1
+	}
+	all, err := s.chain.Checkpoints(lower, head)
+	if err != nil {
+		return nil, err
+	}
+	var cps []*Checkpoint
+	for _, cp := range all {
+		if (from == 0 || cp.Ts >= from) && (to == 0 || cp.Ts <= to) || (first > 0 && cp.Sequence <= last) {
+			cps = append(cps, cp)
+		}
+	}
+	if first == 0 {
+		if len(cps) > 0 {
+			return fail(cps[0].Sequence, 0, "message %d referenced by a signed checkpoint is missing", cps[0].Sequence)
+		}
+		if from == 0 && to == 0 && head >= expectSeq {
+			return fail(expectSeq, 0, "messages %d to %d are missing", expectSeq, head)
+		}
+		return resp, nil
+	}
+	if from == 0 && first != expectSeq {
+		if first > expectSeq {
+			return fail(expectSeq, 0, "messages %d to %d are missing", expectSeq, first-1)
+		}
+		return fail(first, 0, "first message does not match the retention watermark")
+	}
+
+	var prev *log.LogMessage
+	for next := first; next <= last; {
+		page, err := chainPage(idx, lookup, next, last, 500)
+		if err != nil {
+			return nil, err
+		}
+		if len(page) == 0 {
+			break
+		}
+		for _, msg := range page {
+			if len(cps) > 0 && cps[0].Sequence < msg.ChainSeq {
+				return fail(cps[0].Sequence, 0, "message %d referenced by a signed checkpoint is missing", cps[0].Sequence)
+			}
+			if prev == nil {
+				resp.FirstSequence = msg.ChainSeq
+				if from == 0 && msg.ChainPrevHash != expectPrev {
+					return fail(msg.ChainSeq, msg.Ts, "first message does not link to the retention watermark")
+				}
+			} else if msg.ChainSeq != prev.ChainSeq+1 {
+				return fail(prev.ChainSeq+1, 0, "messages %d to %d are missing", prev.ChainSeq+1, msg.ChainSeq-1)
+			} else if msg.ChainPrevHash != prev.ChainHash {
+				return fail(msg.ChainSeq, msg.Ts, "message does not link to previous message hash")
+			}
+			if ChainHash(msg) != msg.ChainHash {
+				return fail(msg.ChainSeq, msg.Ts, "message content does not match its hash")
+			}
+			if len(cps) > 0 && cps[0].Sequence == msg.ChainSeq {
+				if !s.chain.VerifyCheckpoint(cps[0]) {
+					return fail(msg.ChainSeq, msg.Ts, "checkpoint signature is invalid")
+				}
+				if cps[0].Hash != msg.ChainHash {
+					return fail(msg.ChainSeq, msg.Ts, "message hash does not match signed checkpoint")
+				}
+				resp.Checkpoints++
+				cps = cps[1:]
+			}
+			resp.Checked++
+			resp.LastSequence = msg.ChainSeq
+			prev = msg
+		}
+		next = prev.ChainSeq + 1
+	}
+	if len(cps) > 0 {
+		return fail(cps[0].Sequence, 0, "message %d referenced by a signed checkpoint is missing", cps[0].Sequence)
+	}
+	return resp, nil
+}
+
+// updateWatermark signs the position of the first chained message still present, after shards of
+// audit messages were dropped by retention.
+func (s *SyslogServer) updateWatermark() error {
+	s.RLock()
+	chain := s.chain
+	s.RUnlock()
+	if chain == nil {
+		return nil
+	}
+	seq, prevHash := chain.Head()
+	seq++
+	if idx, lookup := s.familyIndex(FamilyAudit); idx != nil {
+		first, err := chainBound(idx, 0, 0, true)
+		if err != nil {
+			return err
+		}
+		if first > 0 {
+			msgs, err := chainPage(idx, lookup, first, first, 1)
+			if err != nil {
+				return err
+			}
+			if len(msgs) > 0 {
+				seq, prevHash = first, msgs[0].ChainPrevHash
+			}
+		}
+	}
+	return chain.SetWatermark(seq, prevHash)
+}
+
+// chainBound finds the lowest (or highest) chain sequence of messages logged in the time range.
+func chainBound(idx bleve.Index, from, to int32, lowest bool) (int64, error) {
+	min, max := float64(from), float64(to)
+	q := bleve.NewConjunctionQuery(chainSeqQuery(1, 0))
+	if from > 0 || to > 0 {
+		tsQ := bleve.NewNumericRangeQuery(nil, nil)
+		if from > 0 {
+			tsQ.Min = &min
+		}
+		if to > 0 {
+			tsQ.Max = &max
+		}
+		inclusive := true
+		tsQ.InclusiveMin, tsQ.InclusiveMax = &inclusive, &inclusive
+		tsQ.SetField(common.KEY_TS)
+		q.AddQuery(tsQ)
+	}
+	req := bleve.NewSearchRequest(q)
+	req.Size = 1
+	req.Fields = []string{"ChainSeq"}
+	if lowest {
+		req.SortBy([]string{"ChainSeq"})
+	} else {
+		req.SortBy([]string{"-ChainSeq"})
+	}
+	sr, err := idx.Search(req)
+	if err != nil || len(sr.Hits) == 0 {
+		return 0, err
+	}
+	seq, _ := sr.Hits[0].Fields["ChainSeq"].(float64)
+	return int64(seq), nil
+}
+
+// chainPage loads the messages with a chain sequence between from and to, ordered by sequence.
+func chainPage(idx bleve.Index, lookup func(string) bleve.Index, from, to int64, size int) ([]*log.LogMessage, error) {
+	req := bleve.NewSearchRequest(chainSeqQuery(from, to))
+	req.Size = size
+	req.SortBy([]string{"ChainSeq"})
+	sr, err := idx.Search(req)
+	if err != nil {
+		return nil, err
+	}
+	var msgs []*log.LogMessage
+	for _, hit := range sr.Hits {
+		hitIndex := lookup(hit.Index)
+		if hitIndex == nil {
+			continue
+		}
+		doc, err := hitIndex.Document(hit.ID)
+		if err != nil {
+			return nil, err
+		}
+		msg := &log.LogMessage{}
+		UnmarshallLogMsgFromDoc(doc, msg)
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+func chainSeqQuery(from, to int64) query.Query {
+	min := float64(from)
+	inclusive := true
+	var max *float64
+	if to > 0 {
+		m := float64(to)
+		max = &m
+	}
+	q := bleve.NewNumericRangeInclusiveQuery(&min, max, &inclusive, &inclusive)
+	q.SetField("ChainSeq")
+	return q
+}
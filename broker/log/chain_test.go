@@ -0,0 +1,230 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package log
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/blevesearch/bleve"
+	"github.com/boltdb/bolt"
+
+	"github.com/pydio/cells/common/crypto"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func auditLog(ts time.Time, i int) map[string]string {
+	line := datedLog(ts, fmt.Sprintf("audit message %d", i), true)
+	line["MsgId"] = "1"
+	line["UserName"] = "jenny"
+	line["Roles"] = "ROOT_GROUP,ADMINS"
+	return line
+}
+
+// findChained returns the shard index and document id of a chained message.
+func findChained(s *SyslogServer, seq int64) (bleve.Index, string) {
+	idx, lookup := s.familyIndex(FamilyAudit)
+	req := bleve.NewSearchRequest(chainSeqQuery(seq, seq))
+	sr, err := idx.Search(req)
+	So(err, ShouldBeNil)
+	So(sr.Hits, ShouldHaveLength, 1)
+	return lookup(sr.Hits[0].Index), sr.Hits[0].ID
+}
+
+func newChainedServer(dir string) *SyslogServer {
+	s, err := NewSyslogServer("")
+	So(err, ShouldBeNil)
+	key, err := LoadChainKey(filepath.Join(dir, "chain.key"), []byte("secret"))
+	So(err, ShouldBeNil)
+	chain, err := NewAuditChain(filepath.Join(dir, "chain.db"), key)
+	So(err, ShouldBeNil)
+	So(s.EnableAuditChain(chain), ShouldBeNil)
+	return s
+}
+
+func TestAuditChain(t *testing.T) {
+
+	CheckpointEvery = 5
+	now := time.Now()
+
+	Convey("Audit messages are chained and verified", t, func() {
+		dir, _ := ioutil.TempDir("", "chain")
+		defer os.RemoveAll(dir)
+		s := newChainedServer(dir)
+		defer s.Close()
+
+		for i := 1; i <= 12; i++ {
+			So(s.PutLog(auditLog(now.Add(time.Duration(i)*time.Second), i)), ShouldBeNil)
+		}
+		So(s.PutLog(datedLog(now, "technical messages are not chained", false)), ShouldBeNil)
+
+		report, err := s.VerifyAuditChain(0, 0)
+		So(err, ShouldBeNil)
+		So(report.Valid, ShouldBeTrue)
+		So(report.Checked, ShouldEqual, 12)
+		So(report.Checkpoints, ShouldEqual, 3)
+		So(report.FirstSequence, ShouldEqual, 1)
+		So(report.LastSequence, ShouldEqual, 12)
+
+		report, err = s.VerifyAuditChain(convertTimeToTs(now.Add(3*time.Second)), convertTimeToTs(now.Add(6*time.Second)))
+		So(err, ShouldBeNil)
+		So(report.Valid, ShouldBeTrue)
+		So(report.Checked, ShouldEqual, 4)
+		So(report.Checkpoints, ShouldEqual, 1)
+
+		Convey("Modified messages are detected", func() {
+			idx, id := findChained(s, 7)
+			doc, _ := idx.Document(id)
+			msg := &IndexableLog{}
+			UnmarshallLogMsgFromDoc(doc, &msg.LogMessage)
+			msg.UserName = "john"
+			So(idx.Index(id, msg), ShouldBeNil)
+
+			report, err := s.VerifyAuditChain(0, 0)
+			So(err, ShouldBeNil)
+			So(report.Valid, ShouldBeFalse)
+			So(report.Break.Sequence, ShouldEqual, 7)
+			So(report.Checked, ShouldEqual, 6)
+		})
+
+		Convey("Deleted messages are detected", func() {
+			idx, id := findChained(s, 3)
+			So(idx.Delete(id), ShouldBeNil)
+
+			report, err := s.VerifyAuditChain(0, 0)
+			So(err, ShouldBeNil)
+			So(report.Valid, ShouldBeFalse)
+			So(report.Break.Sequence, ShouldEqual, 3)
+		})
+
+		Convey("Deleted messages at the end of the chain are detected by checkpoints", func() {
+			for seq := int64(8); seq <= 12; seq++ {
+				idx, id := findChained(s, seq)
+				So(idx.Delete(id), ShouldBeNil)
+			}
+
+			report, err := s.VerifyAuditChain(0, 0)
+			So(err, ShouldBeNil)
+			So(report.Valid, ShouldBeFalse)
+			So(report.Break.Sequence, ShouldEqual, 11)
+		})
+
+		Convey("Deleted messages at the start of the chain are detected", func() {
+			idx, id := findChained(s, 1)
+			So(idx.Delete(id), ShouldBeNil)
+
+			report, err := s.VerifyAuditChain(0, 0)
+			So(err, ShouldBeNil)
+			So(report.Valid, ShouldBeFalse)
+			So(report.Break.Sequence, ShouldEqual, 1)
+		})
+
+		Convey("Removed checkpoints are detected", func() {
+			cps, err := s.chain.Checkpoints(1, 12)
+			So(err, ShouldBeNil)
+			So(len(cps), ShouldBeGreaterThan, 2)
+			So(s.chain.db.Update(func(tx *bolt.Tx) error {
+				return tx.Bucket(chainCheckpointBucket).Delete(seqKey(cps[1].Sequence))
+			}), ShouldBeNil)
+
+			report, err := s.VerifyAuditChain(0, 0)
+			So(err, ShouldBeNil)
+			So(report.Valid, ShouldBeFalse)
+			So(report.Break.Sequence, ShouldEqual, cps[2].Sequence)
+		})
+	})
+
+	Convey("Messages dropped by retention are covered by a signed watermark", t, func() {
+		dir, _ := ioutil.TempDir("", "chain")
+		defer os.RemoveAll(dir)
+		s := newChainedServer(dir)
+		defer s.Close()
+
+		old := now.Add(-72 * time.Hour)
+		for i := 1; i <= 3; i++ {
+			So(s.PutLog(auditLog(old, i)), ShouldBeNil)
+		}
+		for i := 4; i <= 6; i++ {
+			So(s.PutLog(auditLog(now, i)), ShouldBeNil)
+		}
+		s.SetRetentionPolicy(FamilyAudit, RetentionPolicy{MaxAge: 24 * time.Hour})
+		dropped, err := s.ApplyRetention(now)
+		So(err, ShouldBeNil)
+		So(dropped, ShouldHaveLength, 1)
+
+		report, err := s.VerifyAuditChain(0, 0)
+		So(err, ShouldBeNil)
+		So(report.Valid, ShouldBeTrue)
+		So(report.FirstSequence, ShouldEqual, 4)
+
+		idx, id := findChained(s, 4)
+		So(idx.Delete(id), ShouldBeNil)
+		report, err = s.VerifyAuditChain(0, 0)
+		So(err, ShouldBeNil)
+		So(report.Valid, ShouldBeFalse)
+		So(report.Break.Sequence, ShouldEqual, 4)
+	})
+
+	Convey("Chain resumes from the index when its state is lost", t, func() {
+		dir, _ := ioutil.TempDir("", "chain")
+		defer os.RemoveAll(dir)
+		s := newChainedServer(dir)
+		defer s.Close()
+		for i := 1; i <= 3; i++ {
+			So(s.PutLog(auditLog(now, i)), ShouldBeNil)
+		}
+
+		s.chain.Close()
+		os.Remove(filepath.Join(dir, "chain.db"))
+		chain, err := NewAuditChain(filepath.Join(dir, "chain.db"), nil)
+		So(err, ShouldBeNil)
+		So(s.EnableAuditChain(chain), ShouldBeNil)
+		seq, _ := chain.Head()
+		So(seq, ShouldEqual, 3)
+
+		So(s.PutLog(auditLog(now, 4)), ShouldBeNil)
+		report, err := s.VerifyAuditChain(0, 0)
+		So(err, ShouldBeNil)
+		So(report.Valid, ShouldBeTrue)
+		So(report.Checked, ShouldEqual, 4)
+	})
+
+	Convey("Signing key is stored encrypted and reloaded", t, func() {
+		dir, _ := ioutil.TempDir("", "chain")
+		defer os.RemoveAll(dir)
+		keyPath := filepath.Join(dir, "chain.key")
+		k1, err := LoadChainKey(keyPath, []byte("secret"))
+		So(err, ShouldBeNil)
+		k2, err := LoadChainKey(keyPath, []byte("secret"))
+		So(err, ShouldBeNil)
+		So(k2.D.Cmp(k1.D), ShouldEqual, 0)
+		_, err = LoadChainKey(keyPath, []byte("other"))
+		So(err, ShouldNotBeNil)
+
+		signature, err := crypto.GetSignature(k1, []byte("data"))
+		So(err, ShouldBeNil)
+		So(crypto.VerifySignature([]byte("data"), &k2.PublicKey, signature), ShouldBeTrue)
+	})
+}
@@ -37,7 +37,9 @@ import (
 type MessageRepository interface {
 	PutLog(map[string]string) error
 	ListLogs(string, int32, int32) (chan log.ListLogResponse, error)
+	SearchLogs(*log.ListLogRequest) (chan log.ListLogResponse, error)
 	AggregatedLogs(string, string, int32) (chan log.TimeRangeResponse, error)
+	VerifyAuditChain(int32, int32) (*log.VerifyAuditChainResponse, error)
 }
 
 /* HELPER METHODS */
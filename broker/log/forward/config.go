@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package forward sends log messages received by the log service to external collectors
+// (SIEM, syslog servers, HTTP endpoints) in real time.
+//
+// Each forwarder filters the incoming messages, formats them and pushes them in a bounded
+// disk-backed queue that is consumed in background, so that no event is lost during a
+// temporary outage of the remote endpoint.
+package forward
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// TypeSyslog sends messages to a syslog collector over TCP, TLS or UDP
+	TypeSyslog = "syslog"
+	// TypeHttp posts messages to an HTTP endpoint
+	TypeHttp = "http"
+
+	FormatRFC5424 = "rfc5424"
+	FormatCEF     = "cef"
+	FormatLEEF    = "leef"
+	FormatJSON    = "json"
+
+	// DefaultQueueSize is the maximum number of pending messages kept on disk per forwarder
+	DefaultQueueSize = 10000
+)
+
+// Filter restricts the messages sent by a forwarder. Empty lists match everything.
+type Filter struct {
+	// MsgIds keeps only messages whose MsgId is listed
+	MsgIds []string `json:"msgIds,omitempty"`
+	// Levels keeps only messages with one of these levels (case insensitive)
+	Levels []string `json:"levels,omitempty"`
+	// Loggers keeps only messages whose logger starts with one of these prefixes
+	Loggers []string `json:"loggers,omitempty"`
+}
+
+// Match checks if a log line passes the filter.
+func (f Filter) Match(line map[string]string) bool {
+	if len(f.MsgIds) > 0 && !contains(f.MsgIds, line[keyMsgId], false) {
+		return false
+	}
+	if len(f.Levels) > 0 && !contains(f.Levels, line[keyLevel], true) {
+		return false
+	}
+	if len(f.Loggers) > 0 {
+		logger := line[keyLogger]
+		for _, prefix := range f.Loggers {
+			if strings.HasPrefix(logger, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// Config describes a forwarder, as found in the "forwarders" list of the log service configuration.
+type Config struct {
+	// Name identifies the forwarder and its queue on disk
+	Name string `json:"name"`
+	// Type is either TypeSyslog or TypeHttp
+	Type string `json:"type"`
+	// Network is one of tcp, tls or udp for syslog forwarders
+	Network string `json:"network,omitempty"`
+	// Address is the host:port of the syslog collector
+	Address string `json:"address,omitempty"`
+	// InsecureSkipVerify disables the server certificate check for tls network
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// Url is the endpoint of http forwarders
+	Url string `json:"url,omitempty"`
+	// Headers are added to each http request, e.g. for authentication
+	Headers map[string]string `json:"headers,omitempty"`
+	// Format is one of rfc5424, cef, leef or json. Defaults to rfc5424 for syslog and json for http.
+	Format string `json:"format,omitempty"`
+	// Filter restricts the forwarded messages
+	Filter Filter `json:"filter,omitempty"`
+	// QueueSize is the maximum number of pending messages, oldest ones are discarded first
+	QueueSize int `json:"queueSize,omitempty"`
+}
+
+// Validate checks the configuration and sets default values.
+func (c *Config) Validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("forwarder name is required")
+	}
+	switch c.Type {
+	case TypeSyslog:
+		if c.Address == "" {
+			return fmt.Errorf("forwarder %s: address is required", c.Name)
+		}
+		switch c.Network {
+		case "":
+			c.Network = "tcp"
+		case "tcp", "tls", "udp":
+		default:
+			return fmt.Errorf("forwarder %s: unsupported network %s", c.Name, c.Network)
+		}
+		if c.Format == "" {
+			c.Format = FormatRFC5424
+		}
+	case TypeHttp:
+		if c.Url == "" {
+			return fmt.Errorf("forwarder %s: url is required", c.Name)
+		}
+		if c.Format == "" {
+			c.Format = FormatJSON
+		}
+	default:
+		return fmt.Errorf("forwarder %s: unsupported type %s", c.Name, c.Type)
+	}
+	if _, ok := formatters[c.Format]; !ok {
+		return fmt.Errorf("forwarder %s: unsupported format %s", c.Name, c.Format)
+	}
+	if c.QueueSize <= 0 {
+		c.QueueSize = DefaultQueueSize
+	}
+	return nil
+}
+
+func contains(values []string, value string, fold bool) bool {
+	for _, v := range values {
+		if v == value || (fold && strings.EqualFold(v, value)) {
+			return true
+		}
+	}
+	return false
+}
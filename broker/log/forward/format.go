@@ -0,0 +1,295 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package forward
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/service/context"
+)
+
+// Keys of the raw zap lines received by the log service
+const (
+	keyTs      = "ts"
+	keyLevel   = "level"
+	keyLogger  = "logger"
+	keyMsg     = "msg"
+	keyMsgId   = common.KEY_MSG_ID
+	keyLogType = "LogType"
+
+	// sdID is the structured data ID used in RFC5424 messages. 32473 is the enterprise number reserved for documentation (RFC5612).
+	sdID = "cells@32473"
+)
+
+// Formatter serializes a raw log line for a given wire format.
+type Formatter func(line map[string]string) ([]byte, error)
+
+var (
+	formatters = map[string]Formatter{
+		FormatRFC5424: FormatSyslog,
+		FormatCEF:     FormatCef,
+		FormatLEEF:    FormatLeef,
+		FormatJSON:    FormatJson,
+	}
+
+	hostname, _ = os.Hostname()
+
+	// cefKeys maps known log fields to CEF extension keys
+	cefKeys = map[string]string{
+		common.KEY_USERNAME:                  "suser",
+		common.KEY_USER_UUID:                 "suid",
+		servicecontext.HttpMetaRemoteAddress: "src",
+		servicecontext.HttpMetaUserAgent:     "requestClientApplication",
+		servicecontext.HttpMetaRequestMethod: "requestMethod",
+		servicecontext.HttpMetaRequestURI:    "request",
+		common.KEY_NODE_PATH:                 "filePath",
+		common.KEY_NODE_UUID:                 "fileId",
+		common.KEY_WORKSPACE_UUID:            "cs1",
+		common.KEY_IMPERSONATOR:              "cs2",
+		common.KEY_SPAN_ROOT_UUID:            "externalId",
+	}
+	cefLabels = map[string]string{
+		"cs1": "WorkspaceUuid",
+		"cs2": "Impersonator",
+	}
+
+	// leefKeys maps known log fields to LEEF predefined attributes
+	leefKeys = map[string]string{
+		common.KEY_USERNAME:                  "usrName",
+		servicecontext.HttpMetaRemoteAddress: "src",
+	}
+)
+
+// FormatJson simply encodes the line as a JSON object.
+func FormatJson(line map[string]string) ([]byte, error) {
+	return json.Marshal(line)
+}
+
+// FormatSyslog produces an RFC5424 message. Fields that are not part of the header are sent as structured data.
+func FormatSyslog(line map[string]string) ([]byte, error) {
+	facility := 1 // user-level
+	if line[keyLogType] == "audit" {
+		facility = 13 // log audit
+	}
+	pri := facility*8 + severity(line[keyLevel])
+
+	ts := nilValue(line[keyTs])
+	if t, err := time.Parse(time.RFC3339, line[keyTs]); err == nil {
+		ts = t.Format(time.RFC3339)
+	}
+
+	var sd []string
+	for _, k := range extraKeys(line) {
+		sd = append(sd, fmt.Sprintf(`%s="%s"`, sdName(k), sdEscaper.Replace(line[k])))
+	}
+	structured := "-"
+	if len(sd) > 0 {
+		structured = "[" + sdID + " " + strings.Join(sd, " ") + "]"
+	}
+
+	return []byte(fmt.Sprintf("<%d>1 %s %s %s - %s %s %s",
+		pri,
+		ts,
+		nilValue(printable(hostname, 255)),
+		nilValue(printable(line[keyLogger], 48)),
+		nilValue(printable(line[keyMsgId], 32)),
+		structured,
+		line[keyMsg],
+	)), nil
+}
+
+// FormatCef produces an ArcSight Common Event Format message.
+func FormatCef(line map[string]string) ([]byte, error) {
+	ext := []string{"rt=" + strconv.FormatInt(timestamp(line).UnixNano()/int64(time.Millisecond), 10)}
+	if hostname != "" {
+		ext = append(ext, "dvchost="+cefExtEscaper.Replace(hostname))
+	}
+	for _, k := range sortedKeys(cefKeys) {
+		v, ok := line[k]
+		if !ok || v == "" {
+			continue
+		}
+		key := cefKeys[k]
+		ext = append(ext, key+"="+cefExtEscaper.Replace(v))
+		if label, ok := cefLabels[key]; ok {
+			ext = append(ext, key+"Label="+label)
+		}
+	}
+	return []byte(fmt.Sprintf("CEF:0|Pydio|Cells|%s|%s|%s|%d|%s",
+		cefHeaderEscaper.Replace(common.Version().String()),
+		cefHeaderEscaper.Replace(eventId(line)),
+		cefHeaderEscaper.Replace(line[keyMsg]),
+		cefSeverity(line[keyLevel]),
+		strings.Join(ext, " "),
+	)), nil
+}
+
+// FormatLeef produces an IBM QRadar Log Event Extended Format (1.0) message.
+func FormatLeef(line map[string]string) ([]byte, error) {
+	attrs := []string{
+		"devTime=" + timestamp(line).Format("Jan 02 2006 15:04:05"),
+		"devTimeFormat=MMM dd yyyy HH:mm:ss",
+		"sev=" + strconv.Itoa(cefSeverity(line[keyLevel])),
+		"msg=" + leefEscaper.Replace(line[keyMsg]),
+	}
+	for _, k := range extraKeys(line) {
+		key := k
+		if l, ok := leefKeys[k]; ok {
+			key = l
+		}
+		attrs = append(attrs, key+"="+leefEscaper.Replace(line[k]))
+	}
+	return []byte(fmt.Sprintf("LEEF:1.0|Pydio|Cells|%s|%s|%s",
+		leefHeaderEscaper.Replace(common.Version().String()),
+		leefHeaderEscaper.Replace(eventId(line)),
+		strings.Join(attrs, "\t"),
+	)), nil
+}
+
+var (
+	sdEscaper         = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+	cefHeaderEscaper  = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\n", " ", "\r", " ")
+	cefExtEscaper     = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+	leefHeaderEscaper = strings.NewReplacer(`|`, `\|`, "\n", " ", "\r", " ")
+	leefEscaper       = strings.NewReplacer("\t", " ", "\n", " ", "\r", " ")
+)
+
+// severity maps zap levels to syslog severities.
+func severity(level string) int {
+	switch strings.ToLower(level) {
+	case "debug":
+		return 7
+	case "info":
+		return 6
+	case "warn":
+		return 4
+	case "error":
+		return 3
+	case "dpanic", "panic", "fatal":
+		return 2
+	default:
+		return 5
+	}
+}
+
+// cefSeverity maps zap levels to the 0-10 CEF severity scale.
+func cefSeverity(level string) int {
+	switch strings.ToLower(level) {
+	case "debug":
+		return 1
+	case "info":
+		return 3
+	case "warn":
+		return 6
+	case "error":
+		return 8
+	case "dpanic", "panic", "fatal":
+		return 10
+	default:
+		return 5
+	}
+}
+
+// eventId uses the audit MsgId when available, the logger name otherwise.
+func eventId(line map[string]string) string {
+	if id := line[keyMsgId]; id != "" {
+		if label, ok := common.LogEventLabels[id]; ok {
+			return label
+		}
+		return id
+	}
+	if logger := line[keyLogger]; logger != "" {
+		return logger
+	}
+	return "log"
+}
+
+func timestamp(line map[string]string) time.Time {
+	if t, err := time.Parse(time.RFC3339, line[keyTs]); err == nil {
+		return t
+	}
+	return time.Now()
+}
+
+// extraKeys lists the non-header keys of a line, sorted.
+func extraKeys(line map[string]string) (keys []string) {
+	for k, v := range line {
+		switch k {
+		case keyTs, keyLevel, keyLogger, keyMsg, keyMsgId:
+			continue
+		}
+		if v != "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return
+}
+
+func sortedKeys(m map[string]string) (keys []string) {
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return
+}
+
+// sdName keeps only characters allowed in RFC5424 parameter names.
+func sdName(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if r <= 32 || r >= 127 || r == '=' || r == ']' || r == '"' {
+			return '_'
+		}
+		return r
+	}, s)
+	if len(s) > 32 {
+		s = s[:32]
+	}
+	return s
+}
+
+// printable keeps only printable ASCII characters as required for RFC5424 header fields.
+func printable(s string, max int) string {
+	s = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return -1
+		}
+		return r
+	}, s)
+	if len(s) > max {
+		s = s[:max]
+	}
+	return s
+}
+
+func nilValue(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
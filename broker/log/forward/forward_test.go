@@ -0,0 +1,276 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package forward
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/boltdb/bolt"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func init() {
+	RetryDelay = 10 * time.Millisecond
+	MaxRetryDelay = 50 * time.Millisecond
+}
+
+func sampleLine() map[string]string {
+	return map[string]string{
+		"level":         "info",
+		"ts":            "2018-03-08T13:32:18+01:00",
+		"logger":        "pydio.grpc.auth",
+		"msg":           "Login | with = and \"quotes\"]",
+		"MsgId":         "1",
+		"LogType":       "audit",
+		"UserName":      "jenny",
+		"RemoteAddress": "::1",
+	}
+}
+
+func tempDir() string {
+	dir, _ := ioutil.TempDir("", "forward")
+	return dir
+}
+
+func TestFormats(t *testing.T) {
+
+	Convey("RFC5424 messages", t, func() {
+		data, err := FormatSyslog(sampleLine())
+		So(err, ShouldBeNil)
+		msg := string(data)
+		So(msg, ShouldStartWith, "<110>1 2018-03-08T13:32:18+01:00 ")
+		So(msg, ShouldContainSubstring, " pydio.grpc.auth - 1 [cells@32473 LogType=\"audit\" RemoteAddress=\"::1\" UserName=\"jenny\"] Login")
+	})
+
+	Convey("CEF messages", t, func() {
+		data, err := FormatCef(sampleLine())
+		So(err, ShouldBeNil)
+		msg := string(data)
+		So(msg, ShouldStartWith, "CEF:0|Pydio|Cells|")
+		So(msg, ShouldContainSubstring, "|Login \\| with = and \"quotes\"]|3|rt=1520512338000 ")
+		So(msg, ShouldContainSubstring, "src=::1")
+		So(msg, ShouldContainSubstring, "suser=jenny")
+	})
+
+	Convey("LEEF messages", t, func() {
+		data, err := FormatLeef(sampleLine())
+		So(err, ShouldBeNil)
+		msg := string(data)
+		So(msg, ShouldStartWith, "LEEF:1.0|Pydio|Cells|")
+		So(msg, ShouldContainSubstring, "\tusrName=jenny")
+		So(msg, ShouldContainSubstring, "\tsev=3\t")
+	})
+
+	Convey("Filters", t, func() {
+		So(Filter{}.Match(sampleLine()), ShouldBeTrue)
+		So(Filter{MsgIds: []string{"1", "2"}}.Match(sampleLine()), ShouldBeTrue)
+		So(Filter{MsgIds: []string{"2"}}.Match(sampleLine()), ShouldBeFalse)
+		So(Filter{Levels: []string{"INFO"}}.Match(sampleLine()), ShouldBeTrue)
+		So(Filter{Levels: []string{"error"}}.Match(sampleLine()), ShouldBeFalse)
+		So(Filter{Loggers: []string{"pydio.grpc."}}.Match(sampleLine()), ShouldBeTrue)
+		So(Filter{Loggers: []string{"pydio.rest."}}.Match(sampleLine()), ShouldBeFalse)
+	})
+
+	Convey("Invalid configs", t, func() {
+		So((&Config{Name: "a", Type: "kafka"}).Validate(), ShouldNotBeNil)
+		So((&Config{Name: "a", Type: TypeSyslog}).Validate(), ShouldNotBeNil)
+		So((&Config{Name: "a", Type: TypeHttp, Url: "http://localhost", Format: "xml"}).Validate(), ShouldNotBeNil)
+		c := &Config{Name: "a", Type: TypeSyslog, Address: "localhost:514"}
+		So(c.Validate(), ShouldBeNil)
+		So(c.Network, ShouldEqual, "tcp")
+		So(c.Format, ShouldEqual, FormatRFC5424)
+	})
+}
+
+func TestForwarders(t *testing.T) {
+
+	Convey("Syslog over TCP uses octet counting framing", t, func() {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		So(err, ShouldBeNil)
+		defer l.Close()
+		received := make(chan string, 1)
+		go func() {
+			conn, e := l.Accept()
+			if e != nil {
+				return
+			}
+			defer conn.Close()
+			r := bufio.NewReader(conn)
+			length, _ := r.ReadString(' ')
+			n, _ := strconv.Atoi(strings.TrimSpace(length))
+			buf := make([]byte, n)
+			r.Read(buf)
+			received <- string(buf)
+		}()
+
+		dir := tempDir()
+		defer os.RemoveAll(dir)
+		f, err := NewForwarder(Config{Name: "tcp", Type: TypeSyslog, Address: l.Addr().String(), Format: FormatCEF}, dir)
+		So(err, ShouldBeNil)
+		defer f.Close()
+
+		So(f.Forward(sampleLine()), ShouldBeNil)
+		select {
+		case msg := <-received:
+			So(msg, ShouldStartWith, "CEF:0|Pydio|Cells|")
+		case <-time.After(5 * time.Second):
+			So("timeout", ShouldBeEmpty)
+		}
+	})
+
+	Convey("Syslog over UDP sends one message per datagram", t, func() {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		So(err, ShouldBeNil)
+		defer conn.Close()
+
+		dir := tempDir()
+		defer os.RemoveAll(dir)
+		f, err := NewForwarder(Config{Name: "udp", Type: TypeSyslog, Network: "udp", Address: conn.LocalAddr().String()}, dir)
+		So(err, ShouldBeNil)
+		defer f.Close()
+
+		line := sampleLine()
+		line["level"] = "debug"
+		So(f.Forward(sampleLine()), ShouldBeNil)
+		So(f.Forward(line), ShouldBeNil)
+
+		conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		buf := make([]byte, 2048)
+		n, _, err := conn.ReadFrom(buf)
+		So(err, ShouldBeNil)
+		So(string(buf[:n]), ShouldStartWith, "<110>1 ")
+		n, _, err = conn.ReadFrom(buf)
+		So(err, ShouldBeNil)
+		So(string(buf[:n]), ShouldStartWith, "<111>1 ")
+	})
+
+	Convey("Messages are kept in the queue during an outage and filtered", t, func() {
+		var lock sync.Mutex
+		available := false
+		var received []map[string]string
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lock.Lock()
+			defer lock.Unlock()
+			if !available {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			var line map[string]string
+			json.NewDecoder(r.Body).Decode(&line)
+			if r.Header.Get("Authorization") == "Bearer token" {
+				received = append(received, line)
+			}
+		}))
+		defer srv.Close()
+
+		dir := tempDir()
+		defer os.RemoveAll(dir)
+		conf := Config{Name: "http", Type: TypeHttp, Url: srv.URL, Headers: map[string]string{"Authorization": "Bearer token"}, Filter: Filter{MsgIds: []string{"1"}}}
+		f, err := NewForwarder(conf, dir)
+		So(err, ShouldBeNil)
+
+		So(f.Forward(sampleLine()), ShouldBeNil)
+		So(f.Forward(map[string]string{"msg": "technical", "level": "info"}), ShouldBeNil)
+		So(f.Forward(sampleLine()), ShouldBeNil)
+		So(f.Errors(), ShouldNotBeNil)
+		<-f.Errors()
+		So(f.Pending(), ShouldEqual, 2)
+
+		// Queue survives a restart
+		So(f.Close(), ShouldBeNil)
+		f, err = NewForwarder(conf, dir)
+		So(err, ShouldBeNil)
+		defer f.Close()
+		So(f.Pending(), ShouldEqual, 2)
+
+		lock.Lock()
+		available = true
+		lock.Unlock()
+		for i := 0; i < 100 && f.Pending() > 0; i++ {
+			time.Sleep(20 * time.Millisecond)
+		}
+		So(f.Pending(), ShouldEqual, 0)
+		lock.Lock()
+		defer lock.Unlock()
+		So(received, ShouldHaveLength, 2)
+		So(received[0]["UserName"], ShouldEqual, "jenny")
+	})
+
+	Convey("Queue is bounded and drops oldest messages", t, func() {
+		dir := tempDir()
+		defer os.RemoveAll(dir)
+		q, err := OpenQueue(dir+"/bounded.queue", 3)
+		So(err, ShouldBeNil)
+		defer q.Close()
+		for i := 0; i < 5; i++ {
+			So(q.Push([]byte(strconv.Itoa(i))), ShouldBeNil)
+		}
+		So(q.Len(), ShouldEqual, 3)
+		key, data, err := q.Peek()
+		So(err, ShouldBeNil)
+		So(string(data), ShouldEqual, "2")
+		So(q.Ack(key), ShouldBeNil)
+		So(q.Len(), ShouldEqual, 2)
+	})
+
+	Convey("Concurrent pushes are batched and keep the queue bounded", t, func() {
+		dir := tempDir()
+		defer os.RemoveAll(dir)
+		q, err := OpenQueue(dir+"/concurrent.queue", 50)
+		So(err, ShouldBeNil)
+		defer q.Close()
+		wg := &sync.WaitGroup{}
+		for i := 0; i < 200; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				q.Push([]byte(strconv.Itoa(i)))
+			}(i)
+		}
+		wg.Wait()
+		So(q.Len(), ShouldEqual, 50)
+		stored := 0
+		q.db.View(func(tx *bolt.Tx) error {
+			stored = tx.Bucket(queueBucket).Stats().KeyN
+			return nil
+		})
+		So(stored, ShouldEqual, 50)
+	})
+
+	Convey("Duplicate forwarder names are refused", t, func() {
+		dir := tempDir()
+		defer os.RemoveAll(dir)
+		c := Config{Name: "same", Type: TypeHttp, Url: "http://127.0.0.1:1"}
+		_, err := NewForwarders([]Config{c, c}, dir)
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package forward
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+var (
+	// RetryDelay is the initial delay before retrying a failed delivery. It doubles on each failure up to MaxRetryDelay.
+	RetryDelay = 1 * time.Second
+	// MaxRetryDelay caps the delay between two delivery attempts
+	MaxRetryDelay = 1 * time.Minute
+)
+
+// Forwarder filters, formats and queues log lines, and delivers them in background.
+type Forwarder struct {
+	Config
+	format Formatter
+	sender Sender
+	queue  *Queue
+	errors chan error
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+// NewForwarder validates the configuration, opens the forwarder queue in queueDir and starts delivering.
+func NewForwarder(c Config, queueDir string) (*Forwarder, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(queueDir, 0755); err != nil {
+		return nil, err
+	}
+	queue, err := OpenQueue(filepath.Join(queueDir, c.Name+".queue"), c.QueueSize)
+	if err != nil {
+		return nil, err
+	}
+	f := &Forwarder{
+		Config: c,
+		format: formatters[c.Format],
+		sender: newSender(c),
+		queue:  queue,
+		errors: make(chan error, 1),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go f.run()
+	return f, nil
+}
+
+// Forward queues a log line if it matches the forwarder filter.
+func (f *Forwarder) Forward(line map[string]string) error {
+	if !f.Filter.Match(line) {
+		return nil
+	}
+	data, err := f.format(line)
+	if err != nil {
+		return err
+	}
+	return f.queue.Push(data)
+}
+
+// Pending returns the number of messages waiting for delivery.
+func (f *Forwarder) Pending() int {
+	return f.queue.Len()
+}
+
+// Errors reports delivery failures. Only the last failure is kept if nobody reads the channel.
+func (f *Forwarder) Errors() <-chan error {
+	return f.errors
+}
+
+// Close stops delivering and closes the queue. Pending messages are kept on disk.
+func (f *Forwarder) Close() error {
+	close(f.stop)
+	<-f.done
+	f.sender.Close()
+	return f.queue.Close()
+}
+
+func (f *Forwarder) run() {
+	defer close(f.done)
+	delay := RetryDelay
+	for {
+		key, data, err := f.queue.Peek()
+		if err == nil && key == nil {
+			select {
+			case <-f.queue.Notify():
+				continue
+			case <-f.stop:
+				return
+			}
+		}
+		if err == nil {
+			if err = f.sender.Send(data); err == nil {
+				err = f.queue.Ack(key)
+			}
+		}
+		if err == nil {
+			delay = RetryDelay
+			continue
+		}
+		f.reportError(err)
+		select {
+		case <-time.After(delay):
+		case <-f.stop:
+			return
+		}
+		if delay *= 2; delay > MaxRetryDelay {
+			delay = MaxRetryDelay
+		}
+	}
+}
+
+func (f *Forwarder) reportError(err error) {
+	select {
+	case <-f.errors:
+	default:
+	}
+	select {
+	case f.errors <- err:
+	default:
+	}
+}
+
+// Forwarders is a list of forwarders that receive every line.
+type Forwarders []*Forwarder
+
+// NewForwarders creates all configured forwarders, storing their queues in queueDir.
+func NewForwarders(configs []Config, queueDir string) (Forwarders, error) {
+	var ff Forwarders
+	names := make(map[string]bool, len(configs))
+	for _, c := range configs {
+		if names[c.Name] {
+			ff.Close()
+			return nil, fmt.Errorf("duplicate forwarder name %s", c.Name)
+		}
+		names[c.Name] = true
+		f, err := NewForwarder(c, queueDir)
+		if err != nil {
+			ff.Close()
+			return nil, err
+		}
+		ff = append(ff, f)
+	}
+	return ff, nil
+}
+
+// Forward sends the line to all forwarders, returning the last error if any.
+func (ff Forwarders) Forward(line map[string]string) (err error) {
+	for _, f := range ff {
+		if e := f.Forward(line); e != nil {
+			err = e
+		}
+	}
+	return
+}
+
+// Close closes all forwarders.
+func (ff Forwarders) Close() error {
+	for _, f := range ff {
+		f.Close()
+	}
+	return nil
+}
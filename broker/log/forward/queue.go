@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package forward
+
+import (
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+var queueBucket = []byte("queue")
+
+// Queue is a bounded FIFO persisted in a bolt file. When full, the oldest messages are discarded.
+// Concurrent pushes are grouped in a single transaction.
+type Queue struct {
+	sync.Mutex
+	db     *bolt.DB
+	max    int
+	count  int
+	notify chan struct{}
+	// trimming counts the messages being discarded, so that concurrent pushes do not discard them twice
+	trimming int
+}
+
+// OpenQueue opens or creates a queue stored at the given path.
+func OpenQueue(path string, max int) (*Queue, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+	q := &Queue{db: db, max: max, notify: make(chan struct{}, 1)}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		b, e := tx.CreateBucketIfNotExists(queueBucket)
+		if e != nil {
+			return e
+		}
+		q.count = b.Stats().KeyN
+		return nil
+	}); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return q, nil
+}
+
+// Push appends a message to the queue, discarding the oldest ones if the queue is full. The counter is
+// only updated once the message is committed.
+func (q *Queue) Push(data []byte) error {
+	err := q.db.Batch(func(tx *bolt.Tx) error {
+		b := tx.Bucket(queueBucket)
+		seq, _ := b.NextSequence()
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, seq)
+		return b.Put(key, data)
+	})
+	if err != nil {
+		return err
+	}
+	q.Lock()
+	q.count++
+	over := q.count - q.trimming - q.max
+	if over > 0 {
+		q.trimming += over
+	}
+	q.Unlock()
+	if over > 0 {
+		err = q.trim(over)
+	}
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+	return err
+}
+
+// trim discards the given number of oldest messages.
+func (q *Queue) trim(n int) error {
+	var deleted int
+	err := q.db.Batch(func(tx *bolt.Tx) error {
+		// Batch may run this function again if the transaction is retried
+		deleted = 0
+		c := tx.Bucket(queueBucket).Cursor()
+		for deleted < n {
+			if k, _ := c.First(); k == nil {
+				break
+			}
+			if e := c.Delete(); e != nil {
+				return e
+			}
+			deleted++
+		}
+		return nil
+	})
+	q.Lock()
+	defer q.Unlock()
+	q.trimming -= n
+	if err != nil {
+		return err
+	}
+	q.count -= deleted
+	return nil
+}
+
+// Peek returns the oldest message and its key, or a nil key if the queue is empty.
+func (q *Queue) Peek() (key []byte, data []byte, err error) {
+	err = q.db.View(func(tx *bolt.Tx) error {
+		k, v := tx.Bucket(queueBucket).Cursor().First()
+		if k != nil {
+			key = append([]byte{}, k...)
+			data = append([]byte{}, v...)
+		}
+		return nil
+	})
+	return
+}
+
+// Ack removes a message once it has been delivered.
+func (q *Queue) Ack(key []byte) error {
+	q.Lock()
+	defer q.Unlock()
+	var deleted bool
+	err := q.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(queueBucket)
+		if b.Get(key) == nil {
+			// Already discarded because the queue was full
+			return nil
+		}
+		deleted = true
+		return b.Delete(key)
+	})
+	if err == nil && deleted {
+		q.count--
+	}
+	return err
+}
+
+// Len returns the number of pending messages.
+func (q *Queue) Len() int {
+	q.Lock()
+	defer q.Unlock()
+	return q.count
+}
+
+// Notify is signaled when new messages are pushed.
+func (q *Queue) Notify() <-chan struct{} {
+	return q.notify
+}
+
+// Close closes the underlying bolt file.
+func (q *Queue) Close() error {
+	return q.db.Close()
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package forward
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Sender delivers a formatted message to a remote endpoint.
+type Sender interface {
+	Send(data []byte) error
+	Close() error
+}
+
+// syslogSender writes messages to a syslog collector, using octet-counting framing (RFC6587) on streams.
+type syslogSender struct {
+	network string
+	address string
+	tls     *tls.Config
+	conn    net.Conn
+}
+
+func (s *syslogSender) Send(data []byte) error {
+	if s.conn == nil {
+		var err error
+		dialer := &net.Dialer{Timeout: 10 * time.Second}
+		switch s.network {
+		case "tls":
+			s.conn, err = tls.DialWithDialer(dialer, "tcp", s.address, s.tls)
+		default:
+			s.conn, err = dialer.Dial(s.network, s.address)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	frame := data
+	if s.network != "udp" {
+		frame = append([]byte(strconv.Itoa(len(data))+" "), data...)
+	}
+	s.conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+	if _, err := s.conn.Write(frame); err != nil {
+		s.Close()
+		return err
+	}
+	return nil
+}
+
+func (s *syslogSender) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// httpSender posts each message to an HTTP endpoint.
+type httpSender struct {
+	url         string
+	headers     map[string]string
+	contentType string
+	client      *http.Client
+}
+
+func (h *httpSender) Send(data []byte) error {
+	req, err := http.NewRequest(http.MethodPost, h.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", h.contentType)
+	for k, v := range h.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("endpoint %s replied with status %d", h.url, resp.StatusCode)
+	}
+	return nil
+}
+
+func (h *httpSender) Close() error {
+	return nil
+}
+
+func newSender(c Config) Sender {
+	if c.Type == TypeHttp {
+		contentType := "text/plain; charset=utf-8"
+		if c.Format == FormatJSON {
+			contentType = "application/json"
+		}
+		return &httpSender{
+			url:         c.Url,
+			headers:     c.Headers,
+			contentType: contentType,
+			client:      &http.Client{Timeout: 10 * time.Second},
+		}
+	}
+	return &syslogSender{
+		network: c.Network,
+		address: c.Address,
+		tls:     &tls.Config{InsecureSkipVerify: c.InsecureSkipVerify},
+	}
+}
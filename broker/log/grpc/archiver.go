@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"path"
+
+	"github.com/pydio/minio-go"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/proto/object"
+	"github.com/pydio/cells/common/service/defaults"
+)
+
+// archivePartSize is the size of the parts uploaded when the archive size is not known in advance.
+const archivePartSize = 16 * 1024 * 1024
+
+// DataSourceArchiver writes archived logs shards as objects in a datasource.
+type DataSourceArchiver struct {
+	DataSource string
+	// Bucket defaults to the datasource objects bucket
+	Bucket string
+	Prefix string
+}
+
+// Archive implements log.Archiver by putting data directly in the datasource object server.
+func (a *DataSourceArchiver) Archive(name string, data io.Reader, size int64) error {
+
+	ctx := context.Background()
+	endpoint := object.NewDataSourceEndpointClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DATA_SYNC_+a.DataSource, defaults.NewClient())
+	response, err := endpoint.GetDataSourceConfig(ctx, &object.GetDataSourceConfigRequest{})
+	if err != nil {
+		return err
+	}
+	client, err := response.DataSource.CreateClient()
+	if err != nil {
+		return err
+	}
+	bucket := a.Bucket
+	if bucket == "" {
+		bucket = response.DataSource.ObjectsBucket
+	}
+	key := path.Join(a.Prefix, name)
+	opts := minio.PutObjectOptions{
+		ContentType:     "application/x-ndjson",
+		ContentEncoding: "gzip",
+	}
+	if size >= 0 {
+		_, err = client.PutObjectWithContext(ctx, bucket, key, data, size, opts)
+		return err
+	}
+	return a.putStream(ctx, client, bucket, key, data, opts)
+}
+
+// putStream uploads data of unknown size by parts of archivePartSize, as the minio client would otherwise
+// buffer parts sized for the largest possible object.
+func (a *DataSourceArchiver) putStream(ctx context.Context, client *minio.Core, bucket, key string, data io.Reader, opts minio.PutObjectOptions) error {
+
+	buf := make([]byte, archivePartSize)
+	n, readErr := io.ReadFull(data, buf)
+	if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+		_, err := client.PutObjectWithContext(ctx, bucket, key, bytes.NewReader(buf[:n]), int64(n), opts)
+		return err
+	} else if readErr != nil {
+		return readErr
+	}
+
+	uploadID, err := client.NewMultipartUpload(bucket, key, opts)
+	if err != nil {
+		return err
+	}
+	var parts []minio.CompletePart
+	for partID := 1; n > 0; partID++ {
+		part, err := client.PutObjectPart(bucket, key, uploadID, partID, bytes.NewReader(buf[:n]), int64(n), nil, nil)
+		if err != nil {
+			client.AbortMultipartUpload(bucket, key, uploadID)
+			return err
+		}
+		parts = append(parts, minio.CompletePart{PartNumber: part.PartNumber, ETag: part.ETag})
+		if readErr == io.ErrUnexpectedEOF {
+			// Last part was shorter than the buffer
+			break
+		}
+		n, readErr = io.ReadFull(data, buf)
+		if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+			client.AbortMultipartUpload(bucket, key, uploadID)
+			return readErr
+		}
+	}
+	return client.CompleteMultipartUpload(bucket, key, uploadID, parts)
+}
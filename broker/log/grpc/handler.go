@@ -27,12 +27,14 @@ import (
 	"github.com/go-openapi/errors"
 
 	"github.com/pydio/cells/broker/log"
+	"github.com/pydio/cells/broker/log/forward"
 	proto "github.com/pydio/cells/common/proto/log"
 )
 
 // Handler is the gRPC interface for the log service.
 type Handler struct {
-	Repo log.MessageRepository
+	Repo       log.MessageRepository
+	Forwarders forward.Forwarders
 }
 
 // PutLog retrieves the log messages from the proto stream and stores them in the index.
@@ -51,17 +53,14 @@ func (h *Handler) PutLog(ctx context.Context, stream proto.LogRecorder_PutLogStr
 		logCount++
 
 		h.Repo.PutLog(line.GetMessage())
+		h.Forwarders.Forward(line.GetMessage())
 	}
 }
 
 // ListLogs is a simple gateway from protobuf to the indexer search engine.
 func (h *Handler) ListLogs(ctx context.Context, req *proto.ListLogRequest, stream proto.LogRecorder_ListLogsStream) error {
 
-	q := req.GetQuery()
-	p := req.GetPage()
-	s := req.GetSize()
-
-	r, err := h.Repo.ListLogs(q, p, s)
+	r, err := h.Repo.SearchLogs(req)
 
 	if err != nil {
 		return err
@@ -80,3 +79,13 @@ func (h *Handler) ListLogs(ctx context.Context, req *proto.ListLogRequest, strea
 func (h *Handler) AggregatedLogs(ctx context.Context, req *proto.TimeRangeRequest, stream proto.LogRecorder_AggregatedLogsStream) error {
 	return errors.NotImplemented("cannot aggregate syslogs")
 }
+
+// VerifyAuditChain checks the audit hash chain over the requested time range.
+func (h *Handler) VerifyAuditChain(ctx context.Context, req *proto.VerifyAuditChainRequest, rsp *proto.VerifyAuditChainResponse) error {
+	report, err := h.Repo.VerifyAuditChain(req.GetFrom(), req.GetTo())
+	if err != nil {
+		return err
+	}
+	*rsp = *report
+	return nil
+}
@@ -22,17 +22,28 @@
 package grpc
 
 import (
+	"crypto/ecdsa"
 	"path"
+	"time"
 
 	micro "github.com/micro/go-micro"
+	"go.uber.org/zap"
 
 	"github.com/pydio/cells/broker/log"
+	"github.com/pydio/cells/broker/log/forward"
 	"github.com/pydio/cells/common"
 	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/crypto"
+	commonlog "github.com/pydio/cells/common/log"
 	proto "github.com/pydio/cells/common/proto/log"
 	"github.com/pydio/cells/common/service"
 )
 
+var (
+	// RetentionInterval is the delay between two runs of the retention policies
+	RetentionInterval = 1 * time.Hour
+)
+
 func init() {
 	service.NewService(
 		service.Name(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_LOG),
@@ -43,18 +54,143 @@ func init() {
 			if e != nil {
 				return e
 			}
-			repo, err := log.NewSyslogServer(path.Join(serviceDir, "syslog.bleve"))
+			repo, err := log.NewSyslogServer(path.Join(serviceDir, "syslog"))
 			if err != nil {
 				return err
 			}
+			// Index used before logs were sharded
+			if err := repo.OpenLegacyIndex(path.Join(serviceDir, "syslog.bleve")); err != nil {
+				return err
+			}
+			configureRetention(repo)
+			if err := configureAuditChain(m, repo, serviceDir); err != nil {
+				return err
+			}
 
 			handler := &Handler{
-				Repo: repo,
+				Repo:       repo,
+				Forwarders: configureForwarders(m, path.Join(serviceDir, "forwarders")),
 			}
 
 			proto.RegisterLogRecorderHandler(m.Options().Server, handler)
 
+			m.Init(
+				micro.AfterStart(func() error {
+					go runRetention(m, repo)
+					return nil
+				}),
+				micro.BeforeStop(func() error {
+					handler.Forwarders.Close()
+					return repo.Close()
+				}),
+			)
+
 			return nil
 		}),
 	)
 }
+
+// configureRetention reads the retention policies and archive destination from the service configuration:
+//
+//	"pydio.grpc.log": {
+//	  "retention": {
+//	    "audit":     {"maxAge": "8760h", "archive": true},
+//	    "technical": {"maxAge": "720h", "maxSize": 10737418240}
+//	  },
+//	  "archive": {"datasource": "default", "bucket": "logs", "prefix": "syslog"}
+//	}
+func configureRetention(repo *log.SyslogServer) {
+	name := common.SERVICE_GRPC_NAMESPACE_ + common.SERVICE_LOG
+	for _, family := range []string{log.FamilyAudit, log.FamilyTechnical} {
+		repo.SetRetentionPolicy(family, log.RetentionPolicy{
+			MaxAge:  config.Get("services", name, "retention", family, "maxAge").Duration(0),
+			MaxSize: int64(config.Get("services", name, "retention", family, "maxSize").Int(0)),
+			Archive: config.Get("services", name, "retention", family, "archive").Bool(false),
+		})
+	}
+	if ds := config.Get("services", name, "archive", "datasource").String(""); ds != "" {
+		if ds == "default" {
+			ds = config.Get("defaults", "datasource").String("default")
+		}
+		repo.SetArchiver(&DataSourceArchiver{
+			DataSource: ds,
+			Bucket:     config.Get("services", name, "archive", "bucket").String(""),
+			Prefix:     config.Get("services", name, "archive", "prefix").String("syslog"),
+		})
+	}
+}
+
+// configureAuditChain links audit messages by hash. Checkpoints are signed with a key stored in the service
+// directory and protected by a password kept in the system keyring. If the keyring is not available, messages
+// are still chained but no checkpoints are signed.
+func configureAuditChain(m micro.Service, repo *log.SyslogServer, serviceDir string) error {
+	ctx := m.Options().Context
+	var key *ecdsa.PrivateKey
+	password, err := crypto.GetKeyringPassword(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_LOG, common.KEYRING_MASTER_KEY, true)
+	if err == nil && len(password) > 0 {
+		key, err = log.LoadChainKey(path.Join(serviceDir, "audit-chain.key"), password)
+	}
+	if err != nil {
+		commonlog.Logger(ctx).Warn("Cannot load audit chain signing key, checkpoints will not be signed", zap.Error(err))
+	}
+	chain, err := log.NewAuditChain(path.Join(serviceDir, "audit-chain.db"), key)
+	if err != nil {
+		return err
+	}
+	return repo.EnableAuditChain(chain)
+}
+
+// configureForwarders starts the forwarders declared in the service configuration, for instance:
+//
+//	"forwarders": [
+//	  {"name": "siem", "type": "syslog", "network": "tls", "address": "siem.example.com:6514", "format": "cef", "filter": {"msgIds": ["1", "2"]}},
+//	  {"name": "hook", "type": "http", "url": "https://collector.example.com/logs", "headers": {"Authorization": "Bearer xxx"}, "filter": {"levels": ["error"]}}
+//	]
+//
+// Invalid configurations are logged and no forwarder is started, so that logs are still indexed.
+func configureForwarders(m micro.Service, queueDir string) forward.Forwarders {
+	ctx := m.Options().Context
+	var configs []forward.Config
+	if err := config.Get("services", common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_LOG, "forwarders").Scan(&configs); err != nil || len(configs) == 0 {
+		return nil
+	}
+	forwarders, err := forward.NewForwarders(configs, queueDir)
+	if err != nil {
+		commonlog.Logger(ctx).Error("Cannot start logs forwarders", zap.Error(err))
+		return nil
+	}
+	for _, f := range forwarders {
+		go func(f *forward.Forwarder) {
+			for {
+				select {
+				case err := <-f.Errors():
+					commonlog.Logger(ctx).Warn("Cannot deliver log to forwarder, will retry", zap.String("forwarder", f.Name), zap.Int("pending", f.Pending()), zap.Error(err))
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(f)
+	}
+	return forwarders
+}
+
+// runRetention periodically drops expired shards until the service is stopped.
+func runRetention(m micro.Service, repo *log.SyslogServer) {
+	ctx := m.Options().Context
+	ticker := time.NewTicker(RetentionInterval)
+	defer ticker.Stop()
+	for {
+		dropped, err := repo.ApplyRetention(time.Now())
+		if err != nil {
+			commonlog.Logger(ctx).Error("Cannot apply logs retention policies", zap.Error(err))
+		}
+		if len(dropped) > 0 {
+			commonlog.Logger(ctx).Info("Dropped expired logs shards", zap.Strings("shards", dropped))
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -21,9 +21,21 @@
 package rest
 
 import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
 	"github.com/emicklei/go-restful"
+	"github.com/micro/go-micro/errors"
+	"go.uber.org/zap"
 
 	"github.com/pydio/cells/common"
+	commonlog "github.com/pydio/cells/common/log"
 	"github.com/pydio/cells/common/proto/log"
 	"github.com/pydio/cells/common/proto/rest"
 	"github.com/pydio/cells/common/registry"
@@ -73,3 +85,200 @@ func (h *Handler) Syslog(req *restful.Request, rsp *restful.Response) {
 	rsp.WriteEntity(logColl)
 
 }
+
+// AuditVerify checks the audit hash chain over the requested time range and returns the verification report
+func (h *Handler) AuditVerify(req *restful.Request, rsp *restful.Response) {
+
+	var input log.VerifyAuditChainRequest
+	if e := req.ReadEntity(&input); e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	ctx := req.Request.Context()
+
+	c := log.NewLogRecorderClient(registry.GetClient(common.SERVICE_LOG))
+	report, err := c.VerifyAuditChain(ctx, &input)
+	if err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+
+	rsp.WriteEntity(report)
+}
+
+// ExportLogs streams all logs matching the request as CSV or JSON. Logs are loaded page by page from the
+// log service and written as they arrive, so that large exports do not have to fit in memory.
+func (h *Handler) ExportLogs(req *restful.Request, rsp *restful.Response) {
+
+	var input log.ListLogRequest
+	if e := req.ReadEntity(&input); e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	if input.Format == log.ListLogRequest_XLSX {
+		rsp.WriteError(http.StatusBadRequest, errors.BadRequest(common.SERVICE_LOG, "XLSX export is not supported, use CSV or JSON"))
+		return
+	}
+	ctx := req.Request.Context()
+	c := log.NewLogRecorderClient(registry.GetClient(common.SERVICE_LOG))
+
+	// Freeze the upper bound so that new logs do not shift the pages
+	if input.To == 0 {
+		input.To = int32(time.Now().Unix())
+	}
+
+	ext, contentType := "json", "application/json"
+	if input.Format == log.ListLogRequest_CSV {
+		ext, contentType = "csv", "text/csv"
+	}
+	rsp.Header().Set("Content-Type", contentType+"; charset=utf-8")
+	rsp.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"logs-%s.%s\"", time.Now().Format("20060102-150405"), ext))
+	rsp.Header().Set("Trailer", exportErrorTrailer)
+
+	// Logs are sorted by descending timestamp: each page ends the time range at the last timestamp seen
+	// and only skips the logs already written with this timestamp, instead of counting pages from the start.
+	var w *logExportWriter
+	var lastTs int32
+	var seenAtLastTs int
+	for {
+		input.Page = 0
+		input.Size = int32(seenAtLastTs + exportPageSize)
+		if lastTs > 0 {
+			input.To = lastTs
+		}
+		res, err := c.ListLogs(ctx, &input)
+		if err != nil {
+			if w == nil {
+				service.RestError500(req, rsp, err)
+			} else {
+				abortExport(ctx, rsp, err)
+			}
+			return
+		}
+		if w == nil {
+			w = newLogExportWriter(rsp, input.Format)
+		}
+		skip, count := seenAtLastTs, 0
+		for {
+			response, err := res.Recv()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				res.Close()
+				abortExport(ctx, rsp, err)
+				return
+			}
+			if skip > 0 {
+				skip--
+				continue
+			}
+			count++
+			msg := response.GetLogMessage()
+			if err := w.write(msg); err != nil {
+				res.Close()
+				abortExport(ctx, rsp, err)
+				return
+			}
+			if msg.Ts == lastTs {
+				seenAtLastTs++
+			} else {
+				lastTs, seenAtLastTs = msg.Ts, 1
+			}
+		}
+		res.Close()
+		w.flush()
+		if count < exportPageSize {
+			break
+		}
+	}
+	w.close()
+}
+
+// abortExport interrupts a response that was already started, so that the client sees a broken download instead
+// of a truncated file sent with a success status. The connection is closed when it can be hijacked, otherwise
+// the handler returns and the error is sent in the trailer.
+func abortExport(ctx context.Context, rsp *restful.Response, err error) {
+	commonlog.Logger(ctx).Error("Interrupting logs export", zap.Error(err))
+	rsp.Header().Set(exportErrorTrailer, err.Error())
+	if hj, ok := rsp.ResponseWriter.(http.Hijacker); ok {
+		if conn, _, e := hj.Hijack(); e == nil {
+			conn.Close()
+		}
+	}
+}
+
+const exportErrorTrailer = "X-Pydio-Export-Error"
+
+const exportPageSize = 1000
+
+var exportColumns = []string{"Ts", "Level", "Logger", "Msg", "MsgId", "UserName", "UserUuid", "Impersonator", "RemoteAddress", "UserAgent", "NodeUuid", "NodePath", "WsUuid", "AuditContext", "ChainSeq", "ChainHash"}
+
+// logExportWriter writes log messages either as a JSON LogMessageCollection or as CSV rows.
+type logExportWriter struct {
+	rsp     *restful.Response
+	csv     *csv.Writer
+	written int
+}
+
+func newLogExportWriter(rsp *restful.Response, format log.ListLogRequest_LogFormat) *logExportWriter {
+	w := &logExportWriter{rsp: rsp}
+	if format == log.ListLogRequest_CSV {
+		w.csv = csv.NewWriter(rsp)
+		w.csv.Write(exportColumns)
+	} else {
+		rsp.Write([]byte(`{"Logs":[`))
+	}
+	return w
+}
+
+func (w *logExportWriter) write(msg *log.LogMessage) error {
+	defer func() { w.written++ }()
+	if w.csv != nil {
+		ctxData := ""
+		if len(msg.AuditContext) > 0 {
+			data, _ := json.Marshal(msg.AuditContext)
+			ctxData = string(data)
+		}
+		return w.csv.Write([]string{
+			time.Unix(int64(msg.Ts), 0).UTC().Format(time.RFC3339),
+			msg.Level,
+			msg.Logger,
+			msg.Msg,
+			msg.MsgId,
+			msg.UserName,
+			msg.UserUuid,
+			msg.Impersonator,
+			msg.RemoteAddress,
+			msg.UserAgent,
+			msg.NodeUuid,
+			msg.NodePath,
+			msg.WsUuid,
+			ctxData,
+			strconv.FormatInt(msg.ChainSeq, 10),
+			msg.ChainHash,
+		})
+	}
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if w.written > 0 {
+		data = append([]byte(","), data...)
+	}
+	_, err = w.rsp.Write(data)
+	return err
+}
+
+func (w *logExportWriter) flush() {
+	if w.csv != nil {
+		w.csv.Flush()
+	}
+	w.rsp.Flush()
+}
+
+func (w *logExportWriter) close() {
+	if w.csv == nil {
+		w.rsp.Write([]byte(`]}`))
+	}
+	w.flush()
+}
@@ -0,0 +1,249 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package log
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search/query"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/proto/log"
+)
+
+// RetentionPolicy defines how long the shards of a given family of logs are kept.
+type RetentionPolicy struct {
+	// MaxAge drops shards that only contain logs older than this duration. Zero keeps them forever.
+	MaxAge time.Duration
+	// MaxSize drops the oldest shards until the family fits in this number of bytes on disk. Zero disables it.
+	MaxSize int64
+	// Archive exports the shards to the Archiver before dropping them.
+	Archive bool
+}
+
+// Archiver stores the compressed JSON-lines export of an expired shard under the given name. The export is
+// streamed, size is -1 as it is not known in advance.
+type Archiver interface {
+	Archive(name string, data io.Reader, size int64) error
+}
+
+// SetRetentionPolicy registers the policy applied to a family of logs (FamilyAudit or FamilyTechnical).
+func (s *SyslogServer) SetRetentionPolicy(family string, policy RetentionPolicy) {
+	s.Lock()
+	defer s.Unlock()
+	s.policies[family] = policy
+}
+
+// SetArchiver registers the Archiver used for policies that require archiving.
+func (s *SyslogServer) SetArchiver(archiver Archiver) {
+	s.Lock()
+	defer s.Unlock()
+	s.archiver = archiver
+}
+
+// ApplyRetention drops all shards that exceed their family retention policy, archiving them first
+// if required. It returns the names of the dropped shards. A shard whose archiving fails is kept and
+// will be retried on next run.
+func (s *SyslogServer) ApplyRetention(now time.Time) (dropped []string, err error) {
+
+	s.RLock()
+	policies := make(map[string]RetentionPolicy, len(s.policies))
+	for f, p := range s.policies {
+		policies[f] = p
+	}
+	s.RUnlock()
+
+	for family, policy := range policies {
+		shards := s.sortedShards(family)
+		sizes := make([]int64, len(shards))
+		var total int64
+		for i, shard := range shards {
+			sizes[i] = dirSize(shard.path)
+			total += sizes[i]
+		}
+		// Never drop the most recent shard, it is still receiving logs
+		for i := 0; i < len(shards)-1; i++ {
+			shard := shards[i]
+			expired := policy.MaxAge > 0 && shard.day.Add(24*time.Hour).Before(now.Add(-policy.MaxAge))
+			oversized := policy.MaxSize > 0 && total > policy.MaxSize
+			if !expired && !oversized {
+				break
+			}
+			if e := s.dropShard(shard, policy.Archive); e != nil {
+				err = e
+				break
+			}
+			total -= sizes[i]
+			dropped = append(dropped, shard.name())
+		}
+	}
+
+	// Legacy index mixes both families: drop it only when it is older than all policies.
+	s.RLock()
+	legacy, ok := s.shards[familyLegacy]
+	s.RUnlock()
+	if ok && len(policies) > 0 {
+		expired, archive := true, false
+		for _, policy := range policies {
+			if policy.MaxAge == 0 || !legacy.day.Before(now.Add(-policy.MaxAge)) {
+				expired = false
+			}
+			archive = archive || policy.Archive
+		}
+		if expired {
+			if e := s.dropShard(legacy, archive); e != nil {
+				err = e
+			} else {
+				dropped = append(dropped, legacy.name())
+			}
+		}
+	}
+
+	return
+}
+
+// dropShard detaches a shard from the server, archives it if required, then closes and removes it.
+func (s *SyslogServer) dropShard(shard *logShard, archive bool) error {
+
+	s.Lock()
+	delete(s.shards, shard.name())
+	archiver := s.archiver
+	s.Unlock()
+
+	// Wait for pending writes and block new ones until the shard is either closed or restored
+	shard.Lock()
+	if archive && archiver != nil {
+		// Export is streamed to the archiver
+		reader, writer := io.Pipe()
+		exported := make(chan error, 1)
+		go func() {
+			e := exportShard(shard.index, writer)
+			writer.CloseWithError(e)
+			exported <- e
+		}()
+		err := archiver.Archive(shard.name()+".jsonl.gz", reader, -1)
+		// Unblock the export if the archiver stopped reading
+		reader.Close()
+		if e := <-exported; err == nil && e != nil && e != io.ErrClosedPipe {
+			err = e
+		}
+		if err != nil {
+			s.Lock()
+			s.shards[shard.name()] = shard
+			s.Unlock()
+			shard.Unlock()
+			return err
+		}
+	}
+	shard.closed = true
+	err := shard.index.Close()
+	shard.Unlock()
+	if err != nil {
+		return err
+	}
+	if shard.path != "" {
+		if err := os.RemoveAll(shard.path); err != nil {
+			return err
+		}
+	}
+	if shard.family == FamilyAudit {
+		return s.updateWatermark()
+	}
+	return nil
+}
+
+// exportShard writes all logs of an index as gzipped JSON lines, ordered by timestamp.
+func exportShard(idx bleve.Index, w io.Writer) error {
+
+	gz := gzip.NewWriter(w)
+	encoder := json.NewEncoder(gz)
+	err := pageByTimestamp(idx, 1000, func(msg *log.LogMessage) error {
+		return encoder.Encode(msg)
+	})
+	if err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// pageByTimestamp calls fn for all logs of an index, ordered by timestamp and id. As this version of bleve
+// has no SearchAfter, each page starts at the last timestamp seen and only skips the logs already read
+// with this timestamp, instead of re-sorting the index from the start.
+func pageByTimestamp(idx bleve.Index, pageSize int, fn func(msg *log.LogMessage) error) error {
+
+	var q query.Query = bleve.NewMatchAllQuery()
+	var lastTs int32
+	var seenAtLastTs int
+	for {
+		req := bleve.NewSearchRequest(q)
+		req.SortBy([]string{common.KEY_TS, "_id"})
+		req.Size = pageSize
+		req.From = seenAtLastTs
+		sr, err := idx.Search(req)
+		if err != nil {
+			return err
+		}
+		for _, hit := range sr.Hits {
+			doc, err := idx.Document(hit.ID)
+			if err != nil {
+				return err
+			}
+			msg := &log.LogMessage{}
+			UnmarshallLogMsgFromDoc(doc, msg)
+			if err := fn(msg); err != nil {
+				return err
+			}
+			if msg.Ts == lastTs {
+				seenAtLastTs++
+			} else {
+				lastTs, seenAtLastTs = msg.Ts, 1
+			}
+		}
+		if len(sr.Hits) < pageSize {
+			return nil
+		}
+		min := float64(lastTs)
+		inclusive := true
+		rq := bleve.NewNumericRangeInclusiveQuery(&min, nil, &inclusive, nil)
+		rq.SetField(common.KEY_TS)
+		q = rq
+	}
+}
+
+// dirSize computes the size on disk of a shard, or zero for in-memory shards.
+func dirSize(root string) (size int64) {
+	if root == "" {
+		return 0
+	}
+	filepath.Walk(root, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return
+}
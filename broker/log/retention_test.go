@@ -0,0 +1,227 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package log
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/blevesearch/bleve"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/proto/log"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type memArchiver struct {
+	objects map[string][]byte
+	err     error
+}
+
+func (m *memArchiver) Archive(name string, data io.Reader, size int64) error {
+	if m.err != nil {
+		return m.err
+	}
+	b, err := ioutil.ReadAll(data)
+	if err != nil {
+		return err
+	}
+	m.objects[name] = b
+	return nil
+}
+
+func datedLog(ts time.Time, msg string, audit bool) map[string]string {
+	line := map[string]string{"ts": ts.Format(time.RFC3339), "level": "info", "msg": msg}
+	if audit {
+		line[logTypeKey] = FamilyAudit
+	}
+	return line
+}
+
+func countLogs(s *SyslogServer, query string) int {
+	results, err := s.ListLogs(query, 0, 1000)
+	So(err, ShouldBeNil)
+	count := 0
+	for range results {
+		count++
+	}
+	return count
+}
+
+func TestShardedSyslog(t *testing.T) {
+
+	now := time.Now().UTC()
+
+	Convey("Logs are sharded by family and by day, and listed across shards", t, func() {
+		s, err := NewSyslogServer("")
+		So(err, ShouldBeNil)
+		defer s.Close()
+
+		So(s.PutLog(datedLog(now.Add(-72*time.Hour), "old technical", false)), ShouldBeNil)
+		So(s.PutLog(datedLog(now.Add(-48*time.Hour), "old audit", true)), ShouldBeNil)
+		So(s.PutLog(datedLog(now, "recent technical", false)), ShouldBeNil)
+		So(s.PutLog(datedLog(now, "recent audit", true)), ShouldBeNil)
+
+		So(s.sortedShards(FamilyTechnical), ShouldHaveLength, 2)
+		So(s.sortedShards(FamilyAudit), ShouldHaveLength, 2)
+
+		results, err := s.ListLogs("", 0, 3)
+		So(err, ShouldBeNil)
+		var msgs []string
+		for r := range results {
+			msgs = append(msgs, r.GetLogMessage().GetMsg())
+		}
+		So(msgs, ShouldHaveLength, 3)
+		So(msgs[2], ShouldEqual, "old audit")
+
+		So(countLogs(s, "+"+common.KEY_MSG+":technical"), ShouldEqual, 2)
+	})
+
+	Convey("Expired shards are archived then dropped", t, func() {
+		s, _ := NewSyslogServer("")
+		defer s.Close()
+		archiver := &memArchiver{objects: map[string][]byte{}}
+		s.SetArchiver(archiver)
+
+		old := now.Add(-60 * 24 * time.Hour)
+		So(s.PutLog(datedLog(old, "old audit 1", true)), ShouldBeNil)
+		So(s.PutLog(datedLog(old, "old audit 2", true)), ShouldBeNil)
+		So(s.PutLog(datedLog(now.Add(-72*time.Hour), "old technical", false)), ShouldBeNil)
+		So(s.PutLog(datedLog(now, "recent audit", true)), ShouldBeNil)
+		So(s.PutLog(datedLog(now, "recent technical", false)), ShouldBeNil)
+		s.SetRetentionPolicy(FamilyAudit, RetentionPolicy{MaxAge: 30 * 24 * time.Hour, Archive: true})
+		s.SetRetentionPolicy(FamilyTechnical, RetentionPolicy{MaxAge: 24 * time.Hour})
+
+		dropped, err := s.ApplyRetention(now)
+		So(err, ShouldBeNil)
+		So(dropped, ShouldHaveLength, 2)
+		So(countLogs(s, ""), ShouldEqual, 2)
+
+		So(archiver.objects, ShouldHaveLength, 1)
+		data, ok := archiver.objects[FamilyAudit+"/"+old.Format(shardLayout)+".jsonl.gz"]
+		So(ok, ShouldBeTrue)
+		gz, err := gzip.NewReader(bytes.NewReader(data))
+		So(err, ShouldBeNil)
+		scanner := bufio.NewScanner(gz)
+		var lines []*log.LogMessage
+		for scanner.Scan() {
+			msg := &log.LogMessage{}
+			So(json.Unmarshal(scanner.Bytes(), msg), ShouldBeNil)
+			lines = append(lines, msg)
+		}
+		So(lines, ShouldHaveLength, 2)
+		So(lines[0].Msg, ShouldStartWith, "old audit")
+
+		// Late logs do not recreate dropped shards
+		So(s.PutLog(datedLog(old, "late audit", true)), ShouldBeNil)
+		So(s.sortedShards(FamilyAudit), ShouldHaveLength, 1)
+		So(countLogs(s, ""), ShouldEqual, 3)
+	})
+
+	Convey("Logs cannot be written to a dropped shard", t, func() {
+		s, _ := NewSyslogServer("")
+		defer s.Close()
+		So(s.PutLog(datedLog(now, "audit", true)), ShouldBeNil)
+		shard := s.sortedShards(FamilyAudit)[0]
+		So(s.dropShard(shard, false), ShouldBeNil)
+		msg, _ := MarshallLogMsg(datedLog(now, "audit", true))
+		So(shard.put(msg), ShouldNotBeNil)
+	})
+
+	Convey("Shards are exported in timestamp order across pages", t, func() {
+		idx, err := bleve.NewMemOnly(newLogIndexMapping())
+		So(err, ShouldBeNil)
+		defer idx.Close()
+		for i := 0; i < 25; i++ {
+			// Many logs share the same timestamp
+			msg, _ := MarshallLogMsg(datedLog(now.Add(time.Duration(i/10)*time.Second), fmt.Sprintf("log %d", i), false))
+			So(idx.Index(fmt.Sprintf("%03d", i), msg), ShouldBeNil)
+		}
+		var msgs []string
+		var lastTs int32
+		So(pageByTimestamp(idx, 4, func(msg *log.LogMessage) error {
+			So(msg.Ts, ShouldBeGreaterThanOrEqualTo, lastTs)
+			lastTs = msg.Ts
+			msgs = append(msgs, msg.Msg)
+			return nil
+		}), ShouldBeNil)
+		So(msgs, ShouldHaveLength, 25)
+		seen := map[string]bool{}
+		for _, m := range msgs {
+			seen[m] = true
+		}
+		So(seen, ShouldHaveLength, 25)
+	})
+
+	Convey("Shards are kept when archiving fails", t, func() {
+		s, _ := NewSyslogServer("")
+		defer s.Close()
+		s.SetArchiver(&memArchiver{err: fmt.Errorf("datasource unavailable")})
+
+		So(s.PutLog(datedLog(now.Add(-72*time.Hour), "old audit", true)), ShouldBeNil)
+		So(s.PutLog(datedLog(now, "recent audit", true)), ShouldBeNil)
+		s.SetRetentionPolicy(FamilyAudit, RetentionPolicy{MaxAge: 24 * time.Hour, Archive: true})
+
+		dropped, err := s.ApplyRetention(now)
+		So(err, ShouldNotBeNil)
+		So(dropped, ShouldBeEmpty)
+		So(countLogs(s, ""), ShouldEqual, 2)
+	})
+
+	Convey("Oldest shards are dropped when exceeding max size, and shards are reopened from disk", t, func() {
+		dir, err := ioutil.TempDir("", "syslog")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		s, err := NewSyslogServer(dir)
+		So(err, ShouldBeNil)
+		for i := 3; i >= 0; i-- {
+			So(s.PutLog(datedLog(now.Add(-time.Duration(i)*24*time.Hour), fmt.Sprintf("day %d", i), false)), ShouldBeNil)
+		}
+		So(s.Close(), ShouldBeNil)
+
+		s, err = NewSyslogServer(dir)
+		So(err, ShouldBeNil)
+		defer s.Close()
+		shards := s.sortedShards(FamilyTechnical)
+		So(shards, ShouldHaveLength, 4)
+
+		s.SetRetentionPolicy(FamilyTechnical, RetentionPolicy{MaxSize: dirSize(shards[3].path) + dirSize(shards[2].path)})
+		dropped, err := s.ApplyRetention(now)
+		So(err, ShouldBeNil)
+		So(dropped, ShouldHaveLength, 2)
+		So(countLogs(s, ""), ShouldEqual, 2)
+
+		_, err = os.Stat(shards[0].path)
+		So(os.IsNotExist(err), ShouldBeTrue)
+		_, err = os.Stat(filepath.Join(dir, FamilyTechnical, now.Format(shardLayout)+".bleve"))
+		So(err, ShouldBeNil)
+	})
+}
@@ -22,69 +22,353 @@ package log
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/mapping"
 	"github.com/rs/xid"
 
 	"github.com/pydio/cells/common/proto/log"
 )
 
-// SyslogServer is the syslog specific implementation of the Log server
+const (
+	// FamilyAudit groups the logs emitted by the Auditer
+	FamilyAudit = "audit"
+	// FamilyTechnical groups all other logs
+	FamilyTechnical = "technical"
+
+	// familyLegacy is used for the single index created by previous versions
+	familyLegacy = "legacy"
+	// shardLayout is the time format used to name daily shards
+	shardLayout = "20060102"
+	// logTypeKey is the field added by the Auditer to flag audit logs
+	logTypeKey = "LogType"
+)
+
+// SyslogServer is the syslog specific implementation of the Log server.
+// Logs are split in daily shards (one bleve index per family and per day) so that
+// expired data can be dropped by simply closing and removing a whole index.
 type SyslogServer struct {
-	Index bleve.Index
-	idgen xid.ID
+	sync.RWMutex
+	root     string
+	shards   map[string]*logShard
+	policies map[string]RetentionPolicy
+	archiver Archiver
+	chain    *AuditChain
 }
 
-// NewSyslogServer creates and configures a default Bleve instance to store technical logs
-func NewSyslogServer(bleveIndexPath string, deleteOnClose ...bool) (*SyslogServer, error) {
+// logShard is a bleve index holding all logs of one family for a given day. Writers hold the read lock,
+// so that the shard cannot be closed while a log is being indexed.
+type logShard struct {
+	sync.RWMutex
+	family string
+	day    time.Time
+	path   string
+	index  bleve.Index
+	closed bool
+}
 
-	index, err := bleve.Open(bleveIndexPath)
-	if err == nil {
-		// Already existing, no need to create
-		return &SyslogServer{Index: index}, nil
+// put indexes a log in the shard, unless it was closed in the meantime.
+func (s *logShard) put(m *IndexableLog) error {
+	s.RLock()
+	defer s.RUnlock()
+	if s.closed {
+		return fmt.Errorf("log shard %s was dropped", s.name())
 	}
+	return s.index.Index(xid.New().String(), m)
+}
 
-	indexMapping := bleve.NewIndexMapping()
-
-	// Create, configure and add a specific document mapping
-	logMapping := bleve.NewDocumentMapping()
+// close waits for pending writes and closes the index.
+func (s *logShard) close() error {
+	s.Lock()
+	defer s.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	return s.index.Close()
+}
 
-	// Specific fields
-	// standardFieldMapping := bleve.NewTextFieldMapping()
-	// logMapping.AddFieldMappingsAt("level", standardFieldMapping)
-	// dateFieldMapping := bleve.NewDateTimeFieldMapping()
-	// logMapping.AddFieldMappingsAt("ts", dateFieldMapping)
-	// keywordFieldMapping := bleve.NewTextFieldMapping()
-	// keywordFieldMapping.Analyzer = keyword.Name
-	// logMapping.AddFieldMappingsAt("msg", keywordFieldMapping)
+func (s *logShard) name() string {
+	if s.family == familyLegacy {
+		return s.family
+	}
+	return s.family + "/" + s.day.Format(shardLayout)
+}
 
-	indexMapping.AddDocumentMapping("sysLog", logMapping)
+// NewSyslogServer creates and configures a sharded Bleve store for technical and audit logs.
+// Shards are stored as sub-folders of bleveIndexPath. If bleveIndexPath is empty, shards are kept in memory.
+func NewSyslogServer(bleveIndexPath string, deleteOnClose ...bool) (*SyslogServer, error) {
 
-	// Creates the new index and initialises the server
+	s := &SyslogServer{
+		root:     bleveIndexPath,
+		shards:   make(map[string]*logShard),
+		policies: make(map[string]RetentionPolicy),
+	}
 	if bleveIndexPath == "" {
-		index, err = bleve.NewMemOnly(indexMapping)
-	} else {
-		index, err = bleve.New(bleveIndexPath, indexMapping)
+		return s, nil
+	}
+
+	for _, family := range []string{FamilyAudit, FamilyTechnical} {
+		dir := filepath.Join(bleveIndexPath, family)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, err
+		}
+		infos, err := ioutil.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range infos {
+			if !info.IsDir() || !strings.HasSuffix(info.Name(), ".bleve") {
+				continue
+			}
+			day, e := time.ParseInLocation(shardLayout, strings.TrimSuffix(info.Name(), ".bleve"), time.UTC)
+			if e != nil {
+				continue
+			}
+			shard := &logShard{family: family, day: day, path: filepath.Join(dir, info.Name())}
+			if shard.index, err = bleve.Open(shard.path); err != nil {
+				return nil, err
+			}
+			shard.index.SetName(shard.name())
+			s.shards[shard.name()] = shard
+		}
+	}
+	return s, nil
+}
+
+// OpenLegacyIndex mounts the single index used by previous versions, if it exists, so that its logs
+// are still listed. It is dropped as a whole once its last modification is older than all retention policies.
+func (s *SyslogServer) OpenLegacyIndex(indexPath string) error {
+	info, err := os.Stat(indexPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
 	}
+	index, err := bleve.Open(indexPath)
 	if err != nil {
-		return &SyslogServer{}, err
+		return err
 	}
-	return &SyslogServer{Index: index}, nil
+	shard := &logShard{family: familyLegacy, day: info.ModTime(), path: indexPath, index: index}
+	index.SetName(shard.name())
+	s.Lock()
+	s.shards[shard.name()] = shard
+	s.Unlock()
+	return nil
 }
 
 // PutLog  simply add a new LogMessage in the syslog repo
 func (s *SyslogServer) PutLog(line map[string]string) error {
-	return BlevePutLog(s.Index, line)
+
+	msg, err := MarshallLogMsg(line)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	ts := now
+	if msg.Ts > 0 {
+		ts = time.Unix(int64(msg.Ts), 0)
+	}
+	family := FamilyTechnical
+	if line[logTypeKey] == FamilyAudit {
+		family = FamilyAudit
+	}
+	s.RLock()
+	policy := s.policies[family]
+	s.RUnlock()
+	if policy.MaxAge > 0 && ts.Before(now.Add(-policy.MaxAge)) {
+		// Late logs are stored in the current shard rather than recreating a shard already dropped by retention.
+		// They keep their original timestamp.
+		ts = now
+	}
+
+	shard, err := s.shardFor(family, ts)
+	if err != nil {
+		return err
+	}
+	store := shard.put
+	if family == FamilyAudit && s.chain != nil {
+		return s.chain.Append(msg, store)
+	}
+	return store(msg)
 }
 
-// ListLogs performs a simple query in the bleve index, based on the passed query string and
+// EnableAuditChain links all audit messages stored from now on with the given chain.
+// If the chain state was lost, it resumes from the last chained message found in the index.
+func (s *SyslogServer) EnableAuditChain(chain *AuditChain) error {
+	if seq, _ := chain.Head(); seq == 0 {
+		if idx, lookup := s.familyIndex(FamilyAudit); idx != nil {
+			last, err := chainBound(idx, 0, 0, false)
+			if err != nil {
+				return err
+			}
+			if last > 0 {
+				msgs, err := chainPage(idx, lookup, last, last, 1)
+				if err != nil {
+					return err
+				}
+				if len(msgs) > 0 {
+					if err := chain.resume(last, msgs[0].ChainHash); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	s.Lock()
+	s.chain = chain
+	s.Unlock()
+	return nil
+}
+
+// ListLogs performs a simple query across all shards, based on the passed query string and
 // returns the results as a stream of log.ListLogResponse for each corresponding hit.
 // Results are ordered by descending timestamp rather than by score.
 func (s *SyslogServer) ListLogs(str string, page, size int32) (chan log.ListLogResponse, error) {
-	return BleveListLogs(s.Index, str, page, size)
+	return s.SearchLogs(&log.ListLogRequest{Query: str, Page: page, Size: size})
+}
+
+// SearchLogs performs a query across all shards (or only audit shards if AuditOnly is set) combining
+// the query string and the typed filters of the request. Results are ordered by descending timestamp.
+func (s *SyslogServer) SearchLogs(req *log.ListLogRequest) (chan log.ListLogResponse, error) {
+
+	family := ""
+	if req.AuditOnly {
+		family = FamilyAudit
+	}
+	idx, lookup := s.familyIndex(family)
+	if idx == nil {
+		res := make(chan log.ListLogResponse)
+		close(res)
+		return res, nil
+	}
+
+	return bleveSearchLogs(idx, lookup, req)
 }
 
 // AggregatedLogs performs a faceted query in the syslog repository. UNIMPLEMENTED.
 func (s *SyslogServer) AggregatedLogs(msgId string, timeRangeType string, refTime int32) (chan log.TimeRangeResponse, error) {
 	return nil, fmt.Errorf("unimplemented method")
 }
+
+// Close signs the audit chain head and closes all opened shards.
+func (s *SyslogServer) Close() error {
+	s.Lock()
+	defer s.Unlock()
+	var err error
+	if s.chain != nil {
+		err = s.chain.Checkpoint()
+		if e := s.chain.Close(); e != nil {
+			err = e
+		}
+		s.chain = nil
+	}
+	for name, shard := range s.shards {
+		if e := shard.close(); e != nil {
+			err = e
+		}
+		delete(s.shards, name)
+	}
+	return err
+}
+
+// familyIndex returns an alias over all shards of a family (all shards if family is empty) and a function
+// to find a shard by its name. The alias is nil if there is no matching shard.
+func (s *SyslogServer) familyIndex(family string) (bleve.Index, func(string) bleve.Index) {
+	s.RLock()
+	defer s.RUnlock()
+	indexes := make(map[string]bleve.Index, len(s.shards))
+	var all []bleve.Index
+	for name, shard := range s.shards {
+		if family != "" && shard.family != family {
+			continue
+		}
+		indexes[name] = shard.index
+		all = append(all, shard.index)
+	}
+	if len(all) == 0 {
+		return nil, nil
+	}
+	return bleve.NewIndexAlias(all...), func(name string) bleve.Index {
+		return indexes[name]
+	}
+}
+
+// shardFor finds or creates the shard receiving logs of the given family at the given time.
+func (s *SyslogServer) shardFor(family string, ts time.Time) (*logShard, error) {
+
+	day := ts.UTC().Truncate(24 * time.Hour)
+	name := family + "/" + day.Format(shardLayout)
+
+	s.RLock()
+	shard, ok := s.shards[name]
+	s.RUnlock()
+	if ok {
+		return shard, nil
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	if shard, ok := s.shards[name]; ok {
+		return shard, nil
+	}
+	shard = &logShard{family: family, day: day}
+	var err error
+	if s.root == "" {
+		shard.index, err = bleve.NewMemOnly(newLogIndexMapping())
+	} else {
+		shard.path = filepath.Join(s.root, family, day.Format(shardLayout)+".bleve")
+		shard.index, err = bleve.New(shard.path, newLogIndexMapping())
+	}
+	if err != nil {
+		return nil, err
+	}
+	shard.index.SetName(name)
+	s.shards[name] = shard
+	return shard, nil
+}
+
+// sortedShards returns the shards of a given family, oldest first.
+func (s *SyslogServer) sortedShards(family string) []*logShard {
+	s.RLock()
+	defer s.RUnlock()
+	var shards []*logShard
+	for _, shard := range s.shards {
+		if shard.family == family {
+			shards = append(shards, shard)
+		}
+	}
+	sort.Slice(shards, func(i, j int) bool {
+		return shards[i].day.Before(shards[j].day)
+	})
+	return shards
+}
+
+func newLogIndexMapping() mapping.IndexMapping {
+
+	indexMapping := bleve.NewIndexMapping()
+
+	// Create, configure and add a specific document mapping
+	logMapping := bleve.NewDocumentMapping()
+
+	// Specific fields
+	// standardFieldMapping := bleve.NewTextFieldMapping()
+	// logMapping.AddFieldMappingsAt("level", standardFieldMapping)
+	// dateFieldMapping := bleve.NewDateTimeFieldMapping()
+	// logMapping.AddFieldMappingsAt("ts", dateFieldMapping)
+	// keywordFieldMapping := bleve.NewTextFieldMapping()
+	// keywordFieldMapping.Analyzer = keyword.Name
+	// logMapping.AddFieldMappingsAt("msg", keywordFieldMapping)
+
+	indexMapping.AddDocumentMapping("sysLog", logMapping)
+
+	return indexMapping
+}
@@ -76,6 +76,24 @@ func TestNewBleveEngine(t *testing.T) {
 		So(msg.GetUserName(), ShouldEqual, "jenny")
 	})
 
+	Convey("Impersonated requests are indexed with both identities:\n", t, func() {
+		err := server.PutLog(json2map(sampleImpersonatedSyslog))
+		So(err, ShouldBeNil)
+
+		results, err := server.ListLogs(fmt.Sprintf(`+%s:admin`, common.KEY_IMPERSONATOR), 0, 1000)
+		So(err, ShouldBeNil)
+		var msg log.LogMessage
+		count := 0
+		for currResp := range results {
+			count++
+			msg = *currResp.GetLogMessage()
+		}
+		So(count, ShouldEqual, 1)
+		So(msg.GetUserName(), ShouldEqual, "jenny")
+		So(msg.GetImpersonator(), ShouldEqual, "admin")
+		So(msg.GetMsgId(), ShouldEqual, common.AUDIT_IMPERSONATE_REQUEST)
+	})
+
 	Convey("Basic technical log index tests:\n", t, func() {
 		err := server.PutLog(log2map("INFO", "this is the first test"))
 		So(err, ShouldBeNil)
@@ -117,6 +135,54 @@ func TestNewBleveEngine(t *testing.T) {
 	})
 }
 
+func TestTypedFilters(t *testing.T) {
+
+	Convey("Audit context is indexed and logs can be filtered by typed fields", t, func() {
+		s, err := NewSyslogServer("")
+		So(err, ShouldBeNil)
+		defer s.Close()
+
+		base := time.Date(2018, 3, 8, 12, 0, 0, 0, time.UTC)
+		put := func(offset int, user, msgId, shareId string, audit bool) {
+			line := datedLog(base.Add(time.Duration(offset)*time.Minute), "event", audit)
+			line[common.KEY_USERNAME] = user
+			line[common.KEY_MSG_ID] = msgId
+			line[common.KEY_NODE_UUID] = "node-" + user
+			if shareId != "" {
+				line[common.KEY_AUDIT_CONTEXT+"."+common.AUDIT_CONTEXT_SHARE_ID] = shareId
+				line[common.KEY_AUDIT_CONTEXT+".Acl"] = `{"read":true}`
+			}
+			So(s.PutLog(line), ShouldBeNil)
+		}
+		put(0, "jenny", common.AUDIT_LINK_CREATE, "a1b2-c3d4", true)
+		put(1, "jenny", common.AUDIT_LOGIN_SUCCEED, "", true)
+		put(2, "john", common.AUDIT_LINK_UPDATE, "e5f6-a7b8", true)
+		put(3, "john", "", "", false)
+
+		search := func(req *log.ListLogRequest) []*log.LogMessage {
+			req.Size = 100
+			res, err := s.SearchLogs(req)
+			So(err, ShouldBeNil)
+			var msgs []*log.LogMessage
+			for r := range res {
+				msgs = append(msgs, r.GetLogMessage())
+			}
+			return msgs
+		}
+
+		msgs := search(&log.ListLogRequest{AuditContext: map[string]string{common.AUDIT_CONTEXT_SHARE_ID: "a1b2-c3d4"}})
+		So(msgs, ShouldHaveLength, 1)
+		So(msgs[0].AuditContext, ShouldResemble, map[string]string{common.AUDIT_CONTEXT_SHARE_ID: "a1b2-c3d4", "Acl": `{"read":true}`})
+
+		So(search(&log.ListLogRequest{UserName: "jenny"}), ShouldHaveLength, 2)
+		So(search(&log.ListLogRequest{NodeUuid: "node-john"}), ShouldHaveLength, 2)
+		So(search(&log.ListLogRequest{NodeUuid: "node-john", AuditOnly: true}), ShouldHaveLength, 1)
+		So(search(&log.ListLogRequest{MsgIds: []string{common.AUDIT_LINK_CREATE, common.AUDIT_LINK_UPDATE}}), ShouldHaveLength, 2)
+		So(search(&log.ListLogRequest{From: convertTimeToTs(base.Add(time.Minute)), To: convertTimeToTs(base.Add(2 * time.Minute))}), ShouldHaveLength, 2)
+		So(search(&log.ListLogRequest{UserName: "john", Query: "+" + common.KEY_MSG_ID + ":" + common.AUDIT_LINK_UPDATE}), ShouldHaveLength, 1)
+	})
+}
+
 func log2json(level string, msg string) string {
 	str := fmt.Sprintf(`{"ts": "%s", "level": "%s", "msg": "%s"}`, time.Now().Format(time.RFC3339), level, msg)
 	return str
@@ -152,5 +218,6 @@ func json2map(line string) map[string]string {
 }
 
 const (
-	sampleSyslog = `{"level":"info","ts":"2018-03-08T13:32:18+01:00","logger":"pydio.grpc.auth","msg":"Login", "RemoteAddress":"::1","UserAgent":"Mozilla/5.0","HttpProtocol":"HTTP/1.1","MsgId":"1","UserName":"jenny"}`
+	sampleSyslog             = `{"level":"info","ts":"2018-03-08T13:32:18+01:00","logger":"pydio.grpc.auth","msg":"Login", "RemoteAddress":"::1","UserAgent":"Mozilla/5.0","HttpProtocol":"HTTP/1.1","MsgId":"1","UserName":"jenny"}`
+	sampleImpersonatedSyslog = `{"level":"info","ts":"2018-03-08T13:35:18+01:00","logger":"pydio.rest.graph","msg":"admin acting as jenny: GET /a/graph/state/", "MsgId":"6","UserName":"jenny","Impersonator":"admin"}`
 )
@@ -114,6 +114,7 @@ func (b *BoltQueue) Consume(mh func(email *mailer.Mail) error) error {
 		var errStack []string
 		// Launch by batch
 		i := 0
+		now := time.Now()
 		for k, v := c.First(); k != nil; k, v = c.Next() {
 
 			// Unmarshal mail
@@ -125,11 +126,17 @@ func (b *BoltQueue) Consume(mh func(email *mailer.Mail) error) error {
 				continue
 			}
 
+			// Wait for the back-off delay of previously failed mails
+			if em.NextAttemptAt > 0 && int64(em.NextAttemptAt) > now.Unix() {
+				continue
+			}
+
 			// Stream mail
 			if err = mh(&em); err != nil {
-				if em.Retries <= MaxSendRetries {
+				if !IsLastAttempt(&em) {
 					em.Retries++
 					em.SendErrors = append(em.SendErrors, err.Error())
+					em.NextAttemptAt = int32(now.Add(Backoff(int(em.Retries))).Unix())
 					marsh, _ := json.Marshal(&em)
 					b.Put(k, marsh)
 					errStack = append(errStack, fmt.Sprintf("error while trying to send email: %s. Will retry next time", err.Error()))
@@ -158,6 +165,11 @@ func (b *BoltQueue) Consume(mh func(email *mailer.Mail) error) error {
 	return output
 }
 
+// IsLastAttempt tells whether the queue will drop a mail if the current attempt to send it fails.
+func IsLastAttempt(email *mailer.Mail) bool {
+	return email.Retries > MaxSendRetries
+}
+
 // itob returns an 8-byte big endian representation of v.
 func itob(v int) []byte {
 	b := make([]byte, 8)
@@ -108,3 +108,34 @@ func TestEnqueueMail(t *testing.T) {
 		So(i, ShouldEqual, 1)
 	})
 }
+
+func TestRetryBackoff(t *testing.T) {
+
+	queue, e := NewBoltQueue(os.TempDir()+"/bolt-test-retry.db", true)
+	if e != nil {
+		t.Fatal(e)
+	}
+	defer queue.Close()
+
+	Convey("Failed mail waits for the back-off delay", t, func() {
+
+		err := queue.Push(&mailer.Mail{Subject: "Retried", To: []*mailer.User{{Address: "recipient@example.com"}}})
+		So(err, ShouldBeNil)
+
+		calls := 0
+		failing := func(email *mailer.Mail) error {
+			calls++
+			return fmt.Errorf("server unavailable")
+		}
+		So(queue.Consume(failing), ShouldNotBeNil)
+		So(calls, ShouldEqual, 1)
+
+		// Second consumption happens before the retry delay
+		So(queue.Consume(failing), ShouldBeNil)
+		So(calls, ShouldEqual, 1)
+
+		So(Backoff(1), ShouldEqual, RetryDelay)
+		So(Backoff(2), ShouldEqual, 2*RetryDelay)
+		So(Backoff(100), ShouldEqual, MaxRetryDelay)
+	})
+}
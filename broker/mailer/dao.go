@@ -27,6 +27,7 @@ package mailer
 import (
 	"context"
 	"path/filepath"
+	"strings"
 
 	"github.com/micro/go-micro/errors"
 
@@ -46,6 +47,34 @@ type Sender interface {
 	Send(email *mailer.Mail) error
 }
 
+// ReceiptSender is implemented by senders that get an identifier for the sent message from the provider.
+type ReceiptSender interface {
+	SendWithReceipt(email *mailer.Mail) (string, error)
+}
+
+// SendWithReceipt sends a mail and returns the identifier of the message for the provider,
+// which defaults to the Message-ID header built by MessageId.
+func SendWithReceipt(sender Sender, email *mailer.Mail) (string, error) {
+	if rs, ok := sender.(ReceiptSender); ok {
+		return rs.SendWithReceipt(email)
+	}
+	return MessageId(email), sender.Send(email)
+}
+
+// MessageId builds the Message-ID header of a tracked mail from its Uuid and the domain of its sender.
+func MessageId(email *mailer.Mail) string {
+	if email.Uuid == "" {
+		return ""
+	}
+	domain := "localhost"
+	if email.From != nil {
+		if i := strings.LastIndex(email.From.Address, "@"); i >= 0 && i < len(email.From.Address)-1 {
+			domain = email.From.Address[i+1:]
+		}
+	}
+	return "<" + email.Uuid + "@" + domain + ">"
+}
+
 func GetQueue(ctx context.Context, t string, conf config.Map) Queue {
 	switch t {
 	case "memory":
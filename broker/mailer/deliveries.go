@@ -0,0 +1,367 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package mailer
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/boltdb/bolt"
+
+	"github.com/pydio/cells/common/proto/mailer"
+)
+
+const (
+	// RetryDelay is the delay before the second attempt of a queued mail. It doubles on each failure up to MaxRetryDelay.
+	RetryDelay = 5 * time.Minute
+	// MaxRetryDelay caps the delay between two attempts of a queued mail
+	MaxRetryDelay = 6 * time.Hour
+)
+
+var (
+	bucketDeliveries = []byte("deliveries")
+	bucketIndex      = []byte("index")
+	bucketProviders  = []byte("providers")
+	bucketDead       = []byte("dead")
+)
+
+// DeliveryStore keeps a delivery record for each mail accepted by the service, and the mails
+// that exhausted their attempts. Buckets are structured like this:
+//
+//	deliveries
+//	  -> SEQ [delivery record, pruned to LogSize entries]
+//	index
+//	  -> DELIVERY_ID [SEQ]
+//	providers
+//	  -> PROVIDER_MESSAGE_ID [DELIVERY_ID]
+//	dead
+//	  -> DELIVERY_ID [full mail, for resending]
+type DeliveryStore struct {
+	db *bolt.DB
+	// LogSize is the maximum number of delivery records kept
+	LogSize int
+}
+
+// NewDeliveryStore opens or creates the Bolt database at the given path.
+func NewDeliveryStore(fileName string) (*DeliveryStore, error) {
+	db, err := bolt.Open(fileName, 0644, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, b := range [][]byte{bucketDeliveries, bucketIndex, bucketProviders, bucketDead} {
+			if _, e := tx.CreateBucketIfNotExists(b); e != nil {
+				return e
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &DeliveryStore{db: db, LogSize: 10000}, nil
+}
+
+// Close closes the underlying database.
+func (s *DeliveryStore) Close() error {
+	return s.db.Close()
+}
+
+// Track creates a QUEUED record for a mail. The mail must have a Uuid.
+func (s *DeliveryStore) Track(email *mailer.Mail, now time.Time) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		_, err := s.create(tx, email, now)
+		return err
+	})
+}
+
+// Attempted records the outcome of an attempt to send a mail. A failed attempt leaves the record RETRYING,
+// or FAILED if it was the last one, in which case the mail is kept in the dead letters.
+func (s *DeliveryStore) Attempted(email *mailer.Mail, providerId string, sendErr error, last bool, now time.Time) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		d, err := s.load(tx, email.Uuid)
+		if err != nil {
+			return err
+		}
+		if d == nil {
+			// Mail was queued before it could be tracked, or its record was pruned
+			if d, err = s.create(tx, email, now); err != nil {
+				return err
+			}
+		}
+		d.Attempts++
+		d.LastAttemptAt = int32(now.Unix())
+		d.NextAttemptAt = 0
+		if sendErr == nil {
+			d.Status = mailer.DeliveryStatus_SENT
+			d.LastError = ""
+			if providerId != "" {
+				d.ProviderMessageId = providerId
+				if err := tx.Bucket(bucketProviders).Put([]byte(strings.Trim(providerId, "<> ")), []byte(d.Uuid)); err != nil {
+					return err
+				}
+			}
+			return s.save(tx, d)
+		}
+		d.LastError = sendErr.Error()
+		if !last {
+			d.Status = mailer.DeliveryStatus_RETRYING
+			d.NextAttemptAt = int32(now.Add(Backoff(int(d.Attempts))).Unix())
+			return s.save(tx, d)
+		}
+		d.Status = mailer.DeliveryStatus_FAILED
+		d.DeadLetter = true
+		data, err := json.Marshal(email)
+		if err != nil {
+			return err
+		}
+		if err := tx.Bucket(bucketDead).Put([]byte(d.Uuid), data); err != nil {
+			return err
+		}
+		return s.save(tx, d)
+	})
+}
+
+// ApplyEvent updates the record matching an event reported by the provider. Bounces and complaints
+// override any status, while DELIVERED only applies to SENT records. It returns false if no record matches.
+func (s *DeliveryStore) ApplyEvent(e *mailer.DeliveryEvent) (found bool, err error) {
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		d, err := s.find(tx, e.MessageId)
+		if err != nil || d == nil {
+			return err
+		}
+		found = true
+		switch e.Status {
+		case mailer.DeliveryStatus_DELIVERED:
+			if d.Status != mailer.DeliveryStatus_SENT {
+				return nil
+			}
+			d.Status = e.Status
+		case mailer.DeliveryStatus_BOUNCED, mailer.DeliveryStatus_COMPLAINED:
+			d.Status = e.Status
+			d.LastError = e.Reason
+			if e.Recipient != "" && len(d.Recipients) > 1 {
+				d.LastError = fmt.Sprintf("%s: %s", e.Recipient, e.Reason)
+			}
+		default:
+			return fmt.Errorf("unsupported event status %s", e.Status.String())
+		}
+		return s.save(tx, d)
+	})
+	return
+}
+
+// List returns the delivery records matching the request, most recent first, and the total number of matches.
+func (s *DeliveryStore) List(req *mailer.ListDeliveriesRequest) (deliveries []*mailer.Delivery, total int, err error) {
+	statuses := make(map[mailer.DeliveryStatus]bool, len(req.Status))
+	for _, st := range req.Status {
+		statuses[st] = true
+	}
+	recipient := strings.ToLower(req.Recipient)
+	err = s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(bucketDeliveries).Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			d := &mailer.Delivery{}
+			if e := json.Unmarshal(v, d); e != nil {
+				return e
+			}
+			if len(statuses) > 0 && !statuses[d.Status] {
+				continue
+			}
+			if req.DeadLetters && !d.DeadLetter {
+				continue
+			}
+			if recipient != "" && !hasRecipient(d, recipient) {
+				continue
+			}
+			if total >= int(req.Offset) && (req.Limit <= 0 || len(deliveries) < int(req.Limit)) {
+				deliveries = append(deliveries, d)
+			}
+			total++
+		}
+		return nil
+	})
+	return
+}
+
+// Resend pushes dead letters back to the queue with a fresh attempts count. An empty uuids list resends all dead letters.
+func (s *DeliveryStore) Resend(uuids []string, queue Queue) (count int, err error) {
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		dead := tx.Bucket(bucketDead)
+		if len(uuids) == 0 {
+			dead.ForEach(func(k, v []byte) error {
+				uuids = append(uuids, string(k))
+				return nil
+			})
+		}
+		for _, uuid := range uuids {
+			data := dead.Get([]byte(uuid))
+			if data == nil {
+				continue
+			}
+			email := &mailer.Mail{}
+			if e := json.Unmarshal(data, email); e != nil {
+				return e
+			}
+			email.Retries = 0
+			email.SendErrors = nil
+			email.NextAttemptAt = 0
+			if e := queue.Push(email); e != nil {
+				return e
+			}
+			if e := dead.Delete([]byte(uuid)); e != nil {
+				return e
+			}
+			if d, e := s.load(tx, uuid); e != nil {
+				return e
+			} else if d != nil {
+				d.Status = mailer.DeliveryStatus_QUEUED
+				d.DeadLetter = false
+				d.Attempts = 0
+				if e := s.save(tx, d); e != nil {
+					return e
+				}
+			}
+			count++
+		}
+		return nil
+	})
+	return
+}
+
+// Backoff returns the delay before the next attempt, after the given number of failed attempts.
+func Backoff(attempts int) time.Duration {
+	delay := RetryDelay
+	for i := 1; i < attempts && delay < MaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > MaxRetryDelay {
+		delay = MaxRetryDelay
+	}
+	return delay
+}
+
+// create stores a new QUEUED record and prunes the oldest ones.
+func (s *DeliveryStore) create(tx *bolt.Tx, email *mailer.Mail, now time.Time) (*mailer.Delivery, error) {
+	if email.Uuid == "" {
+		return nil, fmt.Errorf("cannot track a mail without Uuid")
+	}
+	d := &mailer.Delivery{
+		Uuid:       email.Uuid,
+		TemplateId: email.TemplateId,
+		Subject:    email.Subject,
+		Status:     mailer.DeliveryStatus_QUEUED,
+		CreatedAt:  int32(now.Unix()),
+	}
+	for _, u := range email.To {
+		d.Recipients = append(d.Recipients, u.Address)
+	}
+	deliveries := tx.Bucket(bucketDeliveries)
+	var err error
+	if d.Seq, err = deliveries.NextSequence(); err != nil {
+		return nil, err
+	}
+	if err := tx.Bucket(bucketIndex).Put([]byte(d.Uuid), seqKey(d.Seq)); err != nil {
+		return nil, err
+	}
+	if err := s.save(tx, d); err != nil {
+		return nil, err
+	}
+	if s.LogSize <= 0 {
+		return d, nil
+	}
+	c := deliveries.Cursor()
+	for k, v := c.First(); k != nil && d.Seq-binary.BigEndian.Uint64(k) >= uint64(s.LogSize); k, v = c.First() {
+		old := &mailer.Delivery{}
+		if json.Unmarshal(v, old) == nil {
+			tx.Bucket(bucketIndex).Delete([]byte(old.Uuid))
+			tx.Bucket(bucketDead).Delete([]byte(old.Uuid))
+			if old.ProviderMessageId != "" {
+				tx.Bucket(bucketProviders).Delete([]byte(strings.Trim(old.ProviderMessageId, "<> ")))
+			}
+		}
+		if err := deliveries.Delete(k); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+func (s *DeliveryStore) save(tx *bolt.Tx, d *mailer.Delivery) error {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	return tx.Bucket(bucketDeliveries).Put(seqKey(d.Seq), data)
+}
+
+func (s *DeliveryStore) load(tx *bolt.Tx, uuid string) (*mailer.Delivery, error) {
+	if uuid == "" {
+		return nil, nil
+	}
+	seq := tx.Bucket(bucketIndex).Get([]byte(uuid))
+	if seq == nil {
+		return nil, nil
+	}
+	data := tx.Bucket(bucketDeliveries).Get(seq)
+	if data == nil {
+		return nil, nil
+	}
+	d := &mailer.Delivery{}
+	if err := json.Unmarshal(data, d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// find resolves a delivery Uuid, a provider message id or a Message-ID header built by MessageId.
+func (s *DeliveryStore) find(tx *bolt.Tx, messageId string) (*mailer.Delivery, error) {
+	messageId = strings.Trim(messageId, "<> ")
+	if d, err := s.load(tx, messageId); d != nil || err != nil {
+		return d, err
+	}
+	if uuid := tx.Bucket(bucketProviders).Get([]byte(messageId)); uuid != nil {
+		return s.load(tx, string(uuid))
+	}
+	if i := strings.Index(messageId, "@"); i > 0 {
+		return s.load(tx, messageId[:i])
+	}
+	return nil, nil
+}
+
+func hasRecipient(d *mailer.Delivery, address string) bool {
+	for _, r := range d.Recipients {
+		if strings.ToLower(r) == address {
+			return true
+		}
+	}
+	return false
+}
+
+func seqKey(seq uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, seq)
+	return b
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package mailer
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/mailer"
+)
+
+func newTestMail(uuid string) *mailer.Mail {
+	return &mailer.Mail{
+		Uuid:       uuid,
+		TemplateId: "Welcome",
+		Subject:    "Welcome " + uuid,
+		From:       &mailer.User{Address: "do-not-reply@example.com"},
+		To:         []*mailer.User{{Address: "User@example.com"}},
+	}
+}
+
+func TestDeliveryStore(t *testing.T) {
+
+	dir, _ := ioutil.TempDir("", "mailer-deliveries")
+	defer os.RemoveAll(dir)
+	store, e := NewDeliveryStore(filepath.Join(dir, "deliveries.db"))
+	if e != nil {
+		t.Fatal(e)
+	}
+	defer store.Close()
+	now := time.Now()
+
+	Convey("Successful send records the provider id", t, func() {
+		m := newTestMail("m1")
+		So(store.Track(m, now), ShouldBeNil)
+		list, total, e := store.List(&mailer.ListDeliveriesRequest{})
+		So(e, ShouldBeNil)
+		So(total, ShouldEqual, 1)
+		So(list[0].Status, ShouldEqual, mailer.DeliveryStatus_QUEUED)
+		So(list[0].Recipients, ShouldResemble, []string{"User@example.com"})
+
+		So(store.Attempted(m, MessageId(m), nil, false, now), ShouldBeNil)
+		list, _, _ = store.List(&mailer.ListDeliveriesRequest{})
+		So(list[0].Status, ShouldEqual, mailer.DeliveryStatus_SENT)
+		So(list[0].Attempts, ShouldEqual, 1)
+		So(list[0].ProviderMessageId, ShouldEqual, "<m1@example.com>")
+	})
+
+	Convey("Failures are retried then moved to the dead letters", t, func() {
+		m := newTestMail("m2")
+		So(store.Track(m, now), ShouldBeNil)
+		So(store.Attempted(m, "", fmt.Errorf("timeout"), false, now), ShouldBeNil)
+		list, _, _ := store.List(&mailer.ListDeliveriesRequest{Status: []mailer.DeliveryStatus{mailer.DeliveryStatus_RETRYING}})
+		So(list, ShouldHaveLength, 1)
+		So(list[0].LastError, ShouldEqual, "timeout")
+		So(list[0].NextAttemptAt, ShouldEqual, int32(now.Add(RetryDelay).Unix()))
+
+		So(store.Attempted(m, "", fmt.Errorf("refused"), true, now), ShouldBeNil)
+		list, total, _ := store.List(&mailer.ListDeliveriesRequest{DeadLetters: true})
+		So(total, ShouldEqual, 1)
+		So(list[0].Uuid, ShouldEqual, "m2")
+		So(list[0].Status, ShouldEqual, mailer.DeliveryStatus_FAILED)
+
+		queue := newInMemoryQueue()
+		count, e := store.Resend(nil, queue)
+		So(e, ShouldBeNil)
+		So(count, ShouldEqual, 1)
+		_, total, _ = store.List(&mailer.ListDeliveriesRequest{DeadLetters: true})
+		So(total, ShouldEqual, 0)
+		list, _, _ = store.List(&mailer.ListDeliveriesRequest{Status: []mailer.DeliveryStatus{mailer.DeliveryStatus_QUEUED}})
+		So(list, ShouldHaveLength, 1)
+		So(list[0].Attempts, ShouldEqual, 0)
+	})
+
+	Convey("Provider events update records", t, func() {
+		found, e := store.ApplyEvent(&mailer.DeliveryEvent{MessageId: "<m1@example.com>", Status: mailer.DeliveryStatus_DELIVERED})
+		So(e, ShouldBeNil)
+		So(found, ShouldBeTrue)
+
+		found, _ = store.ApplyEvent(&mailer.DeliveryEvent{MessageId: "m1", Status: mailer.DeliveryStatus_BOUNCED, Reason: "mailbox full"})
+		So(found, ShouldBeTrue)
+		// A late delivered event does not hide the bounce
+		store.ApplyEvent(&mailer.DeliveryEvent{MessageId: "m1", Status: mailer.DeliveryStatus_DELIVERED})
+		list, _, _ := store.List(&mailer.ListDeliveriesRequest{Recipient: "user@example.com", Status: []mailer.DeliveryStatus{mailer.DeliveryStatus_BOUNCED}})
+		So(list, ShouldHaveLength, 1)
+		So(list[0].LastError, ShouldEqual, "mailbox full")
+
+		found, _ = store.ApplyEvent(&mailer.DeliveryEvent{MessageId: "unknown", Status: mailer.DeliveryStatus_BOUNCED})
+		So(found, ShouldBeFalse)
+	})
+
+	Convey("Oldest records are pruned", t, func() {
+		store.LogSize = 2
+		So(store.Track(newTestMail("m3"), now), ShouldBeNil)
+		list, total, _ := store.List(&mailer.ListDeliveriesRequest{})
+		So(total, ShouldEqual, 2)
+		So(list[0].Uuid, ShouldEqual, "m3")
+		So(list[1].Uuid, ShouldEqual, "m2")
+	})
+}
+
+func TestParseSendGridEvents(t *testing.T) {
+
+	Convey("SendGrid events are converted", t, func() {
+		events, e := ParseSendGridEvents([]byte(`[
+			{"email":"a@example.com","timestamp":1513299569,"event":"delivered","sg_message_id":"14c5d75ce93.dfd.64b469.filter0001.16648.5515E0B88.0"},
+			{"email":"b@example.com","timestamp":1513299569,"event":"bounce","reason":"500 unknown recipient","pydio_uuid":"m1"},
+			{"email":"c@example.com","timestamp":1513299569,"event":"open","pydio_uuid":"m2"},
+			{"email":"d@example.com","timestamp":1513299569,"event":"spamreport","pydio_uuid":"m3"}
+		]`))
+		So(e, ShouldBeNil)
+		So(events, ShouldHaveLength, 3)
+		So(events[0].MessageId, ShouldEqual, "14c5d75ce93")
+		So(events[0].Status, ShouldEqual, mailer.DeliveryStatus_DELIVERED)
+		So(events[1].MessageId, ShouldEqual, "m1")
+		So(events[1].Reason, ShouldEqual, "500 unknown recipient")
+		So(events[2].Status, ShouldEqual, mailer.DeliveryStatus_COMPLAINED)
+
+		_, e = ParseSendGridEvents([]byte(`{}`))
+		So(e, ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package mailer
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pydio/cells/common/proto/mailer"
+)
+
+// sendGridEvent is an entry of the JSON array posted by the sendgrid event webhook.
+type sendGridEvent struct {
+	Email       string `json:"email"`
+	Timestamp   int64  `json:"timestamp"`
+	Event       string `json:"event"`
+	Reason      string `json:"reason"`
+	Response    string `json:"response"`
+	SgMessageId string `json:"sg_message_id"`
+	PydioUuid   string `json:"pydio_uuid"`
+}
+
+// ParseSendGridEvents converts the payload of the sendgrid event webhook to delivery events.
+// Events that do not change a delivery status (open, click, deferred...) are ignored.
+func ParseSendGridEvents(data []byte) ([]*mailer.DeliveryEvent, error) {
+	var raw []*sendGridEvent
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	var events []*mailer.DeliveryEvent
+	for _, r := range raw {
+		ev := &mailer.DeliveryEvent{
+			Recipient: r.Email,
+			Reason:    r.Reason,
+			Timestamp: int32(r.Timestamp),
+		}
+		switch r.Event {
+		case "delivered":
+			ev.Status = mailer.DeliveryStatus_DELIVERED
+		case "bounce", "dropped":
+			ev.Status = mailer.DeliveryStatus_BOUNCED
+		case "spamreport":
+			ev.Status = mailer.DeliveryStatus_COMPLAINED
+		default:
+			continue
+		}
+		if ev.Reason == "" {
+			ev.Reason = r.Response
+		}
+		if r.PydioUuid != "" {
+			ev.MessageId = r.PydioUuid
+		} else if i := strings.Index(r.SgMessageId, "."); i > 0 {
+			// sg_message_id is the X-Message-Id followed by internal routing information
+			ev.MessageId = r.SgMessageId[:i]
+		} else {
+			ev.MessageId = r.SgMessageId
+		}
+		if ev.MessageId == "" {
+			continue
+		}
+		events = append(events, ev)
+	}
+	return events, nil
+}
@@ -58,6 +58,9 @@ func NewGomailMessage(email *mailer.Mail) (*gomail.Message, error) {
 	m.SetHeader("Cc", cc...)
 
 	m.SetHeader("Subject", email.Subject)
+	if id := MessageId(email); id != "" {
+		m.SetHeader("Message-ID", id)
+	}
 
 	//formatMail(email, email.To[0])
 
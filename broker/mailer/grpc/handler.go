@@ -24,9 +24,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
+	"time"
 
 	"github.com/matcornic/hermes"
 	"github.com/micro/go-micro/errors"
+	"github.com/pborman/uuid"
 	"go.uber.org/zap"
 
 	"github.com/pydio/cells/broker/mailer"
@@ -36,15 +39,24 @@ import (
 	"github.com/pydio/cells/common/forms"
 	"github.com/pydio/cells/common/log"
 	proto "github.com/pydio/cells/common/proto/mailer"
+	"github.com/pydio/cells/common/service/context"
 )
 
 type Handler struct {
-	Queue  mailer.Queue
-	sender mailer.Sender
+	Queue      mailer.Queue
+	sender     mailer.Sender
+	deliveries *mailer.DeliveryStore
 }
 
 func NewHandler(serviceCtx context.Context, conf config.Map) (*Handler, error) {
 	h := new(Handler)
+	dataDir, e := config.ServiceDataDir(servicecontext.GetServiceName(serviceCtx))
+	if e != nil {
+		return nil, e
+	}
+	if h.deliveries, e = mailer.NewDeliveryStore(filepath.Join(dataDir, "deliveries.db")); e != nil {
+		return nil, e
+	}
 	if q := conf.String("queue"); q != "" {
 		var queueData struct {
 			Value string `json:"@value"`
@@ -130,12 +142,24 @@ func (m *Handler) SendMail(ctx context.Context, req *proto.SendMailRequest, rsp
 		h := templates.GetHermes(languages...)
 		if mail.ContentHtml == "" {
 			var body hermes.Body
+			var html string
 			if mail.TemplateId != "" {
 				var subject string
-				subject, body = templates.BuildTemplateWithId(to, mail.TemplateId, mail.TemplateData, languages...)
+				var e error
+				override := templates.LoadOverride(ctx, mail.TemplateId, languages...)
+				subject, body, html, e = templates.BuildTemplateWithOverride(to, mail.TemplateId, mail.TemplateData, override, languages...)
+				if e != nil {
+					log.Logger(ctx).Error("SendMail: cannot render custom template, using default one", zap.String("template", mail.TemplateId), zap.Error(e))
+					subject, body = templates.BuildTemplateWithId(to, mail.TemplateId, mail.TemplateData, languages...)
+					html = ""
+				}
 				mail.Subject = subject
 				if mail.ContentMarkdown != "" {
-					body.FreeMarkdown = hermes.Markdown(mail.ContentMarkdown)
+					if body.FreeMarkdown != "" {
+						body.FreeMarkdown = body.FreeMarkdown + hermes.Markdown("\n\n"+mail.ContentMarkdown)
+					} else {
+						body.FreeMarkdown = hermes.Markdown(mail.ContentMarkdown)
+					}
 				}
 			} else {
 				if mail.ContentMarkdown != "" {
@@ -150,12 +174,23 @@ func (m *Handler) SendMail(ctx context.Context, req *proto.SendMailRequest, rsp
 			}
 			hermesMail := hermes.Email{Body: body}
 			var e error
-			mail.ContentHtml, _ = h.GenerateHTML(hermesMail)
-			if mail.ContentPlain, e = h.GenerateHTML(hermesMail); e != nil {
-				return e
+			if html != "" {
+				mail.ContentHtml = html
+				if mail.ContentPlain, e = h.GeneratePlainText(hermesMail); e != nil {
+					return e
+				}
+			} else {
+				mail.ContentHtml, _ = h.GenerateHTML(hermesMail)
+				if mail.ContentPlain, e = h.GenerateHTML(hermesMail); e != nil {
+					return e
+				}
 			}
 		}
 
+		mail.Uuid = uuid.New()
+		if e := m.deliveries.Track(mail, time.Now()); e != nil {
+			log.Logger(ctx).Error("SendMail: cannot create delivery record", zap.Error(e))
+		}
 		if req.InQueue {
 			log.Logger(ctx).Info("SendMail: pushing email to queue", zap.Any("to", mail.To), zap.Any("from", mail.From), zap.Any("subject", mail.Subject))
 			if e := m.Queue.Push(mail); e != nil {
@@ -163,7 +198,7 @@ func (m *Handler) SendMail(ctx context.Context, req *proto.SendMailRequest, rsp
 			}
 		} else {
 			log.Logger(ctx).Info("SendMail: sending email", zap.Any("to", mail.To), zap.Any("from", mail.From), zap.Any("subject", mail.Subject))
-			if e := m.sender.Send(mail); e != nil {
+			if e := m.send(ctx, mail, true); e != nil {
 				return e
 			}
 		}
@@ -181,7 +216,10 @@ func (m *Handler) ConsumeQueue(ctx context.Context, req *proto.ConsumeQueueReque
 			return fmt.Errorf("cannot send empty email")
 		}
 		counter++
-		return m.sender.Send(em)
+		if em.Uuid == "" {
+			em.Uuid = uuid.New()
+		}
+		return m.send(ctx, em, mailer.IsLastAttempt(em))
 	}
 
 	if e := m.Queue.Consume(c); e == nil {
@@ -193,3 +231,54 @@ func (m *Handler) ConsumeQueue(ctx context.Context, req *proto.ConsumeQueueReque
 	}
 
 }
+
+// ListDeliveries lists delivery records, most recent first.
+func (m *Handler) ListDeliveries(ctx context.Context, req *proto.ListDeliveriesRequest, rsp *proto.ListDeliveriesResponse) error {
+	deliveries, total, e := m.deliveries.List(req)
+	if e != nil {
+		return e
+	}
+	rsp.Deliveries = deliveries
+	rsp.Total = int32(total)
+	return nil
+}
+
+// Resend queues dead letters again, resetting their attempts.
+func (m *Handler) Resend(ctx context.Context, req *proto.ResendRequest, rsp *proto.ResendResponse) error {
+	count, e := m.deliveries.Resend(req.Uuids, m.Queue)
+	if e != nil {
+		return e
+	}
+	rsp.Count = int32(count)
+	log.Logger(ctx).Info(fmt.Sprintf("Resend: queued %d dead letters again", count))
+	return nil
+}
+
+// ProcessDeliveryEvents updates delivery records with bounces and complaints reported by the provider.
+func (m *Handler) ProcessDeliveryEvents(ctx context.Context, req *proto.ProcessDeliveryEventsRequest, rsp *proto.ProcessDeliveryEventsResponse) error {
+	for _, ev := range req.Events {
+		found, e := m.deliveries.ApplyEvent(ev)
+		if e != nil {
+			log.Logger(ctx).Error("ProcessDeliveryEvents: cannot apply event", zap.Any("event", ev), zap.Error(e))
+			continue
+		}
+		if !found {
+			log.Logger(ctx).Debug("ProcessDeliveryEvents: no delivery found for event", zap.Any("event", ev))
+			continue
+		}
+		if ev.Status == proto.DeliveryStatus_BOUNCED || ev.Status == proto.DeliveryStatus_COMPLAINED {
+			log.Logger(ctx).Warn("Mail was "+ev.Status.String(), zap.String("message", ev.MessageId), zap.String("recipient", ev.Recipient), zap.String("reason", ev.Reason))
+		}
+		rsp.Count++
+	}
+	return nil
+}
+
+// send sends a mail with the configured sender and records the outcome of the attempt.
+func (m *Handler) send(ctx context.Context, email *proto.Mail, last bool) error {
+	providerId, err := mailer.SendWithReceipt(m.sender, email)
+	if e := m.deliveries.Attempted(email, providerId, err, last, time.Now()); e != nil {
+		log.Logger(ctx).Error("cannot update delivery record", zap.String("uuid", email.Uuid), zap.Error(e))
+	}
+	return err
+}
@@ -47,6 +47,7 @@ func init() {
 		service.Tag(common.SERVICE_TAG_BROKER),
 		service.Description("MailSender Service"),
 		service.Dependency(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_JOBS, []string{}),
+		service.Dependency(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, []string{}),
 		service.Migrations([]*service.Migration{
 			{
 				TargetVersion: service.FirstRun(),
@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"github.com/emicklei/go-restful"
+	"github.com/golang/protobuf/jsonpb"
+	"go.uber.org/zap"
+
+	mailer2 "github.com/pydio/cells/broker/mailer"
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/mailer"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/registry"
+	"github.com/pydio/cells/common/service"
+)
+
+// ListMailDeliveries lists delivery records of outgoing mails, most recent first.
+func (mh *MailerHandler) ListMailDeliveries(req *restful.Request, rsp *restful.Response) {
+	input := &mailer.ListDeliveriesRequest{
+		Recipient:   req.QueryParameter("Recipient"),
+		DeadLetters: req.QueryParameter("DeadLetters") == "true",
+	}
+	for _, s := range req.Request.URL.Query()["Status"] {
+		st, ok := mailer.DeliveryStatus_value[s]
+		if !ok {
+			rsp.WriteError(400, fmt.Errorf("unknown status %s", s))
+			return
+		}
+		input.Status = append(input.Status, mailer.DeliveryStatus(st))
+	}
+	if o, e := strconv.ParseInt(req.QueryParameter("Offset"), 10, 32); e == nil {
+		input.Offset = int32(o)
+	}
+	if l, e := strconv.ParseInt(req.QueryParameter("Limit"), 10, 32); e == nil {
+		input.Limit = int32(l)
+	}
+	cli := mailer.NewMailerServiceClient(registry.GetClient(common.SERVICE_MAILER))
+	resp, err := cli.ListDeliveries(req.Request.Context(), input)
+	if err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	rsp.WriteEntity(resp)
+}
+
+// ResendMails queues mails that exhausted their attempts again.
+func (mh *MailerHandler) ResendMails(req *restful.Request, rsp *restful.Response) {
+	var input mailer.ResendRequest
+	if err := req.ReadEntity(&input); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	ctx := req.Request.Context()
+	cli := mailer.NewMailerServiceClient(registry.GetClient(common.SERVICE_MAILER))
+	resp, err := cli.Resend(ctx, &input)
+	if err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	log.Auditer(ctx).Info(fmt.Sprintf("Queued %d failed mails again", resp.Count), zap.Strings("uuids", input.Uuids))
+	rsp.WriteEntity(resp)
+}
+
+// maxMailEventsSize limits the size of the payloads posted by mail providers.
+const maxMailEventsSize = 5 * 1024 * 1024
+
+// IngestMailEvents receives bounces and complaints posted by the mail provider. As providers cannot
+// authenticate, requests must carry the token set in the "eventsToken" key of this service.
+func (mh *MailerHandler) IngestMailEvents(req *restful.Request, rsp *restful.Response) {
+	ctx := req.Request.Context()
+	token := config.Get("services", common.SERVICE_REST_NAMESPACE_+common.SERVICE_MAILER, "eventsToken").String("")
+	if token == "" {
+		service.RestError403(req, rsp, fmt.Errorf("mail events are disabled, please set an eventsToken"))
+		return
+	}
+	if subtle.ConstantTimeCompare([]byte(req.QueryParameter("Token")), []byte(token)) != 1 {
+		service.RestError403(req, rsp, fmt.Errorf("invalid token"))
+		return
+	}
+	// Read one byte more than allowed to detect larger payloads
+	data, err := ioutil.ReadAll(io.LimitReader(req.Request.Body, maxMailEventsSize+1))
+	if err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	if len(data) > maxMailEventsSize {
+		rsp.WriteError(http.StatusRequestEntityTooLarge, fmt.Errorf("mail events payload exceeds %d bytes", maxMailEventsSize))
+		return
+	}
+	var events []*mailer.DeliveryEvent
+	switch provider := req.PathParameter("Provider"); provider {
+	case "sendgrid":
+		events, err = mailer2.ParseSendGridEvents(data)
+	case "generic":
+		input := &rest.MailEventsRequest{}
+		err = jsonpb.UnmarshalString(string(data), input)
+		events = input.Events
+	default:
+		err = fmt.Errorf("unsupported provider %s", provider)
+	}
+	if err != nil {
+		rsp.WriteError(400, err)
+		return
+	}
+	cli := mailer.NewMailerServiceClient(registry.GetClient(common.SERVICE_MAILER))
+	resp, err := cli.ProcessDeliveryEvents(ctx, &mailer.ProcessDeliveryEventsRequest{Events: events})
+	if err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	rsp.WriteEntity(resp)
+}
@@ -30,8 +30,9 @@ func init() {
 	service.NewService(
 		service.Name(common.SERVICE_REST_NAMESPACE_+common.SERVICE_MAILER),
 		service.Tag(common.SERVICE_TAG_BROKER),
-		service.Description("REST send email service"),
+		service.Description("REST send email and manage mail templates service"),
 		service.Dependency(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_MAILER, []string{}),
+		service.Dependency(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, []string{}),
 		service.WithWeb(func() service.WebHandler {
 			return new(MailerHandler)
 		}),
@@ -0,0 +1,206 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/emicklei/go-restful"
+	"github.com/matcornic/hermes"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/broker/mailer/templates"
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/mailer"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/registry"
+	"github.com/pydio/cells/common/service"
+	"github.com/pydio/cells/common/utils"
+)
+
+// ListMailTemplates lists all known templates for a language, using the stored variant
+// for this language, then the variant for all languages, then the strings of the i18n bundle.
+func (mh *MailerHandler) ListMailTemplates(req *restful.Request, rsp *restful.Response) {
+	ctx := req.Request.Context()
+	language := req.QueryParameter("Language")
+	if language == "" {
+		language = utils.GetDefaultLanguage()
+	}
+	overrides, err := templates.ListOverrides(ctx)
+	if err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	byLang := make(map[string]*mailer.MailTemplate)
+	allLangs := make(map[string]*mailer.MailTemplate)
+	for _, o := range overrides {
+		if o.Language == "" {
+			allLangs[o.TemplateId] = o
+		} else if o.Language == language {
+			byLang[o.TemplateId] = o
+		}
+	}
+	collection := &rest.MailTemplatesCollection{}
+	for _, id := range templates.TemplateIds() {
+		t, ok := byLang[id]
+		if !ok {
+			t, ok = allLangs[id]
+		}
+		if !ok {
+			t = templates.DefaultTemplate(id, language)
+		}
+		t.Variables = templates.Variables[id]
+		collection.Templates = append(collection.Templates, t)
+	}
+	rsp.WriteEntity(collection)
+}
+
+// PutMailTemplate stores a custom variant of a template.
+func (mh *MailerHandler) PutMailTemplate(req *restful.Request, rsp *restful.Response) {
+	var input mailer.MailTemplate
+	if err := req.ReadEntity(&input); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	ctx := req.Request.Context()
+	if input.TemplateId == "" {
+		rsp.WriteError(400, fmt.Errorf("please provide a TemplateId"))
+		return
+	}
+	if err := templates.ValidateTemplate(&input); err != nil {
+		rsp.WriteError(400, err)
+		return
+	}
+	if err := templates.StoreOverride(ctx, &input); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	log.Auditer(ctx).Info("Stored mail template "+input.TemplateId, zap.String("template", input.TemplateId), zap.String("language", input.Language))
+	input.Variables = templates.Variables[input.TemplateId]
+	rsp.WriteEntity(&input)
+}
+
+// DeleteMailTemplate removes a custom variant of a template.
+func (mh *MailerHandler) DeleteMailTemplate(req *restful.Request, rsp *restful.Response) {
+	ctx := req.Request.Context()
+	templateId := req.PathParameter("TemplateId")
+	language := req.QueryParameter("Language")
+	if err := templates.DeleteOverride(ctx, templateId, language); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	log.Auditer(ctx).Info("Deleted mail template "+templateId, zap.String("template", templateId), zap.String("language", language))
+	rsp.WriteEntity(&mailer.SendMailResponse{Success: true})
+}
+
+// PreviewMailTemplate renders a template, saved or not, with sample data for the current user.
+func (mh *MailerHandler) PreviewMailTemplate(req *restful.Request, rsp *restful.Response) {
+	var input rest.MailTemplatePreviewRequest
+	if err := req.ReadEntity(&input); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	ctx := req.Request.Context()
+	preview, err := mh.renderPreview(ctx, &input, currentMailUser(ctx))
+	if err != nil {
+		rsp.WriteError(400, err)
+		return
+	}
+	rsp.WriteEntity(preview)
+}
+
+// SendTestMailTemplate renders a template like PreviewMailTemplate and sends it to the current user.
+func (mh *MailerHandler) SendTestMailTemplate(req *restful.Request, rsp *restful.Response) {
+	var input rest.MailTemplatePreviewRequest
+	if err := req.ReadEntity(&input); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	ctx := req.Request.Context()
+	to := currentMailUser(ctx)
+	if to.Address == "" {
+		rsp.WriteError(400, fmt.Errorf("current user has no email address"))
+		return
+	}
+	preview, err := mh.renderPreview(ctx, &input, to)
+	if err != nil {
+		rsp.WriteError(400, err)
+		return
+	}
+	cli := mailer.NewMailerServiceClient(registry.GetClient(common.SERVICE_MAILER))
+	if _, err := cli.SendMail(ctx, &mailer.SendMailRequest{Mail: &mailer.Mail{
+		To:           []*mailer.User{to},
+		Subject:      preview.Subject,
+		ContentHtml:  preview.ContentHtml,
+		ContentPlain: preview.ContentPlain,
+	}}); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	rsp.WriteEntity(&mailer.SendMailResponse{Success: true})
+}
+
+func (mh *MailerHandler) renderPreview(ctx context.Context, input *rest.MailTemplatePreviewRequest, to *mailer.User) (*rest.MailTemplatePreviewResponse, error) {
+	t := input.Template
+	if t == nil || t.TemplateId == "" {
+		return nil, fmt.Errorf("please provide a Template with a TemplateId")
+	}
+	if err := templates.ValidateTemplate(t); err != nil {
+		return nil, err
+	}
+	var languages []string
+	if t.Language != "" {
+		languages = append(languages, t.Language)
+	}
+	data := templates.SampleData(t.TemplateId, input.TemplateData)
+	subject, body, html, err := templates.BuildTemplateWithOverride(to, t.TemplateId, data, t, languages...)
+	if err != nil {
+		return nil, err
+	}
+	h := templates.GetHermes(languages...)
+	email := hermes.Email{Body: body}
+	if html == "" {
+		if html, err = h.GenerateHTML(email); err != nil {
+			return nil, err
+		}
+	}
+	plain, err := h.GeneratePlainText(email)
+	if err != nil {
+		return nil, err
+	}
+	return &rest.MailTemplatePreviewResponse{Subject: subject, ContentHtml: html, ContentPlain: plain}, nil
+}
+
+func currentMailUser(ctx context.Context) *mailer.User {
+	u := &mailer.User{}
+	if claims, ok := ctx.Value(claim.ContextKey).(claim.Claims); ok {
+		u.Uuid, _ = claims.DecodeUserUuid()
+		u.Address = claims.Email
+		u.Name = claims.DisplayName
+		if u.Name == "" {
+			u.Name = claims.Name
+		}
+	}
+	return u
+}
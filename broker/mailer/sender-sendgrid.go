@@ -32,6 +32,9 @@ import (
 	"github.com/pydio/cells/common/proto/mailer"
 )
 
+// SendGridUuidArg is the custom argument carrying the mail Uuid in sendgrid events
+const SendGridUuidArg = "pydio_uuid"
+
 // SendGrid is a passerelle to Sendgrid API. It holds the application API Key.
 type SendGrid struct {
 	ApiKey string
@@ -53,22 +56,36 @@ func (s *SendGrid) Configure(config config.Map) error {
 
 // Send performs the real code to the sendgrid API.
 func (s *SendGrid) Send(email *mailer.Mail) error {
+	_, err := s.SendWithReceipt(email)
+	return err
+}
+
+// SendWithReceipt sends the mail and returns the X-Message-Id given by sendgrid. The mail Uuid is
+// passed as the "pydio_uuid" custom argument, so that it is found in the events posted by sendgrid.
+func (s *SendGrid) SendWithReceipt(email *mailer.Mail) (string, error) {
 
 	from := mail.NewEmail(email.From.Name, email.From.Address)
 	// fmt.Printf("Sendgrid from mail: %s - %s \n", from.Name, from.Address)
 
+	var messageId string
 	for _, u := range email.To {
 		to := mail.NewEmail(u.Name, u.Address)
 		// fmt.Printf("Sendgrid to mail: %s - %s \n", to.Name, to.Address)
 
 		message := mail.NewSingleEmail(from, email.Subject, to, email.ContentPlain, email.ContentHtml)
+		if email.Uuid != "" {
+			message.SetCustomArg(SendGridUuidArg, email.Uuid)
+		}
 		client := sendgrid.NewSendClient(s.ApiKey)
 		resp, err := client.Send(message)
 		if err != nil {
-			return err
+			return "", err
 		} else if !(resp.StatusCode == 200 || resp.StatusCode == 202) {
-			return fmt.Errorf("sending mail via sendgrid fail with status %d, message: %s", resp.StatusCode, resp.Body)
+			return "", fmt.Errorf("sending mail via sendgrid fail with status %d, message: %s", resp.StatusCode, resp.Body)
+		}
+		if ids := resp.Headers["X-Message-Id"]; len(ids) > 0 && messageId == "" {
+			messageId = ids[0]
 		}
 	}
-	return nil
+	return messageId, nil
 }
@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package templates
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"strings"
+	"text/template"
+
+	"github.com/nicksnyder/go-i18n/i18n/language"
+
+	"github.com/pydio/cells/broker/mailer/lang"
+	"github.com/pydio/cells/common/proto/mailer"
+)
+
+// TemplateContext is the data passed to the i18n strings and to the custom templates.
+type TemplateContext struct {
+	TplData map[string]string
+	User    *mailer.User
+	Configs ApplicationConfigs
+}
+
+// DefaultTemplate builds a MailTemplate from the raw i18n strings of the bundle for the given language,
+// as a starting point for a custom variant.
+func DefaultTemplate(templateId string, languages ...string) *mailer.MailTemplate {
+	var contents []string
+	for _, key := range []string{"Intros", "Outros"} {
+		if s := bundleSource("Mail."+templateId+"."+key, languages...); s != "" {
+			contents = append(contents, s)
+		}
+	}
+	t := &mailer.MailTemplate{
+		TemplateId:       templateId,
+		Subject:          bundleSource("Mail."+templateId+".Subject", languages...),
+		ContentMarkdown:  strings.Join(contents, "\n\n"),
+		LinkLabel:        bundleSource("Mail."+templateId+".LinkLabel", languages...),
+		LinkInstructions: bundleSource("Mail."+templateId+".LinkInstructions", languages...),
+		Variables:        Variables[templateId],
+	}
+	if len(languages) > 0 {
+		t.Language = languages[0]
+	}
+	return t
+}
+
+// ValidateTemplate checks that all fields of a custom variant can be parsed.
+func ValidateTemplate(t *mailer.MailTemplate) error {
+	for _, s := range []string{t.Subject, t.ContentMarkdown, t.LinkLabel, t.LinkInstructions} {
+		if _, e := template.New("").Parse(s); e != nil {
+			return e
+		}
+	}
+	_, e := htmltemplate.New("").Parse(t.ContentHtml)
+	return e
+}
+
+// bundleSource finds the untranslated source of an i18n string, falling back to the default language.
+func bundleSource(id string, languages ...string) string {
+	b := lang.Bundle()
+	tags := []string{"en-us"}
+	if len(languages) > 0 && languages[0] != "" {
+		if _, l, e := b.TfuncAndLanguage(languages[0], "en-US"); e == nil && l != nil {
+			tags = append([]string{l.Tag}, tags...)
+		}
+	}
+	translations := b.Translations()
+	for _, tag := range tags {
+		tr, ok := translations[tag][id]
+		if !ok {
+			continue
+		}
+		if tpl := tr.Template(language.Other); tpl != nil {
+			if src, e := tpl.MarshalText(); e == nil {
+				return string(src)
+			}
+		}
+	}
+	return ""
+}
+
+func executeText(src string, data TemplateContext) (string, error) {
+	tpl, e := template.New("").Parse(src)
+	if e != nil {
+		return "", e
+	}
+	var buf bytes.Buffer
+	if e := tpl.Execute(&buf, data); e != nil {
+		return "", e
+	}
+	return buf.String(), nil
+}
+
+func executeHtml(src string, data TemplateContext) (string, error) {
+	tpl, e := htmltemplate.New("").Parse(src)
+	if e != nil {
+		return "", e
+	}
+	var buf bytes.Buffer
+	if e := tpl.Execute(&buf, data); e != nil {
+		return "", e
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package templates
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/mailer"
+)
+
+func TestBuildTemplateWithOverride(t *testing.T) {
+
+	user := &mailer.User{Name: "John"}
+
+	Convey("Default template is built from the bundle", t, func() {
+		subject, body, html, err := BuildTemplateWithOverride(user, "Welcome", map[string]string{"Login": "jdoe"}, nil)
+		So(err, ShouldBeNil)
+		So(html, ShouldBeEmpty)
+		So(subject, ShouldStartWith, "Welcome on ")
+		So(body.Intros, ShouldHaveLength, 1)
+		So(body.Intros[0], ShouldContainSubstring, "Your login is jdoe")
+		So(body.Actions, ShouldHaveLength, 1)
+	})
+
+	Convey("Override replaces non-empty fields only", t, func() {
+		override := &mailer.MailTemplate{
+			Subject:         "Hi {{.User.Name}}",
+			ContentMarkdown: "Your login: **{{.TplData.Login}}**",
+		}
+		subject, body, html, err := BuildTemplateWithOverride(user, "Welcome", map[string]string{"Login": "jdoe"}, override)
+		So(err, ShouldBeNil)
+		So(html, ShouldBeEmpty)
+		So(subject, ShouldEqual, "Hi John")
+		So(body.Intros, ShouldBeEmpty)
+		So(body.Outros, ShouldBeEmpty)
+		So(string(body.FreeMarkdown), ShouldEqual, "Your login: **jdoe**")
+		So(body.Actions, ShouldHaveLength, 1)
+		So(body.Actions[0].Button.Text, ShouldStartWith, "Login to ")
+	})
+
+	Convey("HTML override is escaped and returned", t, func() {
+		override := &mailer.MailTemplate{ContentHtml: "<p>{{.TplData.Login}}</p>"}
+		_, _, html, err := BuildTemplateWithOverride(user, "Welcome", map[string]string{"Login": "<b>"}, override)
+		So(err, ShouldBeNil)
+		So(html, ShouldEqual, "<p>&lt;b&gt;</p>")
+	})
+
+	Convey("Invalid override is rejected", t, func() {
+		override := &mailer.MailTemplate{TemplateId: "Welcome", Subject: "{{.User.Name"}
+		So(ValidateTemplate(override), ShouldNotBeNil)
+		_, _, _, err := BuildTemplateWithOverride(user, "Welcome", nil, override)
+		So(err, ShouldNotBeNil)
+	})
+
+}
+
+func TestDefaultTemplate(t *testing.T) {
+
+	Convey("Default template exposes raw bundle strings and variables", t, func() {
+		tpl := DefaultTemplate("Invite", "en-us")
+		So(tpl.Subject, ShouldEqual, "{{.TplData.Inviter}} has invited you on {{.Configs.Title}}")
+		So(tpl.ContentMarkdown, ShouldContainSubstring, "An account has been created for you by {{.TplData.Inviter}}")
+		So(tpl.ContentMarkdown, ShouldContainSubstring, "We're glad to have you on board!")
+		So(tpl.LinkInstructions, ShouldEqual, "Click to login")
+		So(tpl.Variables, ShouldHaveLength, 3)
+		So(tpl.Custom, ShouldBeFalse)
+
+		unknown := DefaultTemplate("Invite", "xx")
+		So(unknown.Subject, ShouldEqual, tpl.Subject)
+	})
+
+	Convey("Sample data completes template data", t, func() {
+		data := SampleData("Cell", map[string]string{"Cell": "Sales"})
+		So(data["Cell"], ShouldEqual, "Sales")
+		So(data["Inviter"], ShouldEqual, "John Doe")
+		So(TemplateIds(), ShouldContain, "PublicFolder")
+	})
+
+}
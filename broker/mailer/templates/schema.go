@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package templates
+
+import (
+	"sort"
+
+	"github.com/pydio/cells/common/proto/mailer"
+)
+
+// Variables lists the TplData keys expected by each template of the i18n bundle.
+// LinkPath or LinkUrl, when present, are used for the action button.
+var Variables = map[string][]*mailer.TemplateVariable{
+	"ResetPassword": {
+		{Name: "LinkPath", Description: "Path of the reset password page, including the token", Sample: "/user/reset-password/7b1e2f0c"},
+	},
+	"ResetPasswordDone": {},
+	"Digest":            {},
+	"Notification": {
+		{Name: "Title", Description: "Summary of the activity", Sample: "John Doe modified file report.docx"},
+	},
+	"Welcome": {
+		{Name: "Login", Description: "Login of the new account", Sample: "jdoe"},
+		{Name: "Password", Description: "Initial password, if sent by mail", Sample: "P@ssw0rd"},
+	},
+	"Invite": {
+		{Name: "Inviter", Description: "Display name of the user who created the account", Sample: "John Doe"},
+		{Name: "Login", Description: "Login of the new account", Sample: "jdoe"},
+		{Name: "Password", Description: "Initial password, if sent by mail", Sample: "P@ssw0rd"},
+	},
+	"Cell": {
+		{Name: "Inviter", Description: "Display name of the user who shared the cell", Sample: "John Doe"},
+		{Name: "Cell", Description: "Label of the cell", Sample: "Marketing"},
+		{Name: "LinkPath", Description: "Path of the cell", Sample: "/ws-marketing/"},
+	},
+	"PublicFile": {
+		{Name: "Inviter", Description: "Display name of the user who shared the file", Sample: "John Doe"},
+		{Name: "FileName", Description: "Name of the shared file", Sample: "report.docx"},
+		{Name: "Message", Description: "Message typed by the sharer", Sample: "Please have a look"},
+		{Name: "Expire", Description: "Expiration date of the link", Sample: "2019-12-31"},
+		{Name: "MaxDownloads", Description: "Maximum number of downloads", Sample: "10"},
+		{Name: "LinkUrl", Description: "Public link", Sample: "https://example.com/public/7b1e2f0c"},
+	},
+	"PublicFolder": {
+		{Name: "Inviter", Description: "Display name of the user who shared the folder", Sample: "John Doe"},
+		{Name: "FolderName", Description: "Name of the shared folder", Sample: "Reports"},
+		{Name: "Message", Description: "Message typed by the sharer", Sample: "Please have a look"},
+		{Name: "Expire", Description: "Expiration date of the link", Sample: "2019-12-31"},
+		{Name: "MaxDownloads", Description: "Maximum number of downloads", Sample: "10"},
+		{Name: "LinkUrl", Description: "Public link", Sample: "https://example.com/public/7b1e2f0c"},
+	},
+}
+
+// TemplateIds returns the sorted list of known template Ids.
+func TemplateIds() (ids []string) {
+	for id := range Variables {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return
+}
+
+// SampleData completes data with the sample values of the template variables.
+func SampleData(templateId string, data map[string]string) map[string]string {
+	out := make(map[string]string, len(data))
+	for _, v := range Variables[templateId] {
+		out[v.Name] = v.Sample
+	}
+	for k, v := range data {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package templates
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/proto/docstore"
+	"github.com/pydio/cells/common/proto/mailer"
+	"github.com/pydio/cells/common/service/defaults"
+)
+
+const (
+	// DocStoreID is the docstore where custom template variants are saved
+	DocStoreID = "mailerTemplates"
+)
+
+// LoadOverride finds the stored variant of a template for the first matching language,
+// or the variant applying to all languages. It returns nil if there is none.
+func LoadOverride(ctx context.Context, templateId string, languages ...string) *mailer.MailTemplate {
+	cli := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	for _, l := range append(languages, "") {
+		resp, e := cli.GetDocument(ctx, &docstore.GetDocumentRequest{StoreID: DocStoreID, DocumentID: overrideDocId(templateId, l)})
+		if e != nil || resp.Document == nil {
+			continue
+		}
+		t := &mailer.MailTemplate{}
+		if er := jsonpb.UnmarshalString(resp.Document.Data, t); er == nil {
+			return t
+		}
+	}
+	return nil
+}
+
+// ListOverrides lists all stored template variants.
+func ListOverrides(ctx context.Context) ([]*mailer.MailTemplate, error) {
+	cli := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	stream, e := cli.ListDocuments(ctx, &docstore.ListDocumentsRequest{
+		StoreID: DocStoreID,
+		Query:   &docstore.DocumentQuery{},
+	})
+	if e != nil {
+		return nil, e
+	}
+	defer stream.Close()
+	var tt []*mailer.MailTemplate
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			break
+		}
+		if resp == nil || resp.Document == nil {
+			continue
+		}
+		t := &mailer.MailTemplate{}
+		if er := jsonpb.UnmarshalString(resp.Document.Data, t); er == nil {
+			tt = append(tt, t)
+		}
+	}
+	return tt, nil
+}
+
+// StoreOverride saves a template variant, replacing the existing one for the same language.
+func StoreOverride(ctx context.Context, t *mailer.MailTemplate) error {
+	t.Language = strings.ToLower(t.Language)
+	t.Variables = nil
+	t.Custom = true
+	data, e := (&jsonpb.Marshaler{}).MarshalToString(t)
+	if e != nil {
+		return e
+	}
+	cli := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	docId := overrideDocId(t.TemplateId, t.Language)
+	_, e = cli.PutDocument(ctx, &docstore.PutDocumentRequest{
+		StoreID:    DocStoreID,
+		DocumentID: docId,
+		Document: &docstore.Document{
+			ID:    docId,
+			Type:  docstore.DocumentType_JSON,
+			Owner: common.PYDIO_SYSTEM_USERNAME,
+			Data:  data,
+		},
+	})
+	return e
+}
+
+// DeleteOverride removes a template variant.
+func DeleteOverride(ctx context.Context, templateId string, language string) error {
+	cli := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	_, e := cli.DeleteDocuments(ctx, &docstore.DeleteDocumentsRequest{StoreID: DocStoreID, DocumentID: overrideDocId(templateId, language)})
+	return e
+}
+
+func overrideDocId(templateId string, language string) string {
+	if language == "" {
+		return templateId
+	}
+	return templateId + "." + strings.ToLower(language)
+}
@@ -48,21 +48,30 @@ func GetHermes(languages ...string) hermes.Hermes {
 
 }
 
+// BuildTemplateWithId builds the subject and body of a mail from the i18n strings of the given template.
 func BuildTemplateWithId(user *mailer.User, templateId string, templateData map[string]string, languages ...string) (subject string, body hermes.Body) {
+	subject, body, _, _ = BuildTemplateWithOverride(user, templateId, templateData, nil, languages...)
+	return
+}
+
+// BuildTemplateWithOverride builds the subject and body of a mail like BuildTemplateWithId, replacing
+// the i18n strings by the non-empty fields of override. If override provides a ContentHtml, it is rendered
+// and returned as html, to be used instead of the hermes layout.
+func BuildTemplateWithOverride(user *mailer.User, templateId string, templateData map[string]string, override *mailer.MailTemplate, languages ...string) (subject string, body hermes.Body, html string, err error) {
 
 	T := lang.Bundle().GetTranslationFunc(languages...)
 	configs := GetApplicationConfig(languages...)
 	var intros, outros []string
 	var actions []hermes.Action
+	var markdown string
 	if templateData == nil {
 		templateData = map[string]string{}
 	}
+	if override == nil {
+		override = &mailer.MailTemplate{}
+	}
 
-	i18nTemplateData := struct {
-		TplData map[string]string
-		User    *mailer.User
-		Configs ApplicationConfigs
-	}{
+	i18nTemplateData := TemplateContext{
 		TplData: templateData,
 		User:    user,
 		Configs: configs,
@@ -72,16 +81,30 @@ func BuildTemplateWithId(user *mailer.User, templateId string, templateData map[
 	// If T function returns the ID, the string is not present.
 	introId := fmt.Sprintf("Mail.%s.Intros", templateId)
 	outroId := fmt.Sprintf("Mail.%s.Outros", templateId)
-	if T(introId) != introId {
-		intros = append(intros, T(introId, i18nTemplateData))
-	}
-	if T(outroId) != outroId {
-		outros = append(outros, T(outroId, i18nTemplateData))
+	if override.ContentMarkdown != "" {
+		if markdown, err = executeText(override.ContentMarkdown, i18nTemplateData); err != nil {
+			return
+		}
+	} else {
+		if T(introId) != introId {
+			intros = append(intros, T(introId, i18nTemplateData))
+		}
+		if T(outroId) != outroId {
+			outros = append(outros, T(outroId, i18nTemplateData))
+		}
 	}
 
 	// Init button with link if needed
 	actionLabelId := fmt.Sprintf("Mail.%s.LinkLabel", templateId)
-	if T(actionLabelId) != actionLabelId {
+	var actionLabel string
+	if override.LinkLabel != "" {
+		if actionLabel, err = executeText(override.LinkLabel, i18nTemplateData); err != nil {
+			return
+		}
+	} else if T(actionLabelId) != actionLabelId {
+		actionLabel = T(actionLabelId, i18nTemplateData)
+	}
+	if actionLabel != "" {
 		var link string
 		if linkPath, has := templateData["LinkPath"]; has {
 			link = fmt.Sprintf("%s%s", configs.Url, linkPath)
@@ -92,13 +115,17 @@ func BuildTemplateWithId(user *mailer.User, templateId string, templateData map[
 		}
 		instructions := ""
 		actionInstructionId := fmt.Sprintf("Mail.%s.LinkInstructions", templateId)
-		if T(actionInstructionId) != actionInstructionId {
+		if override.LinkInstructions != "" {
+			if instructions, err = executeText(override.LinkInstructions, i18nTemplateData); err != nil {
+				return
+			}
+		} else if T(actionInstructionId) != actionInstructionId {
 			instructions = T(actionInstructionId, i18nTemplateData)
 		}
 		actions = append(actions, hermes.Action{
 			Button: hermes.Button{
 				Link:  link,
-				Text:  T(actionLabelId, i18nTemplateData),
+				Text:  actionLabel,
 				Color: configs.ButtonsColor,
 			},
 			Instructions: instructions,
@@ -106,15 +133,26 @@ func BuildTemplateWithId(user *mailer.User, templateId string, templateData map[
 	}
 
 	body = hermes.Body{
-		Name:      user.Name,
-		Greeting:  configs.Greeting,
-		Signature: configs.Signature,
-		Intros:    intros,
-		Outros:    outros,
-		Actions:   actions,
+		Name:         user.Name,
+		Greeting:     configs.Greeting,
+		Signature:    configs.Signature,
+		Intros:       intros,
+		Outros:       outros,
+		Actions:      actions,
+		FreeMarkdown: hermes.Markdown(markdown),
 	}
 
-	subject = T(fmt.Sprintf("Mail.%s.Subject", templateId), i18nTemplateData)
+	if override.Subject != "" {
+		if subject, err = executeText(override.Subject, i18nTemplateData); err != nil {
+			return
+		}
+	} else {
+		subject = T(fmt.Sprintf("Mail.%s.Subject", templateId), i18nTemplateData)
+	}
+
+	if override.ContentHtml != "" {
+		html, err = executeHtml(override.ContentHtml, i18nTemplateData)
+	}
 
 	return
 
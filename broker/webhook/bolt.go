@@ -0,0 +1,361 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package webhook
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/boltdb/bolt"
+
+	"github.com/pydio/cells/common/proto/webhook"
+)
+
+var (
+	// ErrWebhookNotFound is returned when loading an unknown webhook
+	ErrWebhookNotFound = errors.New("webhook not found")
+
+	bucketWebhooks = []byte("webhooks")
+	bucketQueue    = []byte("queue")
+	bucketLog      = []byte("log")
+	bucketDead     = []byte("dead")
+)
+
+// Store persists webhooks and their deliveries in a Bolt database.
+// Buckets are structured like this:
+//
+//	webhooks
+//	  -> WEBHOOK_ID [webhook definition]
+//	queue
+//	  -> DELIVERY_ID [pending deliveries, in any webhook]
+//	log
+//	  -> WEBHOOK_ID
+//	     -> SEQ [last known state of each delivery, pruned to LogSize entries]
+//	dead
+//	  -> WEBHOOK_ID
+//	     -> SEQ [deliveries that exhausted their attempts]
+type Store struct {
+	db *bolt.DB
+	// LogSize is the maximum number of deliveries kept in the log of each webhook
+	LogSize int
+}
+
+// NewStore opens or creates the Bolt database at the given path.
+func NewStore(fileName string) (*Store, error) {
+	db, err := bolt.Open(fileName, 0644, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, b := range [][]byte{bucketWebhooks, bucketQueue, bucketLog, bucketDead} {
+			if _, e := tx.CreateBucketIfNotExists(b); e != nil {
+				return e
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &Store{db: db, LogSize: 1000}, nil
+}
+
+// Close closes the underlying database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// PutWebhook creates or replaces a webhook.
+func (s *Store) PutWebhook(w *webhook.Webhook) error {
+	data, err := json.Marshal(w)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketWebhooks).Put([]byte(w.Uuid), data)
+	})
+}
+
+// GetWebhook loads a webhook, or returns ErrWebhookNotFound.
+func (s *Store) GetWebhook(uuid string) (*webhook.Webhook, error) {
+	var w *webhook.Webhook
+	err := s.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(bucketWebhooks).Get([]byte(uuid))
+		if data == nil {
+			return ErrWebhookNotFound
+		}
+		w = &webhook.Webhook{}
+		return json.Unmarshal(data, w)
+	})
+	return w, err
+}
+
+// ListWebhooks returns all webhooks, sorted by creation date.
+func (s *Store) ListWebhooks() (webhooks []*webhook.Webhook, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketWebhooks).ForEach(func(k, v []byte) error {
+			w := &webhook.Webhook{}
+			if e := json.Unmarshal(v, w); e != nil {
+				return e
+			}
+			webhooks = append(webhooks, w)
+			return nil
+		})
+	})
+	sort.Slice(webhooks, func(i, j int) bool {
+		return webhooks[i].CreatedAt < webhooks[j].CreatedAt
+	})
+	return
+}
+
+// DeleteWebhook removes a webhook, its queued deliveries, its log and its dead letters.
+func (s *Store) DeleteWebhook(uuid string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		key := []byte(uuid)
+		if tx.Bucket(bucketWebhooks).Get(key) == nil {
+			return ErrWebhookNotFound
+		}
+		if err := tx.Bucket(bucketWebhooks).Delete(key); err != nil {
+			return err
+		}
+		for _, b := range [][]byte{bucketLog, bucketDead} {
+			if tx.Bucket(b).Bucket(key) != nil {
+				if err := tx.Bucket(b).DeleteBucket(key); err != nil {
+					return err
+				}
+			}
+		}
+		queue := tx.Bucket(bucketQueue)
+		var queued [][]byte
+		queue.ForEach(func(k, v []byte) error {
+			d := &webhook.Delivery{}
+			if json.Unmarshal(v, d) == nil && d.WebhookUuid == uuid {
+				queued = append(queued, k)
+			}
+			return nil
+		})
+		for _, k := range queued {
+			if err := queue.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Enqueue adds a new delivery to the queue and to the webhook log, pruning the oldest log entries.
+func (s *Store) Enqueue(d *webhook.Delivery) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		log, err := tx.Bucket(bucketLog).CreateBucketIfNotExists([]byte(d.WebhookUuid))
+		if err != nil {
+			return err
+		}
+		if d.Seq, err = log.NextSequence(); err != nil {
+			return err
+		}
+		data, err := json.Marshal(d)
+		if err != nil {
+			return err
+		}
+		if err := log.Put(seqKey(d.Seq), data); err != nil {
+			return err
+		}
+		if err := tx.Bucket(bucketQueue).Put([]byte(d.Uuid), data); err != nil {
+			return err
+		}
+		// Entries are only removed from the head, so the log holds a contiguous range of sequences
+		if s.LogSize <= 0 {
+			return nil
+		}
+		c := log.Cursor()
+		for k, _ := c.First(); k != nil && d.Seq-binary.BigEndian.Uint64(k) >= uint64(s.LogSize); k, _ = c.First() {
+			if err := log.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Update records the outcome of a delivery attempt. Pending and retrying deliveries stay in the queue,
+// successful ones leave it and dead ones are moved to the dead letters.
+func (s *Store) Update(d *webhook.Delivery) error {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		key := []byte(d.Uuid)
+		queue := tx.Bucket(bucketQueue)
+		if queue.Get(key) == nil {
+			// Webhook was deleted in the meantime
+			return nil
+		}
+		if log := tx.Bucket(bucketLog).Bucket([]byte(d.WebhookUuid)); log != nil && log.Get(seqKey(d.Seq)) != nil {
+			if err := log.Put(seqKey(d.Seq), data); err != nil {
+				return err
+			}
+		}
+		switch d.Status {
+		case webhook.DeliveryStatus_PENDING, webhook.DeliveryStatus_RETRYING:
+			return queue.Put(key, data)
+		case webhook.DeliveryStatus_DEAD:
+			dead, err := tx.Bucket(bucketDead).CreateBucketIfNotExists([]byte(d.WebhookUuid))
+			if err != nil {
+				return err
+			}
+			if err := dead.Put(seqKey(d.Seq), data); err != nil {
+				return err
+			}
+		}
+		return queue.Delete(key)
+	})
+}
+
+// Due returns at most limit queued deliveries whose next attempt is before now,
+// and the time of the next attempt among the remaining ones (zero if none).
+func (s *Store) Due(now time.Time, limit int) (due []*webhook.Delivery, next time.Time, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketQueue).ForEach(func(k, v []byte) error {
+			d := &webhook.Delivery{}
+			if e := json.Unmarshal(v, d); e != nil {
+				return e
+			}
+			at := time.Unix(int64(d.NextAttemptAt), 0)
+			if !at.After(now) && len(due) < limit {
+				due = append(due, d)
+			} else if next.IsZero() || at.Before(next) {
+				next = at
+			}
+			return nil
+		})
+	})
+	return
+}
+
+// ListDeliveries lists the log or the dead letters of a webhook, most recent first,
+// optionally filtered by status. It returns the total number of matching deliveries.
+func (s *Store) ListDeliveries(webhookUuid string, dead bool, status []webhook.DeliveryStatus, offset, limit int) (deliveries []*webhook.Delivery, total int, err error) {
+	root := bucketLog
+	if dead {
+		root = bucketDead
+	}
+	statuses := make(map[webhook.DeliveryStatus]bool, len(status))
+	for _, st := range status {
+		statuses[st] = true
+	}
+	err = s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(root).Bucket([]byte(webhookUuid))
+		if b == nil {
+			return nil
+		}
+		c := b.Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			d := &webhook.Delivery{}
+			if e := json.Unmarshal(v, d); e != nil {
+				return e
+			}
+			if len(statuses) > 0 && !statuses[d.Status] {
+				continue
+			}
+			if total >= offset && (limit <= 0 || len(deliveries) < limit) {
+				deliveries = append(deliveries, d)
+			}
+			total++
+		}
+		return nil
+	})
+	return
+}
+
+// Redeliver moves dead letters back to the queue for an immediate attempt, with a fresh attempts count.
+// An empty uuids list redelivers all dead letters of the webhook.
+func (s *Store) Redeliver(webhookUuid string, uuids []string, now time.Time) (count int, err error) {
+	err = s.eachDeadLetter(webhookUuid, uuids, func(tx *bolt.Tx, d *webhook.Delivery) error {
+		d.Status = webhook.DeliveryStatus_PENDING
+		d.Attempts = 0
+		d.NextAttemptAt = int32(now.Unix())
+		data, err := json.Marshal(d)
+		if err != nil {
+			return err
+		}
+		if log := tx.Bucket(bucketLog).Bucket([]byte(webhookUuid)); log != nil && log.Get(seqKey(d.Seq)) != nil {
+			if err := log.Put(seqKey(d.Seq), data); err != nil {
+				return err
+			}
+		}
+		count++
+		return tx.Bucket(bucketQueue).Put([]byte(d.Uuid), data)
+	})
+	return
+}
+
+// PurgeDeadLetters deletes dead letters of a webhook. An empty uuids list deletes them all.
+func (s *Store) PurgeDeadLetters(webhookUuid string, uuids []string) (count int, err error) {
+	err = s.eachDeadLetter(webhookUuid, uuids, func(tx *bolt.Tx, d *webhook.Delivery) error {
+		count++
+		return nil
+	})
+	return
+}
+
+// eachDeadLetter removes the selected dead letters of a webhook and passes them to f in the same transaction.
+func (s *Store) eachDeadLetter(webhookUuid string, uuids []string, f func(tx *bolt.Tx, d *webhook.Delivery) error) error {
+	selected := make(map[string]bool, len(uuids))
+	for _, u := range uuids {
+		selected[u] = true
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		dead := tx.Bucket(bucketDead).Bucket([]byte(webhookUuid))
+		if dead == nil {
+			return nil
+		}
+		var keys [][]byte
+		var letters []*webhook.Delivery
+		dead.ForEach(func(k, v []byte) error {
+			d := &webhook.Delivery{}
+			if json.Unmarshal(v, d) == nil && (len(selected) == 0 || selected[d.Uuid]) {
+				keys = append(keys, k)
+				letters = append(letters, d)
+			}
+			return nil
+		})
+		for i, k := range keys {
+			if err := dead.Delete(k); err != nil {
+				return err
+			}
+			if err := f(tx, letters[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func seqKey(seq uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, seq)
+	return b
+}
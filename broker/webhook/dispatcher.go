@@ -0,0 +1,287 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/pborman/uuid"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/webhook"
+)
+
+var (
+	// RetryDelay is the delay before the second attempt of a delivery. It doubles on each failure up to MaxRetryDelay.
+	RetryDelay = 30 * time.Second
+	// MaxRetryDelay caps the delay between two attempts of a delivery
+	MaxRetryDelay = 1 * time.Hour
+	// DefaultMaxAttempts is used for webhooks that do not define their own MaxAttempts
+	DefaultMaxAttempts = 8
+	// Timeout is the maximum duration of one delivery attempt
+	Timeout = 10 * time.Second
+	// Concurrency is the number of deliveries attempted in parallel
+	Concurrency = 4
+)
+
+// Payload is the JSON document POSTed to webhooks.
+type Payload struct {
+	// Id is the delivery Uuid, to detect duplicates on the receiver side
+	Id      string
+	Type    string
+	Time    time.Time
+	Webhook string
+	// User is the login of the user who triggered the event, if known
+	User string `json:",omitempty"`
+	// Workspace is the Uuid of the workspace through which a tree event was matched
+	Workspace string `json:",omitempty"`
+	// Event is the original event, serialized with the protobuf JSON mapping
+	Event json.RawMessage
+}
+
+// Dispatcher queues payloads for matching webhooks and delivers them in background.
+type Dispatcher struct {
+	store  *Store
+	client *http.Client
+	ctx    context.Context
+
+	notify chan struct{}
+	stop   chan struct{}
+	done   chan struct{}
+	slots  chan struct{}
+	wg     sync.WaitGroup
+
+	mu       sync.Mutex
+	inflight map[string]bool
+}
+
+// NewDispatcher creates a dispatcher reading deliveries from the store. Call Start to begin delivering.
+func NewDispatcher(ctx context.Context, store *Store) *Dispatcher {
+	return &Dispatcher{
+		store:    store,
+		client:   &http.Client{Timeout: Timeout},
+		ctx:      ctx,
+		notify:   make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+		slots:    make(chan struct{}, Concurrency),
+		inflight: make(map[string]bool),
+	}
+}
+
+// Start delivers queued payloads in background, including the ones left over by a previous run.
+func (d *Dispatcher) Start() {
+	go d.run()
+}
+
+// Stop waits for running attempts and stops delivering. Queued deliveries are kept in the store.
+func (d *Dispatcher) Stop() {
+	close(d.stop)
+	<-d.done
+	d.wg.Wait()
+}
+
+// Dispatch serializes the event and queues it for delivery to the webhook.
+func (d *Dispatcher) Dispatch(w *webhook.Webhook, eventType, user, workspace string, event proto.Message) (*webhook.Delivery, error) {
+	marshaler := &jsonpb.Marshaler{}
+	ev, err := marshaler.MarshalToString(event)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	delivery := &webhook.Delivery{
+		Uuid:          uuid.New(),
+		WebhookUuid:   w.Uuid,
+		EventType:     eventType,
+		Status:        webhook.DeliveryStatus_PENDING,
+		CreatedAt:     int32(now.Unix()),
+		NextAttemptAt: int32(now.Unix()),
+	}
+	payload, err := json.Marshal(&Payload{
+		Id:        delivery.Uuid,
+		Type:      eventType,
+		Time:      now.UTC(),
+		Webhook:   w.Uuid,
+		User:      user,
+		Workspace: workspace,
+		Event:     json.RawMessage(ev),
+	})
+	if err != nil {
+		return nil, err
+	}
+	delivery.Payload = string(payload)
+	if err := d.store.Enqueue(delivery); err != nil {
+		return nil, err
+	}
+	d.Wake()
+	return delivery, nil
+}
+
+// Wake triggers a delivery round, typically after deliveries were queued directly in the store.
+func (d *Dispatcher) Wake() {
+	select {
+	case d.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (d *Dispatcher) run() {
+	defer close(d.done)
+	for {
+		due, next, err := d.store.Due(time.Now(), Concurrency*4)
+		if err != nil {
+			log.Logger(d.ctx).Error("cannot read webhook deliveries queue", zap.Error(err))
+		}
+		for _, delivery := range due {
+			if !d.acquire(delivery.Uuid) {
+				continue
+			}
+			select {
+			case d.slots <- struct{}{}:
+			case <-d.stop:
+				d.release(delivery.Uuid)
+				return
+			}
+			d.wg.Add(1)
+			go func(delivery *webhook.Delivery) {
+				defer d.wg.Done()
+				d.attempt(delivery)
+				<-d.slots
+				d.release(delivery.Uuid)
+				d.Wake()
+			}(delivery)
+		}
+		wait := time.Minute
+		if !next.IsZero() && time.Until(next) < wait {
+			wait = time.Until(next)
+		}
+		if wait < 100*time.Millisecond {
+			wait = 100 * time.Millisecond
+		}
+		select {
+		case <-d.notify:
+		case <-time.After(wait):
+		case <-d.stop:
+			return
+		}
+	}
+}
+
+func (d *Dispatcher) acquire(id string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.inflight[id] {
+		return false
+	}
+	d.inflight[id] = true
+	return true
+}
+
+func (d *Dispatcher) release(id string) {
+	d.mu.Lock()
+	delete(d.inflight, id)
+	d.mu.Unlock()
+}
+
+// attempt posts the payload once and records the outcome in the store.
+func (d *Dispatcher) attempt(delivery *webhook.Delivery) {
+	w, err := d.store.GetWebhook(delivery.WebhookUuid)
+	if err != nil {
+		return
+	}
+	start := time.Now()
+	code, err := d.post(w, delivery)
+	delivery.Attempts++
+	delivery.LastAttemptAt = int32(start.Unix())
+	delivery.Duration = int64(time.Since(start) / time.Millisecond)
+	delivery.LastStatusCode = int32(code)
+	delivery.LastError = ""
+	maxAttempts := int32(DefaultMaxAttempts)
+	if w.MaxAttempts > 0 {
+		maxAttempts = w.MaxAttempts
+	}
+	if err == nil {
+		delivery.Status = webhook.DeliveryStatus_SUCCESS
+	} else {
+		delivery.LastError = err.Error()
+		if delivery.Attempts >= maxAttempts {
+			delivery.Status = webhook.DeliveryStatus_DEAD
+			log.Logger(d.ctx).Warn("webhook delivery moved to dead letters", zap.String("webhook", w.Label), zap.String("delivery", delivery.Uuid), zap.Error(err))
+		} else {
+			delivery.Status = webhook.DeliveryStatus_RETRYING
+			delivery.NextAttemptAt = int32(time.Now().Add(Backoff(int(delivery.Attempts))).Unix())
+		}
+	}
+	if e := d.store.Update(delivery); e != nil {
+		log.Logger(d.ctx).Error("cannot record webhook delivery", zap.String("delivery", delivery.Uuid), zap.Error(e))
+	}
+}
+
+// post sends the signed payload and returns the HTTP status code. Any non-2xx status is an error.
+func (d *Dispatcher) post(w *webhook.Webhook, delivery *webhook.Delivery) (int, error) {
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequest(http.MethodPost, w.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	ts := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Pydio-Cells-Webhook")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, delivery.Uuid)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(ts, 10))
+	req.Header.Set(HeaderSignature, "sha256="+Sign(w.Secret, ts, body))
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return resp.StatusCode, fmt.Errorf("unexpected status %s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 64*1024))
+	return resp.StatusCode, nil
+}
+
+// Backoff returns the delay before the next attempt, after the given number of failed attempts.
+func Backoff(attempts int) time.Duration {
+	delay := RetryDelay
+	for i := 1; i < attempts && delay < MaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > MaxRetryDelay {
+		delay = MaxRetryDelay
+	}
+	return delay
+}
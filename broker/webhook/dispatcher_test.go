@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/proto/webhook"
+)
+
+func init() {
+	RetryDelay = 10 * time.Millisecond
+	MaxRetryDelay = 20 * time.Millisecond
+}
+
+func newTestStore() (*Store, func()) {
+	dir, _ := ioutil.TempDir("", "webhooks")
+	s, err := NewStore(filepath.Join(dir, "webhooks.db"))
+	So(err, ShouldBeNil)
+	return s, func() {
+		s.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+// waitDeliveries polls the webhook log or dead letters until count deliveries have the expected status.
+func waitDeliveries(s *Store, webhookUuid string, dead bool, status webhook.DeliveryStatus, count int) []*webhook.Delivery {
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		if dd, total, _ := s.ListDeliveries(webhookUuid, dead, []webhook.DeliveryStatus{status}, 0, 0); total >= count {
+			return dd
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	return nil
+}
+
+func TestStore(t *testing.T) {
+
+	Convey("Test webhooks CRUD", t, func() {
+		s, cleanup := newTestStore()
+		defer cleanup()
+
+		So(s.PutWebhook(&webhook.Webhook{Uuid: "w2", Label: "Second", CreatedAt: 2}), ShouldBeNil)
+		So(s.PutWebhook(&webhook.Webhook{Uuid: "w1", Label: "First", CreatedAt: 1}), ShouldBeNil)
+		w, err := s.GetWebhook("w2")
+		So(err, ShouldBeNil)
+		So(w.Label, ShouldEqual, "Second")
+		ww, err := s.ListWebhooks()
+		So(err, ShouldBeNil)
+		So(ww, ShouldHaveLength, 2)
+		So(ww[0].Uuid, ShouldEqual, "w1")
+
+		So(s.Enqueue(&webhook.Delivery{Uuid: "d1", WebhookUuid: "w2"}), ShouldBeNil)
+		So(s.DeleteWebhook("w2"), ShouldBeNil)
+		_, err = s.GetWebhook("w2")
+		So(err, ShouldEqual, ErrWebhookNotFound)
+		So(s.DeleteWebhook("w2"), ShouldEqual, ErrWebhookNotFound)
+		due, _, _ := s.Due(time.Now(), 10)
+		So(due, ShouldBeEmpty)
+	})
+
+	Convey("Test delivery log is pruned", t, func() {
+		s, cleanup := newTestStore()
+		defer cleanup()
+		s.LogSize = 3
+
+		for i := 0; i < 5; i++ {
+			So(s.Enqueue(&webhook.Delivery{Uuid: "d" + strconv.Itoa(i), WebhookUuid: "w1"}), ShouldBeNil)
+		}
+		dd, total, err := s.ListDeliveries("w1", false, nil, 0, 0)
+		So(err, ShouldBeNil)
+		So(total, ShouldEqual, 3)
+		So(dd[0].Uuid, ShouldEqual, "d4")
+		So(dd[2].Uuid, ShouldEqual, "d2")
+
+		dd, total, _ = s.ListDeliveries("w1", false, nil, 1, 1)
+		So(total, ShouldEqual, 3)
+		So(dd, ShouldHaveLength, 1)
+		So(dd[0].Uuid, ShouldEqual, "d3")
+
+		// Pruned deliveries are still delivered
+		due, _, _ := s.Due(time.Now(), 10)
+		So(due, ShouldHaveLength, 5)
+	})
+
+}
+
+func TestDispatcher(t *testing.T) {
+
+	Convey("Test signed delivery", t, func() {
+		s, cleanup := newTestStore()
+		defer cleanup()
+
+		received := make(chan *http.Request, 1)
+		var body []byte
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ = ioutil.ReadAll(r.Body)
+			received <- r
+		}))
+		defer srv.Close()
+
+		w := &webhook.Webhook{Uuid: "w1", Url: srv.URL, Secret: "secret"}
+		So(s.PutWebhook(w), ShouldBeNil)
+		d := NewDispatcher(context.Background(), s)
+		d.Start()
+		defer d.Stop()
+
+		delivery, err := d.Dispatch(w, "tree:create", "admin", "ws1", &tree.NodeChangeEvent{Target: &tree.Node{Path: "common-files/invoice.pdf"}})
+		So(err, ShouldBeNil)
+
+		var r *http.Request
+		select {
+		case r = <-received:
+		case <-time.After(5 * time.Second):
+		}
+		So(r, ShouldNotBeNil)
+		So(r.Header.Get(HeaderEvent), ShouldEqual, "tree:create")
+		So(r.Header.Get(HeaderDelivery), ShouldEqual, delivery.Uuid)
+		ts, _ := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+		So(r.Header.Get(HeaderSignature), ShouldEqual, "sha256="+Sign("secret", ts, body))
+
+		var payload Payload
+		So(json.Unmarshal(body, &payload), ShouldBeNil)
+		So(payload.Id, ShouldEqual, delivery.Uuid)
+		So(payload.User, ShouldEqual, "admin")
+		So(payload.Workspace, ShouldEqual, "ws1")
+		So(string(payload.Event), ShouldContainSubstring, "common-files/invoice.pdf")
+
+		dd := waitDeliveries(s, "w1", false, webhook.DeliveryStatus_SUCCESS, 1)
+		So(dd, ShouldHaveLength, 1)
+		So(dd[0].Attempts, ShouldEqual, 1)
+		So(dd[0].LastStatusCode, ShouldEqual, 200)
+		due, _, _ := s.Due(time.Now(), 10)
+		So(due, ShouldBeEmpty)
+	})
+
+	Convey("Test retries, dead letters and redelivery", t, func() {
+		s, cleanup := newTestStore()
+		defer cleanup()
+
+		var fail int32 = 1
+		var calls int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			if atomic.LoadInt32(&fail) == 1 {
+				http.Error(w, "erp is down", http.StatusServiceUnavailable)
+			}
+		}))
+		defer srv.Close()
+
+		w := &webhook.Webhook{Uuid: "w1", Url: srv.URL, Secret: "secret", MaxAttempts: 2}
+		So(s.PutWebhook(w), ShouldBeNil)
+		d := NewDispatcher(context.Background(), s)
+		d.Start()
+		defer d.Stop()
+
+		_, err := d.Dispatch(w, "tree:create", "admin", "", &tree.NodeChangeEvent{Target: &tree.Node{Path: "invoice.pdf"}})
+		So(err, ShouldBeNil)
+
+		dead := waitDeliveries(s, "w1", true, webhook.DeliveryStatus_DEAD, 1)
+		So(dead, ShouldHaveLength, 1)
+		So(dead[0].Attempts, ShouldEqual, 2)
+		So(dead[0].LastStatusCode, ShouldEqual, 503)
+		So(dead[0].LastError, ShouldContainSubstring, "erp is down")
+		So(atomic.LoadInt32(&calls), ShouldEqual, 2)
+
+		atomic.StoreInt32(&fail, 0)
+		count, err := s.Redeliver("w1", nil, time.Now())
+		So(err, ShouldBeNil)
+		So(count, ShouldEqual, 1)
+		d.Wake()
+
+		dd := waitDeliveries(s, "w1", false, webhook.DeliveryStatus_SUCCESS, 1)
+		So(dd, ShouldHaveLength, 1)
+		So(dd[0].Uuid, ShouldEqual, dead[0].Uuid)
+		_, total, _ := s.ListDeliveries("w1", true, nil, 0, 0)
+		So(total, ShouldEqual, 0)
+
+		count, err = s.PurgeDeadLetters("w1", nil)
+		So(err, ShouldBeNil)
+		So(count, ShouldEqual, 0)
+	})
+
+	Convey("Test backoff", t, func() {
+		So(Backoff(1), ShouldEqual, RetryDelay)
+		So(Backoff(2), ShouldEqual, 2*RetryDelay)
+		So(Backoff(10), ShouldEqual, MaxRetryDelay)
+	})
+
+}
@@ -0,0 +1,155 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package grpc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"time"
+
+	"github.com/micro/go-micro/errors"
+	"github.com/pborman/uuid"
+
+	"github.com/pydio/cells/broker/webhook"
+	proto "github.com/pydio/cells/common/proto/webhook"
+)
+
+// Handler implements the WebhookService on top of the webhooks store.
+type Handler struct {
+	Store      *webhook.Store
+	Dispatcher *webhook.Dispatcher
+}
+
+// PutWebhook validates and stores a webhook. New webhooks without secret get a random one.
+func (h *Handler) PutWebhook(ctx context.Context, req *proto.PutWebhookRequest, resp *proto.PutWebhookResponse) error {
+	w := req.GetWebhook()
+	if w == nil {
+		return errors.BadRequest(Name, "missing webhook")
+	}
+	u, err := url.Parse(w.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.BadRequest(Name, "webhook url must be an absolute http(s) url")
+	}
+	if w.MaxAttempts < 0 {
+		return errors.BadRequest(Name, "webhook max attempts cannot be negative")
+	}
+	now := int32(time.Now().Unix())
+	if w.Uuid == "" {
+		w.Uuid = uuid.New()
+		w.CreatedAt = now
+	} else if existing, e := h.Store.GetWebhook(w.Uuid); e == nil {
+		w.CreatedAt = existing.CreatedAt
+		w.Owner = existing.Owner
+		if w.Secret == "" {
+			w.Secret = existing.Secret
+		}
+	} else if e != webhook.ErrWebhookNotFound {
+		return e
+	} else {
+		w.CreatedAt = now
+	}
+	if w.Secret == "" {
+		b := make([]byte, 32)
+		if _, e := rand.Read(b); e != nil {
+			return e
+		}
+		w.Secret = hex.EncodeToString(b)
+	}
+	w.UpdatedAt = now
+	if err := h.Store.PutWebhook(w); err != nil {
+		return err
+	}
+	resp.Webhook = w
+	return nil
+}
+
+// GetWebhook loads a webhook by its Uuid.
+func (h *Handler) GetWebhook(ctx context.Context, req *proto.GetWebhookRequest, resp *proto.GetWebhookResponse) error {
+	w, err := h.Store.GetWebhook(req.Uuid)
+	if err == webhook.ErrWebhookNotFound {
+		return errors.NotFound(Name, "webhook %s not found", req.Uuid)
+	} else if err != nil {
+		return err
+	}
+	resp.Webhook = w
+	return nil
+}
+
+// DeleteWebhook removes a webhook with its deliveries.
+func (h *Handler) DeleteWebhook(ctx context.Context, req *proto.DeleteWebhookRequest, resp *proto.DeleteWebhookResponse) error {
+	err := h.Store.DeleteWebhook(req.Uuid)
+	if err == webhook.ErrWebhookNotFound {
+		return errors.NotFound(Name, "webhook %s not found", req.Uuid)
+	} else if err != nil {
+		return err
+	}
+	resp.Success = true
+	return nil
+}
+
+// ListWebhooks lists all webhooks.
+func (h *Handler) ListWebhooks(ctx context.Context, req *proto.ListWebhooksRequest, resp *proto.ListWebhooksResponse) error {
+	webhooks, err := h.Store.ListWebhooks()
+	if err != nil {
+		return err
+	}
+	resp.Webhooks = webhooks
+	return nil
+}
+
+// ListDeliveries lists the delivery log or the dead letters of a webhook.
+func (h *Handler) ListDeliveries(ctx context.Context, req *proto.ListDeliveriesRequest, resp *proto.ListDeliveriesResponse) error {
+	if _, err := h.Store.GetWebhook(req.WebhookUuid); err == webhook.ErrWebhookNotFound {
+		return errors.NotFound(Name, "webhook %s not found", req.WebhookUuid)
+	}
+	deliveries, total, err := h.Store.ListDeliveries(req.WebhookUuid, req.DeadLetters, req.Status, int(req.Offset), int(req.Limit))
+	if err != nil {
+		return err
+	}
+	resp.Deliveries = deliveries
+	resp.Total = int32(total)
+	return nil
+}
+
+// Redeliver queues dead letters again and wakes up the dispatcher.
+func (h *Handler) Redeliver(ctx context.Context, req *proto.RedeliverRequest, resp *proto.RedeliverResponse) error {
+	count, err := h.Store.Redeliver(req.WebhookUuid, req.DeliveryUuids, time.Now())
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		h.Dispatcher.Wake()
+	}
+	resp.Count = int32(count)
+	return nil
+}
+
+// PurgeDeadLetters deletes dead letters of a webhook.
+func (h *Handler) PurgeDeadLetters(ctx context.Context, req *proto.PurgeDeadLettersRequest, resp *proto.PurgeDeadLettersResponse) error {
+	count, err := h.Store.PurgeDeadLetters(req.WebhookUuid, req.DeliveryUuids)
+	if err != nil {
+		return err
+	}
+	resp.Count = int32(count)
+	return nil
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package grpc is the webhooks service.
+//
+// It stores the webhooks registered by administrators, listens to tree, IDM and jobs events
+// and delivers the matching ones to the webhooks URLs.
+package grpc
+
+import (
+	"path/filepath"
+
+	"github.com/micro/go-micro"
+
+	"github.com/pydio/cells/broker/webhook"
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/config"
+	proto "github.com/pydio/cells/common/proto/webhook"
+	"github.com/pydio/cells/common/service"
+	"github.com/pydio/cells/common/views"
+)
+
+var (
+	// Name is the identifier of this service
+	Name = common.SERVICE_GRPC_NAMESPACE_ + common.SERVICE_WEBHOOK
+)
+
+func init() {
+	service.NewService(
+		service.Name(Name),
+		service.Tag(common.SERVICE_TAG_BROKER),
+		service.Description("Webhooks Service is delivering application events to external endpoints"),
+		service.Dependency(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_TREE, []string{}),
+		service.Dependency(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_WORKSPACE, []string{}),
+		service.WithMicro(func(m micro.Service) error {
+			ctx := m.Options().Context
+			serviceDir, e := config.ServiceDataDir(Name)
+			if e != nil {
+				return e
+			}
+			store, err := webhook.NewStore(filepath.Join(serviceDir, "webhooks.db"))
+			if err != nil {
+				return err
+			}
+			store.LogSize = config.Get("services", Name, "deliveryLogSize").Int(store.LogSize)
+			dispatcher := webhook.NewDispatcher(ctx, store)
+
+			subscriber := NewEventsSubscriber(store, dispatcher, views.NewRouterEventFilter(views.RouterOptions{WatchRegistry: true}))
+			srv := m.Options().Server
+			if err := srv.Subscribe(srv.NewSubscriber(common.TOPIC_TREE_CHANGES, subscriber.HandleTreeEvent)); err != nil {
+				return err
+			}
+			if err := srv.Subscribe(srv.NewSubscriber(common.TOPIC_IDM_EVENT, subscriber.HandleIdmEvent)); err != nil {
+				return err
+			}
+			if err := srv.Subscribe(srv.NewSubscriber(common.TOPIC_JOB_TASK_EVENT, subscriber.HandleTaskEvent)); err != nil {
+				return err
+			}
+
+			proto.RegisterWebhookServiceHandler(srv, &Handler{Store: store, Dispatcher: dispatcher})
+
+			m.Init(
+				micro.AfterStart(func() error {
+					dispatcher.Start()
+					return nil
+				}),
+				micro.BeforeStop(func() error {
+					dispatcher.Stop()
+					return store.Close()
+				}),
+			)
+
+			return nil
+		}),
+	)
+}
@@ -0,0 +1,291 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/micro/go-micro/metadata"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/broker/webhook"
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/cells/common/proto/tree"
+	proto2 "github.com/pydio/cells/common/proto/webhook"
+	"github.com/pydio/cells/common/service/defaults"
+	service "github.com/pydio/cells/common/service/proto"
+	"github.com/pydio/cells/common/utils"
+)
+
+var (
+	// WorkspaceCacheTTL is the delay after which workspaces roots are reloaded
+	WorkspaceCacheTTL = 1 * time.Minute
+)
+
+// NodeRouter computes how a node is seen from inside a workspace, see views.RouterEventFilter.
+type NodeRouter interface {
+	WorkspaceCanSeeNode(ctx context.Context, workspace *idm.Workspace, node *tree.Node, refresh bool) (*tree.Node, bool)
+}
+
+type cachedWorkspace struct {
+	ws     *idm.Workspace
+	loaded time.Time
+}
+
+// EventsSubscriber matches application events against the registered webhooks and queues the deliveries.
+type EventsSubscriber struct {
+	Store      *webhook.Store
+	Dispatcher *webhook.Dispatcher
+	Router     NodeRouter
+
+	sync.Mutex
+	workspaces map[string]cachedWorkspace
+	tasks      map[string]jobs.TaskStatus
+}
+
+// NewEventsSubscriber creates a subscriber resolving workspace visibility with the given router.
+func NewEventsSubscriber(store *webhook.Store, dispatcher *webhook.Dispatcher, router NodeRouter) *EventsSubscriber {
+	return &EventsSubscriber{
+		Store:      store,
+		Dispatcher: dispatcher,
+		Router:     router,
+		workspaces: make(map[string]cachedWorkspace),
+		tasks:      make(map[string]jobs.TaskStatus),
+	}
+}
+
+// HandleTreeEvent delivers node events, filtered by workspace and path.
+func (e *EventsSubscriber) HandleTreeEvent(ctx context.Context, msg *tree.NodeChangeEvent) error {
+	if msg.Type == tree.NodeChangeEvent_READ {
+		return nil
+	}
+	author := eventAuthor(ctx)
+	if author == common.PYDIO_SYSTEM_USERNAME {
+		// Ignore events triggered by initial sync
+		return nil
+	}
+	node := msg.Target
+	if node == nil {
+		node = msg.Source
+	}
+	if node == nil || utils.IgnoreNodeForOutput(ctx, node) {
+		return nil
+	}
+	eventType := webhook.TreeEventType(msg.Type)
+	for _, w := range e.webhooksFor(eventType) {
+		if len(w.Workspaces) == 0 {
+			if matchNodes(w, msg.Target, msg.Source) {
+				e.dispatch(ctx, w, eventType, author, "", &tree.NodeChangeEvent{
+					Type:   msg.Type,
+					Target: withoutReservedMetas(msg.Target, ""),
+					Source: withoutReservedMetas(msg.Source, ""),
+				})
+			}
+			continue
+		}
+		for _, wsUuid := range w.Workspaces {
+			ws, err := e.workspace(ctx, wsUuid)
+			if err != nil {
+				log.Logger(ctx).Debug("cannot load webhook workspace", zap.String("workspace", wsUuid), zap.Error(err))
+				continue
+			}
+			var target, source *tree.Node
+			if n, ok := e.Router.WorkspaceCanSeeNode(ctx, ws, msg.Target, false); ok && n != nil {
+				target = withoutReservedMetas(msg.Target, n.Path)
+			}
+			if n, ok := e.Router.WorkspaceCanSeeNode(ctx, ws, msg.Source, false); ok && n != nil {
+				source = withoutReservedMetas(msg.Source, n.Path)
+			}
+			if (target == nil && source == nil) || !matchNodes(w, target, source) {
+				continue
+			}
+			e.dispatch(ctx, w, eventType, author, ws.UUID, &tree.NodeChangeEvent{
+				Type:   msg.Type,
+				Target: target,
+				Source: source,
+			})
+			break
+		}
+	}
+	return nil
+}
+
+// HandleIdmEvent delivers users, roles, workspaces and ACLs events. Password hashes are never sent.
+func (e *EventsSubscriber) HandleIdmEvent(ctx context.Context, msg *idm.ChangeEvent) error {
+	eventType := webhook.IdmEventType(msg)
+	var wsUuid string
+	if msg.Workspace != nil {
+		wsUuid = msg.Workspace.UUID
+	} else if msg.Acl != nil {
+		wsUuid = msg.Acl.WorkspaceID
+	}
+	event := proto.Clone(msg).(*idm.ChangeEvent)
+	event.JsonType = ""
+	if event.User != nil {
+		event.User.Password = ""
+	}
+	for _, w := range e.webhooksFor(eventType) {
+		if len(w.Workspaces) > 0 && (wsUuid == "" || !webhook.MatchWorkspace(w, wsUuid)) {
+			continue
+		}
+		e.dispatch(ctx, w, eventType, eventAuthor(ctx), wsUuid, event)
+	}
+	return nil
+}
+
+// HandleTaskEvent delivers tasks status changes. Progress updates that do not change the status are ignored,
+// and webhooks restricted to some workspaces do not receive tasks events.
+func (e *EventsSubscriber) HandleTaskEvent(ctx context.Context, msg *jobs.TaskChangeEvent) error {
+	task := msg.TaskUpdated
+	if task == nil {
+		return nil
+	}
+	e.Lock()
+	last, known := e.tasks[task.ID]
+	switch task.Status {
+	case jobs.TaskStatus_Finished, jobs.TaskStatus_Error, jobs.TaskStatus_Interrupted:
+		delete(e.tasks, task.ID)
+	default:
+		e.tasks[task.ID] = task.Status
+	}
+	e.Unlock()
+	if known && last == task.Status {
+		return nil
+	}
+	eventType := webhook.TaskEventType(task.Status)
+	event := proto.Clone(msg).(*jobs.TaskChangeEvent)
+	event.TaskUpdated.ActionsLogs = nil
+	for _, w := range e.webhooksFor(eventType) {
+		if len(w.Workspaces) > 0 {
+			continue
+		}
+		e.dispatch(ctx, w, eventType, task.TriggerOwner, "", event)
+	}
+	return nil
+}
+
+// webhooksFor lists the enabled webhooks accepting the event type.
+func (e *EventsSubscriber) webhooksFor(eventType string) (webhooks []*proto2.Webhook) {
+	all, err := e.Store.ListWebhooks()
+	if err != nil {
+		return nil
+	}
+	for _, w := range all {
+		if !w.Disabled && webhook.MatchEventType(w, eventType) {
+			webhooks = append(webhooks, w)
+		}
+	}
+	return
+}
+
+func (e *EventsSubscriber) dispatch(ctx context.Context, w *proto2.Webhook, eventType, user, wsUuid string, event proto.Message) {
+	if _, err := e.Dispatcher.Dispatch(w, eventType, user, wsUuid, event); err != nil {
+		log.Logger(ctx).Error("cannot queue webhook delivery", zap.String("webhook", w.Label), zap.String("event", eventType), zap.Error(err))
+	}
+}
+
+// workspace loads a workspace with its root nodes, caching it for WorkspaceCacheTTL.
+func (e *EventsSubscriber) workspace(ctx context.Context, wsUuid string) (*idm.Workspace, error) {
+	e.Lock()
+	cached, ok := e.workspaces[wsUuid]
+	e.Unlock()
+	if ok && time.Since(cached.loaded) < WorkspaceCacheTTL {
+		return cached.ws, nil
+	}
+	ws, err := loadWorkspace(ctx, wsUuid)
+	if err != nil {
+		return nil, err
+	}
+	e.Lock()
+	e.workspaces[wsUuid] = cachedWorkspace{ws: ws, loaded: time.Now()}
+	e.Unlock()
+	return ws, nil
+}
+
+func loadWorkspace(ctx context.Context, wsUuid string) (*idm.Workspace, error) {
+	q, _ := ptypes.MarshalAny(&idm.WorkspaceSingleQuery{Uuid: wsUuid})
+	cli := idm.NewWorkspaceServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_WORKSPACE, defaults.NewClient())
+	stream, err := cli.SearchWorkspace(ctx, &idm.SearchWorkspaceRequest{Query: &service.Query{SubQueries: []*any.Any{q}}})
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+	resp, err := stream.Recv()
+	if err != nil || resp.GetWorkspace() == nil {
+		return nil, fmt.Errorf("workspace %s not found", wsUuid)
+	}
+	ws := resp.GetWorkspace()
+	acls, err := utils.GetACLsForWorkspace(ctx, []string{wsUuid}, utils.ACL_READ, utils.ACL_WRITE)
+	if err != nil {
+		return nil, err
+	}
+	roots := make(map[string]bool)
+	for _, acl := range acls {
+		if acl.NodeID != "" && !roots[acl.NodeID] {
+			roots[acl.NodeID] = true
+			ws.RootNodes = append(ws.RootNodes, acl.NodeID)
+		}
+	}
+	return ws, nil
+}
+
+func matchNodes(w *proto2.Webhook, nodes ...*tree.Node) bool {
+	for _, n := range nodes {
+		if n != nil && webhook.MatchPath(w, n.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutReservedMetas copies the node without internal metadata, replacing its path if p is not empty.
+func withoutReservedMetas(n *tree.Node, p string) *tree.Node {
+	if n == nil {
+		return nil
+	}
+	c := n.WithoutReservedMetas()
+	if p != "" {
+		c.Path = p
+	}
+	return c
+}
+
+func eventAuthor(ctx context.Context) string {
+	if meta, ok := metadata.FromContext(ctx); ok {
+		if user, exists := meta[common.PYDIO_CONTEXT_USER_KEY]; exists {
+			return user
+		}
+		if user, exists := meta[strings.ToLower(common.PYDIO_CONTEXT_USER_KEY)]; exists {
+			return user
+		}
+	}
+	return ""
+}
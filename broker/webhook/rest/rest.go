@@ -0,0 +1,176 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"github.com/emicklei/go-restful"
+	"github.com/micro/go-micro/errors"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/webhook"
+	"github.com/pydio/cells/common/service"
+	"github.com/pydio/cells/common/service/defaults"
+	"github.com/pydio/cells/common/utils"
+)
+
+// WebhookHandler implements the REST API of the webhooks service.
+// Secrets are only returned once, when a webhook is created or its secret is changed.
+type WebhookHandler struct{}
+
+// SwaggerTags list the names of the service tags declared in the swagger json implemented by this service
+func (h *WebhookHandler) SwaggerTags() []string {
+	return []string{"WebhookService"}
+}
+
+// Filter returns a function to filter the swagger path
+func (h *WebhookHandler) Filter() func(string) string {
+	return nil
+}
+
+func (h *WebhookHandler) client() webhook.WebhookServiceClient {
+	return webhook.NewWebhookServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_WEBHOOK, defaults.NewClient())
+}
+
+// ListWebhooks lists all webhooks, without their secrets.
+func (h *WebhookHandler) ListWebhooks(req *restful.Request, rsp *restful.Response) {
+	resp, err := h.client().ListWebhooks(req.Request.Context(), &webhook.ListWebhooksRequest{})
+	if err != nil {
+		writeError(req, rsp, err)
+		return
+	}
+	for _, w := range resp.Webhooks {
+		w.Secret = ""
+	}
+	rsp.WriteEntity(resp)
+}
+
+// PutWebhook creates or updates a webhook.
+func (h *WebhookHandler) PutWebhook(req *restful.Request, rsp *restful.Response) {
+	var input webhook.Webhook
+	if err := req.ReadEntity(&input); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	ctx := req.Request.Context()
+	showSecret := input.Uuid == "" || input.Secret != ""
+	if input.Uuid == "" {
+		input.Owner, _ = utils.FindUserNameInContext(ctx)
+	}
+	resp, err := h.client().PutWebhook(ctx, &webhook.PutWebhookRequest{Webhook: &input})
+	if err != nil {
+		writeError(req, rsp, err)
+		return
+	}
+	w := resp.Webhook
+	log.Auditer(ctx).Info("Stored webhook "+w.Label, zap.String("webhook", w.Uuid), zap.String("url", w.Url))
+	if !showSecret {
+		w.Secret = ""
+	}
+	rsp.WriteEntity(w)
+}
+
+// GetWebhook loads a webhook, without its secret.
+func (h *WebhookHandler) GetWebhook(req *restful.Request, rsp *restful.Response) {
+	resp, err := h.client().GetWebhook(req.Request.Context(), &webhook.GetWebhookRequest{Uuid: req.PathParameter("Uuid")})
+	if err != nil {
+		writeError(req, rsp, err)
+		return
+	}
+	resp.Webhook.Secret = ""
+	rsp.WriteEntity(resp.Webhook)
+}
+
+// DeleteWebhook removes a webhook with its delivery log and dead letters.
+func (h *WebhookHandler) DeleteWebhook(req *restful.Request, rsp *restful.Response) {
+	ctx := req.Request.Context()
+	uuid := req.PathParameter("Uuid")
+	resp, err := h.client().DeleteWebhook(ctx, &webhook.DeleteWebhookRequest{Uuid: uuid})
+	if err != nil {
+		writeError(req, rsp, err)
+		return
+	}
+	log.Auditer(ctx).Info("Deleted webhook", zap.String("webhook", uuid))
+	rsp.WriteEntity(resp)
+}
+
+// ListWebhookDeliveries lists the delivery log or the dead letters of a webhook, most recent first.
+func (h *WebhookHandler) ListWebhookDeliveries(req *restful.Request, rsp *restful.Response) {
+	var input webhook.ListDeliveriesRequest
+	if err := req.ReadEntity(&input); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	input.WebhookUuid = req.PathParameter("WebhookUuid")
+	if input.Limit <= 0 {
+		input.Limit = 50
+	}
+	resp, err := h.client().ListDeliveries(req.Request.Context(), &input)
+	if err != nil {
+		writeError(req, rsp, err)
+		return
+	}
+	rsp.WriteEntity(resp)
+}
+
+// RedeliverWebhook queues dead letters again for delivery.
+func (h *WebhookHandler) RedeliverWebhook(req *restful.Request, rsp *restful.Response) {
+	var input webhook.RedeliverRequest
+	if err := req.ReadEntity(&input); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	input.WebhookUuid = req.PathParameter("WebhookUuid")
+	resp, err := h.client().Redeliver(req.Request.Context(), &input)
+	if err != nil {
+		writeError(req, rsp, err)
+		return
+	}
+	rsp.WriteEntity(resp)
+}
+
+// PurgeWebhookDeadLetters deletes dead letters of a webhook.
+func (h *WebhookHandler) PurgeWebhookDeadLetters(req *restful.Request, rsp *restful.Response) {
+	var input webhook.PurgeDeadLettersRequest
+	if err := req.ReadEntity(&input); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	input.WebhookUuid = req.PathParameter("WebhookUuid")
+	resp, err := h.client().PurgeDeadLetters(req.Request.Context(), &input)
+	if err != nil {
+		writeError(req, rsp, err)
+		return
+	}
+	rsp.WriteEntity(resp)
+}
+
+func writeError(req *restful.Request, rsp *restful.Response, err error) {
+	switch errors.Parse(err.Error()).Code {
+	case 404:
+		service.RestError404(req, rsp, err)
+	case 400:
+		rsp.WriteError(400, err)
+	default:
+		service.RestError500(req, rsp, err)
+	}
+}
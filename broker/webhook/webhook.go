@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package webhook notifies external HTTP endpoints of application events.
+//
+// Administrators register webhooks with a URL, a secret and optional filters on event types, workspaces and paths.
+// Tree, IDM and jobs events matching a webhook are serialized to JSON and queued in a Bolt store, then POSTed to
+// the webhook URL with an HMAC-SHA256 signature. Failed deliveries are retried with an exponential back-off and
+// moved to a dead-letter list once the maximum number of attempts is reached. Every delivery is kept in a
+// per-webhook delivery log.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/proto/webhook"
+)
+
+const (
+	// HeaderEvent carries the event type of the delivered payload
+	HeaderEvent = "X-Pydio-Event"
+	// HeaderDelivery carries the delivery Uuid, which stays the same across retries
+	HeaderDelivery = "X-Pydio-Delivery"
+	// HeaderTimestamp carries the unix time at which the payload was signed
+	HeaderTimestamp = "X-Pydio-Timestamp"
+	// HeaderSignature carries the signature computed by Sign, prefixed with "sha256="
+	HeaderSignature = "X-Pydio-Signature"
+)
+
+// TreeEventType returns the webhook event type of a tree event, e.g. "tree:create".
+func TreeEventType(t tree.NodeChangeEvent_EventType) string {
+	return "tree:" + strings.ToLower(t.String())
+}
+
+// IdmEventType returns the webhook event type of an IDM event, e.g. "idm:user:update".
+func IdmEventType(e *idm.ChangeEvent) string {
+	kind := "unknown"
+	if e.Acl != nil {
+		kind = "acl"
+	} else if e.Role != nil {
+		kind = "role"
+	} else if e.User != nil {
+		kind = "user"
+	} else if e.Workspace != nil {
+		kind = "workspace"
+	}
+	return "idm:" + kind + ":" + strings.ToLower(e.Type.String())
+}
+
+// TaskEventType returns the webhook event type of a task status, e.g. "jobs:task:finished".
+func TaskEventType(s jobs.TaskStatus) string {
+	return "jobs:task:" + strings.ToLower(s.String())
+}
+
+// MatchEventType checks an event type against the webhook filter. A filter ending with "*"
+// matches all types sharing its prefix, and an empty filter list matches everything.
+func MatchEventType(w *webhook.Webhook, eventType string) bool {
+	if len(w.EventTypes) == 0 {
+		return true
+	}
+	for _, f := range w.EventTypes {
+		if f == eventType || f == "*" {
+			return true
+		}
+		if strings.HasSuffix(f, "*") && strings.HasPrefix(eventType, strings.TrimSuffix(f, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchWorkspace checks a workspace Uuid against the webhook filter. An empty filter list matches everything.
+func MatchWorkspace(w *webhook.Webhook, wsUuid string) bool {
+	if len(w.Workspaces) == 0 {
+		return true
+	}
+	for _, ws := range w.Workspaces {
+		if ws == wsUuid {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchPath checks a node path against the webhook path patterns. A pattern matches if it matches
+// the path itself or any of its parents, so that "*/invoices" also matches the folder content.
+// An empty pattern list matches everything.
+func MatchPath(w *webhook.Webhook, nodePath string) bool {
+	if len(w.PathPatterns) == 0 {
+		return true
+	}
+	nodePath = strings.Trim(nodePath, "/")
+	for _, pattern := range w.PathPatterns {
+		pattern = strings.Trim(pattern, "/")
+		for p := nodePath; p != "." && p != ""; p = path.Dir(p) {
+			if ok, _ := path.Match(pattern, p); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Sign computes the hex-encoded HMAC-SHA256 of "<timestamp>.<body>" with the webhook secret.
+// Receivers should recompute it from the HeaderTimestamp value and the raw request body,
+// compare it to HeaderSignature and reject old timestamps to prevent replays.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package webhook
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/proto/webhook"
+)
+
+func TestEventTypes(t *testing.T) {
+
+	Convey("Test event types naming", t, func() {
+		So(TreeEventType(tree.NodeChangeEvent_UPDATE_PATH), ShouldEqual, "tree:update_path")
+		So(IdmEventType(&idm.ChangeEvent{Type: idm.ChangeEventType_CREATE, User: &idm.User{}}), ShouldEqual, "idm:user:create")
+		So(IdmEventType(&idm.ChangeEvent{Type: idm.ChangeEventType_DELETE, Acl: &idm.ACL{}, Role: &idm.Role{}}), ShouldEqual, "idm:acl:delete")
+		So(TaskEventType(jobs.TaskStatus_Finished), ShouldEqual, "jobs:task:finished")
+	})
+
+	Convey("Test event types filter", t, func() {
+		w := &webhook.Webhook{}
+		So(MatchEventType(w, "tree:create"), ShouldBeTrue)
+		w.EventTypes = []string{"tree:create", "idm:user:*"}
+		So(MatchEventType(w, "tree:create"), ShouldBeTrue)
+		So(MatchEventType(w, "tree:delete"), ShouldBeFalse)
+		So(MatchEventType(w, "idm:user:update"), ShouldBeTrue)
+		So(MatchEventType(w, "idm:role:update"), ShouldBeFalse)
+	})
+
+}
+
+func TestFilters(t *testing.T) {
+
+	Convey("Test workspace filter", t, func() {
+		w := &webhook.Webhook{}
+		So(MatchWorkspace(w, "ws1"), ShouldBeTrue)
+		w.Workspaces = []string{"ws1", "ws2"}
+		So(MatchWorkspace(w, "ws2"), ShouldBeTrue)
+		So(MatchWorkspace(w, "ws3"), ShouldBeFalse)
+	})
+
+	Convey("Test path patterns match the node or its parents", t, func() {
+		w := &webhook.Webhook{}
+		So(MatchPath(w, "common-files/any"), ShouldBeTrue)
+		w.PathPatterns = []string{"/common-files/invoices/", "*/reports/*.pdf"}
+		So(MatchPath(w, "common-files/invoices"), ShouldBeTrue)
+		So(MatchPath(w, "common-files/invoices/2018/inv-001.pdf"), ShouldBeTrue)
+		So(MatchPath(w, "/common-files/invoices-old/inv-001.pdf"), ShouldBeFalse)
+		So(MatchPath(w, "personal/reports/q1.pdf"), ShouldBeTrue)
+		So(MatchPath(w, "personal/reports/q1.xlsx"), ShouldBeFalse)
+		So(MatchPath(w, ""), ShouldBeFalse)
+	})
+
+}
+
+func TestSign(t *testing.T) {
+
+	Convey("Test signature depends on secret, timestamp and body", t, func() {
+		body := []byte(`{"Type":"tree:create"}`)
+		s := Sign("secret", 1500000000, body)
+		So(s, ShouldHaveLength, 64)
+		So(Sign("secret", 1500000000, body), ShouldEqual, s)
+		So(Sign("other", 1500000000, body), ShouldNotEqual, s)
+		So(Sign("secret", 1500000001, body), ShouldNotEqual, s)
+		So(Sign("secret", 1500000000, []byte(`{"Type":"tree:delete"}`)), ShouldNotEqual, s)
+	})
+
+}
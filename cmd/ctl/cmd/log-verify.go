@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/proto/log"
+	"github.com/pydio/cells/common/service/defaults"
+)
+
+var (
+	logVerifyFrom string
+	logVerifyTo   string
+)
+
+// logVerifyCmd verifies the audit hash chain
+var logVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify the integrity of audit logs",
+	Long: `Verify the hash chain of audit logs and report the first break.
+
+Each audit message stores the hash of the previous one, and the chain head is regularly signed with a
+key protected by the server keyring. Signed checkpoints are linked to each other, and when retention drops
+the oldest logs, the first message kept is recorded in a signed watermark: the oldest message must match it.
+
+Messages logged after the last signed checkpoint are only protected by the hash chain: they can be removed
+without detection by someone able to rewrite both the index and the chain state.
+
+EXAMPLES
+========
+# Verify the whole chain
+$ pydioctl log verify
+
+# Verify messages logged in a given period (RFC3339 or YYYY-MM-DD)
+$ pydioctl log verify --from 2018-03-01 --to 2018-03-31T23:59:59Z
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		from, err := parseLogVerifyTime(logVerifyFrom)
+		if err != nil {
+			return err
+		}
+		to, err := parseLogVerifyTime(logVerifyTo)
+		if err != nil {
+			return err
+		}
+
+		client := log.NewLogRecorderClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_LOG, defaults.NewClient())
+		report, err := client.VerifyAuditChain(context.Background(), &log.VerifyAuditChainRequest{From: from, To: to})
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("Checked %d messages (sequences %d to %d) and %d signed checkpoints\n", report.Checked, report.FirstSequence, report.LastSequence, report.Checkpoints)
+		if report.Valid {
+			cmd.Println("Audit chain is valid")
+			return nil
+		}
+		b := report.Break
+		msg := fmt.Sprintf("audit chain is broken at sequence %d: %s", b.Sequence, b.Reason)
+		if b.Ts > 0 {
+			msg += fmt.Sprintf(" (message logged at %s)", time.Unix(int64(b.Ts), 0).Format(time.RFC3339))
+		}
+		return errors.New(msg)
+	},
+}
+
+func parseLogVerifyTime(value string) (int32, error) {
+	if value == "" {
+		return 0, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		if t, err = time.Parse("2006-01-02", value); err != nil {
+			return 0, fmt.Errorf("cannot parse date %s, use RFC3339 or YYYY-MM-DD format", value)
+		}
+	}
+	return int32(t.Unix()), nil
+}
+
+func init() {
+	logVerifyCmd.Flags().StringVar(&logVerifyFrom, "from", "", "Verify messages logged after this date")
+	logVerifyCmd.Flags().StringVar(&logVerifyTo, "to", "", "Verify messages logged before this date")
+
+	logCmd.AddCommand(logVerifyCmd)
+}
@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package antivirus scans files content with a ClamAV daemon and reports infected files.
+package antivirus
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultChunkSize is the size of the chunks sent to clamd, it must be lower than its StreamMaxLength
+	DefaultChunkSize = 32 * 1024
+	// DefaultTimeout applies to each network operation with clamd
+	DefaultTimeout = 30 * time.Second
+)
+
+// Result of a scan.
+type Result struct {
+	Infected bool
+	// Signature is the name of the virus found
+	Signature string
+}
+
+// Scanner scans streams and gives the version of its signatures database.
+type Scanner interface {
+	Scan(ctx context.Context, reader io.Reader) (*Result, error)
+	Version(ctx context.Context) (string, error)
+}
+
+// ClamdClient talks to a clamd daemon, see https://linux.die.net/man/8/clamd for the protocol.
+type ClamdClient struct {
+	Network   string
+	Address   string
+	Timeout   time.Duration
+	ChunkSize int
+}
+
+// NewClamdClient parses an address like tcp://127.0.0.1:3310, unix:///var/run/clamav/clamd.ctl or host:port.
+func NewClamdClient(address string, timeout time.Duration) *ClamdClient {
+	c := &ClamdClient{Network: "tcp", Address: address, Timeout: timeout, ChunkSize: DefaultChunkSize}
+	if strings.HasPrefix(address, "unix://") {
+		c.Network, c.Address = "unix", strings.TrimPrefix(address, "unix://")
+	} else if strings.HasPrefix(address, "tcp://") {
+		c.Address = strings.TrimPrefix(address, "tcp://")
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = DefaultTimeout
+	}
+	return c
+}
+
+func (c *ClamdClient) dial(ctx context.Context) (net.Conn, error) {
+	d := &net.Dialer{Timeout: c.Timeout}
+	conn, err := d.DialContext(ctx, c.Network, c.Address)
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to clamd: %s", err.Error())
+	}
+	return conn, nil
+}
+
+// readReply reads a null-terminated reply.
+func (c *ClamdClient) readReply(conn net.Conn) (string, error) {
+	conn.SetReadDeadline(time.Now().Add(c.Timeout))
+	var buf bytes.Buffer
+	b := make([]byte, 256)
+	for {
+		n, err := conn.Read(b)
+		if n > 0 {
+			buf.Write(b[:n])
+			if i := bytes.IndexByte(buf.Bytes(), 0); i >= 0 {
+				return string(buf.Bytes()[:i]), nil
+			}
+		}
+		if err == io.EOF && buf.Len() > 0 {
+			return strings.TrimSpace(buf.String()), nil
+		} else if err != nil {
+			return "", err
+		}
+	}
+}
+
+// Version sends the VERSION command, the reply contains the engine and the signatures database versions.
+func (c *ClamdClient) Version(ctx context.Context) (string, error) {
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	conn.SetWriteDeadline(time.Now().Add(c.Timeout))
+	if _, err := conn.Write([]byte("zVERSION\x00")); err != nil {
+		return "", err
+	}
+	return c.readReply(conn)
+}
+
+// Scan streams the reader content with the INSTREAM command. The reader is always read until its end,
+// even if clamd stops the scan, so that it can be used as one branch of a tee.
+func (c *ClamdClient) Scan(ctx context.Context, reader io.Reader) (*Result, error) {
+
+	conn, err := c.dial(ctx)
+	if err != nil {
+		drain(reader)
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := c.write(conn, []byte("zINSTREAM\x00")); err != nil {
+		drain(reader)
+		return nil, err
+	}
+	chunk := make([]byte, c.ChunkSize)
+	header := make([]byte, 4)
+	for {
+		n, rErr := reader.Read(chunk)
+		if n > 0 {
+			binary.BigEndian.PutUint32(header, uint32(n))
+			if err := c.write(conn, append(header, chunk[:n]...)); err != nil {
+				drain(reader)
+				// Clamd may have closed the stream with an explanation
+				if reply, e := c.readReply(conn); e == nil && reply != "" {
+					return nil, fmt.Errorf("clamd stopped the scan: %s", reply)
+				}
+				return nil, err
+			}
+		}
+		if rErr == io.EOF {
+			break
+		} else if rErr != nil {
+			return nil, rErr
+		}
+	}
+	if err := c.write(conn, []byte{0, 0, 0, 0}); err != nil {
+		return nil, err
+	}
+	reply, err := c.readReply(conn)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReply(reply)
+
+}
+
+func (c *ClamdClient) write(conn net.Conn, data []byte) error {
+	conn.SetWriteDeadline(time.Now().Add(c.Timeout))
+	_, err := conn.Write(data)
+	return err
+}
+
+// ParseReply interprets a clamd scan reply like "stream: OK" or "stream: Eicar-Test-Signature FOUND".
+func ParseReply(reply string) (*Result, error) {
+	reply = strings.TrimSpace(reply)
+	if i := strings.Index(reply, ": "); i >= 0 {
+		reply = reply[i+2:]
+	}
+	switch {
+	case reply == "OK":
+		return &Result{}, nil
+	case strings.HasSuffix(reply, " FOUND"):
+		return &Result{Infected: true, Signature: strings.TrimSuffix(reply, " FOUND")}, nil
+	default:
+		return nil, fmt.Errorf("unexpected clamd reply: %s", reply)
+	}
+}
+
+func drain(reader io.Reader) {
+	io.Copy(ioutil.Discard, reader)
+}
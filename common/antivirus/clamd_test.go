@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package antivirus
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const eicar = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+
+// fakeClamd answers the VERSION and INSTREAM commands, streams containing EICAR are reported as infected.
+func fakeClamd(t *testing.T) (string, func()) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for {
+			conn, e := l.Accept()
+			if e != nil {
+				return
+			}
+			go serveClamd(conn)
+		}
+	}()
+	return "tcp://" + l.Addr().String(), func() { l.Close() }
+}
+
+func serveClamd(conn net.Conn) {
+	defer conn.Close()
+	var command bytes.Buffer
+	b := make([]byte, 1)
+	for {
+		if _, e := conn.Read(b); e != nil {
+			return
+		}
+		if b[0] == 0 {
+			break
+		}
+		command.WriteByte(b[0])
+	}
+	switch command.String() {
+	case "zVERSION":
+		conn.Write([]byte("ClamAV 0.100.0/24000/Mon Oct 19 08:00:00 2026\x00"))
+	case "zINSTREAM":
+		var content bytes.Buffer
+		header := make([]byte, 4)
+		for {
+			if _, e := io.ReadFull(conn, header); e != nil {
+				return
+			}
+			size := binary.BigEndian.Uint32(header)
+			if size == 0 {
+				break
+			}
+			if _, e := io.CopyN(&content, conn, int64(size)); e != nil {
+				return
+			}
+		}
+		if strings.Contains(content.String(), "EICAR-STANDARD-ANTIVIRUS-TEST-FILE") {
+			conn.Write([]byte("stream: Eicar-Test-Signature FOUND\x00"))
+		} else {
+			conn.Write([]byte("stream: OK\x00"))
+		}
+	default:
+		conn.Write([]byte("UNKNOWN COMMAND\x00"))
+	}
+}
+
+func TestClamdClient(t *testing.T) {
+
+	address, closer := fakeClamd(t)
+	defer closer()
+	ctx := context.Background()
+
+	Convey("Test address parsing", t, func() {
+		c := NewClamdClient("unix:///var/run/clamav/clamd.ctl", 0)
+		So(c.Network, ShouldEqual, "unix")
+		So(c.Address, ShouldEqual, "/var/run/clamav/clamd.ctl")
+		So(c.Timeout, ShouldEqual, DefaultTimeout)
+		c = NewClamdClient("clamav:3310", time.Second)
+		So(c.Network, ShouldEqual, "tcp")
+		So(c.Address, ShouldEqual, "clamav:3310")
+	})
+
+	Convey("Test version", t, func() {
+		c := NewClamdClient(address, time.Second)
+		v, e := c.Version(ctx)
+		So(e, ShouldBeNil)
+		So(v, ShouldStartWith, "ClamAV 0.100.0/24000")
+	})
+
+	Convey("Test clean and infected streams", t, func() {
+		c := NewClamdClient(address, time.Second)
+		c.ChunkSize = 16
+		r, e := c.Scan(ctx, strings.NewReader(strings.Repeat("clean content ", 100)))
+		So(e, ShouldBeNil)
+		So(r.Infected, ShouldBeFalse)
+
+		r, e = c.Scan(ctx, strings.NewReader(eicar))
+		So(e, ShouldBeNil)
+		So(r.Infected, ShouldBeTrue)
+		So(r.Signature, ShouldEqual, "Eicar-Test-Signature")
+	})
+
+	Convey("Test reader is drained when clamd is down", t, func() {
+		c := NewClamdClient("tcp://127.0.0.1:1", time.Second)
+		reader := strings.NewReader("some content")
+		_, e := c.Scan(ctx, reader)
+		So(e, ShouldNotBeNil)
+		So(reader.Len(), ShouldEqual, 0)
+	})
+
+	Convey("Test replies parsing", t, func() {
+		r, e := ParseReply("stream: OK")
+		So(e, ShouldBeNil)
+		So(r.Infected, ShouldBeFalse)
+		r, e = ParseReply("stream: Win.Test.EICAR_HDB-1 FOUND")
+		So(e, ShouldBeNil)
+		So(r.Signature, ShouldEqual, "Win.Test.EICAR_HDB-1")
+		_, e = ParseReply("INSTREAM size limit exceeded. ERROR")
+		So(e, ShouldNotBeNil)
+	})
+
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package antivirus
+
+import (
+	"time"
+
+	"github.com/pydio/cells/common/config"
+)
+
+const (
+	// ActionReject deletes infected files
+	ActionReject = "reject"
+	// ActionQuarantine keeps infected files but forbids their download
+	ActionQuarantine = "quarantine"
+)
+
+// Config is read from the defaults/antivirus section of the configuration.
+type Config struct {
+	Enabled bool
+	// Address of clamd, e.g. tcp://127.0.0.1:3310 or unix:///var/run/clamav/clamd.ctl
+	Address string
+	// Action applied to infected files, reject (default) or quarantine
+	Action  string
+	Timeout time.Duration
+	// Files bigger than this size are not scanned, it should match clamd StreamMaxLength
+	MaxSize int64
+	// Refuse uploads that could not be scanned, e.g. when clamd is down (default)
+	RejectOnError bool
+}
+
+// LoadConfig reads the current antivirus configuration.
+func LoadConfig() *Config {
+	c := &Config{
+		Enabled:       config.Get("defaults", "antivirus", "enabled").Bool(false),
+		Address:       config.Get("defaults", "antivirus", "address").String("tcp://127.0.0.1:3310"),
+		Action:        config.Get("defaults", "antivirus", "action").String(ActionReject),
+		Timeout:       time.Duration(config.Get("defaults", "antivirus", "timeout").Int(30)) * time.Second,
+		MaxSize:       int64(config.Get("defaults", "antivirus", "maxSize").Int(25 * 1024 * 1024)),
+		RejectOnError: config.Get("defaults", "antivirus", "rejectOnError").Bool(true),
+	}
+	if c.Action != ActionQuarantine {
+		c.Action = ActionReject
+	}
+	return c
+}
+
+// Scanner returns a clamd client for this configuration.
+func (c *Config) Scanner() Scanner {
+	return NewClamdClient(c.Address, c.Timeout)
+}
@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package antivirus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/activity"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/service/defaults"
+	"github.com/pydio/cells/common/utils"
+)
+
+const (
+	// MetaScanStatus is the node metadata storing the last scan result
+	MetaScanStatus = "antivirus_scan"
+
+	StatusClean    = "clean"
+	StatusInfected = "infected"
+)
+
+// ScanStatus is stored in the node metadata after each scan.
+type ScanStatus struct {
+	Status    string `json:"status"`
+	Signature string `json:"signature,omitempty"`
+	// Engine is the clamd version string, including the signatures database version
+	Engine    string `json:"engine,omitempty"`
+	ScannedAt int64  `json:"scannedAt"`
+	Action    string `json:"action,omitempty"`
+}
+
+// NewStatus builds the status of a scan result.
+func NewStatus(result *Result, engine string, action string) *ScanStatus {
+	s := &ScanStatus{Status: StatusClean, Engine: engine, ScannedAt: time.Now().Unix()}
+	if result.Infected {
+		s.Status = StatusInfected
+		s.Signature = result.Signature
+		s.Action = action
+	}
+	return s
+}
+
+// GetStatus reads the scan status from the node metadata, it returns nil if the node was never scanned.
+func GetStatus(node *tree.Node) *ScanStatus {
+	var s ScanStatus
+	if e := node.GetMeta(MetaScanStatus, &s); e != nil || s.Status == "" {
+		return nil
+	}
+	return &s
+}
+
+// IsQuarantined tells if the node was kept in quarantine by a previous scan.
+func IsQuarantined(node *tree.Node) bool {
+	s := GetStatus(node)
+	return s != nil && s.Status == StatusInfected && s.Action == ActionQuarantine
+}
+
+// StoreStatus saves the scan status in the node metadata.
+func StoreStatus(ctx context.Context, node *tree.Node, status *ScanStatus) error {
+	if node.Uuid == "" {
+		return fmt.Errorf("cannot store scan status on a node without uuid")
+	}
+	metaNode := &tree.Node{Uuid: node.Uuid, Path: node.Path}
+	metaNode.SetMeta(MetaScanStatus, status)
+	node.SetMeta(MetaScanStatus, status)
+	cli := tree.NewNodeReceiverClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_META, defaults.NewClient())
+	_, err := cli.UpdateNode(ctx, &tree.UpdateNodeRequest{From: metaNode, To: metaNode})
+	return err
+}
+
+// ReportInfected writes an audit log and posts an activity for an infected node.
+func ReportInfected(ctx context.Context, node *tree.Node, status *ScanStatus) {
+
+	userName, _ := utils.FindUserNameInContext(ctx)
+	log.Auditer(ctx).Error(
+		fmt.Sprintf("Infected file %s detected (%s), action: %s", node.Path, status.Signature, status.Action),
+		log.GetAuditId(common.AUDIT_OBJECT_INFECTED),
+		node.ZapUuid(),
+		node.ZapPath(),
+		zap.String(common.KEY_USERNAME, userName),
+		log.GetAuditContext("Signature", status.Signature),
+		log.GetAuditContext("Action", status.Action),
+	)
+
+	ac := InfectedActivity(userName, node, status)
+	cli := activity.NewActivityServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_ACTIVITY, defaults.NewClient())
+	stream, err := cli.PostActivity(ctx)
+	if err != nil {
+		log.Logger(ctx).Error("cannot post infected file activity", zap.Error(err))
+		return
+	}
+	defer stream.Close()
+	if err := stream.Send(&activity.PostActivityRequest{Object: ac}); err != nil {
+		log.Logger(ctx).Error("cannot post infected file activity", zap.Error(err))
+	}
+
+}
+
+// InfectedActivity flags the node as infected, it is addressed to the user who uploaded it.
+func InfectedActivity(userName string, node *tree.Node, status *ScanStatus) *activity.Object {
+	ac := &activity.Object{
+		JsonLdContext: "https://www.w3.org/ns/activitystreams",
+		Type:          activity.ObjectType_Flag,
+		Name:          "Infected File",
+		Summary:       status.Signature,
+		Object: &activity.Object{
+			Type: activity.ObjectType_Document,
+			Id:   node.Uuid,
+			Name: node.Path,
+		},
+		Updated: &timestamp.Timestamp{Seconds: time.Now().Unix()},
+	}
+	if userName != "" && userName != common.PYDIO_SYSTEM_USERNAME {
+		ac.Actor = &activity.Object{Type: activity.ObjectType_Person, Id: userName, Name: userName}
+		ac.To = &activity.Object{Type: activity.ObjectType_Person, Id: userName, Name: userName}
+	}
+	return ac
+}
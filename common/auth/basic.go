@@ -29,6 +29,7 @@ import (
 
 	"github.com/pydio/cells/common"
 	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/proto/auth"
 )
 
 func NewBasicAuthenticator(realm string, ttl time.Duration) *BasicAuthenticator {
@@ -76,15 +77,27 @@ func (b *BasicAuthenticator) Wrap(handler http.Handler) http.HandlerFunc {
 			}
 
 			jwtHelper := DefaultJWTVerifier()
-			newCtx, claims, err := jwtHelper.PasswordCredentialsToken(ctx, user, pass)
-			if err == nil {
-				r = r.WithContext(newCtx)
-				b.cache[user] = &validBasicUser{
-					Hash:      pass,
-					Connexion: time.Now(),
-					Claims:    claims,
+			if auth.IsPersonalToken(pass) {
+				// Personal access tokens are verified on each request and never cached, so that
+				// revocation is immediate and last-used dates are tracked.
+				newCtx, claims, err := jwtHelper.Verify(ctx, pass)
+				if err == nil && claims.Name == user {
+					AuditImpersonatedRequest(newCtx, r.Method, r.URL.Path)
+					handler.ServeHTTP(w, r.WithContext(newCtx))
+					return
+				}
+			} else {
+				newCtx, claims, err := jwtHelper.PasswordCredentialsToken(ctx, user, pass)
+				if err == nil {
+					r = r.WithContext(newCtx)
+					b.cache[user] = &validBasicUser{
+						Hash:      pass,
+						Connexion: time.Now(),
+						Claims:    claims,
+					}
+					handler.ServeHTTP(w, r)
+					return
 				}
-				handler.ServeHTTP(w, r)
 			}
 		}
 
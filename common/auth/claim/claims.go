@@ -23,6 +23,7 @@ package claim
 
 import (
 	"encoding/base64"
+	"strings"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -31,6 +32,13 @@ import (
 const (
 	ContextKey         = "pydio-claims"
 	MetadataContextKey = "x-pydio-claims"
+
+	// ScopeReadOnly removes all write permissions from the user access list
+	ScopeReadOnly = "readonly"
+	// ScopeNoAdmin downgrades an admin profile to a standard one
+	ScopeNoAdmin = "noadmin"
+	// ScopeWorkspacePrefix restricts access to one workspace, identified by its UUID or its slug
+	ScopeWorkspacePrefix = "workspace:"
 )
 
 type IDTokenSubject struct {
@@ -55,20 +63,49 @@ type Claims struct {
 	AuthSource  string    `json:"authSource"`
 	DisplayName string    `json:"displayName"`
 	GroupPath   string    `json:"groupPath"`
+	Scopes      []string  `json:"scopes,omitempty"`
+	// Impersonator is the login of the admin acting on behalf of this user, if any
+	Impersonator string `json:"impersonator,omitempty"`
+}
+
+// HasScope checks if the claims are restricted by the given scope
+func (c *Claims) HasScope(scope string) bool {
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// WorkspaceScopes lists the workspaces the claims are restricted to, if any
+func (c *Claims) WorkspaceScopes() (workspaces []string) {
+	for _, s := range c.Scopes {
+		if strings.HasPrefix(s, ScopeWorkspacePrefix) {
+			workspaces = append(workspaces, strings.TrimPrefix(s, ScopeWorkspacePrefix))
+		}
+	}
+	return
 }
 
 // Decode Subject field of the claims
 func (c *Claims) DecodeUserUuid() (string, error) {
-	sub := c.Subject
-	data, err := base64.RawURLEncoding.DecodeString(sub)
-	if err != nil {
+	if subject, err := c.DecodeSubject(); err != nil {
 		return "", err
+	} else {
+		return subject.UserId, nil
 	}
+}
 
+// DecodeSubject returns both the user and the connector IDs stored in the Subject field of the claims
+func (c *Claims) DecodeSubject() (*IDTokenSubject, error) {
+	data, err := base64.RawURLEncoding.DecodeString(c.Subject)
+	if err != nil {
+		return nil, err
+	}
 	var subject IDTokenSubject
 	if err := proto.Unmarshal(data, &subject); err != nil {
-		return "", err
-	} else {
-		return subject.UserId, nil
+		return nil, err
 	}
+	return &subject, nil
 }
@@ -125,6 +125,11 @@ func (p *pydioAPIConnector) Login(ctx context.Context, s connector.Scopes, usern
 		return connector.Identity{}, false, err
 	}
 
+	if resp.GetUser().IsLockedOut() {
+		log.Logger(ctx).Error("user " + username + " is locked out and cannot login")
+		return connector.Identity{}, false, nil
+	}
+
 	identity = ConvertUserApiToIdentity(resp.GetUser(), "pydioapi")
 	log.Logger(ctx).Info("Login", zap.Any("identity", identity))
 	return identity, true, nil
@@ -37,6 +37,8 @@ var (
 
 func init() {
 	RegisterDexPydioConnector("pydio-api", func() PydioConnectorConfig { return new(ApiConfig) })
+	RegisterDexPydioConnector("pydio-oidc", func() PydioConnectorConfig { return new(OIDCConfig) })
+	RegisterDexPydioConnector("pydio-saml", func() PydioConnectorConfig { return new(SAMLConfig) })
 }
 
 func RegisterDexPydioConnector(name string, configProvider func() PydioConnectorConfig) {
@@ -0,0 +1,275 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package dex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/coreos/dex/connector"
+	"github.com/coreos/go-oidc"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+)
+
+const (
+	OIDCProviderGeneric  = "generic"
+	OIDCProviderGoogle   = "google"
+	OIDCProviderKeycloak = "keycloak"
+
+	googleIssuer = "https://accounts.google.com"
+)
+
+// OIDCConfig configures an upstream OpenID Connect provider. Contrary to the
+// standard dex oidc connector, all the ID Token claims are made available to
+// the provisioning mapping rules.
+type OIDCConfig struct {
+	// Provider selects defaults for a known provider: generic, google or keycloak.
+	Provider string `json:"provider"`
+
+	Issuer       string   `json:"issuer"`
+	ClientID     string   `json:"clientID"`
+	ClientSecret string   `json:"clientSecret"`
+	RedirectURI  string   `json:"redirectURI"`
+	Scopes       []string `json:"scopes"`
+
+	// Keycloak only: the issuer can be computed from the server URL and the realm.
+	KeycloakURL   string `json:"keycloakURL"`
+	KeycloakRealm string `json:"keycloakRealm"`
+
+	// Google only: restrict logins to these hosted domains.
+	HostedDomains []string `json:"hostedDomains"`
+
+	// Claims used to build the identity. Nested claims can be addressed with
+	// a dotted path, e.g. realm_access.roles.
+	UsernameClaim    string `json:"usernameClaim"`
+	DisplayNameClaim string `json:"displayNameClaim"`
+
+	Provisioning ProvisioningConfig `json:"provisioning"`
+}
+
+// applyPresets fills empty values with the defaults of the selected provider.
+func (c *OIDCConfig) applyPresets() error {
+	switch c.Provider {
+	case OIDCProviderGoogle:
+		if c.Issuer == "" {
+			c.Issuer = googleIssuer
+		}
+		if c.UsernameClaim == "" {
+			c.UsernameClaim = "email"
+		}
+	case OIDCProviderKeycloak:
+		if c.Issuer == "" && c.KeycloakURL != "" && c.KeycloakRealm != "" {
+			c.Issuer = strings.TrimRight(c.KeycloakURL, "/") + "/auth/realms/" + c.KeycloakRealm
+		}
+		if c.UsernameClaim == "" {
+			c.UsernameClaim = "preferred_username"
+		}
+	case "", OIDCProviderGeneric:
+		c.Provider = OIDCProviderGeneric
+	default:
+		return fmt.Errorf("unknown oidc provider %q", c.Provider)
+	}
+	if c.DisplayNameClaim == "" {
+		c.DisplayNameClaim = "name"
+	}
+	if c.Issuer == "" {
+		return errors.New("oidc: missing issuer")
+	}
+	return nil
+}
+
+// Open discovers the provider configuration and returns a callback connector.
+func (c *OIDCConfig) Open(logger logrus.FieldLogger) (connector.Connector, error) {
+	return c.openConnector(logger)
+}
+
+func (c *OIDCConfig) openConnector(logger logrus.FieldLogger) (*pydioOIDCConnector, error) {
+	if err := c.applyPresets(); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	provider, err := oidc.NewProvider(ctx, c.Issuer)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to get provider: %v", err)
+	}
+	scopes := []string{oidc.ScopeOpenID}
+	if len(c.Scopes) > 0 {
+		scopes = append(scopes, c.Scopes...)
+	} else {
+		scopes = append(scopes, "profile", "email")
+	}
+	return &pydioOIDCConnector{
+		OIDCConfig: *c,
+		oauth2Config: &oauth2.Config{
+			ClientID:     c.ClientID,
+			ClientSecret: c.ClientSecret,
+			Endpoint:     provider.Endpoint(),
+			Scopes:       scopes,
+			RedirectURL:  c.RedirectURI,
+		},
+		verifier:    provider.Verifier(&oidc.Config{ClientID: c.ClientID}),
+		cancel:      cancel,
+		logger:      logger,
+		provisioner: newProvisioner(c.Provisioning, "oidc-"+c.Provider, logger),
+	}, nil
+}
+
+type pydioOIDCConnector struct {
+	OIDCConfig
+	oauth2Config *oauth2.Config
+	verifier     *oidc.IDTokenVerifier
+	cancel       context.CancelFunc
+	logger       logrus.FieldLogger
+	provisioner  *provisioner
+}
+
+var (
+	_ connector.CallbackConnector = (*pydioOIDCConnector)(nil)
+	_ connector.RefreshConnector  = (*pydioOIDCConnector)(nil)
+)
+
+func (c *pydioOIDCConnector) Close() error {
+	c.cancel()
+	return nil
+}
+
+// LoginURL builds the authorization URL of the upstream provider.
+func (c *pydioOIDCConnector) LoginURL(s connector.Scopes, callbackURL, state string) (string, error) {
+	if c.RedirectURI != callbackURL {
+		return "", fmt.Errorf("expected callback URL %q did not match the URL in the config %q", callbackURL, c.RedirectURI)
+	}
+	if len(c.HostedDomains) > 0 {
+		preferredDomain := c.HostedDomains[0]
+		if len(c.HostedDomains) > 1 {
+			preferredDomain = "*"
+		}
+		return c.oauth2Config.AuthCodeURL(state, oauth2.SetAuthURLParam("hd", preferredDomain)), nil
+	}
+	return c.oauth2Config.AuthCodeURL(state), nil
+}
+
+// HandleCallback exchanges the code, verifies the ID Token and provisions the user.
+func (c *pydioOIDCConnector) HandleCallback(s connector.Scopes, r *http.Request) (identity connector.Identity, err error) {
+	q := r.URL.Query()
+	if errType := q.Get("error"); errType != "" {
+		if desc := q.Get("error_description"); desc != "" {
+			return identity, fmt.Errorf("oidc: %s: %s", errType, desc)
+		}
+		return identity, fmt.Errorf("oidc: %s", errType)
+	}
+	token, err := c.oauth2Config.Exchange(r.Context(), q.Get("code"))
+	if err != nil {
+		return identity, fmt.Errorf("oidc: failed to get token: %v", err)
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return identity, errors.New("oidc: no id_token in token response")
+	}
+	idToken, err := c.verifier.Verify(r.Context(), rawIDToken)
+	if err != nil {
+		return identity, fmt.Errorf("oidc: failed to verify ID Token: %v", err)
+	}
+	var raw map[string]interface{}
+	if err := idToken.Claims(&raw); err != nil {
+		return identity, fmt.Errorf("oidc: failed to decode claims: %v", err)
+	}
+	claims := flattenClaims(raw)
+
+	if len(c.HostedDomains) > 0 && !containsString(c.HostedDomains, firstClaim(claims, "hd")) {
+		return identity, fmt.Errorf("oidc: unexpected hd claim %v", firstClaim(claims, "hd"))
+	}
+
+	identity = connector.Identity{
+		UserID:        idToken.Subject,
+		Username:      firstClaim(claims, c.UsernameClaim, "preferred_username", "email", "sub"),
+		Email:         firstClaim(claims, "email"),
+		EmailVerified: firstClaim(claims, "email_verified") == "true",
+		DisplayName:   firstClaim(claims, c.DisplayNameClaim),
+	}
+	return c.provisioner.Provision(r.Context(), identity, claims)
+}
+
+// Refresh reloads the user from the directory.
+func (c *pydioOIDCConnector) Refresh(ctx context.Context, s connector.Scopes, identity connector.Identity) (connector.Identity, error) {
+	return c.provisioner.Refresh(ctx, identity)
+}
+
+// flattenClaims converts decoded JSON claims to lists of strings. Nested
+// objects are flattened using dotted keys.
+func flattenClaims(raw map[string]interface{}) map[string][]string {
+	out := make(map[string][]string)
+	var walk func(prefix string, v interface{})
+	walk = func(prefix string, v interface{}) {
+		switch value := v.(type) {
+		case map[string]interface{}:
+			for k, sub := range value {
+				if prefix != "" {
+					walk(prefix+"."+k, sub)
+				} else {
+					walk(k, sub)
+				}
+			}
+		case []interface{}:
+			for _, item := range value {
+				switch item.(type) {
+				case map[string]interface{}, []interface{}, nil:
+					continue
+				}
+				out[prefix] = append(out[prefix], fmt.Sprintf("%v", item))
+			}
+		case nil:
+		case float64:
+			out[prefix] = append(out[prefix], strconv.FormatFloat(value, 'f', -1, 64))
+		default:
+			out[prefix] = append(out[prefix], fmt.Sprintf("%v", value))
+		}
+	}
+	walk("", raw)
+	return out
+}
+
+// firstClaim returns the first non-empty value found for the given claim names.
+func firstClaim(claims map[string][]string, names ...string) string {
+	for _, n := range names {
+		if n == "" {
+			continue
+		}
+		if values := claims[n]; len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+func containsString(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
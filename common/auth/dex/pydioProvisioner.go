@@ -0,0 +1,428 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package dex
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/coreos/dex/connector"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/service/defaults"
+	service "github.com/pydio/cells/common/service/proto"
+)
+
+const (
+	// MappingRoles is the reserved RightAttribute mapping values to role identifiers.
+	MappingRoles = "Roles"
+	// MappingGroupPath is the reserved RightAttribute mapping a value to the user group.
+	MappingGroupPath = "GroupPath"
+
+	// AttributeAuthSource stores the name of the connector that provisioned a user.
+	AttributeAuthSource = "authSource"
+)
+
+// ProvisioningConfig defines how identities returned by an external identity
+// provider are turned into Pydio users.
+type ProvisioningConfig struct {
+	// AuthSource is the name reported in the identity claims, defaults to the connector type.
+	AuthSource string `json:"authSource"`
+	// AutoCreate creates unknown users in the directory on their first login.
+	AutoCreate bool `json:"autoCreate"`
+	// LinkExistingUsers allows binding an identity to an existing user that was not
+	// provisioned by any external source (e.g. created before the connector was set up).
+	// Users provisioned by another source are always refused.
+	LinkExistingUsers bool `json:"linkExistingUsers"`
+	// GroupPath is the group where users are created, unless a GroupPath rule matches.
+	GroupPath string `json:"groupPath"`
+	// Profile is the profile of created users, "standard" by default.
+	Profile string `json:"profile"`
+	// MappingRules map an external claim (LeftAttribute) to a user attribute,
+	// to the Roles or to the GroupPath (RightAttribute).
+	MappingRules []auth.MappingRule `json:"mappingRules"`
+}
+
+// provisioner looks up or creates the Pydio user matching an external identity.
+type provisioner struct {
+	ProvisioningConfig
+	logger            logrus.FieldLogger
+	UserServiceClient idm.UserServiceClient
+	RoleServiceClient idm.RoleServiceClient
+	clientsOnce       sync.Once
+}
+
+func newProvisioner(c ProvisioningConfig, defaultSource string, logger logrus.FieldLogger) *provisioner {
+	if c.AuthSource == "" {
+		c.AuthSource = defaultSource
+	}
+	return &provisioner{
+		ProvisioningConfig: c,
+		logger:             logger,
+	}
+}
+
+// initClients lazily creates the grpc clients, as connectors are opened
+// before the micro registry is ready.
+func (p *provisioner) initClients() {
+	p.clientsOnce.Do(func() {
+		if p.UserServiceClient == nil {
+			p.UserServiceClient = idm.NewUserServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_USER, defaults.NewClient())
+		}
+		if p.RoleServiceClient == nil {
+			p.RoleServiceClient = idm.NewRoleServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_ROLE, defaults.NewClient())
+		}
+	})
+}
+
+// mappedValues is the result of applying the mapping rules to a set of claims.
+type mappedValues struct {
+	attributes map[string]string
+	roles      []string
+	// prefixes lists the RolePrefix of every Roles rule: roles carrying
+	// one of them are owned by the connector and resynchronized at login.
+	prefixes  []string
+	groupPath string
+}
+
+// mapClaims applies the mapping rules to the claims received from the provider.
+func (p *provisioner) mapClaims(claims map[string][]string) *mappedValues {
+	mapped := &mappedValues{attributes: make(map[string]string)}
+	for _, rule := range p.MappingRules {
+		if rule.RightAttribute == MappingRoles && rule.RolePrefix != "" {
+			mapped.prefixes = append(mapped.prefixes, rule.RolePrefix)
+		}
+		values := rule.ConvertDNtoName(rule.SanitizeValues(claims[rule.LeftAttribute]))
+		if strings.HasPrefix(rule.RuleString, "preg:") {
+			values = rule.FilterPreg(rule.RuleString, values)
+		} else if rule.RuleString != "" {
+			values = rule.FilterList(rule.SanitizeValues(strings.Split(rule.RuleString, ",")), values)
+		}
+		if len(values) == 0 {
+			continue
+		}
+		switch rule.RightAttribute {
+		case MappingRoles:
+			mapped.roles = append(mapped.roles, rule.AddPrefix(rule.RolePrefix, values)...)
+		case MappingGroupPath:
+			mapped.groupPath = "/" + strings.Trim(values[0], "/")
+		default:
+			mapped.attributes[rule.RightAttribute] = strings.Join(values, ",")
+		}
+	}
+	return mapped
+}
+
+// Provision finds the user matching the identity, creates it if necessary and
+// applies the mapping rules. It returns the identity enriched with Pydio data.
+func (p *provisioner) Provision(ctx context.Context, ident connector.Identity, claims map[string][]string) (connector.Identity, error) {
+	if ident.Username == "" {
+		return connector.Identity{}, fmt.Errorf("identity returned by %s has no username", p.AuthSource)
+	}
+	if ident.Email != "" && strings.EqualFold(ident.Username, ident.Email) && !ident.EmailVerified {
+		return connector.Identity{}, fmt.Errorf("email %s is used as login but was not verified by %s", ident.Email, p.AuthSource)
+	}
+	p.initClients()
+	mapped := p.mapClaims(claims)
+	mapped.attributes[AttributeAuthSource] = p.AuthSource
+
+	user, err := p.loadUser(ctx, ident.Username)
+	if err != nil {
+		return connector.Identity{}, err
+	}
+	if user == nil {
+		if !p.AutoCreate {
+			return connector.Identity{}, fmt.Errorf("user %s is unknown and auto-provisioning is disabled for %s", ident.Username, p.AuthSource)
+		}
+		if user, err = p.createUser(ctx, ident, mapped); err != nil {
+			return connector.Identity{}, err
+		}
+	} else {
+		if err := p.checkOrigin(ctx, user, p.LinkExistingUsers); err != nil {
+			return connector.Identity{}, err
+		}
+		if user.IsLockedOut() {
+			return connector.Identity{}, fmt.Errorf("user %s is locked", user.Login)
+		}
+		if user, err = p.updateUser(ctx, user, mapped); err != nil {
+			return connector.Identity{}, err
+		}
+	}
+
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("User %s logged in via %s", user.Login, p.AuthSource),
+		log.GetAuditId(common.AUDIT_LOGIN_SUCCEED),
+		zap.String(common.KEY_USERNAME, user.Login),
+		zap.String(common.KEY_CONNECTOR, p.AuthSource),
+	)
+	out := ConvertUserApiToIdentity(user, p.AuthSource)
+	out.EmailVerified = ident.EmailVerified
+	out.Groups = ident.Groups
+	out.ConnectorData = ident.ConnectorData
+	return out, nil
+}
+
+// Refresh reloads the user, making sure it still exists and is not locked.
+func (p *provisioner) Refresh(ctx context.Context, ident connector.Identity) (connector.Identity, error) {
+	p.initClients()
+	user, err := p.loadUser(ctx, ident.Username)
+	if err != nil {
+		return connector.Identity{}, err
+	}
+	if user == nil {
+		return connector.Identity{}, fmt.Errorf("user %s not found", ident.Username)
+	}
+	if err := p.checkOrigin(ctx, user, false); err != nil {
+		return connector.Identity{}, err
+	}
+	if user.IsLockedOut() {
+		return connector.Identity{}, fmt.Errorf("user %s is locked", user.Login)
+	}
+	out := ConvertUserApiToIdentity(user, p.AuthSource)
+	out.EmailVerified = ident.EmailVerified
+	out.Groups = ident.Groups
+	out.ConnectorData = ident.ConnectorData
+	return out, nil
+}
+
+// checkOrigin makes sure an existing user can be bound to an identity of this source.
+// Users without origin (internal users) are only accepted if linking is allowed.
+func (p *provisioner) checkOrigin(ctx context.Context, user *idm.User, allowLinking bool) error {
+	origin := user.Attributes[AttributeAuthSource]
+	if origin == p.AuthSource {
+		return nil
+	}
+	if origin == "" && allowLinking && user.Attributes["profile"] != common.PYDIO_PROFILE_ADMIN {
+		return nil
+	}
+	log.Auditer(ctx).Error(
+		fmt.Sprintf("Refusing to bind user %s to an identity provided by %s", user.Login, p.AuthSource),
+		log.GetAuditId(common.AUDIT_LOGIN_FAILED),
+		zap.String(common.KEY_USERNAME, user.Login),
+		zap.String(common.KEY_CONNECTOR, p.AuthSource),
+	)
+	return fmt.Errorf("user %s already exists and is not managed by %s", user.Login, p.AuthSource)
+}
+
+func (p *provisioner) loadUser(ctx context.Context, login string) (*idm.User, error) {
+	singleQ, _ := ptypes.MarshalAny(&idm.UserSingleQuery{Login: login, NodeType: idm.NodeType_USER})
+	streamer, err := p.UserServiceClient.SearchUser(ctx, &idm.SearchUserRequest{Query: &service.Query{SubQueries: []*any.Any{singleQ}}})
+	if err != nil {
+		return nil, err
+	}
+	defer streamer.Close()
+	for {
+		resp, e := streamer.Recv()
+		if e != nil {
+			break
+		}
+		if resp.User.Login == login {
+			return resp.User, nil
+		}
+	}
+	return nil, nil
+}
+
+func (p *provisioner) createUser(ctx context.Context, ident connector.Identity, mapped *mappedValues) (*idm.User, error) {
+	groupPath := mapped.groupPath
+	if groupPath == "" {
+		groupPath = "/" + strings.Trim(p.GroupPath, "/")
+	}
+	profile := p.Profile
+	if profile == "" {
+		profile = common.PYDIO_PROFILE_STANDARD
+	}
+	displayName := ident.DisplayName
+	if displayName == "" {
+		displayName = ident.Username
+	}
+	user := &idm.User{
+		Login:     ident.Username,
+		GroupPath: groupPath,
+		Attributes: map[string]string{
+			"profile":           profile,
+			"displayName":       displayName,
+			AttributeAuthSource: p.AuthSource,
+		},
+		Policies: service.NewResourcePoliciesBuilder().WithStandardUserPolicies(ident.Username).Policies(),
+	}
+	if ident.Email != "" {
+		user.Attributes["email"] = ident.Email
+	}
+	for k, v := range mapped.attributes {
+		user.Attributes[k] = v
+	}
+	if err := p.ensureRoles(ctx, mapped.roles); err != nil {
+		return nil, err
+	}
+	for _, r := range mapped.roles {
+		user.Roles = append(user.Roles, &idm.Role{Uuid: r})
+	}
+
+	resp, err := p.UserServiceClient.CreateUser(ctx, &idm.CreateUserRequest{User: user})
+	if err != nil {
+		return nil, err
+	}
+	created := resp.User
+	if _, err := p.RoleServiceClient.CreateRole(ctx, &idm.CreateRoleRequest{Role: &idm.Role{
+		Uuid:     created.Uuid,
+		Label:    created.Login,
+		UserRole: true,
+		Policies: user.Policies,
+	}}); err != nil {
+		return nil, err
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("User %s provisioned by %s", created.Login, p.AuthSource),
+		log.GetAuditId(common.AUDIT_USER_CREATE),
+		created.ZapUuid(),
+		zap.String(common.KEY_CONNECTOR, p.AuthSource),
+	)
+	created.Roles = append(created.Roles, user.Roles...)
+	return created, nil
+}
+
+// updateUser synchronizes mapped attributes, roles and group of an existing user.
+// Roles carrying the prefix of a Roles rule are replaced, other roles are kept.
+func (p *provisioner) updateUser(ctx context.Context, user *idm.User, mapped *mappedValues) (*idm.User, error) {
+	changed := false
+	if user.Attributes == nil {
+		user.Attributes = make(map[string]string)
+	}
+	for k, v := range mapped.attributes {
+		if user.Attributes[k] != v {
+			user.Attributes[k] = v
+			changed = true
+		}
+	}
+	if mapped.groupPath != "" && mapped.groupPath != user.GroupPath {
+		user.GroupPath = mapped.groupPath
+		changed = true
+	}
+
+	wanted := make(map[string]bool, len(mapped.roles))
+	for _, r := range mapped.roles {
+		wanted[r] = true
+	}
+	var roles []*idm.Role
+	for _, r := range user.Roles {
+		if r.UserRole || r.GroupRole || len(r.AutoApplies) > 0 {
+			continue
+		}
+		if !wanted[r.Uuid] && hasAnyPrefix(r.Uuid, mapped.prefixes) {
+			changed = true
+			continue
+		}
+		delete(wanted, r.Uuid)
+		roles = append(roles, r)
+	}
+	var added []string
+	for r := range wanted {
+		added = append(added, r)
+	}
+	sort.Strings(added)
+	if len(added) > 0 {
+		if err := p.ensureRoles(ctx, added); err != nil {
+			return nil, err
+		}
+		for _, r := range added {
+			roles = append(roles, &idm.Role{Uuid: r})
+		}
+		changed = true
+	}
+	if !changed {
+		return user, nil
+	}
+
+	user.Roles = roles
+	resp, err := p.UserServiceClient.CreateUser(ctx, &idm.CreateUserRequest{User: user})
+	if err != nil {
+		return nil, err
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("User %s updated by %s", user.Login, p.AuthSource),
+		log.GetAuditId(common.AUDIT_USER_UPDATE),
+		user.ZapUuid(),
+		zap.String(common.KEY_CONNECTOR, p.AuthSource),
+	)
+	updated := resp.User
+	updated.Roles = roles
+	return updated, nil
+}
+
+// ensureRoles creates the roles that do not exist yet, using their identifier as label.
+func (p *provisioner) ensureRoles(ctx context.Context, roles []string) error {
+	if len(roles) == 0 {
+		return nil
+	}
+	q, _ := ptypes.MarshalAny(&idm.RoleSingleQuery{Uuid: roles})
+	streamer, err := p.RoleServiceClient.SearchRole(ctx, &idm.SearchRoleRequest{Query: &service.Query{SubQueries: []*any.Any{q}}})
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]bool)
+	for {
+		resp, e := streamer.Recv()
+		if e != nil {
+			break
+		}
+		existing[resp.Role.Uuid] = true
+	}
+	streamer.Close()
+	for _, r := range roles {
+		if existing[r] {
+			continue
+		}
+		if _, err := p.RoleServiceClient.CreateRole(ctx, &idm.CreateRoleRequest{Role: &idm.Role{
+			Uuid:     r,
+			Label:    r,
+			Policies: service.NewResourcePoliciesBuilder().WithProfileRead(common.PYDIO_PROFILE_STANDARD).WithProfileWrite(common.PYDIO_PROFILE_ADMIN).Policies(),
+		}}); err != nil {
+			return err
+		}
+		existing[r] = true
+		log.Auditer(ctx).Info(
+			fmt.Sprintf("Role %s created by %s", r, p.AuthSource),
+			log.GetAuditId(common.AUDIT_ROLE_CREATE),
+			zap.String(common.KEY_ROLE_UUID, r),
+		)
+	}
+	return nil
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,451 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package dex
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/coreos/dex/connector"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/micro/go-micro/client"
+	"github.com/pborman/uuid"
+	"github.com/sirupsen/logrus"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/pydio/cells/common/auth"
+	"github.com/pydio/cells/common/proto/idm"
+)
+
+// mockIssuer is a minimal OpenID Connect provider serving discovery, keys and
+// a token endpoint that returns an ID Token built from the next claims.
+type mockIssuer struct {
+	*httptest.Server
+	key    *rsa.PrivateKey
+	claims map[string]interface{}
+}
+
+func newMockIssuer() *mockIssuer {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	m := &mockIssuer{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"issuer":                 m.URL,
+			"authorization_endpoint": m.URL + "/auth",
+			"token_endpoint":         m.URL + "/token",
+			"jwks_uri":               m.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &m.key.PublicKey, KeyID: "test", Algorithm: "RS256", Use: "sig"},
+		}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code") != "valid-code" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     m.sign(),
+		})
+	})
+	m.Server = httptest.NewServer(mux)
+	return m
+}
+
+func (m *mockIssuer) sign() string {
+	claims := map[string]interface{}{
+		"iss": m.URL,
+		"aud": "cells",
+		"exp": time.Now().Add(time.Hour).Unix(),
+		"iat": time.Now().Unix(),
+	}
+	for k, v := range m.claims {
+		claims[k] = v
+	}
+	payload, _ := json.Marshal(claims)
+	signer, _ := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: &jose.JSONWebKey{Key: m.key, KeyID: "test"}}, nil)
+	signed, _ := signer.Sign(payload)
+	raw, _ := signed.CompactSerialize()
+	return raw
+}
+
+// userClientMock stores users in memory and only supports login queries.
+type userClientMock struct {
+	users []*idm.User
+}
+
+type userStreamMock struct {
+	results []*idm.User
+}
+
+func (s *userStreamMock) SendMsg(interface{}) error { return nil }
+func (s *userStreamMock) RecvMsg(interface{}) error { return nil }
+func (s *userStreamMock) Close() error              { return nil }
+func (s *userStreamMock) Recv() (*idm.SearchUserResponse, error) {
+	if len(s.results) == 0 {
+		return nil, io.EOF
+	}
+	u := s.results[0]
+	s.results = s.results[1:]
+	return &idm.SearchUserResponse{User: u}, nil
+}
+
+func (m *userClientMock) CreateUser(ctx context.Context, in *idm.CreateUserRequest, opts ...client.CallOption) (*idm.CreateUserResponse, error) {
+	u := *in.User
+	if u.Uuid == "" {
+		u.Uuid = uuid.New()
+	}
+	for i, existing := range m.users {
+		if existing.Uuid == u.Uuid {
+			m.users[i] = &u
+			return &idm.CreateUserResponse{User: &u}, nil
+		}
+	}
+	m.users = append(m.users, &u)
+	return &idm.CreateUserResponse{User: &u}, nil
+}
+
+func (m *userClientMock) SearchUser(ctx context.Context, in *idm.SearchUserRequest, opts ...client.CallOption) (idm.UserService_SearchUserClient, error) {
+	q := &idm.UserSingleQuery{}
+	ptypes.UnmarshalAny(in.Query.SubQueries[0], q)
+	s := &userStreamMock{}
+	for _, u := range m.users {
+		if u.Login == q.Login {
+			copied := *u
+			s.results = append(s.results, &copied)
+		}
+	}
+	return s, nil
+}
+
+func (m *userClientMock) DeleteUser(ctx context.Context, in *idm.DeleteUserRequest, opts ...client.CallOption) (*idm.DeleteUserResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *userClientMock) BindUser(ctx context.Context, in *idm.BindUserRequest, opts ...client.CallOption) (*idm.BindUserResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *userClientMock) CountUser(ctx context.Context, in *idm.SearchUserRequest, opts ...client.CallOption) (*idm.CountUserResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *userClientMock) StreamUser(ctx context.Context, opts ...client.CallOption) (idm.UserService_StreamUserClient, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// roleClientMock stores roles in memory.
+type roleClientMock struct {
+	roles map[string]*idm.Role
+}
+
+type roleStreamMock struct {
+	results []*idm.Role
+}
+
+func (s *roleStreamMock) SendMsg(interface{}) error { return nil }
+func (s *roleStreamMock) RecvMsg(interface{}) error { return nil }
+func (s *roleStreamMock) Close() error              { return nil }
+func (s *roleStreamMock) Recv() (*idm.SearchRoleResponse, error) {
+	if len(s.results) == 0 {
+		return nil, io.EOF
+	}
+	r := s.results[0]
+	s.results = s.results[1:]
+	return &idm.SearchRoleResponse{Role: r}, nil
+}
+
+func (m *roleClientMock) CreateRole(ctx context.Context, in *idm.CreateRoleRequest, opts ...client.CallOption) (*idm.CreateRoleResponse, error) {
+	m.roles[in.Role.Uuid] = in.Role
+	return &idm.CreateRoleResponse{Role: in.Role}, nil
+}
+
+func (m *roleClientMock) SearchRole(ctx context.Context, in *idm.SearchRoleRequest, opts ...client.CallOption) (idm.RoleService_SearchRoleClient, error) {
+	q := &idm.RoleSingleQuery{}
+	ptypes.UnmarshalAny(in.Query.SubQueries[0], q)
+	s := &roleStreamMock{}
+	for _, id := range q.Uuid {
+		if r, ok := m.roles[id]; ok {
+			s.results = append(s.results, r)
+		}
+	}
+	return s, nil
+}
+
+func (m *roleClientMock) DeleteRole(ctx context.Context, in *idm.DeleteRoleRequest, opts ...client.CallOption) (*idm.DeleteRoleResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *roleClientMock) StreamRole(ctx context.Context, opts ...client.CallOption) (idm.RoleService_StreamRoleClient, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func roleIds(roles []string) map[string]bool {
+	out := make(map[string]bool)
+	for _, r := range roles {
+		out[r] = true
+	}
+	return out
+}
+
+func TestOIDCProvisioning(t *testing.T) {
+
+	issuer := newMockIssuer()
+	defer issuer.Close()
+
+	Convey("Test OIDC login against a mock issuer", t, func() {
+
+		config := &OIDCConfig{}
+		So(json.Unmarshal([]byte(`{
+			"provider": "keycloak",
+			"issuer": "`+issuer.URL+`",
+			"clientID": "cells",
+			"clientSecret": "secret",
+			"redirectURI": "https://cells.example.com/auth/dex/callback",
+			"provisioning": {
+				"autoCreate": true,
+				"groupPath": "/external",
+				"mappingRules": [
+					{"RuleName": "groups", "LeftAttribute": "groups", "RightAttribute": "Roles", "RolePrefix": "kc_", "RuleString": "staff,admins"},
+					{"RuleName": "realm", "LeftAttribute": "realm_access.roles", "RightAttribute": "Roles", "RuleString": "preg:^app-"},
+					{"RuleName": "dept", "LeftAttribute": "department", "RightAttribute": "GroupPath"},
+					{"RuleName": "phone", "LeftAttribute": "phone_number", "RightAttribute": "phone"}
+				]
+			}
+		}`), config), ShouldBeNil)
+
+		conn, err := config.openConnector(logrus.New())
+		So(err, ShouldBeNil)
+		users := &userClientMock{}
+		roles := &roleClientMock{roles: map[string]*idm.Role{"app-reader": {Uuid: "app-reader", Label: "Reader"}}}
+		conn.provisioner.UserServiceClient = users
+		conn.provisioner.RoleServiceClient = roles
+
+		loginURL, err := conn.LoginURL(connector.Scopes{}, config.RedirectURI, "state")
+		So(err, ShouldBeNil)
+		So(loginURL, ShouldStartWith, issuer.URL+"/auth?")
+		_, err = conn.LoginURL(connector.Scopes{}, "https://other/callback", "state")
+		So(err, ShouldNotBeNil)
+
+		callback := func(code string) (connector.Identity, error) {
+			r := httptest.NewRequest("GET", "/auth/dex/callback?state=state&code="+url.QueryEscape(code), nil)
+			return conn.HandleCallback(connector.Scopes{}, r)
+		}
+
+		issuer.claims = map[string]interface{}{
+			"sub":                "f:1234",
+			"preferred_username": "jdoe",
+			"name":               "John Doe",
+			"email":              "jdoe@example.com",
+			"email_verified":     true,
+			"groups":             []string{"staff", "visitors"},
+			"realm_access":       map[string]interface{}{"roles": []string{"app-reader", "offline_access"}},
+			"department":         "/external/sales",
+			"phone_number":       "+33 1 23 45 67 89",
+		}
+
+		ident, err := callback("valid-code")
+		So(err, ShouldBeNil)
+		So(users.users, ShouldHaveLength, 1)
+		created := users.users[0]
+		So(created.Login, ShouldEqual, "jdoe")
+		So(created.GroupPath, ShouldEqual, "/external/sales")
+		So(created.Attributes["displayName"], ShouldEqual, "John Doe")
+		So(created.Attributes["email"], ShouldEqual, "jdoe@example.com")
+		So(created.Attributes["profile"], ShouldEqual, "standard")
+		So(created.Attributes["phone"], ShouldEqual, "+33 1 23 45 67 89")
+		So(created.Attributes[AttributeAuthSource], ShouldEqual, "oidc-keycloak")
+
+		So(ident.UserID, ShouldEqual, created.Uuid)
+		So(ident.Username, ShouldEqual, "jdoe")
+		So(ident.EmailVerified, ShouldBeTrue)
+		So(ident.AuthSource, ShouldEqual, "oidc-keycloak")
+		So(ident.GroupPath, ShouldEqual, "/external/sales")
+		So(roleIds(ident.Roles), ShouldResemble, roleIds([]string{"kc_staff", "app-reader"}))
+
+		// User role and missing mapped roles are created, existing ones are kept
+		So(roles.roles, ShouldContainKey, created.Uuid)
+		So(roles.roles[created.Uuid].UserRole, ShouldBeTrue)
+		So(roles.roles, ShouldContainKey, "kc_staff")
+		So(roles.roles["app-reader"].Label, ShouldEqual, "Reader")
+
+		Convey("Second login resynchronizes prefixed roles only", func() {
+			users.users[0].Roles = append(users.users[0].Roles, &idm.Role{Uuid: "manual"})
+			issuer.claims["groups"] = []string{"admins"}
+
+			ident, err := callback("valid-code")
+			So(err, ShouldBeNil)
+			So(users.users, ShouldHaveLength, 1)
+			So(roleIds(ident.Roles), ShouldResemble, roleIds([]string{"kc_admins", "app-reader", "manual"}))
+		})
+
+		Convey("Locked users are refused", func() {
+			users.users[0].Attributes["locks"] = `["logout"]`
+			_, err := callback("valid-code")
+			So(err, ShouldNotBeNil)
+			_, err = conn.Refresh(context.Background(), connector.Scopes{}, ident)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Users of another source are never bound", func() {
+			users.users = append(users.users, &idm.User{Uuid: "admin-uuid", Login: "admin", Attributes: map[string]string{"profile": "admin"}})
+			users.users = append(users.users, &idm.User{Uuid: "ldap-uuid", Login: "ldapuser", Attributes: map[string]string{AttributeAuthSource: "pydio_ldap"}})
+			conn.provisioner.LinkExistingUsers = true
+			issuer.claims["preferred_username"] = "admin"
+			_, err := callback("valid-code")
+			So(err, ShouldNotBeNil)
+			So(users.users[1].Attributes, ShouldNotContainKey, AttributeAuthSource)
+			issuer.claims["preferred_username"] = "ldapuser"
+			_, err = callback("valid-code")
+			So(err, ShouldNotBeNil)
+			So(users.users[2].Attributes[AttributeAuthSource], ShouldEqual, "pydio_ldap")
+		})
+
+		Convey("Internal users are only linked when explicitly allowed", func() {
+			users.users = append(users.users, &idm.User{Uuid: "local-uuid", Login: "local", Attributes: map[string]string{"profile": "standard"}})
+			issuer.claims["preferred_username"] = "local"
+			_, err := callback("valid-code")
+			So(err, ShouldNotBeNil)
+			conn.provisioner.LinkExistingUsers = true
+			ident, err := callback("valid-code")
+			So(err, ShouldBeNil)
+			So(ident.UserID, ShouldEqual, "local-uuid")
+			So(users.users[1].Attributes[AttributeAuthSource], ShouldEqual, "oidc-keycloak")
+		})
+
+		Convey("Unverified emails are refused when used as login", func() {
+			delete(issuer.claims, "preferred_username")
+			issuer.claims["email_verified"] = false
+			_, err := callback("valid-code")
+			So(err, ShouldNotBeNil)
+			So(users.users, ShouldHaveLength, 1)
+		})
+
+		Convey("Unknown users are refused without auto-provisioning", func() {
+			conn.provisioner.AutoCreate = false
+			issuer.claims["preferred_username"] = "other"
+			_, err := callback("valid-code")
+			So(err, ShouldNotBeNil)
+			So(users.users, ShouldHaveLength, 1)
+		})
+
+		Convey("Provider errors are reported", func() {
+			_, err := callback("bad-code")
+			So(err, ShouldNotBeNil)
+			r := httptest.NewRequest("GET", "/auth/dex/callback?error=access_denied", nil)
+			_, err = conn.HandleCallback(connector.Scopes{}, r)
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Test OIDC provider presets", t, func() {
+		google := &OIDCConfig{Provider: OIDCProviderGoogle}
+		So(google.applyPresets(), ShouldBeNil)
+		So(google.Issuer, ShouldEqual, "https://accounts.google.com")
+		So(google.UsernameClaim, ShouldEqual, "email")
+
+		keycloak := &OIDCConfig{Provider: OIDCProviderKeycloak, KeycloakURL: "https://sso.example.com/", KeycloakRealm: "cells"}
+		So(keycloak.applyPresets(), ShouldBeNil)
+		So(keycloak.Issuer, ShouldEqual, "https://sso.example.com/auth/realms/cells")
+
+		So((&OIDCConfig{}).applyPresets(), ShouldNotBeNil)
+		So((&OIDCConfig{Provider: "unknown", Issuer: "https://example.com"}).applyPresets(), ShouldNotBeNil)
+	})
+}
+
+func TestMapClaims(t *testing.T) {
+
+	Convey("Test mapping rules applied to claims", t, func() {
+		p := &provisioner{ProvisioningConfig: ProvisioningConfig{MappingRules: []auth.MappingRule{
+			{LeftAttribute: "memberOf", RightAttribute: MappingRoles, RolePrefix: "saml_"},
+			{LeftAttribute: "ou", RightAttribute: MappingGroupPath},
+			{LeftAttribute: "mail", RightAttribute: "email", RuleString: "preg:@example\\.com$"},
+		}}}
+		mapped := p.mapClaims(map[string][]string{
+			"memberOf": {"cn=teachers,ou=groups,dc=example,dc=com", " students "},
+			"ou":       {"staff/"},
+			"mail":     {"john@other.org", "john@example.com"},
+		})
+		So(mapped.roles, ShouldResemble, []string{"saml_teachers", "saml_students"})
+		So(mapped.prefixes, ShouldResemble, []string{"saml_"})
+		So(mapped.groupPath, ShouldEqual, "/staff")
+		So(mapped.attributes, ShouldResemble, map[string]string{"email": "john@example.com"})
+	})
+
+	Convey("Test flattening of JSON claims", t, func() {
+		var raw map[string]interface{}
+		json.Unmarshal([]byte(`{"sub":"1","age":42,"verified":true,"groups":["a","b"],"realm_access":{"roles":["r1"]},"empty":null}`), &raw)
+		claims := flattenClaims(raw)
+		So(claims["age"], ShouldResemble, []string{"42"})
+		So(claims["verified"], ShouldResemble, []string{"true"})
+		So(claims["groups"], ShouldResemble, []string{"a", "b"})
+		So(claims["realm_access.roles"], ShouldResemble, []string{"r1"})
+		So(claims, ShouldNotContainKey, "empty")
+		So(firstClaim(claims, "missing", "sub"), ShouldEqual, "1")
+	})
+}
+
+func TestSAMLAttributes(t *testing.T) {
+
+	Convey("Test reading attributes from a SAML response", t, func() {
+		response := `<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion">
+  <saml:Assertion>
+    <saml:Subject><saml:NameID>jdoe</saml:NameID></saml:Subject>
+    <saml:AttributeStatement>
+      <saml:Attribute Name="urn:oid:1.3.6.1.4.1.5923.1.1.1.1" FriendlyName="eduPersonAffiliation">
+        <saml:AttributeValue>staff</saml:AttributeValue>
+        <saml:AttributeValue>member</saml:AttributeValue>
+      </saml:Attribute>
+      <saml:Attribute Name="mail"><saml:AttributeValue> jdoe@example.com </saml:AttributeValue></saml:Attribute>
+    </saml:AttributeStatement>
+  </saml:Assertion>
+</samlp:Response>`
+		nameID, attributes, err := samlAttributes(base64.StdEncoding.EncodeToString([]byte(response)))
+		So(err, ShouldBeNil)
+		So(nameID, ShouldEqual, "jdoe")
+		So(attributes["eduPersonAffiliation"], ShouldResemble, []string{"staff", "member"})
+		So(attributes["urn:oid:1.3.6.1.4.1.5923.1.1.1.1"], ShouldResemble, []string{"staff", "member"})
+		So(attributes["mail"], ShouldResemble, []string{"jdoe@example.com"})
+
+		wrapped := `<Response><Assertion><Subject><NameID>a</NameID></Subject></Assertion><Assertion><Subject><NameID>b</NameID></Subject></Assertion></Response>`
+		_, _, err = samlAttributes(base64.StdEncoding.EncodeToString([]byte(wrapped)))
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package dex
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/coreos/dex/connector"
+	"github.com/coreos/dex/connector/saml"
+	"github.com/sirupsen/logrus"
+)
+
+// SAMLConfig configures an upstream SAML 2.0 identity provider. The response is
+// validated by the standard dex SAML connector, then all assertion attributes
+// are made available to the provisioning mapping rules.
+type SAMLConfig struct {
+	saml.Config
+	Provisioning ProvisioningConfig `json:"provisioning"`
+}
+
+// Open validates the config and returns a SAML connector.
+func (c *SAMLConfig) Open(logger logrus.FieldLogger) (connector.Connector, error) {
+	conn, err := c.Config.Open(logger)
+	if err != nil {
+		return nil, err
+	}
+	samlConn, ok := conn.(connector.SAMLConnector)
+	if !ok {
+		return nil, fmt.Errorf("saml connector does not implement the POST binding")
+	}
+	return &pydioSAMLConnector{
+		SAMLConnector: samlConn,
+		logger:        logger,
+		provisioner:   newProvisioner(c.Provisioning, "saml", logger),
+	}, nil
+}
+
+type pydioSAMLConnector struct {
+	connector.SAMLConnector
+	logger      logrus.FieldLogger
+	provisioner *provisioner
+}
+
+var (
+	_ connector.SAMLConnector    = (*pydioSAMLConnector)(nil)
+	_ connector.RefreshConnector = (*pydioSAMLConnector)(nil)
+)
+
+// HandlePOST verifies the response with the wrapped connector and provisions the user.
+func (c *pydioSAMLConnector) HandlePOST(s connector.Scopes, samlResponse, inResponseTo string) (connector.Identity, error) {
+	ident, err := c.SAMLConnector.HandlePOST(s, samlResponse, inResponseTo)
+	if err != nil {
+		return ident, err
+	}
+	nameID, attributes, err := samlAttributes(samlResponse)
+	if err != nil {
+		return connector.Identity{}, err
+	}
+	// Make sure the attributes are read from the assertion that was verified.
+	if nameID != ident.UserID {
+		return connector.Identity{}, fmt.Errorf("saml: unexpected NameID %q", nameID)
+	}
+	return c.provisioner.Provision(context.Background(), ident, attributes)
+}
+
+// Refresh reloads the user from the directory.
+func (c *pydioSAMLConnector) Refresh(ctx context.Context, s connector.Scopes, identity connector.Identity) (connector.Identity, error) {
+	return c.provisioner.Refresh(ctx, identity)
+}
+
+type samlResponseAttributes struct {
+	Assertions []struct {
+		NameID     string `xml:"Subject>NameID"`
+		Attributes []struct {
+			Name         string   `xml:"Name,attr"`
+			FriendlyName string   `xml:"FriendlyName,attr"`
+			Values       []string `xml:"AttributeValue"`
+		} `xml:"AttributeStatement>Attribute"`
+	} `xml:"Assertion"`
+}
+
+// samlAttributes extracts the subject and the attributes of the single assertion
+// contained in a base64 encoded SAML response. Attributes are indexed both by
+// Name and FriendlyName.
+func samlAttributes(samlResponse string) (string, map[string][]string, error) {
+	rawResp, err := base64.StdEncoding.DecodeString(samlResponse)
+	if err != nil {
+		return "", nil, fmt.Errorf("decode response: %v", err)
+	}
+	var resp samlResponseAttributes
+	if err := xml.Unmarshal(rawResp, &resp); err != nil {
+		return "", nil, fmt.Errorf("unmarshal response: %v", err)
+	}
+	if len(resp.Assertions) != 1 {
+		return "", nil, fmt.Errorf("saml: expected one assertion, got %d", len(resp.Assertions))
+	}
+	assertion := resp.Assertions[0]
+	attributes := make(map[string][]string)
+	for _, a := range assertion.Attributes {
+		var values []string
+		for _, v := range a.Values {
+			values = append(values, strings.TrimSpace(v))
+		}
+		if a.Name != "" {
+			attributes[a.Name] = append(attributes[a.Name], values...)
+		}
+		if a.FriendlyName != "" && a.FriendlyName != a.Name {
+			attributes[a.FriendlyName] = append(attributes[a.FriendlyName], values...)
+		}
+	}
+	return strings.TrimSpace(assertion.NameID), attributes, nil
+}
@@ -226,15 +226,21 @@ func (p *pydioWrapperConnector) getConnectorList(logger logrus.FieldLogger) (con
 		if er != nil {
 			logger.Errorf(er.Error())
 		}
+		passwordConnector, ok := connConnector.(interface {
+			connector.Connector
+			connector.PasswordConnector
+			connector.RefreshConnector
+		})
+		if !ok {
+			// Callback connectors (oidc, saml) must be declared as dex connectors.
+			logger.Errorf("connector %s of type %s does not support password login, skipping", connConfig.Name, connConfig.Type)
+			continue
+		}
 		connConnectorFull := ConnectorList{
-			Type: connConfig.Type,
-			Name: connConfig.Name,
-			ID:   connConfig.ID,
-			Connector: connConnector.(interface {
-				connector.Connector
-				connector.PasswordConnector
-				connector.RefreshConnector
-			}),
+			Type:      connConfig.Type,
+			Name:      connConfig.Name,
+			ID:        connConfig.ID,
+			Connector: passwordConnector,
 		}
 		connectorList = append(connectorList, connConnectorFull)
 	}
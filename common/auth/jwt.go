@@ -22,12 +22,16 @@ package auth
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/coreos/dex/storage"
 	"github.com/coreos/go-oidc"
+	proto2 "github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	errors2 "github.com/micro/go-micro/errors"
@@ -46,6 +50,13 @@ import (
 	"github.com/pydio/cells/common/service/proto"
 )
 
+const (
+	// PersonalTokenAuthSource is used as auth source and client app in claims built from a personal access token
+	PersonalTokenAuthSource = "personal-token"
+	// ImpersonationAuthSource is used instead when the token was issued for an admin "login as" session
+	ImpersonationAuthSource = "impersonation"
+)
+
 // Config is the config format for the main application.
 type simpleConfig struct {
 	Issuer string `json:"issuer"`
@@ -74,9 +85,14 @@ type JWTVerifier struct {
 	ClientSecret string
 }
 
-// Verify validates an existing JWT token against the OIDC service that issued it
+// Verify validates an existing JWT token against the OIDC service that issued it.
+// Personal access tokens are also accepted and checked against the AuthTokenRevoker service.
 func (j *JWTVerifier) Verify(ctx context.Context, rawIDToken string) (context.Context, claim.Claims, error) {
 
+	if auth.IsPersonalToken(rawIDToken) {
+		return j.verifyPersonalToken(ctx, rawIDToken)
+	}
+
 	claims := claim.Claims{}
 
 	provider, err := oidc.NewProvider(ctx, j.IssuerUrl)
@@ -119,6 +135,74 @@ func (j *JWTVerifier) Verify(ctx context.Context, rawIDToken string) (context.Co
 		return ctx, claims, errors.New("Cannot find name inside claims")
 	}
 
+	return claimsToContext(ctx, claims), claims, nil
+
+}
+
+// verifyPersonalToken finds the personal access token and builds claims from its owner's current profile,
+// restricted by the token scopes.
+func (j *JWTVerifier) verifyPersonalToken(ctx context.Context, value string) (context.Context, claim.Claims, error) {
+
+	claims := claim.Claims{}
+
+	cli := auth.NewAuthTokenRevokerClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_AUTH, defaults.NewClient())
+	rsp, err := cli.VerifyPersonalToken(ctx, &auth.VerifyPersonalTokenRequest{AccessToken: value})
+	if err != nil {
+		log.Logger(ctx).Error("verify personal token", zap.Error(err))
+		return ctx, claims, err
+	}
+	token := rsp.GetToken()
+
+	user, err := loadUser(ctx, token.UserUuid)
+	if err != nil {
+		log.Logger(ctx).Error("verify personal token", zap.Error(err))
+		return ctx, claims, err
+	}
+	if user.IsLockedOut() {
+		log.Logger(ctx).Error("verify personal token: user is locked out", zap.String("login", user.Login))
+		return ctx, claims, errors2.Unauthorized(common.SERVICE_AUTH, "user %s is locked out", user.Login)
+	}
+
+	var roles []string
+	for _, r := range user.Roles {
+		roles = append(roles, r.Uuid)
+	}
+	profile := user.Attributes["profile"]
+	if profile == "" {
+		profile = common.PYDIO_PROFILE_STANDARD
+	}
+	source := PersonalTokenAuthSource
+	if token.Impersonator != "" {
+		source = ImpersonationAuthSource
+	}
+	subject, _ := proto2.Marshal(&claim.IDTokenSubject{UserId: user.Uuid, ConnId: source})
+
+	claims = claim.Claims{
+		ClientApp:   source,
+		Issuer:      j.IssuerUrl,
+		Subject:     base64.RawURLEncoding.EncodeToString(subject),
+		Name:        user.Login,
+		Email:       user.Attributes["email"],
+		Profile:     profile,
+		Verified:    true,
+		Roles:       strings.Join(roles, ","),
+		AuthSource:  source,
+		DisplayName: user.Attributes["displayName"],
+		GroupPath:   user.GroupPath,
+		Scopes:      token.Scopes,
+
+		Impersonator: token.Impersonator,
+		Expiry:       time.Unix(int64(token.ExpiresAt), 0),
+	}
+	if claims.Profile == common.PYDIO_PROFILE_ADMIN && claims.HasScope(claim.ScopeNoAdmin) {
+		claims.Profile = common.PYDIO_PROFILE_STANDARD
+	}
+
+	return claimsToContext(ctx, claims), claims, nil
+}
+
+// claimsToContext stores the claims in the context and in the outgoing metadata
+func claimsToContext(ctx context.Context, claims claim.Claims) context.Context {
 	ctx = context.WithValue(ctx, claim.ContextKey, claims)
 	md := make(map[string]string)
 	if existing, ok := metadata.FromContext(ctx); ok {
@@ -129,10 +213,30 @@ func (j *JWTVerifier) Verify(ctx context.Context, rawIDToken string) (context.Co
 	md[common.PYDIO_CONTEXT_USER_KEY] = claims.Name
 	jsonClaims, _ := json.Marshal(claims)
 	md[claim.MetadataContextKey] = string(jsonClaims)
-	ctx = metadata.NewContext(ctx, md)
-
-	return ctx, claims, nil
+	return metadata.NewContext(ctx, md)
+}
 
+// loadUser finds a user by its uuid
+func loadUser(ctx context.Context, userUuid string) (*idm.User, error) {
+	subQ, _ := ptypes.MarshalAny(&idm.UserSingleQuery{Uuid: userUuid})
+	uClient := idm.NewUserServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_USER, defaults.NewClient())
+	stream, err := uClient.SearchUser(ctx, &idm.SearchUserRequest{
+		Query: &service.Query{SubQueries: []*any.Any{subQ}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp.GetUser() != nil {
+			return resp.GetUser(), nil
+		}
+	}
+	return nil, errors2.NotFound(common.SERVICE_USER, "cannot find user %s", userUuid)
 }
 
 // PasswordCredentialsToken will perform a call to the OIDC service with grantType "password"
@@ -200,6 +304,19 @@ func WithImpersonate(ctx context.Context, user *idm.User) context.Context {
 	return context.WithValue(ctx, claim.ContextKey, c)
 }
 
+// AuditImpersonatedRequest records a request made during an admin "login as" session in the audit log.
+// Both identities are logged, as the audit logger reads the user and the impersonator from the context claims.
+func AuditImpersonatedRequest(ctx context.Context, method string, path string) {
+	claims, ok := ctx.Value(claim.ContextKey).(claim.Claims)
+	if !ok || claims.Impersonator == "" {
+		return
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("%s acting as %s: %s %s", claims.Impersonator, claims.Name, method, path),
+		log.GetAuditId(common.AUDIT_IMPERSONATE_REQUEST),
+	)
+}
+
 // SubjectsForResourcePolicyQuery prepares a slice of strings that will be used to check for resource ownership.
 // Can be extracted either from context or by loading a given user ID from database.
 func SubjectsForResourcePolicyQuery(ctx context.Context, q *rest.ResourcePolicyQuery) (subjects []string, err error) {
@@ -54,6 +54,8 @@ type CaddyTemplateConf struct {
 	DAV *url.URL
 	// WOPI server
 	WOPI *url.URL
+	// SCIM provisioning server
+	SCIM *url.URL
 	// Collabora definition from plugins
 	Collabora *url.URL
 
@@ -86,6 +88,7 @@ var (
 	proxy /io   {{.Gateway.Host}} {
 		transparent
 	}
+	header /io/pydio-thumbstore Vary Accept
 	proxy /ws   {{.WebSocket.Host}} {
 		websocket
 		without /ws
@@ -99,6 +102,13 @@ var (
 		without /dav/
 	}
 
+	{{if .SCIM}}
+	proxy /scim/ {{.SCIM.Host}} {
+		transparent
+		without /scim
+	}
+	{{end}}
+
 	{{if .Collabora}}
 	proxy /wopi/ {{.WOPI.Host}} {
 		transparent
@@ -141,6 +151,7 @@ var (
 		if {path} not_starts_with "/plug/"
 		if {path} not_starts_with "/dav/"
 		if {path} not_starts_with "/wopi/"
+		if {path} not_starts_with "/scim/"
 		if {path} not_starts_with "/loleaflet/"
 		if {path} not_starts_with "/hosting/discovery"
 		if {path} not_starts_with "/lool/"
@@ -178,7 +189,7 @@ func LoadCaddyConf() (*CaddyTemplateConf, error) {
 	} else {
 		c.Bind, _ = url.Parse(bindUrl)
 	}
-	servicesHost := "localhost" // This should be detected on a per-service basis
+	servicesHost := GetServicesHost() // This should be detected on a per-service basis
 
 	tls := Get("cert", "proxy", "ssl").Bool(false)
 	if tls {
@@ -247,6 +258,12 @@ func LoadCaddyConf() (*CaddyTemplateConf, error) {
 		return c, e
 	}
 
+	if p, e := internalUrlFromConfig("scim", []string{"services", "pydio.rest.gateway.scim", "port"}, servicesHost, tls); e == nil {
+		c.SCIM = p
+	} else {
+		c.SCIM = nil
+	}
+
 	if p, e := internalUrlFromConfig("collabora", []string{"frontend", "plugin", "editor.libreoffice", "LIBREOFFICE_WEBSOCKET_PORT"}, servicesHost, tls); e == nil {
 		c.Collabora = p
 	} else {
@@ -268,6 +285,12 @@ func LoadCaddyConf() (*CaddyTemplateConf, error) {
 	return c, nil
 }
 
+// GetServicesHost returns the host on which the proxy reaches the internal services. Gateways that are
+// only exposed through the proxy listen on this host.
+func GetServicesHost() string {
+	return Get("defaults", "servicesHost").String("localhost")
+}
+
 func internalUrlFromConfig(name string, path []string, host string, tls bool, split ...bool) (*url.URL, error) {
 	port := Get(path...).String("")
 	if port == "" {
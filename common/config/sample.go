@@ -129,6 +129,23 @@ var SampleConfig = `{
             "datasource" : "default",
             "bucket"     : "versions"
         },
+        "pydio.grpc.log": {
+            "retention": {
+                "audit": {
+                    "maxAge": "8760h",
+                    "archive": false
+                },
+                "technical": {
+                    "maxAge": "720h",
+                    "maxSize": 10737418240
+                }
+            },
+            "archive": {
+                "datasource": "default",
+                "bucket": "logs",
+                "prefix": "syslog"
+            }
+        },
         "pydio.grpc.search": {
             "indexContent": false
         },
@@ -129,12 +129,23 @@ func EncodePrivate(password []byte, key crypto.PrivateKey) ([]byte, error) {
 	return Seal(pk, bytes)
 }
 
+// ParsePrivate decodes a private key previously encoded with EncodePrivate and the same password.
 func ParsePrivate(password []byte, bytes []byte) (crypto.PrivateKey, error) {
+	if len(bytes) < 12 {
+		return nil, fmt.Errorf("invalid key data len %d", len(bytes))
+	}
 	var err error
-	bytes, err = Open(password, bytes[:12], bytes[12:])
+	pk := KeyFromPassword(password, 32)
+	bytes, err = Open(pk, bytes[:12], bytes[12:])
 	if err != nil {
 		return nil, err
 	}
+	if key, e := x509.ParseECPrivateKey(bytes); e == nil {
+		return key, nil
+	}
+	if key, e := x509.ParsePKCS1PrivateKey(bytes); e == nil {
+		return key, nil
+	}
 	return x509.ParsePKCS8PrivateKey(bytes)
 }
 
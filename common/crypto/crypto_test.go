@@ -21,6 +21,7 @@
 package crypto
 
 import (
+	"crypto/ecdsa"
 	"crypto/rand"
 	"encoding/base64"
 	"testing"
@@ -57,4 +58,23 @@ func Test(t *testing.T) {
 		convey.So(base64.StdEncoding.EncodeToString(deciphered), convey.ShouldEqual, base64.StdEncoding.EncodeToString(plain))
 	})
 
+	convey.Convey("Encode and parse private keys", t, func() {
+		k, err := NewEcdsaPrivateKey("p256")
+		convey.So(err, convey.ShouldBeNil)
+
+		encoded, err := EncodePrivate([]byte(password), k)
+		convey.So(err, convey.ShouldBeNil)
+
+		parsed, err := ParsePrivate([]byte(password), encoded)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(parsed.(*ecdsa.PrivateKey).D.Cmp(k.D), convey.ShouldEqual, 0)
+
+		signature, err := GetSignature(k, plain)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(VerifySignature(plain, &parsed.(*ecdsa.PrivateKey).PublicKey, signature), convey.ShouldBeTrue)
+
+		_, err = ParsePrivate([]byte("wrong"), encoded)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
 }
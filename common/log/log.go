@@ -23,10 +23,12 @@ package log
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"time"
 
 	micro "github.com/micro/go-log"
@@ -183,6 +185,20 @@ func GetAuditId(msgId string) zapcore.Field {
 	return zap.String(common.KEY_MSG_ID, msgId)
 }
 
+// GetAuditContext returns a zap field that is stored and indexed in the AuditContext of the audit message.
+// Values that are not strings are serialized to JSON.
+func GetAuditContext(key string, value interface{}) zapcore.Field {
+	var str string
+	switch v := value.(type) {
+	case string:
+		str = v
+	default:
+		data, _ := json.Marshal(v)
+		str = string(data)
+	}
+	return zap.String(common.KEY_AUDIT_CONTEXT+"."+strings.Replace(key, ".", "_", -1), str)
+}
+
 type micrologger struct {
 	*zap.Logger
 }
@@ -248,6 +264,9 @@ func fillLogContext(ctx context.Context, logger *zap.Logger) *zap.Logger {
 			zap.String(common.KEY_PROFILE, claims.Profile),
 			zap.String(common.KEY_ROLES, claims.Roles),
 		)
+		if claims.Impersonator != "" {
+			logger = logger.With(zap.String(common.KEY_IMPERSONATOR, claims.Impersonator))
+		}
 	}
 	return logger
 }
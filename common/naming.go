@@ -72,6 +72,7 @@ const (
 
 	SERVICE_ACTIVITY   = "activity"
 	SERVICE_MAILER     = "mailer"
+	SERVICE_WEBHOOK    = "webhook"
 	SERVICE_WEBSOCKET  = "websocket"
 	SERVICE_CHAT       = "chat"
 	SERVICE_FRONTEND   = "frontend"
@@ -96,6 +97,7 @@ const (
 	SERVICE_GATEWAY_DATA  = SERVICE_GATEWAY + ".data"
 	SERVICE_GATEWAY_DAV   = SERVICE_GATEWAY + ".dav"
 	SERVICE_GATEWAY_WOPI  = SERVICE_GATEWAY + ".wopi"
+	SERVICE_GATEWAY_SCIM  = SERVICE_GATEWAY + ".scim"
 	SERVICE_MICRO_API     = "micro.api"
 
 	SERVICE_GRPC_NAMESPACE_ = "pydio.grpc."
@@ -117,6 +119,7 @@ const (
 	META_NAMESPACE_DATASOURCE_NAME        = "pydio:meta-data-source-name"
 	META_NAMESPACE_DATASOURCE_PATH        = "pydio:meta-data-source-path"
 	META_NAMESPACE_NODE_TEST_LOCAL_FOLDER = "pydio:test:local-folder-storage"
+	META_NAMESPACE_GEOLOCATION            = "GeoLocation"
 
 	PYDIO_THUMBSTORE_NAMESPACE        = "pydio-thumbstore"
 	PYDIO_DOCSTORE_BINARIES_NAMESPACE = "pydio-binaries"
@@ -15,6 +15,12 @@ It has these top-level messages:
 	StreamActivitiesRequest
 	StreamActivitiesResponse
 	Subscription
+	QuietHours
+	NotificationPreferences
+	GetNotificationPreferencesRequest
+	GetNotificationPreferencesResponse
+	PutNotificationPreferencesRequest
+	PutNotificationPreferencesResponse
 	SubscribeRequest
 	SubscribeResponse
 	SearchSubscriptionsRequest
@@ -23,6 +29,8 @@ It has these top-level messages:
 	UnreadActivitiesResponse
 	UserLastActivityRequest
 	UserLastActivityResponse
+	PurgeActivitiesRequest
+	PurgeActivitiesResponse
 */
 package activity
 
@@ -62,6 +70,9 @@ type ActivityServiceClient interface {
 	SetUserLastActivity(ctx context.Context, in *UserLastActivityRequest, opts ...client.CallOption) (*UserLastActivityResponse, error)
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...client.CallOption) (*SubscribeResponse, error)
 	SearchSubscriptions(ctx context.Context, in *SearchSubscriptionsRequest, opts ...client.CallOption) (ActivityService_SearchSubscriptionsClient, error)
+	PurgeActivities(ctx context.Context, in *PurgeActivitiesRequest, opts ...client.CallOption) (*PurgeActivitiesResponse, error)
+	GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...client.CallOption) (*GetNotificationPreferencesResponse, error)
+	PutNotificationPreferences(ctx context.Context, in *PutNotificationPreferencesRequest, opts ...client.CallOption) (*PutNotificationPreferencesResponse, error)
 }
 
 type activityServiceClient struct {
@@ -236,6 +247,36 @@ func (x *activityServiceSearchSubscriptionsClient) Recv() (*SearchSubscriptionsR
 	return m, nil
 }
 
+func (c *activityServiceClient) PurgeActivities(ctx context.Context, in *PurgeActivitiesRequest, opts ...client.CallOption) (*PurgeActivitiesResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "ActivityService.PurgeActivities", in)
+	out := new(PurgeActivitiesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...client.CallOption) (*GetNotificationPreferencesResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "ActivityService.GetNotificationPreferences", in)
+	out := new(GetNotificationPreferencesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) PutNotificationPreferences(ctx context.Context, in *PutNotificationPreferencesRequest, opts ...client.CallOption) (*PutNotificationPreferencesResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "ActivityService.PutNotificationPreferences", in)
+	out := new(PutNotificationPreferencesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ActivityService service
 
 type ActivityServiceHandler interface {
@@ -245,6 +286,9 @@ type ActivityServiceHandler interface {
 	SetUserLastActivity(context.Context, *UserLastActivityRequest, *UserLastActivityResponse) error
 	Subscribe(context.Context, *SubscribeRequest, *SubscribeResponse) error
 	SearchSubscriptions(context.Context, *SearchSubscriptionsRequest, ActivityService_SearchSubscriptionsStream) error
+	PurgeActivities(context.Context, *PurgeActivitiesRequest, *PurgeActivitiesResponse) error
+	GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest, *GetNotificationPreferencesResponse) error
+	PutNotificationPreferences(context.Context, *PutNotificationPreferencesRequest, *PutNotificationPreferencesResponse) error
 }
 
 func RegisterActivityServiceHandler(s server.Server, hdlr ActivityServiceHandler, opts ...server.HandlerOption) {
@@ -371,3 +415,15 @@ func (x *activityServiceSearchSubscriptionsStream) RecvMsg(m interface{}) error
 func (x *activityServiceSearchSubscriptionsStream) Send(m *SearchSubscriptionsResponse) error {
 	return x.stream.Send(m)
 }
+
+func (h *ActivityService) PurgeActivities(ctx context.Context, in *PurgeActivitiesRequest, out *PurgeActivitiesResponse) error {
+	return h.ActivityServiceHandler.PurgeActivities(ctx, in, out)
+}
+
+func (h *ActivityService) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, out *GetNotificationPreferencesResponse) error {
+	return h.ActivityServiceHandler.GetNotificationPreferences(ctx, in, out)
+}
+
+func (h *ActivityService) PutNotificationPreferences(ctx context.Context, in *PutNotificationPreferencesRequest, out *PutNotificationPreferencesResponse) error {
+	return h.ActivityServiceHandler.PutNotificationPreferences(ctx, in, out)
+}
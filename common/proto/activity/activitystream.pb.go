@@ -15,6 +15,12 @@ It has these top-level messages:
 	StreamActivitiesRequest
 	StreamActivitiesResponse
 	Subscription
+	QuietHours
+	NotificationPreferences
+	GetNotificationPreferencesRequest
+	GetNotificationPreferencesResponse
+	PutNotificationPreferencesRequest
+	PutNotificationPreferencesResponse
 	SubscribeRequest
 	SubscribeResponse
 	SearchSubscriptionsRequest
@@ -23,6 +29,8 @@ It has these top-level messages:
 	UnreadActivitiesResponse
 	UserLastActivityRequest
 	UserLastActivityResponse
+	PurgeActivitiesRequest
+	PurgeActivitiesResponse
 */
 package activity
 
@@ -299,6 +307,34 @@ func (x OwnerType) String() string {
 }
 func (OwnerType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
+// How a user is notified of the activities posted to its inbox
+type NotificationDelivery int32
+
+const (
+	NotificationDelivery_DEFAULT NotificationDelivery = 0
+	NotificationDelivery_IN_APP  NotificationDelivery = 1
+	NotificationDelivery_DIGEST  NotificationDelivery = 2
+	NotificationDelivery_INSTANT NotificationDelivery = 3
+)
+
+var NotificationDelivery_name = map[int32]string{
+	0: "DEFAULT",
+	1: "IN_APP",
+	2: "DIGEST",
+	3: "INSTANT",
+}
+var NotificationDelivery_value = map[string]int32{
+	"DEFAULT": 0,
+	"IN_APP":  1,
+	"DIGEST":  2,
+	"INSTANT": 3,
+}
+
+func (x NotificationDelivery) String() string {
+	return proto.EnumName(NotificationDelivery_name, int32(x))
+}
+func (NotificationDelivery) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
 type Object struct {
 	JsonLdContext string                     `protobuf:"bytes,53,opt,name=jsonLdContext,json=@context" json:"jsonLdContext,omitempty"`
 	Type          ObjectType                 `protobuf:"varint,1,opt,name=type,enum=activity.ObjectType" json:"type,omitempty"`
@@ -979,10 +1015,11 @@ func (m *StreamActivitiesResponse) GetActivity() *Object {
 }
 
 type Subscription struct {
-	UserId     string    `protobuf:"bytes,1,opt,name=UserId" json:"UserId,omitempty"`
-	ObjectType OwnerType `protobuf:"varint,2,opt,name=ObjectType,enum=activity.OwnerType" json:"ObjectType,omitempty"`
-	ObjectId   string    `protobuf:"bytes,3,opt,name=ObjectId" json:"ObjectId,omitempty"`
-	Events     []string  `protobuf:"bytes,4,rep,name=Events" json:"Events,omitempty"`
+	UserId     string               `protobuf:"bytes,1,opt,name=UserId" json:"UserId,omitempty"`
+	ObjectType OwnerType            `protobuf:"varint,2,opt,name=ObjectType,enum=activity.OwnerType" json:"ObjectType,omitempty"`
+	ObjectId   string               `protobuf:"bytes,3,opt,name=ObjectId" json:"ObjectId,omitempty"`
+	Events     []string             `protobuf:"bytes,4,rep,name=Events" json:"Events,omitempty"`
+	Delivery   NotificationDelivery `protobuf:"varint,5,opt,name=Delivery,enum=activity.NotificationDelivery" json:"Delivery,omitempty"`
 }
 
 func (m *Subscription) Reset()                    { *m = Subscription{} }
@@ -1018,6 +1055,158 @@ func (m *Subscription) GetEvents() []string {
 	return nil
 }
 
+func (m *Subscription) GetDelivery() NotificationDelivery {
+	if m != nil {
+		return m.Delivery
+	}
+	return NotificationDelivery_DEFAULT
+}
+
+// Daily time range during which instant emails are deferred to the digest
+type QuietHours struct {
+	StartMinute int32  `protobuf:"varint,1,opt,name=StartMinute" json:"StartMinute,omitempty"`
+	EndMinute   int32  `protobuf:"varint,2,opt,name=EndMinute" json:"EndMinute,omitempty"`
+	TimeZone    string `protobuf:"bytes,3,opt,name=TimeZone" json:"TimeZone,omitempty"`
+}
+
+func (m *QuietHours) Reset()                    { *m = QuietHours{} }
+func (m *QuietHours) String() string            { return proto.CompactTextString(m) }
+func (*QuietHours) ProtoMessage()               {}
+func (*QuietHours) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *QuietHours) GetStartMinute() int32 {
+	if m != nil {
+		return m.StartMinute
+	}
+	return 0
+}
+
+func (m *QuietHours) GetEndMinute() int32 {
+	if m != nil {
+		return m.EndMinute
+	}
+	return 0
+}
+
+func (m *QuietHours) GetTimeZone() string {
+	if m != nil {
+		return m.TimeZone
+	}
+	return ""
+}
+
+type NotificationPreferences struct {
+	UserId          string                          `protobuf:"bytes,1,opt,name=UserId" json:"UserId,omitempty"`
+	DefaultDelivery NotificationDelivery            `protobuf:"varint,2,opt,name=DefaultDelivery,enum=activity.NotificationDelivery" json:"DefaultDelivery,omitempty"`
+	EventsDelivery  map[string]NotificationDelivery `protobuf:"bytes,3,rep,name=EventsDelivery" json:"EventsDelivery,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=activity.NotificationDelivery"`
+	QuietHours      *QuietHours                     `protobuf:"bytes,4,opt,name=QuietHours" json:"QuietHours,omitempty"`
+}
+
+func (m *NotificationPreferences) Reset()                    { *m = NotificationPreferences{} }
+func (m *NotificationPreferences) String() string            { return proto.CompactTextString(m) }
+func (*NotificationPreferences) ProtoMessage()               {}
+func (*NotificationPreferences) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *NotificationPreferences) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *NotificationPreferences) GetDefaultDelivery() NotificationDelivery {
+	if m != nil {
+		return m.DefaultDelivery
+	}
+	return NotificationDelivery_DEFAULT
+}
+
+func (m *NotificationPreferences) GetEventsDelivery() map[string]NotificationDelivery {
+	if m != nil {
+		return m.EventsDelivery
+	}
+	return nil
+}
+
+func (m *NotificationPreferences) GetQuietHours() *QuietHours {
+	if m != nil {
+		return m.QuietHours
+	}
+	return nil
+}
+
+type GetNotificationPreferencesRequest struct {
+	UserId string `protobuf:"bytes,1,opt,name=UserId" json:"UserId,omitempty"`
+}
+
+func (m *GetNotificationPreferencesRequest) Reset()         { *m = GetNotificationPreferencesRequest{} }
+func (m *GetNotificationPreferencesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNotificationPreferencesRequest) ProtoMessage()    {}
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{9}
+}
+
+func (m *GetNotificationPreferencesRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type GetNotificationPreferencesResponse struct {
+	Preferences *NotificationPreferences `protobuf:"bytes,1,opt,name=Preferences" json:"Preferences,omitempty"`
+}
+
+func (m *GetNotificationPreferencesResponse) Reset()         { *m = GetNotificationPreferencesResponse{} }
+func (m *GetNotificationPreferencesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNotificationPreferencesResponse) ProtoMessage()    {}
+func (*GetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{10}
+}
+
+func (m *GetNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
+	if m != nil {
+		return m.Preferences
+	}
+	return nil
+}
+
+type PutNotificationPreferencesRequest struct {
+	Preferences *NotificationPreferences `protobuf:"bytes,1,opt,name=Preferences" json:"Preferences,omitempty"`
+}
+
+func (m *PutNotificationPreferencesRequest) Reset()         { *m = PutNotificationPreferencesRequest{} }
+func (m *PutNotificationPreferencesRequest) String() string { return proto.CompactTextString(m) }
+func (*PutNotificationPreferencesRequest) ProtoMessage()    {}
+func (*PutNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{11}
+}
+
+func (m *PutNotificationPreferencesRequest) GetPreferences() *NotificationPreferences {
+	if m != nil {
+		return m.Preferences
+	}
+	return nil
+}
+
+type PutNotificationPreferencesResponse struct {
+	Preferences *NotificationPreferences `protobuf:"bytes,1,opt,name=Preferences" json:"Preferences,omitempty"`
+}
+
+func (m *PutNotificationPreferencesResponse) Reset()         { *m = PutNotificationPreferencesResponse{} }
+func (m *PutNotificationPreferencesResponse) String() string { return proto.CompactTextString(m) }
+func (*PutNotificationPreferencesResponse) ProtoMessage()    {}
+func (*PutNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{12}
+}
+
+func (m *PutNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
+	if m != nil {
+		return m.Preferences
+	}
+	return nil
+}
+
 type SubscribeRequest struct {
 	Subscription *Subscription `protobuf:"bytes,1,opt,name=Subscription" json:"Subscription,omitempty"`
 }
@@ -1025,7 +1214,7 @@ type SubscribeRequest struct {
 func (m *SubscribeRequest) Reset()                    { *m = SubscribeRequest{} }
 func (m *SubscribeRequest) String() string            { return proto.CompactTextString(m) }
 func (*SubscribeRequest) ProtoMessage()               {}
-func (*SubscribeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*SubscribeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 func (m *SubscribeRequest) GetSubscription() *Subscription {
 	if m != nil {
@@ -1041,7 +1230,7 @@ type SubscribeResponse struct {
 func (m *SubscribeResponse) Reset()                    { *m = SubscribeResponse{} }
 func (m *SubscribeResponse) String() string            { return proto.CompactTextString(m) }
 func (*SubscribeResponse) ProtoMessage()               {}
-func (*SubscribeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*SubscribeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *SubscribeResponse) GetSubscription() *Subscription {
 	if m != nil {
@@ -1059,7 +1248,7 @@ type SearchSubscriptionsRequest struct {
 func (m *SearchSubscriptionsRequest) Reset()                    { *m = SearchSubscriptionsRequest{} }
 func (m *SearchSubscriptionsRequest) String() string            { return proto.CompactTextString(m) }
 func (*SearchSubscriptionsRequest) ProtoMessage()               {}
-func (*SearchSubscriptionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*SearchSubscriptionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *SearchSubscriptionsRequest) GetUserIds() []string {
 	if m != nil {
@@ -1089,7 +1278,7 @@ type SearchSubscriptionsResponse struct {
 func (m *SearchSubscriptionsResponse) Reset()                    { *m = SearchSubscriptionsResponse{} }
 func (m *SearchSubscriptionsResponse) String() string            { return proto.CompactTextString(m) }
 func (*SearchSubscriptionsResponse) ProtoMessage()               {}
-func (*SearchSubscriptionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*SearchSubscriptionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *SearchSubscriptionsResponse) GetSubscription() *Subscription {
 	if m != nil {
@@ -1105,7 +1294,7 @@ type UnreadActivitiesRequest struct {
 func (m *UnreadActivitiesRequest) Reset()                    { *m = UnreadActivitiesRequest{} }
 func (m *UnreadActivitiesRequest) String() string            { return proto.CompactTextString(m) }
 func (*UnreadActivitiesRequest) ProtoMessage()               {}
-func (*UnreadActivitiesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*UnreadActivitiesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *UnreadActivitiesRequest) GetUserId() string {
 	if m != nil {
@@ -1121,7 +1310,7 @@ type UnreadActivitiesResponse struct {
 func (m *UnreadActivitiesResponse) Reset()                    { *m = UnreadActivitiesResponse{} }
 func (m *UnreadActivitiesResponse) String() string            { return proto.CompactTextString(m) }
 func (*UnreadActivitiesResponse) ProtoMessage()               {}
-func (*UnreadActivitiesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*UnreadActivitiesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 func (m *UnreadActivitiesResponse) GetNumber() int32 {
 	if m != nil {
@@ -1139,7 +1328,7 @@ type UserLastActivityRequest struct {
 func (m *UserLastActivityRequest) Reset()                    { *m = UserLastActivityRequest{} }
 func (m *UserLastActivityRequest) String() string            { return proto.CompactTextString(m) }
 func (*UserLastActivityRequest) ProtoMessage()               {}
-func (*UserLastActivityRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*UserLastActivityRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *UserLastActivityRequest) GetUserId() string {
 	if m != nil {
@@ -1169,7 +1358,7 @@ type UserLastActivityResponse struct {
 func (m *UserLastActivityResponse) Reset()                    { *m = UserLastActivityResponse{} }
 func (m *UserLastActivityResponse) String() string            { return proto.CompactTextString(m) }
 func (*UserLastActivityResponse) ProtoMessage()               {}
-func (*UserLastActivityResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*UserLastActivityResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *UserLastActivityResponse) GetSuccess() bool {
 	if m != nil {
@@ -1178,6 +1367,118 @@ func (m *UserLastActivityResponse) GetSuccess() bool {
 	return false
 }
 
+type PurgeActivitiesRequest struct {
+	OwnerType              OwnerType `protobuf:"varint,1,opt,name=OwnerType,enum=activity.OwnerType" json:"OwnerType,omitempty"`
+	OwnerID                string    `protobuf:"bytes,2,opt,name=OwnerID" json:"OwnerID,omitempty"`
+	BoxName                string    `protobuf:"bytes,3,opt,name=BoxName" json:"BoxName,omitempty"`
+	MinCount               int32     `protobuf:"varint,4,opt,name=minCount" json:"minCount,omitempty"`
+	MaxCount               int32     `protobuf:"varint,5,opt,name=maxCount" json:"maxCount,omitempty"`
+	UpdatedBeforeTimestamp int32     `protobuf:"varint,6,opt,name=updatedBeforeTimestamp" json:"updatedBeforeTimestamp,omitempty"`
+	CompactDB              bool      `protobuf:"varint,7,opt,name=compactDB" json:"compactDB,omitempty"`
+}
+
+func (m *PurgeActivitiesRequest) Reset()                    { *m = PurgeActivitiesRequest{} }
+func (m *PurgeActivitiesRequest) String() string            { return proto.CompactTextString(m) }
+func (*PurgeActivitiesRequest) ProtoMessage()               {}
+func (*PurgeActivitiesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *PurgeActivitiesRequest) GetOwnerType() OwnerType {
+	if m != nil {
+		return m.OwnerType
+	}
+	return OwnerType_NODE
+}
+
+func (m *PurgeActivitiesRequest) GetOwnerID() string {
+	if m != nil {
+		return m.OwnerID
+	}
+	return ""
+}
+
+func (m *PurgeActivitiesRequest) GetBoxName() string {
+	if m != nil {
+		return m.BoxName
+	}
+	return ""
+}
+
+func (m *PurgeActivitiesRequest) GetMinCount() int32 {
+	if m != nil {
+		return m.MinCount
+	}
+	return 0
+}
+
+func (m *PurgeActivitiesRequest) GetMaxCount() int32 {
+	if m != nil {
+		return m.MaxCount
+	}
+	return 0
+}
+
+func (m *PurgeActivitiesRequest) GetUpdatedBeforeTimestamp() int32 {
+	if m != nil {
+		return m.UpdatedBeforeTimestamp
+	}
+	return 0
+}
+
+func (m *PurgeActivitiesRequest) GetCompactDB() bool {
+	if m != nil {
+		return m.CompactDB
+	}
+	return false
+}
+
+type PurgeActivitiesResponse struct {
+	Success      bool  `protobuf:"varint,1,opt,name=Success" json:"Success,omitempty"`
+	DeletedCount int32 `protobuf:"varint,2,opt,name=DeletedCount" json:"DeletedCount,omitempty"`
+	BoxesCount   int32 `protobuf:"varint,3,opt,name=BoxesCount" json:"BoxesCount,omitempty"`
+	DbSizeBefore int64 `protobuf:"varint,4,opt,name=DbSizeBefore" json:"DbSizeBefore,omitempty"`
+	DbSizeAfter  int64 `protobuf:"varint,5,opt,name=DbSizeAfter" json:"DbSizeAfter,omitempty"`
+}
+
+func (m *PurgeActivitiesResponse) Reset()                    { *m = PurgeActivitiesResponse{} }
+func (m *PurgeActivitiesResponse) String() string            { return proto.CompactTextString(m) }
+func (*PurgeActivitiesResponse) ProtoMessage()               {}
+func (*PurgeActivitiesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *PurgeActivitiesResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *PurgeActivitiesResponse) GetDeletedCount() int32 {
+	if m != nil {
+		return m.DeletedCount
+	}
+	return 0
+}
+
+func (m *PurgeActivitiesResponse) GetBoxesCount() int32 {
+	if m != nil {
+		return m.BoxesCount
+	}
+	return 0
+}
+
+func (m *PurgeActivitiesResponse) GetDbSizeBefore() int64 {
+	if m != nil {
+		return m.DbSizeBefore
+	}
+	return 0
+}
+
+func (m *PurgeActivitiesResponse) GetDbSizeAfter() int64 {
+	if m != nil {
+		return m.DbSizeAfter
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Object)(nil), "activity.Object")
 	proto.RegisterType((*PostActivityRequest)(nil), "activity.PostActivityRequest")
@@ -1186,6 +1487,12 @@ func init() {
 	proto.RegisterType((*StreamActivitiesRequest)(nil), "activity.StreamActivitiesRequest")
 	proto.RegisterType((*StreamActivitiesResponse)(nil), "activity.StreamActivitiesResponse")
 	proto.RegisterType((*Subscription)(nil), "activity.Subscription")
+	proto.RegisterType((*QuietHours)(nil), "activity.QuietHours")
+	proto.RegisterType((*NotificationPreferences)(nil), "activity.NotificationPreferences")
+	proto.RegisterType((*GetNotificationPreferencesRequest)(nil), "activity.GetNotificationPreferencesRequest")
+	proto.RegisterType((*GetNotificationPreferencesResponse)(nil), "activity.GetNotificationPreferencesResponse")
+	proto.RegisterType((*PutNotificationPreferencesRequest)(nil), "activity.PutNotificationPreferencesRequest")
+	proto.RegisterType((*PutNotificationPreferencesResponse)(nil), "activity.PutNotificationPreferencesResponse")
 	proto.RegisterType((*SubscribeRequest)(nil), "activity.SubscribeRequest")
 	proto.RegisterType((*SubscribeResponse)(nil), "activity.SubscribeResponse")
 	proto.RegisterType((*SearchSubscriptionsRequest)(nil), "activity.SearchSubscriptionsRequest")
@@ -1194,143 +1501,175 @@ func init() {
 	proto.RegisterType((*UnreadActivitiesResponse)(nil), "activity.UnreadActivitiesResponse")
 	proto.RegisterType((*UserLastActivityRequest)(nil), "activity.UserLastActivityRequest")
 	proto.RegisterType((*UserLastActivityResponse)(nil), "activity.UserLastActivityResponse")
+	proto.RegisterType((*PurgeActivitiesRequest)(nil), "activity.PurgeActivitiesRequest")
+	proto.RegisterType((*PurgeActivitiesResponse)(nil), "activity.PurgeActivitiesResponse")
 	proto.RegisterEnum("activity.ObjectType", ObjectType_name, ObjectType_value)
 	proto.RegisterEnum("activity.StreamContext", StreamContext_name, StreamContext_value)
 	proto.RegisterEnum("activity.SummaryPointOfView", SummaryPointOfView_name, SummaryPointOfView_value)
 	proto.RegisterEnum("activity.OwnerType", OwnerType_name, OwnerType_value)
+	proto.RegisterEnum("activity.NotificationDelivery", NotificationDelivery_name, NotificationDelivery_value)
 }
 
 func init() { proto.RegisterFile("activitystream.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2057 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdb, 0x5a, 0x1b, 0xc9,
-	0x11, 0x46, 0xe2, 0x24, 0x15, 0x18, 0xda, 0x0d, 0x86, 0x36, 0x3e, 0xc9, 0x5a, 0xdb, 0xcb, 0xb2,
-	0x5e, 0x6c, 0x63, 0xec, 0x3d, 0xe4, 0xb4, 0x18, 0xf0, 0x86, 0xfd, 0x30, 0x62, 0x07, 0xb1, 0xf9,
-	0xf6, 0x22, 0xc9, 0x37, 0x9a, 0x29, 0x89, 0x5e, 0x46, 0xd3, 0x4a, 0x4f, 0x0f, 0x5e, 0xf2, 0x0a,
-	0xb9, 0xc8, 0xdb, 0xe4, 0x3e, 0x8f, 0x91, 0x77, 0xc8, 0x23, 0xe4, 0x22, 0x5f, 0xf5, 0xcc, 0x48,
-	0x03, 0xb8, 0xcd, 0x45, 0x72, 0xa5, 0xa9, 0xfa, 0xff, 0xae, 0xaa, 0xae, 0xae, 0xae, 0x2e, 0xc1,
-	0xa2, 0x1f, 0x18, 0x79, 0x26, 0xcd, 0x79, 0x62, 0x34, 0xfa, 0xfd, 0xf5, 0x81, 0x56, 0x46, 0xf1,
-	0x5a, 0xa1, 0x5d, 0x79, 0xd0, 0x53, 0xaa, 0x17, 0xe1, 0x33, 0xab, 0xef, 0xa4, 0xdd, 0x67, 0x46,
-	0xf6, 0x31, 0x31, 0x7e, 0x7f, 0x90, 0x51, 0x9b, 0xff, 0xe2, 0x30, 0xd5, 0xea, 0xfc, 0x8c, 0x81,
-	0xe1, 0x0f, 0xe0, 0xc6, 0xcf, 0x89, 0x8a, 0xf7, 0xc3, 0x6d, 0x15, 0x1b, 0xfc, 0xc5, 0x88, 0x57,
-	0x8d, 0xca, 0x6a, 0xdd, 0xab, 0x7d, 0x1b, 0x64, 0x32, 0x5f, 0x85, 0x09, 0x73, 0x3e, 0x40, 0x51,
-	0x69, 0x54, 0x56, 0xe7, 0x36, 0x16, 0xd7, 0x0b, 0x2f, 0xeb, 0x99, 0x81, 0xf6, 0xf9, 0x00, 0x3d,
-	0xcb, 0xe0, 0x73, 0x50, 0x95, 0xa1, 0xa8, 0xda, 0xf5, 0x55, 0x19, 0x72, 0x0e, 0x13, 0xb1, 0xdf,
-	0x47, 0x31, 0x6e, 0x35, 0xf6, 0x9b, 0x0b, 0x98, 0x4e, 0xd2, 0x7e, 0xdf, 0xd7, 0xe7, 0x62, 0xc2,
-	0xaa, 0x0b, 0x91, 0xaf, 0xc1, 0x74, 0xee, 0x52, 0x4c, 0x36, 0x2a, 0xab, 0x33, 0x1b, 0xec, 0xb2,
-	0x2b, 0xaf, 0x20, 0xf0, 0xe7, 0x00, 0xbe, 0x31, 0x7e, 0x70, 0xd2, 0xc7, 0xd8, 0x88, 0x29, 0x07,
-	0xbd, 0xc4, 0xe1, 0x9b, 0x30, 0xeb, 0x1b, 0xa3, 0x65, 0x27, 0x35, 0x18, 0xb6, 0x95, 0x98, 0x76,
-	0xac, 0xb9, 0xc0, 0xe2, 0x4f, 0xa1, 0xe6, 0xa7, 0xa1, 0xc4, 0x38, 0x40, 0x51, 0x73, 0xac, 0x18,
-	0x32, 0x86, 0x3b, 0x88, 0x8d, 0xa8, 0x7f, 0x74, 0x07, 0xb1, 0xe1, 0x5f, 0x41, 0x3d, 0x31, 0xbe,
-	0x36, 0x6d, 0xd9, 0x47, 0x01, 0x96, 0xbd, 0xb2, 0x9e, 0x1d, 0xdb, 0x7a, 0x71, 0x6c, 0xeb, 0xed,
-	0xe2, 0xd8, 0xbc, 0x11, 0x99, 0x6f, 0xc2, 0x34, 0xc6, 0xa1, 0x5d, 0x37, 0x73, 0xed, 0xba, 0x82,
-	0x4a, 0xfe, 0x06, 0x69, 0x27, 0x92, 0xc9, 0x09, 0x86, 0x62, 0xf6, 0x7a, 0x7f, 0x43, 0x32, 0xf9,
-	0x4b, 0x07, 0xa1, 0x6f, 0x30, 0x14, 0x37, 0xae, 0xf7, 0x97, 0x53, 0xf9, 0x6b, 0xa8, 0x85, 0xa9,
-	0xf6, 0x8d, 0x54, 0xb1, 0x98, 0xbb, 0x76, 0xd9, 0x90, 0xcb, 0x9b, 0x30, 0x9e, 0xea, 0x48, 0xcc,
-	0x3b, 0xf2, 0x47, 0x20, 0xbf, 0x0b, 0xf5, 0x3e, 0x86, 0xd2, 0xa7, 0xd2, 0x13, 0xcc, 0x56, 0xd1,
-	0x48, 0xc1, 0x1f, 0xc1, 0x84, 0x0c, 0x54, 0x2c, 0x6e, 0x3a, 0x4c, 0x58, 0x94, 0x3f, 0x81, 0x49,
-	0xd9, 0xf7, 0x7b, 0x28, 0xb8, 0x83, 0x96, 0xc1, 0x74, 0xa6, 0x03, 0x8d, 0x67, 0x12, 0xdf, 0x8b,
-	0x05, 0xd7, 0x99, 0xe6, 0x04, 0xaa, 0x96, 0x48, 0x05, 0xd9, 0x9e, 0x17, 0x5d, 0xd5, 0x52, 0x30,
-	0xf8, 0x3a, 0xd4, 0x65, 0xec, 0xe1, 0x20, 0x3a, 0x6f, 0x2b, 0x71, 0xcb, 0x41, 0x1f, 0x51, 0x28,
-	0x12, 0x8d, 0x83, 0x48, 0x62, 0x22, 0x96, 0x5c, 0x91, 0xe4, 0x04, 0xca, 0xa2, 0xf1, 0x7b, 0x62,
-	0xd9, 0x95, 0x45, 0xe3, 0xf7, 0xc8, 0x7f, 0x0f, 0x63, 0xd4, 0xbe, 0x51, 0x5a, 0x08, 0x97, 0xff,
-	0x21, 0x85, 0x37, 0xa0, 0x6a, 0x94, 0xb8, 0xed, 0x20, 0x56, 0x8d, 0x22, 0xaf, 0x1d, 0xa3, 0xc4,
-	0x8a, 0xcb, 0x6b, 0xc7, 0x28, 0xb2, 0x12, 0x04, 0xe2, 0x8e, 0xcb, 0x4a, 0x10, 0x58, 0x2b, 0x41,
-	0x20, 0xee, 0x3a, 0xad, 0x04, 0x01, 0x9d, 0x9e, 0x1f, 0x50, 0xdc, 0xf7, 0x5c, 0xa7, 0x67, 0x61,
-	0xbe, 0x0a, 0x53, 0xca, 0x2a, 0xc4, 0x7d, 0x07, 0x31, 0xc7, 0x89, 0x69, 0x7c, 0xdd, 0x43, 0x23,
-	0x1e, 0xb8, 0x98, 0x19, 0x4e, 0x4c, 0x8d, 0x49, 0x1a, 0x19, 0xd1, 0x70, 0x31, 0x33, 0xdc, 0x7a,
-	0xd7, 0xb2, 0x27, 0x63, 0xf1, 0xd0, 0xe9, 0xdd, 0xe2, 0xd4, 0xcf, 0x64, 0x9c, 0x18, 0x9d, 0xda,
-	0x7e, 0xd6, 0x74, 0xf5, 0xb3, 0x11, 0x87, 0x7a, 0xeb, 0x89, 0xc6, 0xae, 0xf8, 0x24, 0xeb, 0xad,
-	0xf4, 0xcd, 0x19, 0x8c, 0x6b, 0x8c, 0xc4, 0x23, 0xab, 0xa2, 0x4f, 0xbe, 0x02, 0x35, 0x42, 0x22,
-	0x3f, 0xee, 0x89, 0xc7, 0x59, 0x5f, 0x2f, 0x64, 0xbe, 0x04, 0x53, 0x27, 0x28, 0x7b, 0x27, 0x46,
-	0x3c, 0x69, 0x54, 0x56, 0x27, 0xbd, 0x5c, 0xe2, 0x8b, 0x30, 0xf9, 0x5e, 0x86, 0xe6, 0x44, 0x7c,
-	0x6a, 0xd5, 0x99, 0x40, 0x19, 0x57, 0x31, 0xb6, 0xba, 0x62, 0xd5, 0x95, 0x71, 0x0b, 0xdb, 0x93,
-	0x89, 0xcf, 0x5b, 0x5d, 0xf1, 0x99, 0xf3, 0x64, 0x08, 0xe6, 0x1b, 0x30, 0x15, 0x44, 0x2a, 0xc1,
-	0x50, 0xac, 0x5d, 0xdb, 0x1d, 0x72, 0x26, 0xdd, 0x80, 0x24, 0xcd, 0x8e, 0xf3, 0x73, 0xd7, 0x0d,
-	0xc8, 0x09, 0xd4, 0xef, 0x35, 0x46, 0xf6, 0xa6, 0x25, 0x27, 0x72, 0x20, 0x9e, 0xba, 0xfa, 0x7d,
-	0x99, 0xc5, 0x37, 0x01, 0xba, 0x4a, 0xf7, 0x51, 0xdb, 0xd6, 0xf2, 0xc5, 0x47, 0x5e, 0xbc, 0x12,
-	0x8f, 0x3a, 0x64, 0x88, 0x11, 0x52, 0x87, 0x5c, 0xbf, 0xbe, 0x43, 0xe6, 0x54, 0x3a, 0x1b, 0x3f,
-	0x08, 0x52, 0xed, 0x07, 0xe7, 0xe2, 0x59, 0xa3, 0xb2, 0x5a, 0xf5, 0x86, 0xb2, 0xc5, 0x22, 0x23,
-	0x4d, 0x1a, 0xa2, 0x78, 0x9e, 0x63, 0xb9, 0x4c, 0x18, 0x45, 0x6c, 0xb1, 0x17, 0x19, 0x56, 0xc8,
-	0xd4, 0x19, 0x23, 0x15, 0xf7, 0x32, 0x70, 0xc3, 0x82, 0x23, 0x05, 0x9d, 0xb8, 0xf6, 0x43, 0x99,
-	0x26, 0xe2, 0xa5, 0x85, 0x72, 0x89, 0x4e, 0x3c, 0x8d, 0xa5, 0x49, 0xc4, 0xa6, 0x2d, 0x91, 0x4c,
-	0xb0, 0x1d, 0xd2, 0x60, 0x3f, 0x11, 0xaf, 0x1b, 0xe3, 0x8e, 0x0e, 0x49, 0x30, 0xbf, 0x0f, 0x60,
-	0x94, 0xf1, 0xa3, 0x3d, 0x4b, 0xfe, 0xd2, 0x16, 0x4d, 0x49, 0x63, 0x5f, 0xc5, 0x54, 0x6b, 0x2a,
-	0xec, 0xaf, 0x9c, 0xaf, 0x62, 0x46, 0x20, 0x9f, 0x5d, 0xa9, 0x13, 0x23, 0xbe, 0x76, 0x55, 0x8f,
-	0x85, 0xa9, 0xc7, 0x47, 0x7e, 0x62, 0xc4, 0x37, 0xae, 0x1e, 0x4f, 0x28, 0xdd, 0xbf, 0x81, 0xaf,
-	0x4d, 0xab, 0x2b, 0x7e, 0xe5, 0xba, 0x7f, 0x19, 0x4e, 0xf6, 0x62, 0x1a, 0x3c, 0x7e, 0xed, 0xb2,
-	0x47, 0x28, 0xb1, 0xa8, 0xd5, 0x8b, 0xdf, 0xb8, 0x58, 0x84, 0x36, 0x7f, 0x07, 0x0b, 0x87, 0x2a,
-	0x31, 0x5b, 0x39, 0xe8, 0xe1, 0x5f, 0x52, 0xcc, 0x82, 0xc9, 0x68, 0x76, 0x90, 0xfa, 0x60, 0x30,
-	0xd9, 0x6f, 0x73, 0x09, 0x16, 0x2f, 0x1a, 0x48, 0x06, 0x2a, 0x4e, 0xb0, 0xf9, 0xcf, 0x0a, 0xdc,
-	0x2c, 0x03, 0xbb, 0x67, 0x94, 0xb2, 0x65, 0xa8, 0xd1, 0xfc, 0xd6, 0x2e, 0x46, 0xb4, 0xba, 0x37,
-	0xf9, 0xad, 0x9d, 0xc6, 0x5e, 0x40, 0xbd, 0xf5, 0x3e, 0xce, 0x4b, 0xb9, 0x6a, 0x4b, 0x79, 0xa1,
-	0xe4, 0xb3, 0x80, 0xbc, 0x11, 0x8b, 0x86, 0x33, 0x2b, 0xec, 0x85, 0xf9, 0xcc, 0x56, 0x88, 0x84,
-	0xbc, 0x51, 0xbf, 0x1c, 0xd0, 0x34, 0x97, 0x8f, 0x6d, 0xb9, 0x48, 0x8f, 0x5e, 0x11, 0x90, 0x73,
-	0x6e, 0x1b, 0x32, 0x9a, 0xff, 0xa9, 0xc2, 0xf2, 0x91, 0x1d, 0x5a, 0x73, 0x95, 0xc4, 0xa4, 0xc8,
-	0xd0, 0x0b, 0x98, 0x2e, 0x66, 0xd0, 0x6c, 0xd6, 0x5c, 0x1e, 0x19, 0xca, 0xd6, 0xe4, 0xb0, 0x57,
-	0xf0, 0x78, 0x03, 0x66, 0xf2, 0xcf, 0x1d, 0xdf, 0xf8, 0xf9, 0xe8, 0x59, 0x56, 0xf1, 0x26, 0xcc,
-	0x66, 0x6b, 0xdf, 0xca, 0xc8, 0xa0, 0xce, 0xf7, 0x75, 0x41, 0xf7, 0x91, 0xcd, 0xad, 0xc2, 0xfc,
-	0x71, 0xac, 0xd1, 0x0f, 0xb7, 0x55, 0x1a, 0x9b, 0x56, 0x1c, 0x65, 0x7b, 0xac, 0x79, 0x97, 0xd5,
-	0x74, 0xb7, 0x5a, 0xdd, 0x6e, 0x82, 0xd9, 0x34, 0x3a, 0xee, 0xe5, 0x12, 0xdd, 0xad, 0x7d, 0xd9,
-	0x97, 0xc6, 0x0e, 0x9c, 0xe3, 0x5e, 0x26, 0xd0, 0x1d, 0xde, 0x4a, 0x76, 0x64, 0x0f, 0x13, 0x63,
-	0xe7, 0xca, 0x9a, 0x37, 0x94, 0xf9, 0x6f, 0x61, 0xe6, 0x50, 0xc9, 0xd8, 0xb4, 0xba, 0x3f, 0xd2,
-	0xd4, 0x51, 0xb7, 0xa9, 0xb8, 0x5b, 0x4a, 0x45, 0x36, 0x2f, 0x97, 0x38, 0x5e, 0x79, 0x01, 0xd9,
-	0xde, 0xf7, 0xe3, 0x5e, 0x4a, 0xc3, 0x0d, 0x64, 0x3d, 0xbf, 0x90, 0x9b, 0xbf, 0x07, 0x71, 0x35,
-	0xfb, 0x59, 0x79, 0xd9, 0x59, 0xb7, 0x38, 0xc8, 0x8a, 0x73, 0xd6, 0x2d, 0x0e, 0xf2, 0xef, 0x15,
-	0x98, 0x3d, 0x4a, 0x3b, 0x49, 0xa0, 0xe5, 0xc0, 0x8e, 0x33, 0x4b, 0x30, 0x75, 0x9c, 0xd8, 0xd2,
-	0xc9, 0xaa, 0x30, 0x97, 0xf8, 0x4b, 0x80, 0x51, 0xdb, 0xfc, 0x58, 0x1d, 0x96, 0x68, 0xb4, 0x87,
-	0x4c, 0x1a, 0x56, 0xe2, 0x50, 0x26, 0x47, 0xb6, 0xf2, 0x13, 0x31, 0xd1, 0x18, 0x27, 0x47, 0x99,
-	0xd4, 0x3c, 0x00, 0x96, 0x07, 0xd4, 0xc1, 0xa2, 0xa4, 0xbe, 0xb9, 0x18, 0x64, 0xbe, 0xaf, 0xa5,
-	0x72, 0x32, 0x47, 0xa8, 0x77, 0x81, 0xdb, 0x6c, 0xc1, 0xcd, 0x92, 0xbd, 0x3c, 0x49, 0xff, 0x8b,
-	0xc1, 0xbf, 0x55, 0x60, 0xe5, 0x08, 0x7d, 0x1d, 0x9c, 0x94, 0xd5, 0xc3, 0xf2, 0x17, 0x30, 0x9d,
-	0xa5, 0x2c, 0x11, 0x15, 0xbb, 0xb1, 0x42, 0xe4, 0xaf, 0x60, 0x66, 0x94, 0x9b, 0x44, 0x54, 0x1b,
-	0xe3, 0xae, 0x1c, 0x96, 0x79, 0xf4, 0x18, 0x14, 0x49, 0x4b, 0xc4, 0xb8, 0x35, 0x39, 0x52, 0x34,
-	0x7f, 0x82, 0x3b, 0x1f, 0x0c, 0xe6, 0xff, 0xb0, 0xd1, 0x17, 0xb0, 0x9c, 0x5d, 0x8f, 0xab, 0x77,
-	0xdc, 0x51, 0x25, 0xcd, 0x0d, 0x10, 0x57, 0x97, 0xe4, 0xa1, 0x2c, 0xc1, 0x54, 0x9c, 0xf6, 0x3b,
-	0xa8, 0xed, 0x9a, 0x49, 0x2f, 0x97, 0x9a, 0xa7, 0xb0, 0x4c, 0xab, 0xf7, 0xfd, 0xab, 0xcd, 0xd6,
-	0x55, 0x8c, 0xa5, 0x9b, 0x5e, 0xbd, 0x78, 0xd3, 0xef, 0x03, 0x14, 0x46, 0x86, 0x35, 0x57, 0xd2,
-	0x34, 0x37, 0x41, 0x5c, 0x75, 0x96, 0x07, 0x28, 0x60, 0xfa, 0x28, 0x0d, 0x02, 0x4c, 0x12, 0xeb,
-	0xae, 0xe6, 0x15, 0xe2, 0xda, 0xbf, 0x27, 0xcb, 0xd5, 0xcf, 0xe7, 0x00, 0xde, 0xf8, 0x09, 0x66,
-	0x1a, 0x36, 0xc6, 0x67, 0x47, 0xbd, 0x93, 0x55, 0x78, 0x0d, 0x26, 0xf6, 0x65, 0x7c, 0xca, 0x9e,
-	0xf1, 0x19, 0x98, 0x7e, 0x87, 0x31, 0xe5, 0x92, 0x3d, 0xa7, 0x45, 0xdb, 0x2a, 0x8a, 0x30, 0xb0,
-	0xf2, 0x0b, 0x7e, 0x0b, 0x6e, 0xb6, 0x74, 0x88, 0x1a, 0xc3, 0x92, 0x7a, 0x83, 0x73, 0x98, 0x1b,
-	0xc9, 0x87, 0x7e, 0x0f, 0xd9, 0x4b, 0x7e, 0x1b, 0x6e, 0x5d, 0xa1, 0x5a, 0x68, 0x93, 0xcf, 0xc3,
-	0xcc, 0xd6, 0x60, 0x10, 0xc9, 0xec, 0xcf, 0x08, 0xab, 0xf2, 0x3a, 0x4c, 0x7e, 0xa7, 0x55, 0x3a,
-	0x60, 0xe3, 0x9c, 0xc1, 0x6c, 0x4b, 0xf7, 0xfc, 0x58, 0xfe, 0x35, 0x03, 0x27, 0x38, 0xc0, 0xd4,
-	0x21, 0xea, 0x44, 0xc5, 0x6c, 0x92, 0x82, 0x3b, 0x42, 0x7d, 0x26, 0x03, 0x64, 0x53, 0x24, 0x6c,
-	0x69, 0x23, 0x83, 0x08, 0xd9, 0x34, 0x99, 0xd8, 0x4a, 0x43, 0xa9, 0x58, 0x8d, 0x76, 0xb6, 0xa3,
-	0x02, 0x3b, 0xaa, 0xb2, 0x3a, 0x01, 0xf6, 0x92, 0x32, 0xa0, 0xcf, 0x3d, 0xfa, 0x63, 0xc5, 0x66,
-	0x68, 0xbf, 0x07, 0xca, 0x20, 0x9b, 0xa5, 0x2f, 0x1b, 0xd6, 0x0d, 0x82, 0x0f, 0x23, 0x3f, 0x40,
-	0x36, 0x47, 0xa6, 0x0f, 0xb5, 0xea, 0xca, 0x08, 0xd9, 0x3c, 0x85, 0xe4, 0x95, 0x06, 0x35, 0xc6,
-	0xf8, 0x0d, 0xa8, 0xb7, 0x55, 0xbf, 0x93, 0x18, 0x15, 0x23, 0xbb, 0x49, 0x0b, 0x7f, 0x94, 0x21,
-	0x2a, 0xc6, 0x29, 0xd8, 0xad, 0x20, 0xc0, 0x81, 0x61, 0x0b, 0x7c, 0x1a, 0xc6, 0xb7, 0xc2, 0x90,
-	0x2d, 0xda, 0x54, 0xc7, 0xb1, 0x4a, 0xe3, 0x00, 0xd9, 0x2d, 0x4b, 0xd1, 0x5a, 0x9e, 0x21, 0x5b,
-	0xa2, 0x95, 0x6f, 0x22, 0x15, 0x9c, 0xb2, 0x65, 0x52, 0x6f, 0x6b, 0xf4, 0x0d, 0x32, 0x41, 0xdf,
-	0x3b, 0x76, 0x52, 0x63, 0xb7, 0x29, 0x94, 0x1d, 0x99, 0x44, 0xf2, 0x14, 0xd9, 0x0a, 0x05, 0xfb,
-	0x36, 0xf2, 0x7b, 0xec, 0x0e, 0x51, 0xde, 0xaa, 0x28, 0x52, 0xef, 0xd9, 0x5d, 0xfa, 0xde, 0xeb,
-	0xc5, 0x4a, 0x23, 0xbb, 0x67, 0xbf, 0xe3, 0x33, 0x69, 0x90, 0xdd, 0x27, 0xf6, 0xf7, 0x4a, 0xc6,
-	0xec, 0x01, 0xf9, 0xd9, 0x47, 0xff, 0x0c, 0x59, 0x23, 0x3b, 0xe9, 0x53, 0x64, 0x0f, 0x89, 0xba,
-	0x2f, 0x13, 0x83, 0x31, 0x6b, 0x92, 0xf6, 0x9d, 0x3a, 0x43, 0xf6, 0x09, 0x51, 0x5b, 0xdd, 0x2e,
-	0x6a, 0xf6, 0x88, 0xe2, 0xfe, 0x81, 0x4a, 0x9a, 0xce, 0xe1, 0x31, 0xd1, 0x3d, 0xb4, 0xc5, 0xf3,
-	0x84, 0xe8, 0x1e, 0xfa, 0x21, 0xfb, 0x34, 0xd3, 0xf6, 0x69, 0xe9, 0x2a, 0x5f, 0x80, 0xf9, 0x36,
-	0xc6, 0xc6, 0x37, 0xf2, 0x0c, 0x73, 0xea, 0x67, 0x17, 0x94, 0x79, 0x6a, 0xd6, 0x68, 0x55, 0x5b,
-	0xfb, 0x67, 0x18, 0xb1, 0xcf, 0xc9, 0xd6, 0x71, 0x1c, 0x2a, 0xf6, 0x94, 0xb4, 0xc7, 0xf6, 0x2f,
-	0x3c, 0xfb, 0x82, 0xb4, 0xf4, 0xa2, 0xb0, 0x75, 0x4a, 0xf6, 0x1f, 0x94, 0x3e, 0x4d, 0x06, 0x74,
-	0x34, 0xaf, 0x6c, 0x6e, 0xec, 0x63, 0xc5, 0x5e, 0xe7, 0x49, 0x08, 0x51, 0xb3, 0x2f, 0xd7, 0x5e,
-	0xc1, 0x8d, 0x0b, 0x0f, 0x35, 0x81, 0xef, 0x7e, 0x7a, 0xbb, 0xbb, 0xbb, 0xc3, 0xc6, 0x28, 0x89,
-	0xc7, 0x47, 0xbb, 0xde, 0x9f, 0xf7, 0x76, 0x58, 0x85, 0x84, 0x83, 0xd6, 0xce, 0x2e, 0x09, 0xd5,
-	0xb5, 0xaf, 0x81, 0x5f, 0x7d, 0xd4, 0x88, 0xf2, 0xdd, 0xee, 0xc1, 0xae, 0xb7, 0xb7, 0xcd, 0xc6,
-	0x6c, 0x69, 0x6d, 0xb7, 0x5b, 0x5e, 0xb6, 0xf4, 0xe8, 0xf8, 0xcd, 0xf7, 0xbb, 0xdb, 0x6d, 0x56,
-	0x5d, 0x7b, 0x50, 0x1a, 0x72, 0x6c, 0x41, 0xb5, 0x76, 0x76, 0xd9, 0x98, 0xdd, 0xcf, 0xd1, 0xae,
-	0xc7, 0x2a, 0x1b, 0xff, 0x98, 0x80, 0xf9, 0xe2, 0x8e, 0xe5, 0x65, 0xcb, 0x7f, 0x80, 0xd9, 0xf2,
-	0x1c, 0xc5, 0xef, 0x8d, 0xba, 0xda, 0x07, 0x26, 0xb7, 0x95, 0xfb, 0x2e, 0x38, 0x9f, 0xcb, 0xc6,
-	0x56, 0x2b, 0xfc, 0x8f, 0xc0, 0x2e, 0x3f, 0xac, 0xfc, 0xe1, 0xe5, 0xf1, 0xe5, 0x4a, 0x3b, 0x5c,
-	0x69, 0x7e, 0x8c, 0x52, 0x98, 0x7f, 0x5e, 0xe1, 0x3e, 0x2c, 0x5d, 0x6e, 0x8f, 0x07, 0xb6, 0x09,
-	0x96, 0x9d, 0x38, 0x7a, 0x6e, 0xd9, 0x89, 0xab, 0xc7, 0x36, 0xc7, 0xf8, 0x9f, 0x60, 0xe1, 0x08,
-	0xcd, 0xe5, 0x1e, 0x77, 0xc1, 0xfe, 0x87, 0x9b, 0xed, 0x05, 0xfb, 0x8e, 0x16, 0xd9, 0x1c, 0xe3,
-	0x6f, 0xa1, 0x3e, 0x7c, 0x4e, 0xf9, 0xca, 0x95, 0x77, 0x64, 0xf8, 0x66, 0xaf, 0xdc, 0xf9, 0x20,
-	0x36, 0xb4, 0xd3, 0xa5, 0x38, 0xaf, 0xbc, 0x5b, 0xfc, 0x51, 0x69, 0x95, 0xf3, 0x8d, 0x5d, 0x79,
-	0x7c, 0x0d, 0x6b, 0x94, 0xf2, 0xce, 0x94, 0xfd, 0xef, 0xf6, 0xf2, 0xbf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x4f, 0x59, 0xb8, 0x65, 0x6c, 0x15, 0x00, 0x00,
+	// 2527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdd, 0x56, 0x1b, 0x47,
+	0xf2, 0x47, 0x12, 0x02, 0xa9, 0xc0, 0xd0, 0x6e, 0x30, 0x74, 0x64, 0xc7, 0x86, 0x89, 0x93, 0x10,
+	0xe2, 0x60, 0x1b, 0x63, 0x27, 0x71, 0xfe, 0x1f, 0x01, 0x24, 0xdb, 0xe4, 0x60, 0xa4, 0x8c, 0x44,
+	0x76, 0xb3, 0xe7, 0x64, 0x73, 0x86, 0x99, 0x96, 0xe8, 0x30, 0x9a, 0xd1, 0xf6, 0xf4, 0x60, 0x93,
+	0x07, 0xd8, 0x9b, 0x3d, 0xfb, 0x48, 0x7b, 0xb1, 0xd7, 0xfb, 0x04, 0xfb, 0x0e, 0x7b, 0xb7, 0xb7,
+	0x7b, 0xb1, 0xa7, 0xba, 0x67, 0xa4, 0x11, 0x30, 0xe0, 0x9c, 0xcd, 0xd9, 0xbb, 0xa9, 0xaa, 0x5f,
+	0x7d, 0x74, 0x75, 0x75, 0x75, 0xf5, 0xc0, 0xa2, 0xe3, 0x2a, 0x71, 0x2a, 0xd4, 0x59, 0xa4, 0x24,
+	0x77, 0xfa, 0x1b, 0x03, 0x19, 0xaa, 0x90, 0x56, 0x52, 0x6e, 0xed, 0x5e, 0x2f, 0x0c, 0x7b, 0x3e,
+	0x7f, 0xa8, 0xf9, 0x47, 0x71, 0xf7, 0xa1, 0x12, 0x7d, 0x1e, 0x29, 0xa7, 0x3f, 0x30, 0x50, 0xeb,
+	0xef, 0x14, 0xa6, 0x9a, 0x47, 0x3f, 0x71, 0x57, 0xd1, 0x7b, 0x70, 0xe3, 0xa7, 0x28, 0x0c, 0xf6,
+	0xbd, 0xdd, 0x30, 0x50, 0xfc, 0xad, 0x62, 0x4f, 0x57, 0x0a, 0x6b, 0x55, 0xbb, 0xf2, 0xb5, 0x6b,
+	0x68, 0xba, 0x06, 0x93, 0xea, 0x6c, 0xc0, 0x59, 0x61, 0xa5, 0xb0, 0x36, 0xb7, 0xb9, 0xb8, 0x91,
+	0x7a, 0xd9, 0x30, 0x06, 0x3a, 0x67, 0x03, 0x6e, 0x6b, 0x04, 0x9d, 0x83, 0xa2, 0xf0, 0x58, 0x51,
+	0xeb, 0x17, 0x85, 0x47, 0x29, 0x4c, 0x06, 0x4e, 0x9f, 0xb3, 0x92, 0xe6, 0xe8, 0x6f, 0xca, 0x60,
+	0x3a, 0x8a, 0xfb, 0x7d, 0x47, 0x9e, 0xb1, 0x49, 0xcd, 0x4e, 0x49, 0xba, 0x0e, 0xd3, 0x89, 0x4b,
+	0x56, 0x5e, 0x29, 0xac, 0xcd, 0x6c, 0x92, 0xf3, 0xae, 0xec, 0x14, 0x40, 0x1f, 0x01, 0x38, 0x4a,
+	0x39, 0xee, 0x71, 0x9f, 0x07, 0x8a, 0x4d, 0xe5, 0xc0, 0x33, 0x18, 0xba, 0x05, 0xb3, 0x8e, 0x52,
+	0x52, 0x1c, 0xc5, 0x8a, 0x7b, 0x9d, 0x90, 0x4d, 0xe7, 0xe8, 0x8c, 0xa1, 0xe8, 0x03, 0xa8, 0x38,
+	0xb1, 0x27, 0x78, 0xe0, 0x72, 0x56, 0xc9, 0xd1, 0x18, 0x22, 0x86, 0x2b, 0x08, 0x14, 0xab, 0x5e,
+	0xb9, 0x82, 0x40, 0xd1, 0x2f, 0xa0, 0x1a, 0x29, 0x47, 0xaa, 0x8e, 0xe8, 0x73, 0x06, 0x1a, 0x5d,
+	0xdb, 0x30, 0xdb, 0xb6, 0x91, 0x6e, 0xdb, 0x46, 0x27, 0xdd, 0x36, 0x7b, 0x04, 0xa6, 0x5b, 0x30,
+	0xcd, 0x03, 0x4f, 0xeb, 0xcd, 0x5c, 0xab, 0x97, 0x42, 0xd1, 0xdf, 0x20, 0x3e, 0xf2, 0x45, 0x74,
+	0xcc, 0x3d, 0x36, 0x7b, 0xbd, 0xbf, 0x21, 0x18, 0xfd, 0xc5, 0x03, 0xcf, 0x51, 0xdc, 0x63, 0x37,
+	0xae, 0xf7, 0x97, 0x40, 0xe9, 0x33, 0xa8, 0x78, 0xb1, 0x74, 0x94, 0x08, 0x03, 0x36, 0x77, 0xad,
+	0xda, 0x10, 0x4b, 0x2d, 0x28, 0xc5, 0xd2, 0x67, 0xf3, 0x39, 0xf9, 0x43, 0x21, 0xbd, 0x03, 0xd5,
+	0x3e, 0xf7, 0x84, 0x83, 0xa5, 0xc7, 0x88, 0xae, 0xa2, 0x11, 0x83, 0xde, 0x87, 0x49, 0xe1, 0x86,
+	0x01, 0xbb, 0x99, 0x63, 0x42, 0x4b, 0xe9, 0x47, 0x50, 0x16, 0x7d, 0xa7, 0xc7, 0x19, 0xcd, 0x81,
+	0x19, 0x31, 0xee, 0xe9, 0x40, 0xf2, 0x53, 0xc1, 0xdf, 0xb0, 0x85, 0xbc, 0x3d, 0x4d, 0x00, 0x58,
+	0x2d, 0x7e, 0xe8, 0x9a, 0x35, 0x2f, 0xe6, 0x55, 0x4b, 0x8a, 0xa0, 0x1b, 0x50, 0x15, 0x81, 0xcd,
+	0x07, 0xfe, 0x59, 0x27, 0x64, 0xb7, 0x72, 0xe0, 0x23, 0x08, 0x46, 0x22, 0xf9, 0xc0, 0x17, 0x3c,
+	0x62, 0x4b, 0x79, 0x91, 0x24, 0x00, 0xcc, 0xa2, 0x72, 0x7a, 0x6c, 0x39, 0x2f, 0x8b, 0xca, 0xe9,
+	0xa1, 0xff, 0x1e, 0x0f, 0xb8, 0x74, 0x54, 0x28, 0x19, 0xcb, 0xf3, 0x3f, 0x84, 0xd0, 0x15, 0x28,
+	0xaa, 0x90, 0xbd, 0x97, 0x03, 0x2c, 0xaa, 0x10, 0xbd, 0x1e, 0xa9, 0x90, 0xd5, 0xf2, 0xbc, 0x1e,
+	0xa9, 0x10, 0xad, 0xb8, 0x2e, 0xbb, 0x9d, 0x67, 0xc5, 0x75, 0xb5, 0x15, 0xd7, 0x65, 0x77, 0x72,
+	0xad, 0xb8, 0x2e, 0xee, 0x9e, 0xe3, 0x62, 0xdc, 0xef, 0xe7, 0xed, 0x9e, 0x16, 0xd3, 0x35, 0x98,
+	0x0a, 0x35, 0x83, 0xdd, 0xcd, 0x01, 0x26, 0x72, 0x44, 0x2a, 0x47, 0xf6, 0xb8, 0x62, 0xf7, 0xf2,
+	0x90, 0x46, 0x8e, 0x48, 0xc9, 0xa3, 0xd8, 0x57, 0x6c, 0x25, 0x0f, 0x69, 0xe4, 0xda, 0xbb, 0x14,
+	0x3d, 0x11, 0xb0, 0xd5, 0x5c, 0xef, 0x5a, 0x8e, 0xfd, 0x4c, 0x04, 0x91, 0x92, 0xb1, 0xee, 0x67,
+	0x56, 0x0e, 0x3a, 0x83, 0xc1, 0xde, 0x7a, 0x2c, 0x79, 0x97, 0x7d, 0x60, 0x7a, 0x2b, 0x7e, 0x53,
+	0x02, 0x25, 0xc9, 0x7d, 0x76, 0x5f, 0xb3, 0xf0, 0x93, 0xd6, 0xa0, 0x82, 0x12, 0xdf, 0x09, 0x7a,
+	0xec, 0x43, 0xd3, 0xd7, 0x53, 0x9a, 0x2e, 0xc1, 0xd4, 0x31, 0x17, 0xbd, 0x63, 0xc5, 0x3e, 0x5a,
+	0x29, 0xac, 0x95, 0xed, 0x84, 0xa2, 0x8b, 0x50, 0x7e, 0x23, 0x3c, 0x75, 0xcc, 0x3e, 0xd6, 0x6c,
+	0x43, 0x60, 0xc6, 0xc3, 0x80, 0x37, 0xbb, 0x6c, 0x2d, 0x2f, 0xe3, 0x5a, 0xac, 0x77, 0x26, 0x38,
+	0x6b, 0x76, 0xd9, 0x27, 0xb9, 0x3b, 0x83, 0x62, 0xba, 0x09, 0x53, 0xae, 0x1f, 0x46, 0xdc, 0x63,
+	0xeb, 0xd7, 0x76, 0x87, 0x04, 0x89, 0x27, 0x20, 0x8a, 0xcd, 0x76, 0x7e, 0x9a, 0x77, 0x02, 0x12,
+	0x00, 0xf6, 0x7b, 0xc9, 0x7d, 0x7d, 0xd2, 0xa2, 0x63, 0x31, 0x60, 0x0f, 0xf2, 0xfa, 0x7d, 0x16,
+	0x45, 0xb7, 0x00, 0xba, 0xa1, 0xec, 0x73, 0xa9, 0x5b, 0xcb, 0x67, 0x57, 0xdc, 0x78, 0x19, 0x1c,
+	0x76, 0x48, 0x8f, 0xfb, 0x1c, 0x3b, 0xe4, 0xc6, 0xf5, 0x1d, 0x32, 0x81, 0xe2, 0xde, 0x38, 0xae,
+	0x1b, 0x4b, 0xc7, 0x3d, 0x63, 0x0f, 0x57, 0x0a, 0x6b, 0x45, 0x7b, 0x48, 0x6b, 0x99, 0xaf, 0x84,
+	0x8a, 0x3d, 0xce, 0x1e, 0x25, 0xb2, 0x84, 0x46, 0x99, 0xef, 0x98, 0x6f, 0xf6, 0xd8, 0xc8, 0x52,
+	0x1a, 0x3b, 0xa3, 0x1f, 0x06, 0x3d, 0x23, 0xdc, 0xd4, 0xc2, 0x11, 0x03, 0x77, 0x5c, 0x3a, 0x9e,
+	0x88, 0x23, 0xf6, 0x44, 0x8b, 0x12, 0x0a, 0x77, 0x3c, 0x0e, 0x84, 0x8a, 0xd8, 0x96, 0x2e, 0x11,
+	0x43, 0xe8, 0x0e, 0xa9, 0x78, 0x3f, 0x62, 0xcf, 0x56, 0x4a, 0x39, 0x1d, 0x12, 0xc5, 0xf4, 0x2e,
+	0x80, 0x0a, 0x95, 0xe3, 0xef, 0x69, 0xf0, 0xe7, 0xba, 0x68, 0x32, 0x1c, 0x7d, 0x2b, 0xc6, 0x52,
+	0x62, 0x61, 0x7f, 0x91, 0x7b, 0x2b, 0x1a, 0x00, 0xfa, 0xec, 0x0a, 0x19, 0x29, 0xf6, 0x65, 0x5e,
+	0xf5, 0x68, 0x31, 0xf6, 0x78, 0xdf, 0x89, 0x14, 0x7b, 0x9e, 0xd7, 0xe3, 0x51, 0x8a, 0xe7, 0x6f,
+	0xe0, 0x48, 0xd5, 0xec, 0xb2, 0xaf, 0xf2, 0xce, 0x9f, 0x91, 0xa3, 0xbd, 0x00, 0x07, 0x8f, 0xff,
+	0xc9, 0xb3, 0x87, 0x52, 0x44, 0x61, 0xab, 0x67, 0xff, 0x9b, 0x87, 0x42, 0xa9, 0xf5, 0xff, 0xb0,
+	0xd0, 0x0a, 0x23, 0xb5, 0x9d, 0x08, 0x6d, 0xfe, 0x87, 0x98, 0x9b, 0x60, 0x0c, 0x8c, 0x15, 0x72,
+	0xd4, 0x13, 0xb9, 0xb5, 0x04, 0x8b, 0xe3, 0x06, 0xa2, 0x41, 0x18, 0x44, 0xdc, 0xfa, 0x6b, 0x01,
+	0x6e, 0x66, 0x05, 0x8d, 0x53, 0x4c, 0xd9, 0x32, 0x54, 0x70, 0x7e, 0xeb, 0xa4, 0x23, 0x5a, 0xd5,
+	0x2e, 0x7f, 0xad, 0xa7, 0xb1, 0xc7, 0x50, 0x6d, 0xbe, 0x09, 0x92, 0x52, 0x2e, 0xea, 0x52, 0x5e,
+	0xc8, 0xf8, 0x4c, 0x45, 0xf6, 0x08, 0x85, 0xc3, 0x99, 0x26, 0xf6, 0xbc, 0x64, 0x66, 0x4b, 0x49,
+	0x94, 0xec, 0x84, 0x6f, 0x0f, 0x70, 0x9a, 0x4b, 0xc6, 0xb6, 0x84, 0xc4, 0x4b, 0x2f, 0x0d, 0x28,
+	0x77, 0x6e, 0x1b, 0x22, 0xac, 0x7f, 0x15, 0x61, 0xb9, 0xad, 0x87, 0xd6, 0x84, 0x25, 0x78, 0x94,
+	0x66, 0xe8, 0x31, 0x4c, 0xa7, 0x33, 0xa8, 0x99, 0x35, 0x97, 0x47, 0x86, 0x8c, 0x4e, 0x22, 0xb6,
+	0x53, 0x1c, 0x5d, 0x81, 0x99, 0xe4, 0xb3, 0xee, 0x28, 0x27, 0x19, 0x3d, 0xb3, 0x2c, 0x6a, 0xc1,
+	0xac, 0xd1, 0x7d, 0x21, 0x7c, 0xc5, 0x65, 0xb2, 0xae, 0x31, 0xde, 0x15, 0x8b, 0x5b, 0x83, 0xf9,
+	0xc3, 0x40, 0x72, 0xc7, 0xdb, 0x0d, 0xe3, 0x40, 0x35, 0x03, 0xdf, 0xac, 0xb1, 0x62, 0x9f, 0x67,
+	0xe3, 0xd9, 0x6a, 0x76, 0xbb, 0x11, 0x37, 0xd3, 0x68, 0xc9, 0x4e, 0x28, 0x3c, 0x5b, 0xfb, 0xa2,
+	0x2f, 0x94, 0x1e, 0x38, 0x4b, 0xb6, 0x21, 0xf0, 0x0c, 0x6f, 0x47, 0x75, 0xd1, 0xe3, 0x91, 0xd2,
+	0x73, 0x65, 0xc5, 0x1e, 0xd2, 0xf4, 0xff, 0x60, 0xa6, 0x15, 0x8a, 0x40, 0x35, 0xbb, 0xdf, 0xe1,
+	0xd4, 0x51, 0xd5, 0xa9, 0xb8, 0x93, 0x49, 0x85, 0x99, 0x97, 0x33, 0x18, 0x3b, 0xab, 0x80, 0xb6,
+	0xf7, 0x9d, 0xa0, 0x17, 0x3b, 0x3d, 0x33, 0x58, 0x56, 0xed, 0x21, 0x6d, 0xbd, 0x02, 0x76, 0x31,
+	0xfb, 0xa6, 0xbc, 0xf4, 0xac, 0x9b, 0x6e, 0x64, 0x21, 0x77, 0xd6, 0x4d, 0x37, 0xf2, 0x6f, 0x05,
+	0x98, 0x6d, 0xc7, 0x47, 0x91, 0x2b, 0xc5, 0x40, 0x8f, 0x33, 0x4b, 0x30, 0x75, 0x18, 0xe9, 0xd2,
+	0x31, 0x55, 0x98, 0x50, 0xf4, 0x09, 0xc0, 0xa8, 0x6d, 0x5e, 0x55, 0x87, 0x19, 0x18, 0xae, 0xc1,
+	0x50, 0xc3, 0x4a, 0x1c, 0xd2, 0xe8, 0x48, 0x57, 0x7e, 0xc4, 0x26, 0x57, 0x4a, 0xe8, 0xc8, 0x50,
+	0xf4, 0x39, 0x54, 0xea, 0xdc, 0x17, 0xa7, 0x5c, 0x9a, 0x4d, 0x9a, 0xdb, 0xbc, 0x3b, 0x72, 0x73,
+	0x10, 0x2a, 0xd1, 0x15, 0x66, 0xf2, 0x4a, 0x51, 0xf6, 0x10, 0x6f, 0x1d, 0x03, 0x7c, 0x1b, 0x0b,
+	0xae, 0x5e, 0x85, 0xb1, 0x8c, 0xb0, 0xaa, 0xda, 0x38, 0x6e, 0xbf, 0x16, 0x41, 0xac, 0xcc, 0xa9,
+	0x2a, 0xdb, 0x59, 0x16, 0xf6, 0xd9, 0x46, 0xe0, 0x25, 0xf2, 0xa2, 0x96, 0x8f, 0x18, 0x18, 0x3d,
+	0xf6, 0xfb, 0xdf, 0x85, 0x41, 0xfa, 0xf6, 0x19, 0xd2, 0xd6, 0x3f, 0x8b, 0xb0, 0x9c, 0x0d, 0xa6,
+	0x25, 0x79, 0x97, 0x4b, 0x7c, 0x3e, 0x44, 0xb9, 0x29, 0x7c, 0x05, 0xf3, 0x75, 0xde, 0x75, 0x62,
+	0x5f, 0x0d, 0x17, 0x58, 0x7c, 0xa7, 0x05, 0x9e, 0x57, 0xa3, 0x3f, 0xc0, 0x9c, 0xc9, 0xd6, 0xd0,
+	0x50, 0x49, 0x37, 0xf7, 0xa7, 0x97, 0x1b, 0xca, 0x04, 0xb7, 0x31, 0xae, 0xd7, 0x08, 0x94, 0x3c,
+	0xb3, 0xcf, 0x19, 0xa3, 0x5b, 0xd9, 0x34, 0xea, 0xb3, 0x34, 0x93, 0xbd, 0x3e, 0x47, 0x32, 0x3b,
+	0x83, 0xab, 0x39, 0xb0, 0x70, 0x89, 0x71, 0x9c, 0x66, 0x4e, 0xf8, 0x59, 0x92, 0x0a, 0xfc, 0xa4,
+	0x5b, 0x50, 0x3e, 0x75, 0xfc, 0x98, 0xbf, 0xe3, 0xea, 0x0d, 0xf8, 0x79, 0xf1, 0x8b, 0x82, 0xf5,
+	0x15, 0xac, 0xbe, 0xe4, 0x2a, 0x67, 0x69, 0x69, 0xff, 0xc9, 0x49, 0xbf, 0x25, 0xc0, 0xba, 0x4a,
+	0x39, 0x39, 0x3e, 0xbb, 0x30, 0x93, 0x61, 0x27, 0x27, 0x68, 0xf5, 0xda, 0xbc, 0xda, 0x59, 0x2d,
+	0xeb, 0x18, 0x56, 0x5b, 0xf1, 0x75, 0x71, 0xfe, 0x2a, 0x9e, 0x04, 0x58, 0xad, 0xf8, 0xbf, 0xb3,
+	0xa8, 0x03, 0x20, 0x49, 0xa7, 0x38, 0xe2, 0xe9, 0x1a, 0x9e, 0x8f, 0x77, 0x8f, 0xc4, 0xf2, 0x52,
+	0xb6, 0xcb, 0x8d, 0xa4, 0xf6, 0x18, 0xd6, 0x6a, 0xc2, 0xcd, 0x8c, 0xbd, 0x24, 0xd2, 0xff, 0xc4,
+	0xe0, 0x9f, 0x0a, 0x50, 0x6b, 0x73, 0x47, 0xba, 0xc7, 0x59, 0xf6, 0x30, 0xdf, 0x0c, 0xa6, 0x4d,
+	0x25, 0x60, 0x02, 0xb0, 0xe3, 0xa4, 0x24, 0x7d, 0x0a, 0x33, 0xa3, 0xa6, 0x15, 0xb1, 0xe2, 0x4a,
+	0x29, 0xaf, 0xb9, 0x65, 0x71, 0xd8, 0x3d, 0xd2, 0x6e, 0x16, 0xe9, 0x03, 0x58, 0xb5, 0x47, 0x0c,
+	0xeb, 0x7b, 0xb8, 0x7d, 0x69, 0x30, 0xbf, 0xc2, 0x42, 0x1f, 0xc3, 0xb2, 0xb9, 0xb7, 0x2e, 0x5e,
+	0xbe, 0x79, 0xc5, 0xbf, 0x09, 0xec, 0xa2, 0x4a, 0x12, 0xca, 0x12, 0x4c, 0x05, 0x71, 0xff, 0x88,
+	0xcb, 0xa4, 0x45, 0x26, 0x94, 0x75, 0x02, 0xcb, 0xa8, 0xbd, 0xef, 0x5c, 0x9c, 0x82, 0xf2, 0x5a,
+	0x5c, 0xe6, 0x0a, 0x2e, 0x8e, 0x5f, 0xc1, 0x77, 0x01, 0x52, 0x23, 0xc3, 0xcb, 0x20, 0xc3, 0xb1,
+	0xb6, 0x80, 0x5d, 0x74, 0x96, 0x04, 0xc8, 0x60, 0xba, 0x1d, 0xbb, 0x2e, 0x8f, 0x4c, 0xe9, 0x56,
+	0xec, 0x94, 0xb4, 0xfe, 0x5c, 0x84, 0xa5, 0x56, 0x2c, 0x7b, 0xfc, 0xb2, 0x31, 0x24, 0x33, 0x37,
+	0x15, 0x7e, 0xd9, 0xdc, 0x54, 0x4f, 0xa3, 0x4f, 0xc8, 0xec, 0xba, 0x4a, 0xe3, 0xeb, 0xaa, 0x41,
+	0xa5, 0x2f, 0x02, 0x3d, 0x40, 0xe8, 0x4e, 0x59, 0xb6, 0x87, 0xb4, 0x96, 0x39, 0x6f, 0x8d, 0xac,
+	0x9c, 0xc8, 0x12, 0x9a, 0x3e, 0x83, 0xa5, 0xe4, 0x1f, 0xcb, 0x0e, 0xef, 0x86, 0x92, 0x0f, 0x5f,
+	0x16, 0x7a, 0xf0, 0x28, 0xdb, 0x39, 0x52, 0x2c, 0x3a, 0x37, 0xec, 0x0f, 0x1c, 0x57, 0xd5, 0x77,
+	0xf4, 0x30, 0x52, 0xb1, 0x47, 0x0c, 0xeb, 0x2f, 0x05, 0x58, 0xbe, 0x90, 0x8f, 0xeb, 0xb2, 0x88,
+	0xc3, 0x55, 0xdd, 0xbc, 0x66, 0x4c, 0xac, 0xe6, 0x26, 0x1c, 0xe3, 0xe1, 0xfe, 0xed, 0x84, 0x6f,
+	0x79, 0x64, 0x10, 0x25, 0x8d, 0xc8, 0x70, 0xb4, 0x8d, 0xa3, 0xb6, 0xf8, 0x99, 0x9b, 0x80, 0x75,
+	0x2e, 0x4a, 0xf6, 0x18, 0x0f, 0x2f, 0x64, 0x43, 0x6f, 0x77, 0x71, 0x86, 0x2b, 0x6b, 0x48, 0x96,
+	0xb5, 0xfe, 0x8f, 0x72, 0x76, 0xcc, 0xa0, 0x73, 0x00, 0x3b, 0x4e, 0xc4, 0x0d, 0x87, 0x4c, 0xd0,
+	0xd9, 0xd1, 0x90, 0x4a, 0x0a, 0xb4, 0x02, 0x93, 0xfb, 0x22, 0x38, 0x21, 0x0f, 0xe9, 0x0c, 0x4c,
+	0xbf, 0xe6, 0x01, 0x9e, 0x0d, 0xf2, 0x08, 0x95, 0x76, 0x43, 0xdf, 0xe7, 0xae, 0xa6, 0x1f, 0xd3,
+	0x5b, 0x70, 0xb3, 0x29, 0x3d, 0x2e, 0xb9, 0x97, 0x61, 0x6f, 0x52, 0x0a, 0x73, 0x23, 0xba, 0xe5,
+	0xf4, 0x38, 0x79, 0x42, 0xdf, 0x83, 0x5b, 0x17, 0xa0, 0x5a, 0xb4, 0x45, 0xe7, 0x61, 0x66, 0x7b,
+	0x30, 0xf0, 0x93, 0x26, 0x49, 0x8a, 0xb4, 0x0a, 0xe5, 0x97, 0x32, 0x8c, 0x07, 0xa4, 0x44, 0x09,
+	0xcc, 0x36, 0x65, 0xcf, 0x09, 0xc4, 0xcf, 0x46, 0x38, 0x49, 0x01, 0xa6, 0x5a, 0x5c, 0x46, 0x61,
+	0x40, 0xca, 0x18, 0x5c, 0x9b, 0xcb, 0x53, 0xe1, 0x72, 0x32, 0x85, 0xc4, 0xb6, 0x54, 0xc2, 0xf5,
+	0x39, 0x99, 0x46, 0x13, 0xdb, 0xb1, 0x27, 0x42, 0x52, 0xc1, 0x95, 0xd5, 0x43, 0x57, 0xff, 0x13,
+	0x20, 0x55, 0x14, 0xe8, 0xab, 0x94, 0x00, 0x7e, 0xee, 0xe1, 0x1f, 0x2c, 0x32, 0x83, 0xeb, 0x3d,
+	0x08, 0x15, 0x27, 0xb3, 0xf8, 0xa5, 0xc3, 0xba, 0x81, 0xe2, 0x96, 0xef, 0xb8, 0x9c, 0xcc, 0xa1,
+	0xe9, 0x96, 0x0c, 0xbb, 0xc2, 0xe7, 0x64, 0x1e, 0x43, 0xb2, 0x33, 0x2f, 0x62, 0x42, 0xe8, 0x0d,
+	0xa8, 0x76, 0xc2, 0xfe, 0x51, 0xa4, 0xc2, 0x80, 0x93, 0x9b, 0xa8, 0xf8, 0x9d, 0xf0, 0x78, 0x48,
+	0x28, 0x06, 0xbb, 0xed, 0xba, 0x7c, 0xa0, 0xc8, 0x02, 0x9d, 0x86, 0xd2, 0xb6, 0xe7, 0x91, 0x45,
+	0x9d, 0xea, 0x20, 0x08, 0xe3, 0xc0, 0xe5, 0xe4, 0x96, 0x86, 0x48, 0x29, 0x4e, 0x39, 0x59, 0x42,
+	0xcd, 0x1d, 0x3f, 0x74, 0x4f, 0xc8, 0x32, 0xb2, 0x77, 0x25, 0x77, 0x14, 0x27, 0x0c, 0xbf, 0x4d,
+	0xc1, 0x90, 0xf7, 0x30, 0x94, 0xba, 0x88, 0x7c, 0x71, 0xc2, 0x49, 0x0d, 0x83, 0x7d, 0xe1, 0x3b,
+	0x3d, 0x72, 0x1b, 0x21, 0x2f, 0x42, 0xdf, 0x0f, 0xdf, 0x90, 0x3b, 0xf8, 0xbd, 0xd7, 0x0b, 0x42,
+	0xc9, 0xc9, 0xfb, 0xfa, 0x3b, 0x38, 0x15, 0x8a, 0x93, 0xbb, 0x88, 0xfe, 0x26, 0x14, 0x01, 0xb9,
+	0x87, 0x7e, 0xf6, 0xb9, 0x73, 0xca, 0xc9, 0x8a, 0xd9, 0xe9, 0x13, 0x4e, 0x56, 0x11, 0xba, 0x2f,
+	0x22, 0xc5, 0x03, 0x62, 0x21, 0xf7, 0x75, 0x78, 0xca, 0xc9, 0x07, 0x08, 0x6d, 0x76, 0xbb, 0x5c,
+	0x92, 0xfb, 0x18, 0xf7, 0xb7, 0x78, 0xfe, 0x71, 0x1f, 0x3e, 0x44, 0xb8, 0xcd, 0x75, 0xf1, 0x7c,
+	0x84, 0x70, 0x9b, 0x3b, 0x1e, 0xf9, 0xd8, 0x70, 0xfb, 0xa8, 0xba, 0x46, 0x17, 0x60, 0xbe, 0xc3,
+	0x03, 0xe5, 0x28, 0x71, 0xca, 0x13, 0xe8, 0x27, 0x63, 0xcc, 0x24, 0x35, 0xeb, 0xa8, 0xd5, 0x91,
+	0xce, 0x29, 0xf7, 0xc9, 0xa7, 0x68, 0xeb, 0x30, 0xf0, 0x42, 0xf2, 0x00, 0xb9, 0x87, 0xfa, 0xa4,
+	0x92, 0xcf, 0x90, 0x8b, 0xa3, 0x3b, 0xd9, 0xc0, 0x64, 0xff, 0x26, 0x94, 0x27, 0xd1, 0x00, 0xb7,
+	0xe6, 0xa9, 0xce, 0x8d, 0x7e, 0x15, 0x90, 0x67, 0x49, 0x12, 0x3c, 0x2e, 0xc9, 0xe7, 0xeb, 0x4f,
+	0xe1, 0xc6, 0xd8, 0x8b, 0x08, 0x85, 0xaf, 0xbf, 0x7f, 0xd1, 0x68, 0xd4, 0xc9, 0x04, 0x26, 0xf1,
+	0xb0, 0xdd, 0xb0, 0x7f, 0xdc, 0xab, 0x93, 0x02, 0x12, 0x07, 0xcd, 0x7a, 0x03, 0x89, 0xe2, 0xfa,
+	0x97, 0x40, 0x2f, 0xbe, 0x1e, 0x10, 0xf2, 0xb2, 0x71, 0xd0, 0xb0, 0xf7, 0x76, 0xc9, 0x84, 0x2e,
+	0xad, 0xdd, 0x4e, 0xd3, 0x36, 0xaa, 0xed, 0xc3, 0x9d, 0x6f, 0x1a, 0xbb, 0x1d, 0x52, 0x5c, 0xbf,
+	0x97, 0xe9, 0x8a, 0xba, 0xa0, 0x9a, 0xf5, 0x06, 0x99, 0xd0, 0xeb, 0x69, 0x37, 0x6c, 0x52, 0x58,
+	0x7f, 0x05, 0x8b, 0x97, 0x4d, 0x61, 0x7a, 0x4b, 0x1b, 0x2f, 0xb6, 0x0f, 0xf7, 0x3b, 0x64, 0x42,
+	0x6f, 0xd8, 0xc1, 0x8f, 0xdb, 0xad, 0x16, 0x29, 0xe8, 0xb5, 0xed, 0xbd, 0x6c, 0xb4, 0x3b, 0xa4,
+	0x88, 0xa0, 0xbd, 0x83, 0x76, 0x67, 0xfb, 0xa0, 0x43, 0x4a, 0x9b, 0x7f, 0x9c, 0x86, 0xf9, 0xf4,
+	0xb4, 0x26, 0x07, 0x80, 0x7e, 0x0b, 0xb3, 0xd9, 0xa7, 0x2f, 0x7d, 0x7f, 0xd4, 0x91, 0x2f, 0x79,
+	0x6c, 0xd7, 0xee, 0xe6, 0x89, 0x93, 0xa7, 0xf4, 0xc4, 0x5a, 0x81, 0xfe, 0x00, 0xe4, 0xfc, 0x5b,
+	0x88, 0xae, 0x9e, 0x7f, 0x71, 0x5e, 0xb8, 0x1e, 0x6a, 0xd6, 0x55, 0x90, 0xd4, 0xfc, 0xa3, 0x02,
+	0x75, 0x60, 0xe9, 0xfc, 0xc5, 0x79, 0xa0, 0xaf, 0xc7, 0xac, 0x93, 0x9c, 0xdb, 0xb8, 0x66, 0x5d,
+	0x05, 0x49, 0x9d, 0xd0, 0xdf, 0xc3, 0x42, 0x9b, 0xab, 0xf3, 0xb7, 0xdf, 0x98, 0xfd, 0xcb, 0xaf,
+	0xe1, 0x9a, 0x75, 0x15, 0x64, 0x68, 0xff, 0x05, 0x54, 0x87, 0x83, 0x16, 0xad, 0x5d, 0x98, 0x30,
+	0x86, 0xd3, 0x5c, 0xed, 0xf6, 0xa5, 0xb2, 0xa1, 0x9d, 0x2e, 0x2c, 0x5c, 0x32, 0xd1, 0xd0, 0xfb,
+	0x19, 0xad, 0xdc, 0xe9, 0xab, 0xf6, 0xe1, 0x35, 0xa8, 0x4c, 0xca, 0x7f, 0x0b, 0xf3, 0xe7, 0xee,
+	0x30, 0xba, 0x92, 0x29, 0x84, 0x4b, 0xaf, 0xfb, 0xda, 0xea, 0x15, 0x88, 0xe1, 0x0a, 0xce, 0xa0,
+	0x96, 0xff, 0x04, 0xa0, 0x9f, 0x8e, 0x4c, 0x5c, 0xfb, 0xca, 0xa8, 0x3d, 0x78, 0x37, 0x70, 0xd6,
+	0x75, 0x2b, 0x7e, 0x17, 0xd7, 0xad, 0xf8, 0x17, 0xb8, 0xbe, 0x7e, 0xf6, 0xb7, 0x26, 0x8e, 0xa6,
+	0xf4, 0xef, 0xcb, 0x27, 0xff, 0x1e, 0x00, 0x5a, 0xc0, 0x37, 0xd4, 0x6f, 0x1c, 0x00, 0x00,
 }
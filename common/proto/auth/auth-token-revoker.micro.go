@@ -16,6 +16,20 @@ It has these top-level messages:
 	RevokeTokenResponse
 	PruneTokensRequest
 	PruneTokensResponse
+	PersonalToken
+	GeneratePersonalTokenRequest
+	GeneratePersonalTokenResponse
+	VerifyPersonalTokenRequest
+	VerifyPersonalTokenResponse
+	ListPersonalTokensRequest
+	ListPersonalTokensResponse
+	RevokePersonalTokenRequest
+	RevokePersonalTokenResponse
+	Session
+	ListSessionsRequest
+	ListSessionsResponse
+	RevokeSessionsRequest
+	RevokeSessionsResponse
 	LdapSearchFilter
 	LdapMapping
 	LdapMemberOfMapping
@@ -59,6 +73,20 @@ type AuthTokenRevokerClient interface {
 	// Revoker invalidates the current token and specifies if the invalidation is due to a refresh or a revokation
 	Revoke(ctx context.Context, in *RevokeTokenRequest, opts ...client.CallOption) (*RevokeTokenResponse, error)
 	PruneTokens(ctx context.Context, in *PruneTokensRequest, opts ...client.CallOption) (*PruneTokensResponse, error)
+	//
+	// Generate a new personal access token, its value is only sent back once
+	GeneratePersonalToken(ctx context.Context, in *GeneratePersonalTokenRequest, opts ...client.CallOption) (*GeneratePersonalTokenResponse, error)
+	//
+	// Find a valid personal access token by its value and record its usage
+	VerifyPersonalToken(ctx context.Context, in *VerifyPersonalTokenRequest, opts ...client.CallOption) (*VerifyPersonalTokenResponse, error)
+	ListPersonalTokens(ctx context.Context, in *ListPersonalTokensRequest, opts ...client.CallOption) (*ListPersonalTokensResponse, error)
+	RevokePersonalToken(ctx context.Context, in *RevokePersonalTokenRequest, opts ...client.CallOption) (*RevokePersonalTokenResponse, error)
+	//
+	// List the sessions of a user, as recorded when dex issues tokens and when gateways verify them
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...client.CallOption) (*ListSessionsResponse, error)
+	//
+	// Revoke one or all sessions of a user: refresh tokens are deleted and tokens already issued are rejected
+	RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...client.CallOption) (*RevokeSessionsResponse, error)
 }
 
 type authTokenRevokerClient struct {
@@ -109,6 +137,66 @@ func (c *authTokenRevokerClient) PruneTokens(ctx context.Context, in *PruneToken
 	return out, nil
 }
 
+func (c *authTokenRevokerClient) GeneratePersonalToken(ctx context.Context, in *GeneratePersonalTokenRequest, opts ...client.CallOption) (*GeneratePersonalTokenResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "AuthTokenRevoker.GeneratePersonalToken", in)
+	out := new(GeneratePersonalTokenResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authTokenRevokerClient) VerifyPersonalToken(ctx context.Context, in *VerifyPersonalTokenRequest, opts ...client.CallOption) (*VerifyPersonalTokenResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "AuthTokenRevoker.VerifyPersonalToken", in)
+	out := new(VerifyPersonalTokenResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authTokenRevokerClient) ListPersonalTokens(ctx context.Context, in *ListPersonalTokensRequest, opts ...client.CallOption) (*ListPersonalTokensResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "AuthTokenRevoker.ListPersonalTokens", in)
+	out := new(ListPersonalTokensResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authTokenRevokerClient) RevokePersonalToken(ctx context.Context, in *RevokePersonalTokenRequest, opts ...client.CallOption) (*RevokePersonalTokenResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "AuthTokenRevoker.RevokePersonalToken", in)
+	out := new(RevokePersonalTokenResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authTokenRevokerClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...client.CallOption) (*ListSessionsResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "AuthTokenRevoker.ListSessions", in)
+	out := new(ListSessionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authTokenRevokerClient) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...client.CallOption) (*RevokeSessionsResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "AuthTokenRevoker.RevokeSessions", in)
+	out := new(RevokeSessionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AuthTokenRevoker service
 
 type AuthTokenRevokerHandler interface {
@@ -119,6 +207,20 @@ type AuthTokenRevokerHandler interface {
 	// Revoker invalidates the current token and specifies if the invalidation is due to a refresh or a revokation
 	Revoke(context.Context, *RevokeTokenRequest, *RevokeTokenResponse) error
 	PruneTokens(context.Context, *PruneTokensRequest, *PruneTokensResponse) error
+	//
+	// Generate a new personal access token, its value is only sent back once
+	GeneratePersonalToken(context.Context, *GeneratePersonalTokenRequest, *GeneratePersonalTokenResponse) error
+	//
+	// Find a valid personal access token by its value and record its usage
+	VerifyPersonalToken(context.Context, *VerifyPersonalTokenRequest, *VerifyPersonalTokenResponse) error
+	ListPersonalTokens(context.Context, *ListPersonalTokensRequest, *ListPersonalTokensResponse) error
+	RevokePersonalToken(context.Context, *RevokePersonalTokenRequest, *RevokePersonalTokenResponse) error
+	//
+	// List the sessions of a user, as recorded when dex issues tokens and when gateways verify them
+	ListSessions(context.Context, *ListSessionsRequest, *ListSessionsResponse) error
+	//
+	// Revoke one or all sessions of a user: refresh tokens are deleted and tokens already issued are rejected
+	RevokeSessions(context.Context, *RevokeSessionsRequest, *RevokeSessionsResponse) error
 }
 
 func RegisterAuthTokenRevokerHandler(s server.Server, hdlr AuthTokenRevokerHandler, opts ...server.HandlerOption) {
@@ -140,3 +242,27 @@ func (h *AuthTokenRevoker) Revoke(ctx context.Context, in *RevokeTokenRequest, o
 func (h *AuthTokenRevoker) PruneTokens(ctx context.Context, in *PruneTokensRequest, out *PruneTokensResponse) error {
 	return h.AuthTokenRevokerHandler.PruneTokens(ctx, in, out)
 }
+
+func (h *AuthTokenRevoker) GeneratePersonalToken(ctx context.Context, in *GeneratePersonalTokenRequest, out *GeneratePersonalTokenResponse) error {
+	return h.AuthTokenRevokerHandler.GeneratePersonalToken(ctx, in, out)
+}
+
+func (h *AuthTokenRevoker) VerifyPersonalToken(ctx context.Context, in *VerifyPersonalTokenRequest, out *VerifyPersonalTokenResponse) error {
+	return h.AuthTokenRevokerHandler.VerifyPersonalToken(ctx, in, out)
+}
+
+func (h *AuthTokenRevoker) ListPersonalTokens(ctx context.Context, in *ListPersonalTokensRequest, out *ListPersonalTokensResponse) error {
+	return h.AuthTokenRevokerHandler.ListPersonalTokens(ctx, in, out)
+}
+
+func (h *AuthTokenRevoker) RevokePersonalToken(ctx context.Context, in *RevokePersonalTokenRequest, out *RevokePersonalTokenResponse) error {
+	return h.AuthTokenRevokerHandler.RevokePersonalToken(ctx, in, out)
+}
+
+func (h *AuthTokenRevoker) ListSessions(ctx context.Context, in *ListSessionsRequest, out *ListSessionsResponse) error {
+	return h.AuthTokenRevokerHandler.ListSessions(ctx, in, out)
+}
+
+func (h *AuthTokenRevoker) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, out *RevokeSessionsResponse) error {
+	return h.AuthTokenRevokerHandler.RevokeSessions(ctx, in, out)
+}
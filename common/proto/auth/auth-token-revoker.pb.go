@@ -16,6 +16,20 @@ It has these top-level messages:
 	RevokeTokenResponse
 	PruneTokensRequest
 	PruneTokensResponse
+	PersonalToken
+	GeneratePersonalTokenRequest
+	GeneratePersonalTokenResponse
+	VerifyPersonalTokenRequest
+	VerifyPersonalTokenResponse
+	ListPersonalTokensRequest
+	ListPersonalTokensResponse
+	RevokePersonalTokenRequest
+	RevokePersonalTokenResponse
+	Session
+	ListSessionsRequest
+	ListSessionsResponse
+	RevokeSessionsRequest
+	RevokeSessionsResponse
 	LdapSearchFilter
 	LdapMapping
 	LdapMemberOfMapping
@@ -180,6 +194,414 @@ func (m *PruneTokensResponse) GetTokens() []string {
 	return nil
 }
 
+type PersonalToken struct {
+	Uuid         string   `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+	Label        string   `protobuf:"bytes,2,opt,name=Label" json:"Label,omitempty"`
+	UserUuid     string   `protobuf:"bytes,3,opt,name=UserUuid" json:"UserUuid,omitempty"`
+	UserLogin    string   `protobuf:"bytes,4,opt,name=UserLogin" json:"UserLogin,omitempty"`
+	Scopes       []string `protobuf:"bytes,5,rep,name=Scopes" json:"Scopes,omitempty"`
+	CreatedAt    int32    `protobuf:"varint,6,opt,name=CreatedAt" json:"CreatedAt,omitempty"`
+	ExpiresAt    int32    `protobuf:"varint,7,opt,name=ExpiresAt" json:"ExpiresAt,omitempty"`
+	LastUsedAt   int32    `protobuf:"varint,8,opt,name=LastUsedAt" json:"LastUsedAt,omitempty"`
+	Impersonator string   `protobuf:"bytes,9,opt,name=Impersonator" json:"Impersonator,omitempty"`
+	Reason       string   `protobuf:"bytes,10,opt,name=Reason" json:"Reason,omitempty"`
+}
+
+func (m *PersonalToken) Reset()                    { *m = PersonalToken{} }
+func (m *PersonalToken) String() string            { return proto.CompactTextString(m) }
+func (*PersonalToken) ProtoMessage()               {}
+func (*PersonalToken) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *PersonalToken) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *PersonalToken) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *PersonalToken) GetUserUuid() string {
+	if m != nil {
+		return m.UserUuid
+	}
+	return ""
+}
+
+func (m *PersonalToken) GetUserLogin() string {
+	if m != nil {
+		return m.UserLogin
+	}
+	return ""
+}
+
+func (m *PersonalToken) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *PersonalToken) GetCreatedAt() int32 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *PersonalToken) GetExpiresAt() int32 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *PersonalToken) GetLastUsedAt() int32 {
+	if m != nil {
+		return m.LastUsedAt
+	}
+	return 0
+}
+
+func (m *PersonalToken) GetImpersonator() string {
+	if m != nil {
+		return m.Impersonator
+	}
+	return ""
+}
+
+func (m *PersonalToken) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type GeneratePersonalTokenRequest struct {
+	Token *PersonalToken `protobuf:"bytes,1,opt,name=Token" json:"Token,omitempty"`
+}
+
+func (m *GeneratePersonalTokenRequest) Reset()                    { *m = GeneratePersonalTokenRequest{} }
+func (m *GeneratePersonalTokenRequest) String() string            { return proto.CompactTextString(m) }
+func (*GeneratePersonalTokenRequest) ProtoMessage()               {}
+func (*GeneratePersonalTokenRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *GeneratePersonalTokenRequest) GetToken() *PersonalToken {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+type GeneratePersonalTokenResponse struct {
+	Token       *PersonalToken `protobuf:"bytes,1,opt,name=Token" json:"Token,omitempty"`
+	AccessToken string         `protobuf:"bytes,2,opt,name=AccessToken" json:"AccessToken,omitempty"`
+}
+
+func (m *GeneratePersonalTokenResponse) Reset()                    { *m = GeneratePersonalTokenResponse{} }
+func (m *GeneratePersonalTokenResponse) String() string            { return proto.CompactTextString(m) }
+func (*GeneratePersonalTokenResponse) ProtoMessage()               {}
+func (*GeneratePersonalTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *GeneratePersonalTokenResponse) GetToken() *PersonalToken {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *GeneratePersonalTokenResponse) GetAccessToken() string {
+	if m != nil {
+		return m.AccessToken
+	}
+	return ""
+}
+
+type VerifyPersonalTokenRequest struct {
+	AccessToken string `protobuf:"bytes,1,opt,name=AccessToken" json:"AccessToken,omitempty"`
+}
+
+func (m *VerifyPersonalTokenRequest) Reset()                    { *m = VerifyPersonalTokenRequest{} }
+func (m *VerifyPersonalTokenRequest) String() string            { return proto.CompactTextString(m) }
+func (*VerifyPersonalTokenRequest) ProtoMessage()               {}
+func (*VerifyPersonalTokenRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *VerifyPersonalTokenRequest) GetAccessToken() string {
+	if m != nil {
+		return m.AccessToken
+	}
+	return ""
+}
+
+type VerifyPersonalTokenResponse struct {
+	Token *PersonalToken `protobuf:"bytes,1,opt,name=Token" json:"Token,omitempty"`
+}
+
+func (m *VerifyPersonalTokenResponse) Reset()                    { *m = VerifyPersonalTokenResponse{} }
+func (m *VerifyPersonalTokenResponse) String() string            { return proto.CompactTextString(m) }
+func (*VerifyPersonalTokenResponse) ProtoMessage()               {}
+func (*VerifyPersonalTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *VerifyPersonalTokenResponse) GetToken() *PersonalToken {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+type ListPersonalTokensRequest struct {
+	UserUuid string `protobuf:"bytes,1,opt,name=UserUuid" json:"UserUuid,omitempty"`
+}
+
+func (m *ListPersonalTokensRequest) Reset()                    { *m = ListPersonalTokensRequest{} }
+func (m *ListPersonalTokensRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListPersonalTokensRequest) ProtoMessage()               {}
+func (*ListPersonalTokensRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *ListPersonalTokensRequest) GetUserUuid() string {
+	if m != nil {
+		return m.UserUuid
+	}
+	return ""
+}
+
+type ListPersonalTokensResponse struct {
+	Tokens []*PersonalToken `protobuf:"bytes,1,rep,name=Tokens" json:"Tokens,omitempty"`
+}
+
+func (m *ListPersonalTokensResponse) Reset()                    { *m = ListPersonalTokensResponse{} }
+func (m *ListPersonalTokensResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListPersonalTokensResponse) ProtoMessage()               {}
+func (*ListPersonalTokensResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *ListPersonalTokensResponse) GetTokens() []*PersonalToken {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+type RevokePersonalTokenRequest struct {
+	Uuid     string `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+	UserUuid string `protobuf:"bytes,2,opt,name=UserUuid" json:"UserUuid,omitempty"`
+}
+
+func (m *RevokePersonalTokenRequest) Reset()                    { *m = RevokePersonalTokenRequest{} }
+func (m *RevokePersonalTokenRequest) String() string            { return proto.CompactTextString(m) }
+func (*RevokePersonalTokenRequest) ProtoMessage()               {}
+func (*RevokePersonalTokenRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *RevokePersonalTokenRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *RevokePersonalTokenRequest) GetUserUuid() string {
+	if m != nil {
+		return m.UserUuid
+	}
+	return ""
+}
+
+type RevokePersonalTokenResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=Success" json:"Success,omitempty"`
+}
+
+func (m *RevokePersonalTokenResponse) Reset()                    { *m = RevokePersonalTokenResponse{} }
+func (m *RevokePersonalTokenResponse) String() string            { return proto.CompactTextString(m) }
+func (*RevokePersonalTokenResponse) ProtoMessage()               {}
+func (*RevokePersonalTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *RevokePersonalTokenResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+type Session struct {
+	Uuid          string `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+	UserUuid      string `protobuf:"bytes,2,opt,name=UserUuid" json:"UserUuid,omitempty"`
+	UserLogin     string `protobuf:"bytes,3,opt,name=UserLogin" json:"UserLogin,omitempty"`
+	ConnectorId   string `protobuf:"bytes,4,opt,name=ConnectorId" json:"ConnectorId,omitempty"`
+	ClientApp     string `protobuf:"bytes,5,opt,name=ClientApp" json:"ClientApp,omitempty"`
+	UserAgent     string `protobuf:"bytes,6,opt,name=UserAgent" json:"UserAgent,omitempty"`
+	RemoteAddress string `protobuf:"bytes,7,opt,name=RemoteAddress" json:"RemoteAddress,omitempty"`
+	CreatedAt     int32  `protobuf:"varint,8,opt,name=CreatedAt" json:"CreatedAt,omitempty"`
+	LastSeenAt    int32  `protobuf:"varint,9,opt,name=LastSeenAt" json:"LastSeenAt,omitempty"`
+	RefreshId     string `protobuf:"bytes,10,opt,name=RefreshId" json:"RefreshId,omitempty"`
+	Current       bool   `protobuf:"varint,11,opt,name=Current" json:"Current,omitempty"`
+}
+
+func (m *Session) Reset()                    { *m = Session{} }
+func (m *Session) String() string            { return proto.CompactTextString(m) }
+func (*Session) ProtoMessage()               {}
+func (*Session) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *Session) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *Session) GetUserUuid() string {
+	if m != nil {
+		return m.UserUuid
+	}
+	return ""
+}
+
+func (m *Session) GetUserLogin() string {
+	if m != nil {
+		return m.UserLogin
+	}
+	return ""
+}
+
+func (m *Session) GetConnectorId() string {
+	if m != nil {
+		return m.ConnectorId
+	}
+	return ""
+}
+
+func (m *Session) GetClientApp() string {
+	if m != nil {
+		return m.ClientApp
+	}
+	return ""
+}
+
+func (m *Session) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
+}
+
+func (m *Session) GetRemoteAddress() string {
+	if m != nil {
+		return m.RemoteAddress
+	}
+	return ""
+}
+
+func (m *Session) GetCreatedAt() int32 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Session) GetLastSeenAt() int32 {
+	if m != nil {
+		return m.LastSeenAt
+	}
+	return 0
+}
+
+func (m *Session) GetRefreshId() string {
+	if m != nil {
+		return m.RefreshId
+	}
+	return ""
+}
+
+func (m *Session) GetCurrent() bool {
+	if m != nil {
+		return m.Current
+	}
+	return false
+}
+
+type ListSessionsRequest struct {
+	UserUuid string `protobuf:"bytes,1,opt,name=UserUuid" json:"UserUuid,omitempty"`
+}
+
+func (m *ListSessionsRequest) Reset()                    { *m = ListSessionsRequest{} }
+func (m *ListSessionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListSessionsRequest) ProtoMessage()               {}
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *ListSessionsRequest) GetUserUuid() string {
+	if m != nil {
+		return m.UserUuid
+	}
+	return ""
+}
+
+type ListSessionsResponse struct {
+	Sessions []*Session `protobuf:"bytes,1,rep,name=Sessions" json:"Sessions,omitempty"`
+}
+
+func (m *ListSessionsResponse) Reset()                    { *m = ListSessionsResponse{} }
+func (m *ListSessionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListSessionsResponse) ProtoMessage()               {}
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *ListSessionsResponse) GetSessions() []*Session {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionsRequest struct {
+	UserUuid   string `protobuf:"bytes,1,opt,name=UserUuid" json:"UserUuid,omitempty"`
+	Uuid       string `protobuf:"bytes,2,opt,name=Uuid" json:"Uuid,omitempty"`
+	ExceptUuid string `protobuf:"bytes,3,opt,name=ExceptUuid" json:"ExceptUuid,omitempty"`
+}
+
+func (m *RevokeSessionsRequest) Reset()                    { *m = RevokeSessionsRequest{} }
+func (m *RevokeSessionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*RevokeSessionsRequest) ProtoMessage()               {}
+func (*RevokeSessionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *RevokeSessionsRequest) GetUserUuid() string {
+	if m != nil {
+		return m.UserUuid
+	}
+	return ""
+}
+
+func (m *RevokeSessionsRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *RevokeSessionsRequest) GetExceptUuid() string {
+	if m != nil {
+		return m.ExceptUuid
+	}
+	return ""
+}
+
+type RevokeSessionsResponse struct {
+	Sessions []string `protobuf:"bytes,1,rep,name=Sessions" json:"Sessions,omitempty"`
+}
+
+func (m *RevokeSessionsResponse) Reset()                    { *m = RevokeSessionsResponse{} }
+func (m *RevokeSessionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*RevokeSessionsResponse) ProtoMessage()               {}
+func (*RevokeSessionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *RevokeSessionsResponse) GetSessions() []string {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Token)(nil), "auth.Token")
 	proto.RegisterType((*MatchInvalidTokenRequest)(nil), "auth.MatchInvalidTokenRequest")
@@ -188,34 +610,82 @@ func init() {
 	proto.RegisterType((*RevokeTokenResponse)(nil), "auth.RevokeTokenResponse")
 	proto.RegisterType((*PruneTokensRequest)(nil), "auth.PruneTokensRequest")
 	proto.RegisterType((*PruneTokensResponse)(nil), "auth.PruneTokensResponse")
+	proto.RegisterType((*PersonalToken)(nil), "auth.PersonalToken")
+	proto.RegisterType((*GeneratePersonalTokenRequest)(nil), "auth.GeneratePersonalTokenRequest")
+	proto.RegisterType((*GeneratePersonalTokenResponse)(nil), "auth.GeneratePersonalTokenResponse")
+	proto.RegisterType((*VerifyPersonalTokenRequest)(nil), "auth.VerifyPersonalTokenRequest")
+	proto.RegisterType((*VerifyPersonalTokenResponse)(nil), "auth.VerifyPersonalTokenResponse")
+	proto.RegisterType((*ListPersonalTokensRequest)(nil), "auth.ListPersonalTokensRequest")
+	proto.RegisterType((*ListPersonalTokensResponse)(nil), "auth.ListPersonalTokensResponse")
+	proto.RegisterType((*RevokePersonalTokenRequest)(nil), "auth.RevokePersonalTokenRequest")
+	proto.RegisterType((*RevokePersonalTokenResponse)(nil), "auth.RevokePersonalTokenResponse")
+	proto.RegisterType((*Session)(nil), "auth.Session")
+	proto.RegisterType((*ListSessionsRequest)(nil), "auth.ListSessionsRequest")
+	proto.RegisterType((*ListSessionsResponse)(nil), "auth.ListSessionsResponse")
+	proto.RegisterType((*RevokeSessionsRequest)(nil), "auth.RevokeSessionsRequest")
+	proto.RegisterType((*RevokeSessionsResponse)(nil), "auth.RevokeSessionsResponse")
 	proto.RegisterEnum("auth.State", State_name, State_value)
 }
 
 func init() { proto.RegisterFile("auth-token-revoker.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 355 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4d, 0x4f, 0xf2, 0x40,
-	0x10, 0xc7, 0xe9, 0xf3, 0xc8, 0xdb, 0x94, 0x10, 0xb2, 0x10, 0x53, 0x38, 0xa8, 0xec, 0xc1, 0x10,
-	0x13, 0xd0, 0xe0, 0xc1, 0xa3, 0x21, 0x42, 0x22, 0x31, 0x88, 0x2e, 0x84, 0xab, 0x59, 0xcb, 0x12,
-	0x08, 0xa4, 0x8b, 0xed, 0x2e, 0x9f, 0xda, 0x0f, 0x61, 0xf6, 0xa5, 0xa6, 0x95, 0x72, 0x9c, 0xf9,
-	0xcf, 0xfc, 0xf7, 0x37, 0x33, 0x0b, 0x1e, 0x95, 0x62, 0xdd, 0x15, 0x7c, 0xcb, 0x82, 0x6e, 0xc8,
-	0x0e, 0x7c, 0xcb, 0xc2, 0xde, 0x3e, 0xe4, 0x82, 0xa3, 0x33, 0xa5, 0xe0, 0x11, 0xe4, 0xe7, 0x4a,
-	0x44, 0x0d, 0xc8, 0x2f, 0xe8, 0x4e, 0x32, 0xcf, 0xb9, 0x72, 0x3a, 0x65, 0x62, 0x02, 0x74, 0x0d,
-	0xd5, 0xc1, 0x72, 0xb9, 0x11, 0x1b, 0x1e, 0xd0, 0xdd, 0x38, 0x58, 0x71, 0xef, 0x9f, 0x96, 0xff,
-	0x64, 0xf1, 0x1d, 0x78, 0x13, 0x2a, 0xfc, 0xf5, 0x38, 0x38, 0xd0, 0xdd, 0x66, 0xa9, 0x2d, 0x09,
-	0xfb, 0x92, 0x2c, 0x12, 0xca, 0x59, 0xc7, 0xb1, 0xb3, 0x0e, 0xf0, 0x0a, 0x9a, 0x19, 0x1d, 0xd1,
-	0x9e, 0x07, 0x11, 0x43, 0x6d, 0xc8, 0xcf, 0x04, 0x15, 0x06, 0xa6, 0xda, 0x77, 0x7b, 0x8a, 0xb5,
-	0xa7, 0x53, 0xc4, 0x28, 0x8a, 0x8c, 0xb0, 0x03, 0xf7, 0xa9, 0xa2, 0x48, 0x92, 0xa5, 0xb3, 0xf8,
-	0x01, 0x10, 0xd1, 0x73, 0xa7, 0x98, 0xda, 0x49, 0x26, 0x37, 0x7e, 0xc0, 0x94, 0x58, 0xc0, 0x5b,
-	0xa8, 0xa7, 0x1a, 0x2d, 0x9a, 0x07, 0xc5, 0x99, 0xf4, 0x7d, 0x16, 0x45, 0xba, 0xb7, 0x44, 0xe2,
-	0x10, 0x37, 0x00, 0xbd, 0x85, 0x32, 0x30, 0xf5, 0x91, 0x7d, 0x09, 0x77, 0xa1, 0x9e, 0xca, 0x5a,
-	0x9b, 0x73, 0x28, 0xe8, 0xa3, 0x28, 0x97, 0xff, 0x9d, 0x32, 0xb1, 0xd1, 0x0d, 0xb6, 0x93, 0xa3,
-	0x0a, 0x94, 0x5e, 0xa7, 0x1f, 0x93, 0xc1, 0xfc, 0xe9, 0xb9, 0x96, 0x43, 0x2e, 0x14, 0xc9, 0x68,
-	0x31, 0x7d, 0x19, 0x0d, 0x6b, 0x4e, 0xff, 0xdb, 0x81, 0xda, 0x40, 0x8a, 0xb5, 0x05, 0xd3, 0x47,
-	0x45, 0xef, 0x50, 0x49, 0xee, 0x13, 0x5d, 0x98, 0x91, 0x4e, 0x5d, 0xa5, 0x75, 0x79, 0x52, 0x37,
-	0x84, 0x38, 0x87, 0x1e, 0xa1, 0x60, 0xdc, 0x91, 0x67, 0x8a, 0x8f, 0x17, 0xd9, 0x6a, 0x66, 0x28,
-	0xbf, 0x06, 0x43, 0x70, 0x13, 0xb3, 0xc7, 0x2e, 0xc7, 0x4b, 0x8a, 0x5d, 0x32, 0x16, 0x85, 0x73,
-	0x9f, 0x05, 0xfd, 0x5f, 0xef, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xb5, 0xf9, 0xfd, 0x9b, 0xcb,
-	0x02, 0x00, 0x00,
+	// 902 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x6d, 0x4f, 0xe3, 0x46,
+	0x10, 0xbe, 0x04, 0xf2, 0x36, 0x01, 0x84, 0x36, 0xdc, 0xc9, 0x31, 0x94, 0x86, 0x6d, 0x55, 0xd1,
+	0x56, 0xd0, 0xf6, 0x5a, 0x89, 0x6f, 0xad, 0x2c, 0x2e, 0xba, 0x8b, 0x1a, 0x7a, 0x57, 0x07, 0x90,
+	0x2a, 0x55, 0xaa, 0x4c, 0x3c, 0x80, 0x75, 0x39, 0x6f, 0xba, 0xbb, 0x41, 0xd7, 0xdf, 0xd3, 0x5f,
+	0xd4, 0x5f, 0xd4, 0x6a, 0x5f, 0xec, 0x78, 0x8d, 0x43, 0xe1, 0x5b, 0xe6, 0x99, 0x99, 0x67, 0x1f,
+	0xef, 0xbc, 0x6c, 0xc0, 0x8b, 0x16, 0xf2, 0xf6, 0x48, 0xb2, 0xf7, 0x98, 0x1e, 0x71, 0xbc, 0x63,
+	0xef, 0x91, 0x1f, 0xcf, 0x39, 0x93, 0x8c, 0xac, 0x2b, 0x0f, 0x1d, 0x42, 0xe3, 0x5c, 0x39, 0xc9,
+	0x0e, 0x34, 0x2e, 0xa3, 0xd9, 0x02, 0xbd, 0xda, 0xa0, 0x76, 0xd8, 0x09, 0x8d, 0x41, 0xbe, 0x80,
+	0xad, 0x20, 0x8e, 0x13, 0x99, 0xb0, 0x34, 0x9a, 0x8d, 0xd2, 0x6b, 0xe6, 0xd5, 0xb5, 0xbb, 0x84,
+	0xd2, 0x6f, 0xc1, 0x3b, 0x8b, 0xe4, 0xf4, 0x76, 0x94, 0xde, 0x45, 0xb3, 0x24, 0xd6, 0x94, 0x21,
+	0xfe, 0xb9, 0x40, 0x21, 0x15, 0xb3, 0xb6, 0x33, 0x66, 0x6d, 0xd0, 0x6b, 0xe8, 0x57, 0x64, 0x88,
+	0x39, 0x4b, 0x05, 0x92, 0x03, 0x68, 0x4c, 0x64, 0x24, 0x8d, 0x98, 0xad, 0x97, 0xdd, 0x63, 0xa5,
+	0xf5, 0x58, 0x43, 0xa1, 0xf1, 0x28, 0x65, 0x21, 0xde, 0xb1, 0x69, 0xa4, 0x54, 0x14, 0x95, 0xb9,
+	0x28, 0x3d, 0x01, 0x12, 0xea, 0xef, 0x76, 0x34, 0x1d, 0x14, 0x35, 0x75, 0xb3, 0x03, 0x4c, 0x88,
+	0x15, 0xf8, 0x0d, 0xf4, 0x9c, 0x44, 0x2b, 0xcd, 0x83, 0xd6, 0x64, 0x31, 0x9d, 0xa2, 0x10, 0x3a,
+	0xb7, 0x1d, 0x66, 0x26, 0xdd, 0x01, 0xf2, 0x8e, 0x2f, 0x52, 0x13, 0x2f, 0xec, 0x49, 0xf4, 0x08,
+	0x7a, 0x0e, 0x6a, 0x69, 0x5e, 0x40, 0x53, 0x17, 0x45, 0xb1, 0xac, 0x1d, 0x76, 0x42, 0x6b, 0xd1,
+	0xbf, 0xeb, 0xb0, 0xf9, 0x0e, 0xb9, 0x50, 0x37, 0x6b, 0x0a, 0x43, 0x60, 0xfd, 0x62, 0x91, 0xc4,
+	0xf6, 0xf6, 0xf4, 0x6f, 0x75, 0xa5, 0xe3, 0xe8, 0x0a, 0x67, 0xf6, 0x9b, 0x8d, 0x41, 0x7c, 0x68,
+	0x5f, 0x08, 0xe4, 0x3a, 0x7a, 0x4d, 0x3b, 0x72, 0x9b, 0xec, 0x41, 0x47, 0xfd, 0x1e, 0xb3, 0x9b,
+	0x24, 0xf5, 0xd6, 0xb5, 0x73, 0x09, 0x28, 0x35, 0x93, 0x29, 0x9b, 0xa3, 0xf0, 0x1a, 0x46, 0x8d,
+	0xb1, 0x54, 0xd6, 0x29, 0xc7, 0x48, 0x62, 0x1c, 0x48, 0xaf, 0x39, 0xa8, 0x1d, 0x36, 0xc2, 0x25,
+	0xa0, 0xbc, 0xc3, 0x8f, 0xf3, 0x84, 0xa3, 0x08, 0xa4, 0xd7, 0x32, 0xde, 0x1c, 0x20, 0xfb, 0x00,
+	0xe3, 0x48, 0xc8, 0x0b, 0xa1, 0x93, 0xdb, 0xda, 0x5d, 0x40, 0x08, 0x85, 0x8d, 0xd1, 0x87, 0xb9,
+	0xf9, 0x54, 0xc9, 0xb8, 0xd7, 0xd1, 0xa2, 0x1c, 0x4c, 0xe9, 0x0a, 0x31, 0x12, 0x2c, 0xf5, 0x40,
+	0x7b, 0xad, 0x45, 0x47, 0xb0, 0xf7, 0x1a, 0x53, 0xe4, 0x91, 0x44, 0xe7, 0xb2, 0xb2, 0xf2, 0x7e,
+	0xe9, 0x96, 0xb7, 0x67, 0xca, 0xeb, 0x86, 0xda, 0x32, 0xcf, 0xe0, 0x93, 0x15, 0x54, 0xb6, 0x52,
+	0x8f, 0xe7, 0x22, 0x03, 0xe8, 0x06, 0xba, 0x17, 0x4c, 0x82, 0x29, 0x4e, 0x11, 0xa2, 0x3f, 0x82,
+	0x7f, 0x89, 0x3c, 0xb9, 0xfe, 0xab, 0x52, 0x76, 0x29, 0xbf, 0x76, 0x3f, 0xff, 0x0d, 0xec, 0x56,
+	0xe6, 0x3f, 0x59, 0x2b, 0x3d, 0x81, 0xfe, 0x38, 0x11, 0xd2, 0xf1, 0x65, 0x4d, 0xeb, 0x74, 0x52,
+	0xcd, 0xed, 0x24, 0x3a, 0x02, 0xbf, 0x2a, 0xd1, 0x2a, 0xf8, 0x1a, 0x9a, 0xe7, 0xcb, 0xbe, 0x5e,
+	0x21, 0xc1, 0x86, 0xd0, 0x31, 0xf8, 0x66, 0xc4, 0x2a, 0x6f, 0xa3, 0xaa, 0xf1, 0x8b, 0xc2, 0xea,
+	0x25, 0x61, 0x27, 0xb0, 0x5b, 0xc9, 0xf6, 0xbf, 0x83, 0xfb, 0x4f, 0x1d, 0x5a, 0x13, 0x14, 0x22,
+	0x61, 0xe9, 0x53, 0x0f, 0x75, 0xe7, 0x6a, 0xad, 0x3c, 0x57, 0x03, 0xe8, 0x9e, 0xb2, 0x34, 0xc5,
+	0xa9, 0x64, 0x7c, 0x14, 0xdb, 0xb9, 0x2b, 0x42, 0x7a, 0xc2, 0x66, 0x09, 0xa6, 0x32, 0x98, 0xcf,
+	0xbd, 0x86, 0xc9, 0xcf, 0x81, 0x8c, 0x3d, 0xb8, 0xc1, 0xd4, 0xcc, 0x5f, 0x27, 0x5c, 0x02, 0xe4,
+	0x73, 0xd8, 0x0c, 0xf1, 0x03, 0x93, 0x18, 0xc4, 0x31, 0x57, 0xdf, 0xd5, 0xd2, 0x11, 0x2e, 0xe8,
+	0xce, 0x70, 0xbb, 0x3c, 0xc3, 0x76, 0x4a, 0x27, 0x88, 0x69, 0x20, 0xbd, 0xce, 0x72, 0x4a, 0x0d,
+	0xa2, 0xb2, 0x43, 0xbc, 0xe6, 0x28, 0x6e, 0x47, 0xb1, 0x1d, 0xc2, 0x25, 0xa0, 0xee, 0xf4, 0x74,
+	0xc1, 0xb9, 0x52, 0xd7, 0x35, 0x77, 0x6a, 0x4d, 0xfa, 0x1d, 0xf4, 0x54, 0x97, 0xd8, 0x6b, 0x7d,
+	0x54, 0x63, 0x05, 0xb0, 0xe3, 0xa6, 0xe4, 0x4d, 0xdd, 0xce, 0x30, 0xdb, 0x54, 0x9b, 0xf6, 0x3d,
+	0x30, 0x68, 0x98, 0xbb, 0xe9, 0x0d, 0x3c, 0x37, 0x2d, 0xf0, 0x84, 0x73, 0xf3, 0x92, 0xd7, 0x0b,
+	0x25, 0xdf, 0x07, 0x18, 0x7e, 0x9c, 0xe2, 0x5c, 0x16, 0x96, 0x69, 0x01, 0xa1, 0x3f, 0xc0, 0x8b,
+	0xf2, 0x41, 0x56, 0xad, 0x5f, 0x52, 0xdb, 0x59, 0xca, 0xfb, 0x8a, 0xda, 0x67, 0x8d, 0x6c, 0x40,
+	0xfb, 0x97, 0xb7, 0x7f, 0x9c, 0x05, 0xe7, 0xa7, 0x6f, 0xb6, 0x9f, 0x91, 0x2e, 0xb4, 0xc2, 0xe1,
+	0xe5, 0xdb, 0x9f, 0x87, 0xaf, 0xb6, 0x6b, 0x2f, 0xff, 0x6d, 0xc0, 0x76, 0xb0, 0x90, 0xb7, 0xb6,
+	0x79, 0xf5, 0x8b, 0x4d, 0x7e, 0x85, 0x8d, 0xe2, 0x63, 0x49, 0xf6, 0xcd, 0x05, 0xac, 0x7a, 0x72,
+	0xfd, 0x4f, 0x57, 0xfa, 0x8d, 0x4a, 0xfa, 0x8c, 0xfc, 0x04, 0x4d, 0xc3, 0x4e, 0x3c, 0x13, 0x7c,
+	0xff, 0x95, 0xf4, 0xfb, 0x15, 0x9e, 0x9c, 0xe0, 0x15, 0x74, 0x0b, 0x0f, 0x5b, 0xc6, 0x72, 0xff,
+	0x05, 0xf4, 0xfb, 0x15, 0x9e, 0x9c, 0xe5, 0x0a, 0x9e, 0x57, 0xae, 0x5f, 0x42, 0x4d, 0xd6, 0x43,
+	0x6b, 0xde, 0xff, 0xec, 0xc1, 0x98, 0xfc, 0x8c, 0xdf, 0xa1, 0x57, 0xb1, 0x34, 0xc9, 0xc0, 0x64,
+	0xaf, 0xde, 0xc7, 0xfe, 0xc1, 0x03, 0x11, 0x39, 0xfb, 0x6f, 0x40, 0xee, 0xef, 0x43, 0x62, 0x2b,
+	0xb0, 0x72, 0xc5, 0xfa, 0x83, 0xd5, 0x01, 0x45, 0xe1, 0x15, 0x1b, 0x2d, 0x13, 0xbe, 0x7a, 0x75,
+	0xfa, 0x07, 0x0f, 0x44, 0xe4, 0xec, 0xaf, 0x61, 0xa3, 0x38, 0x6f, 0xa4, 0xbf, 0x54, 0x54, 0x1a,
+	0x1f, 0xdf, 0xaf, 0x72, 0xe5, 0x44, 0x67, 0xb0, 0xe5, 0x0e, 0x03, 0xd9, 0x2d, 0x9e, 0x5f, 0x26,
+	0xdb, 0xab, 0x76, 0x66, 0x74, 0x57, 0x4d, 0xfd, 0xff, 0xf4, 0xfb, 0xff, 0x06, 0x00, 0x23, 0xe2,
+	0x29, 0xf4, 0xbb, 0x0a, 0x00, 0x00,
 }
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+/* personal-token.go enriches the generated PersonalToken struct with helpers shared by the token store and the verifiers */
+
+// PersonalTokenPrefix is prepended to personal access tokens values, so that they can be told apart from JWT.
+const PersonalTokenPrefix = "pat_"
+
+// NewPersonalTokenValue generates a random personal access token value.
+func NewPersonalTokenValue() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return PersonalTokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// IsPersonalToken checks if a raw token value looks like a personal access token.
+func IsPersonalToken(value string) bool {
+	return strings.HasPrefix(value, PersonalTokenPrefix)
+}
+
+// HashPersonalToken computes the key under which a token is stored: clear values are never persisted.
+func HashPersonalToken(value string) string {
+	h := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(h[:])
+}
+
+// IsExpired checks the token expiration date against a reference time. Tokens without expiration date are
+// considered expired.
+func (m *PersonalToken) IsExpired(ref time.Time) bool {
+	return int64(m.ExpiresAt) <= ref.Unix()
+}
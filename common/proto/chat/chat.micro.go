@@ -30,12 +30,25 @@ It is generated from these files:
 It has these top-level messages:
 	ChatRoom
 	ChatMessage
+	ChatMessageRevision
+	ChatReaction
 	PutRoomRequest
 	PutRoomResponse
 	PostMessageRequest
 	PostMessageResponse
 	DeleteMessageRequest
 	DeleteMessageResponse
+	EditMessageRequest
+	EditMessageResponse
+	ReactToMessageRequest
+	ReactToMessageResponse
+	GetRoomRequest
+	GetRoomResponse
+	SearchMessagesRequest
+	SearchMessagesResponse
+	PurgeMessagesRequest
+	PurgeMessagesResponse
+	ChatTranscript
 	ListMessagesRequest
 	ListMessagesResponse
 	ListRoomsRequest
@@ -83,6 +96,16 @@ type ChatServiceClient interface {
 	ListMessages(ctx context.Context, in *ListMessagesRequest, opts ...client.CallOption) (ChatService_ListMessagesClient, error)
 	PostMessage(ctx context.Context, in *PostMessageRequest, opts ...client.CallOption) (*PostMessageResponse, error)
 	DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...client.CallOption) (*DeleteMessageResponse, error)
+	// EditMessage replaces the text of a message, keeping the previous version in its history. Only the author can edit.
+	EditMessage(ctx context.Context, in *EditMessageRequest, opts ...client.CallOption) (*EditMessageResponse, error)
+	// ReactToMessage adds the reaction of a user to a message, or removes it if it is already there.
+	ReactToMessage(ctx context.Context, in *ReactToMessageRequest, opts ...client.CallOption) (*ReactToMessageResponse, error)
+	// GetRoom loads a room by its Uuid
+	GetRoom(ctx context.Context, in *GetRoomRequest, opts ...client.CallOption) (*GetRoomResponse, error)
+	// SearchMessages looks up the full-text index of messages, most recent first. It does not check any ACL.
+	SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...client.CallOption) (ChatService_SearchMessagesClient, error)
+	// PurgeMessages removes the messages older than a given date in all the rooms of a given type.
+	PurgeMessages(ctx context.Context, in *PurgeMessagesRequest, opts ...client.CallOption) (*PurgeMessagesResponse, error)
 }
 
 type chatServiceClient struct {
@@ -231,6 +254,90 @@ func (c *chatServiceClient) DeleteMessage(ctx context.Context, in *DeleteMessage
 	return out, nil
 }
 
+func (c *chatServiceClient) EditMessage(ctx context.Context, in *EditMessageRequest, opts ...client.CallOption) (*EditMessageResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "ChatService.EditMessage", in)
+	out := new(EditMessageResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ReactToMessage(ctx context.Context, in *ReactToMessageRequest, opts ...client.CallOption) (*ReactToMessageResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "ChatService.ReactToMessage", in)
+	out := new(ReactToMessageResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetRoom(ctx context.Context, in *GetRoomRequest, opts ...client.CallOption) (*GetRoomResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "ChatService.GetRoom", in)
+	out := new(GetRoomResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...client.CallOption) (ChatService_SearchMessagesClient, error) {
+	req := c.c.NewRequest(c.serviceName, "ChatService.SearchMessages", &SearchMessagesRequest{})
+	stream, err := c.c.Stream(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(in); err != nil {
+		return nil, err
+	}
+	return &chatServiceSearchMessagesClient{stream}, nil
+}
+
+type ChatService_SearchMessagesClient interface {
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	Close() error
+	Recv() (*SearchMessagesResponse, error)
+}
+
+type chatServiceSearchMessagesClient struct {
+	stream client.Streamer
+}
+
+func (x *chatServiceSearchMessagesClient) Close() error {
+	return x.stream.Close()
+}
+
+func (x *chatServiceSearchMessagesClient) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *chatServiceSearchMessagesClient) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *chatServiceSearchMessagesClient) Recv() (*SearchMessagesResponse, error) {
+	m := new(SearchMessagesResponse)
+	err := x.stream.Recv(m)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chatServiceClient) PurgeMessages(ctx context.Context, in *PurgeMessagesRequest, opts ...client.CallOption) (*PurgeMessagesResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "ChatService.PurgeMessages", in)
+	out := new(PurgeMessagesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ChatService service
 
 type ChatServiceHandler interface {
@@ -240,6 +347,16 @@ type ChatServiceHandler interface {
 	ListMessages(context.Context, *ListMessagesRequest, ChatService_ListMessagesStream) error
 	PostMessage(context.Context, *PostMessageRequest, *PostMessageResponse) error
 	DeleteMessage(context.Context, *DeleteMessageRequest, *DeleteMessageResponse) error
+	// EditMessage replaces the text of a message, keeping the previous version in its history. Only the author can edit.
+	EditMessage(context.Context, *EditMessageRequest, *EditMessageResponse) error
+	// ReactToMessage adds the reaction of a user to a message, or removes it if it is already there.
+	ReactToMessage(context.Context, *ReactToMessageRequest, *ReactToMessageResponse) error
+	// GetRoom loads a room by its Uuid
+	GetRoom(context.Context, *GetRoomRequest, *GetRoomResponse) error
+	// SearchMessages looks up the full-text index of messages, most recent first. It does not check any ACL.
+	SearchMessages(context.Context, *SearchMessagesRequest, ChatService_SearchMessagesStream) error
+	// PurgeMessages removes the messages older than a given date in all the rooms of a given type.
+	PurgeMessages(context.Context, *PurgeMessagesRequest, *PurgeMessagesResponse) error
 }
 
 func RegisterChatServiceHandler(s server.Server, hdlr ChatServiceHandler, opts ...server.HandlerOption) {
@@ -335,3 +452,54 @@ func (h *ChatService) PostMessage(ctx context.Context, in *PostMessageRequest, o
 func (h *ChatService) DeleteMessage(ctx context.Context, in *DeleteMessageRequest, out *DeleteMessageResponse) error {
 	return h.ChatServiceHandler.DeleteMessage(ctx, in, out)
 }
+
+func (h *ChatService) EditMessage(ctx context.Context, in *EditMessageRequest, out *EditMessageResponse) error {
+	return h.ChatServiceHandler.EditMessage(ctx, in, out)
+}
+
+func (h *ChatService) ReactToMessage(ctx context.Context, in *ReactToMessageRequest, out *ReactToMessageResponse) error {
+	return h.ChatServiceHandler.ReactToMessage(ctx, in, out)
+}
+
+func (h *ChatService) GetRoom(ctx context.Context, in *GetRoomRequest, out *GetRoomResponse) error {
+	return h.ChatServiceHandler.GetRoom(ctx, in, out)
+}
+
+func (h *ChatService) SearchMessages(ctx context.Context, stream server.Streamer) error {
+	m := new(SearchMessagesRequest)
+	if err := stream.Recv(m); err != nil {
+		return err
+	}
+	return h.ChatServiceHandler.SearchMessages(ctx, m, &chatServiceSearchMessagesStream{stream})
+}
+
+type ChatService_SearchMessagesStream interface {
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	Close() error
+	Send(*SearchMessagesResponse) error
+}
+
+type chatServiceSearchMessagesStream struct {
+	stream server.Streamer
+}
+
+func (x *chatServiceSearchMessagesStream) Close() error {
+	return x.stream.Close()
+}
+
+func (x *chatServiceSearchMessagesStream) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *chatServiceSearchMessagesStream) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *chatServiceSearchMessagesStream) Send(m *SearchMessagesResponse) error {
+	return x.stream.Send(m)
+}
+
+func (h *ChatService) PurgeMessages(ctx context.Context, in *PurgeMessagesRequest, out *PurgeMessagesResponse) error {
+	return h.ChatServiceHandler.PurgeMessages(ctx, in, out)
+}
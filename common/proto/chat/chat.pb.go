@@ -30,12 +30,25 @@ It is generated from these files:
 It has these top-level messages:
 	ChatRoom
 	ChatMessage
+	ChatMessageRevision
+	ChatReaction
 	PutRoomRequest
 	PutRoomResponse
 	PostMessageRequest
 	PostMessageResponse
 	DeleteMessageRequest
 	DeleteMessageResponse
+	EditMessageRequest
+	EditMessageResponse
+	ReactToMessageRequest
+	ReactToMessageResponse
+	GetRoomRequest
+	GetRoomResponse
+	SearchMessagesRequest
+	SearchMessagesResponse
+	PurgeMessagesRequest
+	PurgeMessagesResponse
+	ChatTranscript
 	ListMessagesRequest
 	ListMessagesResponse
 	ListRoomsRequest
@@ -100,6 +113,8 @@ const (
 	WsMessageType_HISTORY     WsMessageType = 4
 	WsMessageType_DELETE_MSG  WsMessageType = 5
 	WsMessageType_DELETE_ROOM WsMessageType = 6
+	WsMessageType_EDIT_MSG    WsMessageType = 7
+	WsMessageType_REACT       WsMessageType = 8
 )
 
 var WsMessageType_name = map[int32]string{
@@ -110,6 +125,8 @@ var WsMessageType_name = map[int32]string{
 	4: "HISTORY",
 	5: "DELETE_MSG",
 	6: "DELETE_ROOM",
+	7: "EDIT_MSG",
+	8: "REACT",
 }
 var WsMessageType_value = map[string]int32{
 	"JOIN":        0,
@@ -119,6 +136,8 @@ var WsMessageType_value = map[string]int32{
 	"HISTORY":     4,
 	"DELETE_MSG":  5,
 	"DELETE_ROOM": 6,
+	"EDIT_MSG":    7,
+	"REACT":       8,
 }
 
 func (x WsMessageType) String() string {
@@ -189,6 +208,15 @@ type ChatMessage struct {
 	Author    string           `protobuf:"bytes,4,opt,name=Author" json:"Author,omitempty"`
 	Timestamp int64            `protobuf:"varint,5,opt,name=Timestamp" json:"Timestamp,omitempty"`
 	Activity  *activity.Object `protobuf:"bytes,6,opt,name=Activity" json:"Activity,omitempty"`
+	// Uuid of the first message of the thread this message replies to
+	ThreadUuid string `protobuf:"bytes,7,opt,name=ThreadUuid" json:"ThreadUuid,omitempty"`
+	// Last edition time, zero if the message was never edited
+	EditedAt int64 `protobuf:"varint,8,opt,name=EditedAt" json:"EditedAt,omitempty"`
+	// Previous versions of the message, oldest first
+	History   []*ChatMessageRevision `protobuf:"bytes,9,rep,name=History" json:"History,omitempty"`
+	Reactions []*ChatReaction        `protobuf:"bytes,10,rep,name=Reactions" json:"Reactions,omitempty"`
+	// Logins of the users mentioned with @login in the message
+	Mentions []string `protobuf:"bytes,11,rep,name=Mentions" json:"Mentions,omitempty"`
 }
 
 func (m *ChatMessage) Reset()                    { *m = ChatMessage{} }
@@ -238,6 +266,89 @@ func (m *ChatMessage) GetActivity() *activity.Object {
 	return nil
 }
 
+func (m *ChatMessage) GetThreadUuid() string {
+	if m != nil {
+		return m.ThreadUuid
+	}
+	return ""
+}
+
+func (m *ChatMessage) GetEditedAt() int64 {
+	if m != nil {
+		return m.EditedAt
+	}
+	return 0
+}
+
+func (m *ChatMessage) GetHistory() []*ChatMessageRevision {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *ChatMessage) GetReactions() []*ChatReaction {
+	if m != nil {
+		return m.Reactions
+	}
+	return nil
+}
+
+func (m *ChatMessage) GetMentions() []string {
+	if m != nil {
+		return m.Mentions
+	}
+	return nil
+}
+
+type ChatMessageRevision struct {
+	Message   string `protobuf:"bytes,1,opt,name=Message" json:"Message,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=Timestamp" json:"Timestamp,omitempty"`
+}
+
+func (m *ChatMessageRevision) Reset()                    { *m = ChatMessageRevision{} }
+func (m *ChatMessageRevision) String() string            { return proto.CompactTextString(m) }
+func (*ChatMessageRevision) ProtoMessage()               {}
+func (*ChatMessageRevision) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *ChatMessageRevision) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ChatMessageRevision) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type ChatReaction struct {
+	Emoji string   `protobuf:"bytes,1,opt,name=Emoji" json:"Emoji,omitempty"`
+	Users []string `protobuf:"bytes,2,rep,name=Users" json:"Users,omitempty"`
+}
+
+func (m *ChatReaction) Reset()                    { *m = ChatReaction{} }
+func (m *ChatReaction) String() string            { return proto.CompactTextString(m) }
+func (*ChatReaction) ProtoMessage()               {}
+func (*ChatReaction) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *ChatReaction) GetEmoji() string {
+	if m != nil {
+		return m.Emoji
+	}
+	return ""
+}
+
+func (m *ChatReaction) GetUsers() []string {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
 type PutRoomRequest struct {
 	Room *ChatRoom `protobuf:"bytes,1,opt,name=Room" json:"Room,omitempty"`
 }
@@ -245,7 +356,7 @@ type PutRoomRequest struct {
 func (m *PutRoomRequest) Reset()                    { *m = PutRoomRequest{} }
 func (m *PutRoomRequest) String() string            { return proto.CompactTextString(m) }
 func (*PutRoomRequest) ProtoMessage()               {}
-func (*PutRoomRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*PutRoomRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 func (m *PutRoomRequest) GetRoom() *ChatRoom {
 	if m != nil {
@@ -261,7 +372,7 @@ type PutRoomResponse struct {
 func (m *PutRoomResponse) Reset()                    { *m = PutRoomResponse{} }
 func (m *PutRoomResponse) String() string            { return proto.CompactTextString(m) }
 func (*PutRoomResponse) ProtoMessage()               {}
-func (*PutRoomResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*PutRoomResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 func (m *PutRoomResponse) GetRoom() *ChatRoom {
 	if m != nil {
@@ -277,7 +388,7 @@ type PostMessageRequest struct {
 func (m *PostMessageRequest) Reset()                    { *m = PostMessageRequest{} }
 func (m *PostMessageRequest) String() string            { return proto.CompactTextString(m) }
 func (*PostMessageRequest) ProtoMessage()               {}
-func (*PostMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*PostMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 func (m *PostMessageRequest) GetMessages() []*ChatMessage {
 	if m != nil {
@@ -294,7 +405,7 @@ type PostMessageResponse struct {
 func (m *PostMessageResponse) Reset()                    { *m = PostMessageResponse{} }
 func (m *PostMessageResponse) String() string            { return proto.CompactTextString(m) }
 func (*PostMessageResponse) ProtoMessage()               {}
-func (*PostMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*PostMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 func (m *PostMessageResponse) GetSuccess() bool {
 	if m != nil {
@@ -317,7 +428,7 @@ type DeleteMessageRequest struct {
 func (m *DeleteMessageRequest) Reset()                    { *m = DeleteMessageRequest{} }
 func (m *DeleteMessageRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteMessageRequest) ProtoMessage()               {}
-func (*DeleteMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*DeleteMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 func (m *DeleteMessageRequest) GetMessages() []*ChatMessage {
 	if m != nil {
@@ -333,7 +444,7 @@ type DeleteMessageResponse struct {
 func (m *DeleteMessageResponse) Reset()                    { *m = DeleteMessageResponse{} }
 func (m *DeleteMessageResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteMessageResponse) ProtoMessage()               {}
-func (*DeleteMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*DeleteMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *DeleteMessageResponse) GetSuccess() bool {
 	if m != nil {
@@ -342,18 +453,322 @@ func (m *DeleteMessageResponse) GetSuccess() bool {
 	return false
 }
 
+type EditMessageRequest struct {
+	// Message with its Uuid, RoomUuid, Author and new text
+	Message *ChatMessage `protobuf:"bytes,1,opt,name=Message" json:"Message,omitempty"`
+}
+
+func (m *EditMessageRequest) Reset()                    { *m = EditMessageRequest{} }
+func (m *EditMessageRequest) String() string            { return proto.CompactTextString(m) }
+func (*EditMessageRequest) ProtoMessage()               {}
+func (*EditMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *EditMessageRequest) GetMessage() *ChatMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+type EditMessageResponse struct {
+	Message *ChatMessage `protobuf:"bytes,1,opt,name=Message" json:"Message,omitempty"`
+}
+
+func (m *EditMessageResponse) Reset()                    { *m = EditMessageResponse{} }
+func (m *EditMessageResponse) String() string            { return proto.CompactTextString(m) }
+func (*EditMessageResponse) ProtoMessage()               {}
+func (*EditMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *EditMessageResponse) GetMessage() *ChatMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+type ReactToMessageRequest struct {
+	RoomUuid    string `protobuf:"bytes,1,opt,name=RoomUuid" json:"RoomUuid,omitempty"`
+	MessageUuid string `protobuf:"bytes,2,opt,name=MessageUuid" json:"MessageUuid,omitempty"`
+	Emoji       string `protobuf:"bytes,3,opt,name=Emoji" json:"Emoji,omitempty"`
+	User        string `protobuf:"bytes,4,opt,name=User" json:"User,omitempty"`
+}
+
+func (m *ReactToMessageRequest) Reset()                    { *m = ReactToMessageRequest{} }
+func (m *ReactToMessageRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReactToMessageRequest) ProtoMessage()               {}
+func (*ReactToMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *ReactToMessageRequest) GetRoomUuid() string {
+	if m != nil {
+		return m.RoomUuid
+	}
+	return ""
+}
+
+func (m *ReactToMessageRequest) GetMessageUuid() string {
+	if m != nil {
+		return m.MessageUuid
+	}
+	return ""
+}
+
+func (m *ReactToMessageRequest) GetEmoji() string {
+	if m != nil {
+		return m.Emoji
+	}
+	return ""
+}
+
+func (m *ReactToMessageRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+type ReactToMessageResponse struct {
+	Message *ChatMessage `protobuf:"bytes,1,opt,name=Message" json:"Message,omitempty"`
+}
+
+func (m *ReactToMessageResponse) Reset()                    { *m = ReactToMessageResponse{} }
+func (m *ReactToMessageResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReactToMessageResponse) ProtoMessage()               {}
+func (*ReactToMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *ReactToMessageResponse) GetMessage() *ChatMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+type GetRoomRequest struct {
+	Uuid string `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+}
+
+func (m *GetRoomRequest) Reset()                    { *m = GetRoomRequest{} }
+func (m *GetRoomRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetRoomRequest) ProtoMessage()               {}
+func (*GetRoomRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *GetRoomRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type GetRoomResponse struct {
+	Room *ChatRoom `protobuf:"bytes,1,opt,name=Room" json:"Room,omitempty"`
+}
+
+func (m *GetRoomResponse) Reset()                    { *m = GetRoomResponse{} }
+func (m *GetRoomResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetRoomResponse) ProtoMessage()               {}
+func (*GetRoomResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *GetRoomResponse) GetRoom() *ChatRoom {
+	if m != nil {
+		return m.Room
+	}
+	return nil
+}
+
+type SearchMessagesRequest struct {
+	// Words to look for, the last one is matched as a prefix
+	Query string `protobuf:"bytes,1,opt,name=Query" json:"Query,omitempty"`
+	// Restrict search to these rooms
+	RoomUuids       []string `protobuf:"bytes,2,rep,name=RoomUuids" json:"RoomUuids,omitempty"`
+	Author          string   `protobuf:"bytes,3,opt,name=Author" json:"Author,omitempty"`
+	AfterTimestamp  int64    `protobuf:"varint,4,opt,name=AfterTimestamp" json:"AfterTimestamp,omitempty"`
+	BeforeTimestamp int64    `protobuf:"varint,5,opt,name=BeforeTimestamp" json:"BeforeTimestamp,omitempty"`
+	Limit           int64    `protobuf:"varint,6,opt,name=Limit" json:"Limit,omitempty"`
+	// Number of results to skip, most recent first
+	Offset int64 `protobuf:"varint,7,opt,name=Offset" json:"Offset,omitempty"`
+}
+
+func (m *SearchMessagesRequest) Reset()                    { *m = SearchMessagesRequest{} }
+func (m *SearchMessagesRequest) String() string            { return proto.CompactTextString(m) }
+func (*SearchMessagesRequest) ProtoMessage()               {}
+func (*SearchMessagesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *SearchMessagesRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SearchMessagesRequest) GetRoomUuids() []string {
+	if m != nil {
+		return m.RoomUuids
+	}
+	return nil
+}
+
+func (m *SearchMessagesRequest) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *SearchMessagesRequest) GetAfterTimestamp() int64 {
+	if m != nil {
+		return m.AfterTimestamp
+	}
+	return 0
+}
+
+func (m *SearchMessagesRequest) GetBeforeTimestamp() int64 {
+	if m != nil {
+		return m.BeforeTimestamp
+	}
+	return 0
+}
+
+func (m *SearchMessagesRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *SearchMessagesRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type SearchMessagesResponse struct {
+	Message *ChatMessage `protobuf:"bytes,1,opt,name=Message" json:"Message,omitempty"`
+	Room    *ChatRoom    `protobuf:"bytes,2,opt,name=Room" json:"Room,omitempty"`
+}
+
+func (m *SearchMessagesResponse) Reset()                    { *m = SearchMessagesResponse{} }
+func (m *SearchMessagesResponse) String() string            { return proto.CompactTextString(m) }
+func (*SearchMessagesResponse) ProtoMessage()               {}
+func (*SearchMessagesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *SearchMessagesResponse) GetMessage() *ChatMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *SearchMessagesResponse) GetRoom() *ChatRoom {
+	if m != nil {
+		return m.Room
+	}
+	return nil
+}
+
+type PurgeMessagesRequest struct {
+	RoomType RoomType `protobuf:"varint,1,opt,name=RoomType,enum=chat.RoomType" json:"RoomType,omitempty"`
+	// Remove messages posted before this timestamp
+	BeforeTimestamp int64 `protobuf:"varint,2,opt,name=BeforeTimestamp" json:"BeforeTimestamp,omitempty"`
+}
+
+func (m *PurgeMessagesRequest) Reset()                    { *m = PurgeMessagesRequest{} }
+func (m *PurgeMessagesRequest) String() string            { return proto.CompactTextString(m) }
+func (*PurgeMessagesRequest) ProtoMessage()               {}
+func (*PurgeMessagesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *PurgeMessagesRequest) GetRoomType() RoomType {
+	if m != nil {
+		return m.RoomType
+	}
+	return RoomType_GLOBAL
+}
+
+func (m *PurgeMessagesRequest) GetBeforeTimestamp() int64 {
+	if m != nil {
+		return m.BeforeTimestamp
+	}
+	return 0
+}
+
+type PurgeMessagesResponse struct {
+	DeletedCount int64 `protobuf:"varint,1,opt,name=DeletedCount" json:"DeletedCount,omitempty"`
+	RoomsCount   int64 `protobuf:"varint,2,opt,name=RoomsCount" json:"RoomsCount,omitempty"`
+}
+
+func (m *PurgeMessagesResponse) Reset()                    { *m = PurgeMessagesResponse{} }
+func (m *PurgeMessagesResponse) String() string            { return proto.CompactTextString(m) }
+func (*PurgeMessagesResponse) ProtoMessage()               {}
+func (*PurgeMessagesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *PurgeMessagesResponse) GetDeletedCount() int64 {
+	if m != nil {
+		return m.DeletedCount
+	}
+	return 0
+}
+
+func (m *PurgeMessagesResponse) GetRoomsCount() int64 {
+	if m != nil {
+		return m.RoomsCount
+	}
+	return 0
+}
+
+// Full transcript of a room, as exported by the REST API
+type ChatTranscript struct {
+	Room       *ChatRoom      `protobuf:"bytes,1,opt,name=Room" json:"Room,omitempty"`
+	Messages   []*ChatMessage `protobuf:"bytes,2,rep,name=Messages" json:"Messages,omitempty"`
+	ExportedBy string         `protobuf:"bytes,3,opt,name=ExportedBy" json:"ExportedBy,omitempty"`
+	ExportedAt int64          `protobuf:"varint,4,opt,name=ExportedAt" json:"ExportedAt,omitempty"`
+}
+
+func (m *ChatTranscript) Reset()                    { *m = ChatTranscript{} }
+func (m *ChatTranscript) String() string            { return proto.CompactTextString(m) }
+func (*ChatTranscript) ProtoMessage()               {}
+func (*ChatTranscript) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *ChatTranscript) GetRoom() *ChatRoom {
+	if m != nil {
+		return m.Room
+	}
+	return nil
+}
+
+func (m *ChatTranscript) GetMessages() []*ChatMessage {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *ChatTranscript) GetExportedBy() string {
+	if m != nil {
+		return m.ExportedBy
+	}
+	return ""
+}
+
+func (m *ChatTranscript) GetExportedAt() int64 {
+	if m != nil {
+		return m.ExportedAt
+	}
+	return 0
+}
+
 type ListMessagesRequest struct {
 	RoomUuid string `protobuf:"bytes,1,opt,name=RoomUuid" json:"RoomUuid,omitempty"`
 	// List starting at a given message ID
 	LastMessage string `protobuf:"bytes,2,opt,name=LastMessage" json:"LastMessage,omitempty"`
 	Offset      int64  `protobuf:"varint,3,opt,name=Offset" json:"Offset,omitempty"`
 	Limit       int64  `protobuf:"varint,4,opt,name=Limit" json:"Limit,omitempty"`
+	// Only list the replies to this message
+	ThreadUuid string `protobuf:"bytes,5,opt,name=ThreadUuid" json:"ThreadUuid,omitempty"`
 }
 
 func (m *ListMessagesRequest) Reset()                    { *m = ListMessagesRequest{} }
 func (m *ListMessagesRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListMessagesRequest) ProtoMessage()               {}
-func (*ListMessagesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*ListMessagesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *ListMessagesRequest) GetRoomUuid() string {
 	if m != nil {
@@ -383,6 +798,13 @@ func (m *ListMessagesRequest) GetLimit() int64 {
 	return 0
 }
 
+func (m *ListMessagesRequest) GetThreadUuid() string {
+	if m != nil {
+		return m.ThreadUuid
+	}
+	return ""
+}
+
 type ListMessagesResponse struct {
 	Message *ChatMessage `protobuf:"bytes,1,opt,name=Message" json:"Message,omitempty"`
 }
@@ -390,7 +812,7 @@ type ListMessagesResponse struct {
 func (m *ListMessagesResponse) Reset()                    { *m = ListMessagesResponse{} }
 func (m *ListMessagesResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListMessagesResponse) ProtoMessage()               {}
-func (*ListMessagesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*ListMessagesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *ListMessagesResponse) GetMessage() *ChatMessage {
 	if m != nil {
@@ -407,7 +829,7 @@ type ListRoomsRequest struct {
 func (m *ListRoomsRequest) Reset()                    { *m = ListRoomsRequest{} }
 func (m *ListRoomsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListRoomsRequest) ProtoMessage()               {}
-func (*ListRoomsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*ListRoomsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *ListRoomsRequest) GetByType() RoomType {
 	if m != nil {
@@ -430,7 +852,7 @@ type ListRoomsResponse struct {
 func (m *ListRoomsResponse) Reset()                    { *m = ListRoomsResponse{} }
 func (m *ListRoomsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListRoomsResponse) ProtoMessage()               {}
-func (*ListRoomsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*ListRoomsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *ListRoomsResponse) GetRoom() *ChatRoom {
 	if m != nil {
@@ -446,7 +868,7 @@ type DeleteRoomRequest struct {
 func (m *DeleteRoomRequest) Reset()                    { *m = DeleteRoomRequest{} }
 func (m *DeleteRoomRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteRoomRequest) ProtoMessage()               {}
-func (*DeleteRoomRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*DeleteRoomRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *DeleteRoomRequest) GetRoom() *ChatRoom {
 	if m != nil {
@@ -462,7 +884,7 @@ type DeleteRoomResponse struct {
 func (m *DeleteRoomResponse) Reset()                    { *m = DeleteRoomResponse{} }
 func (m *DeleteRoomResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteRoomResponse) ProtoMessage()               {}
-func (*DeleteRoomResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*DeleteRoomResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *DeleteRoomResponse) GetSuccess() bool {
 	if m != nil {
@@ -475,12 +897,14 @@ type ChatEvent struct {
 	Message *ChatMessage `protobuf:"bytes,1,opt,name=Message" json:"Message,omitempty"`
 	Room    *ChatRoom    `protobuf:"bytes,2,opt,name=Room" json:"Room,omitempty"`
 	Details string       `protobuf:"bytes,3,opt,name=Details" json:"Details,omitempty"`
+	// Users mentioned for the first time by this post or edit, in Details "PUT" or "EDIT"
+	NewMentions []string `protobuf:"bytes,4,rep,name=NewMentions" json:"NewMentions,omitempty"`
 }
 
 func (m *ChatEvent) Reset()                    { *m = ChatEvent{} }
 func (m *ChatEvent) String() string            { return proto.CompactTextString(m) }
 func (*ChatEvent) ProtoMessage()               {}
-func (*ChatEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*ChatEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *ChatEvent) GetMessage() *ChatMessage {
 	if m != nil {
@@ -503,16 +927,25 @@ func (m *ChatEvent) GetDetails() string {
 	return ""
 }
 
+func (m *ChatEvent) GetNewMentions() []string {
+	if m != nil {
+		return m.NewMentions
+	}
+	return nil
+}
+
 type WebSocketMessage struct {
 	Type    WsMessageType `protobuf:"varint,1,opt,name=Type,json=@type,enum=chat.WsMessageType" json:"Type,omitempty"`
 	Room    *ChatRoom     `protobuf:"bytes,2,opt,name=Room" json:"Room,omitempty"`
 	Message *ChatMessage  `protobuf:"bytes,3,opt,name=Message" json:"Message,omitempty"`
+	// Emoji toggled by a REACT message
+	Emoji string `protobuf:"bytes,4,opt,name=Emoji" json:"Emoji,omitempty"`
 }
 
 func (m *WebSocketMessage) Reset()                    { *m = WebSocketMessage{} }
 func (m *WebSocketMessage) String() string            { return proto.CompactTextString(m) }
 func (*WebSocketMessage) ProtoMessage()               {}
-func (*WebSocketMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*WebSocketMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func (m *WebSocketMessage) GetType() WsMessageType {
 	if m != nil {
@@ -535,15 +968,35 @@ func (m *WebSocketMessage) GetMessage() *ChatMessage {
 	return nil
 }
 
+func (m *WebSocketMessage) GetEmoji() string {
+	if m != nil {
+		return m.Emoji
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ChatRoom)(nil), "chat.ChatRoom")
 	proto.RegisterType((*ChatMessage)(nil), "chat.ChatMessage")
+	proto.RegisterType((*ChatMessageRevision)(nil), "chat.ChatMessageRevision")
+	proto.RegisterType((*ChatReaction)(nil), "chat.ChatReaction")
 	proto.RegisterType((*PutRoomRequest)(nil), "chat.PutRoomRequest")
 	proto.RegisterType((*PutRoomResponse)(nil), "chat.PutRoomResponse")
 	proto.RegisterType((*PostMessageRequest)(nil), "chat.PostMessageRequest")
 	proto.RegisterType((*PostMessageResponse)(nil), "chat.PostMessageResponse")
 	proto.RegisterType((*DeleteMessageRequest)(nil), "chat.DeleteMessageRequest")
 	proto.RegisterType((*DeleteMessageResponse)(nil), "chat.DeleteMessageResponse")
+	proto.RegisterType((*EditMessageRequest)(nil), "chat.EditMessageRequest")
+	proto.RegisterType((*EditMessageResponse)(nil), "chat.EditMessageResponse")
+	proto.RegisterType((*ReactToMessageRequest)(nil), "chat.ReactToMessageRequest")
+	proto.RegisterType((*ReactToMessageResponse)(nil), "chat.ReactToMessageResponse")
+	proto.RegisterType((*GetRoomRequest)(nil), "chat.GetRoomRequest")
+	proto.RegisterType((*GetRoomResponse)(nil), "chat.GetRoomResponse")
+	proto.RegisterType((*SearchMessagesRequest)(nil), "chat.SearchMessagesRequest")
+	proto.RegisterType((*SearchMessagesResponse)(nil), "chat.SearchMessagesResponse")
+	proto.RegisterType((*PurgeMessagesRequest)(nil), "chat.PurgeMessagesRequest")
+	proto.RegisterType((*PurgeMessagesResponse)(nil), "chat.PurgeMessagesResponse")
+	proto.RegisterType((*ChatTranscript)(nil), "chat.ChatTranscript")
 	proto.RegisterType((*ListMessagesRequest)(nil), "chat.ListMessagesRequest")
 	proto.RegisterType((*ListMessagesResponse)(nil), "chat.ListMessagesResponse")
 	proto.RegisterType((*ListRoomsRequest)(nil), "chat.ListRoomsRequest")
@@ -559,58 +1012,89 @@ func init() {
 func init() { proto.RegisterFile("chat.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 847 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0xae, 0xe3, 0x24, 0x9b, 0x1c, 0xb3, 0xa9, 0x77, 0x76, 0xdb, 0xba, 0x06, 0xa1, 0xc8, 0x17,
-	0x55, 0xd4, 0x42, 0x02, 0xe1, 0xa7, 0xe2, 0x06, 0xc8, 0x26, 0xd6, 0xb6, 0xe0, 0xad, 0xa3, 0x71,
-	0xc2, 0x0a, 0x2e, 0xa8, 0x1c, 0x67, 0xda, 0x18, 0xd6, 0xeb, 0x90, 0x99, 0x44, 0xca, 0x25, 0x0f,
-	0xc0, 0x23, 0xf0, 0x24, 0xdc, 0xf0, 0x68, 0x68, 0x7e, 0xec, 0x38, 0x3f, 0xb0, 0x5d, 0x71, 0xe7,
-	0xf3, 0xf7, 0xcd, 0x77, 0xce, 0x7c, 0x73, 0x12, 0x80, 0x68, 0x16, 0xb2, 0xf6, 0x7c, 0x91, 0xb2,
-	0x14, 0x95, 0xf9, 0xb7, 0x3d, 0x78, 0x1b, 0xb3, 0xd9, 0x72, 0xd2, 0x8e, 0xd2, 0xa4, 0x33, 0x5f,
-	0x4f, 0xe3, 0xb4, 0x43, 0xc9, 0x62, 0x15, 0x47, 0x84, 0x76, 0xa2, 0x34, 0x49, 0xd2, 0x9b, 0x8e,
-	0xc8, 0xee, 0x84, 0x11, 0x8b, 0x57, 0x31, 0x5b, 0xe7, 0x1f, 0x94, 0x2d, 0x48, 0x98, 0x48, 0x2c,
-	0xe7, 0x6f, 0x0d, 0x6a, 0xfd, 0x59, 0xc8, 0x70, 0x9a, 0x26, 0x08, 0x41, 0x79, 0xbc, 0x8c, 0xa7,
-	0x96, 0xd6, 0xd4, 0x5a, 0x75, 0x2c, 0xbe, 0x91, 0x03, 0xe5, 0xd1, 0x7a, 0x4e, 0xac, 0x52, 0x53,
-	0x6b, 0x35, 0xba, 0x8d, 0xb6, 0xe0, 0xc1, 0xb3, 0xb9, 0x17, 0x8b, 0x18, 0x7a, 0x02, 0x8d, 0xcc,
-	0xe3, 0x4f, 0x7e, 0x21, 0x11, 0xb3, 0x74, 0x81, 0xb0, 0xe3, 0x45, 0x1f, 0x40, 0x9d, 0x7b, 0xbc,
-	0x70, 0x42, 0xae, 0xad, 0xb2, 0x48, 0xd9, 0x38, 0xd0, 0x19, 0x54, 0xc6, 0x94, 0x2c, 0xa8, 0x55,
-	0x69, 0xea, 0xad, 0x3a, 0x96, 0x06, 0x6a, 0x82, 0xe1, 0x85, 0x94, 0x8d, 0xe7, 0xd3, 0x90, 0x91,
-	0xa9, 0x55, 0x6d, 0x6a, 0xad, 0x0a, 0x2e, 0xba, 0x9c, 0xbf, 0x34, 0x30, 0x78, 0x0b, 0x97, 0x84,
-	0xd2, 0xf0, 0x2d, 0x39, 0xd8, 0x85, 0x0d, 0x35, 0x7e, 0x90, 0xf0, 0x97, 0x84, 0x3f, 0xb7, 0x91,
-	0x05, 0x47, 0xaa, 0x54, 0xd1, 0xce, 0x4c, 0xf4, 0x10, 0xaa, 0xbd, 0x25, 0x9b, 0xa5, 0x0b, 0x45,
-	0x56, 0x59, 0xbc, 0x8f, 0x51, 0x9c, 0x10, 0xca, 0xc2, 0x64, 0x6e, 0x55, 0x9a, 0x5a, 0x4b, 0xc7,
-	0x1b, 0x07, 0xfa, 0x08, 0x6a, 0x3d, 0x35, 0x6a, 0x41, 0xd7, 0xe8, 0x9a, 0xed, 0x6c, 0xf6, 0x6d,
-	0x39, 0x09, 0x9c, 0x67, 0x38, 0x9f, 0x43, 0x63, 0xb8, 0x14, 0xe3, 0xc7, 0xe4, 0xb7, 0x25, 0xa1,
-	0x8c, 0x4f, 0x9c, 0x9b, 0x82, 0xbf, 0x91, 0x4d, 0x3c, 0xbb, 0x23, 0x2c, 0x62, 0xce, 0x17, 0x70,
-	0x3f, 0xaf, 0xa2, 0xf3, 0xf4, 0x86, 0x92, 0x77, 0x2a, 0xeb, 0x03, 0x1a, 0xa6, 0x34, 0x9b, 0x54,
-	0x76, 0xe0, 0xc7, 0x50, 0x53, 0x1e, 0x6a, 0x69, 0x4d, 0xbd, 0x65, 0x74, 0x4f, 0x36, 0xd5, 0x59,
-	0x6e, 0x9e, 0xe2, 0xfc, 0x0c, 0xa7, 0x5b, 0x20, 0xea, 0x7c, 0x0b, 0x8e, 0x82, 0x65, 0x14, 0x11,
-	0x4a, 0x05, 0x85, 0x1a, 0xce, 0xcc, 0x2d, 0xfc, 0xd2, 0xed, 0xf8, 0x2e, 0x9c, 0x0d, 0xc8, 0x35,
-	0x61, 0xe4, 0xff, 0xd1, 0xfc, 0x14, 0x1e, 0xec, 0xc0, 0xdc, 0x46, 0xd4, 0xf9, 0x5d, 0x83, 0x53,
-	0x2f, 0xce, 0x5b, 0xa3, 0xd9, 0xc9, 0x45, 0xf5, 0x68, 0x3b, 0xea, 0x51, 0xfa, 0xcc, 0x14, 0x24,
-	0xc5, 0x55, 0x74, 0x71, 0x15, 0xf9, 0x6f, 0xde, 0x50, 0x22, 0x5f, 0x85, 0x8e, 0x95, 0xc5, 0xf5,
-	0xee, 0xc5, 0x49, 0xcc, 0x84, 0xb8, 0x74, 0x2c, 0x0d, 0xa7, 0x0f, 0x67, 0xdb, 0x14, 0x14, 0xeb,
-	0x67, 0x1b, 0x95, 0xca, 0x1b, 0x3e, 0xd0, 0x7c, 0x96, 0xe1, 0xfc, 0x04, 0x26, 0x07, 0xe1, 0x24,
-	0xf3, 0x26, 0x9e, 0x40, 0xf5, 0x7c, 0x2d, 0x9e, 0xb2, 0x76, 0xf0, 0x29, 0xab, 0x28, 0xfa, 0x10,
-	0xa0, 0xf0, 0x90, 0x65, 0x3f, 0x05, 0x8f, 0xf3, 0x1c, 0x4e, 0x0a, 0xd8, 0x77, 0x10, 0xdf, 0x73,
-	0x38, 0x91, 0x17, 0x72, 0x57, 0xb1, 0xb7, 0x01, 0x15, 0x0b, 0x6f, 0xbd, 0xc6, 0x15, 0xd4, 0x39,
-	0x82, 0xbb, 0x22, 0x37, 0xec, 0x4e, 0x73, 0xcb, 0xd9, 0x94, 0xfe, 0x9d, 0x0d, 0x3f, 0x77, 0x40,
-	0x58, 0x18, 0x5f, 0xd3, 0x6c, 0x5d, 0x28, 0xd3, 0xf9, 0x43, 0x03, 0xf3, 0x8a, 0x4c, 0x82, 0x34,
-	0xfa, 0x95, 0xe4, 0xb7, 0xdf, 0x52, 0xfb, 0x53, 0x0e, 0xfd, 0x54, 0x42, 0x5e, 0x51, 0x15, 0x16,
-	0x93, 0xaf, 0x7c, 0xcb, 0xf8, 0xe0, 0xdf, 0xe5, 0xf0, 0x67, 0xdb, 0xbb, 0xea, 0x3f, 0xbb, 0x79,
-	0xfa, 0x95, 0x94, 0xad, 0xb8, 0x55, 0x80, 0xea, 0x85, 0xe7, 0x9f, 0xf7, 0x3c, 0xf3, 0x1e, 0x3a,
-	0x86, 0xfa, 0x95, 0x8f, 0xbf, 0x0f, 0x86, 0xbd, 0xbe, 0x6b, 0x6a, 0xa8, 0x06, 0xe5, 0x71, 0xe0,
-	0x62, 0xb3, 0xc4, 0xbf, 0x5e, 0xf9, 0x03, 0xd7, 0xd4, 0x9f, 0x26, 0x70, 0xbc, 0xc5, 0x91, 0x87,
-	0xbe, 0xf3, 0x5f, 0xbe, 0x32, 0xef, 0xa1, 0x3a, 0x54, 0x3c, 0xb7, 0xf7, 0x83, 0xaa, 0x1c, 0xfa,
-	0xc1, 0xc8, 0x2c, 0xa1, 0xfb, 0x60, 0x60, 0xdf, 0xbf, 0x7c, 0x3d, 0x1e, 0x0e, 0x7a, 0x23, 0xd7,
-	0xd4, 0x91, 0x01, 0x47, 0x2f, 0x5e, 0x06, 0x23, 0x1f, 0xff, 0x68, 0x96, 0x51, 0x03, 0x60, 0xe0,
-	0x7a, 0xee, 0xc8, 0x7d, 0x7d, 0x19, 0x5c, 0x98, 0x15, 0x9e, 0xad, 0x6c, 0x5e, 0x64, 0x56, 0xbb,
-	0x7f, 0xea, 0x72, 0x85, 0x07, 0xf2, 0x17, 0x0c, 0x7d, 0x09, 0x47, 0x6a, 0xbd, 0xa1, 0x33, 0xd9,
-	0xe0, 0xf6, 0x8e, 0xb4, 0x1f, 0xec, 0x78, 0x95, 0x26, 0xbe, 0x01, 0xd8, 0x28, 0x05, 0x3d, 0x92,
-	0x49, 0x7b, 0xa2, 0xb3, 0xad, 0xfd, 0x80, 0x02, 0xf8, 0x1a, 0xea, 0xb9, 0xb8, 0xd1, 0x43, 0x99,
-	0xb6, 0xfb, 0x92, 0xec, 0x47, 0x7b, 0x7e, 0x59, 0xfd, 0x89, 0x86, 0x2e, 0xe0, 0xbd, 0xe2, 0xeb,
-	0x45, 0x8f, 0x37, 0xa9, 0x3b, 0x4b, 0xc5, 0xb6, 0x0f, 0x85, 0x72, 0xa0, 0x73, 0x30, 0x0a, 0x4b,
-	0x16, 0x29, 0xc6, 0xfb, 0xcb, 0xdb, 0x7e, 0x7c, 0x20, 0xa2, 0x9a, 0x79, 0x01, 0xc7, 0x5b, 0x1b,
-	0x10, 0xd9, 0xc5, 0xbe, 0x77, 0x70, 0xde, 0x3f, 0x18, 0x93, 0x48, 0x93, 0xaa, 0xf8, 0xb3, 0xf0,
-	0xd9, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x96, 0x6a, 0xe1, 0x85, 0x86, 0x08, 0x00, 0x00,
+	// 1337 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x72, 0xdb, 0xc4,
+	0x17, 0xaf, 0x2c, 0x3b, 0xb6, 0x8f, 0x13, 0x57, 0xdd, 0x7c, 0x54, 0x51, 0x3b, 0xff, 0xf1, 0x68,
+	0xfe, 0xd3, 0xf1, 0xb4, 0x90, 0x94, 0x14, 0xda, 0x81, 0x0b, 0x40, 0x4e, 0x34, 0x49, 0xa9, 0x53,
+	0x1b, 0xd9, 0xa1, 0x03, 0xcc, 0xd0, 0x51, 0xe4, 0x4d, 0xad, 0x62, 0x5b, 0x46, 0xbb, 0x0e, 0xf8,
+	0x8a, 0x3b, 0x9e, 0x81, 0x5b, 0x86, 0x17, 0xe1, 0x5d, 0x78, 0x0b, 0x6e, 0x60, 0x76, 0xb5, 0x92,
+	0x56, 0xb2, 0xa6, 0x69, 0x0a, 0x77, 0x3a, 0x1f, 0x7b, 0xce, 0x6f, 0xcf, 0xfe, 0xf6, 0xec, 0x11,
+	0x80, 0x37, 0x76, 0xe9, 0xde, 0x3c, 0x0c, 0x68, 0x80, 0xca, 0xec, 0xdb, 0xb0, 0x5e, 0xf9, 0x74,
+	0xbc, 0x38, 0xdf, 0xf3, 0x82, 0xe9, 0xfe, 0x7c, 0x39, 0xf2, 0x83, 0x7d, 0x0f, 0x4f, 0x26, 0x64,
+	0xdf, 0x0b, 0xa6, 0xd3, 0x60, 0xb6, 0xcf, 0x5d, 0xf7, 0x5d, 0x8f, 0xfa, 0x97, 0x3e, 0x5d, 0x26,
+	0x1f, 0x84, 0x86, 0xd8, 0x9d, 0x46, 0x81, 0xcc, 0x3f, 0x14, 0xa8, 0x1d, 0x8e, 0x5d, 0xea, 0x04,
+	0xc1, 0x14, 0x21, 0x28, 0x9f, 0x2d, 0xfc, 0x91, 0xae, 0xb4, 0x94, 0x76, 0xdd, 0xe1, 0xdf, 0xc8,
+	0x84, 0xf2, 0x70, 0x39, 0xc7, 0x7a, 0xa9, 0xa5, 0xb4, 0x9b, 0x07, 0xcd, 0x3d, 0x0e, 0x82, 0x79,
+	0x33, 0xad, 0xc3, 0x6d, 0xe8, 0x1e, 0x34, 0x63, 0x4d, 0xef, 0xfc, 0x35, 0xf6, 0xa8, 0xae, 0xf2,
+	0x08, 0x39, 0x2d, 0xba, 0x0b, 0x75, 0xa6, 0xe9, 0xba, 0xe7, 0x78, 0xa2, 0x97, 0xb9, 0x4b, 0xaa,
+	0x40, 0x5b, 0x50, 0x39, 0x23, 0x38, 0x24, 0x7a, 0xa5, 0xa5, 0xb6, 0xeb, 0x4e, 0x24, 0xa0, 0x16,
+	0x34, 0xba, 0x2e, 0xa1, 0x67, 0xf3, 0x91, 0x4b, 0xf1, 0x48, 0x5f, 0x6b, 0x29, 0xed, 0x8a, 0x23,
+	0xab, 0xcc, 0xbf, 0x4a, 0xd0, 0x60, 0x5b, 0x38, 0xc5, 0x84, 0xb8, 0xaf, 0x70, 0xe1, 0x2e, 0x0c,
+	0xa8, 0xb1, 0x44, 0x5c, 0x5f, 0xe2, 0xfa, 0x44, 0x46, 0x3a, 0x54, 0xc5, 0x52, 0x01, 0x3b, 0x16,
+	0xd1, 0x0e, 0xac, 0x59, 0x0b, 0x3a, 0x0e, 0x42, 0x01, 0x56, 0x48, 0x6c, 0x1f, 0x43, 0x7f, 0x8a,
+	0x09, 0x75, 0xa7, 0x73, 0xbd, 0xd2, 0x52, 0xda, 0xaa, 0x93, 0x2a, 0xd0, 0x7b, 0x50, 0xb3, 0x44,
+	0xa9, 0x39, 0xdc, 0xc6, 0x81, 0xb6, 0x17, 0xd7, 0x7e, 0x2f, 0xaa, 0x84, 0x93, 0x78, 0xa0, 0xff,
+	0x01, 0x0c, 0xc7, 0x21, 0x76, 0x47, 0x1c, 0x5b, 0x95, 0xe7, 0x91, 0x34, 0x0c, 0xb9, 0x3d, 0xf2,
+	0x29, 0x1e, 0x59, 0x54, 0xaf, 0xf1, 0x54, 0x89, 0x8c, 0x1e, 0x41, 0xf5, 0xc4, 0x27, 0x34, 0x08,
+	0x97, 0x7a, 0xbd, 0xa5, 0xb6, 0x1b, 0x07, 0xbb, 0xd1, 0xf1, 0x48, 0xd5, 0x70, 0xf0, 0xa5, 0x4f,
+	0xfc, 0x60, 0xe6, 0xc4, 0x9e, 0xe8, 0x21, 0xd4, 0x1d, 0xcc, 0xf0, 0x04, 0x33, 0xa2, 0x03, 0x5f,
+	0x86, 0xd2, 0x65, 0xb1, 0xc9, 0x49, 0x9d, 0x18, 0x84, 0x53, 0x3c, 0x8b, 0x16, 0x34, 0xf8, 0xd9,
+	0x24, 0xb2, 0x79, 0x0a, 0x9b, 0x05, 0xd9, 0xe4, 0x9a, 0x2a, 0xd9, 0x9a, 0x66, 0x6a, 0x57, 0xca,
+	0xd5, 0xce, 0xfc, 0x04, 0xd6, 0x65, 0x14, 0x8c, 0x13, 0xf6, 0x34, 0x78, 0xed, 0x8b, 0x28, 0x91,
+	0x90, 0x32, 0xa5, 0x24, 0x31, 0xc5, 0xfc, 0x10, 0x9a, 0xfd, 0x05, 0x27, 0xb2, 0x83, 0x7f, 0x58,
+	0x60, 0x42, 0x19, 0x77, 0x99, 0xc8, 0x17, 0x37, 0x62, 0xee, 0xc6, 0x6c, 0x77, 0xb8, 0xcd, 0xfc,
+	0x08, 0x6e, 0x26, 0xab, 0xc8, 0x3c, 0x98, 0x11, 0xfc, 0x56, 0xcb, 0x0e, 0x01, 0xf5, 0x03, 0x92,
+	0xee, 0x3b, 0x4a, 0xf8, 0x3e, 0xd4, 0x84, 0x86, 0xe8, 0x0a, 0x2f, 0xed, 0xad, 0xd5, 0x13, 0x49,
+	0x5c, 0xcc, 0xef, 0x60, 0x33, 0x13, 0x44, 0xe4, 0xd7, 0xa1, 0x3a, 0x58, 0x78, 0x1e, 0x26, 0x84,
+	0x43, 0xa8, 0x39, 0xb1, 0x98, 0x89, 0x5f, 0xba, 0x3a, 0xbe, 0x0d, 0x5b, 0x47, 0x78, 0x82, 0x29,
+	0xfe, 0x77, 0x30, 0x3f, 0x80, 0xed, 0x5c, 0x98, 0xab, 0x80, 0x9a, 0x16, 0x20, 0xc6, 0xd2, 0x5c,
+	0xde, 0x07, 0x59, 0x56, 0x14, 0xa6, 0x8d, 0x3d, 0xcc, 0x0e, 0x6c, 0x66, 0x42, 0x88, 0x9c, 0xd7,
+	0x8a, 0xf1, 0x33, 0x6c, 0x73, 0x2a, 0x0d, 0x83, 0x1c, 0x12, 0xb9, 0x1f, 0x28, 0xb9, 0x7e, 0xd0,
+	0x82, 0x86, 0xf0, 0x96, 0xda, 0x85, 0xac, 0x4a, 0x59, 0xa9, 0xca, 0xac, 0x64, 0x7d, 0x87, 0xe0,
+	0xb8, 0x57, 0xf0, 0x6f, 0xd3, 0x86, 0x9d, 0x3c, 0x80, 0x77, 0xd9, 0xc7, 0xff, 0xa1, 0x79, 0x8c,
+	0x33, 0xd4, 0x2e, 0x68, 0x72, 0x8c, 0xca, 0x89, 0xd7, 0x35, 0xa8, 0xfc, 0xa7, 0x02, 0xdb, 0x03,
+	0xec, 0x86, 0xde, 0x38, 0x3e, 0xf1, 0x38, 0xc9, 0x16, 0x54, 0xbe, 0x5c, 0xe0, 0x70, 0x19, 0xdf,
+	0x3e, 0x2e, 0xc4, 0x5d, 0x9c, 0xa5, 0x8c, 0x6f, 0x60, 0xaa, 0x90, 0x7a, 0xa6, 0x9a, 0xe9, 0x99,
+	0xf7, 0xa0, 0x69, 0x5d, 0x50, 0x1c, 0xa6, 0x97, 0xbf, 0xcc, 0x2f, 0x7f, 0x4e, 0x8b, 0xda, 0x70,
+	0xb3, 0x83, 0x2f, 0x82, 0x10, 0xe7, 0x3b, 0x6c, 0x5e, 0xcd, 0xd0, 0x75, 0xfd, 0xa9, 0x4f, 0x79,
+	0x93, 0x55, 0x9d, 0x48, 0x60, 0xf9, 0x7b, 0x17, 0x17, 0x04, 0x53, 0xde, 0x4b, 0x55, 0x47, 0x48,
+	0xa6, 0x0f, 0x3b, 0xf9, 0x4d, 0xbe, 0xc3, 0x49, 0x24, 0x05, 0x2d, 0xbd, 0xa1, 0xa0, 0x13, 0xd8,
+	0xea, 0x2f, 0xc2, 0x57, 0x38, 0x5f, 0xce, 0xfb, 0x50, 0x8b, 0x1f, 0x44, 0x5d, 0x29, 0x7c, 0x4e,
+	0x13, 0x7b, 0x51, 0x19, 0x4a, 0x85, 0x65, 0x30, 0xbf, 0x85, 0xed, 0x5c, 0xb6, 0xe4, 0xec, 0xd7,
+	0xa3, 0x6b, 0x3b, 0x3a, 0x0c, 0x16, 0x33, 0xca, 0x53, 0xaa, 0x4e, 0x46, 0xc7, 0x5e, 0x1f, 0x96,
+	0x92, 0x44, 0x1e, 0x51, 0x06, 0x49, 0x63, 0xfe, 0xae, 0x40, 0x93, 0xed, 0x6e, 0x18, 0xba, 0x33,
+	0xe2, 0x85, 0xfe, 0xfc, 0xad, 0x9a, 0xea, 0x35, 0xfb, 0x14, 0x43, 0x61, 0xff, 0x34, 0x0f, 0x42,
+	0x8a, 0x47, 0x9d, 0xa5, 0xe0, 0x8d, 0xa4, 0x91, 0xed, 0x16, 0x15, 0xbc, 0x91, 0x34, 0xe6, 0x6f,
+	0x0a, 0x6c, 0x76, 0x7d, 0x42, 0xf3, 0x05, 0xbf, 0xe2, 0x96, 0x77, 0xdd, 0x64, 0x49, 0x7c, 0xcb,
+	0x25, 0x95, 0xc4, 0x24, 0x55, 0x66, 0x52, 0xca, 0xbb, 0xb2, 0xcc, 0xbb, 0xec, 0x3b, 0x5e, 0xc9,
+	0xbf, 0xe3, 0xe6, 0x21, 0x6c, 0x65, 0x21, 0xbe, 0x4b, 0x1f, 0xf8, 0x06, 0x34, 0x16, 0x84, 0x1f,
+	0x50, 0xbc, 0xc9, 0x7b, 0xb0, 0xd6, 0x59, 0xbe, 0x81, 0x53, 0xc2, 0xca, 0x01, 0xa6, 0x03, 0x5a,
+	0x49, 0x00, 0x4c, 0x34, 0xe6, 0x13, 0xb8, 0x25, 0xc5, 0xbe, 0x46, 0xff, 0x78, 0x02, 0xb7, 0x22,
+	0x4e, 0x5d, 0xf7, 0xe9, 0xdd, 0x03, 0x24, 0x2f, 0xbc, 0xf2, 0x51, 0xf9, 0x55, 0x81, 0x3a, 0x0b,
+	0x61, 0x5f, 0xe2, 0x19, 0xfd, 0xcf, 0xaf, 0x2d, 0x4b, 0x7c, 0x84, 0xa9, 0xeb, 0x4f, 0x48, 0x3c,
+	0x07, 0x0a, 0x91, 0x71, 0xe5, 0x39, 0xfe, 0x31, 0x99, 0x81, 0xca, 0xbc, 0xe7, 0xc9, 0x2a, 0x76,
+	0x4f, 0xb4, 0x17, 0xf8, 0x7c, 0x10, 0x78, 0xdf, 0xe3, 0x84, 0x40, 0x6d, 0x31, 0x3a, 0x47, 0xe7,
+	0xb2, 0x19, 0x25, 0x7d, 0x41, 0x84, 0x99, 0x99, 0x9c, 0xca, 0xe7, 0x74, 0x39, 0x7f, 0x3b, 0x78,
+	0x0f, 0xb2, 0x63, 0xea, 0x9b, 0xf7, 0x9b, 0xbc, 0x50, 0x65, 0xe9, 0x85, 0xba, 0xff, 0x71, 0xda,
+	0x80, 0x10, 0xc0, 0xda, 0x71, 0xb7, 0xd7, 0xb1, 0xba, 0xda, 0x0d, 0xb4, 0x01, 0xf5, 0x17, 0x3d,
+	0xe7, 0xd9, 0xa0, 0x6f, 0x1d, 0xda, 0x9a, 0x82, 0x6a, 0x50, 0x3e, 0x1b, 0xd8, 0x8e, 0x56, 0x62,
+	0x5f, 0xcf, 0x7b, 0x47, 0xb6, 0xa6, 0xde, 0xff, 0x45, 0x81, 0x8d, 0x0c, 0x74, 0x66, 0xfb, 0xa2,
+	0xf7, 0xf4, 0xb9, 0x76, 0x03, 0xd5, 0xa1, 0xd2, 0xb5, 0xad, 0xaf, 0xc4, 0xd2, 0x7e, 0x6f, 0x30,
+	0xd4, 0x4a, 0xe8, 0x26, 0x34, 0x9c, 0x5e, 0xef, 0xf4, 0xe5, 0x59, 0xff, 0xc8, 0x1a, 0xda, 0x9a,
+	0x8a, 0x1a, 0x50, 0x3d, 0x79, 0x3a, 0x18, 0xf6, 0x9c, 0xaf, 0xb5, 0x32, 0x6a, 0x02, 0x1c, 0xd9,
+	0x5d, 0x7b, 0x68, 0xbf, 0x3c, 0x1d, 0x1c, 0x6b, 0x15, 0xe6, 0x2d, 0x64, 0xb6, 0x48, 0x5b, 0x43,
+	0xeb, 0x50, 0xb3, 0x8f, 0x9e, 0x0e, 0xb9, 0xb9, 0xca, 0x32, 0x38, 0xb6, 0x75, 0x38, 0xd4, 0x6a,
+	0x07, 0x7f, 0x57, 0xa2, 0x69, 0x7f, 0x80, 0xc3, 0x4b, 0xdf, 0xc3, 0xe8, 0x31, 0x54, 0xc5, 0xfc,
+	0x86, 0xb6, 0xa2, 0x82, 0x64, 0x87, 0x40, 0x63, 0x3b, 0xa7, 0x15, 0x34, 0xfb, 0x0c, 0x20, 0x25,
+	0x1f, 0xba, 0x1d, 0x39, 0xad, 0xf0, 0xd8, 0xd0, 0x57, 0x0d, 0x22, 0xc0, 0xa7, 0x50, 0x4f, 0xee,
+	0x0b, 0xda, 0x89, 0xdc, 0xf2, 0x97, 0xd3, 0xb8, 0xbd, 0xa2, 0x8f, 0x56, 0x3f, 0x54, 0xd0, 0x31,
+	0xac, 0xcb, 0x0d, 0x01, 0xed, 0xa6, 0xae, 0xb9, 0x3e, 0x66, 0x18, 0x45, 0xa6, 0x24, 0x50, 0x07,
+	0x1a, 0xd2, 0x14, 0x89, 0x04, 0xe2, 0xd5, 0xe9, 0xd4, 0xd8, 0x2d, 0xb0, 0x88, 0xcd, 0x9c, 0xc0,
+	0x46, 0x66, 0xc4, 0x43, 0x86, 0xbc, 0xef, 0x5c, 0x9c, 0x3b, 0x85, 0x36, 0x11, 0xa9, 0x03, 0x0d,
+	0x69, 0x6c, 0x8b, 0xd1, 0xac, 0x0e, 0x83, 0xc6, 0x6e, 0x81, 0x45, 0xc4, 0x78, 0x06, 0xcd, 0xec,
+	0xd4, 0x84, 0x44, 0xca, 0xc2, 0x61, 0xce, 0xb8, 0x5b, 0x6c, 0x14, 0xc1, 0x1e, 0x43, 0xf5, 0x18,
+	0x67, 0x08, 0x72, 0x8c, 0x8b, 0x08, 0x92, 0x1f, 0x9d, 0x4e, 0xa1, 0x99, 0x1d, 0x18, 0x62, 0x10,
+	0x85, 0xb3, 0x92, 0x71, 0xb7, 0xd8, 0x98, 0x9c, 0xd2, 0x09, 0x6c, 0x64, 0x9e, 0xe9, 0xb8, 0xc2,
+	0x45, 0x93, 0x82, 0x71, 0xa7, 0xd0, 0x16, 0xc5, 0x3a, 0x5f, 0xe3, 0x7f, 0xee, 0x8f, 0xfe, 0x19,
+	0x00, 0x18, 0x43, 0xb6, 0xac, 0x10, 0x10, 0x00, 0x00,
 }
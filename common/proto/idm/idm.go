@@ -21,6 +21,8 @@
 package idm
 
 import (
+	"encoding/json"
+
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
@@ -47,6 +49,56 @@ func (user *User) ZapUuid() zapcore.Field { return zap.String(common.KEY_USER_UU
 // ZapUuid simply calls zap.String() with Login standard key and this user login
 func (user *User) ZapLogin() zapcore.Field { return zap.String(common.KEY_USERNAME, user.GetLogin()) }
 
+const (
+	// UserAttrLocks stores the JSON-encoded list of locks set on a user
+	UserAttrLocks = "locks"
+	// UserLockLogout is the lock that prevents a user from logging in
+	UserLockLogout = "logout"
+)
+
+// IsLockedOut checks if the locks attribute of the user contains the logout lock.
+func (user *User) IsLockedOut() bool {
+	locks := user.GetAttributes()[UserAttrLocks]
+	if locks == "" {
+		return false
+	}
+	var ll []string
+	if e := json.Unmarshal([]byte(locks), &ll); e != nil {
+		return false
+	}
+	for _, l := range ll {
+		if l == UserLockLogout {
+			return true
+		}
+	}
+	return false
+}
+
+// SetLockedOut adds or removes the logout lock, keeping the other locks of the user.
+func (user *User) SetLockedOut(locked bool) {
+	var ll, out []string
+	if locks := user.GetAttributes()[UserAttrLocks]; locks != "" {
+		json.Unmarshal([]byte(locks), &ll)
+	}
+	for _, l := range ll {
+		if l != UserLockLogout {
+			out = append(out, l)
+		}
+	}
+	if locked {
+		out = append(out, UserLockLogout)
+	}
+	if len(out) == 0 {
+		delete(user.Attributes, UserAttrLocks)
+		return
+	}
+	if user.Attributes == nil {
+		user.Attributes = make(map[string]string)
+	}
+	b, _ := json.Marshal(out)
+	user.Attributes[UserAttrLocks] = string(b)
+}
+
 /*         POLICIES, ACLS
  */
 
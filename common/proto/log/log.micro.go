@@ -13,6 +13,9 @@ It has these top-level messages:
 	LogMessage
 	ListLogRequest
 	ListLogResponse
+	VerifyAuditChainRequest
+	AuditChainBreak
+	VerifyAuditChainResponse
 	TimeRangeResponse
 	TimeRangeResult
 	TimeRangeRequest
@@ -55,6 +58,8 @@ type LogRecorderClient interface {
 	ListLogs(ctx context.Context, in *ListLogRequest, opts ...client.CallOption) (LogRecorder_ListLogsClient, error)
 	// AggregatedLogs performs a query to retrieve log events of the given type, faceted by time range.
 	AggregatedLogs(ctx context.Context, in *TimeRangeRequest, opts ...client.CallOption) (LogRecorder_AggregatedLogsClient, error)
+	// VerifyAuditChain checks the audit hash chain over a time range and reports the first break.
+	VerifyAuditChain(ctx context.Context, in *VerifyAuditChainRequest, opts ...client.CallOption) (*VerifyAuditChainResponse, error)
 }
 
 type logRecorderClient struct {
@@ -199,6 +204,16 @@ func (x *logRecorderAggregatedLogsClient) Recv() (*TimeRangeResponse, error) {
 	return m, nil
 }
 
+func (c *logRecorderClient) VerifyAuditChain(ctx context.Context, in *VerifyAuditChainRequest, opts ...client.CallOption) (*VerifyAuditChainResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "LogRecorder.VerifyAuditChain", in)
+	out := new(VerifyAuditChainResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for LogRecorder service
 
 type LogRecorderHandler interface {
@@ -208,6 +223,8 @@ type LogRecorderHandler interface {
 	ListLogs(context.Context, *ListLogRequest, LogRecorder_ListLogsStream) error
 	// AggregatedLogs performs a query to retrieve log events of the given type, faceted by time range.
 	AggregatedLogs(context.Context, *TimeRangeRequest, LogRecorder_AggregatedLogsStream) error
+	// VerifyAuditChain checks the audit hash chain over a time range and reports the first break.
+	VerifyAuditChain(context.Context, *VerifyAuditChainRequest, *VerifyAuditChainResponse) error
 }
 
 func RegisterLogRecorderHandler(s server.Server, hdlr LogRecorderHandler, opts ...server.HandlerOption) {
@@ -322,3 +339,7 @@ func (x *logRecorderAggregatedLogsStream) RecvMsg(m interface{}) error {
 func (x *logRecorderAggregatedLogsStream) Send(m *TimeRangeResponse) error {
 	return x.stream.Send(m)
 }
+
+func (h *LogRecorder) VerifyAuditChain(ctx context.Context, in *VerifyAuditChainRequest, out *VerifyAuditChainResponse) error {
+	return h.LogRecorderHandler.VerifyAuditChain(ctx, in, out)
+}
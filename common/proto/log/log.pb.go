@@ -13,6 +13,9 @@ It has these top-level messages:
 	LogMessage
 	ListLogRequest
 	ListLogResponse
+	VerifyAuditChainRequest
+	AuditChainBreak
+	VerifyAuditChainResponse
 	TimeRangeResponse
 	TimeRangeResult
 	TimeRangeRequest
@@ -134,6 +137,8 @@ type LogMessage struct {
 	GroupPath string   `protobuf:"bytes,8,opt,name=GroupPath" json:"GroupPath,omitempty"`
 	Profile   string   `protobuf:"bytes,16,opt,name=Profile" json:"Profile,omitempty"`
 	RoleUuids []string `protobuf:"bytes,9,rep,name=RoleUuids" json:"RoleUuids,omitempty"`
+	// Login of the admin acting on behalf of UserName, if any
+	Impersonator string `protobuf:"bytes,21,opt,name=Impersonator" json:"Impersonator,omitempty"`
 	// Client info
 	RemoteAddress string `protobuf:"bytes,10,opt,name=RemoteAddress" json:"RemoteAddress,omitempty"`
 	UserAgent     string `protobuf:"bytes,11,opt,name=UserAgent" json:"UserAgent,omitempty"`
@@ -147,6 +152,12 @@ type LogMessage struct {
 	SpanUuid       string `protobuf:"bytes,18,opt,name=SpanUuid" json:"SpanUuid,omitempty"`
 	SpanParentUuid string `protobuf:"bytes,19,opt,name=SpanParentUuid" json:"SpanParentUuid,omitempty"`
 	SpanRootUuid   string `protobuf:"bytes,20,opt,name=SpanRootUuid" json:"SpanRootUuid,omitempty"`
+	// Audit chain: position of the message, hash of the previous message and hash of this one
+	ChainSeq      int64  `protobuf:"varint,22,opt,name=ChainSeq" json:"ChainSeq,omitempty"`
+	ChainPrevHash string `protobuf:"bytes,23,opt,name=ChainPrevHash" json:"ChainPrevHash,omitempty"`
+	ChainHash     string `protobuf:"bytes,24,opt,name=ChainHash" json:"ChainHash,omitempty"`
+	// Structured context of audit messages (share, target user, ACL, job...) referenced by key
+	AuditContext map[string]string `protobuf:"bytes,25,rep,name=AuditContext" json:"AuditContext,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *LogMessage) Reset()                    { *m = LogMessage{} }
@@ -224,6 +235,13 @@ func (m *LogMessage) GetRoleUuids() []string {
 	return nil
 }
 
+func (m *LogMessage) GetImpersonator() string {
+	if m != nil {
+		return m.Impersonator
+	}
+	return ""
+}
+
 func (m *LogMessage) GetRemoteAddress() string {
 	if m != nil {
 		return m.RemoteAddress
@@ -294,6 +312,34 @@ func (m *LogMessage) GetSpanRootUuid() string {
 	return ""
 }
 
+func (m *LogMessage) GetChainSeq() int64 {
+	if m != nil {
+		return m.ChainSeq
+	}
+	return 0
+}
+
+func (m *LogMessage) GetChainPrevHash() string {
+	if m != nil {
+		return m.ChainPrevHash
+	}
+	return ""
+}
+
+func (m *LogMessage) GetChainHash() string {
+	if m != nil {
+		return m.ChainHash
+	}
+	return ""
+}
+
+func (m *LogMessage) GetAuditContext() map[string]string {
+	if m != nil {
+		return m.AuditContext
+	}
+	return nil
+}
+
 // ListLogRequest launches a parameterised query in the log repository and streams the results.
 type ListLogRequest struct {
 	// Bleve-type Query stsring
@@ -303,6 +349,20 @@ type ListLogRequest struct {
 	// Number of results
 	Size   int32                    `protobuf:"varint,3,opt,name=Size" json:"Size,omitempty"`
 	Format ListLogRequest_LogFormat `protobuf:"varint,4,opt,name=Format,enum=log.ListLogRequest_LogFormat" json:"Format,omitempty"`
+	// Typed filters, combined with Query
+	UserName string   `protobuf:"bytes,5,opt,name=UserName" json:"UserName,omitempty"`
+	UserUuid string   `protobuf:"bytes,6,opt,name=UserUuid" json:"UserUuid,omitempty"`
+	NodeUuid string   `protobuf:"bytes,7,opt,name=NodeUuid" json:"NodeUuid,omitempty"`
+	NodePath string   `protobuf:"bytes,8,opt,name=NodePath" json:"NodePath,omitempty"`
+	WsUuid   string   `protobuf:"bytes,9,opt,name=WsUuid" json:"WsUuid,omitempty"`
+	MsgIds   []string `protobuf:"bytes,10,rep,name=MsgIds" json:"MsgIds,omitempty"`
+	// Time range as unix timestamps, zero values are unbounded
+	From int32 `protobuf:"varint,11,opt,name=From" json:"From,omitempty"`
+	To   int32 `protobuf:"varint,12,opt,name=To" json:"To,omitempty"`
+	// Filter on audit context values by key
+	AuditContext map[string]string `protobuf:"bytes,13,rep,name=AuditContext" json:"AuditContext,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Only search audit logs
+	AuditOnly bool `protobuf:"varint,14,opt,name=AuditOnly" json:"AuditOnly,omitempty"`
 }
 
 func (m *ListLogRequest) Reset()                    { *m = ListLogRequest{} }
@@ -338,6 +398,76 @@ func (m *ListLogRequest) GetFormat() ListLogRequest_LogFormat {
 	return ListLogRequest_JSON
 }
 
+func (m *ListLogRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *ListLogRequest) GetUserUuid() string {
+	if m != nil {
+		return m.UserUuid
+	}
+	return ""
+}
+
+func (m *ListLogRequest) GetNodeUuid() string {
+	if m != nil {
+		return m.NodeUuid
+	}
+	return ""
+}
+
+func (m *ListLogRequest) GetNodePath() string {
+	if m != nil {
+		return m.NodePath
+	}
+	return ""
+}
+
+func (m *ListLogRequest) GetWsUuid() string {
+	if m != nil {
+		return m.WsUuid
+	}
+	return ""
+}
+
+func (m *ListLogRequest) GetMsgIds() []string {
+	if m != nil {
+		return m.MsgIds
+	}
+	return nil
+}
+
+func (m *ListLogRequest) GetFrom() int32 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *ListLogRequest) GetTo() int32 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *ListLogRequest) GetAuditContext() map[string]string {
+	if m != nil {
+		return m.AuditContext
+	}
+	return nil
+}
+
+func (m *ListLogRequest) GetAuditOnly() bool {
+	if m != nil {
+		return m.AuditOnly
+	}
+	return false
+}
+
 type ListLogResponse struct {
 	LogMessage *LogMessage `protobuf:"bytes,1,opt,name=LogMessage" json:"LogMessage,omitempty"`
 }
@@ -354,6 +484,127 @@ func (m *ListLogResponse) GetLogMessage() *LogMessage {
 	return nil
 }
 
+// VerifyAuditChainRequest selects the audit messages to verify by timestamp. Zero values are unbounded.
+type VerifyAuditChainRequest struct {
+	From int32 `protobuf:"varint,1,opt,name=From" json:"From,omitempty"`
+	To   int32 `protobuf:"varint,2,opt,name=To" json:"To,omitempty"`
+}
+
+func (m *VerifyAuditChainRequest) Reset()                    { *m = VerifyAuditChainRequest{} }
+func (m *VerifyAuditChainRequest) String() string            { return proto.CompactTextString(m) }
+func (*VerifyAuditChainRequest) ProtoMessage()               {}
+func (*VerifyAuditChainRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *VerifyAuditChainRequest) GetFrom() int32 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *VerifyAuditChainRequest) GetTo() int32 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+// AuditChainBreak describes the first inconsistency found in the audit chain.
+type AuditChainBreak struct {
+	// Sequence of the message where the chain breaks
+	Sequence int64 `protobuf:"varint,1,opt,name=Sequence" json:"Sequence,omitempty"`
+	// Timestamp of the message, if it is still present
+	Ts int32 `protobuf:"varint,2,opt,name=Ts" json:"Ts,omitempty"`
+	// Human readable explanation
+	Reason string `protobuf:"bytes,3,opt,name=Reason" json:"Reason,omitempty"`
+}
+
+func (m *AuditChainBreak) Reset()                    { *m = AuditChainBreak{} }
+func (m *AuditChainBreak) String() string            { return proto.CompactTextString(m) }
+func (*AuditChainBreak) ProtoMessage()               {}
+func (*AuditChainBreak) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *AuditChainBreak) GetSequence() int64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *AuditChainBreak) GetTs() int32 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *AuditChainBreak) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// VerifyAuditChainResponse is the verification report.
+type VerifyAuditChainResponse struct {
+	Valid bool `protobuf:"varint,1,opt,name=Valid" json:"Valid,omitempty"`
+	// Number of messages checked
+	Checked int64 `protobuf:"varint,2,opt,name=Checked" json:"Checked,omitempty"`
+	// Number of signed checkpoints checked
+	Checkpoints   int64 `protobuf:"varint,3,opt,name=Checkpoints" json:"Checkpoints,omitempty"`
+	FirstSequence int64 `protobuf:"varint,4,opt,name=FirstSequence" json:"FirstSequence,omitempty"`
+	LastSequence  int64 `protobuf:"varint,5,opt,name=LastSequence" json:"LastSequence,omitempty"`
+	// First break found, if the chain is not valid
+	Break *AuditChainBreak `protobuf:"bytes,6,opt,name=Break" json:"Break,omitempty"`
+}
+
+func (m *VerifyAuditChainResponse) Reset()                    { *m = VerifyAuditChainResponse{} }
+func (m *VerifyAuditChainResponse) String() string            { return proto.CompactTextString(m) }
+func (*VerifyAuditChainResponse) ProtoMessage()               {}
+func (*VerifyAuditChainResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *VerifyAuditChainResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *VerifyAuditChainResponse) GetChecked() int64 {
+	if m != nil {
+		return m.Checked
+	}
+	return 0
+}
+
+func (m *VerifyAuditChainResponse) GetCheckpoints() int64 {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return 0
+}
+
+func (m *VerifyAuditChainResponse) GetFirstSequence() int64 {
+	if m != nil {
+		return m.FirstSequence
+	}
+	return 0
+}
+
+func (m *VerifyAuditChainResponse) GetLastSequence() int64 {
+	if m != nil {
+		return m.LastSequence
+	}
+	return 0
+}
+
+func (m *VerifyAuditChainResponse) GetBreak() *AuditChainBreak {
+	if m != nil {
+		return m.Break
+	}
+	return nil
+}
+
 // TimeRangeResponse contains either one aggregated result of a facetted request
 // OR a time range cursor.
 type TimeRangeResponse struct {
@@ -364,7 +615,7 @@ type TimeRangeResponse struct {
 func (m *TimeRangeResponse) Reset()                    { *m = TimeRangeResponse{} }
 func (m *TimeRangeResponse) String() string            { return proto.CompactTextString(m) }
 func (*TimeRangeResponse) ProtoMessage()               {}
-func (*TimeRangeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*TimeRangeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 func (m *TimeRangeResponse) GetTimeRangeResult() *TimeRangeResult {
 	if m != nil {
@@ -401,7 +652,7 @@ type TimeRangeResult struct {
 func (m *TimeRangeResult) Reset()                    { *m = TimeRangeResult{} }
 func (m *TimeRangeResult) String() string            { return proto.CompactTextString(m) }
 func (*TimeRangeResult) ProtoMessage()               {}
-func (*TimeRangeResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*TimeRangeResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *TimeRangeResult) GetName() string {
 	if m != nil {
@@ -453,7 +704,7 @@ type TimeRangeRequest struct {
 func (m *TimeRangeRequest) Reset()                    { *m = TimeRangeRequest{} }
 func (m *TimeRangeRequest) String() string            { return proto.CompactTextString(m) }
 func (*TimeRangeRequest) ProtoMessage()               {}
-func (*TimeRangeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*TimeRangeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *TimeRangeRequest) GetMsgId() string {
 	if m != nil {
@@ -486,7 +737,7 @@ type TimeRangeCursor struct {
 func (m *TimeRangeCursor) Reset()                    { *m = TimeRangeCursor{} }
 func (m *TimeRangeCursor) String() string            { return proto.CompactTextString(m) }
 func (*TimeRangeCursor) ProtoMessage()               {}
-func (*TimeRangeCursor) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*TimeRangeCursor) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *TimeRangeCursor) GetRel() RelType {
 	if m != nil {
@@ -515,6 +766,9 @@ func init() {
 	proto.RegisterType((*LogMessage)(nil), "log.LogMessage")
 	proto.RegisterType((*ListLogRequest)(nil), "log.ListLogRequest")
 	proto.RegisterType((*ListLogResponse)(nil), "log.ListLogResponse")
+	proto.RegisterType((*VerifyAuditChainRequest)(nil), "log.VerifyAuditChainRequest")
+	proto.RegisterType((*AuditChainBreak)(nil), "log.AuditChainBreak")
+	proto.RegisterType((*VerifyAuditChainResponse)(nil), "log.VerifyAuditChainResponse")
 	proto.RegisterType((*TimeRangeResponse)(nil), "log.TimeRangeResponse")
 	proto.RegisterType((*TimeRangeResult)(nil), "log.TimeRangeResult")
 	proto.RegisterType((*TimeRangeRequest)(nil), "log.TimeRangeRequest")
@@ -526,57 +780,78 @@ func init() {
 func init() { proto.RegisterFile("log.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 823 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x55, 0x4d, 0x6f, 0xdb, 0x46,
-	0x10, 0x35, 0x45, 0x49, 0x96, 0xc6, 0xb6, 0xcc, 0xac, 0x3f, 0xb0, 0x30, 0xda, 0xc2, 0x20, 0x82,
-	0xc2, 0xe8, 0xc1, 0x09, 0x54, 0x14, 0x68, 0x83, 0xa2, 0x80, 0x6b, 0x28, 0x6d, 0x0a, 0x5a, 0x51,
-	0x97, 0x4a, 0xe2, 0x2b, 0x2b, 0x4d, 0x68, 0xa1, 0x14, 0x57, 0xe5, 0x92, 0x06, 0xdc, 0x5b, 0x7f,
-	0x43, 0x7f, 0x48, 0xef, 0xbd, 0xf4, 0xaf, 0x15, 0x33, 0x4b, 0x52, 0x94, 0x94, 0xdb, 0xbe, 0x37,
-	0xf3, 0x66, 0x77, 0x76, 0xdf, 0x90, 0xd0, 0x4f, 0x74, 0x7c, 0xbd, 0xca, 0x74, 0xae, 0x85, 0x9b,
-	0xe8, 0xd8, 0x3f, 0x83, 0x13, 0x85, 0x33, 0x9d, 0xcd, 0x31, 0x9b, 0x14, 0xb9, 0x42, 0xb3, 0xd2,
-	0xa9, 0x41, 0x3f, 0x03, 0x37, 0xd0, 0xb1, 0x78, 0x01, 0xfb, 0x4b, 0x34, 0x26, 0x8a, 0x51, 0x3a,
-	0x97, 0xee, 0xd5, 0xc1, 0xf0, 0xec, 0x9a, 0xf4, 0x81, 0x8e, 0xaf, 0xef, 0x2c, 0x3f, 0x4a, 0xf3,
-	0xec, 0x49, 0x55, 0x59, 0x17, 0xaf, 0xe0, 0xb0, 0x19, 0x10, 0x1e, 0xb8, 0xbf, 0xe3, 0x93, 0x74,
-	0x2e, 0x9d, 0xab, 0xbe, 0xa2, 0xa5, 0x38, 0x85, 0xce, 0x63, 0x94, 0x14, 0x28, 0x5b, 0xcc, 0x59,
-	0xf0, 0xaa, 0xf5, 0xad, 0xe3, 0xff, 0xdb, 0x06, 0x08, 0x74, 0x5c, 0xea, 0xc5, 0x00, 0x5a, 0x53,
-	0xc3, 0xca, 0x8e, 0x6a, 0x4d, 0x0d, 0x09, 0x03, 0x7c, 0xc4, 0xa4, 0x12, 0x32, 0x10, 0xe7, 0xd0,
-	0x0d, 0x74, 0x1c, 0x63, 0x26, 0x5d, 0xa6, 0x4b, 0x44, 0x1b, 0xdf, 0x99, 0x58, 0xb6, 0xed, 0xc6,
-	0x77, 0x26, 0x26, 0xfd, 0x9d, 0x89, 0xdf, 0xcc, 0x65, 0xc7, 0xea, 0x19, 0x88, 0x0b, 0xe8, 0xbd,
-	0x33, 0x98, 0x8d, 0xa3, 0x25, 0xca, 0x2e, 0x07, 0x6a, 0x5c, 0xc5, 0xde, 0x15, 0x8b, 0xb9, 0xdc,
-	0x5f, 0xc7, 0x08, 0x8b, 0xcf, 0xa0, 0xff, 0x53, 0xa6, 0x8b, 0xd5, 0x24, 0xca, 0x1f, 0x64, 0x8f,
-	0x83, 0x6b, 0x42, 0x48, 0xd8, 0x9f, 0x64, 0xfa, 0xe3, 0x22, 0x41, 0xe9, 0x71, 0xac, 0x82, 0xa4,
-	0x53, 0x3a, 0x41, 0xaa, 0x61, 0x64, 0xff, 0xd2, 0x25, 0x5d, 0x4d, 0x88, 0xe7, 0x70, 0xa4, 0x70,
-	0xa9, 0x73, 0xbc, 0x99, 0xcf, 0x33, 0x34, 0x46, 0x02, 0xab, 0x37, 0x49, 0xaa, 0x41, 0xe7, 0xb8,
-	0x89, 0x31, 0xcd, 0xe5, 0x81, 0xdd, 0xbb, 0x26, 0x84, 0x0f, 0x87, 0x3f, 0xe7, 0xf9, 0x6a, 0x42,
-	0x6f, 0x3c, 0xd3, 0x89, 0x3c, 0xe4, 0x84, 0x0d, 0x8e, 0x3a, 0x1b, 0xeb, 0x39, 0x6f, 0x2a, 0x8f,
-	0x6c, 0x67, 0x15, 0xae, 0x62, 0xdc, 0xd8, 0x60, 0x1d, 0xe3, 0xbe, 0xce, 0xa1, 0xfb, 0xc1, 0xb0,
-	0xea, 0xd8, 0xde, 0xb6, 0x45, 0xd4, 0xef, 0x07, 0x13, 0xce, 0xf4, 0x0a, 0xe5, 0x33, 0xdb, 0x6f,
-	0x09, 0xa9, 0x5a, 0xb8, 0x8a, 0x52, 0xd6, 0x08, 0x5b, 0xad, 0xc2, 0xe2, 0x4b, 0x18, 0xd0, 0x7a,
-	0x12, 0x65, 0x98, 0xe6, 0x9c, 0x71, 0xc2, 0x19, 0x5b, 0x2c, 0x75, 0x44, 0x8c, 0xd2, 0xda, 0x66,
-	0x9d, 0xda, 0x8e, 0x9a, 0x9c, 0xff, 0x8f, 0x03, 0x83, 0x60, 0x61, 0xf2, 0x40, 0xc7, 0x0a, 0xff,
-	0x28, 0xd0, 0xe4, 0xf4, 0xe0, 0xbf, 0x16, 0x98, 0x55, 0xee, 0xb3, 0x40, 0x08, 0x68, 0x4f, 0xc8,
-	0xcf, 0x2d, 0x36, 0x16, 0xaf, 0x89, 0x0b, 0x17, 0x7f, 0x22, 0x5b, 0xa8, 0xa3, 0x78, 0x2d, 0xbe,
-	0x81, 0xee, 0x6b, 0x9d, 0x2d, 0xa3, 0x9c, 0x3d, 0x34, 0x18, 0x7e, 0x6e, 0x9d, 0xbf, 0xb1, 0x05,
-	0x0d, 0x82, 0x4d, 0x52, 0x65, 0xb2, 0x7f, 0x05, 0xfd, 0x9a, 0x14, 0x3d, 0x68, 0xff, 0x12, 0xbe,
-	0x1d, 0x7b, 0x7b, 0x62, 0x1f, 0xdc, 0xdb, 0xf0, 0xbd, 0xe7, 0x10, 0x75, 0x1f, 0x84, 0xf7, 0x5e,
-	0xcb, 0xff, 0x11, 0x8e, 0xeb, 0x6a, 0x76, 0xea, 0xc4, 0x8b, 0xe6, 0x00, 0xf0, 0xb1, 0x0f, 0x86,
-	0xc7, 0xd5, 0xc4, 0x95, 0xb4, 0x6a, 0xa4, 0xf8, 0x7f, 0x3b, 0xf0, 0x6c, 0xba, 0x58, 0xa2, 0x8a,
-	0xd2, 0x18, 0xeb, 0x32, 0x3f, 0xc0, 0x71, 0x93, 0x2c, 0x92, 0xbc, 0xac, 0x75, 0xca, 0xb5, 0xb6,
-	0x62, 0x6a, 0x3b, 0x79, 0x43, 0x7f, 0x5b, 0x64, 0x46, 0x67, 0x7c, 0x5b, 0x3b, 0x7a, 0x1b, 0x53,
-	0xdb, 0xc9, 0xfe, 0x5f, 0xce, 0xce, 0x01, 0xe8, 0x8a, 0x79, 0xc6, 0xec, 0x5b, 0xf0, 0x9a, 0x1e,
-	0x28, 0xcc, 0xa3, 0x2c, 0x2f, 0xdf, 0xc2, 0x02, 0x9a, 0xdc, 0x51, 0x3a, 0x2f, 0xdf, 0x82, 0x96,
-	0x94, 0x77, 0xab, 0x8b, 0xd4, 0xbe, 0x44, 0x47, 0x59, 0xc0, 0x93, 0x84, 0x09, 0x3e, 0x46, 0xe9,
-	0x0c, 0x79, 0xa6, 0x3b, 0x6a, 0x4d, 0xf8, 0x0f, 0xe0, 0x35, 0x8e, 0x50, 0x1b, 0xc2, 0x7e, 0x01,
-	0x9c, 0xe6, 0x17, 0xe0, 0x39, 0x1c, 0xd5, 0x99, 0xd3, 0xa7, 0x55, 0xf5, 0x61, 0xda, 0x24, 0xc9,
-	0xe1, 0x0a, 0x3f, 0x12, 0x57, 0x9e, 0xac, 0x82, 0x7e, 0xb4, 0x73, 0x5b, 0xe2, 0x0b, 0x70, 0x15,
-	0x26, 0xbc, 0xcd, 0x60, 0x78, 0xc8, 0x97, 0xa6, 0x30, 0xa1, 0x3a, 0x8a, 0x02, 0xcd, 0x62, 0xad,
-	0x8d, 0x62, 0xeb, 0x56, 0xdd, 0x46, 0xab, 0x5f, 0x7d, 0x4f, 0xf9, 0xac, 0x27, 0xff, 0x8c, 0xdf,
-	0x8e, 0x47, 0xde, 0x9e, 0xe8, 0x43, 0xe7, 0xf5, 0x1b, 0x15, 0x4e, 0xad, 0xa9, 0x26, 0x6a, 0xf4,
-	0xde, 0x6b, 0x71, 0x78, 0x74, 0x3f, 0xf5, 0x5c, 0x5a, 0x05, 0x37, 0xe1, 0xd4, 0x6b, 0x0f, 0xff,
-	0x73, 0xe0, 0x80, 0x5d, 0x66, 0x3f, 0xf3, 0xe2, 0x25, 0x74, 0x27, 0x05, 0xf9, 0x4e, 0xf4, 0x2a,
-	0x6f, 0x5d, 0xc8, 0xf2, 0x90, 0xbb, 0x7f, 0x82, 0xbd, 0x2b, 0x47, 0x7c, 0x07, 0xbd, 0xd2, 0xaa,
-	0x46, 0x9c, 0x7c, 0x62, 0x0e, 0x2e, 0x4e, 0x37, 0xc9, 0x4a, 0xfa, 0xd2, 0x11, 0xb7, 0x30, 0xb8,
-	0x89, 0xe3, 0x0c, 0xe3, 0x28, 0xc7, 0x39, 0x17, 0x38, 0xdb, 0x36, 0xa1, 0x2d, 0x71, 0xbe, 0xe3,
-	0xcd, 0xba, 0xc8, 0x6f, 0x5d, 0xfe, 0x61, 0x7d, 0xfd, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x68,
-	0x89, 0xec, 0x9c, 0xbd, 0x06, 0x00, 0x00,
+	// 1155 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x0e, 0x45, 0x53, 0x96, 0x46, 0xb2, 0xac, 0x6c, 0x6c, 0x65, 0x2b, 0x24, 0x85, 0x4b, 0xa4,
+	0x85, 0x91, 0x83, 0x13, 0xa8, 0x28, 0xd0, 0x06, 0xfd, 0x81, 0x23, 0xc8, 0x8d, 0x0b, 0xd9, 0x51,
+	0x56, 0x8a, 0x93, 0x2b, 0x6b, 0x6d, 0x68, 0x21, 0x14, 0x57, 0xd9, 0xa5, 0x8c, 0xba, 0xb7, 0xbe,
+	0x42, 0x7b, 0xee, 0xeb, 0xf4, 0x41, 0xfa, 0x24, 0xc5, 0xcc, 0x92, 0x14, 0x29, 0x25, 0x29, 0x8a,
+	0xde, 0x76, 0xbe, 0xf9, 0xd9, 0x99, 0xd9, 0xf9, 0x86, 0x84, 0x7a, 0xa4, 0xc2, 0xa3, 0x85, 0x56,
+	0x89, 0x62, 0x6e, 0xa4, 0x42, 0x7f, 0x1f, 0xee, 0x08, 0x79, 0xa9, 0xf4, 0x54, 0xea, 0xd1, 0x32,
+	0x11, 0xd2, 0x2c, 0x54, 0x6c, 0xa4, 0xaf, 0xc1, 0x1d, 0xaa, 0x90, 0x3d, 0x82, 0xed, 0xb9, 0x34,
+	0x26, 0x08, 0x25, 0x77, 0x0e, 0xdc, 0xc3, 0x46, 0x6f, 0xff, 0x08, 0xfd, 0x87, 0x2a, 0x3c, 0x3a,
+	0xb3, 0xf8, 0x20, 0x4e, 0xf4, 0x8d, 0xc8, 0xac, 0xba, 0x4f, 0xa0, 0x59, 0x54, 0xb0, 0x36, 0xb8,
+	0x6f, 0xe5, 0x0d, 0x77, 0x0e, 0x9c, 0xc3, 0xba, 0xc0, 0x23, 0xdb, 0x03, 0xef, 0x3a, 0x88, 0x96,
+	0x92, 0x57, 0x08, 0xb3, 0xc2, 0x93, 0xca, 0xd7, 0x8e, 0xff, 0x57, 0x15, 0x60, 0xa8, 0xc2, 0xd4,
+	0x9f, 0xb5, 0xa0, 0x32, 0x31, 0xe4, 0xe9, 0x89, 0xca, 0xc4, 0xa0, 0xe3, 0x50, 0x5e, 0xcb, 0x28,
+	0x73, 0x24, 0x81, 0x75, 0xa0, 0x3a, 0x54, 0x61, 0x28, 0x35, 0x77, 0x09, 0x4e, 0x25, 0xbc, 0xf8,
+	0xcc, 0x84, 0x7c, 0xcb, 0x5e, 0x7c, 0x66, 0x42, 0xf4, 0x3f, 0x33, 0xe1, 0xe9, 0x94, 0x7b, 0xd6,
+	0x9f, 0x04, 0xd6, 0x85, 0xda, 0x4b, 0x23, 0xf5, 0x79, 0x30, 0x97, 0xbc, 0x4a, 0x8a, 0x5c, 0xce,
+	0x74, 0x2f, 0x97, 0xb3, 0x29, 0xdf, 0x5e, 0xe9, 0x50, 0x66, 0xf7, 0xa0, 0xfe, 0xa3, 0x56, 0xcb,
+	0xc5, 0x28, 0x48, 0xae, 0x78, 0x8d, 0x94, 0x2b, 0x80, 0x71, 0xd8, 0x1e, 0x69, 0xf5, 0x66, 0x16,
+	0x49, 0xde, 0x26, 0x5d, 0x26, 0xa2, 0x9f, 0x50, 0x91, 0xc4, 0x18, 0x86, 0xd7, 0x0f, 0x5c, 0xf4,
+	0xcb, 0x01, 0xe6, 0x43, 0xf3, 0x74, 0xbe, 0x90, 0xda, 0xa8, 0x38, 0x48, 0x94, 0xe6, 0xfb, 0xe4,
+	0x5c, 0xc2, 0xd8, 0x03, 0xd8, 0x11, 0x72, 0xae, 0x12, 0x79, 0x3c, 0x9d, 0x6a, 0x69, 0x0c, 0x07,
+	0x32, 0x2a, 0x83, 0x78, 0x0f, 0xe6, 0x7a, 0x1c, 0xca, 0x38, 0xe1, 0x0d, 0x9b, 0x5f, 0x0e, 0xe0,
+	0x3d, 0xcf, 0x92, 0x64, 0x31, 0xc2, 0x39, 0xb8, 0x54, 0x11, 0x6f, 0xda, 0x7b, 0x8a, 0x18, 0x56,
+	0x7f, 0xae, 0xa6, 0x94, 0x18, 0xdf, 0xb1, 0xd5, 0x67, 0x72, 0xa6, 0xa3, 0xe2, 0x5b, 0x2b, 0x1d,
+	0xd5, 0xde, 0x81, 0xea, 0x2b, 0x43, 0x5e, 0xbb, 0xf6, 0x45, 0xac, 0x84, 0x3d, 0x79, 0x65, 0xc6,
+	0x97, 0x6a, 0x21, 0xf9, 0x6d, 0xdb, 0x93, 0x54, 0xc4, 0x68, 0xe3, 0x45, 0x10, 0x93, 0x0f, 0xb3,
+	0xd1, 0x32, 0x99, 0x7d, 0x01, 0x2d, 0x3c, 0x8f, 0x02, 0x2d, 0xe3, 0x84, 0x2c, 0xee, 0x90, 0xc5,
+	0x1a, 0x8a, 0x15, 0x21, 0x22, 0x94, 0xb2, 0x56, 0x7b, 0xb6, 0xa2, 0x22, 0x86, 0xf7, 0xf4, 0xaf,
+	0x82, 0x59, 0x3c, 0x96, 0xef, 0x78, 0xe7, 0xc0, 0x39, 0x74, 0x45, 0x2e, 0x63, 0x57, 0xe9, 0x3c,
+	0xd2, 0xf2, 0xfa, 0x59, 0x60, 0xae, 0xf8, 0x5d, 0xdb, 0xd5, 0x12, 0x88, 0x5d, 0x25, 0x80, 0x2c,
+	0xb8, 0xed, 0x6a, 0x0e, 0xb0, 0x01, 0x34, 0x8f, 0x97, 0xd3, 0x59, 0xd2, 0x57, 0x71, 0x22, 0x7f,
+	0x49, 0xf8, 0x27, 0x44, 0x99, 0xcf, 0x32, 0xca, 0xa4, 0x83, 0x7d, 0x54, 0xb4, 0xb1, 0xf4, 0x29,
+	0xb9, 0x75, 0x7f, 0x80, 0xdb, 0x1b, 0x26, 0xff, 0x89, 0x48, 0x7f, 0x6e, 0x41, 0x6b, 0x38, 0x33,
+	0xc9, 0x50, 0x85, 0x42, 0xbe, 0x5b, 0x4a, 0x93, 0xa0, 0xf1, 0x8b, 0xa5, 0xd4, 0x59, 0x00, 0x2b,
+	0x30, 0x06, 0x5b, 0xa3, 0x20, 0xb4, 0x11, 0x3c, 0x41, 0x67, 0xc4, 0xc6, 0xb3, 0x5f, 0x25, 0xd1,
+	0xc9, 0x13, 0x74, 0x66, 0x5f, 0x41, 0xf5, 0x44, 0xe9, 0x79, 0x90, 0x10, 0x9f, 0x5a, 0xbd, 0xfb,
+	0xb6, 0xa4, 0xd2, 0x15, 0x58, 0xa1, 0x35, 0x12, 0xa9, 0x71, 0x89, 0x5b, 0xde, 0x47, 0xb8, 0x55,
+	0x5d, 0xe3, 0x56, 0x71, 0xf2, 0xb6, 0x3f, 0x32, 0x79, 0xb5, 0x0f, 0x4e, 0x5e, 0xbd, 0x34, 0x79,
+	0x1d, 0xa8, 0x12, 0xd9, 0x91, 0x2a, 0x48, 0xb8, 0x54, 0xc2, 0x52, 0x4f, 0xb4, 0x9a, 0x13, 0x3d,
+	0x3c, 0x41, 0x67, 0xda, 0x3a, 0x8a, 0x37, 0xd3, 0xad, 0xa3, 0xd8, 0xe9, 0xda, 0x9b, 0xee, 0xd0,
+	0x9b, 0x7e, 0xfe, 0xbe, 0x06, 0xfc, 0xcb, 0xbb, 0xe2, 0xf0, 0x90, 0xfc, 0x3c, 0x8e, 0x6e, 0x88,
+	0x35, 0x35, 0xb1, 0x02, 0xfe, 0xff, 0xab, 0x1f, 0x42, 0x3d, 0x7f, 0x02, 0x56, 0x83, 0xad, 0x9f,
+	0xc6, 0xcf, 0xcf, 0xdb, 0xb7, 0xd8, 0x36, 0xb8, 0xfd, 0xf1, 0x45, 0xdb, 0x41, 0xe8, 0xf5, 0x70,
+	0xfc, 0xba, 0x5d, 0xf1, 0x9f, 0xc2, 0x6e, 0x9e, 0xba, 0xdd, 0xf7, 0xec, 0x51, 0x71, 0xf5, 0xd2,
+	0x7d, 0x8d, 0xde, 0xee, 0xda, 0xe0, 0x8a, 0x82, 0x89, 0xff, 0x1d, 0xdc, 0xbd, 0x90, 0x7a, 0xf6,
+	0xe6, 0xc6, 0x26, 0x8d, 0x1c, 0xc8, 0x66, 0x2d, 0x6b, 0xab, 0xb3, 0xd1, 0xd6, 0x4a, 0xd6, 0x56,
+	0xff, 0x25, 0xec, 0xae, 0x1c, 0x9f, 0x6a, 0x19, 0xbc, 0xa5, 0x2d, 0x80, 0x11, 0xe2, 0x4b, 0x9b,
+	0x80, 0x2b, 0x72, 0x39, 0xfd, 0x16, 0x54, 0xf2, 0x6f, 0x41, 0x07, 0xaa, 0x42, 0x06, 0x46, 0xc5,
+	0xd9, 0xd6, 0xb7, 0x92, 0xff, 0xb7, 0x03, 0x7c, 0x33, 0xad, 0xb4, 0xc6, 0x3d, 0xf0, 0x2e, 0x82,
+	0x68, 0x36, 0xa5, 0xe8, 0x35, 0x61, 0x05, 0x5c, 0x4b, 0xfd, 0x2b, 0x79, 0xf9, 0x56, 0x4e, 0x29,
+	0xbe, 0x2b, 0x32, 0x91, 0x1d, 0x40, 0x83, 0x8e, 0x0b, 0x35, 0x8b, 0x13, 0x43, 0x37, 0xb9, 0xa2,
+	0x08, 0xe1, 0xd2, 0x38, 0x99, 0x69, 0x93, 0xe4, 0x79, 0x6f, 0x91, 0x4d, 0x19, 0xc4, 0xd5, 0x34,
+	0x0c, 0x0a, 0x46, 0x1e, 0x19, 0x95, 0x30, 0xf6, 0x10, 0x3c, 0xea, 0x02, 0x71, 0xa1, 0xd1, 0xdb,
+	0xa3, 0xd6, 0xaf, 0x75, 0x48, 0x58, 0x13, 0xff, 0x0f, 0x07, 0x6e, 0x4f, 0x66, 0x73, 0x29, 0x82,
+	0x38, 0x94, 0x79, 0x75, 0xdf, 0xc3, 0x6e, 0x11, 0x5c, 0x46, 0x09, 0x77, 0x0a, 0xb1, 0xd6, 0x74,
+	0x62, 0xdd, 0xb8, 0xe4, 0xdf, 0x5f, 0x6a, 0xa3, 0x34, 0xaf, 0xbc, 0xcf, 0xdf, 0xea, 0xc4, 0xba,
+	0xb1, 0xff, 0x9b, 0xb3, 0x91, 0x00, 0x4e, 0x02, 0x91, 0xdf, 0xce, 0x2f, 0x9d, 0xf1, 0x15, 0xc6,
+	0x49, 0xa0, 0x93, 0xf4, 0x35, 0xad, 0x80, 0x83, 0x3e, 0x88, 0xa7, 0xe9, 0xd2, 0xc1, 0x23, 0xda,
+	0xf5, 0xd5, 0x32, 0xb6, 0x2b, 0xc7, 0x13, 0x56, 0xa0, 0xcf, 0xa7, 0x8c, 0xe4, 0x75, 0x90, 0x35,
+	0xd2, 0x13, 0x2b, 0xc0, 0xbf, 0x82, 0x76, 0x21, 0x85, 0x7c, 0xf3, 0xd9, 0xcf, 0xbe, 0x53, 0xfc,
+	0xec, 0x3f, 0x80, 0x9d, 0xdc, 0x72, 0x72, 0xb3, 0xc8, 0xe8, 0x54, 0x06, 0x71, 0x36, 0x84, 0x7c,
+	0x83, 0x58, 0x9a, 0x59, 0x26, 0xfa, 0xc1, 0x46, 0xb7, 0xd8, 0xa7, 0xe0, 0x0a, 0x19, 0xd1, 0x35,
+	0xad, 0x5e, 0x93, 0x9a, 0x26, 0x64, 0x84, 0x71, 0x04, 0x2a, 0x8a, 0xc1, 0x2a, 0xa5, 0x60, 0xab,
+	0x52, 0xdd, 0x42, 0xa9, 0x0f, 0xbf, 0x85, 0xed, 0xd4, 0x1f, 0xa9, 0x7b, 0xfe, 0xfc, 0x7c, 0xd0,
+	0xbe, 0xc5, 0xea, 0xe0, 0x9d, 0x9c, 0x8a, 0xf1, 0xc4, 0xf2, 0x79, 0x24, 0x06, 0x17, 0xed, 0x0a,
+	0xa9, 0x07, 0xaf, 0x27, 0x6d, 0x17, 0x4f, 0xc3, 0xe3, 0xf1, 0xa4, 0xbd, 0xd5, 0xfb, 0xbd, 0x02,
+	0x0d, 0x22, 0xb8, 0xfd, 0xb7, 0x63, 0x8f, 0xa1, 0x3a, 0x5a, 0x22, 0xe5, 0x59, 0x2d, 0xa3, 0x75,
+	0x97, 0xa7, 0x49, 0x6e, 0xfe, 0xfe, 0xdd, 0x3a, 0x74, 0xd8, 0x37, 0x50, 0x4b, 0xb7, 0x84, 0x61,
+	0x77, 0xde, 0xb3, 0xef, 0xba, 0x7b, 0x65, 0x30, 0x73, 0x7d, 0xec, 0xb0, 0x3e, 0xb4, 0x8e, 0xc3,
+	0x50, 0xcb, 0x30, 0x48, 0xe4, 0x94, 0x02, 0xec, 0xaf, 0x0f, 0xa1, 0x0d, 0xd1, 0x59, 0x87, 0x0b,
+	0x41, 0x5e, 0x40, 0x7b, 0x9d, 0xca, 0xec, 0x1e, 0xd9, 0x7f, 0x60, 0xf1, 0x74, 0xef, 0x7f, 0x40,
+	0x9b, 0x05, 0xfd, 0xb9, 0x4a, 0x3f, 0xbe, 0x5f, 0xfe, 0x33, 0x00, 0x2e, 0x7d, 0x06, 0x59, 0x05,
+	0x0b, 0x00, 0x00,
 }
@@ -10,10 +10,20 @@ It is generated from these files:
 It has these top-level messages:
 	User
 	Mail
+	TemplateVariable
+	MailTemplate
+	Delivery
+	DeliveryEvent
 	SendMailRequest
 	SendMailResponse
 	ConsumeQueueRequest
 	ConsumeQueueResponse
+	ListDeliveriesRequest
+	ListDeliveriesResponse
+	ResendRequest
+	ResendResponse
+	ProcessDeliveryEventsRequest
+	ProcessDeliveryEventsResponse
 */
 package mailer
 
@@ -48,6 +58,12 @@ var _ server.Option
 type MailerServiceClient interface {
 	SendMail(ctx context.Context, in *SendMailRequest, opts ...client.CallOption) (*SendMailResponse, error)
 	ConsumeQueue(ctx context.Context, in *ConsumeQueueRequest, opts ...client.CallOption) (*ConsumeQueueResponse, error)
+	// ListDeliveries lists delivery records, most recent first.
+	ListDeliveries(ctx context.Context, in *ListDeliveriesRequest, opts ...client.CallOption) (*ListDeliveriesResponse, error)
+	// Resend queues dead letters again, resetting their attempts.
+	Resend(ctx context.Context, in *ResendRequest, opts ...client.CallOption) (*ResendResponse, error)
+	// ProcessDeliveryEvents updates delivery records with bounces and complaints reported by the provider.
+	ProcessDeliveryEvents(ctx context.Context, in *ProcessDeliveryEventsRequest, opts ...client.CallOption) (*ProcessDeliveryEventsResponse, error)
 }
 
 type mailerServiceClient struct {
@@ -88,11 +104,47 @@ func (c *mailerServiceClient) ConsumeQueue(ctx context.Context, in *ConsumeQueue
 	return out, nil
 }
 
+func (c *mailerServiceClient) ListDeliveries(ctx context.Context, in *ListDeliveriesRequest, opts ...client.CallOption) (*ListDeliveriesResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "MailerService.ListDeliveries", in)
+	out := new(ListDeliveriesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mailerServiceClient) Resend(ctx context.Context, in *ResendRequest, opts ...client.CallOption) (*ResendResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "MailerService.Resend", in)
+	out := new(ResendResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mailerServiceClient) ProcessDeliveryEvents(ctx context.Context, in *ProcessDeliveryEventsRequest, opts ...client.CallOption) (*ProcessDeliveryEventsResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "MailerService.ProcessDeliveryEvents", in)
+	out := new(ProcessDeliveryEventsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for MailerService service
 
 type MailerServiceHandler interface {
 	SendMail(context.Context, *SendMailRequest, *SendMailResponse) error
 	ConsumeQueue(context.Context, *ConsumeQueueRequest, *ConsumeQueueResponse) error
+	// ListDeliveries lists delivery records, most recent first.
+	ListDeliveries(context.Context, *ListDeliveriesRequest, *ListDeliveriesResponse) error
+	// Resend queues dead letters again, resetting their attempts.
+	Resend(context.Context, *ResendRequest, *ResendResponse) error
+	// ProcessDeliveryEvents updates delivery records with bounces and complaints reported by the provider.
+	ProcessDeliveryEvents(context.Context, *ProcessDeliveryEventsRequest, *ProcessDeliveryEventsResponse) error
 }
 
 func RegisterMailerServiceHandler(s server.Server, hdlr MailerServiceHandler, opts ...server.HandlerOption) {
@@ -110,3 +162,15 @@ func (h *MailerService) SendMail(ctx context.Context, in *SendMailRequest, out *
 func (h *MailerService) ConsumeQueue(ctx context.Context, in *ConsumeQueueRequest, out *ConsumeQueueResponse) error {
 	return h.MailerServiceHandler.ConsumeQueue(ctx, in, out)
 }
+
+func (h *MailerService) ListDeliveries(ctx context.Context, in *ListDeliveriesRequest, out *ListDeliveriesResponse) error {
+	return h.MailerServiceHandler.ListDeliveries(ctx, in, out)
+}
+
+func (h *MailerService) Resend(ctx context.Context, in *ResendRequest, out *ResendResponse) error {
+	return h.MailerServiceHandler.Resend(ctx, in, out)
+}
+
+func (h *MailerService) ProcessDeliveryEvents(ctx context.Context, in *ProcessDeliveryEventsRequest, out *ProcessDeliveryEventsResponse) error {
+	return h.MailerServiceHandler.ProcessDeliveryEvents(ctx, in, out)
+}
@@ -10,10 +10,20 @@ It is generated from these files:
 It has these top-level messages:
 	User
 	Mail
+	TemplateVariable
+	MailTemplate
+	Delivery
+	DeliveryEvent
 	SendMailRequest
 	SendMailResponse
 	ConsumeQueueRequest
 	ConsumeQueueResponse
+	ListDeliveriesRequest
+	ListDeliveriesResponse
+	ResendRequest
+	ResendResponse
+	ProcessDeliveryEventsRequest
+	ProcessDeliveryEventsResponse
 */
 package mailer
 
@@ -32,6 +42,44 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type DeliveryStatus int32
+
+const (
+	DeliveryStatus_QUEUED   DeliveryStatus = 0
+	DeliveryStatus_SENT     DeliveryStatus = 1
+	DeliveryStatus_RETRYING DeliveryStatus = 2
+	// All attempts failed, the mail is kept in the dead letters
+	DeliveryStatus_FAILED DeliveryStatus = 3
+	// Statuses reported by the provider after the mail was sent
+	DeliveryStatus_DELIVERED  DeliveryStatus = 4
+	DeliveryStatus_BOUNCED    DeliveryStatus = 5
+	DeliveryStatus_COMPLAINED DeliveryStatus = 6
+)
+
+var DeliveryStatus_name = map[int32]string{
+	0: "QUEUED",
+	1: "SENT",
+	2: "RETRYING",
+	3: "FAILED",
+	4: "DELIVERED",
+	5: "BOUNCED",
+	6: "COMPLAINED",
+}
+var DeliveryStatus_value = map[string]int32{
+	"QUEUED":     0,
+	"SENT":       1,
+	"RETRYING":   2,
+	"FAILED":     3,
+	"DELIVERED":  4,
+	"BOUNCED":    5,
+	"COMPLAINED": 6,
+}
+
+func (x DeliveryStatus) String() string {
+	return proto.EnumName(DeliveryStatus_name, int32(x))
+}
+func (DeliveryStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
 type User struct {
 	Uuid     string `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
 	Address  string `protobuf:"bytes,2,opt,name=Address" json:"Address,omitempty"`
@@ -89,6 +137,10 @@ type Mail struct {
 	TemplateData map[string]string `protobuf:"bytes,14,rep,name=TemplateData" json:"TemplateData,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	Retries      int32             `protobuf:"varint,15,opt,name=Retries" json:"Retries,omitempty"`
 	SendErrors   []string          `protobuf:"bytes,16,rep,name=sendErrors" json:"sendErrors,omitempty"`
+	// Identifier of the delivery record, assigned when the mail is accepted by the service
+	Uuid string `protobuf:"bytes,17,opt,name=Uuid" json:"Uuid,omitempty"`
+	// Unix time before which a failed mail is not retried
+	NextAttemptAt int32 `protobuf:"varint,18,opt,name=NextAttemptAt" json:"NextAttemptAt,omitempty"`
 }
 
 func (m *Mail) Reset()                    { *m = Mail{} }
@@ -201,6 +253,308 @@ func (m *Mail) GetSendErrors() []string {
 	return nil
 }
 
+func (m *Mail) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *Mail) GetNextAttemptAt() int32 {
+	if m != nil {
+		return m.NextAttemptAt
+	}
+	return 0
+}
+
+// TemplateVariable describes one of the TplData keys that a template expects
+type TemplateVariable struct {
+	Name        string `protobuf:"bytes,1,opt,name=Name" json:"Name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=Description" json:"Description,omitempty"`
+	// Value used when rendering a preview
+	Sample string `protobuf:"bytes,3,opt,name=Sample" json:"Sample,omitempty"`
+}
+
+func (m *TemplateVariable) Reset()                    { *m = TemplateVariable{} }
+func (m *TemplateVariable) String() string            { return proto.CompactTextString(m) }
+func (*TemplateVariable) ProtoMessage()               {}
+func (*TemplateVariable) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *TemplateVariable) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TemplateVariable) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *TemplateVariable) GetSample() string {
+	if m != nil {
+		return m.Sample
+	}
+	return ""
+}
+
+// MailTemplate is an admin-defined variant of a template, replacing the strings of the i18n bundle.
+// Subject, ContentMarkdown, LinkLabel and LinkInstructions are go templates receiving
+// .TplData, .User and .Configs, like the i18n strings. Empty fields fall back to the bundle.
+type MailTemplate struct {
+	TemplateId string `protobuf:"bytes,1,opt,name=TemplateId" json:"TemplateId,omitempty"`
+	// Language code this variant applies to, empty for all languages
+	Language string `protobuf:"bytes,2,opt,name=Language" json:"Language,omitempty"`
+	Subject  string `protobuf:"bytes,3,opt,name=Subject" json:"Subject,omitempty"`
+	// Replaces the bundle Intros and Outros
+	ContentMarkdown string `protobuf:"bytes,4,opt,name=ContentMarkdown" json:"ContentMarkdown,omitempty"`
+	// Full HTML document, bypassing the hermes layout
+	ContentHtml      string `protobuf:"bytes,5,opt,name=ContentHtml" json:"ContentHtml,omitempty"`
+	LinkLabel        string `protobuf:"bytes,6,opt,name=LinkLabel" json:"LinkLabel,omitempty"`
+	LinkInstructions string `protobuf:"bytes,7,opt,name=LinkInstructions" json:"LinkInstructions,omitempty"`
+	// Read-only: variables available for this template
+	Variables []*TemplateVariable `protobuf:"bytes,8,rep,name=Variables" json:"Variables,omitempty"`
+	// Read-only: whether this variant is stored or built from the bundle
+	Custom bool `protobuf:"varint,9,opt,name=Custom" json:"Custom,omitempty"`
+}
+
+func (m *MailTemplate) Reset()                    { *m = MailTemplate{} }
+func (m *MailTemplate) String() string            { return proto.CompactTextString(m) }
+func (*MailTemplate) ProtoMessage()               {}
+func (*MailTemplate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *MailTemplate) GetTemplateId() string {
+	if m != nil {
+		return m.TemplateId
+	}
+	return ""
+}
+
+func (m *MailTemplate) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *MailTemplate) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *MailTemplate) GetContentMarkdown() string {
+	if m != nil {
+		return m.ContentMarkdown
+	}
+	return ""
+}
+
+func (m *MailTemplate) GetContentHtml() string {
+	if m != nil {
+		return m.ContentHtml
+	}
+	return ""
+}
+
+func (m *MailTemplate) GetLinkLabel() string {
+	if m != nil {
+		return m.LinkLabel
+	}
+	return ""
+}
+
+func (m *MailTemplate) GetLinkInstructions() string {
+	if m != nil {
+		return m.LinkInstructions
+	}
+	return ""
+}
+
+func (m *MailTemplate) GetVariables() []*TemplateVariable {
+	if m != nil {
+		return m.Variables
+	}
+	return nil
+}
+
+func (m *MailTemplate) GetCustom() bool {
+	if m != nil {
+		return m.Custom
+	}
+	return false
+}
+
+// Delivery records the outcome of a mail sent by the service
+type Delivery struct {
+	Uuid       string         `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+	TemplateId string         `protobuf:"bytes,2,opt,name=TemplateId" json:"TemplateId,omitempty"`
+	Subject    string         `protobuf:"bytes,3,opt,name=Subject" json:"Subject,omitempty"`
+	Recipients []string       `protobuf:"bytes,4,rep,name=Recipients" json:"Recipients,omitempty"`
+	Status     DeliveryStatus `protobuf:"varint,5,opt,name=Status,enum=mailer.DeliveryStatus" json:"Status,omitempty"`
+	Attempts   int32          `protobuf:"varint,6,opt,name=Attempts" json:"Attempts,omitempty"`
+	LastError  string         `protobuf:"bytes,7,opt,name=LastError" json:"LastError,omitempty"`
+	// Identifier of the message for the mail provider, used to match bounces and complaints
+	ProviderMessageId string `protobuf:"bytes,8,opt,name=ProviderMessageId" json:"ProviderMessageId,omitempty"`
+	CreatedAt         int32  `protobuf:"varint,9,opt,name=CreatedAt" json:"CreatedAt,omitempty"`
+	LastAttemptAt     int32  `protobuf:"varint,10,opt,name=LastAttemptAt" json:"LastAttemptAt,omitempty"`
+	NextAttemptAt     int32  `protobuf:"varint,11,opt,name=NextAttemptAt" json:"NextAttemptAt,omitempty"`
+	// Whether the mail is kept in the dead letters and can be resent
+	DeadLetter bool   `protobuf:"varint,12,opt,name=DeadLetter" json:"DeadLetter,omitempty"`
+	Seq        uint64 `protobuf:"varint,13,opt,name=Seq" json:"Seq,omitempty"`
+}
+
+func (m *Delivery) Reset()                    { *m = Delivery{} }
+func (m *Delivery) String() string            { return proto.CompactTextString(m) }
+func (*Delivery) ProtoMessage()               {}
+func (*Delivery) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *Delivery) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *Delivery) GetTemplateId() string {
+	if m != nil {
+		return m.TemplateId
+	}
+	return ""
+}
+
+func (m *Delivery) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *Delivery) GetRecipients() []string {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func (m *Delivery) GetStatus() DeliveryStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DeliveryStatus_QUEUED
+}
+
+func (m *Delivery) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *Delivery) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *Delivery) GetProviderMessageId() string {
+	if m != nil {
+		return m.ProviderMessageId
+	}
+	return ""
+}
+
+func (m *Delivery) GetCreatedAt() int32 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Delivery) GetLastAttemptAt() int32 {
+	if m != nil {
+		return m.LastAttemptAt
+	}
+	return 0
+}
+
+func (m *Delivery) GetNextAttemptAt() int32 {
+	if m != nil {
+		return m.NextAttemptAt
+	}
+	return 0
+}
+
+func (m *Delivery) GetDeadLetter() bool {
+	if m != nil {
+		return m.DeadLetter
+	}
+	return false
+}
+
+func (m *Delivery) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// DeliveryEvent is a status reported by the mail provider for a sent message
+type DeliveryEvent struct {
+	// Delivery Uuid, provider message id or Message-ID header
+	MessageId string `protobuf:"bytes,1,opt,name=MessageId" json:"MessageId,omitempty"`
+	Recipient string `protobuf:"bytes,2,opt,name=Recipient" json:"Recipient,omitempty"`
+	// One of DELIVERED, BOUNCED or COMPLAINED
+	Status    DeliveryStatus `protobuf:"varint,3,opt,name=Status,enum=mailer.DeliveryStatus" json:"Status,omitempty"`
+	Reason    string         `protobuf:"bytes,4,opt,name=Reason" json:"Reason,omitempty"`
+	Timestamp int32          `protobuf:"varint,5,opt,name=Timestamp" json:"Timestamp,omitempty"`
+}
+
+func (m *DeliveryEvent) Reset()                    { *m = DeliveryEvent{} }
+func (m *DeliveryEvent) String() string            { return proto.CompactTextString(m) }
+func (*DeliveryEvent) ProtoMessage()               {}
+func (*DeliveryEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *DeliveryEvent) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *DeliveryEvent) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *DeliveryEvent) GetStatus() DeliveryStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DeliveryStatus_QUEUED
+}
+
+func (m *DeliveryEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *DeliveryEvent) GetTimestamp() int32 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
 type SendMailRequest struct {
 	Mail    *Mail `protobuf:"bytes,1,opt,name=Mail" json:"Mail,omitempty"`
 	InQueue bool  `protobuf:"varint,2,opt,name=InQueue" json:"InQueue,omitempty"`
@@ -209,7 +563,7 @@ type SendMailRequest struct {
 func (m *SendMailRequest) Reset()                    { *m = SendMailRequest{} }
 func (m *SendMailRequest) String() string            { return proto.CompactTextString(m) }
 func (*SendMailRequest) ProtoMessage()               {}
-func (*SendMailRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*SendMailRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 func (m *SendMailRequest) GetMail() *Mail {
 	if m != nil {
@@ -232,7 +586,7 @@ type SendMailResponse struct {
 func (m *SendMailResponse) Reset()                    { *m = SendMailResponse{} }
 func (m *SendMailResponse) String() string            { return proto.CompactTextString(m) }
 func (*SendMailResponse) ProtoMessage()               {}
-func (*SendMailResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*SendMailResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 func (m *SendMailResponse) GetSuccess() bool {
 	if m != nil {
@@ -248,7 +602,7 @@ type ConsumeQueueRequest struct {
 func (m *ConsumeQueueRequest) Reset()                    { *m = ConsumeQueueRequest{} }
 func (m *ConsumeQueueRequest) String() string            { return proto.CompactTextString(m) }
 func (*ConsumeQueueRequest) ProtoMessage()               {}
-func (*ConsumeQueueRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*ConsumeQueueRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 func (m *ConsumeQueueRequest) GetMaxEmails() int64 {
 	if m != nil {
@@ -265,7 +619,7 @@ type ConsumeQueueResponse struct {
 func (m *ConsumeQueueResponse) Reset()                    { *m = ConsumeQueueResponse{} }
 func (m *ConsumeQueueResponse) String() string            { return proto.CompactTextString(m) }
 func (*ConsumeQueueResponse) ProtoMessage()               {}
-func (*ConsumeQueueResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*ConsumeQueueResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *ConsumeQueueResponse) GetMessage() string {
 	if m != nil {
@@ -281,52 +635,245 @@ func (m *ConsumeQueueResponse) GetEmailsSent() int64 {
 	return 0
 }
 
+type ListDeliveriesRequest struct {
+	Status []DeliveryStatus `protobuf:"varint,1,rep,packed,name=Status,enum=mailer.DeliveryStatus" json:"Status,omitempty"`
+	// Only list deliveries sent to this address
+	Recipient string `protobuf:"bytes,2,opt,name=Recipient" json:"Recipient,omitempty"`
+	// Only list dead letters
+	DeadLetters bool  `protobuf:"varint,3,opt,name=DeadLetters" json:"DeadLetters,omitempty"`
+	Offset      int32 `protobuf:"varint,4,opt,name=Offset" json:"Offset,omitempty"`
+	Limit       int32 `protobuf:"varint,5,opt,name=Limit" json:"Limit,omitempty"`
+}
+
+func (m *ListDeliveriesRequest) Reset()                    { *m = ListDeliveriesRequest{} }
+func (m *ListDeliveriesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListDeliveriesRequest) ProtoMessage()               {}
+func (*ListDeliveriesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *ListDeliveriesRequest) GetStatus() []DeliveryStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListDeliveriesRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *ListDeliveriesRequest) GetDeadLetters() bool {
+	if m != nil {
+		return m.DeadLetters
+	}
+	return false
+}
+
+func (m *ListDeliveriesRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListDeliveriesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListDeliveriesResponse struct {
+	Deliveries []*Delivery `protobuf:"bytes,1,rep,name=Deliveries" json:"Deliveries,omitempty"`
+	Total      int32       `protobuf:"varint,2,opt,name=Total" json:"Total,omitempty"`
+}
+
+func (m *ListDeliveriesResponse) Reset()                    { *m = ListDeliveriesResponse{} }
+func (m *ListDeliveriesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListDeliveriesResponse) ProtoMessage()               {}
+func (*ListDeliveriesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *ListDeliveriesResponse) GetDeliveries() []*Delivery {
+	if m != nil {
+		return m.Deliveries
+	}
+	return nil
+}
+
+func (m *ListDeliveriesResponse) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type ResendRequest struct {
+	// Dead letters to queue again. Empty means all dead letters.
+	Uuids []string `protobuf:"bytes,1,rep,name=Uuids" json:"Uuids,omitempty"`
+}
+
+func (m *ResendRequest) Reset()                    { *m = ResendRequest{} }
+func (m *ResendRequest) String() string            { return proto.CompactTextString(m) }
+func (*ResendRequest) ProtoMessage()               {}
+func (*ResendRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *ResendRequest) GetUuids() []string {
+	if m != nil {
+		return m.Uuids
+	}
+	return nil
+}
+
+type ResendResponse struct {
+	Count int32 `protobuf:"varint,1,opt,name=Count" json:"Count,omitempty"`
+}
+
+func (m *ResendResponse) Reset()                    { *m = ResendResponse{} }
+func (m *ResendResponse) String() string            { return proto.CompactTextString(m) }
+func (*ResendResponse) ProtoMessage()               {}
+func (*ResendResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *ResendResponse) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ProcessDeliveryEventsRequest struct {
+	Events []*DeliveryEvent `protobuf:"bytes,1,rep,name=Events" json:"Events,omitempty"`
+}
+
+func (m *ProcessDeliveryEventsRequest) Reset()                    { *m = ProcessDeliveryEventsRequest{} }
+func (m *ProcessDeliveryEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ProcessDeliveryEventsRequest) ProtoMessage()               {}
+func (*ProcessDeliveryEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *ProcessDeliveryEventsRequest) GetEvents() []*DeliveryEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type ProcessDeliveryEventsResponse struct {
+	// Number of events matching a known delivery
+	Count int32 `protobuf:"varint,1,opt,name=Count" json:"Count,omitempty"`
+}
+
+func (m *ProcessDeliveryEventsResponse) Reset()                    { *m = ProcessDeliveryEventsResponse{} }
+func (m *ProcessDeliveryEventsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ProcessDeliveryEventsResponse) ProtoMessage()               {}
+func (*ProcessDeliveryEventsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *ProcessDeliveryEventsResponse) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*User)(nil), "mailer.User")
 	proto.RegisterType((*Mail)(nil), "mailer.Mail")
+	proto.RegisterType((*TemplateVariable)(nil), "mailer.TemplateVariable")
+	proto.RegisterType((*MailTemplate)(nil), "mailer.MailTemplate")
+	proto.RegisterType((*Delivery)(nil), "mailer.Delivery")
+	proto.RegisterType((*DeliveryEvent)(nil), "mailer.DeliveryEvent")
 	proto.RegisterType((*SendMailRequest)(nil), "mailer.SendMailRequest")
 	proto.RegisterType((*SendMailResponse)(nil), "mailer.SendMailResponse")
 	proto.RegisterType((*ConsumeQueueRequest)(nil), "mailer.ConsumeQueueRequest")
 	proto.RegisterType((*ConsumeQueueResponse)(nil), "mailer.ConsumeQueueResponse")
+	proto.RegisterType((*ListDeliveriesRequest)(nil), "mailer.ListDeliveriesRequest")
+	proto.RegisterType((*ListDeliveriesResponse)(nil), "mailer.ListDeliveriesResponse")
+	proto.RegisterType((*ResendRequest)(nil), "mailer.ResendRequest")
+	proto.RegisterType((*ResendResponse)(nil), "mailer.ResendResponse")
+	proto.RegisterType((*ProcessDeliveryEventsRequest)(nil), "mailer.ProcessDeliveryEventsRequest")
+	proto.RegisterType((*ProcessDeliveryEventsResponse)(nil), "mailer.ProcessDeliveryEventsResponse")
+	proto.RegisterEnum("mailer.DeliveryStatus", DeliveryStatus_name, DeliveryStatus_value)
 }
 
 func init() { proto.RegisterFile("mailer.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xb1, 0xdb, 0xa6, 0x93, 0x94, 0x84, 0xa5, 0x12, 0xab, 0x10, 0x55, 0x96, 0x4f, 0x39,
-	0xa0, 0x1c, 0xda, 0x0b, 0xe2, 0x52, 0x95, 0x34, 0x88, 0x08, 0x8c, 0x8a, 0x93, 0x3e, 0xc0, 0xd6,
-	0x1e, 0xb5, 0xa6, 0xf6, 0x3a, 0xec, 0xae, 0x4b, 0xfb, 0x30, 0x3c, 0x12, 0xef, 0x84, 0x76, 0xd7,
-	0x4e, 0x9c, 0x9f, 0xdb, 0x7e, 0xdf, 0xcc, 0x7c, 0x3b, 0x33, 0xfe, 0xd6, 0xd0, 0xcd, 0x59, 0x9a,
-	0xa1, 0x18, 0x2f, 0x45, 0xa1, 0x0a, 0x72, 0x68, 0x51, 0x90, 0x80, 0x77, 0x2b, 0x51, 0x10, 0x02,
-	0xde, 0x6d, 0x99, 0x26, 0xd4, 0xf1, 0x9d, 0xd1, 0x71, 0x64, 0xce, 0x84, 0xc2, 0xd1, 0x55, 0x92,
-	0x08, 0x94, 0x92, 0xb6, 0x0c, 0x5d, 0x43, 0x9d, 0xfd, 0x83, 0xe5, 0x48, 0x5d, 0x9b, 0xad, 0xcf,
-	0x64, 0x00, 0xed, 0xef, 0x8c, 0xdf, 0x97, 0xec, 0x1e, 0xa9, 0x67, 0xf8, 0x15, 0x0e, 0xfe, 0x79,
-	0xe0, 0x85, 0x2c, 0xcd, 0x88, 0x0f, 0xde, 0x17, 0x51, 0xe4, 0xe6, 0x9a, 0xce, 0x79, 0x77, 0x5c,
-	0xf5, 0xa4, 0x5b, 0x88, 0x4c, 0x84, 0x0c, 0xa1, 0xb5, 0x28, 0xa8, 0xeb, 0xbb, 0x3b, 0xf1, 0xd6,
-	0xa2, 0xd0, 0xd1, 0x49, 0x4c, 0xbd, 0x7d, 0xd1, 0x49, 0xac, 0x5b, 0xb8, 0x66, 0x0a, 0xe7, 0xc8,
-	0x15, 0x3d, 0xf0, 0x9d, 0x91, 0x1b, 0xad, 0xb0, 0x1e, 0x66, 0x5e, 0xde, 0xfd, 0xc2, 0x58, 0xd1,
-	0x43, 0x3b, 0x4c, 0x05, 0x49, 0x00, 0xdd, 0x49, 0xc1, 0x15, 0x72, 0x75, 0x93, 0xb1, 0x94, 0xd3,
-	0x23, 0x13, 0xde, 0xe0, 0x88, 0x0f, 0x9d, 0x0a, 0x7f, 0x55, 0x79, 0x46, 0xdb, 0x26, 0xa5, 0x49,
-	0x91, 0x11, 0xf4, 0x2a, 0x18, 0x32, 0xf1, 0x98, 0x14, 0x7f, 0x38, 0x3d, 0x36, 0x59, 0xdb, 0xb4,
-	0xd6, 0xba, 0x52, 0x8a, 0xc5, 0x0f, 0x39, 0x72, 0x25, 0x29, 0xf8, 0xae, 0xd6, 0x6a, 0x50, 0xe4,
-	0x0c, 0x60, 0xf1, 0x20, 0x90, 0x25, 0xe6, 0x93, 0x74, 0x8c, 0x4c, 0x83, 0xd1, 0x0a, 0x16, 0xcd,
-	0x78, 0x82, 0xcf, 0xb4, 0x6b, 0xbb, 0x69, 0x50, 0x46, 0x01, 0xf3, 0x65, 0xc6, 0x14, 0xce, 0x12,
-	0x7a, 0x52, 0x29, 0xac, 0x18, 0xf2, 0x19, 0xba, 0x35, 0xba, 0x66, 0x8a, 0xd1, 0xd7, 0x66, 0xa3,
-	0x67, 0xf5, 0x46, 0xf5, 0xb7, 0x1a, 0x37, 0x13, 0xa6, 0x5c, 0x89, 0x97, 0x68, 0xa3, 0x46, 0x6f,
-	0x34, 0x42, 0x25, 0x52, 0x94, 0xb4, 0xe7, 0x3b, 0xa3, 0x83, 0xa8, 0x86, 0xfa, 0x76, 0x89, 0x3c,
-	0x99, 0x0a, 0x51, 0x08, 0x49, 0xfb, 0x66, 0xc0, 0x06, 0x33, 0xb8, 0x84, 0x37, 0x3b, 0xe2, 0xa4,
-	0x0f, 0xee, 0x23, 0xbe, 0x54, 0x06, 0xd4, 0x47, 0x72, 0x0a, 0x07, 0x4f, 0x2c, 0x2b, 0xb1, 0x72,
-	0x9f, 0x05, 0x9f, 0x5a, 0x1f, 0x9d, 0x20, 0x84, 0xde, 0x1c, 0x79, 0xa2, 0xdb, 0x8c, 0xf0, 0x77,
-	0x89, 0x52, 0x69, 0x67, 0x69, 0xb8, 0xed, 0x2c, 0x93, 0x62, 0xbd, 0x47, 0xe1, 0x68, 0xc6, 0x7f,
-	0x96, 0x58, 0x09, 0xb6, 0xa3, 0x1a, 0x06, 0x1f, 0xa0, 0xbf, 0x96, 0x93, 0xcb, 0x82, 0x4b, 0xb4,
-	0x7e, 0x89, 0x63, 0x6d, 0x7e, 0xc7, 0x66, 0x57, 0x30, 0xb8, 0x80, 0xb7, 0x93, 0x82, 0xcb, 0x32,
-	0x47, 0x53, 0x5d, 0x37, 0x30, 0x84, 0xe3, 0x90, 0x3d, 0x4f, 0xf5, 0xbd, 0xb6, 0xc4, 0x8d, 0xd6,
-	0x44, 0x70, 0x03, 0xa7, 0x9b, 0x45, 0xeb, 0x6b, 0x42, 0x94, 0x52, 0x3f, 0x1a, 0x3b, 0x79, 0x0d,
-	0xf5, 0x12, 0x6d, 0xad, 0xb1, 0x73, 0xcb, 0x08, 0x36, 0x98, 0xf3, 0xbf, 0x0e, 0x9c, 0x84, 0x66,
-	0xc8, 0x39, 0x8a, 0xa7, 0x34, 0x46, 0x72, 0x09, 0xed, 0x7a, 0x0c, 0xf2, 0xae, 0x5e, 0xc0, 0xd6,
-	0x9e, 0x06, 0x74, 0x37, 0x60, 0x5b, 0x09, 0x5e, 0x91, 0x6f, 0xe6, 0x25, 0xac, 0x9a, 0x24, 0xef,
-	0xeb, 0xdc, 0x3d, 0xf3, 0x0e, 0x86, 0xfb, 0x83, 0xb5, 0xd8, 0xdd, 0xa1, 0xf9, 0xd1, 0x5c, 0xfc,
-	0x0f, 0x00, 0x00, 0xff, 0xff, 0xd6, 0xaf, 0xd3, 0xcd, 0x78, 0x04, 0x00, 0x00,
+	// 1223 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0xd1, 0x8e, 0xda, 0x46,
+	0x17, 0x0e, 0x18, 0x13, 0x38, 0xb0, 0x1b, 0x67, 0xfe, 0xcd, 0xfe, 0x16, 0xdd, 0xac, 0x90, 0x95,
+	0x54, 0xab, 0x28, 0x5d, 0x55, 0x1b, 0xb5, 0x6a, 0x7b, 0x13, 0x11, 0x70, 0x5a, 0x54, 0x20, 0x9b,
+	0x81, 0x8d, 0xd4, 0xbb, 0xcc, 0xe2, 0x93, 0xc4, 0x0d, 0xb6, 0x89, 0x67, 0xd8, 0x26, 0x4f, 0xd0,
+	0x07, 0xe8, 0x6b, 0xf4, 0xa6, 0x17, 0x7d, 0x89, 0x3e, 0x55, 0x35, 0xe3, 0x19, 0x6c, 0x03, 0xbb,
+	0xcd, 0x9d, 0xbf, 0x6f, 0xce, 0x9c, 0x39, 0xf3, 0x9d, 0xef, 0x0c, 0x02, 0xda, 0x11, 0x0b, 0x17,
+	0x98, 0x9e, 0x2e, 0xd3, 0x44, 0x24, 0xa4, 0x9e, 0x21, 0x2f, 0x80, 0xda, 0x05, 0xc7, 0x94, 0x10,
+	0xa8, 0x5d, 0xac, 0xc2, 0xc0, 0xad, 0x74, 0x2b, 0x27, 0x4d, 0xaa, 0xbe, 0x89, 0x0b, 0xb7, 0x7b,
+	0x41, 0x90, 0x22, 0xe7, 0x6e, 0x55, 0xd1, 0x06, 0xca, 0xe8, 0x09, 0x8b, 0xd0, 0xb5, 0xb2, 0x68,
+	0xf9, 0x4d, 0x3a, 0xd0, 0x18, 0xb1, 0xf8, 0xed, 0x8a, 0xbd, 0x45, 0xb7, 0xa6, 0xf8, 0x35, 0xf6,
+	0x7e, 0xb7, 0xa1, 0x36, 0x66, 0xe1, 0x82, 0x74, 0xa1, 0xf6, 0x3c, 0x4d, 0x22, 0x75, 0x4c, 0xeb,
+	0xac, 0x7d, 0xaa, 0x6b, 0x92, 0x25, 0x50, 0xb5, 0x42, 0x8e, 0xa0, 0x3a, 0x4b, 0x5c, 0xab, 0x6b,
+	0x6d, 0xad, 0x57, 0x67, 0x89, 0x5c, 0xed, 0xcf, 0xdd, 0xda, 0xae, 0xd5, 0xfe, 0x5c, 0x96, 0x30,
+	0x60, 0x02, 0xa7, 0x18, 0x0b, 0xd7, 0xee, 0x56, 0x4e, 0x2c, 0xba, 0xc6, 0xf2, 0x32, 0xd3, 0xd5,
+	0xe5, 0xaf, 0x38, 0x17, 0x6e, 0x3d, 0xbb, 0x8c, 0x86, 0xc4, 0x83, 0x76, 0x3f, 0x89, 0x05, 0xc6,
+	0xe2, 0x7c, 0xc1, 0xc2, 0xd8, 0xbd, 0xad, 0x96, 0x4b, 0x1c, 0xe9, 0x42, 0x4b, 0xe3, 0x9f, 0x44,
+	0xb4, 0x70, 0x1b, 0x2a, 0xa4, 0x48, 0x91, 0x13, 0xb8, 0xa3, 0xe1, 0x98, 0xa5, 0xef, 0x83, 0xe4,
+	0xb7, 0xd8, 0x6d, 0xaa, 0xa8, 0x4d, 0x5a, 0xe6, 0xea, 0x09, 0xc1, 0xe6, 0xef, 0x22, 0x8c, 0x05,
+	0x77, 0xa1, 0x6b, 0xc9, 0x5c, 0x05, 0x8a, 0x1c, 0x03, 0xcc, 0xde, 0xa5, 0xc8, 0x02, 0xd5, 0x92,
+	0x96, 0x4a, 0x53, 0x60, 0x64, 0x86, 0x0c, 0x0d, 0xe3, 0x00, 0x3f, 0xba, 0xed, 0xac, 0x9a, 0x02,
+	0xa5, 0x32, 0x60, 0xb4, 0x5c, 0x30, 0x81, 0xc3, 0xc0, 0xdd, 0xd3, 0x19, 0xd6, 0x0c, 0x79, 0x06,
+	0x6d, 0x83, 0x06, 0x4c, 0x30, 0x77, 0x5f, 0x29, 0x7a, 0x6c, 0x14, 0x95, 0xbd, 0x3a, 0x2d, 0x06,
+	0xf8, 0xb1, 0x48, 0x3f, 0xd1, 0xd2, 0x1e, 0xa9, 0x28, 0x45, 0x91, 0x86, 0xc8, 0xdd, 0x3b, 0xdd,
+	0xca, 0x89, 0x4d, 0x0d, 0x94, 0xa7, 0x73, 0x8c, 0x03, 0x3f, 0x4d, 0x93, 0x94, 0xbb, 0x8e, 0xba,
+	0x60, 0x81, 0x59, 0x9b, 0xed, 0x6e, 0xc1, 0x6c, 0x0f, 0x60, 0x6f, 0x82, 0x1f, 0x45, 0x4f, 0x08,
+	0x8c, 0x96, 0xa2, 0x27, 0x5c, 0xa2, 0x72, 0x96, 0xc9, 0xce, 0x53, 0xb8, 0xbb, 0x55, 0x16, 0x71,
+	0xc0, 0x7a, 0x8f, 0x9f, 0xb4, 0x75, 0xe5, 0x27, 0x39, 0x00, 0xfb, 0x8a, 0x2d, 0x56, 0xa8, 0x7d,
+	0x9b, 0x81, 0x1f, 0xaa, 0xdf, 0x55, 0xbc, 0xd7, 0xe0, 0x98, 0x04, 0xaf, 0x58, 0x1a, 0xb2, 0xcb,
+	0x05, 0xae, 0xdd, 0x5c, 0x29, 0xb8, 0xb9, 0x0b, 0xad, 0x01, 0xf2, 0x79, 0x1a, 0x2e, 0x45, 0x98,
+	0xc4, 0x3a, 0x4f, 0x91, 0x22, 0x87, 0x50, 0x9f, 0xb2, 0x68, 0xb9, 0x30, 0x53, 0xa0, 0x91, 0xf7,
+	0x4f, 0x15, 0xda, 0x52, 0x3f, 0x73, 0xcc, 0x46, 0x2f, 0x2a, 0x5b, 0xbd, 0x28, 0x0e, 0x4e, 0xb5,
+	0x3c, 0x38, 0x45, 0xd7, 0x5a, 0x65, 0xd7, 0xee, 0xf0, 0x5b, 0xed, 0x5a, 0xbf, 0x15, 0xbd, 0x6b,
+	0x6f, 0x7b, 0xf7, 0x08, 0x9a, 0xa3, 0x30, 0x7e, 0x3f, 0x62, 0x97, 0xb8, 0xd0, 0xd3, 0x91, 0x13,
+	0xe4, 0x11, 0x38, 0x12, 0x0c, 0x63, 0x2e, 0xd2, 0xd5, 0x5c, 0xde, 0x9d, 0xeb, 0x19, 0xd9, 0xe2,
+	0xc9, 0xb7, 0xd0, 0x34, 0xb2, 0x72, 0xb7, 0xa1, 0x4c, 0xe5, 0x1a, 0x53, 0x6d, 0xea, 0x4e, 0xf3,
+	0x50, 0x29, 0x66, 0x7f, 0xc5, 0x45, 0x12, 0xa9, 0xa1, 0x69, 0x50, 0x8d, 0xbc, 0x3f, 0x2d, 0x68,
+	0x0c, 0x70, 0x11, 0x5e, 0x61, 0xfa, 0x69, 0xe7, 0x1b, 0x55, 0x16, 0xb7, 0xba, 0x25, 0xee, 0xf5,
+	0x02, 0x1e, 0x03, 0x50, 0x9c, 0x87, 0xcb, 0x50, 0x4d, 0x61, 0x2d, 0x33, 0x69, 0xce, 0x90, 0x53,
+	0xa8, 0x4f, 0x05, 0x13, 0x2b, 0xae, 0x14, 0xdb, 0x3f, 0x3b, 0x34, 0xf7, 0x30, 0xf5, 0x64, 0xab,
+	0x54, 0x47, 0xc9, 0x36, 0x6a, 0x9f, 0x72, 0xa5, 0xa1, 0x4d, 0xd7, 0x58, 0x09, 0xcc, 0xb8, 0x50,
+	0xf6, 0xd7, 0xda, 0xe5, 0x04, 0x79, 0x0c, 0x77, 0xcf, 0xd3, 0xe4, 0x2a, 0x0c, 0x30, 0x1d, 0x23,
+	0xe7, 0xec, 0xad, 0xbc, 0x4a, 0xf6, 0xc4, 0x6c, 0x2f, 0xc8, 0x5c, 0xfd, 0x14, 0x99, 0xc0, 0xa0,
+	0x27, 0x94, 0x5a, 0x36, 0xcd, 0x09, 0x39, 0x46, 0x32, 0x71, 0x3e, 0x46, 0x90, 0x8d, 0x51, 0x89,
+	0xdc, 0x1e, 0xb6, 0xd6, 0x8e, 0x61, 0x93, 0x0a, 0x0d, 0x90, 0x05, 0x23, 0x14, 0x02, 0x53, 0xf5,
+	0xca, 0x34, 0x68, 0x81, 0x91, 0x73, 0x37, 0xc5, 0x0f, 0xea, 0x75, 0xa9, 0x51, 0xf9, 0xe9, 0xfd,
+	0x55, 0x81, 0x3d, 0x23, 0x8f, 0x7f, 0x25, 0x9f, 0xdd, 0x23, 0x68, 0xe6, 0x77, 0xca, 0x1a, 0xd7,
+	0x2c, 0xdd, 0x65, 0xad, 0xb8, 0x6e, 0x5e, 0x4e, 0x14, 0x3a, 0x60, 0x7d, 0x56, 0x07, 0x0e, 0xa1,
+	0x4e, 0x91, 0xf1, 0xc4, 0x4c, 0x82, 0x46, 0xf2, 0x94, 0x59, 0x18, 0x21, 0x17, 0x2c, 0x5a, 0xaa,
+	0x66, 0xda, 0x34, 0x27, 0xbc, 0x31, 0xdc, 0x99, 0x62, 0x1c, 0xc8, 0x91, 0xa5, 0xf8, 0x61, 0x85,
+	0x5c, 0xc8, 0x5f, 0x29, 0x09, 0x37, 0x7f, 0xa5, 0x54, 0x88, 0x5a, 0x91, 0xb6, 0x1a, 0xc6, 0x2f,
+	0x57, 0xa8, 0x9f, 0x98, 0x06, 0x35, 0xd0, 0x7b, 0x0c, 0x4e, 0x9e, 0x8e, 0x2f, 0x93, 0x98, 0xeb,
+	0x29, 0x9e, 0xcf, 0x91, 0x73, 0x95, 0xb2, 0x41, 0x0d, 0xf4, 0x9e, 0xc0, 0xff, 0xfa, 0x49, 0xcc,
+	0x57, 0x11, 0xaa, 0xdd, 0xa6, 0x00, 0xa9, 0x1a, 0xfb, 0xe8, 0xcb, 0x73, 0xb3, 0x2d, 0x16, 0xcd,
+	0x09, 0xef, 0x1c, 0x0e, 0xca, 0x9b, 0xf2, 0x63, 0xb4, 0xb4, 0x5a, 0x69, 0x03, 0x65, 0x27, 0xb3,
+	0xbd, 0x53, 0x23, 0xb4, 0x45, 0x0b, 0x8c, 0xf7, 0x77, 0x05, 0xee, 0x8d, 0x42, 0x2e, 0xb4, 0xb0,
+	0x21, 0x72, 0x53, 0x49, 0xde, 0x83, 0x4a, 0xd7, 0xfa, 0x8c, 0x1e, 0xdc, 0xdc, 0x51, 0xf5, 0xaa,
+	0x1a, 0xff, 0x64, 0x6d, 0x6d, 0xd0, 0x22, 0x25, 0x7b, 0xf8, 0xe2, 0xcd, 0x1b, 0x8e, 0x42, 0xf5,
+	0xd0, 0xa6, 0x1a, 0xc9, 0x17, 0x7d, 0x14, 0x46, 0xa1, 0xd0, 0xfd, 0xcb, 0x80, 0xf7, 0x1a, 0x0e,
+	0x37, 0xcb, 0xd6, 0x5a, 0x7c, 0x0d, 0x90, 0xb3, 0xaa, 0xf6, 0xd6, 0x99, 0xb3, 0x59, 0x3b, 0x2d,
+	0xc4, 0xc8, 0x13, 0x66, 0x89, 0x60, 0x0b, 0x55, 0xb5, 0x4d, 0x33, 0xe0, 0x3d, 0x84, 0x3d, 0x8a,
+	0xf2, 0xa7, 0xcb, 0x08, 0x72, 0x00, 0xb6, 0x7c, 0x78, 0xb2, 0x9c, 0x4d, 0x9a, 0x01, 0xef, 0x4b,
+	0xd8, 0x37, 0x61, 0xba, 0x80, 0x03, 0xb0, 0xfb, 0xc9, 0x2a, 0x16, 0xaa, 0x15, 0x36, 0xcd, 0x80,
+	0x37, 0x86, 0xa3, 0xf3, 0x34, 0x91, 0xad, 0x2f, 0x8d, 0xc9, 0x5a, 0xee, 0xaf, 0xa0, 0x9e, 0x11,
+	0xba, 0xe4, 0x7b, 0x9b, 0x25, 0xab, 0x55, 0xaa, 0x83, 0xbc, 0x6f, 0xe0, 0xfe, 0x35, 0xe9, 0x6e,
+	0xaa, 0xe2, 0xd1, 0x02, 0xf6, 0xcb, 0xed, 0x23, 0x00, 0xf5, 0x97, 0x17, 0xfe, 0x85, 0x3f, 0x70,
+	0x6e, 0x91, 0x06, 0xd4, 0xa6, 0xfe, 0x64, 0xe6, 0x54, 0x48, 0x1b, 0x1a, 0xd4, 0x9f, 0xd1, 0x5f,
+	0x86, 0x93, 0x1f, 0x9d, 0xaa, 0x8c, 0x79, 0xde, 0x1b, 0x8e, 0xfc, 0x81, 0x63, 0x91, 0x3d, 0x68,
+	0x0e, 0xfc, 0xd1, 0xf0, 0x95, 0x4f, 0xfd, 0x81, 0x53, 0x23, 0x2d, 0xb8, 0xfd, 0xec, 0xc5, 0xc5,
+	0xa4, 0xef, 0x0f, 0x1c, 0x9b, 0xec, 0x03, 0xf4, 0x5f, 0x8c, 0xcf, 0x47, 0xbd, 0xe1, 0xc4, 0x1f,
+	0x38, 0xf5, 0xb3, 0x3f, 0x2c, 0xd8, 0x1b, 0xab, 0x5b, 0x4c, 0x31, 0xbd, 0x0a, 0xe7, 0x48, 0x9e,
+	0x42, 0xc3, 0xcc, 0x08, 0xf9, 0xbf, 0xb9, 0xe1, 0xc6, 0x10, 0x76, 0xdc, 0xed, 0x85, 0xec, 0x52,
+	0xde, 0x2d, 0xf2, 0x33, 0xb4, 0x8b, 0x13, 0x40, 0xbe, 0x30, 0xb1, 0x3b, 0x86, 0xa9, 0x73, 0xb4,
+	0x7b, 0x71, 0x9d, 0xec, 0x25, 0xec, 0x97, 0x4d, 0x44, 0xee, 0x9b, 0x1d, 0x3b, 0x67, 0xa2, 0x73,
+	0x7c, 0xdd, 0xf2, 0x3a, 0xe5, 0xf7, 0x50, 0xcf, 0xec, 0x40, 0xd6, 0x0d, 0x2c, 0xb9, 0xa8, 0x73,
+	0xb8, 0x49, 0xaf, 0xb7, 0xbe, 0x81, 0x7b, 0x3b, 0x5b, 0x4a, 0x1e, 0x98, 0x2d, 0x37, 0x19, 0xa8,
+	0xf3, 0xf0, 0x3f, 0xa2, 0xcc, 0x39, 0x97, 0x75, 0xf5, 0x3f, 0xe0, 0xc9, 0xbf, 0x03, 0x00, 0x9d,
+	0xf3, 0xb5, 0x21, 0x17, 0x0c, 0x00, 0x00,
 }
@@ -19,9 +19,18 @@ It is generated from these files:
 It has these top-level messages:
 	ActivitiesCollection
 	SubscriptionsCollection
+	ActivityFeedRequest
+	MailTemplatesRequest
+	MailTemplatesCollection
+	DeleteMailTemplateRequest
+	MailTemplatePreviewRequest
+	MailTemplatePreviewResponse
+	MailEventsRequest
 	LogCollection
 	LogMessageCollection
 	TimeRangeResultCollection
+	ChatSearchCollection
+	ChatExportRequest
 	DeleteResponse
 	Configuration
 	ListDataSourceRequest
@@ -57,6 +66,9 @@ It has these top-level messages:
 	DocstoreCollection
 	ChangeRequest
 	ChangeCollection
+	RecycleBinsRequest
+	RecycleBin
+	RecycleBinsCollection
 	FrontLogMessage
 	FrontLogResponse
 	SettingsMenuRequest
@@ -89,6 +101,17 @@ It has these top-level messages:
 	ResetPasswordTokenResponse
 	ResetPasswordRequest
 	ResetPasswordResponse
+	CreatePersonalTokenRequest
+	CreatePersonalTokenResponse
+	ListPersonalTokensRequest
+	PersonalTokenCollection
+	RevokePersonalTokenRequest
+	ImpersonateRequest
+	ImpersonateResponse
+	ListSessionsRequest
+	SessionCollection
+	RevokeSessionRequest
+	RevokeSessionsRequest
 	UserJobRequest
 	UserJobResponse
 	UserJobsCollection
@@ -113,7 +136,9 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import activity "github.com/pydio/cells/common/proto/activity"
+import chat "github.com/pydio/cells/common/proto/chat"
 import log "github.com/pydio/cells/common/proto/log"
+import mailer "github.com/pydio/cells/common/proto/mailer"
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -159,6 +184,218 @@ func (m *SubscriptionsCollection) GetSubscriptions() []*activity.Subscription {
 	return nil
 }
 
+// Request for an Atom or ActivityStreams feed of a folder or of a user outbox
+type ActivityFeedRequest struct {
+	// Either "node" (ContextData is a node Uuid) or "user" (ContextData is a user login)
+	Context     string `protobuf:"bytes,1,opt,name=Context" json:"Context,omitempty"`
+	ContextData string `protobuf:"bytes,2,opt,name=ContextData" json:"ContextData,omitempty"`
+	// Output format, "atom" (default) or "json" for ActivityStreams
+	Format   string `protobuf:"bytes,3,opt,name=Format" json:"Format,omitempty"`
+	Limit    int32  `protobuf:"varint,4,opt,name=Limit" json:"Limit,omitempty"`
+	Language string `protobuf:"bytes,5,opt,name=Language" json:"Language,omitempty"`
+	// Personal access token or JWT, for Atom feed readers that cannot send an Authorization or X-Pydio-Feed-Token header
+	Token string `protobuf:"bytes,6,opt,name=Token" json:"Token,omitempty"`
+}
+
+func (m *ActivityFeedRequest) Reset()                    { *m = ActivityFeedRequest{} }
+func (m *ActivityFeedRequest) String() string            { return proto.CompactTextString(m) }
+func (*ActivityFeedRequest) ProtoMessage()               {}
+func (*ActivityFeedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *ActivityFeedRequest) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *ActivityFeedRequest) GetContextData() string {
+	if m != nil {
+		return m.ContextData
+	}
+	return ""
+}
+
+func (m *ActivityFeedRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ActivityFeedRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ActivityFeedRequest) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *ActivityFeedRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// Request for listing mail templates, resolved for a given language
+type MailTemplatesRequest struct {
+	Language string `protobuf:"bytes,1,opt,name=Language" json:"Language,omitempty"`
+}
+
+func (m *MailTemplatesRequest) Reset()                    { *m = MailTemplatesRequest{} }
+func (m *MailTemplatesRequest) String() string            { return proto.CompactTextString(m) }
+func (*MailTemplatesRequest) ProtoMessage()               {}
+func (*MailTemplatesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *MailTemplatesRequest) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+// Collection of mail templates
+type MailTemplatesCollection struct {
+	Templates []*mailer.MailTemplate `protobuf:"bytes,1,rep,name=Templates" json:"Templates,omitempty"`
+}
+
+func (m *MailTemplatesCollection) Reset()                    { *m = MailTemplatesCollection{} }
+func (m *MailTemplatesCollection) String() string            { return proto.CompactTextString(m) }
+func (*MailTemplatesCollection) ProtoMessage()               {}
+func (*MailTemplatesCollection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *MailTemplatesCollection) GetTemplates() []*mailer.MailTemplate {
+	if m != nil {
+		return m.Templates
+	}
+	return nil
+}
+
+// Request for removing a custom mail template variant
+type DeleteMailTemplateRequest struct {
+	TemplateId string `protobuf:"bytes,1,opt,name=TemplateId" json:"TemplateId,omitempty"`
+	Language   string `protobuf:"bytes,2,opt,name=Language" json:"Language,omitempty"`
+}
+
+func (m *DeleteMailTemplateRequest) Reset()                    { *m = DeleteMailTemplateRequest{} }
+func (m *DeleteMailTemplateRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteMailTemplateRequest) ProtoMessage()               {}
+func (*DeleteMailTemplateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *DeleteMailTemplateRequest) GetTemplateId() string {
+	if m != nil {
+		return m.TemplateId
+	}
+	return ""
+}
+
+func (m *DeleteMailTemplateRequest) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+// Request for rendering a mail template, saved or not. Sample data is used for missing TemplateData keys.
+type MailTemplatePreviewRequest struct {
+	Template     *mailer.MailTemplate `protobuf:"bytes,1,opt,name=Template" json:"Template,omitempty"`
+	TemplateData map[string]string    `protobuf:"bytes,2,rep,name=TemplateData" json:"TemplateData,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *MailTemplatePreviewRequest) Reset()                    { *m = MailTemplatePreviewRequest{} }
+func (m *MailTemplatePreviewRequest) String() string            { return proto.CompactTextString(m) }
+func (*MailTemplatePreviewRequest) ProtoMessage()               {}
+func (*MailTemplatePreviewRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *MailTemplatePreviewRequest) GetTemplate() *mailer.MailTemplate {
+	if m != nil {
+		return m.Template
+	}
+	return nil
+}
+
+func (m *MailTemplatePreviewRequest) GetTemplateData() map[string]string {
+	if m != nil {
+		return m.TemplateData
+	}
+	return nil
+}
+
+// Rendered mail template
+type MailTemplatePreviewResponse struct {
+	Subject      string `protobuf:"bytes,1,opt,name=Subject" json:"Subject,omitempty"`
+	ContentHtml  string `protobuf:"bytes,2,opt,name=ContentHtml" json:"ContentHtml,omitempty"`
+	ContentPlain string `protobuf:"bytes,3,opt,name=ContentPlain" json:"ContentPlain,omitempty"`
+}
+
+func (m *MailTemplatePreviewResponse) Reset()                    { *m = MailTemplatePreviewResponse{} }
+func (m *MailTemplatePreviewResponse) String() string            { return proto.CompactTextString(m) }
+func (*MailTemplatePreviewResponse) ProtoMessage()               {}
+func (*MailTemplatePreviewResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *MailTemplatePreviewResponse) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *MailTemplatePreviewResponse) GetContentHtml() string {
+	if m != nil {
+		return m.ContentHtml
+	}
+	return ""
+}
+
+func (m *MailTemplatePreviewResponse) GetContentPlain() string {
+	if m != nil {
+		return m.ContentPlain
+	}
+	return ""
+}
+
+// Delivery events posted by a mail provider. The body format depends on the provider:
+// "generic" expects this message, "sendgrid" expects the payload of the sendgrid event webhook.
+type MailEventsRequest struct {
+	Provider string `protobuf:"bytes,1,opt,name=Provider" json:"Provider,omitempty"`
+	// Shared secret configured in the mailer REST service
+	Token  string                  `protobuf:"bytes,2,opt,name=Token" json:"Token,omitempty"`
+	Events []*mailer.DeliveryEvent `protobuf:"bytes,3,rep,name=Events" json:"Events,omitempty"`
+}
+
+func (m *MailEventsRequest) Reset()                    { *m = MailEventsRequest{} }
+func (m *MailEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*MailEventsRequest) ProtoMessage()               {}
+func (*MailEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *MailEventsRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *MailEventsRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *MailEventsRequest) GetEvents() []*mailer.DeliveryEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 // Collection of serialized log messages
 type LogCollection struct {
 	Lines []*log.Log `protobuf:"bytes,1,rep,name=lines" json:"lines,omitempty"`
@@ -167,7 +404,7 @@ type LogCollection struct {
 func (m *LogCollection) Reset()                    { *m = LogCollection{} }
 func (m *LogCollection) String() string            { return proto.CompactTextString(m) }
 func (*LogCollection) ProtoMessage()               {}
-func (*LogCollection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*LogCollection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *LogCollection) GetLines() []*log.Log {
 	if m != nil {
@@ -184,7 +421,7 @@ type LogMessageCollection struct {
 func (m *LogMessageCollection) Reset()                    { *m = LogMessageCollection{} }
 func (m *LogMessageCollection) String() string            { return proto.CompactTextString(m) }
 func (*LogMessageCollection) ProtoMessage()               {}
-func (*LogMessageCollection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*LogMessageCollection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *LogMessageCollection) GetLogs() []*log.LogMessage {
 	if m != nil {
@@ -203,7 +440,7 @@ type TimeRangeResultCollection struct {
 func (m *TimeRangeResultCollection) Reset()                    { *m = TimeRangeResultCollection{} }
 func (m *TimeRangeResultCollection) String() string            { return proto.CompactTextString(m) }
 func (*TimeRangeResultCollection) ProtoMessage()               {}
-func (*TimeRangeResultCollection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*TimeRangeResultCollection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *TimeRangeResultCollection) GetResults() []*log.TimeRangeResult {
 	if m != nil {
@@ -219,35 +456,122 @@ func (m *TimeRangeResultCollection) GetLinks() []*log.TimeRangeCursor {
 	return nil
 }
 
+// Messages found in the chat rooms visible by the current user, most recent first
+type ChatSearchCollection struct {
+	Results []*chat.SearchMessagesResponse `protobuf:"bytes,1,rep,name=Results" json:"Results,omitempty"`
+	Total   int32                          `protobuf:"varint,2,opt,name=Total" json:"Total,omitempty"`
+}
+
+func (m *ChatSearchCollection) Reset()                    { *m = ChatSearchCollection{} }
+func (m *ChatSearchCollection) String() string            { return proto.CompactTextString(m) }
+func (*ChatSearchCollection) ProtoMessage()               {}
+func (*ChatSearchCollection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *ChatSearchCollection) GetResults() []*chat.SearchMessagesResponse {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *ChatSearchCollection) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+// Request for exporting the transcript of a chat room
+type ChatExportRequest struct {
+	RoomUuid string `protobuf:"bytes,1,opt,name=RoomUuid" json:"RoomUuid,omitempty"`
+	// Output format, "json" (default) or "html" for a printable document
+	Format string `protobuf:"bytes,2,opt,name=Format" json:"Format,omitempty"`
+}
+
+func (m *ChatExportRequest) Reset()                    { *m = ChatExportRequest{} }
+func (m *ChatExportRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChatExportRequest) ProtoMessage()               {}
+func (*ChatExportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *ChatExportRequest) GetRoomUuid() string {
+	if m != nil {
+		return m.RoomUuid
+	}
+	return ""
+}
+
+func (m *ChatExportRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ActivitiesCollection)(nil), "rest.ActivitiesCollection")
 	proto.RegisterType((*SubscriptionsCollection)(nil), "rest.SubscriptionsCollection")
+	proto.RegisterType((*ActivityFeedRequest)(nil), "rest.ActivityFeedRequest")
+	proto.RegisterType((*MailTemplatesRequest)(nil), "rest.MailTemplatesRequest")
+	proto.RegisterType((*MailTemplatesCollection)(nil), "rest.MailTemplatesCollection")
+	proto.RegisterType((*DeleteMailTemplateRequest)(nil), "rest.DeleteMailTemplateRequest")
+	proto.RegisterType((*MailTemplatePreviewRequest)(nil), "rest.MailTemplatePreviewRequest")
+	proto.RegisterType((*MailTemplatePreviewResponse)(nil), "rest.MailTemplatePreviewResponse")
+	proto.RegisterType((*MailEventsRequest)(nil), "rest.MailEventsRequest")
 	proto.RegisterType((*LogCollection)(nil), "rest.LogCollection")
 	proto.RegisterType((*LogMessageCollection)(nil), "rest.LogMessageCollection")
 	proto.RegisterType((*TimeRangeResultCollection)(nil), "rest.TimeRangeResultCollection")
+	proto.RegisterType((*ChatSearchCollection)(nil), "rest.ChatSearchCollection")
+	proto.RegisterType((*ChatExportRequest)(nil), "rest.ChatExportRequest")
 }
 
 func init() { proto.RegisterFile("broker.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 295 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcf, 0x4b, 0xf3, 0x30,
-	0x18, 0xc7, 0x79, 0x5f, 0x37, 0x95, 0x47, 0x87, 0x32, 0x86, 0xce, 0x1d, 0x44, 0xea, 0x45, 0x3c,
-	0x24, 0xfe, 0x38, 0xea, 0x65, 0xec, 0xe2, 0xa1, 0x22, 0x44, 0xc1, 0x73, 0x1b, 0x1f, 0x62, 0x5c,
-	0xda, 0xa7, 0x24, 0xa9, 0xb2, 0xff, 0x5e, 0xda, 0xac, 0x35, 0x8a, 0x07, 0x0f, 0x81, 0xf0, 0x7d,
-	0x3e, 0xdf, 0x4f, 0x12, 0x02, 0xbb, 0xb9, 0xa5, 0x25, 0x5a, 0x56, 0x59, 0xf2, 0x34, 0x1e, 0x58,
-	0x74, 0x7e, 0x36, 0x57, 0xda, 0xbf, 0xd6, 0x39, 0x93, 0x54, 0xf0, 0x6a, 0xf5, 0xa2, 0x89, 0x4b,
-	0x34, 0xc6, 0x71, 0x49, 0x45, 0x41, 0x25, 0x6f, 0x51, 0x9e, 0x49, 0xaf, 0xdf, 0xb5, 0x5f, 0xf5,
-	0x1b, 0xe7, 0x2d, 0x66, 0x45, 0x10, 0xcd, 0x2e, 0xff, 0xa2, 0x30, 0xa4, 0x9a, 0x15, 0x2a, 0xc9,
-	0x1d, 0x4c, 0xe6, 0x41, 0xa5, 0xd1, 0x2d, 0xc8, 0x18, 0x94, 0x5e, 0x53, 0x39, 0xbe, 0x00, 0xc8,
-	0xfa, 0x7c, 0xfa, 0xef, 0x64, 0xe3, 0x6c, 0xe7, 0x6a, 0x9f, 0x75, 0xa7, 0xb2, 0x87, 0xfc, 0x0d,
-	0xa5, 0x17, 0x11, 0x93, 0x3c, 0xc3, 0xe1, 0x63, 0x9d, 0x3b, 0x69, 0x75, 0xd5, 0x18, 0x62, 0xd9,
-	0x2d, 0x8c, 0x5c, 0x3c, 0x5a, 0xfb, 0x0e, 0xbe, 0x7c, 0x71, 0x53, 0x7c, 0x87, 0x13, 0x0e, 0xa3,
-	0x94, 0x54, 0xa4, 0x3b, 0x86, 0xa1, 0xd1, 0x65, 0x7f, 0xad, 0x6d, 0xd6, 0x3c, 0x27, 0x25, 0x25,
-	0x42, 0x9c, 0xdc, 0xc0, 0x24, 0x25, 0x75, 0x8f, 0xce, 0x65, 0x0a, 0xa3, 0xde, 0x29, 0x0c, 0x52,
-	0x52, 0x5d, 0x6d, 0xaf, 0xab, 0xad, 0x41, 0xd1, 0x0e, 0x93, 0x0f, 0x38, 0x7a, 0xd2, 0x05, 0x8a,
-	0xac, 0x54, 0x28, 0xd0, 0xd5, 0xc6, 0x47, 0x06, 0x06, 0x5b, 0x21, 0xeb, 0x24, 0x93, 0x56, 0xf2,
-	0xa3, 0x20, 0x3a, 0x68, 0x7c, 0x0e, 0xc3, 0x54, 0x97, 0x4b, 0x37, 0xfd, 0xff, 0x1b, 0xbd, 0xa8,
-	0xad, 0x23, 0x2b, 0x02, 0x92, 0x6f, 0xb6, 0x1f, 0x72, 0xfd, 0x19, 0x00, 0x00, 0xff, 0xff, 0x9c,
-	0xda, 0xdc, 0x76, 0x1c, 0x02, 0x00, 0x00,
+	// 722 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x51, 0x4f, 0xe3, 0x46,
+	0x10, 0x96, 0x13, 0x12, 0x60, 0x00, 0x15, 0xdc, 0x14, 0x4c, 0x5a, 0xa1, 0xc8, 0x7d, 0x41, 0x95,
+	0xea, 0xd0, 0x20, 0xb5, 0x55, 0x5b, 0xa9, 0x42, 0x01, 0x8e, 0x93, 0x82, 0x0e, 0x2d, 0xdc, 0xf1,
+	0xbc, 0x71, 0x46, 0xce, 0x5e, 0x6c, 0x6f, 0x6e, 0x77, 0x1d, 0xc8, 0xc3, 0xfd, 0xa8, 0xfb, 0x55,
+	0xf7, 0x37, 0x4e, 0xb6, 0x77, 0x9d, 0x35, 0x07, 0x12, 0x0f, 0x10, 0xcf, 0xcc, 0xf7, 0x7d, 0x33,
+	0xbb, 0xfb, 0xed, 0xc2, 0xf6, 0x58, 0xf0, 0x19, 0x8a, 0x60, 0x2e, 0xb8, 0xe2, 0xee, 0x9a, 0x40,
+	0xa9, 0xba, 0x67, 0x11, 0x53, 0xd3, 0x6c, 0x1c, 0x84, 0x3c, 0xe9, 0xcf, 0x97, 0x13, 0xc6, 0xfb,
+	0x21, 0xc6, 0xb1, 0xec, 0x87, 0x3c, 0x49, 0x78, 0xda, 0x2f, 0xa0, 0x7d, 0x1a, 0x2a, 0xb6, 0x60,
+	0x6a, 0x59, 0x7d, 0x48, 0x25, 0x90, 0x26, 0xa5, 0x50, 0xf7, 0xf4, 0x35, 0x12, 0xe1, 0x94, 0xaa,
+	0xe2, 0x9f, 0x26, 0xfd, 0xf1, 0x1a, 0x52, 0xcc, 0xa3, 0xfc, 0x4f, 0x53, 0xfe, 0x7a, 0x0d, 0x25,
+	0xa1, 0x2c, 0x46, 0xa1, 0x7f, 0x4a, 0xa2, 0x7f, 0x05, 0x9d, 0xb3, 0x72, 0x70, 0x86, 0x72, 0xc8,
+	0xe3, 0x18, 0x43, 0xc5, 0x78, 0xea, 0x9e, 0x00, 0xd0, 0x2a, 0xef, 0x39, 0xbd, 0xe6, 0xf1, 0xd6,
+	0x60, 0x37, 0x30, 0x6b, 0x0c, 0xde, 0x8d, 0x3f, 0x62, 0xa8, 0x88, 0x85, 0xf1, 0xef, 0xe1, 0xe0,
+	0x36, 0x1b, 0xcb, 0x50, 0xb0, 0x79, 0xae, 0x60, 0x8b, 0xfd, 0x07, 0x3b, 0xd2, 0x2e, 0x69, 0xbd,
+	0xfd, 0x95, 0x9e, 0xcd, 0x24, 0x75, 0xb0, 0xff, 0xc5, 0x81, 0x1f, 0xf5, 0x8c, 0xcb, 0x4b, 0xc4,
+	0x09, 0xc1, 0x4f, 0x19, 0x4a, 0xe5, 0x7a, 0xb0, 0x3e, 0xe4, 0xa9, 0xc2, 0x47, 0xe5, 0x39, 0x3d,
+	0xe7, 0x78, 0x93, 0x98, 0xd0, 0xed, 0xc1, 0x96, 0xfe, 0x3c, 0xa7, 0x8a, 0x7a, 0x8d, 0xa2, 0x6a,
+	0xa7, 0xdc, 0x7d, 0x68, 0x5f, 0x72, 0x91, 0x50, 0xe5, 0x35, 0x8b, 0xa2, 0x8e, 0xdc, 0x0e, 0xb4,
+	0x46, 0x2c, 0x61, 0xca, 0x5b, 0xeb, 0x39, 0xc7, 0x2d, 0x52, 0x06, 0x6e, 0x17, 0x36, 0x46, 0x34,
+	0x8d, 0x32, 0x1a, 0xa1, 0xd7, 0x2a, 0xf0, 0x55, 0x9c, 0x33, 0xee, 0xf8, 0x0c, 0x53, 0xaf, 0x5d,
+	0x14, 0xca, 0xc0, 0x1f, 0x40, 0xe7, 0x9a, 0xb2, 0xf8, 0x0e, 0x93, 0x79, 0x4c, 0x15, 0x4a, 0x33,
+	0xb3, 0xad, 0xe4, 0xd4, 0x95, 0xfc, 0x6b, 0x38, 0xa8, 0x71, 0xac, 0x0d, 0x1c, 0xc0, 0x66, 0x95,
+	0xd6, 0x9b, 0xd7, 0x09, 0xf4, 0x39, 0xda, 0x1c, 0xb2, 0x82, 0xf9, 0xf7, 0x70, 0x78, 0x8e, 0x31,
+	0x2a, 0xac, 0x01, 0xf4, 0x1c, 0x47, 0x00, 0x26, 0xf5, 0x76, 0xa2, 0x27, 0xb1, 0x32, 0xb5, 0x39,
+	0x1b, 0x4f, 0xe6, 0xfc, 0xea, 0x40, 0xd7, 0xd6, 0xbc, 0x11, 0xb8, 0x60, 0xf8, 0x60, 0xa4, 0x4f,
+	0x60, 0xc3, 0x54, 0x0a, 0xe1, 0x97, 0x46, 0xad, 0x50, 0xee, 0x07, 0xd8, 0x36, 0xdf, 0xfa, 0xbc,
+	0xf2, 0x05, 0x0e, 0x82, 0xfc, 0x12, 0x06, 0x2f, 0x77, 0x0a, 0x6c, 0xd2, 0x45, 0xaa, 0xc4, 0x92,
+	0xd4, 0x74, 0xba, 0xff, 0xc3, 0xde, 0x77, 0x10, 0x77, 0x17, 0x9a, 0x33, 0x5c, 0xea, 0x25, 0xe7,
+	0x9f, 0xf9, 0x09, 0x2e, 0x68, 0x9c, 0x99, 0x85, 0x96, 0xc1, 0x3f, 0x8d, 0xbf, 0x1d, 0xff, 0x33,
+	0xfc, 0xfc, 0x6c, 0x7b, 0x39, 0xe7, 0xa9, 0xc4, 0xdc, 0x80, 0xb7, 0x59, 0x71, 0x11, 0x8c, 0x01,
+	0x75, 0x58, 0x19, 0x30, 0x55, 0x57, 0x2a, 0x89, 0x6b, 0x06, 0x2c, 0x53, 0xae, 0x0f, 0xdb, 0x3a,
+	0xbc, 0x89, 0x29, 0x4b, 0xb5, 0x0d, 0x6b, 0x39, 0x5f, 0xc1, 0x5e, 0xde, 0xfe, 0x62, 0x81, 0xa9,
+	0xb2, 0x1d, 0x74, 0x23, 0xf8, 0x82, 0x4d, 0x50, 0x18, 0x07, 0x99, 0x78, 0xe5, 0xc5, 0x86, 0xe5,
+	0x45, 0xf7, 0x77, 0x68, 0x97, 0x12, 0x5e, 0xb3, 0xd8, 0xd8, 0x9f, 0xcc, 0x71, 0x9c, 0x63, 0xcc,
+	0x16, 0x28, 0x96, 0x45, 0x95, 0x68, 0x90, 0xdf, 0x87, 0x9d, 0x11, 0x8f, 0x2c, 0xf3, 0x1d, 0x41,
+	0x2b, 0x66, 0x69, 0x65, 0xbc, 0x8d, 0x20, 0x7f, 0x76, 0x46, 0x3c, 0x22, 0x65, 0xda, 0xff, 0x17,
+	0x3a, 0x23, 0x1e, 0x5d, 0xa3, 0x94, 0x34, 0x42, 0x8b, 0xf7, 0x2b, 0xac, 0x8d, 0x78, 0x64, 0x68,
+	0x3f, 0x18, 0x9a, 0x06, 0x92, 0xa2, 0xe8, 0x3f, 0xc0, 0xe1, 0x1d, 0x4b, 0x90, 0xd0, 0x34, 0x42,
+	0x82, 0x32, 0x8b, 0x95, 0xa5, 0x10, 0xc0, 0x7a, 0x99, 0x5b, 0x99, 0x3e, 0x17, 0x79, 0x42, 0x20,
+	0x06, 0xe4, 0xfe, 0x96, 0xdf, 0xde, 0x74, 0x26, 0xbd, 0xc6, 0x73, 0xe8, 0x61, 0x26, 0x24, 0x17,
+	0xa4, 0x84, 0xf8, 0x13, 0xe8, 0x0c, 0xa7, 0x54, 0xdd, 0x22, 0x15, 0xe1, 0xd4, 0xea, 0xf9, 0xe7,
+	0xd3, 0x9e, 0xbf, 0x04, 0xc5, 0xd3, 0x5c, 0x02, 0xf5, 0xf0, 0xd2, 0x78, 0x60, 0xd5, 0xbb, 0xd8,
+	0x7b, 0x45, 0xcb, 0xc3, 0x6e, 0x91, 0x32, 0xf0, 0xdf, 0xc0, 0x5e, 0xde, 0xe5, 0xe2, 0x71, 0xce,
+	0x85, 0xb2, 0x8e, 0x90, 0x70, 0x9e, 0xbc, 0xcf, 0x98, 0xb9, 0x7a, 0x55, 0x6c, 0x3d, 0x4c, 0x0d,
+	0xfb, 0x61, 0x1a, 0xb7, 0x8b, 0xe7, 0xfa, 0xf4, 0xdb, 0x00, 0xfc, 0xd0, 0x7c, 0x40, 0xa8, 0x06,
+	0x00, 0x00,
 }
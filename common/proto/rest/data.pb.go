@@ -342,6 +342,111 @@ func (m *ChangeCollection) GetLastSeqId() int64 {
 	return 0
 }
 
+type RecycleBinsRequest struct {
+	// Restrict report to a given workspace
+	WorkspaceUuid string `protobuf:"bytes,1,opt,name=WorkspaceUuid" json:"WorkspaceUuid,omitempty"`
+}
+
+func (m *RecycleBinsRequest) Reset()                    { *m = RecycleBinsRequest{} }
+func (m *RecycleBinsRequest) String() string            { return proto.CompactTextString(m) }
+func (*RecycleBinsRequest) ProtoMessage()               {}
+func (*RecycleBinsRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{13} }
+
+func (m *RecycleBinsRequest) GetWorkspaceUuid() string {
+	if m != nil {
+		return m.WorkspaceUuid
+	}
+	return ""
+}
+
+type RecycleBin struct {
+	WorkspaceUuid  string `protobuf:"bytes,1,opt,name=WorkspaceUuid" json:"WorkspaceUuid,omitempty"`
+	WorkspaceLabel string `protobuf:"bytes,2,opt,name=WorkspaceLabel" json:"WorkspaceLabel,omitempty"`
+	Path           string `protobuf:"bytes,3,opt,name=Path" json:"Path,omitempty"`
+	RetentionDays  int32  `protobuf:"varint,4,opt,name=RetentionDays" json:"RetentionDays,omitempty"`
+	Size           int64  `protobuf:"varint,5,opt,name=Size" json:"Size,omitempty"`
+	EntriesCount   int32  `protobuf:"varint,6,opt,name=EntriesCount" json:"EntriesCount,omitempty"`
+	OldestDeletion int32  `protobuf:"varint,7,opt,name=OldestDeletion" json:"OldestDeletion,omitempty"`
+}
+
+func (m *RecycleBin) Reset()                    { *m = RecycleBin{} }
+func (m *RecycleBin) String() string            { return proto.CompactTextString(m) }
+func (*RecycleBin) ProtoMessage()               {}
+func (*RecycleBin) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{14} }
+
+func (m *RecycleBin) GetWorkspaceUuid() string {
+	if m != nil {
+		return m.WorkspaceUuid
+	}
+	return ""
+}
+
+func (m *RecycleBin) GetWorkspaceLabel() string {
+	if m != nil {
+		return m.WorkspaceLabel
+	}
+	return ""
+}
+
+func (m *RecycleBin) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *RecycleBin) GetRetentionDays() int32 {
+	if m != nil {
+		return m.RetentionDays
+	}
+	return 0
+}
+
+func (m *RecycleBin) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *RecycleBin) GetEntriesCount() int32 {
+	if m != nil {
+		return m.EntriesCount
+	}
+	return 0
+}
+
+func (m *RecycleBin) GetOldestDeletion() int32 {
+	if m != nil {
+		return m.OldestDeletion
+	}
+	return 0
+}
+
+type RecycleBinsCollection struct {
+	Bins      []*RecycleBin `protobuf:"bytes,1,rep,name=Bins" json:"Bins,omitempty"`
+	TotalSize int64         `protobuf:"varint,2,opt,name=TotalSize" json:"TotalSize,omitempty"`
+}
+
+func (m *RecycleBinsCollection) Reset()                    { *m = RecycleBinsCollection{} }
+func (m *RecycleBinsCollection) String() string            { return proto.CompactTextString(m) }
+func (*RecycleBinsCollection) ProtoMessage()               {}
+func (*RecycleBinsCollection) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{15} }
+
+func (m *RecycleBinsCollection) GetBins() []*RecycleBin {
+	if m != nil {
+		return m.Bins
+	}
+	return nil
+}
+
+func (m *RecycleBinsCollection) GetTotalSize() int64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SearchResults)(nil), "rest.SearchResults")
 	proto.RegisterType((*Metadata)(nil), "rest.Metadata")
@@ -356,49 +461,61 @@ func init() {
 	proto.RegisterType((*DocstoreCollection)(nil), "rest.DocstoreCollection")
 	proto.RegisterType((*ChangeRequest)(nil), "rest.ChangeRequest")
 	proto.RegisterType((*ChangeCollection)(nil), "rest.ChangeCollection")
+	proto.RegisterType((*RecycleBinsRequest)(nil), "rest.RecycleBinsRequest")
+	proto.RegisterType((*RecycleBin)(nil), "rest.RecycleBin")
+	proto.RegisterType((*RecycleBinsCollection)(nil), "rest.RecycleBinsCollection")
 }
 
 func init() { proto.RegisterFile("data.proto", fileDescriptor3) }
 
 var fileDescriptor3 = []byte{
-	// 623 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x6f, 0x13, 0x3d,
-	0x10, 0x56, 0x9a, 0xa6, 0x49, 0xa6, 0x6a, 0xdf, 0xc8, 0x6f, 0x0a, 0xab, 0xc0, 0x21, 0xb2, 0x50,
-	0x15, 0x55, 0x90, 0x48, 0x2d, 0x37, 0x2e, 0x40, 0x22, 0x21, 0xa0, 0x94, 0xe0, 0x00, 0x07, 0x10,
-	0x42, 0xee, 0xee, 0xb4, 0x59, 0xe1, 0xb5, 0x53, 0xdb, 0x8b, 0x14, 0x89, 0x1f, 0xc2, 0xcf, 0x45,
-	0xde, 0xb5, 0x77, 0xd3, 0x0f, 0x21, 0x2e, 0xed, 0x3e, 0xf3, 0xf1, 0xcc, 0x3c, 0xe3, 0x99, 0x00,
-	0x24, 0xdc, 0xf2, 0xf1, 0x4a, 0x2b, 0xab, 0xc8, 0xb6, 0x46, 0x63, 0x07, 0x27, 0x97, 0xa9, 0x5d,
-	0xe6, 0xe7, 0xe3, 0x58, 0x65, 0x93, 0xd5, 0x3a, 0x49, 0xd5, 0x24, 0x46, 0x21, 0xcc, 0x24, 0x56,
-	0x59, 0xa6, 0xe4, 0xa4, 0x08, 0x9d, 0x58, 0x8d, 0x58, 0xfc, 0x29, 0x53, 0x07, 0xcf, 0xfe, 0x25,
-	0x29, 0x51, 0xb1, 0xb1, 0x4a, 0x63, 0xf5, 0x51, 0x26, 0xd3, 0xb7, 0xb0, 0xb7, 0x40, 0xae, 0xe3,
-	0x25, 0x43, 0x93, 0x0b, 0x6b, 0xc8, 0x23, 0x68, 0xfb, 0xcf, 0xa8, 0x31, 0x6c, 0x8e, 0x76, 0x8f,
-	0x61, 0x5c, 0xd4, 0x3a, 0x53, 0x09, 0xb2, 0xe0, 0x22, 0x7d, 0x68, 0x7d, 0x54, 0x96, 0x8b, 0x68,
-	0x6b, 0xd8, 0x18, 0xb5, 0x58, 0x09, 0xe8, 0x0c, 0x3a, 0xef, 0xd0, 0x72, 0x27, 0x8b, 0x3c, 0x84,
-	0xee, 0x19, 0xcf, 0xd0, 0xac, 0x78, 0x8c, 0x51, 0x63, 0xd8, 0x18, 0x75, 0x59, 0x6d, 0x20, 0x03,
-	0xe8, 0xbc, 0x31, 0x4a, 0xba, 0xe8, 0x82, 0xa2, 0xcb, 0x2a, 0x4c, 0xbf, 0xc0, 0xbe, 0xfb, 0x3f,
-	0x55, 0x42, 0x60, 0x6c, 0x53, 0x25, 0x5d, 0xb4, 0x2b, 0x3f, 0xe7, 0x76, 0xe9, 0xa9, 0x2a, 0x4c,
-	0x1e, 0x43, 0x37, 0xd4, 0x34, 0xd1, 0x56, 0xd1, 0xf1, 0xfe, 0xd8, 0x0d, 0x73, 0x1c, 0xcc, 0xac,
-	0x0e, 0xa0, 0x73, 0xe8, 0x3b, 0x50, 0x35, 0xc2, 0xf0, 0x2a, 0x47, 0x63, 0xff, 0x5a, 0xe1, 0x9a,
-	0x12, 0x57, 0x61, 0x53, 0x09, 0xfd, 0xdd, 0x00, 0xf2, 0x0a, 0xed, 0xcb, 0x5c, 0xfc, 0x70, 0xcc,
-	0x81, 0xd0, 0x25, 0x79, 0x82, 0x72, 0x90, 0x2e, 0x29, 0x18, 0x82, 0xf7, 0x53, 0x9e, 0x26, 0xa6,
-	0xa2, 0x0c, 0x06, 0x72, 0x04, 0xbd, 0x17, 0x42, 0x38, 0xb6, 0xb9, 0x56, 0x3f, 0xd3, 0x04, 0xb5,
-	0x89, 0x9a, 0xc3, 0xc6, 0xa8, 0xc3, 0x6e, 0xd9, 0x5d, 0xe3, 0x9f, 0x51, 0x9b, 0x54, 0x49, 0x13,
-	0x6d, 0x17, 0x31, 0x15, 0xa6, 0x4f, 0xa1, 0x57, 0xb7, 0x65, 0x56, 0x4a, 0x1a, 0x24, 0x43, 0x68,
-	0xb9, 0x42, 0x77, 0x3d, 0x6e, 0xe9, 0xa0, 0xcf, 0x81, 0x2c, 0x6e, 0xeb, 0x39, 0x82, 0x96, 0x83,
-	0x21, 0xaf, 0x5f, 0x8f, 0xb8, 0x7e, 0x27, 0x56, 0x86, 0xd0, 0x14, 0x0e, 0x66, 0x28, 0xd0, 0xe2,
-	0x4d, 0x92, 0x39, 0x1c, 0xdc, 0x35, 0xfd, 0x40, 0x3a, 0xa8, 0x49, 0x6f, 0x86, 0xb0, 0xbb, 0x13,
-	0xe9, 0x37, 0xf8, 0xaf, 0xe8, 0x7a, 0x63, 0x59, 0x28, 0xec, 0xcc, 0xb9, 0x46, 0x69, 0x8b, 0x87,
-	0xbc, 0x2e, 0xd1, 0x7b, 0xc8, 0x21, 0x74, 0xa6, 0xcb, 0x54, 0x24, 0x1a, 0xa5, 0xdf, 0x99, 0xcd,
-	0xa8, 0xca, 0x47, 0x7f, 0xc1, 0xff, 0xa7, 0xa9, 0xb1, 0x33, 0x7f, 0x33, 0x41, 0x47, 0x04, 0xed,
-	0x85, 0xc3, 0xaf, 0x67, 0x7e, 0x59, 0x02, 0x24, 0x4f, 0xa0, 0xf5, 0x21, 0x47, 0xbd, 0x2e, 0x96,
-	0x7a, 0xf7, 0xf8, 0xfe, 0xb8, 0x3a, 0xb7, 0x99, 0x8a, 0xf3, 0x0c, 0xa5, 0x2d, 0xdc, 0xac, 0x8c,
-	0x72, 0x7b, 0x30, 0x55, 0xb9, 0xb4, 0xef, 0xa5, 0x58, 0xfb, 0x27, 0xae, 0x0d, 0x94, 0x01, 0x09,
-	0x95, 0x37, 0xf4, 0x1d, 0xc2, 0xb6, 0xb3, 0xfa, 0x99, 0x91, 0xdb, 0x15, 0x58, 0xe1, 0xbf, 0x7e,
-	0xa2, 0xcd, 0x70, 0xa2, 0x0a, 0xf6, 0xa6, 0x4b, 0x2e, 0x2f, 0x2b, 0x2d, 0x7d, 0x68, 0x2d, 0xf0,
-	0xca, 0x2b, 0x69, 0xb2, 0x12, 0x90, 0x7b, 0xb0, 0x73, 0x91, 0x0a, 0x8b, 0xda, 0x5f, 0xa7, 0x47,
-	0x4e, 0xf9, 0x85, 0xe0, 0xd6, 0xa2, 0xf4, 0xed, 0x06, 0xe8, 0x32, 0x8c, 0xd5, 0xc8, 0x33, 0xbf,
-	0x86, 0x1e, 0xd1, 0xaf, 0xd0, 0x2b, 0x0b, 0x6e, 0x48, 0x38, 0x82, 0x76, 0x69, 0x0b, 0x2a, 0x7a,
-	0xe5, 0xf4, 0x17, 0x6b, 0x19, 0xfb, 0xee, 0xda, 0x71, 0x19, 0x40, 0x1e, 0x40, 0xf7, 0x94, 0x1b,
-	0xeb, 0xda, 0x4a, 0xbc, 0x94, 0x8e, 0xe0, 0xc6, 0x7e, 0x37, 0x78, 0x75, 0xbe, 0x53, 0xfc, 0x88,
-	0x9d, 0xfc, 0x09, 0x00, 0x00, 0xff, 0xff, 0x66, 0x24, 0xf1, 0xd9, 0x4a, 0x05, 0x00, 0x00,
+	// 767 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x5b, 0x6f, 0x22, 0x37,
+	0x14, 0x16, 0x81, 0xe1, 0x72, 0xd2, 0xa4, 0xc8, 0x25, 0xed, 0x88, 0xf6, 0x01, 0x59, 0x51, 0x84,
+	0xa2, 0x16, 0xa4, 0xa4, 0x4f, 0xed, 0x4b, 0x1b, 0xa8, 0xaa, 0xb6, 0x69, 0x42, 0x4d, 0x2f, 0x52,
+	0xa3, 0xd5, 0xca, 0x99, 0x39, 0x09, 0xa3, 0x18, 0x9b, 0x8c, 0x3d, 0x2b, 0xb1, 0xda, 0x1f, 0xb2,
+	0x3f, 0x74, 0x7f, 0xc0, 0xca, 0x1e, 0xcf, 0x0c, 0x90, 0x68, 0x95, 0x17, 0xf0, 0xf9, 0xce, 0xf5,
+	0x3b, 0x17, 0x00, 0x88, 0xb9, 0xe1, 0xa3, 0x55, 0xaa, 0x8c, 0x22, 0x8d, 0x14, 0xb5, 0xe9, 0x9f,
+	0xdf, 0x27, 0x66, 0x91, 0xdd, 0x8e, 0x22, 0xb5, 0x1c, 0xaf, 0xd6, 0x71, 0xa2, 0xc6, 0x11, 0x0a,
+	0xa1, 0xc7, 0x91, 0x5a, 0x2e, 0x95, 0x1c, 0x3b, 0xd3, 0xb1, 0x49, 0x11, 0xdd, 0x47, 0xee, 0xda,
+	0xff, 0xf1, 0x25, 0x4e, 0xb1, 0x8a, 0xb4, 0x51, 0x29, 0x96, 0x8f, 0xdc, 0x99, 0xfe, 0x01, 0x07,
+	0x73, 0xe4, 0x69, 0xb4, 0x60, 0xa8, 0x33, 0x61, 0x34, 0x39, 0x86, 0x96, 0x7f, 0x86, 0xb5, 0x41,
+	0x7d, 0xb8, 0x7f, 0x06, 0x23, 0x97, 0xeb, 0x4a, 0xc5, 0xc8, 0x0a, 0x15, 0xe9, 0x41, 0xf0, 0xb7,
+	0x32, 0x5c, 0x84, 0x7b, 0x83, 0xda, 0x30, 0x60, 0xb9, 0x40, 0xa7, 0xd0, 0xfe, 0x13, 0x0d, 0xb7,
+	0xb4, 0xc8, 0x37, 0xd0, 0xb9, 0xe2, 0x4b, 0xd4, 0x2b, 0x1e, 0x61, 0x58, 0x1b, 0xd4, 0x86, 0x1d,
+	0x56, 0x01, 0xa4, 0x0f, 0xed, 0xdf, 0xb5, 0x92, 0xd6, 0xda, 0x85, 0xe8, 0xb0, 0x52, 0xa6, 0xff,
+	0xc3, 0xa1, 0xfd, 0x9e, 0x28, 0x21, 0x30, 0x32, 0x89, 0x92, 0xd6, 0xda, 0xa6, 0x9f, 0x71, 0xb3,
+	0xf0, 0xa1, 0x4a, 0x99, 0x7c, 0x0b, 0x9d, 0x22, 0xa7, 0x0e, 0xf7, 0x5c, 0xc5, 0x87, 0x23, 0xdb,
+	0xcc, 0x51, 0x01, 0xb3, 0xca, 0x80, 0xce, 0xa0, 0x67, 0x85, 0xb2, 0x10, 0x86, 0x8f, 0x19, 0x6a,
+	0xf3, 0xc9, 0x0c, 0x5b, 0x4c, 0x6c, 0x86, 0x4d, 0x26, 0xf4, 0x7d, 0x0d, 0xc8, 0xaf, 0x68, 0x2e,
+	0x32, 0xf1, 0x60, 0x23, 0x17, 0x01, 0xad, 0x93, 0x0f, 0x90, 0x37, 0xb2, 0xc3, 0x2a, 0xa0, 0xd0,
+	0xfe, 0x93, 0x25, 0xb1, 0x2e, 0x43, 0x16, 0x00, 0x39, 0x85, 0xee, 0xcf, 0x42, 0xd8, 0x68, 0xb3,
+	0x54, 0xbd, 0x49, 0x62, 0x4c, 0x75, 0x58, 0x1f, 0xd4, 0x86, 0x6d, 0xf6, 0x04, 0xb7, 0x85, 0xff,
+	0x8b, 0xa9, 0x4e, 0x94, 0xd4, 0x61, 0xc3, 0xd9, 0x94, 0x32, 0xfd, 0x1e, 0xba, 0x55, 0x59, 0x7a,
+	0xa5, 0xa4, 0x46, 0x32, 0x80, 0xc0, 0x26, 0x7a, 0x6e, 0xb8, 0xb9, 0x82, 0xfe, 0x04, 0x64, 0xfe,
+	0x94, 0xcf, 0x29, 0x04, 0x56, 0x2c, 0xfc, 0x7a, 0x55, 0x8b, 0xab, 0x39, 0xb1, 0xdc, 0x84, 0x26,
+	0x70, 0x34, 0x45, 0x81, 0x06, 0x77, 0x83, 0xcc, 0xe0, 0xe8, 0xb9, 0xee, 0x17, 0x41, 0xfb, 0x55,
+	0xd0, 0x5d, 0x13, 0xf6, 0xbc, 0x23, 0x7d, 0x05, 0x9f, 0xbb, 0xaa, 0x37, 0x96, 0x85, 0x42, 0x73,
+	0xc6, 0x53, 0x94, 0xc6, 0x0d, 0x72, 0x9b, 0xa2, 0xd7, 0x90, 0x13, 0x68, 0x4f, 0x16, 0x89, 0x88,
+	0x53, 0x94, 0x7e, 0x67, 0x36, 0xad, 0x4a, 0x1d, 0x7d, 0x07, 0x5f, 0x5c, 0x26, 0xda, 0x4c, 0xfd,
+	0xcd, 0x14, 0x3c, 0x42, 0x68, 0xcd, 0xad, 0xfc, 0xdb, 0xd4, 0x2f, 0x4b, 0x21, 0x92, 0xef, 0x20,
+	0xf8, 0x2b, 0xc3, 0x74, 0xed, 0x96, 0x7a, 0xff, 0xec, 0xab, 0x51, 0x79, 0x6e, 0x53, 0x15, 0x65,
+	0x4b, 0x94, 0xc6, 0xa9, 0x59, 0x6e, 0x65, 0xf7, 0x60, 0xa2, 0x32, 0x69, 0xae, 0xa5, 0x58, 0xfb,
+	0x11, 0x57, 0x00, 0x65, 0x40, 0x8a, 0xcc, 0x1b, 0xfc, 0x4e, 0xa0, 0x61, 0x51, 0xdf, 0x33, 0xf2,
+	0x34, 0x03, 0x73, 0xfa, 0xed, 0x13, 0xad, 0x17, 0x27, 0xaa, 0xe0, 0x60, 0xb2, 0xe0, 0xf2, 0xbe,
+	0xe4, 0xd2, 0x83, 0x60, 0x8e, 0x8f, 0x9e, 0x49, 0x9d, 0xe5, 0x02, 0xf9, 0x12, 0x9a, 0x77, 0x89,
+	0x30, 0x98, 0xfa, 0xeb, 0xf4, 0x92, 0x65, 0x7e, 0x27, 0xb8, 0x31, 0x28, 0x7d, 0xb9, 0x85, 0x68,
+	0x3d, 0xb4, 0x49, 0x91, 0x2f, 0xfd, 0x1a, 0x7a, 0x89, 0xde, 0x40, 0x37, 0x4f, 0xb8, 0x41, 0xe1,
+	0x14, 0x5a, 0x39, 0x56, 0xb0, 0xe8, 0xe6, 0xdd, 0x9f, 0xaf, 0x65, 0xe4, 0xab, 0x6b, 0x45, 0xb9,
+	0x01, 0xf9, 0x1a, 0x3a, 0x97, 0x5c, 0x1b, 0x5b, 0x56, 0xec, 0xa9, 0xb4, 0x05, 0xd7, 0xe6, 0xb5,
+	0xc6, 0x47, 0xfa, 0x03, 0x10, 0x86, 0xd1, 0x3a, 0x12, 0x78, 0x91, 0x48, 0x5d, 0x50, 0x3a, 0x86,
+	0x83, 0xff, 0x54, 0xfa, 0xe0, 0x36, 0xc5, 0x5e, 0x94, 0x1f, 0xd2, 0x36, 0x48, 0x3f, 0xd4, 0x00,
+	0x2a, 0xe7, 0x97, 0x39, 0x91, 0x13, 0x38, 0x2c, 0x81, 0x4b, 0x7e, 0x8b, 0xc2, 0xf7, 0x67, 0x07,
+	0x25, 0x04, 0x1a, 0xee, 0xb7, 0xa4, 0xee, 0xb4, 0xee, 0x6d, 0x33, 0x30, 0x34, 0x28, 0x6d, 0x0b,
+	0xa6, 0x7c, 0x9d, 0xdf, 0x6b, 0xc0, 0xb6, 0x41, 0xeb, 0x39, 0x4f, 0xde, 0x62, 0x18, 0x38, 0xaa,
+	0xee, 0x4d, 0x28, 0x7c, 0xf6, 0x8b, 0x34, 0x69, 0x62, 0xf7, 0x3c, 0x93, 0x26, 0x6c, 0x3a, 0xc7,
+	0x2d, 0xcc, 0x56, 0x76, 0x2d, 0x62, 0xd4, 0xc6, 0x9d, 0x5e, 0xa2, 0x64, 0xd8, 0x72, 0x56, 0x3b,
+	0x28, 0xbd, 0x81, 0xa3, 0x8d, 0x96, 0x6d, 0x0c, 0xe5, 0x18, 0x1a, 0x16, 0x29, 0x27, 0xe2, 0x6e,
+	0xb1, 0x32, 0x65, 0x4e, 0x6b, 0x37, 0xd6, 0x2d, 0x92, 0xab, 0x31, 0x1f, 0x47, 0x05, 0xdc, 0x36,
+	0xdd, 0x9f, 0xca, 0xf9, 0xc7, 0x01, 0x00, 0x26, 0x6e, 0x23, 0xf3, 0xda, 0x06, 0x00, 0x00,
 }
@@ -32,6 +32,10 @@ func (m *UserStateRequest) GetSegment() string {
 type UserStateResponse struct {
 	Workspaces         []*idm.Workspace  `protobuf:"bytes,1,rep,name=Workspaces" json:"Workspaces,omitempty"`
 	WorkspacesAccesses map[string]string `protobuf:"bytes,2,rep,name=WorkspacesAccesses" json:"WorkspacesAccesses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Set when an admin is currently logged in as this user
+	Impersonator           string `protobuf:"bytes,3,opt,name=Impersonator" json:"Impersonator,omitempty"`
+	ImpersonationExpiresAt int32  `protobuf:"varint,4,opt,name=ImpersonationExpiresAt" json:"ImpersonationExpiresAt,omitempty"`
+	ReadOnly               bool   `protobuf:"varint,5,opt,name=ReadOnly" json:"ReadOnly,omitempty"`
 }
 
 func (m *UserStateResponse) Reset()                    { *m = UserStateResponse{} }
@@ -53,6 +57,27 @@ func (m *UserStateResponse) GetWorkspacesAccesses() map[string]string {
 	return nil
 }
 
+func (m *UserStateResponse) GetImpersonator() string {
+	if m != nil {
+		return m.Impersonator
+	}
+	return ""
+}
+
+func (m *UserStateResponse) GetImpersonationExpiresAt() int32 {
+	if m != nil {
+		return m.ImpersonationExpiresAt
+	}
+	return 0
+}
+
+func (m *UserStateResponse) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
 type RelationRequest struct {
 	UserId string `protobuf:"bytes,1,opt,name=UserId" json:"UserId,omitempty"`
 }
@@ -103,25 +128,28 @@ func init() {
 func init() { proto.RegisterFile("graph.proto", fileDescriptor5) }
 
 var fileDescriptor5 = []byte{
-	// 310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x41, 0x4f, 0xc2, 0x40,
-	0x10, 0x85, 0x53, 0x50, 0x0c, 0x43, 0x82, 0xb8, 0x31, 0xda, 0x70, 0x22, 0x3d, 0x61, 0x62, 0xb6,
-	0xa2, 0x17, 0xe3, 0x4d, 0x0d, 0x07, 0xaf, 0x0b, 0xc6, 0xa3, 0x59, 0xda, 0x49, 0x69, 0x68, 0x3b,
-	0x75, 0x67, 0xd1, 0xf0, 0x9b, 0xfd, 0x13, 0xa6, 0x4b, 0x11, 0x22, 0x72, 0x68, 0xd2, 0x37, 0xf9,
-	0xde, 0xcc, 0xce, 0x1b, 0xe8, 0x24, 0x46, 0x97, 0x73, 0x59, 0x1a, 0xb2, 0x24, 0x8e, 0x0c, 0xb2,
-	0xed, 0x8f, 0x92, 0xd4, 0xce, 0x97, 0x33, 0x19, 0x51, 0x1e, 0x96, 0xab, 0x38, 0xa5, 0x30, 0xc2,
-	0x2c, 0xe3, 0x30, 0xa2, 0x3c, 0xa7, 0x22, 0x74, 0x68, 0x98, 0xc6, 0x79, 0xf5, 0xad, 0x8d, 0xc1,
-	0x35, 0xf4, 0x5e, 0x19, 0xcd, 0xc4, 0x6a, 0x8b, 0x0a, 0x3f, 0x96, 0xc8, 0x56, 0xf8, 0x70, 0x32,
-	0xc1, 0x24, 0xc7, 0xc2, 0xfa, 0xde, 0xc0, 0x1b, 0xb6, 0xd5, 0x46, 0x06, 0xdf, 0x1e, 0x9c, 0xed,
-	0xe0, 0x5c, 0x52, 0xc1, 0x28, 0x24, 0xc0, 0x1b, 0x99, 0x05, 0x97, 0x3a, 0x42, 0xf6, 0xbd, 0x41,
-	0x73, 0xd8, 0xb9, 0xed, 0xca, 0x6a, 0xc6, 0x6f, 0x59, 0xed, 0x10, 0xe2, 0x1d, 0xc4, 0x56, 0x3d,
-	0x46, 0x11, 0x32, 0x23, 0xfb, 0x0d, 0xe7, 0x0b, 0x65, 0xb5, 0x89, 0xdc, 0x1b, 0x22, 0xf7, 0x1d,
-	0xe3, 0xc2, 0x9a, 0x95, 0xfa, 0xa7, 0x55, 0x7f, 0x0c, 0x97, 0x07, 0x70, 0xd1, 0x83, 0xe6, 0x02,
-	0x57, 0xf5, 0x5e, 0xd5, 0xaf, 0x38, 0x87, 0xe3, 0x4f, 0x9d, 0x2d, 0xd1, 0x6f, 0xb8, 0xda, 0x5a,
-	0x3c, 0x34, 0xee, 0xbd, 0xe0, 0x0a, 0x4e, 0x15, 0x66, 0xda, 0xa6, 0x54, 0x6c, 0xa2, 0xb9, 0x80,
-	0x56, 0xf5, 0xb4, 0x97, 0xb8, 0xee, 0x50, 0xab, 0xe0, 0x0b, 0x7a, 0x5b, 0xb4, 0x8e, 0xe5, 0x06,
-	0x3a, 0x93, 0xb9, 0x36, 0x18, 0x3f, 0x57, 0x47, 0x38, 0x90, 0xcb, 0x2e, 0x22, 0x46, 0xd0, 0x7d,
-	0xc2, 0x8c, 0x8a, 0x84, 0xa7, 0x34, 0x45, 0x9d, 0xb3, 0xdf, 0x74, 0xa6, 0xb6, 0x33, 0x29, 0xca,
-	0x50, 0xfd, 0x01, 0x66, 0x2d, 0x77, 0xc6, 0xbb, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x49, 0xb3,
-	0x35, 0xa3, 0x0e, 0x02, 0x00, 0x00,
+	// 366 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x4d, 0x8f, 0xda, 0x30,
+	0x10, 0x55, 0x12, 0xa0, 0x30, 0x54, 0x94, 0x5a, 0x15, 0xb5, 0x38, 0x45, 0x39, 0xa5, 0x52, 0x95,
+	0x94, 0x56, 0xaa, 0xaa, 0xde, 0x68, 0xc5, 0x81, 0x53, 0x25, 0x43, 0xd5, 0x63, 0x65, 0x92, 0x51,
+	0x88, 0x48, 0xec, 0xd4, 0x36, 0xdd, 0xcd, 0xef, 0xdd, 0x3f, 0xb2, 0x8a, 0x09, 0x1f, 0xbb, 0x2c,
+	0x87, 0x48, 0x79, 0xe3, 0xf7, 0xe6, 0x79, 0xe6, 0x19, 0x86, 0x99, 0xe2, 0xd5, 0x36, 0xaa, 0x94,
+	0x34, 0x92, 0x74, 0x14, 0x6a, 0x33, 0x9d, 0x65, 0xb9, 0xd9, 0xee, 0x37, 0x51, 0x22, 0xcb, 0xb8,
+	0xaa, 0xd3, 0x5c, 0xc6, 0x09, 0x16, 0x85, 0x8e, 0x13, 0x59, 0x96, 0x52, 0xc4, 0x96, 0x1a, 0xe7,
+	0x69, 0xd9, 0x7c, 0x07, 0x61, 0xf0, 0x11, 0xc6, 0xbf, 0x35, 0xaa, 0x95, 0xe1, 0x06, 0x19, 0xfe,
+	0xdb, 0xa3, 0x36, 0x84, 0xc2, 0xab, 0x15, 0x66, 0x25, 0x0a, 0x43, 0x1d, 0xdf, 0x09, 0x07, 0xec,
+	0x08, 0x83, 0x07, 0x17, 0xde, 0x5e, 0xd0, 0x75, 0x25, 0x85, 0x46, 0x12, 0x01, 0xfc, 0x91, 0x6a,
+	0xa7, 0x2b, 0x9e, 0xa0, 0xa6, 0x8e, 0xef, 0x85, 0xc3, 0xcf, 0xa3, 0xa8, 0xf1, 0x38, 0x95, 0xd9,
+	0x05, 0x83, 0xfc, 0x05, 0x72, 0x46, 0xf3, 0x24, 0x41, 0xad, 0x51, 0x53, 0xd7, 0xea, 0xe2, 0xa8,
+	0x99, 0x24, 0xba, 0x32, 0x89, 0xae, 0x15, 0x0b, 0x61, 0x54, 0xcd, 0x5e, 0x68, 0x45, 0x02, 0x78,
+	0xbd, 0x2c, 0x2b, 0x54, 0x5a, 0x0a, 0x6e, 0xa4, 0xa2, 0x9e, 0x9d, 0xe2, 0x49, 0x8d, 0x7c, 0x85,
+	0xc9, 0x19, 0xe7, 0x52, 0x2c, 0xee, 0xab, 0x5c, 0xa1, 0x9e, 0x1b, 0xda, 0xf1, 0x9d, 0xb0, 0xcb,
+	0x6e, 0x9c, 0x92, 0x29, 0xf4, 0x19, 0xf2, 0xf4, 0x97, 0x28, 0x6a, 0xda, 0xf5, 0x9d, 0xb0, 0xcf,
+	0x4e, 0x78, 0xba, 0x80, 0xf7, 0x37, 0xae, 0x49, 0xc6, 0xe0, 0xed, 0xb0, 0x6e, 0xf7, 0xd9, 0xfc,
+	0x92, 0x77, 0xd0, 0xfd, 0xcf, 0x8b, 0x3d, 0x52, 0xd7, 0xd6, 0x0e, 0xe0, 0xbb, 0xfb, 0xcd, 0x09,
+	0x3e, 0xc0, 0x1b, 0x86, 0x85, 0xf5, 0x3d, 0x46, 0x32, 0x81, 0x5e, 0xb3, 0x92, 0x65, 0xda, 0x76,
+	0x68, 0x51, 0x70, 0x07, 0xe3, 0x33, 0xb5, 0x8d, 0xe3, 0x13, 0x0c, 0x57, 0x5b, 0xae, 0x30, 0xfd,
+	0xd9, 0x84, 0x7f, 0x23, 0x8f, 0x4b, 0x0a, 0x99, 0xc1, 0xe8, 0x07, 0x16, 0x52, 0x64, 0x7a, 0x2d,
+	0xd7, 0xc8, 0x4b, 0x4d, 0x3d, 0x2b, 0x1a, 0x58, 0x11, 0x93, 0x05, 0xb2, 0x67, 0x84, 0x4d, 0xcf,
+	0x3e, 0x9f, 0x2f, 0x8f, 0x03, 0x00, 0xc6, 0xac, 0x01, 0x2f, 0x86, 0x02, 0x00, 0x00,
 }
@@ -8,6 +8,7 @@ import fmt "fmt"
 import math "math"
 import idm "github.com/pydio/cells/common/proto/idm"
 import service "github.com/pydio/cells/common/service/proto"
+import auth1 "github.com/pydio/cells/common/proto/auth"
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -618,6 +619,256 @@ func (m *ResetPasswordResponse) GetMessage() string {
 	return ""
 }
 
+// Request to create a personal access token for the current user
+type CreatePersonalTokenRequest struct {
+	Label     string   `protobuf:"bytes,1,opt,name=Label" json:"Label,omitempty"`
+	ExpiresAt int32    `protobuf:"varint,2,opt,name=ExpiresAt" json:"ExpiresAt,omitempty"`
+	Scopes    []string `protobuf:"bytes,3,rep,name=Scopes" json:"Scopes,omitempty"`
+}
+
+func (m *CreatePersonalTokenRequest) Reset()                    { *m = CreatePersonalTokenRequest{} }
+func (m *CreatePersonalTokenRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreatePersonalTokenRequest) ProtoMessage()               {}
+func (*CreatePersonalTokenRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{19} }
+
+func (m *CreatePersonalTokenRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *CreatePersonalTokenRequest) GetExpiresAt() int32 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *CreatePersonalTokenRequest) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+// Rest response, the AccessToken value cannot be retrieved later on
+type CreatePersonalTokenResponse struct {
+	Token       *auth1.PersonalToken `protobuf:"bytes,1,opt,name=Token" json:"Token,omitempty"`
+	AccessToken string               `protobuf:"bytes,2,opt,name=AccessToken" json:"AccessToken,omitempty"`
+}
+
+func (m *CreatePersonalTokenResponse) Reset()                    { *m = CreatePersonalTokenResponse{} }
+func (m *CreatePersonalTokenResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreatePersonalTokenResponse) ProtoMessage()               {}
+func (*CreatePersonalTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{20} }
+
+func (m *CreatePersonalTokenResponse) GetToken() *auth1.PersonalToken {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *CreatePersonalTokenResponse) GetAccessToken() string {
+	if m != nil {
+		return m.AccessToken
+	}
+	return ""
+}
+
+// List tokens of the current user, admins can pass another user login
+type ListPersonalTokensRequest struct {
+	UserLogin string `protobuf:"bytes,1,opt,name=UserLogin" json:"UserLogin,omitempty"`
+}
+
+func (m *ListPersonalTokensRequest) Reset()                    { *m = ListPersonalTokensRequest{} }
+func (m *ListPersonalTokensRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListPersonalTokensRequest) ProtoMessage()               {}
+func (*ListPersonalTokensRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{21} }
+
+func (m *ListPersonalTokensRequest) GetUserLogin() string {
+	if m != nil {
+		return m.UserLogin
+	}
+	return ""
+}
+
+type PersonalTokenCollection struct {
+	Tokens []*auth1.PersonalToken `protobuf:"bytes,1,rep,name=Tokens" json:"Tokens,omitempty"`
+}
+
+func (m *PersonalTokenCollection) Reset()                    { *m = PersonalTokenCollection{} }
+func (m *PersonalTokenCollection) String() string            { return proto.CompactTextString(m) }
+func (*PersonalTokenCollection) ProtoMessage()               {}
+func (*PersonalTokenCollection) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{22} }
+
+func (m *PersonalTokenCollection) GetTokens() []*auth1.PersonalToken {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+type RevokePersonalTokenRequest struct {
+	Uuid string `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+}
+
+func (m *RevokePersonalTokenRequest) Reset()                    { *m = RevokePersonalTokenRequest{} }
+func (m *RevokePersonalTokenRequest) String() string            { return proto.CompactTextString(m) }
+func (*RevokePersonalTokenRequest) ProtoMessage()               {}
+func (*RevokePersonalTokenRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{23} }
+
+func (m *RevokePersonalTokenRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+// Request to log in as another user, for admins only
+type ImpersonateRequest struct {
+	UserLogin string `protobuf:"bytes,1,opt,name=UserLogin" json:"UserLogin,omitempty"`
+	// Session duration in seconds, defaults to 30 minutes
+	Duration int32 `protobuf:"varint,2,opt,name=Duration" json:"Duration,omitempty"`
+	// Sessions are read-only unless explicitly allowed
+	AllowWrite bool `protobuf:"varint,3,opt,name=AllowWrite" json:"AllowWrite,omitempty"`
+	// Justification recorded in the audit log and visible to the user
+	Reason string `protobuf:"bytes,4,opt,name=Reason" json:"Reason,omitempty"`
+}
+
+func (m *ImpersonateRequest) Reset()                    { *m = ImpersonateRequest{} }
+func (m *ImpersonateRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImpersonateRequest) ProtoMessage()               {}
+func (*ImpersonateRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{24} }
+
+func (m *ImpersonateRequest) GetUserLogin() string {
+	if m != nil {
+		return m.UserLogin
+	}
+	return ""
+}
+
+func (m *ImpersonateRequest) GetDuration() int32 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *ImpersonateRequest) GetAllowWrite() bool {
+	if m != nil {
+		return m.AllowWrite
+	}
+	return false
+}
+
+func (m *ImpersonateRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type ImpersonateResponse struct {
+	Token       *auth1.PersonalToken `protobuf:"bytes,1,opt,name=Token" json:"Token,omitempty"`
+	AccessToken string               `protobuf:"bytes,2,opt,name=AccessToken" json:"AccessToken,omitempty"`
+}
+
+func (m *ImpersonateResponse) Reset()                    { *m = ImpersonateResponse{} }
+func (m *ImpersonateResponse) String() string            { return proto.CompactTextString(m) }
+func (*ImpersonateResponse) ProtoMessage()               {}
+func (*ImpersonateResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{25} }
+
+func (m *ImpersonateResponse) GetToken() *auth1.PersonalToken {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *ImpersonateResponse) GetAccessToken() string {
+	if m != nil {
+		return m.AccessToken
+	}
+	return ""
+}
+
+// List sessions of the current user, admins can pass another user login
+type ListSessionsRequest struct {
+	UserLogin string `protobuf:"bytes,1,opt,name=UserLogin" json:"UserLogin,omitempty"`
+}
+
+func (m *ListSessionsRequest) Reset()                    { *m = ListSessionsRequest{} }
+func (m *ListSessionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListSessionsRequest) ProtoMessage()               {}
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{26} }
+
+func (m *ListSessionsRequest) GetUserLogin() string {
+	if m != nil {
+		return m.UserLogin
+	}
+	return ""
+}
+
+type SessionCollection struct {
+	Sessions []*auth1.Session `protobuf:"bytes,1,rep,name=Sessions" json:"Sessions,omitempty"`
+}
+
+func (m *SessionCollection) Reset()                    { *m = SessionCollection{} }
+func (m *SessionCollection) String() string            { return proto.CompactTextString(m) }
+func (*SessionCollection) ProtoMessage()               {}
+func (*SessionCollection) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{27} }
+
+func (m *SessionCollection) GetSessions() []*auth1.Session {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	Uuid string `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+}
+
+func (m *RevokeSessionRequest) Reset()                    { *m = RevokeSessionRequest{} }
+func (m *RevokeSessionRequest) String() string            { return proto.CompactTextString(m) }
+func (*RevokeSessionRequest) ProtoMessage()               {}
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{28} }
+
+func (m *RevokeSessionRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+// Revoke all sessions of a user, typically after a password change
+type RevokeSessionsRequest struct {
+	UserLogin string `protobuf:"bytes,1,opt,name=UserLogin" json:"UserLogin,omitempty"`
+	// Keep the session sending this request, to log out everywhere else
+	KeepCurrent bool `protobuf:"varint,2,opt,name=KeepCurrent" json:"KeepCurrent,omitempty"`
+}
+
+func (m *RevokeSessionsRequest) Reset()                    { *m = RevokeSessionsRequest{} }
+func (m *RevokeSessionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*RevokeSessionsRequest) ProtoMessage()               {}
+func (*RevokeSessionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{29} }
+
+func (m *RevokeSessionsRequest) GetUserLogin() string {
+	if m != nil {
+		return m.UserLogin
+	}
+	return ""
+}
+
+func (m *RevokeSessionsRequest) GetKeepCurrent() bool {
+	if m != nil {
+		return m.KeepCurrent
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*ResourcePolicyQuery)(nil), "rest.ResourcePolicyQuery")
 	proto.RegisterType((*SearchRoleRequest)(nil), "rest.SearchRoleRequest")
@@ -638,59 +889,87 @@ func init() {
 	proto.RegisterType((*ResetPasswordTokenResponse)(nil), "rest.ResetPasswordTokenResponse")
 	proto.RegisterType((*ResetPasswordRequest)(nil), "rest.ResetPasswordRequest")
 	proto.RegisterType((*ResetPasswordResponse)(nil), "rest.ResetPasswordResponse")
+	proto.RegisterType((*CreatePersonalTokenRequest)(nil), "rest.CreatePersonalTokenRequest")
+	proto.RegisterType((*CreatePersonalTokenResponse)(nil), "rest.CreatePersonalTokenResponse")
+	proto.RegisterType((*ListPersonalTokensRequest)(nil), "rest.ListPersonalTokensRequest")
+	proto.RegisterType((*PersonalTokenCollection)(nil), "rest.PersonalTokenCollection")
+	proto.RegisterType((*RevokePersonalTokenRequest)(nil), "rest.RevokePersonalTokenRequest")
+	proto.RegisterType((*ImpersonateRequest)(nil), "rest.ImpersonateRequest")
+	proto.RegisterType((*ImpersonateResponse)(nil), "rest.ImpersonateResponse")
+	proto.RegisterType((*ListSessionsRequest)(nil), "rest.ListSessionsRequest")
+	proto.RegisterType((*SessionCollection)(nil), "rest.SessionCollection")
+	proto.RegisterType((*RevokeSessionRequest)(nil), "rest.RevokeSessionRequest")
+	proto.RegisterType((*RevokeSessionsRequest)(nil), "rest.RevokeSessionsRequest")
 	proto.RegisterEnum("rest.ResourcePolicyQuery_QueryType", ResourcePolicyQuery_QueryType_name, ResourcePolicyQuery_QueryType_value)
 }
 
 func init() { proto.RegisterFile("idm.proto", fileDescriptor6) }
 
 var fileDescriptor6 = []byte{
-	// 768 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0xcd, 0x6e, 0xda, 0x4a,
-	0x14, 0xbe, 0xe6, 0xdf, 0x87, 0x9b, 0x84, 0x3b, 0xe1, 0x22, 0x27, 0x37, 0xd2, 0xa5, 0xee, 0x86,
-	0xaa, 0xaa, 0x51, 0x49, 0x7f, 0x97, 0xc4, 0x8d, 0xaa, 0x28, 0x04, 0xd2, 0x81, 0xa8, 0xad, 0xba,
-	0x72, 0xec, 0x09, 0xb1, 0xb0, 0x3d, 0xd4, 0x63, 0x12, 0xf1, 0x02, 0x7d, 0x8b, 0xbe, 0x41, 0xd7,
-	0x7d, 0x9b, 0xbe, 0x4b, 0x35, 0x63, 0x8f, 0x31, 0x94, 0x34, 0x52, 0xbb, 0xa9, 0x94, 0x0d, 0xe2,
-	0x7c, 0xe7, 0x3b, 0x7f, 0xdf, 0x99, 0x61, 0x00, 0xd5, 0x75, 0x7c, 0x63, 0x1a, 0xd2, 0x88, 0xa2,
-	0x42, 0x48, 0x58, 0xb4, 0xfb, 0x78, 0xec, 0x46, 0x97, 0xb3, 0x73, 0xc3, 0xa6, 0x7e, 0x7b, 0x3a,
-	0x77, 0x5c, 0xda, 0xb6, 0x89, 0xe7, 0xb1, 0xb6, 0x4d, 0x7d, 0x9f, 0x06, 0x6d, 0x41, 0x6d, 0xbb,
-	0x8e, 0xdf, 0x4e, 0x03, 0x77, 0x5f, 0xfc, 0x3c, 0x84, 0x91, 0xf0, 0xca, 0xb5, 0x49, 0x12, 0x1a,
-	0x83, 0x71, 0xa4, 0xfe, 0x59, 0x81, 0x6d, 0x4c, 0x18, 0x9d, 0x85, 0x36, 0x39, 0xa5, 0x9e, 0x6b,
-	0xcf, 0xdf, 0xcc, 0x48, 0x38, 0x47, 0xcf, 0xa1, 0x30, 0x9a, 0x4f, 0x89, 0xa6, 0x34, 0x95, 0xd6,
-	0x66, 0xe7, 0xbe, 0xc1, 0x3b, 0x33, 0xd6, 0x10, 0x0d, 0xf1, 0xc9, 0xa9, 0x58, 0x04, 0xa0, 0x06,
-	0x94, 0xce, 0x18, 0x09, 0x8f, 0x1c, 0x2d, 0xd7, 0x54, 0x5a, 0x2a, 0x4e, 0x2c, 0xfd, 0x29, 0xa8,
-	0x29, 0x15, 0x55, 0xa1, 0x6c, 0x0e, 0xfa, 0xa3, 0xc3, 0x77, 0xa3, 0xda, 0x5f, 0xa8, 0x0c, 0xf9,
-	0x6e, 0xff, 0x7d, 0x4d, 0x41, 0x15, 0x28, 0xf4, 0x07, 0xfd, 0xc3, 0x5a, 0x8e, 0x7f, 0x3b, 0x1b,
-	0x1e, 0xe2, 0x5a, 0x5e, 0xff, 0x92, 0x83, 0x7f, 0x86, 0xc4, 0x0a, 0xed, 0x4b, 0x4c, 0x3d, 0x82,
-	0xc9, 0xc7, 0x19, 0x61, 0x11, 0x32, 0xa0, 0xcc, 0x93, 0xb9, 0x84, 0x69, 0x4a, 0x33, 0xdf, 0xaa,
-	0x76, 0xea, 0x06, 0x17, 0x83, 0x53, 0x86, 0x6e, 0x30, 0xf6, 0x88, 0x28, 0x85, 0x25, 0x09, 0x1d,
-	0xaf, 0x1d, 0x52, 0x2b, 0x37, 0x95, 0x56, 0xb5, 0xb3, 0x73, 0xe3, 0x70, 0x78, 0xad, 0x34, 0x0d,
-	0x28, 0x0d, 0x2e, 0x2e, 0x18, 0x89, 0xc4, 0x84, 0x79, 0x9c, 0x58, 0xa8, 0x0e, 0xc5, 0x9e, 0xeb,
-	0xbb, 0x91, 0x96, 0x17, 0x70, 0x6c, 0x20, 0x0d, 0xca, 0xaf, 0x43, 0x3a, 0x9b, 0x1e, 0xcc, 0xb5,
-	0x42, 0x53, 0x69, 0x15, 0xb1, 0x34, 0xd1, 0x1e, 0xa8, 0x26, 0x9d, 0x05, 0xd1, 0x20, 0xf0, 0xe6,
-	0x5a, 0xb1, 0xa9, 0xb4, 0x2a, 0x78, 0x01, 0xa0, 0x27, 0xa0, 0x0e, 0xa6, 0x24, 0xb4, 0x22, 0x97,
-	0x06, 0x5a, 0x49, 0x6c, 0xa1, 0x61, 0x24, 0x8b, 0x34, 0x52, 0x8f, 0x10, 0x7e, 0x41, 0xd4, 0x3b,
-	0xb0, 0xc5, 0x45, 0x60, 0x26, 0xf5, 0x3c, 0x62, 0x73, 0x08, 0xfd, 0x0f, 0x45, 0x01, 0x25, 0x4a,
-	0xa9, 0xa9, 0x52, 0x38, 0xc6, 0x33, 0x12, 0xf3, 0x55, 0xdd, 0x22, 0x31, 0xa7, 0xdc, 0x6d, 0x89,
-	0x27, 0xb0, 0xc5, 0x45, 0xc8, 0x4a, 0x7c, 0x0f, 0x4a, 0xa2, 0xe2, 0xb2, 0xc6, 0x42, 0xcd, 0xc4,
-	0xc1, 0xb7, 0x20, 0xa2, 0xb4, 0xdc, 0x2a, 0x23, 0xc6, 0xf9, 0x68, 0x23, 0x1a, 0x59, 0x9e, 0x18,
-	0xad, 0x88, 0x63, 0x43, 0x6f, 0xc1, 0xdf, 0x07, 0x6e, 0xe0, 0x60, 0xc2, 0xa6, 0x34, 0x60, 0x84,
-	0x8f, 0x3a, 0x9c, 0xd9, 0x36, 0x61, 0x4c, 0xdc, 0xcc, 0x0a, 0x96, 0xa6, 0xfe, 0x4d, 0x81, 0x5a,
-	0xbc, 0xc5, 0xae, 0xd9, 0x93, 0x4b, 0x7c, 0xb4, 0xba, 0xc4, 0x6d, 0x51, 0xb7, 0x6b, 0xf6, 0xd6,
-	0xee, 0xf0, 0x4f, 0x96, 0xdd, 0x84, 0x8d, 0xae, 0xd9, 0xcb, 0x88, 0xbe, 0x07, 0x85, 0xae, 0xd9,
-	0x93, 0x83, 0x55, 0xe4, 0x60, 0x58, 0xa0, 0x0b, 0x39, 0x73, 0x59, 0x39, 0xbf, 0xe6, 0xa0, 0x11,
-	0x8b, 0xf4, 0x96, 0x86, 0x13, 0x36, 0xb5, 0xec, 0xf4, 0x27, 0x65, 0x7f, 0x55, 0xaa, 0x1d, 0x91,
-	0x31, 0xe5, 0xdd, 0xed, 0x43, 0xff, 0x01, 0xb6, 0x53, 0x25, 0x32, 0x3b, 0x30, 0x00, 0x52, 0x58,
-	0xea, 0xb6, 0xb9, 0xac, 0x1b, 0xce, 0x30, 0x6e, 0xd8, 0x4a, 0x17, 0x10, 0xbf, 0x03, 0x27, 0x24,
-	0xb2, 0x32, 0xb9, 0x1f, 0x82, 0xca, 0x11, 0xc7, 0x8a, 0x2c, 0x99, 0x7a, 0x23, 0xbd, 0x35, 0xdc,
-	0x83, 0x17, 0x7e, 0xfd, 0x0c, 0xfe, 0x93, 0x70, 0xdf, 0xf2, 0xc9, 0x6a, 0x9f, 0xcf, 0x00, 0x52,
-	0x58, 0x26, 0x6b, 0x2c, 0x25, 0x4b, 0xdd, 0x38, 0xc3, 0xd4, 0x1b, 0x50, 0xe7, 0x84, 0x03, 0x4a,
-	0x27, 0xbe, 0x15, 0x4e, 0x58, 0x72, 0x58, 0xf4, 0x07, 0xb0, 0x81, 0xc9, 0x15, 0x9d, 0xa4, 0xa7,
-	0x47, 0x83, 0xf2, 0x88, 0x4e, 0x48, 0x70, 0xe4, 0x88, 0x7b, 0xa9, 0x62, 0x69, 0xea, 0xaf, 0x60,
-	0x53, 0x52, 0x6f, 0xbb, 0xc3, 0xdc, 0x73, 0x42, 0x18, 0xb3, 0xc6, 0x24, 0x79, 0x3c, 0xa5, 0xa9,
-	0xbf, 0x84, 0x1d, 0x4c, 0x18, 0x89, 0x4e, 0x2d, 0xc6, 0xae, 0x69, 0xe8, 0x88, 0xec, 0xb2, 0xf8,
-	0x1e, 0xa8, 0xbc, 0xcb, 0x1e, 0x1d, 0xbb, 0x41, 0x52, 0x7e, 0x01, 0xe8, 0xa7, 0xb0, 0xbb, 0x2e,
-	0xf4, 0x37, 0x9a, 0xf9, 0xa4, 0x40, 0x7d, 0x29, 0xe5, 0xe2, 0xcd, 0x40, 0x3f, 0x96, 0x4a, 0x3a,
-	0x5a, 0xe3, 0x59, 0x6e, 0x3c, 0xb7, 0xd2, 0x38, 0x6a, 0x42, 0xb5, 0x4f, 0xae, 0x65, 0x84, 0x38,
-	0xfd, 0x2a, 0xce, 0x42, 0xfa, 0x31, 0xfc, 0xbb, 0xd2, 0xc7, 0xaf, 0x4f, 0x75, 0x5e, 0x12, 0x7f,
-	0x88, 0xf6, 0xbf, 0x07, 0x00, 0x00, 0xff, 0xff, 0xda, 0x02, 0xb7, 0x5a, 0x90, 0x09, 0x00, 0x00,
+	// 1037 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xc6, 0xf9, 0x6b, 0x7c, 0xca, 0x76, 0xbb, 0xd3, 0x6e, 0x70, 0xb3, 0x15, 0x04, 0x73, 0x93,
+	0x82, 0xea, 0x40, 0xcb, 0xef, 0x0d, 0x52, 0x9a, 0x2d, 0xa8, 0x6a, 0x36, 0x2d, 0x93, 0x56, 0x05,
+	0x71, 0xe5, 0x3a, 0x67, 0x5b, 0x13, 0xc7, 0x63, 0x66, 0xec, 0x2d, 0x79, 0x01, 0xde, 0x82, 0x37,
+	0xe0, 0x9a, 0xb7, 0xe1, 0x5d, 0xd0, 0x8c, 0xc7, 0x8e, 0x1d, 0xb2, 0xbb, 0x95, 0x56, 0x48, 0x48,
+	0x7b, 0x13, 0xe5, 0x7c, 0xf3, 0x9d, 0x33, 0xe7, 0x7c, 0x67, 0xce, 0x4c, 0x02, 0xa6, 0x3f, 0x99,
+	0x39, 0x11, 0x67, 0x31, 0x23, 0x35, 0x8e, 0x22, 0x6e, 0x7f, 0x76, 0xe3, 0xc7, 0xb7, 0xc9, 0xb5,
+	0xe3, 0xb1, 0x59, 0x2f, 0x9a, 0x4f, 0x7c, 0xd6, 0xf3, 0x30, 0x08, 0x44, 0xcf, 0x63, 0xb3, 0x19,
+	0x0b, 0x7b, 0x8a, 0xda, 0xf3, 0x27, 0xb3, 0x5e, 0xee, 0xd8, 0xfe, 0xfa, 0xd5, 0x2e, 0x02, 0xf9,
+	0x0b, 0xdf, 0x43, 0xed, 0x9a, 0x82, 0xda, 0xb3, 0x7f, 0x9f, 0xcd, 0xdc, 0x24, 0xbe, 0x55, 0x1f,
+	0xfb, 0x31, 0x9b, 0x62, 0xb8, 0xcf, 0xf1, 0x05, 0x9b, 0x22, 0x4f, 0x43, 0xd8, 0x7f, 0x18, 0xb0,
+	0x45, 0x51, 0xb0, 0x84, 0x7b, 0x78, 0xce, 0x02, 0xdf, 0x9b, 0xff, 0x90, 0x20, 0x9f, 0x93, 0xaf,
+	0xa0, 0x76, 0x31, 0x8f, 0xd0, 0x32, 0x3a, 0x46, 0x77, 0xe3, 0xe0, 0x23, 0x47, 0x16, 0xe7, 0xac,
+	0x20, 0x3a, 0xea, 0x53, 0x52, 0xa9, 0x72, 0x20, 0x2d, 0x68, 0x5c, 0x0a, 0xe4, 0x27, 0x13, 0xab,
+	0xd2, 0x31, 0xba, 0x26, 0xd5, 0x96, 0xfd, 0x05, 0x98, 0x39, 0x95, 0xac, 0xc3, 0xda, 0xe0, 0x6c,
+	0x74, 0x71, 0xfc, 0xe3, 0xc5, 0xe6, 0x3b, 0x64, 0x0d, 0xaa, 0xfd, 0xd1, 0x4f, 0x9b, 0x06, 0x69,
+	0x42, 0x6d, 0x74, 0x36, 0x3a, 0xde, 0xac, 0xc8, 0x6f, 0x97, 0xe3, 0x63, 0xba, 0x59, 0xb5, 0xff,
+	0xac, 0xc0, 0xa3, 0x31, 0xba, 0xdc, 0xbb, 0xa5, 0x2c, 0x40, 0x8a, 0xbf, 0x26, 0x28, 0x62, 0xe2,
+	0xc0, 0x9a, 0x0c, 0xe6, 0xa3, 0xb0, 0x8c, 0x4e, 0xb5, 0xbb, 0x7e, 0xb0, 0xed, 0x48, 0x3d, 0x25,
+	0x65, 0xec, 0x87, 0x37, 0x01, 0xaa, 0xad, 0x68, 0x46, 0x22, 0xa7, 0x2b, 0x8b, 0xb4, 0xd6, 0x3a,
+	0x46, 0x77, 0xfd, 0x60, 0xe7, 0xa5, 0xc5, 0xd1, 0x95, 0xd2, 0xb4, 0xa0, 0x71, 0xf6, 0xfc, 0xb9,
+	0xc0, 0x58, 0x55, 0x58, 0xa5, 0xda, 0x22, 0xdb, 0x50, 0x1f, 0xfa, 0x33, 0x3f, 0xb6, 0xaa, 0x0a,
+	0x4e, 0x0d, 0x62, 0xc1, 0xda, 0xf7, 0x9c, 0x25, 0xd1, 0xd1, 0xdc, 0xaa, 0x75, 0x8c, 0x6e, 0x9d,
+	0x66, 0x26, 0xd9, 0x05, 0x73, 0xc0, 0x92, 0x30, 0x3e, 0x0b, 0x83, 0xb9, 0x55, 0xef, 0x18, 0xdd,
+	0x26, 0x5d, 0x00, 0xe4, 0x73, 0x30, 0xcf, 0x22, 0xe4, 0x6e, 0xec, 0xb3, 0xd0, 0x6a, 0xa8, 0x2e,
+	0xb4, 0x1c, 0x7d, 0x16, 0x9c, 0x7c, 0x45, 0x09, 0xbf, 0x20, 0xda, 0x07, 0xf0, 0x50, 0x8a, 0x20,
+	0x06, 0x2c, 0x08, 0xd0, 0x93, 0x10, 0xf9, 0x00, 0xea, 0x0a, 0xd2, 0x4a, 0x99, 0xb9, 0x52, 0x34,
+	0xc5, 0x0b, 0x12, 0xcb, 0x56, 0xbd, 0x46, 0x62, 0x49, 0x79, 0xbb, 0x25, 0x9e, 0xc2, 0x43, 0x29,
+	0x42, 0x51, 0xe2, 0x0f, 0xa1, 0xa1, 0x76, 0x2c, 0x6b, 0xac, 0xd4, 0xd4, 0x0b, 0xb2, 0x0b, 0xca,
+	0xcb, 0xaa, 0x2c, 0x33, 0x52, 0x5c, 0x96, 0x76, 0xc1, 0x62, 0x37, 0x50, 0xa5, 0xd5, 0x69, 0x6a,
+	0xd8, 0x5d, 0x78, 0xf7, 0xc8, 0x0f, 0x27, 0x14, 0x45, 0xc4, 0x42, 0x81, 0xb2, 0xd4, 0x71, 0xe2,
+	0x79, 0x28, 0x84, 0x9a, 0xcc, 0x26, 0xcd, 0x4c, 0xfb, 0x6f, 0x03, 0x36, 0xd3, 0x2e, 0xf6, 0x07,
+	0xc3, 0xac, 0x89, 0xfb, 0xcb, 0x4d, 0xdc, 0x52, 0xfb, 0xf6, 0x07, 0xc3, 0x95, 0x3d, 0xfc, 0x3f,
+	0xcb, 0x3e, 0x80, 0x07, 0xfd, 0xc1, 0xb0, 0x20, 0xfa, 0x2e, 0xd4, 0xfa, 0x83, 0x61, 0x56, 0x58,
+	0x33, 0x2b, 0x8c, 0x2a, 0x74, 0x21, 0x67, 0xa5, 0x28, 0xe7, 0x5f, 0x15, 0x68, 0xa5, 0x22, 0x5d,
+	0x31, 0x3e, 0x15, 0x91, 0xeb, 0xe5, 0x57, 0xca, 0xe1, 0xb2, 0x54, 0x3b, 0x2a, 0x62, 0xce, 0x7b,
+	0xbb, 0x0f, 0xfd, 0xcf, 0xb0, 0x95, 0x2b, 0x51, 0xe8, 0x81, 0x03, 0x90, 0xc3, 0x99, 0x6e, 0x1b,
+	0x65, 0xdd, 0x68, 0x81, 0xf1, 0x92, 0xae, 0xf4, 0x81, 0xc8, 0x19, 0x78, 0x86, 0xb1, 0x5b, 0x88,
+	0xfd, 0x09, 0x98, 0x12, 0x99, 0xb8, 0xb1, 0x9b, 0x85, 0x7e, 0x90, 0x4f, 0x8d, 0x5c, 0xa1, 0x8b,
+	0x75, 0xfb, 0x12, 0x9e, 0x64, 0xf0, 0xc8, 0x9d, 0xe1, 0x72, 0x9e, 0x5f, 0x02, 0xe4, 0x70, 0x16,
+	0xac, 0x55, 0x0a, 0x96, 0x2f, 0xd3, 0x02, 0xd3, 0x6e, 0xc1, 0xb6, 0x24, 0x1c, 0x31, 0x36, 0x9d,
+	0xb9, 0x7c, 0x2a, 0xf4, 0x61, 0xb1, 0xf7, 0xe0, 0x01, 0x55, 0xcf, 0xa8, 0x06, 0x64, 0x37, 0x2e,
+	0xe4, 0xeb, 0x7a, 0x32, 0x51, 0x73, 0x69, 0xd2, 0xcc, 0xb4, 0x9f, 0xc2, 0x46, 0x46, 0x7d, 0xdd,
+	0x0c, 0xcb, 0x95, 0x67, 0x28, 0x84, 0x7b, 0x83, 0xfa, 0xf1, 0xcc, 0x4c, 0xfb, 0x1b, 0xd8, 0xa1,
+	0x28, 0x30, 0x3e, 0x77, 0x85, 0xb8, 0x63, 0x7c, 0xa2, 0xa2, 0x67, 0x9b, 0xef, 0x82, 0x29, 0xb3,
+	0x1c, 0xb2, 0x1b, 0x3f, 0xd4, 0xdb, 0x2f, 0x00, 0xfb, 0x1c, 0xda, 0xab, 0x5c, 0xdf, 0x20, 0x99,
+	0xdf, 0x0d, 0xd8, 0x2e, 0x85, 0x5c, 0xbc, 0x19, 0xe4, 0xdf, 0x5b, 0xe9, 0x8c, 0x56, 0xac, 0x94,
+	0x13, 0xaf, 0x2c, 0x25, 0x4e, 0x3a, 0xb0, 0x3e, 0xc2, 0xbb, 0xcc, 0x43, 0x9d, 0x7e, 0x93, 0x16,
+	0x21, 0xfb, 0x14, 0x1e, 0x2f, 0xe5, 0xf1, 0x06, 0x55, 0xdd, 0x42, 0x7b, 0xc0, 0xd1, 0x8d, 0xf1,
+	0x1c, 0xb9, 0x60, 0xa1, 0x1b, 0x94, 0x34, 0x96, 0x43, 0xe8, 0x5e, 0x63, 0xa0, 0xab, 0x49, 0x0d,
+	0x59, 0xc0, 0xf1, 0x6f, 0x91, 0xcf, 0x51, 0xf4, 0x63, 0x7d, 0xa6, 0x17, 0x80, 0x1c, 0xe8, 0xb1,
+	0xc7, 0x22, 0x14, 0x56, 0xb5, 0x53, 0x95, 0x3f, 0x85, 0x52, 0xcb, 0xfe, 0x05, 0x9e, 0xac, 0xdc,
+	0x49, 0x27, 0xbf, 0x07, 0xf5, 0x85, 0x70, 0xf2, 0xca, 0x96, 0x3f, 0xde, 0x9c, 0x32, 0x37, 0x65,
+	0x48, 0x89, 0xfa, 0xaa, 0xae, 0xd4, 0x21, 0xad, 0xa8, 0x08, 0xc9, 0x83, 0x33, 0xf4, 0x45, 0x5c,
+	0xf2, 0x16, 0xf7, 0x3b, 0x38, 0xdf, 0xc1, 0x7b, 0x25, 0xb7, 0xd2, 0x6c, 0x36, 0xd2, 0x48, 0xf9,
+	0xb3, 0xb2, 0x22, 0x47, 0x4d, 0xb1, 0x3f, 0x85, 0x76, 0x3a, 0x01, 0x2b, 0x85, 0x25, 0x50, 0xbb,
+	0x4c, 0xfc, 0x6c, 0x6c, 0xd4, 0x77, 0x79, 0xc0, 0xc8, 0xc9, 0x2c, 0x4a, 0xe9, 0x31, 0xde, 0x2b,
+	0x5d, 0xd2, 0x86, 0xe6, 0xd3, 0x44, 0xdf, 0x6b, 0x69, 0x2b, 0x72, 0x9b, 0xbc, 0x0f, 0xd0, 0x0f,
+	0x02, 0x76, 0x77, 0xc5, 0xfd, 0x18, 0xd5, 0x49, 0x6a, 0xd2, 0x02, 0x22, 0x3b, 0x45, 0xd1, 0x15,
+	0x2c, 0x54, 0x77, 0xa9, 0x49, 0xb5, 0x65, 0x5f, 0xc3, 0x56, 0x29, 0x8f, 0xff, 0xa2, 0x43, 0x87,
+	0xb0, 0x25, 0x3b, 0x34, 0x46, 0x21, 0x7c, 0x76, 0xdf, 0xde, 0x7c, 0x0b, 0x8f, 0xb4, 0x43, 0xa1,
+	0x2b, 0x7b, 0xd0, 0xcc, 0xa2, 0xe4, 0x17, 0xa6, 0xca, 0x4c, 0xa3, 0x34, 0x5f, 0xb6, 0x3f, 0x86,
+	0xed, 0xb4, 0x27, 0xd9, 0xd2, 0x2b, 0xba, 0x71, 0x05, 0x8f, 0x4b, 0xdc, 0xfb, 0xa5, 0x28, 0x2b,
+	0x3f, 0x45, 0x8c, 0x06, 0x09, 0xe7, 0x18, 0xa6, 0xd3, 0xd1, 0xa4, 0x45, 0xe8, 0xba, 0xa1, 0xfe,
+	0x82, 0x1c, 0xfe, 0x33, 0x00, 0x93, 0xba, 0x90, 0x32, 0x45, 0x0d, 0x00, 0x00,
 }
@@ -9,6 +9,8 @@ import math "math"
 import _ "github.com/pydio/cells/common/proto/tree"
 import _ "github.com/pydio/cells/common/proto/idm"
 import _ "github.com/pydio/cells/common/proto/mailer"
+import _ "github.com/pydio/cells/common/proto/webhook"
+import _ "github.com/pydio/cells/common/proto/chat"
 import _ "github.com/pydio/cells/common/proto/activity"
 import _ "github.com/pydio/cells/common/proto/docstore"
 import _ "github.com/pydio/cells/common/proto/jobs"
@@ -30,215 +32,271 @@ var _ = math.Inf
 func init() { proto.RegisterFile("rest.proto", fileDescriptor7) }
 
 var fileDescriptor7 = []byte{
-	// 3346 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x6d, 0x6f, 0xdc, 0xc6,
-	0xb5, 0xc6, 0xca, 0x6f, 0xd2, 0x68, 0x57, 0x92, 0x67, 0xf5, 0x4a, 0xc9, 0xb6, 0xcc, 0x38, 0xb9,
-	0x81, 0xee, 0xd5, 0x32, 0xd9, 0xdc, 0xdc, 0x24, 0xbe, 0x28, 0x9a, 0xf5, 0xca, 0x56, 0xed, 0xc8,
-	0xc9, 0x56, 0xb2, 0xdd, 0x24, 0x4e, 0x90, 0x72, 0xc9, 0x11, 0x97, 0x16, 0x97, 0xb3, 0x99, 0x19,
-	0xda, 0x11, 0x54, 0xf7, 0x43, 0x80, 0x22, 0x68, 0x3e, 0xa6, 0x2d, 0x90, 0x16, 0xc8, 0xa7, 0xfe,
-	0x89, 0xa2, 0x40, 0x7f, 0x40, 0xd3, 0x7e, 0x29, 0x8a, 0xa2, 0x7f, 0xa0, 0xfd, 0x1f, 0xc5, 0xbc,
-	0x91, 0xc3, 0x17, 0xad, 0xa4, 0xb4, 0x1f, 0xa4, 0x25, 0xcf, 0x39, 0xf3, 0x3c, 0x67, 0xce, 0xbc,
-	0x9d, 0x99, 0x21, 0x00, 0x04, 0x51, 0xd6, 0x1a, 0x11, 0xcc, 0x30, 0x3c, 0xcf, 0x9f, 0xad, 0xba,
-	0x87, 0x87, 0x43, 0x1c, 0x4b, 0x99, 0x05, 0x7c, 0x97, 0xb9, 0xea, 0x79, 0x2a, 0xf4, 0x87, 0xea,
-	0xb1, 0xde, 0x27, 0xf8, 0x00, 0x11, 0xfd, 0xe6, 0xe1, 0x78, 0x3f, 0x0c, 0xd4, 0xdb, 0x2c, 0xf5,
-	0x06, 0xc8, 0x4f, 0xa2, 0x54, 0x3d, 0x1d, 0x10, 0x77, 0x34, 0xd0, 0x2f, 0x74, 0xe0, 0x12, 0xa4,
-	0x5e, 0x66, 0xf6, 0x09, 0x8e, 0x19, 0x8a, 0x7d, 0xf5, 0xfe, 0x5a, 0x10, 0xb2, 0x41, 0xd2, 0x6f,
-	0x79, 0x78, 0xe8, 0x8c, 0x0e, 0xfd, 0x10, 0x3b, 0x1e, 0x8a, 0x22, 0xea, 0x48, 0x97, 0x1c, 0x61,
-	0xe4, 0x30, 0x82, 0x90, 0xf8, 0xa7, 0x0a, 0xbd, 0x7a, 0x9a, 0x42, 0xa1, 0x3f, 0x74, 0x32, 0xf7,
-	0xdf, 0x38, 0x4d, 0x91, 0xa1, 0x1b, 0x46, 0x88, 0xa8, 0x1f, 0x55, 0xb0, 0x73, 0x9a, 0x82, 0xae,
-	0xc7, 0xc2, 0xa7, 0x21, 0x3b, 0x4c, 0x1f, 0x28, 0x23, 0xc8, 0xd5, 0xdc, 0xff, 0x7f, 0x1a, 0x08,
-	0x1f, 0x7b, 0x94, 0x61, 0x82, 0xd2, 0x87, 0xb3, 0x04, 0xe8, 0x09, 0xee, 0x53, 0xf1, 0x4f, 0x15,
-	0xfa, 0xfe, 0x69, 0x0a, 0xa1, 0xd8, 0x23, 0x87, 0x23, 0x16, 0xe2, 0xd8, 0x78, 0x3c, 0x4b, 0x84,
-	0x23, 0x1c, 0xf0, 0xbf, 0xb3, 0x44, 0x18, 0xf7, 0x9f, 0x20, 0x8f, 0xa9, 0x1f, 0x55, 0xf0, 0xad,
-	0x53, 0xb5, 0x66, 0x4c, 0x99, 0x1b, 0x45, 0xfa, 0xf7, 0x2c, 0x6e, 0x7a, 0x2c, 0xe2, 0x7f, 0xaa,
-	0xc8, 0xff, 0x9e, 0xaa, 0x08, 0x22, 0x4c, 0x3e, 0x9e, 0xa5, 0x72, 0xc9, 0xc8, 0x77, 0x19, 0x52,
-	0x3f, 0xaa, 0xe0, 0x5a, 0x80, 0x71, 0x10, 0x21, 0xc7, 0x1d, 0x85, 0x8e, 0x1b, 0xc7, 0x98, 0xb9,
-	0x3c, 0xca, 0xba, 0x9d, 0xfe, 0x47, 0xfc, 0x78, 0x9b, 0x01, 0x8a, 0x37, 0xe9, 0x33, 0x37, 0x08,
-	0x10, 0x71, 0xb0, 0x68, 0x07, 0x5a, 0xb6, 0x6e, 0x7f, 0xb9, 0x04, 0x1a, 0x5d, 0x31, 0xee, 0xf6,
-	0x10, 0x79, 0x1a, 0x7a, 0x08, 0x3e, 0x00, 0x53, 0xbd, 0x84, 0x49, 0x19, 0x6c, 0xb6, 0xc4, 0xc8,
-	0x96, 0x6f, 0x09, 0x11, 0x45, 0xad, 0x2a, 0xa1, 0x7d, 0xe5, 0xf3, 0xbf, 0xfe, 0xe3, 0x17, 0x13,
-	0x4b, 0x16, 0x74, 0xe4, 0x30, 0x76, 0x8e, 0xee, 0x24, 0x51, 0xd4, 0x73, 0xd9, 0xe0, 0xf9, 0xcd,
-	0xda, 0x06, 0xfc, 0x21, 0x98, 0xda, 0x46, 0x67, 0x47, 0xb5, 0x04, 0xea, 0x3c, 0xac, 0x40, 0x85,
-	0x1f, 0x83, 0x46, 0x2f, 0x61, 0x5b, 0x2e, 0x73, 0xf7, 0x70, 0x42, 0x3c, 0x04, 0x61, 0x4b, 0xf5,
-	0x81, 0x4c, 0x66, 0x55, 0xc8, 0xec, 0x1b, 0x02, 0xf4, 0xaa, 0xbd, 0xa2, 0x41, 0xf9, 0xec, 0x44,
-	0x85, 0xce, 0x39, 0x7a, 0xd7, 0x1d, 0x22, 0xe1, 0xf1, 0x87, 0xa0, 0xb1, 0x8d, 0xbe, 0x0b, 0xfc,
-	0x75, 0x01, 0xbf, 0x0a, 0x8f, 0x87, 0x87, 0x21, 0x98, 0xdb, 0x42, 0x11, 0x62, 0xe8, 0x04, 0xf8,
-	0xab, 0x32, 0x26, 0x45, 0xdb, 0x5d, 0x44, 0x47, 0x38, 0xa6, 0x29, 0xd5, 0xc6, 0x18, 0xaa, 0x7d,
-	0x30, 0xbb, 0x13, 0x52, 0xa3, 0x1e, 0x14, 0xae, 0x4a, 0xd4, 0xbc, 0x78, 0x17, 0x7d, 0x9a, 0xf0,
-	0x89, 0xdb, 0x52, 0x94, 0xa9, 0xa2, 0x8b, 0xa3, 0x08, 0x79, 0xd5, 0xad, 0x91, 0xd1, 0xc1, 0x43,
-	0xb0, 0xc8, 0x01, 0x1f, 0x21, 0x42, 0x43, 0x1c, 0x87, 0x71, 0xd0, 0xc3, 0x51, 0xe8, 0x85, 0x88,
-	0xc2, 0xeb, 0x19, 0x5d, 0x41, 0x7b, 0xa8, 0x49, 0xd7, 0xa5, 0x49, 0x51, 0x3d, 0x8e, 0xfa, 0x69,
-	0x6a, 0x0b, 0x07, 0xa0, 0xb9, 0x8d, 0x4a, 0xd8, 0x70, 0xb1, 0x25, 0xa6, 0xf7, 0xa2, 0xdc, 0x3a,
-	0x46, 0x5e, 0x6e, 0xb7, 0x8c, 0xc2, 0x39, 0x7a, 0x98, 0x84, 0xfe, 0x73, 0xf8, 0x45, 0x0d, 0x34,
-	0x7b, 0xc9, 0xbf, 0x4f, 0xf5, 0xf6, 0x57, 0x9d, 0x15, 0xb0, 0x74, 0x3b, 0x66, 0x88, 0x8c, 0x48,
-	0x48, 0x51, 0x6e, 0x04, 0x16, 0x7b, 0x67, 0xc9, 0x0d, 0xde, 0x3b, 0x7f, 0x55, 0x03, 0x8b, 0xb2,
-	0x5b, 0x9c, 0xda, 0x99, 0x1b, 0x66, 0x67, 0x2a, 0xb7, 0x84, 0xea, 0x52, 0xdf, 0x3b, 0xd1, 0x35,
-	0xa3, 0xbb, 0x95, 0x23, 0xf4, 0x08, 0xd4, 0x79, 0x43, 0x2b, 0x7b, 0x0a, 0x97, 0xb3, 0xc6, 0x57,
-	0x32, 0xdd, 0xe6, 0x4b, 0x52, 0xa3, 0xa4, 0x46, 0x53, 0x37, 0x05, 0x4b, 0x03, 0x4e, 0x6b, 0x16,
-	0x8f, 0x45, 0x70, 0x0f, 0xcc, 0x74, 0x71, 0xcc, 0x08, 0x8e, 0xf4, 0x3c, 0xb5, 0x9a, 0xce, 0x17,
-	0x86, 0x54, 0x83, 0xd7, 0x5b, 0x7c, 0x76, 0x56, 0x42, 0x7b, 0x51, 0x20, 0xce, 0xd9, 0x26, 0x22,
-	0x0f, 0x62, 0x0c, 0x20, 0x77, 0xac, 0x87, 0x10, 0xa1, 0x1d, 0xdf, 0x27, 0x88, 0x52, 0x44, 0xe1,
-	0xb5, 0xcc, 0xe5, 0xbc, 0xa6, 0xd0, 0x5b, 0xab, 0x0c, 0x54, 0x10, 0x17, 0x04, 0xe1, 0x2c, 0x6c,
-	0x68, 0xc2, 0x11, 0xb7, 0x83, 0xb1, 0x1c, 0x8b, 0xbc, 0xd0, 0x1d, 0x1c, 0xf9, 0x5c, 0xb4, 0x96,
-	0xc7, 0x52, 0x62, 0xcd, 0xb4, 0x20, 0xb5, 0xef, 0x62, 0x1f, 0x51, 0x23, 0x42, 0x2f, 0x09, 0xf8,
-	0x75, 0x7b, 0x35, 0x07, 0xef, 0x1c, 0x71, 0x04, 0xe5, 0x8c, 0xe8, 0x24, 0xcf, 0x65, 0xfd, 0x6e,
-	0xa7, 0x2b, 0xf1, 0x3b, 0xe8, 0x90, 0xc2, 0xf5, 0x96, 0xb1, 0x34, 0x77, 0xfc, 0x61, 0x18, 0x73,
-	0x23, 0xae, 0xd2, 0xb4, 0xd7, 0xc7, 0x58, 0xa8, 0x1a, 0xda, 0xc2, 0x85, 0x35, 0x7b, 0x49, 0xbb,
-	0x60, 0xac, 0xfc, 0x51, 0x48, 0x19, 0xa7, 0xff, 0xbc, 0x06, 0x9a, 0x5d, 0x82, 0x5c, 0x86, 0x72,
-	0x1e, 0xc0, 0x32, 0xbc, 0xb4, 0x7a, 0x07, 0xa5, 0x13, 0x82, 0x3d, 0xce, 0x44, 0xb9, 0x50, 0x9a,
-	0xc6, 0x0d, 0x17, 0x3c, 0x61, 0xad, 0x9d, 0x90, 0x5d, 0xfe, 0x24, 0x27, 0xa4, 0xd5, 0x58, 0x27,
-	0x0c, 0x93, 0x53, 0x38, 0xe1, 0x0b, 0x6b, 0xed, 0xc4, 0xed, 0xcf, 0x46, 0x98, 0xb0, 0x93, 0x9c,
-	0x90, 0x56, 0x63, 0x9d, 0x30, 0x4c, 0x4e, 0xe1, 0x04, 0x12, 0xd6, 0xda, 0x89, 0xbb, 0xc3, 0xd3,
-	0x38, 0x21, 0xad, 0xc6, 0x3a, 0x61, 0x98, 0xe4, 0x9d, 0xb0, 0xaa, 0x9c, 0x08, 0x87, 0xda, 0x89,
-	0x1f, 0x03, 0x78, 0x3b, 0xf6, 0x47, 0x38, 0x8c, 0x19, 0xdd, 0x0a, 0xa9, 0x87, 0x9f, 0x22, 0xc2,
-	0xa7, 0x2c, 0x39, 0x35, 0x69, 0x41, 0x61, 0x8e, 0x30, 0xe4, 0x8a, 0x6c, 0x45, 0x90, 0x35, 0xe1,
-	0xe5, 0x74, 0x25, 0x4a, 0xb1, 0x7c, 0x30, 0xf7, 0xde, 0x08, 0xc5, 0x9d, 0x51, 0x78, 0x32, 0xbe,
-	0x1a, 0x5f, 0xca, 0xbe, 0xb8, 0xac, 0x1a, 0x2b, 0xb8, 0x2e, 0xe8, 0xe0, 0x11, 0x8a, 0xdd, 0x51,
-	0x08, 0x9f, 0x81, 0x79, 0x39, 0x33, 0xde, 0xc1, 0x64, 0x68, 0xd4, 0x64, 0xc9, 0xcc, 0x62, 0xb8,
-	0xee, 0xc4, 0xaa, 0x6c, 0x0a, 0xb2, 0xff, 0x82, 0x2f, 0x96, 0xc9, 0xf6, 0x39, 0xb6, 0x73, 0xa4,
-	0xa6, 0x31, 0xb9, 0x9e, 0xff, 0xba, 0x06, 0x96, 0xc4, 0xa0, 0xfe, 0x8c, 0x21, 0x12, 0xbb, 0xd1,
-	0x56, 0x48, 0x90, 0xc7, 0x30, 0xe1, 0x2b, 0xad, 0x9d, 0x4d, 0x26, 0x45, 0xf5, 0x61, 0x36, 0xb6,
-	0x85, 0x4d, 0x49, 0x6f, 0x4c, 0x2f, 0x6f, 0x9c, 0xb8, 0x04, 0x2c, 0xc0, 0x66, 0xe6, 0x6d, 0xc6,
-	0xff, 0x4d, 0x0d, 0xcc, 0xf7, 0x92, 0x32, 0x37, 0xbc, 0x72, 0x2c, 0x29, 0xc7, 0xb0, 0xae, 0x1d,
-	0xa3, 0x4e, 0x63, 0x74, 0xfb, 0x44, 0x8f, 0x5e, 0xb0, 0xae, 0x56, 0x78, 0xe4, 0x1c, 0x49, 0xcb,
-	0xbb, 0x72, 0xd1, 0xfc, 0xa6, 0x06, 0x96, 0xd4, 0x5c, 0xf0, 0x1f, 0x77, 0xf1, 0xd6, 0x89, 0x2e,
-	0xae, 0x6f, 0x9c, 0xe0, 0x62, 0xfb, 0xe7, 0x13, 0x60, 0x7a, 0x17, 0x47, 0x48, 0x2f, 0x71, 0x6f,
-	0x82, 0x4b, 0x7b, 0x88, 0x71, 0x09, 0x9c, 0x6a, 0xf1, 0x7d, 0x27, 0x7f, 0xb4, 0xb2, 0x47, 0x7b,
-	0x49, 0x00, 0x5f, 0xb6, 0xea, 0x0e, 0xc1, 0x11, 0x32, 0xd2, 0x83, 0x37, 0x01, 0x90, 0x15, 0x1d,
-	0x53, 0x78, 0x5e, 0x14, 0x9e, 0xd9, 0xc8, 0x15, 0x86, 0xaf, 0x83, 0x4b, 0xdb, 0x63, 0x39, 0x55,
-	0x31, 0x98, 0x2f, 0xf6, 0x1e, 0x98, 0xde, 0x43, 0x2e, 0xf1, 0x06, 0xdc, 0x86, 0xc2, 0x74, 0x71,
-	0xd7, 0xa2, 0xc2, 0x88, 0x13, 0x56, 0x46, 0x97, 0x9b, 0x13, 0xa0, 0xc0, 0xbe, 0x20, 0x40, 0x6f,
-	0xd6, 0x36, 0xda, 0xbf, 0x3d, 0x07, 0xa6, 0x1f, 0x52, 0x44, 0x74, 0x2c, 0xde, 0x02, 0x97, 0x7a,
-	0x09, 0xe3, 0x12, 0xe5, 0x17, 0x7f, 0xb4, 0xb2, 0x47, 0x7b, 0x59, 0x40, 0x40, 0xab, 0xe1, 0x24,
-	0x14, 0x11, 0xe7, 0x68, 0x07, 0x07, 0x61, 0x2c, 0x82, 0xb1, 0xa5, 0x83, 0x51, 0x2c, 0x3d, 0x6f,
-	0x66, 0x44, 0xc5, 0xc5, 0x7b, 0x23, 0x0f, 0x04, 0xff, 0x4f, 0x04, 0x66, 0x8c, 0x03, 0xd9, 0xa2,
-	0x9f, 0x2b, 0x97, 0x46, 0x86, 0x1b, 0x15, 0x22, 0xc3, 0x45, 0x85, 0xc8, 0x08, 0xab, 0xca, 0xc8,
-	0x70, 0x54, 0x5e, 0x9d, 0x1f, 0x80, 0xc9, 0x5b, 0x61, 0xec, 0x17, 0x3d, 0x81, 0xb2, 0x3c, 0x57,
-	0xa5, 0x55, 0x51, 0x9b, 0x32, 0x1b, 0xe6, 0x5c, 0x72, 0xfa, 0x61, 0xec, 0x73, 0xa4, 0xb7, 0xc1,
-	0x64, 0x2f, 0x61, 0xb2, 0xc5, 0xaa, 0xeb, 0x74, 0x55, 0x00, 0x2c, 0x5b, 0x4d, 0x09, 0xc0, 0x1b,
-	0x87, 0x1a, 0xa1, 0x6d, 0xff, 0xa5, 0x06, 0x40, 0xa7, 0xbb, 0xa3, 0x1b, 0x69, 0x13, 0x5c, 0xec,
-	0x25, 0xac, 0xe3, 0x45, 0x70, 0x52, 0x60, 0x74, 0xba, 0x3b, 0x56, 0xfa, 0x64, 0xcf, 0x0a, 0xb0,
-	0x29, 0xeb, 0xbc, 0xe3, 0x7a, 0x91, 0xac, 0xc9, 0x94, 0x8c, 0x7d, 0xbe, 0x44, 0x75, 0xb3, 0xac,
-	0xca, 0x99, 0xc7, 0x9e, 0xe3, 0xa5, 0x9d, 0x7e, 0x12, 0x1d, 0x18, 0x0b, 0xec, 0x3d, 0x00, 0x64,
-	0x44, 0x3b, 0x5e, 0x44, 0xf5, 0x74, 0xaf, 0x24, 0xdd, 0x1d, 0x1d, 0x62, 0xb5, 0xc5, 0xec, 0x74,
-	0x77, 0x8c, 0x00, 0x2b, 0xaf, 0x6c, 0xed, 0x55, 0xfb, 0x0f, 0x13, 0xa0, 0x21, 0x93, 0x62, 0x5d,
-	0xad, 0x4f, 0x64, 0x52, 0x9b, 0xee, 0x68, 0xd6, 0x84, 0xab, 0xa9, 0xe8, 0x70, 0x9b, 0xe0, 0x64,
-	0x94, 0x66, 0x4f, 0x57, 0x8e, 0xd1, 0xaa, 0x7a, 0x40, 0xc1, 0x57, 0xb7, 0x2f, 0x39, 0x23, 0xa1,
-	0xe6, 0xee, 0x7f, 0x22, 0xf6, 0xdc, 0x2a, 0x7f, 0x9f, 0x13, 0xe5, 0x8d, 0xb2, 0x56, 0x49, 0x62,
-	0xb7, 0x0a, 0xb3, 0x4d, 0xce, 0x5f, 0x49, 0x60, 0x99, 0x04, 0x4f, 0x40, 0x5d, 0x86, 0xf3, 0x58,
-	0x8e, 0xea, 0xa0, 0xb7, 0x4f, 0xe4, 0x99, 0xdb, 0x98, 0x51, 0x3c, 0x6a, 0x2a, 0x68, 0x7f, 0x3d,
-	0x01, 0xe6, 0x7e, 0x84, 0xc9, 0x01, 0x1d, 0xb9, 0x5e, 0x3a, 0x95, 0xed, 0x80, 0x7a, 0x2f, 0x61,
-	0xa9, 0x18, 0xce, 0x08, 0x07, 0xd2, 0x77, 0xab, 0xf0, 0x6e, 0xaf, 0x09, 0xf0, 0x45, 0xeb, 0xb2,
-	0xf3, 0x4c, 0xcb, 0x9c, 0xa3, 0xbd, 0x28, 0x09, 0xc4, 0x88, 0xde, 0x05, 0xb3, 0xd2, 0xd1, 0xe3,
-	0x01, 0xab, 0xeb, 0xa3, 0xf2, 0x86, 0x8d, 0x32, 0x2c, 0xec, 0x83, 0x39, 0xd9, 0x61, 0x52, 0x8c,
-	0x34, 0x3b, 0x2f, 0xc8, 0x75, 0x43, 0xaf, 0x48, 0x6d, 0x2a, 0x37, 0x3a, 0x95, 0x9a, 0x0b, 0x6c,
-	0x90, 0xf1, 0xf0, 0xae, 0xf5, 0xed, 0x04, 0x98, 0xed, 0xa8, 0xe3, 0x3c, 0x1d, 0x99, 0x0f, 0xc1,
-	0xc5, 0x3d, 0x71, 0xb2, 0x07, 0xaf, 0xb7, 0xf4, 0x51, 0x5f, 0x4b, 0x4a, 0x94, 0x69, 0x98, 0x6d,
-	0x3d, 0xe6, 0x32, 0x93, 0xf7, 0xc4, 0x69, 0x41, 0x6e, 0x58, 0xa8, 0x03, 0x43, 0x79, 0x50, 0xc8,
-	0xe3, 0xf4, 0x18, 0x4c, 0xed, 0x25, 0x7d, 0xea, 0x91, 0xb0, 0x8f, 0xe0, 0xa2, 0x01, 0x2f, 0x85,
-	0x22, 0x39, 0xb3, 0x8e, 0x91, 0xeb, 0xb1, 0x6f, 0x37, 0x0d, 0x64, 0x0d, 0xc6, 0xc1, 0x7f, 0x0a,
-	0x9a, 0x32, 0x30, 0x66, 0x29, 0x0a, 0x6f, 0x18, 0x70, 0x65, 0x75, 0x36, 0x48, 0x64, 0x64, 0x4d,
-	0x9d, 0x11, 0xbf, 0x6c, 0x7b, 0x51, 0xe4, 0x96, 0xa6, 0x3c, 0x98, 0xbf, 0x3b, 0x0f, 0xc0, 0x0e,
-	0x4e, 0xcf, 0xad, 0xde, 0x05, 0x17, 0xf7, 0x0e, 0x69, 0x84, 0x03, 0xd8, 0x6c, 0x45, 0x38, 0x10,
-	0x03, 0x70, 0x07, 0x07, 0x85, 0x73, 0x8d, 0x1d, 0x1c, 0xdc, 0x47, 0x94, 0xba, 0x41, 0xc5, 0x8e,
-	0xd3, 0x9e, 0x14, 0xc7, 0x8f, 0xf4, 0x90, 0xc3, 0x43, 0x06, 0xea, 0x12, 0x4f, 0xe6, 0xdb, 0x67,
-	0x47, 0x7d, 0xed, 0xab, 0xce, 0x22, 0x98, 0xcf, 0xc6, 0x4e, 0xe6, 0xab, 0x3c, 0xcb, 0xb0, 0x67,
-	0x35, 0x9d, 0x91, 0xa4, 0x0f, 0xc0, 0x85, 0x4e, 0xe2, 0x87, 0xdf, 0x81, 0xae, 0x35, 0x9e, 0x8e,
-	0xf7, 0x45, 0x4e, 0xe7, 0x72, 0x74, 0xce, 0x94, 0x80, 0x69, 0xc1, 0xf4, 0x5d, 0xab, 0xf7, 0xfa,
-	0x78, 0xbe, 0x45, 0xfb, 0x72, 0xc6, 0x97, 0xdf, 0x85, 0xcc, 0x08, 0xde, 0xee, 0xc0, 0x25, 0xe2,
-	0xfc, 0x09, 0x2e, 0x08, 0xea, 0x07, 0xe1, 0x10, 0xed, 0xba, 0x71, 0x90, 0x0e, 0x2f, 0x95, 0x72,
-	0x19, 0x72, 0x9a, 0x44, 0xcc, 0xf0, 0xe0, 0xcd, 0xf1, 0x1e, 0xac, 0xd8, 0xf3, 0x86, 0x07, 0x1e,
-	0xa7, 0xf3, 0x5d, 0xe6, 0xf2, 0xae, 0xf3, 0xf7, 0x09, 0x50, 0x7f, 0x80, 0x0f, 0x50, 0xac, 0x3b,
-	0xcf, 0x2e, 0xb8, 0xb8, 0x8b, 0x9e, 0xe2, 0x03, 0xa4, 0xcf, 0x26, 0xe5, 0x9b, 0x76, 0x65, 0x3e,
-	0x2f, 0x2c, 0xad, 0xae, 0x6e, 0xc2, 0x06, 0x0e, 0xe3, 0x80, 0x0e, 0x11, 0x36, 0xbc, 0xa6, 0x5f,
-	0xd4, 0x00, 0xdc, 0x45, 0x14, 0xb1, 0x9e, 0x4b, 0xe9, 0x33, 0x4c, 0x7c, 0xc1, 0xa8, 0x8f, 0x17,
-	0xca, 0x9a, 0xc2, 0xf1, 0x42, 0x95, 0x81, 0x22, 0x6e, 0x09, 0xe2, 0x97, 0xad, 0x97, 0x24, 0x31,
-	0xe1, 0x96, 0x9b, 0x23, 0x65, 0xba, 0x29, 0xfd, 0x38, 0xe2, 0xeb, 0xb7, 0x4a, 0x41, 0x42, 0xd0,
-	0xc8, 0xa1, 0x41, 0xab, 0x82, 0x42, 0xd3, 0xaf, 0x56, 0xea, 0x14, 0xf3, 0xb5, 0x34, 0xb2, 0x15,
-	0xcc, 0x3c, 0xb2, 0xef, 0x83, 0xc6, 0x7d, 0x71, 0xd5, 0xa1, 0x23, 0xbb, 0x0d, 0xce, 0xef, 0xa1,
-	0xd8, 0x87, 0xf5, 0x96, 0xba, 0x02, 0xe1, 0x6a, 0x6b, 0x59, 0xbf, 0x71, 0x1d, 0x97, 0xa4, 0x0c,
-	0x2a, 0xa5, 0xb5, 0xeb, 0xfa, 0xe6, 0x84, 0x22, 0x91, 0xac, 0xb4, 0x3f, 0x02, 0x0d, 0x35, 0x9f,
-	0x28, 0xe4, 0x77, 0xc0, 0x05, 0x71, 0x30, 0x02, 0x9b, 0xf2, 0xc0, 0x4b, 0x25, 0x9b, 0xf9, 0xb5,
-	0x5e, 0x0b, 0x79, 0xd7, 0xa1, 0x3a, 0x47, 0xb4, 0x1b, 0x0e, 0x15, 0x72, 0x27, 0xe6, 0x00, 0x1c,
-	0xfd, 0x9f, 0x13, 0x60, 0xfa, 0x3e, 0x62, 0x6e, 0xd6, 0x21, 0x78, 0xb6, 0xc7, 0x25, 0x3a, 0x58,
-	0xfc, 0x99, 0x6f, 0xc1, 0x72, 0x4b, 0x00, 0x90, 0xd4, 0xdc, 0x0f, 0x23, 0x36, 0x43, 0xc4, 0x5c,
-	0x27, 0x40, 0xcc, 0x39, 0xe2, 0x8a, 0xf4, 0x0c, 0x7c, 0x47, 0xa4, 0xf3, 0x02, 0x73, 0x3e, 0xc3,
-	0xcc, 0x3a, 0xf4, 0x38, 0x34, 0x5a, 0x42, 0x7b, 0x5f, 0x67, 0xb5, 0x67, 0x72, 0x32, 0x9b, 0x58,
-	0x05, 0xac, 0xcc, 0xa0, 0x0a, 0xc8, 0x1f, 0x82, 0xe9, 0x6d, 0xc4, 0x6e, 0x25, 0xd1, 0x81, 0x80,
-	0x56, 0x47, 0x78, 0x86, 0x48, 0x03, 0xab, 0x3c, 0x2b, 0x13, 0xe7, 0x57, 0x59, 0x7b, 0x46, 0x92,
-	0x88, 0x5c, 0x2d, 0x40, 0x7c, 0xf8, 0xb7, 0xff, 0x74, 0x1e, 0xcc, 0xf2, 0x9e, 0x69, 0xc6, 0x3a,
-	0x00, 0x33, 0x0f, 0xc5, 0xfd, 0x86, 0x56, 0x40, 0x4b, 0x66, 0xa0, 0x39, 0x61, 0xd6, 0x3f, 0xab,
-	0x74, 0x8a, 0x39, 0x4b, 0x1b, 0x78, 0xbe, 0xba, 0x29, 0xe8, 0xe5, 0xdd, 0x09, 0xaf, 0x98, 0x0f,
-	0x66, 0xb2, 0xbc, 0xdb, 0x20, 0xca, 0x0b, 0x35, 0xd1, 0x72, 0x96, 0x90, 0xe7, 0xdb, 0x49, 0xb3,
-	0xd8, 0x26, 0x8b, 0xec, 0x50, 0x92, 0xa5, 0xc1, 0xcb, 0xdc, 0xc2, 0xf8, 0x60, 0xe8, 0x92, 0x03,
-	0xaa, 0xdb, 0x26, 0x27, 0x3c, 0x29, 0x84, 0x59, 0xf3, 0x67, 0x14, 0x7d, 0x5d, 0x98, 0xb3, 0xfc,
-	0xac, 0x06, 0x96, 0xf2, 0x41, 0x48, 0xdb, 0x1d, 0xbe, 0x50, 0x11, 0xa2, 0x52, 0xaf, 0xb8, 0x31,
-	0xde, 0x28, 0xef, 0x87, 0x65, 0xfa, 0x11, 0x6b, 0x2b, 0xee, 0xc7, 0x11, 0x58, 0xe0, 0x8b, 0x46,
-	0xd9, 0x89, 0xeb, 0x69, 0x1a, 0x7c, 0xac, 0x0b, 0xd7, 0xf3, 0x11, 0x4e, 0xf5, 0xe5, 0x50, 0xc3,
-	0x4a, 0xfe, 0xf6, 0xe7, 0xe7, 0xc0, 0xf4, 0x3d, 0xdc, 0xa7, 0xba, 0x27, 0x7d, 0x2c, 0x43, 0x2f,
-	0xcf, 0x0a, 0xef, 0xe1, 0xbe, 0x1e, 0x67, 0x5c, 0x78, 0x0f, 0xf7, 0x2b, 0xb6, 0x5a, 0x42, 0x5a,
-	0xaa, 0xab, 0xb8, 0xfb, 0x94, 0x5b, 0xa6, 0x7b, 0xb8, 0x9f, 0x5e, 0x09, 0x3d, 0x02, 0x75, 0x31,
-	0xab, 0x86, 0x94, 0x71, 0x56, 0xb8, 0xd0, 0x12, 0xf7, 0xa3, 0xfa, 0xbd, 0xa2, 0xe3, 0x70, 0x71,
-	0x65, 0x5a, 0x98, 0x32, 0x70, 0xdc, 0x87, 0x60, 0x46, 0xb8, 0x2d, 0x8f, 0xb2, 0xb9, 0xdf, 0x97,
-	0x25, 0x72, 0x97, 0x91, 0xa8, 0x8b, 0x87, 0x43, 0x37, 0xf6, 0xad, 0x95, 0x92, 0xa8, 0xb8, 0x63,
-	0xb5, 0x0a, 0xb0, 0x48, 0x0e, 0x35, 0x39, 0x4b, 0x3c, 0x70, 0xe9, 0x01, 0x85, 0xcb, 0x12, 0xc4,
-	0x10, 0x65, 0xc9, 0x6c, 0x59, 0x53, 0x5a, 0xe7, 0x04, 0x3c, 0xe3, 0xca, 0x6c, 0xef, 0xd5, 0xfe,
-	0x73, 0x0d, 0xcc, 0x89, 0x33, 0xc1, 0x07, 0x04, 0xa5, 0xf9, 0xfe, 0x63, 0xd0, 0xe0, 0x61, 0x49,
-	0xe5, 0xfa, 0x56, 0x82, 0x0b, 0xc5, 0xac, 0x7d, 0xc2, 0x11, 0x77, 0x96, 0xd6, 0x8a, 0x8b, 0x7a,
-	0x97, 0xe3, 0xa4, 0x07, 0xcb, 0x8f, 0x41, 0x63, 0x8f, 0xb9, 0x06, 0xf8, 0x82, 0x04, 0xdf, 0x45,
-	0xae, 0xcf, 0x81, 0xb2, 0xc1, 0x55, 0x10, 0x97, 0xb6, 0x92, 0x06, 0x38, 0x65, 0xae, 0x98, 0xa1,
-	0xfe, 0x76, 0x0e, 0xcc, 0x6e, 0x61, 0x6f, 0x8f, 0x61, 0x82, 0xb2, 0x0d, 0xe0, 0xa4, 0xb8, 0x2d,
-	0xc3, 0x1e, 0x85, 0x2b, 0xc6, 0xed, 0x99, 0xba, 0x56, 0x2f, 0x34, 0xbc, 0x16, 0x1b, 0xd5, 0xc9,
-	0x72, 0xe9, 0xf4, 0x4e, 0xfe, 0x48, 0x30, 0xdc, 0xdd, 0x12, 0x3d, 0xeb, 0x27, 0x7a, 0x27, 0xbc,
-	0x85, 0x3d, 0xb8, 0xde, 0x4a, 0xef, 0xeb, 0x53, 0x61, 0x32, 0x44, 0x31, 0x33, 0x0e, 0xe8, 0x8f,
-	0xb7, 0x50, 0x75, 0xdc, 0x10, 0x8c, 0x37, 0xec, 0x6b, 0x19, 0x23, 0x9f, 0x87, 0x3f, 0xd1, 0x33,
-	0xbe, 0xc9, 0x4e, 0xc4, 0xb6, 0x9d, 0x53, 0xaf, 0x65, 0xc0, 0x52, 0x22, 0x50, 0xb3, 0xa4, 0xbd,
-	0x5a, 0xab, 0x28, 0xff, 0x5b, 0x50, 0xbe, 0x68, 0xad, 0x57, 0x54, 0xd2, 0x39, 0xd2, 0xe6, 0x8a,
-	0x13, 0x83, 0x8b, 0xdb, 0xa8, 0xc8, 0x29, 0x25, 0xc7, 0x71, 0xe6, 0xb4, 0x8a, 0xf3, 0x65, 0xc1,
-	0x69, 0xc3, 0x13, 0x39, 0xdb, 0x7f, 0xac, 0x81, 0xfa, 0x36, 0x71, 0x47, 0x69, 0xfe, 0xf0, 0x11,
-	0x98, 0x12, 0x07, 0x4c, 0xcc, 0x65, 0x48, 0x1f, 0x19, 0xa4, 0x82, 0xc2, 0xb1, 0xad, 0x21, 0x57,
-	0xc4, 0xaa, 0x45, 0xe1, 0xa2, 0x23, 0xbe, 0x4b, 0x11, 0xdd, 0x87, 0x73, 0xa3, 0x80, 0x13, 0x3e,
-	0x87, 0x8f, 0xc1, 0xe4, 0x2e, 0x8a, 0xc4, 0x2d, 0x36, 0xd4, 0x87, 0x5e, 0xea, 0xbd, 0x30, 0xf7,
-	0x67, 0x62, 0x05, 0xbd, 0x2e, 0xa0, 0x2d, 0xb8, 0xac, 0xa0, 0x89, 0x32, 0x90, 0x39, 0xdd, 0x5d,
-	0xff, 0x79, 0x3b, 0x00, 0x8d, 0xee, 0x80, 0xe7, 0xc4, 0xba, 0x2e, 0x8f, 0x00, 0xd8, 0x46, 0x4c,
-	0xca, 0x68, 0x7a, 0xbf, 0x3e, 0x30, 0xd3, 0xe9, 0x45, 0x53, 0x58, 0x39, 0xd2, 0x3c, 0x59, 0x9c,
-	0x57, 0xe2, 0x53, 0xd9, 0x4a, 0xed, 0x2f, 0x2f, 0x80, 0xfa, 0xde, 0xc0, 0xcd, 0x46, 0x42, 0x57,
-	0x1c, 0xc3, 0x75, 0x51, 0x14, 0xe9, 0xb9, 0x55, 0xbd, 0x66, 0xc9, 0x86, 0xa4, 0x41, 0x51, 0xa4,
-	0xef, 0xdd, 0xac, 0x69, 0x47, 0x7c, 0xae, 0x23, 0xbe, 0x6f, 0xe0, 0x6d, 0xbf, 0x2d, 0x92, 0x2b,
-	0x13, 0x44, 0xbd, 0x56, 0x81, 0x64, 0x37, 0xbf, 0x19, 0x88, 0x3e, 0x75, 0x7c, 0xac, 0x73, 0x20,
-	0x81, 0xb5, 0x64, 0x6e, 0xf9, 0x4d, 0xb8, 0xe5, 0xb2, 0x42, 0x85, 0x5a, 0x81, 0x6f, 0x54, 0x81,
-	0xef, 0x8a, 0x23, 0x0b, 0x51, 0xfb, 0x9d, 0x30, 0x3e, 0xd0, 0x03, 0xdf, 0x94, 0x69, 0x82, 0x59,
-	0x95, 0x6c, 0x6a, 0x79, 0xa9, 0xe6, 0x51, 0x18, 0x1f, 0xa8, 0x15, 0x64, 0x1b, 0x95, 0x31, 0x4d,
-	0xd9, 0xb1, 0x98, 0xc5, 0x40, 0x70, 0x4c, 0xed, 0xeb, 0x13, 0x7d, 0x20, 0x92, 0x41, 0xaf, 0x99,
-	0x95, 0x2e, 0xa1, 0x5f, 0x39, 0x46, 0x7b, 0x4c, 0x5c, 0x4c, 0xae, 0x67, 0xa0, 0x29, 0xae, 0x73,
-	0xb9, 0x82, 0xaf, 0x41, 0xea, 0xab, 0x02, 0xe3, 0x56, 0xb4, 0xa0, 0x2a, 0x2c, 0xf7, 0x95, 0x16,
-	0xa5, 0x99, 0x59, 0xf2, 0x12, 0x6d, 0xc1, 0x3b, 0xe3, 0x6f, 0xce, 0x81, 0x99, 0xbb, 0xf2, 0x5b,
-	0x1d, 0xdd, 0x1d, 0x3f, 0x10, 0xfd, 0x5e, 0x09, 0xe1, 0x6a, 0x4b, 0x7f, 0xca, 0xc3, 0xa7, 0x0a,
-	0xb4, 0xef, 0xf2, 0xa4, 0x5f, 0xb3, 0xaf, 0x55, 0x2b, 0x15, 0xb1, 0x3a, 0x66, 0x85, 0x93, 0xfa,
-	0x6b, 0x20, 0xf8, 0x10, 0x4c, 0xf7, 0x30, 0x4d, 0xb1, 0x97, 0xd2, 0xe2, 0x4a, 0x92, 0x75, 0xae,
-	0x92, 0x42, 0x61, 0x66, 0xc7, 0x0a, 0xca, 0x82, 0xf7, 0x80, 0x21, 0x68, 0xf6, 0x10, 0xd9, 0xc7,
-	0x64, 0xa8, 0xcc, 0xbb, 0x03, 0xe4, 0xf1, 0xd6, 0xd2, 0x28, 0x4a, 0x2b, 0xc4, 0xc6, 0x91, 0x62,
-	0xa5, 0xb6, 0x94, 0x6f, 0xeb, 0x4f, 0x9a, 0x3c, 0xae, 0xe7, 0x74, 0x81, 0xe8, 0x70, 0x9d, 0x80,
-	0x20, 0xc4, 0xe7, 0x25, 0x98, 0x8b, 0x42, 0x2a, 0x2e, 0xf3, 0xe4, 0xb5, 0xf9, 0x5e, 0x01, 0x61,
-	0xca, 0xe3, 0x6a, 0x9b, 0xf6, 0xb7, 0x35, 0xd0, 0x90, 0xc9, 0xa4, 0x6e, 0x9b, 0x9e, 0x4e, 0xeb,
-	0x39, 0x7a, 0x48, 0x90, 0x0f, 0x17, 0x5a, 0xea, 0x3b, 0xa6, 0x4c, 0x2e, 0x67, 0xa6, 0x82, 0x58,
-	0xd1, 0xa9, 0x93, 0x59, 0x78, 0x49, 0xa5, 0xf0, 0x30, 0x00, 0xd3, 0x9d, 0xd1, 0x28, 0x3a, 0x94,
-	0x76, 0xd0, 0xd2, 0xe5, 0x0c, 0x61, 0xb6, 0x4b, 0xa8, 0xd2, 0xe5, 0x13, 0x3d, 0xb8, 0xa4, 0x3f,
-	0xaf, 0x3a, 0x7a, 0xe0, 0x92, 0x20, 0xfd, 0x84, 0xe4, 0x79, 0xfb, 0xf7, 0x13, 0x60, 0xf6, 0x8e,
-	0xfa, 0xa8, 0x50, 0x57, 0x67, 0x1f, 0xd4, 0xf7, 0x10, 0x63, 0x61, 0x1c, 0xd0, 0xfb, 0x28, 0x4e,
-	0xf4, 0xd0, 0x35, 0x65, 0x85, 0x63, 0x93, 0xbc, 0xaa, 0xc4, 0xad, 0xbf, 0x5a, 0xe4, 0x7b, 0x3b,
-	0x61, 0xb7, 0x39, 0xe4, 0xb8, 0x1f, 0x80, 0x49, 0x41, 0xbd, 0x83, 0x03, 0xbd, 0x70, 0xe8, 0x77,
-	0x75, 0x08, 0xa3, 0xa7, 0x72, 0x2d, 0x2e, 0xae, 0x49, 0x56, 0x33, 0xc3, 0x16, 0x0f, 0x11, 0x0e,
-	0xa8, 0xda, 0x99, 0x88, 0x32, 0xb7, 0x30, 0x16, 0x9f, 0x62, 0xe9, 0x9d, 0x49, 0x4e, 0x58, 0x38,
-	0x07, 0x28, 0xe8, 0x4a, 0x3d, 0x21, 0x65, 0xea, 0x63, 0xcc, 0x3c, 0x1c, 0xef, 0xb7, 0x31, 0x98,
-	0xd9, 0x09, 0x3d, 0x14, 0x53, 0x94, 0xa5, 0xe5, 0x75, 0x2d, 0x61, 0x2e, 0xe3, 0x29, 0x94, 0x87,
-	0x08, 0x9f, 0x08, 0x32, 0x59, 0x16, 0xba, 0x0a, 0x95, 0x22, 0x55, 0x93, 0x2a, 0x9c, 0x71, 0x22,
-	0xa9, 0x16, 0x8b, 0x2e, 0xbd, 0xf5, 0x75, 0xed, 0xab, 0xce, 0x2f, 0x6b, 0xf0, 0x0d, 0x30, 0xdf,
-	0x3b, 0xf4, 0x43, 0xbc, 0xce, 0x67, 0x78, 0xba, 0xbe, 0x8b, 0x28, 0x5b, 0xef, 0xf4, 0xee, 0xda,
-	0x16, 0xb8, 0x20, 0xe4, 0xf0, 0xf2, 0x80, 0xb1, 0x11, 0xbd, 0xe9, 0xc8, 0xaf, 0xed, 0x5a, 0x1e,
-	0x1e, 0xb6, 0xcf, 0xbd, 0xda, 0x7a, 0x65, 0xe3, 0x5c, 0x6d, 0xe2, 0x7c, 0x7b, 0xce, 0x1d, 0x8d,
-	0xa2, 0xd0, 0x93, 0x0b, 0xed, 0x13, 0x8a, 0xe3, 0x9b, 0x25, 0x09, 0x79, 0x05, 0xac, 0xde, 0xc7,
-	0x04, 0xad, 0xbb, 0x7d, 0x9c, 0xb0, 0x75, 0x93, 0xac, 0x33, 0x0a, 0x69, 0x05, 0x7e, 0xff, 0xa2,
-	0xf8, 0xca, 0xee, 0xb5, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x5e, 0x49, 0x5b, 0x21, 0x2b,
+	// 4242 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5b, 0x5b, 0x6f, 0x24, 0x49,
+	0x56, 0x56, 0xf5, 0xdd, 0x61, 0x97, 0x2f, 0xe1, 0x5b, 0x77, 0xda, 0xdd, 0xed, 0xce, 0x9d, 0x99,
+	0x5d, 0xbc, 0xeb, 0xca, 0xdd, 0x1a, 0x86, 0x99, 0x1d, 0x84, 0xd8, 0x6a, 0xbb, 0xdb, 0xb8, 0xc7,
+	0x3d, 0x53, 0xd8, 0xee, 0x5e, 0x76, 0x7a, 0x97, 0x99, 0xac, 0xac, 0x70, 0x55, 0xb6, 0xb3, 0x32,
+	0x6a, 0x23, 0xa2, 0xdc, 0x63, 0x19, 0x23, 0x34, 0x02, 0x8d, 0x58, 0xde, 0x66, 0x41, 0x5a, 0x90,
+	0xf6, 0x89, 0x7f, 0x81, 0xc4, 0x23, 0x48, 0xc0, 0x13, 0x42, 0x3c, 0xf2, 0x82, 0xe0, 0x47, 0xf0,
+	0x86, 0x4e, 0x5c, 0x32, 0x22, 0x2f, 0xe5, 0xcb, 0xc0, 0x43, 0xb7, 0x33, 0xcf, 0x39, 0xf1, 0x7d,
+	0x27, 0x4e, 0x44, 0xc6, 0xe5, 0x44, 0x14, 0x42, 0x8c, 0x70, 0xd1, 0x18, 0x32, 0x2a, 0x28, 0xbe,
+	0x01, 0xcf, 0xde, 0x54, 0x44, 0x07, 0x03, 0x9a, 0x2a, 0x99, 0x87, 0xba, 0xa1, 0x08, 0xf5, 0xf3,
+	0x44, 0xdc, 0x1d, 0xe8, 0xc7, 0xa9, 0x0e, 0xa3, 0x47, 0x84, 0x99, 0xb7, 0x88, 0xa6, 0x87, 0x71,
+	0x4f, 0xbf, 0xcd, 0xf0, 0xa8, 0x4f, 0xba, 0xa3, 0x24, 0x53, 0x4f, 0xf6, 0x58, 0x38, 0xec, 0x9b,
+	0x17, 0xde, 0x0f, 0x19, 0xd1, 0x2f, 0xd3, 0x87, 0x8c, 0xa6, 0x82, 0xa4, 0x5d, 0xfd, 0xfe, 0x6e,
+	0x2f, 0x16, 0xfd, 0x51, 0xa7, 0x11, 0xd1, 0x41, 0x30, 0x3c, 0xe9, 0xc6, 0x34, 0x88, 0x48, 0x92,
+	0xf0, 0x40, 0xb9, 0x14, 0x48, 0xa3, 0x40, 0x30, 0x42, 0xe4, 0x7f, 0xba, 0xd0, 0x0f, 0x2e, 0x53,
+	0x28, 0xee, 0x0e, 0x02, 0xeb, 0xfe, 0xfb, 0x97, 0x29, 0x32, 0x08, 0xe3, 0x84, 0x30, 0xfd, 0x47,
+	0x17, 0xfc, 0xe1, 0x65, 0x0a, 0xbe, 0x21, 0x9d, 0x3e, 0xa5, 0x47, 0xe6, 0xef, 0x55, 0xea, 0x16,
+	0xf5, 0x43, 0x21, 0xff, 0xd3, 0x85, 0x5a, 0x97, 0x29, 0x14, 0x46, 0x22, 0x3e, 0x8e, 0xc5, 0x49,
+	0xf6, 0xc0, 0x05, 0x23, 0xa1, 0xa9, 0xeb, 0x6f, 0x5f, 0x06, 0xa2, 0x4b, 0x23, 0x2e, 0x28, 0x23,
+	0xd9, 0xc3, 0x55, 0x9c, 0x7e, 0x4d, 0x3b, 0x5c, 0xfe, 0xa7, 0x0b, 0xfd, 0xee, 0x65, 0x0a, 0x91,
+	0x34, 0x62, 0x27, 0x43, 0x11, 0xd3, 0xd4, 0x79, 0xbc, 0x4a, 0x8b, 0x26, 0xb4, 0x07, 0xff, 0xae,
+	0xd2, 0xa2, 0xb4, 0xf3, 0x9a, 0x44, 0x42, 0xff, 0xb9, 0x4a, 0x8b, 0xc6, 0x29, 0x17, 0x61, 0x92,
+	0x98, 0xbf, 0x57, 0x71, 0x33, 0x12, 0x09, 0xfc, 0xd3, 0x45, 0x7e, 0xf3, 0x52, 0x45, 0x08, 0x13,
+	0xea, 0xf1, 0x2a, 0x95, 0x1b, 0x0d, 0xbb, 0xa1, 0x20, 0xfa, 0x8f, 0x2e, 0xb8, 0xda, 0xa3, 0xb4,
+	0x97, 0x90, 0x20, 0x1c, 0xc6, 0x41, 0x98, 0xa6, 0x54, 0x84, 0x10, 0x65, 0xd3, 0x4e, 0xdf, 0x93,
+	0x7f, 0xa2, 0x8d, 0x1e, 0x49, 0x37, 0xf8, 0x9b, 0xb0, 0xd7, 0x23, 0x2c, 0xa0, 0xb2, 0x1d, 0x78,
+	0xd9, 0xba, 0xf9, 0x8b, 0x65, 0x54, 0xdf, 0x94, 0xdf, 0xf9, 0x3e, 0x61, 0xc7, 0x71, 0x44, 0xf0,
+	0x01, 0x9a, 0x68, 0x8f, 0x84, 0x92, 0xe1, 0xf9, 0x86, 0x1c, 0x49, 0xd4, 0xdb, 0x88, 0xc9, 0xa2,
+	0x5e, 0x95, 0xd0, 0xbf, 0xff, 0xe5, 0xbf, 0xfd, 0xd7, 0x2f, 0xaf, 0x2d, 0x7b, 0x38, 0x50, 0xc3,
+	0x46, 0x70, 0xfa, 0x74, 0x94, 0x24, 0xed, 0x50, 0xf4, 0xcf, 0x3e, 0xac, 0xad, 0xe3, 0xdf, 0x47,
+	0x13, 0xdb, 0xe4, 0xea, 0xa8, 0x9e, 0x44, 0x5d, 0xc0, 0x15, 0xa8, 0xf8, 0x67, 0xa8, 0xde, 0x1e,
+	0x89, 0xad, 0x50, 0x84, 0xfb, 0x74, 0xc4, 0x22, 0x82, 0x71, 0x43, 0xf7, 0x01, 0x2b, 0xf3, 0x2a,
+	0x64, 0xfe, 0x5b, 0x12, 0xf4, 0x81, 0x7f, 0xcf, 0x80, 0xc2, 0x68, 0xc8, 0xa5, 0x2e, 0x38, 0xfd,
+	0x38, 0x1c, 0x10, 0xe9, 0xf1, 0xa7, 0xa8, 0xbe, 0x4d, 0xbe, 0x09, 0xfc, 0x23, 0x09, 0xbf, 0x82,
+	0xc7, 0xc3, 0xe3, 0x18, 0xcd, 0x6e, 0x91, 0x84, 0x08, 0x72, 0x01, 0xfc, 0x03, 0x15, 0x93, 0xa2,
+	0xed, 0x1e, 0xe1, 0x43, 0x9a, 0xf2, 0x8c, 0x6a, 0xfd, 0x1c, 0xaa, 0x43, 0x34, 0xb3, 0x1b, 0x73,
+	0xa7, 0x1e, 0x1c, 0xaf, 0x28, 0xd4, 0xbc, 0x78, 0x8f, 0xfc, 0x7c, 0x04, 0x13, 0x85, 0xa7, 0x29,
+	0x33, 0xc5, 0x26, 0x4d, 0x12, 0x12, 0x55, 0xb7, 0x86, 0xa5, 0xc3, 0x27, 0x68, 0x09, 0x00, 0x5f,
+	0x12, 0xc6, 0x63, 0x9a, 0xc6, 0x69, 0xaf, 0x4d, 0x93, 0x38, 0x8a, 0x09, 0xc7, 0x8f, 0x2c, 0x5d,
+	0x41, 0x7b, 0x62, 0x48, 0xd7, 0x94, 0x49, 0x51, 0x7d, 0x1e, 0xf5, 0x71, 0x66, 0x8b, 0xfb, 0x68,
+	0x7e, 0x9b, 0x94, 0xb0, 0xf1, 0x52, 0x43, 0x4e, 0x27, 0x45, 0xb9, 0x37, 0x46, 0x5e, 0x6e, 0x37,
+	0x4b, 0x11, 0x9c, 0xbe, 0x18, 0xc5, 0xdd, 0x33, 0xfc, 0x55, 0x0d, 0xcd, 0xb7, 0x47, 0xff, 0x77,
+	0xaa, 0x1f, 0x7d, 0xdd, 0xba, 0x87, 0x96, 0x9f, 0xa4, 0x82, 0xb0, 0x21, 0x8b, 0x39, 0xc9, 0x7d,
+	0x81, 0xc5, 0xde, 0x59, 0x72, 0x03, 0x7a, 0xe7, 0x5f, 0xd5, 0xd0, 0x92, 0xea, 0x16, 0x97, 0x76,
+	0xe6, 0x2d, 0xb7, 0x33, 0x95, 0x5b, 0x42, 0x77, 0xa9, 0xdf, 0xb9, 0xd0, 0xb5, 0x95, 0xf5, 0xf1,
+	0xae, 0xe1, 0x97, 0x68, 0x0a, 0x1a, 0x5a, 0xdb, 0x73, 0x7c, 0xd7, 0x36, 0xbe, 0x96, 0x99, 0x36,
+	0x5f, 0x56, 0x1a, 0x2d, 0x75, 0x9a, 0x7a, 0x5e, 0xb2, 0xd4, 0xf1, 0xa4, 0x61, 0x89, 0x44, 0x82,
+	0xf7, 0xd1, 0xf4, 0x26, 0x4d, 0x05, 0xa3, 0x89, 0x19, 0xa7, 0x56, 0xb2, 0xf1, 0xc2, 0x91, 0x1a,
+	0xf0, 0xa9, 0x06, 0x8c, 0xce, 0x5a, 0xe8, 0x2f, 0x49, 0xc4, 0x59, 0xdf, 0x45, 0x84, 0x20, 0xa6,
+	0x08, 0x83, 0x63, 0x6d, 0x42, 0x18, 0x6f, 0x75, 0xbb, 0x8c, 0x70, 0x4e, 0x38, 0x7e, 0x68, 0x5d,
+	0xce, 0x6b, 0x0a, 0xbd, 0xb5, 0xca, 0x40, 0x07, 0x71, 0x51, 0x12, 0xce, 0xe0, 0xba, 0x21, 0x1c,
+	0x82, 0x1d, 0x4e, 0xd1, 0x8c, 0x29, 0xf4, 0x94, 0x26, 0x5d, 0x10, 0xad, 0xe6, 0xb1, 0xb4, 0xd8,
+	0x30, 0x2d, 0x2a, 0xed, 0xc7, 0xb4, 0x4b, 0xb8, 0x13, 0xa1, 0x77, 0x24, 0xfc, 0x9a, 0xbf, 0x92,
+	0x83, 0x0f, 0x4e, 0x01, 0x41, 0x3b, 0x23, 0x3b, 0xc9, 0x99, 0xaa, 0xdf, 0x93, 0x6c, 0x26, 0xfe,
+	0x88, 0x9c, 0x70, 0xbc, 0xd6, 0x70, 0xa6, 0xe6, 0x56, 0x77, 0x10, 0xa7, 0x60, 0x04, 0x2a, 0x43,
+	0xfb, 0xe8, 0x1c, 0x0b, 0x5d, 0x43, 0x5f, 0xba, 0xb0, 0xea, 0x2f, 0x1b, 0x17, 0x6c, 0x89, 0x20,
+	0x89, 0xb9, 0x00, 0xfa, 0x2f, 0x6b, 0x68, 0x7e, 0x93, 0x91, 0x50, 0x90, 0x9c, 0x07, 0xb8, 0x0c,
+	0xaf, 0xac, 0x3e, 0x22, 0xd9, 0x80, 0xe0, 0x9f, 0x67, 0xa2, 0x5d, 0x28, 0x0d, 0xe3, 0x8e, 0x0b,
+	0x91, 0xb4, 0x36, 0x4e, 0xa8, 0x2e, 0x7f, 0x91, 0x13, 0xca, 0xea, 0x5c, 0x27, 0x1c, 0x93, 0x4b,
+	0x38, 0xd1, 0x95, 0xd6, 0xc6, 0x89, 0x27, 0x5f, 0x0c, 0x29, 0x13, 0x17, 0x39, 0xa1, 0xac, 0xce,
+	0x75, 0xc2, 0x31, 0xb9, 0x84, 0x13, 0x44, 0x5a, 0x1b, 0x27, 0x76, 0x06, 0x97, 0x71, 0x62, 0x67,
+	0x90, 0x31, 0x8c, 0x73, 0x62, 0x67, 0x30, 0xc6, 0x09, 0xaf, 0xca, 0x89, 0x78, 0x60, 0x9c, 0xf8,
+	0x1c, 0xe1, 0x27, 0x69, 0x77, 0x48, 0xe3, 0x54, 0xf0, 0xad, 0x98, 0x47, 0xf4, 0x98, 0x30, 0x18,
+	0xb2, 0xd4, 0xd0, 0x64, 0x04, 0x85, 0x31, 0xc2, 0x91, 0x6b, 0xb2, 0x7b, 0x92, 0x6c, 0x1e, 0xcf,
+	0x65, 0x33, 0x51, 0x86, 0xd5, 0x45, 0xb3, 0x9f, 0x0c, 0x49, 0xda, 0x1a, 0xc6, 0x17, 0xe3, 0xeb,
+	0xef, 0x4b, 0xdb, 0x17, 0xa7, 0x55, 0x67, 0x06, 0x37, 0x05, 0x03, 0x3a, 0x24, 0x69, 0x38, 0x8c,
+	0xf1, 0x1b, 0xb4, 0xa0, 0x46, 0xc6, 0xa7, 0x94, 0x0d, 0x9c, 0x9a, 0x2c, 0xbb, 0xab, 0x18, 0xd0,
+	0x5d, 0x58, 0x95, 0x0d, 0x49, 0xf6, 0x6d, 0xfc, 0x76, 0x99, 0xec, 0x10, 0xb0, 0x83, 0x53, 0x3d,
+	0x8c, 0xa9, 0xf9, 0xfc, 0xaf, 0x6b, 0x68, 0x59, 0x7e, 0xd4, 0x5f, 0x08, 0xc2, 0xd2, 0x30, 0xd9,
+	0x8a, 0x19, 0x89, 0x04, 0x65, 0x30, 0xd3, 0xfa, 0x76, 0x30, 0x29, 0xaa, 0x4f, 0xec, 0xb7, 0x2d,
+	0x6d, 0x4a, 0x7a, 0x67, 0x78, 0x79, 0xff, 0xc2, 0x29, 0x60, 0x11, 0xcf, 0x5b, 0x6f, 0x2d, 0xff,
+	0xaf, 0x6b, 0x68, 0xa1, 0x3d, 0x2a, 0x73, 0xe3, 0xfb, 0x63, 0x49, 0x01, 0xc3, 0x7b, 0x38, 0x46,
+	0x9d, 0xc5, 0xe8, 0xc9, 0x85, 0x1e, 0x7d, 0xcb, 0x7b, 0x50, 0xe1, 0x51, 0x70, 0xaa, 0x2c, 0x77,
+	0xd4, 0xa4, 0xf9, 0xeb, 0x1a, 0x5a, 0xd6, 0x63, 0xc1, 0xff, 0xbb, 0x8b, 0x8f, 0x2f, 0x74, 0x71,
+	0x6d, 0xfd, 0x02, 0x17, 0x9b, 0x7f, 0x7e, 0x0d, 0x4d, 0xee, 0xd1, 0x84, 0x98, 0x29, 0xee, 0x03,
+	0x74, 0x7b, 0x9f, 0x08, 0x90, 0xe0, 0x89, 0x06, 0xec, 0x73, 0xe1, 0xd1, 0xb3, 0x8f, 0xfe, 0xb2,
+	0x04, 0x9e, 0xf3, 0xa6, 0x02, 0x46, 0x13, 0xe2, 0x2c, 0x0f, 0x3e, 0x40, 0x48, 0x55, 0xf4, 0x9c,
+	0xc2, 0x0b, 0xb2, 0xf0, 0xf4, 0x7a, 0xae, 0x30, 0x7e, 0x0f, 0xdd, 0xde, 0x26, 0xe2, 0xe2, 0x62,
+	0x38, 0x5f, 0xec, 0x13, 0x34, 0xb9, 0x4f, 0x42, 0x16, 0xf5, 0xc1, 0x86, 0xe3, 0x6c, 0x72, 0x37,
+	0xa2, 0xc2, 0x17, 0x27, 0xad, 0x9c, 0x2e, 0x37, 0x2b, 0x41, 0x91, 0x7f, 0x53, 0x82, 0x7e, 0x58,
+	0x5b, 0x6f, 0xfe, 0xed, 0x75, 0x34, 0xf9, 0x82, 0x13, 0x66, 0x62, 0xf1, 0x43, 0x74, 0xbb, 0x3d,
+	0x12, 0x20, 0xd1, 0x7e, 0xc1, 0xa3, 0x67, 0x1f, 0xfd, 0xbb, 0x12, 0x02, 0x7b, 0xf5, 0x60, 0xc4,
+	0x09, 0x0b, 0x4e, 0x77, 0x69, 0x2f, 0x4e, 0x65, 0x30, 0xb6, 0x4c, 0x30, 0x8a, 0xa5, 0x17, 0xdc,
+	0x15, 0x51, 0x71, 0xf2, 0x5e, 0xcf, 0x03, 0xe1, 0xdf, 0x92, 0x81, 0x39, 0xc7, 0x01, 0x3b, 0xe9,
+	0xe7, 0xca, 0x65, 0x91, 0x01, 0xa3, 0x42, 0x64, 0x40, 0x54, 0x88, 0x8c, 0xb4, 0xaa, 0x8c, 0x0c,
+	0xa0, 0x42, 0x75, 0x7e, 0x0f, 0xdd, 0x79, 0x1c, 0xa7, 0xdd, 0xa2, 0x27, 0x58, 0x95, 0x07, 0x55,
+	0x56, 0x15, 0xbd, 0x29, 0xf3, 0x71, 0xce, 0xa5, 0xa0, 0x13, 0xa7, 0x5d, 0x40, 0xfa, 0x11, 0xba,
+	0xd3, 0x1e, 0x09, 0xd5, 0x62, 0xd5, 0x75, 0x7a, 0x20, 0x01, 0xee, 0x7a, 0xf3, 0x0a, 0x00, 0x1a,
+	0x87, 0x3b, 0xa1, 0x6d, 0xfe, 0x6b, 0x0d, 0xa1, 0xd6, 0xe6, 0xae, 0x69, 0xa4, 0x0d, 0x74, 0xab,
+	0x3d, 0x12, 0xad, 0x28, 0xc1, 0x77, 0x24, 0x46, 0x6b, 0x73, 0xd7, 0xcb, 0x9e, 0xfc, 0x19, 0x09,
+	0x36, 0xe1, 0xdd, 0x08, 0xc2, 0x28, 0x51, 0x35, 0x99, 0x50, 0xb1, 0xcf, 0x97, 0xa8, 0x6e, 0x96,
+	0x15, 0x35, 0xf2, 0xf8, 0xb3, 0x50, 0x3a, 0xe8, 0x8c, 0x92, 0x23, 0x67, 0x82, 0x7d, 0x86, 0x90,
+	0x8a, 0x68, 0x2b, 0x4a, 0xb8, 0x19, 0xee, 0xb5, 0x64, 0x73, 0xd7, 0x84, 0x58, 0x6f, 0x31, 0x5b,
+	0x9b, 0xbb, 0x4e, 0x80, 0xb5, 0x57, 0xbe, 0xf1, 0xaa, 0xf9, 0xf7, 0xd7, 0x50, 0x5d, 0x2d, 0x8a,
+	0x4d, 0xb5, 0x3e, 0x53, 0x8b, 0xda, 0x6c, 0x47, 0xb3, 0x2a, 0x5d, 0xcd, 0x44, 0x27, 0xdb, 0x8c,
+	0x8e, 0x86, 0xd9, 0xea, 0xe9, 0xfe, 0x18, 0xad, 0xae, 0x07, 0x96, 0x7c, 0x53, 0xfe, 0xed, 0x60,
+	0x28, 0xd5, 0xe0, 0xfe, 0x67, 0x72, 0xcf, 0xad, 0xcc, 0xf1, 0xac, 0x2c, 0xef, 0x94, 0xf5, 0x4a,
+	0x12, 0xbf, 0x51, 0x18, 0x6d, 0x72, 0xfe, 0x2a, 0x02, 0xcf, 0x25, 0x78, 0x8d, 0xa6, 0x54, 0x38,
+	0xc7, 0x72, 0x54, 0x07, 0xbd, 0x79, 0x21, 0xcf, 0xec, 0xfa, 0xb4, 0xe6, 0xd1, 0x43, 0x41, 0xf3,
+	0x57, 0xd7, 0xd0, 0xec, 0x8f, 0x29, 0x3b, 0xe2, 0xc3, 0x30, 0xca, 0x86, 0xb2, 0x5d, 0x34, 0xd5,
+	0x1e, 0x89, 0x4c, 0x8c, 0xa7, 0xa5, 0x03, 0xd9, 0xbb, 0x57, 0x78, 0xf7, 0x57, 0x25, 0xf8, 0x92,
+	0x37, 0x17, 0xbc, 0x31, 0xb2, 0xe0, 0x74, 0x3f, 0x19, 0xf5, 0xe4, 0x17, 0xbd, 0x87, 0x66, 0x94,
+	0xa3, 0xe3, 0x01, 0xab, 0xeb, 0xa3, 0xd7, 0x0d, 0xeb, 0x65, 0x58, 0xdc, 0x41, 0xb3, 0xaa, 0xc3,
+	0x64, 0x18, 0xd9, 0xea, 0xbc, 0x20, 0x37, 0x0d, 0x7d, 0x4f, 0x69, 0x33, 0xb9, 0xd3, 0xa9, 0xf4,
+	0x58, 0xe0, 0x23, 0xcb, 0x03, 0x5d, 0xeb, 0x1f, 0x6e, 0xa2, 0x99, 0x96, 0x4e, 0xe7, 0x99, 0xc8,
+	0x7c, 0x8a, 0x6e, 0xed, 0xcb, 0xcc, 0x1e, 0x7e, 0xd4, 0x30, 0xa9, 0xbe, 0x86, 0x92, 0x68, 0xd3,
+	0xd8, 0x6e, 0x3d, 0x66, 0xad, 0xc9, 0x27, 0x32, 0x5b, 0x90, 0xfb, 0x2c, 0x94, 0x26, 0x50, 0x89,
+	0x42, 0x88, 0xd3, 0x2b, 0x34, 0xb1, 0x3f, 0xea, 0xf0, 0x88, 0xc5, 0x1d, 0x82, 0x97, 0x1c, 0x78,
+	0x25, 0x94, 0x8b, 0x33, 0x6f, 0x8c, 0xdc, 0x7c, 0xfb, 0xfe, 0xbc, 0x83, 0x6c, 0xc0, 0x00, 0xfc,
+	0x8f, 0xd1, 0xbc, 0x0a, 0x8c, 0x5b, 0x8a, 0xe3, 0xb7, 0x1c, 0xb8, 0xb2, 0xda, 0x7e, 0x24, 0x2a,
+	0xb2, 0xae, 0xce, 0x89, 0x9f, 0xdd, 0x5e, 0x14, 0xb9, 0x95, 0x29, 0xf0, 0xff, 0x45, 0x0d, 0x79,
+	0xdb, 0x44, 0x7c, 0x4c, 0x45, 0x7c, 0x18, 0x47, 0x32, 0x5f, 0xd4, 0x66, 0xe4, 0x90, 0x30, 0x92,
+	0x42, 0xdb, 0x7d, 0xd7, 0xfa, 0x31, 0xde, 0xca, 0xae, 0x8a, 0x32, 0xe3, 0x31, 0x96, 0x66, 0x2c,
+	0xc5, 0x8b, 0xd6, 0xa5, 0xa1, 0x43, 0xf7, 0xa7, 0x35, 0xe4, 0xb5, 0x47, 0x63, 0xbd, 0xb9, 0x98,
+	0xe0, 0x32, 0x3e, 0xac, 0x49, 0x1f, 0x3c, 0xaf, 0xda, 0x07, 0x08, 0x4a, 0x17, 0xdd, 0x78, 0x4a,
+	0x48, 0x17, 0xeb, 0xbe, 0x69, 0x3a, 0x1b, 0xc8, 0xc6, 0xf7, 0xa1, 0x40, 0xc2, 0xfe, 0x06, 0xfe,
+	0xb6, 0x85, 0x3d, 0x24, 0xa4, 0xab, 0xd6, 0x26, 0x82, 0x7c, 0x21, 0xce, 0xb2, 0x27, 0xc8, 0x0b,
+	0x9d, 0x35, 0xff, 0xe7, 0x26, 0x42, 0xbb, 0x34, 0x4b, 0x19, 0x7e, 0x8c, 0x6e, 0xed, 0x9f, 0xf0,
+	0x84, 0x42, 0x66, 0x0f, 0x92, 0xb7, 0x30, 0xf6, 0xed, 0xd2, 0x5e, 0x21, 0xa5, 0xb4, 0x4b, 0x7b,
+	0xcf, 0x09, 0xe7, 0x61, 0xaf, 0x62, 0xb3, 0xef, 0xdf, 0x91, 0x99, 0x5f, 0x7e, 0x22, 0x2b, 0x21,
+	0xd0, 0x94, 0xc2, 0x53, 0x5b, 0x9d, 0xab, 0xa3, 0xbe, 0xfb, 0x75, 0x6b, 0x09, 0x2d, 0xd8, 0x61,
+	0xcb, 0xfa, 0xaa, 0xd2, 0x48, 0xfe, 0x8c, 0xa1, 0x73, 0xf6, 0x47, 0x7d, 0x74, 0xb3, 0x35, 0xea,
+	0xc6, 0xdf, 0x80, 0xae, 0x71, 0x3e, 0x1d, 0x0c, 0x03, 0x40, 0x17, 0x02, 0x3a, 0x30, 0x8d, 0xd0,
+	0xa4, 0x64, 0xfa, 0xa6, 0xd5, 0x7b, 0xef, 0x7c, 0xbe, 0x25, 0x7f, 0xce, 0xf2, 0xe5, 0x37, 0x80,
+	0xd3, 0x92, 0x77, 0xb3, 0x1f, 0x32, 0xd9, 0x92, 0x78, 0x51, 0x52, 0x1f, 0xc4, 0x03, 0xb2, 0x17,
+	0xa6, 0xbd, 0x6c, 0x64, 0xd3, 0xab, 0x5d, 0x47, 0xce, 0x47, 0x89, 0x70, 0x3c, 0xf8, 0xe0, 0x7c,
+	0x0f, 0xee, 0xf9, 0x0b, 0x8e, 0x07, 0x11, 0xd0, 0x41, 0xaa, 0x10, 0x9c, 0x78, 0x81, 0x90, 0xaa,
+	0xf6, 0x2e, 0xed, 0xf1, 0xab, 0x57, 0xdd, 0xa6, 0x72, 0x00, 0xdf, 0x6d, 0x3c, 0x15, 0xd2, 0x97,
+	0x84, 0xc5, 0x87, 0x27, 0x78, 0x55, 0xe2, 0xaa, 0x17, 0x53, 0xe5, 0x38, 0xb5, 0x83, 0x4f, 0xb5,
+	0x56, 0x4f, 0x12, 0xab, 0x15, 0x51, 0x3c, 0x96, 0xc6, 0x30, 0x86, 0xff, 0xe3, 0x1d, 0x34, 0x75,
+	0x40, 0x8f, 0x48, 0x6a, 0x7a, 0xff, 0x1e, 0xba, 0xb5, 0x47, 0x8e, 0xe9, 0x11, 0x31, 0x79, 0x6d,
+	0xf5, 0x66, 0xc8, 0x16, 0xf2, 0xc2, 0xd2, 0xca, 0x2c, 0x1c, 0x89, 0x7e, 0x20, 0x00, 0x30, 0x60,
+	0xd2, 0x06, 0xaa, 0xf3, 0x55, 0x0d, 0xe1, 0x3d, 0xc2, 0x89, 0x68, 0x87, 0x9c, 0xbf, 0xa1, 0xac,
+	0x2b, 0x19, 0x4d, 0x6a, 0xaa, 0xac, 0x29, 0xa4, 0xa6, 0xaa, 0x0c, 0x34, 0x71, 0x43, 0x12, 0x7f,
+	0xc7, 0x7b, 0x47, 0x11, 0x33, 0xb0, 0xdc, 0x18, 0x6a, 0xd3, 0x0d, 0xe5, 0xc7, 0x29, 0xac, 0xfd,
+	0xf4, 0xf2, 0x35, 0x46, 0xf5, 0x1c, 0x1a, 0xf6, 0x2a, 0x28, 0x0c, 0xfd, 0x4a, 0xa5, 0x4e, 0x33,
+	0x3f, 0xcc, 0xba, 0x46, 0x05, 0x33, 0x54, 0xfa, 0x8f, 0x4c, 0xba, 0xa8, 0x4d, 0x18, 0xa7, 0x69,
+	0x98, 0xa8, 0x4a, 0xeb, 0x3a, 0x55, 0xa8, 0x0a, 0x7b, 0xda, 0x4a, 0x0b, 0x4d, 0xee, 0x8c, 0x9c,
+	0x40, 0x3e, 0xd4, 0x46, 0xaa, 0xc2, 0x72, 0xd0, 0xe1, 0x26, 0x19, 0xe8, 0x14, 0x2f, 0x24, 0x03,
+	0x5d, 0x4d, 0x61, 0x22, 0xcb, 0x29, 0x9d, 0xfe, 0xea, 0xcc, 0x1a, 0x15, 0xbc, 0x98, 0xa3, 0x79,
+	0xd5, 0x31, 0x2a, 0xab, 0x5c, 0xa1, 0x3a, 0xbf, 0x57, 0xe9, 0x1c, 0xcc, 0xfa, 0x6a, 0x25, 0x9b,
+	0xd9, 0xab, 0x7d, 0x8e, 0x26, 0x77, 0x06, 0x5a, 0x27, 0x88, 0x49, 0xd1, 0x3a, 0xa2, 0xc2, 0x02,
+	0x27, 0xa7, 0x29, 0x7d, 0x23, 0x92, 0x29, 0xb6, 0x26, 0xea, 0xec, 0x44, 0x67, 0x81, 0x39, 0x97,
+	0x6b, 0x82, 0x7b, 0x6e, 0x16, 0x58, 0xc9, 0x4a, 0x69, 0x60, 0x29, 0x2e, 0x7f, 0xe9, 0x78, 0x5a,
+	0x31, 0x70, 0x83, 0xf5, 0x39, 0xaa, 0xab, 0x5a, 0xeb, 0x22, 0xb6, 0x43, 0x3a, 0xc2, 0x4b, 0x7d,
+	0x7c, 0xeb, 0x8b, 0x79, 0x68, 0x13, 0x1f, 0x82, 0xa6, 0x73, 0x60, 0xd9, 0x89, 0x49, 0x5e, 0x7a,
+	0x3e, 0x87, 0xee, 0x70, 0x7e, 0x91, 0x23, 0xfb, 0xc6, 0x9b, 0xff, 0x79, 0x1b, 0xd5, 0x9f, 0xcb,
+	0x63, 0x68, 0x33, 0x92, 0x6c, 0xa3, 0x1b, 0xfb, 0x24, 0xed, 0xe2, 0xa9, 0x86, 0x3e, 0x9e, 0x06,
+	0xb5, 0x77, 0xd7, 0xbc, 0x81, 0x0e, 0x24, 0x19, 0x87, 0xde, 0xfe, 0xfb, 0x53, 0xe6, 0x54, 0x9b,
+	0x13, 0xb5, 0xb1, 0x8b, 0xd1, 0x1c, 0xc4, 0x1a, 0x8c, 0x0f, 0xc8, 0x60, 0x98, 0x84, 0x82, 0x70,
+	0x13, 0xa7, 0x9c, 0xb0, 0xd0, 0x8b, 0x73, 0x3a, 0xa7, 0x2d, 0x6c, 0xba, 0x4d, 0x13, 0x89, 0x0c,
+	0xf5, 0x15, 0x9a, 0x69, 0x8f, 0x72, 0x4c, 0x78, 0xc1, 0x75, 0xdf, 0x48, 0xbd, 0x4a, 0xa9, 0xb3,
+	0xce, 0x2f, 0x22, 0x43, 0x3d, 0xde, 0x20, 0xac, 0x16, 0xf0, 0x39, 0xfc, 0x87, 0xee, 0xd2, 0xde,
+	0xd5, 0x98, 0xda, 0x8c, 0x8f, 0xd8, 0x3b, 0x59, 0x26, 0xa6, 0x48, 0x17, 0x9c, 0x1a, 0x94, 0x9d,
+	0xee, 0x19, 0xfe, 0x13, 0x38, 0xe8, 0x61, 0xe4, 0x38, 0x26, 0x6f, 0x72, 0xd4, 0x6b, 0xe5, 0x38,
+	0x69, 0xb3, 0xc2, 0x58, 0x54, 0x69, 0x51, 0x4a, 0xd7, 0x96, 0x9c, 0x18, 0x2a, 0x53, 0xb5, 0x48,
+	0x58, 0x00, 0xf7, 0x0f, 0x08, 0x17, 0x57, 0x74, 0x61, 0x7c, 0xf5, 0x75, 0x62, 0xd3, 0x5f, 0x2a,
+	0x33, 0x0b, 0xa2, 0x92, 0xf6, 0x54, 0x0d, 0x83, 0x50, 0x6c, 0x8b, 0x24, 0x31, 0xcc, 0x7b, 0x84,
+	0xe3, 0xfb, 0x06, 0x12, 0x74, 0x56, 0x6e, 0x18, 0x1f, 0x8c, 0x53, 0x6b, 0x5e, 0x7b, 0x7a, 0xa7,
+	0x79, 0xbb, 0x16, 0xba, 0x83, 0x26, 0xf7, 0x08, 0xd7, 0x9e, 0x72, 0xbc, 0x68, 0xa0, 0x94, 0xd0,
+	0x30, 0x2c, 0x15, 0xc5, 0xe3, 0x62, 0x69, 0x91, 0xe5, 0x0c, 0xa3, 0xbe, 0x87, 0x2f, 0xd0, 0xec,
+	0x4e, 0xda, 0xd3, 0x91, 0x7c, 0x72, 0x4c, 0x52, 0x91, 0x25, 0x62, 0xac, 0xc4, 0x50, 0xbd, 0x6d,
+	0xa8, 0xda, 0x8c, 0x46, 0x84, 0x73, 0x5d, 0x9f, 0x13, 0x63, 0x35, 0x8e, 0x99, 0x48, 0x7d, 0x70,
+	0xda, 0x66, 0xf4, 0x38, 0xee, 0x12, 0x26, 0x13, 0x24, 0xbf, 0xbc, 0x85, 0xa6, 0x7f, 0xac, 0x6e,
+	0x8c, 0x98, 0xaf, 0xfc, 0x0f, 0xd5, 0xe0, 0xa8, 0xa5, 0xb0, 0xc9, 0x34, 0x57, 0x4a, 0x5c, 0xb1,
+	0xfd, 0x32, 0xab, 0xb5, 0xda, 0x8b, 0x39, 0xe9, 0xc5, 0x24, 0x9e, 0x30, 0xf7, 0x52, 0x38, 0x7e,
+	0x8a, 0x10, 0x6c, 0xb5, 0xd5, 0x2b, 0x9e, 0xcd, 0xca, 0x6b, 0x89, 0x57, 0x92, 0x98, 0x94, 0x9e,
+	0x67, 0x41, 0x20, 0x68, 0x07, 0x08, 0x6d, 0x93, 0x0c, 0xc7, 0xcb, 0x4a, 0x59, 0xa1, 0xdd, 0x51,
+	0x14, 0x11, 0x75, 0x32, 0x0e, 0xcf, 0x66, 0x88, 0x66, 0x70, 0xed, 0xa3, 0xba, 0xde, 0xba, 0x6b,
+	0x60, 0x5b, 0xc1, 0x9c, 0xdc, 0x76, 0xad, 0x31, 0x6a, 0x1d, 0x00, 0xcd, 0xb4, 0x5e, 0x66, 0xfa,
+	0xaa, 0x86, 0x16, 0x9d, 0x98, 0x39, 0xbd, 0xf9, 0x41, 0x2e, 0xa6, 0xe5, 0xee, 0xfc, 0x70, 0xac,
+	0x3e, 0x9f, 0xb3, 0xf7, 0x7d, 0x87, 0x54, 0xd3, 0x48, 0x6e, 0xa7, 0x17, 0xaa, 0x61, 0x6c, 0x76,
+	0x8f, 0x68, 0x91, 0xa9, 0xf6, 0xbd, 0x8c, 0x23, 0x53, 0xd9, 0xf5, 0x6f, 0x85, 0x4a, 0x33, 0x7f,
+	0x4f, 0x32, 0xbf, 0xe3, 0x3f, 0x1a, 0xc7, 0xcc, 0x4c, 0x11, 0x7d, 0x4a, 0xbc, 0xdc, 0x1e, 0xb1,
+	0x1e, 0xc9, 0x62, 0x10, 0x76, 0x77, 0x89, 0x10, 0x84, 0xc1, 0x31, 0xa0, 0x61, 0x91, 0x16, 0x8e,
+	0xca, 0x0e, 0x65, 0xe3, 0x2d, 0xb4, 0x3b, 0xef, 0x49, 0x77, 0x02, 0x7f, 0x7d, 0x7c, 0x20, 0xc2,
+	0xee, 0x46, 0xa2, 0x4a, 0x05, 0x43, 0x80, 0x81, 0xaf, 0xe2, 0x3f, 0x6a, 0x68, 0x72, 0xb3, 0x1f,
+	0x9a, 0x23, 0x61, 0x7c, 0x88, 0xb0, 0xca, 0x15, 0x80, 0x50, 0x2f, 0xfa, 0x61, 0xd6, 0x95, 0x77,
+	0xa6, 0x94, 0xc6, 0x48, 0x0b, 0x9b, 0x04, 0x85, 0x22, 0x8b, 0xda, 0xe9, 0xca, 0xce, 0x8b, 0x00,
+	0x10, 0x70, 0xa9, 0x87, 0x78, 0x1c, 0xa2, 0x69, 0xb5, 0xf9, 0x80, 0x62, 0x7b, 0x94, 0x0e, 0xb2,
+	0xf3, 0x9a, 0x7e, 0xa8, 0x77, 0x63, 0x76, 0x56, 0x97, 0xe4, 0xa0, 0x38, 0x60, 0x61, 0xaa, 0x52,
+	0x0f, 0xfe, 0xdb, 0x12, 0xf9, 0x21, 0xbe, 0xaf, 0x90, 0x19, 0xa5, 0x70, 0x40, 0x03, 0x48, 0xaa,
+	0xba, 0x6a, 0x43, 0xd2, 0xfc, 0x29, 0xaa, 0xeb, 0xdc, 0x87, 0xae, 0xe0, 0x47, 0xe8, 0xa6, 0x3c,
+	0xc4, 0xc5, 0xf3, 0xea, 0x70, 0x5e, 0x69, 0x0b, 0x79, 0x49, 0x23, 0x84, 0xbd, 0x16, 0x37, 0x1d,
+	0xdb, 0xaf, 0x6b, 0xff, 0x83, 0x14, 0x00, 0x20, 0x7a, 0xff, 0x7d, 0x0d, 0x4d, 0x3e, 0x27, 0x22,
+	0xb4, 0x1b, 0x10, 0xc8, 0x4c, 0x83, 0x24, 0x9b, 0xe3, 0x89, 0x08, 0xe1, 0xb8, 0x28, 0x97, 0xae,
+	0x42, 0x8a, 0x1a, 0xfc, 0x70, 0xd6, 0xe2, 0x03, 0x22, 0xc2, 0xa0, 0x47, 0x44, 0x70, 0x0a, 0x8a,
+	0xec, 0xbe, 0xce, 0xae, 0x3c, 0x7a, 0x90, 0x98, 0x0b, 0x16, 0xd3, 0xc6, 0xf8, 0x3c, 0x34, 0x5e,
+	0x42, 0xfb, 0x03, 0x93, 0x81, 0xbf, 0x92, 0x93, 0x36, 0x09, 0x24, 0x61, 0x55, 0xb6, 0xb7, 0x80,
+	0xfc, 0x29, 0x9a, 0xdc, 0x26, 0xe2, 0xf1, 0x28, 0x39, 0x92, 0xd0, 0x7a, 0x2d, 0xeb, 0x88, 0xec,
+	0x04, 0xa2, 0xd2, 0xe3, 0x99, 0x38, 0x9f, 0x11, 0xf4, 0xa7, 0x15, 0x89, 0xcc, 0x2b, 0xf7, 0x08,
+	0x4c, 0x85, 0xcd, 0x7f, 0xb9, 0x81, 0x66, 0x60, 0x27, 0xe4, 0xc6, 0xba, 0x87, 0xa6, 0x5f, 0xc8,
+	0xbb, 0x58, 0x46, 0x81, 0x3d, 0x95, 0x2d, 0xcf, 0x09, 0xed, 0x7e, 0xa8, 0x4a, 0x97, 0x5f, 0x42,
+	0x7b, 0x73, 0x32, 0xb7, 0xbe, 0x21, 0xe9, 0xd5, 0x3d, 0x2f, 0x95, 0xc8, 0x99, 0xb6, 0x67, 0x04,
+	0x0e, 0x51, 0x5e, 0x68, 0xa7, 0xfc, 0xec, 0xf0, 0x20, 0xdf, 0x4e, 0xce, 0x42, 0xdd, 0xb2, 0xd8,
+	0x0f, 0xa2, 0x8b, 0xea, 0x50, 0xe6, 0x31, 0xa5, 0x47, 0x83, 0x90, 0x1d, 0x65, 0x8b, 0xc4, 0x9c,
+	0xf0, 0xa2, 0x10, 0xda, 0xe6, 0xb7, 0x14, 0x1d, 0x53, 0x18, 0x58, 0xfe, 0xac, 0x86, 0x96, 0xf3,
+	0x41, 0xc8, 0xda, 0x1d, 0x7f, 0xab, 0x22, 0x44, 0xa5, 0x5e, 0xf1, 0xd6, 0xf9, 0x46, 0x79, 0x3f,
+	0x3c, 0xd7, 0x8f, 0xd4, 0x58, 0x81, 0x1f, 0xa7, 0x6a, 0x42, 0x28, 0x3b, 0xf1, 0x28, 0x4b, 0xd9,
+	0x8f, 0x75, 0xe1, 0x51, 0x3e, 0xc2, 0x99, 0xbe, 0x1c, 0x6a, 0x5c, 0xc9, 0xdf, 0xfc, 0xf2, 0x3a,
+	0x9a, 0x7c, 0x46, 0x3b, 0xdc, 0xf4, 0xa4, 0x9f, 0xa9, 0xd0, 0xab, 0x4d, 0xeb, 0x33, 0xda, 0x31,
+	0xdf, 0x19, 0x08, 0x9f, 0xd1, 0x4e, 0xc5, 0xb1, 0x90, 0x94, 0x96, 0xea, 0x2a, 0xef, 0x69, 0xaa,
+	0xe3, 0x9d, 0x67, 0xb4, 0x93, 0x5d, 0x5f, 0x7b, 0x89, 0xa6, 0xe4, 0x2e, 0x3e, 0xe6, 0x02, 0x58,
+	0xf1, 0x62, 0x03, 0x0c, 0x1b, 0xe6, 0xbd, 0xa2, 0xe3, 0x80, 0xb8, 0x32, 0x85, 0x9d, 0x31, 0xa8,
+	0xfc, 0xcd, 0xb4, 0x74, 0x5b, 0x5d, 0xbb, 0x01, 0xbf, 0xe7, 0x14, 0xf2, 0xa6, 0x60, 0xc9, 0x26,
+	0x1d, 0x0c, 0xc2, 0xb4, 0xeb, 0xdd, 0x2b, 0x89, 0x8a, 0xa7, 0x6b, 0x5e, 0x01, 0x96, 0xa8, 0x4f,
+	0x4d, 0x8d, 0x12, 0x07, 0x21, 0x3f, 0x82, 0xab, 0x43, 0x12, 0xc4, 0x11, 0xd9, 0x7d, 0x69, 0x59,
+	0x53, 0xca, 0xab, 0x48, 0x78, 0x01, 0x4a, 0x7b, 0x4e, 0xd4, 0xfc, 0xbb, 0x6b, 0x68, 0x56, 0xde,
+	0x5f, 0x38, 0x60, 0x24, 0x3b, 0x9b, 0x78, 0x85, 0xea, 0x10, 0x96, 0x4c, 0x6e, 0x6e, 0x50, 0x81,
+	0x50, 0x8e, 0xda, 0x17, 0x5c, 0xc7, 0xb1, 0x29, 0x78, 0x28, 0x16, 0x84, 0x80, 0x93, 0x5d, 0x82,
+	0x79, 0x85, 0xea, 0xfb, 0x22, 0x74, 0xc0, 0x17, 0x15, 0xf8, 0x1e, 0x09, 0xbb, 0x00, 0x64, 0x3f,
+	0xae, 0x82, 0xb8, 0x74, 0xec, 0xe5, 0x80, 0x73, 0x11, 0x4a, 0xf0, 0x04, 0xcd, 0xed, 0x91, 0xe8,
+	0x24, 0x4a, 0xc8, 0xe3, 0x18, 0x36, 0xa4, 0x32, 0x9d, 0x78, 0xd7, 0xec, 0x47, 0x1d, 0x45, 0x21,
+	0x3d, 0x93, 0x69, 0x9c, 0x5a, 0xe8, 0x0d, 0x2b, 0xbe, 0xeb, 0x12, 0x31, 0x65, 0xba, 0xd1, 0x89,
+	0x53, 0xde, 0xfc, 0xf7, 0xeb, 0x68, 0x66, 0x8b, 0x46, 0xfb, 0x82, 0x32, 0x62, 0x8f, 0xc6, 0xee,
+	0xc8, 0x35, 0x12, 0x8d, 0x72, 0xbb, 0xfc, 0x2d, 0x7d, 0xe1, 0xb8, 0xd0, 0xcd, 0x8c, 0xd8, 0xa1,
+	0xb5, 0xa7, 0x0c, 0xd9, 0x6d, 0xe5, 0x53, 0xc9, 0xb0, 0xb3, 0x75, 0xa6, 0x92, 0x42, 0xfa, 0x8c,
+	0x70, 0x8b, 0x46, 0x78, 0xad, 0x61, 0x8c, 0x1a, 0x99, 0x70, 0x34, 0x70, 0x17, 0xef, 0x8f, 0xce,
+	0xb1, 0xd0, 0x11, 0x5d, 0x97, 0x8c, 0x6f, 0xf9, 0x0f, 0x2d, 0x23, 0x8c, 0xfa, 0x9f, 0x99, 0xf9,
+	0xc5, 0x65, 0x67, 0xf2, 0x40, 0x13, 0xa8, 0x57, 0x2d, 0xb0, 0x92, 0x48, 0x54, 0xbb, 0x4a, 0xaf,
+	0xd6, 0x6a, 0xca, 0xef, 0x4a, 0xca, 0xb7, 0xbd, 0xb5, 0x8a, 0x4a, 0x06, 0xa7, 0xc6, 0x5c, 0x73,
+	0x52, 0x74, 0x6b, 0x9b, 0x14, 0x39, 0x95, 0x64, 0x1c, 0xe7, 0x36, 0x29, 0x73, 0x7e, 0x47, 0x72,
+	0xfa, 0xf8, 0x42, 0xce, 0xe6, 0x3f, 0xd5, 0xd0, 0xd4, 0x36, 0x5c, 0xe6, 0x37, 0x8d, 0xfa, 0x53,
+	0x34, 0x21, 0x8f, 0xde, 0x05, 0xec, 0x37, 0x97, 0xec, 0x08, 0x21, 0x05, 0x85, 0xc4, 0x8d, 0x23,
+	0xd7, 0xc4, 0xba, 0x45, 0xf1, 0x52, 0x20, 0x7f, 0x21, 0x20, 0x3b, 0x2b, 0x70, 0x93, 0x1e, 0x10,
+	0x9e, 0xe1, 0x57, 0xe8, 0xce, 0x1e, 0x49, 0xe4, 0xd9, 0x06, 0x36, 0xd7, 0x01, 0xf4, 0x7b, 0x61,
+	0xa6, 0xb1, 0xe2, 0x7c, 0x52, 0x05, 0xdf, 0xd5, 0xd0, 0x4c, 0x1b, 0xa8, 0x8c, 0x25, 0x5c, 0xa1,
+	0xe8, 0xa1, 0xfa, 0x66, 0x1f, 0x52, 0xd6, 0xa6, 0x2e, 0x2f, 0xe5, 0x2e, 0x46, 0xc9, 0x78, 0x76,
+	0xf3, 0xb8, 0xef, 0x66, 0xbb, 0x97, 0x5c, 0x61, 0xe5, 0x77, 0x1d, 0xa9, 0xe2, 0x50, 0x89, 0x9f,
+	0xab, 0x56, 0x6a, 0xfe, 0xe2, 0x26, 0x9a, 0xda, 0xef, 0x87, 0xf6, 0x4b, 0xd8, 0x94, 0x17, 0x14,
+	0x36, 0x49, 0x92, 0x98, 0x91, 0x5c, 0xbf, 0xda, 0xa5, 0x8d, 0xa2, 0x21, 0x49, 0x62, 0x92, 0x5b,
+	0xde, 0x64, 0x20, 0x7f, 0x38, 0x21, 0x6f, 0x7e, 0x43, 0xdb, 0x6f, 0xcb, 0xa5, 0x9c, 0x0b, 0xb2,
+	0x4d, 0xc6, 0x82, 0xd8, 0x5d, 0xb5, 0x05, 0x31, 0x9b, 0x9f, 0x57, 0x66, 0xc5, 0x25, 0xb1, 0x96,
+	0xdd, 0x8c, 0x89, 0x0b, 0x77, 0xb7, 0xac, 0xc8, 0x6f, 0xd9, 0xd7, 0xab, 0xc0, 0xf7, 0xe4, 0x61,
+	0xae, 0xac, 0xfd, 0x6e, 0x9c, 0x1e, 0x99, 0x0f, 0xdf, 0x95, 0x19, 0x82, 0x19, 0xa5, 0xca, 0xe4,
+	0xa5, 0x9a, 0x27, 0x71, 0x7a, 0xa4, 0xe7, 0xab, 0x6d, 0x52, 0xc6, 0xdc, 0x26, 0x97, 0xc0, 0x2c,
+	0x06, 0x02, 0x30, 0x8d, 0xaf, 0xaf, 0xcd, 0x51, 0xb1, 0x85, 0x5e, 0x75, 0x2b, 0x5d, 0x42, 0xbf,
+	0x3f, 0x46, 0x3b, 0x26, 0x2e, 0x2e, 0xd7, 0x1b, 0x34, 0x2f, 0x53, 0x9c, 0xa0, 0x80, 0x19, 0x4f,
+	0xdf, 0xb7, 0x76, 0xee, 0x8b, 0x16, 0x54, 0x85, 0xc5, 0x45, 0xa5, 0x45, 0x69, 0x1e, 0x50, 0xbc,
+	0xcc, 0x58, 0x40, 0x67, 0xfc, 0x9b, 0xeb, 0x68, 0x7a, 0x47, 0xfd, 0x8a, 0xc1, 0x74, 0xc7, 0x9f,
+	0xc8, 0x7e, 0xaf, 0x85, 0x78, 0xa5, 0x61, 0x7e, 0xe4, 0x00, 0x43, 0x05, 0x39, 0x0c, 0x61, 0x8b,
+	0x61, 0xd8, 0x57, 0xab, 0x95, 0x9a, 0x58, 0x5f, 0x40, 0xc1, 0x77, 0xcc, 0xef, 0x24, 0xf0, 0x0b,
+	0x34, 0xd9, 0xa6, 0x3c, 0xc3, 0x5e, 0xce, 0x8a, 0x6b, 0x89, 0xed, 0x5c, 0x25, 0x85, 0xc6, 0xb4,
+	0xa7, 0x7e, 0xda, 0x02, 0x7a, 0xc0, 0x00, 0xcd, 0xb7, 0x09, 0x83, 0x3b, 0x6f, 0xda, 0x7c, 0xb3,
+	0x4f, 0x22, 0x68, 0x2d, 0x83, 0xa2, 0xb5, 0x52, 0x6c, 0x5b, 0xab, 0x5a, 0x5b, 0x5a, 0xdd, 0x6b,
+	0xb3, 0x20, 0x02, 0x3d, 0xd0, 0xf5, 0x64, 0x87, 0x6b, 0xf5, 0x18, 0x21, 0x30, 0x2e, 0xe1, 0x5c,
+	0x14, 0x32, 0x71, 0x99, 0x27, 0xaf, 0x2d, 0x25, 0xb8, 0x0c, 0x4f, 0x68, 0x6c, 0x9a, 0xff, 0x5c,
+	0x43, 0x75, 0xb5, 0x74, 0x35, 0x6d, 0xd3, 0x36, 0x9b, 0x08, 0x40, 0x8f, 0x19, 0xe9, 0xe2, 0xc5,
+	0x86, 0xfe, 0x85, 0x87, 0x95, 0xab, 0x91, 0xa9, 0x20, 0xd6, 0x74, 0xfa, 0xce, 0x0a, 0xbe, 0xad,
+	0x37, 0x0c, 0xb8, 0x87, 0x26, 0x5b, 0xc3, 0x61, 0x72, 0xa2, 0xec, 0xb0, 0x67, 0xca, 0x39, 0x42,
+	0xbb, 0x08, 0xa8, 0xd2, 0xe5, 0x97, 0x95, 0x78, 0x59, 0x03, 0x07, 0xa7, 0x07, 0x21, 0xeb, 0x65,
+	0x97, 0xeb, 0xcf, 0x60, 0x01, 0x35, 0xf3, 0x54, 0xff, 0xbc, 0xcb, 0xee, 0xde, 0xa7, 0xf6, 0x89,
+	0x10, 0x71, 0xda, 0xe3, 0xcf, 0x49, 0x3a, 0x32, 0x9f, 0xae, 0x2b, 0x2b, 0xec, 0xda, 0xf3, 0xaa,
+	0x12, 0xb7, 0xf9, 0xfd, 0x58, 0xc0, 0xb5, 0xdd, 0xc6, 0x00, 0x70, 0x7f, 0x82, 0xee, 0x48, 0xea,
+	0x5d, 0xda, 0x33, 0x13, 0x87, 0x79, 0xd7, 0xd9, 0x01, 0x6f, 0x29, 0x2f, 0x2e, 0xce, 0x49, 0xde,
+	0xbc, 0xc5, 0x96, 0x0f, 0x09, 0xed, 0xe9, 0x63, 0xf3, 0xba, 0x2c, 0xf3, 0x98, 0x52, 0xf9, 0x23,
+	0x15, 0xb3, 0x0f, 0xca, 0x09, 0x0b, 0xcb, 0xa8, 0x82, 0xae, 0xd4, 0x13, 0x32, 0xa6, 0x0e, 0xa5,
+	0x02, 0x2e, 0xfe, 0x35, 0x29, 0x9a, 0xde, 0x8d, 0x23, 0x92, 0x72, 0x62, 0x37, 0x01, 0x53, 0x46,
+	0x22, 0x42, 0x01, 0x4b, 0xa8, 0x88, 0x30, 0xd1, 0x70, 0x65, 0x36, 0x74, 0x15, 0x2a, 0x4d, 0x6a,
+	0xcf, 0x4a, 0x12, 0xa5, 0x96, 0x93, 0x2e, 0x7f, 0xfc, 0xab, 0xda, 0xd7, 0xad, 0xbf, 0xac, 0xe1,
+	0xf7, 0xd1, 0x42, 0x1b, 0x7e, 0x60, 0xb4, 0x06, 0x23, 0x3c, 0x5f, 0xdb, 0x23, 0x5c, 0xac, 0xb5,
+	0xda, 0x3b, 0xbe, 0x87, 0x6e, 0x4a, 0x39, 0x9e, 0xeb, 0x0b, 0x31, 0xe4, 0x1f, 0x06, 0xea, 0x77,
+	0x48, 0xf0, 0x8b, 0xa4, 0xe6, 0xf5, 0x1f, 0x34, 0xbe, 0xbf, 0x7e, 0xbd, 0x76, 0xed, 0x46, 0x73,
+	0x36, 0x1c, 0x0e, 0x13, 0x7d, 0x19, 0x21, 0x78, 0xcd, 0x69, 0xfa, 0x61, 0x49, 0xc2, 0xbe, 0x8f,
+	0x56, 0x9e, 0x53, 0x46, 0xd6, 0xc2, 0x0e, 0x1d, 0x89, 0x35, 0x97, 0xac, 0x35, 0x8c, 0x79, 0x05,
+	0x7e, 0xe7, 0x96, 0xfc, 0xfd, 0xd1, 0xbb, 0xff, 0x3b, 0x00, 0x77, 0x55, 0xa3, 0xa6, 0xab, 0x38,
 	0x00, 0x00,
 }
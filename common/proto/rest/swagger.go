@@ -100,6 +100,114 @@ var SwaggerJson = `{
         ]
       }
     },
+    "/activity/feeds/{Context}/{ContextData}": {
+      "get": {
+        "summary": "Render the activities of a folder or of a user outbox as an Atom or ActivityStreams JSON feed",
+        "operationId": "Feed",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/activityObject"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "Context",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "ContextData",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "Format",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "title": "Output format, \"atom\" (default) or \"json\" for ActivityStreams"
+          },
+          {
+            "name": "Limit",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int32"
+          },
+          {
+            "name": "Language",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "Token",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "title": "Personal access token or JWT, for Atom feed readers that cannot send an Authorization or X-Pydio-Feed-Token header"
+          }
+        ],
+        "tags": [
+          "ActivityService"
+        ]
+      }
+    },
+    "/activity/preferences": {
+      "get": {
+        "summary": "Load the notification preferences of the current user",
+        "operationId": "GetNotificationPreferences",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/activityNotificationPreferences"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ActivityService"
+        ]
+      },
+      "put": {
+        "summary": "Store the notification preferences of the current user",
+        "operationId": "PutNotificationPreferences",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/activityNotificationPreferences"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/activityNotificationPreferences"
+            }
+          }
+        ],
+        "tags": [
+          "ActivityService"
+        ]
+      }
+    },
     "/activity/stream": {
       "post": {
         "summary": "Load the the feeds of the currently logged user",
@@ -181,6 +289,108 @@ var SwaggerJson = `{
         ]
       }
     },
+    "/auth/impersonate": {
+      "post": {
+        "summary": "Open a time-boxed session as another user, for support purposes",
+        "operationId": "Impersonate",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restImpersonateResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restImpersonateRequest"
+            }
+          }
+        ],
+        "tags": [
+          "TokenService"
+        ]
+      }
+    },
+    "/auth/personal-tokens": {
+      "get": {
+        "summary": "List personal access tokens with their last usage date",
+        "operationId": "ListPersonalTokens",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restPersonalTokenCollection"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "UserLogin",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "TokenService"
+        ]
+      },
+      "put": {
+        "summary": "Create a personal access token for API and sync clients",
+        "operationId": "CreatePersonalToken",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restCreatePersonalTokenResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restCreatePersonalTokenRequest"
+            }
+          }
+        ],
+        "tags": [
+          "TokenService"
+        ]
+      }
+    },
+    "/auth/personal-tokens/{Uuid}": {
+      "delete": {
+        "summary": "Revoke a personal access token",
+        "operationId": "RevokePersonalToken",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restRevokeResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "Uuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "TokenService"
+        ]
+      }
+    },
     "/auth/reset-password": {
       "post": {
         "summary": "Finish up the reset password process by providing the unique token",
@@ -233,6 +443,83 @@ var SwaggerJson = `{
         ]
       }
     },
+    "/auth/sessions": {
+      "get": {
+        "summary": "List active sessions with their client, address and last seen date",
+        "operationId": "ListSessions",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restSessionCollection"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "UserLogin",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "TokenService"
+        ]
+      }
+    },
+    "/auth/sessions/revoke": {
+      "post": {
+        "summary": "Revoke all sessions of a user, optionally keeping the current one",
+        "operationId": "RevokeSessions",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restRevokeResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restRevokeSessionsRequest"
+            }
+          }
+        ],
+        "tags": [
+          "TokenService"
+        ]
+      }
+    },
+    "/auth/sessions/{Uuid}": {
+      "delete": {
+        "summary": "Revoke a session, logging out the corresponding device",
+        "operationId": "RevokeSession",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restRevokeResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "Uuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "TokenService"
+        ]
+      }
+    },
     "/auth/token/revoke": {
       "post": {
         "summary": "Revoke a JWT token",
@@ -294,6 +581,65 @@ var SwaggerJson = `{
         ]
       }
     },
+    "/chat/rooms/{RoomUuid}/export": {
+      "get": {
+        "summary": "Export the transcript of a chat room as JSON or as a printable HTML document",
+        "operationId": "ExportChatRoom",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/chatChatTranscript"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "RoomUuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "Format",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "title": "Output format, \"json\" (default) or \"html\" for a printable document"
+          }
+        ],
+        "tags": [
+          "ChatService"
+        ]
+      }
+    },
+    "/chat/search": {
+      "post": {
+        "summary": "Search messages in all the chat rooms visible by the current user",
+        "operationId": "SearchChatMessages",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restChatSearchCollection"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/chatSearchMessagesRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ChatService"
+        ]
+      }
+    },
     "/config/ctl": {
       "get": {
         "summary": "List all services and their status",
@@ -1252,19 +1598,226 @@ var SwaggerJson = `{
           }
         },
         "tags": [
-          "FrontendService"
+          "FrontendService"
+        ]
+      }
+    },
+    "/frontend/frontlogs": {
+      "put": {
+        "summary": "Sends a log from front (php) to back",
+        "operationId": "FrontLog",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restFrontLogResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restFrontLogMessage"
+            }
+          }
+        ],
+        "tags": [
+          "FrontendService"
+        ]
+      }
+    },
+    "/frontend/settings-menu": {
+      "get": {
+        "summary": "Sends a tree of nodes to be used a menu in the Settings panel",
+        "operationId": "SettingsMenu",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restSettingsMenuResponse"
+            }
+          }
+        },
+        "tags": [
+          "FrontendService"
+        ]
+      }
+    },
+    "/graph/relation/{UserId}": {
+      "get": {
+        "summary": "Compute relation of context user with another user",
+        "operationId": "Relation",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restRelationResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "UserId",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "GraphService"
+        ]
+      }
+    },
+    "/graph/state/{Segment}": {
+      "get": {
+        "summary": "Compute accessible workspaces for a given user",
+        "operationId": "UserState",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restUserStateResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "Segment",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "GraphService"
+        ]
+      }
+    },
+    "/install": {
+      "get": {
+        "summary": "Loads default values for install form",
+        "operationId": "GetInstall",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/installGetDefaultsResponse"
+            }
+          }
+        },
+        "tags": [
+          "InstallService"
+        ]
+      },
+      "post": {
+        "summary": "Post values to be saved for install",
+        "operationId": "PostInstall",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/installInstallResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installInstallRequest"
+            }
+          }
+        ],
+        "tags": [
+          "InstallService"
+        ]
+      }
+    },
+    "/install/agreement": {
+      "get": {
+        "summary": "Perform a check during install (like DB connection, php-fpm detection, etc)",
+        "operationId": "GetAgreement",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/installGetAgreementResponse"
+            }
+          }
+        },
+        "tags": [
+          "InstallService"
+        ]
+      }
+    },
+    "/install/check": {
+      "post": {
+        "summary": "Perform a check during install (like DB connection, php-fpm detection, etc)",
+        "operationId": "PerformInstallCheck",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/installPerformCheckResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installPerformCheckRequest"
+            }
+          }
+        ],
+        "tags": [
+          "InstallService"
+        ]
+      }
+    },
+    "/jobs/tasks/delete": {
+      "post": {
+        "summary": "Send a control command to clean tasks on a given job",
+        "operationId": "UserDeleteTasks",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/jobsDeleteTasksResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/jobsDeleteTasksRequest"
+            }
+          }
+        ],
+        "tags": [
+          "JobsService"
         ]
       }
     },
-    "/frontend/frontlogs": {
-      "put": {
-        "summary": "Sends a log from front (php) to back",
-        "operationId": "FrontLog",
+    "/jobs/user": {
+      "post": {
+        "summary": "List jobs associated with current user",
+        "operationId": "UserListJobs",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/restFrontLogResponse"
+              "$ref": "#/definitions/restUserJobsCollection"
             }
           }
         },
@@ -1274,106 +1827,135 @@ var SwaggerJson = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/restFrontLogMessage"
+              "$ref": "#/definitions/jobsListJobsRequest"
             }
           }
         ],
         "tags": [
-          "FrontendService"
+          "JobsService"
         ]
-      }
-    },
-    "/frontend/settings-menu": {
-      "get": {
-        "summary": "Sends a tree of nodes to be used a menu in the Settings panel",
-        "operationId": "SettingsMenu",
+      },
+      "put": {
+        "summary": "Send Control Commands to one or many jobs / tasks",
+        "operationId": "UserControlJob",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/restSettingsMenuResponse"
+              "$ref": "#/definitions/jobsCtrlCommandResponse"
             }
           }
         },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/jobsCtrlCommand"
+            }
+          }
+        ],
         "tags": [
-          "FrontendService"
+          "JobsService"
         ]
       }
     },
-    "/graph/relation/{UserId}": {
-      "get": {
-        "summary": "Compute relation of context user with another user",
-        "operationId": "Relation",
+    "/jobs/user/{JobName}": {
+      "put": {
+        "summary": "Create a predefined job to be run directly",
+        "operationId": "UserCreateJob",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/restRelationResponse"
+              "$ref": "#/definitions/restUserJobResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "UserId",
+            "name": "JobName",
             "in": "path",
             "required": true,
             "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restUserJobRequest"
+            }
           }
         ],
         "tags": [
-          "GraphService"
+          "JobsService"
         ]
       }
     },
-    "/graph/state/{Segment}": {
+    "/license/stats": {
       "get": {
-        "summary": "Compute accessible workspaces for a given user",
-        "operationId": "UserState",
+        "summary": "[Enterprise Only] Display statistics about licenses usage",
+        "operationId": "LicenseStats",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/restUserStateResponse"
+              "$ref": "#/definitions/certLicenseStatsResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "Segment",
-            "in": "path",
-            "required": true,
-            "type": "string"
+            "name": "ForceRefresh",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
           }
         ],
         "tags": [
-          "GraphService"
+          "LicenseService"
         ]
       }
     },
-    "/install": {
-      "get": {
-        "summary": "Loads default values for install form",
-        "operationId": "GetInstall",
+    "/log/audit": {
+      "post": {
+        "summary": "Auditable Logs, in Json or CSV format",
+        "operationId": "Audit",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/installGetDefaultsResponse"
+              "$ref": "#/definitions/restLogMessageCollection"
             }
           }
         },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logListLogRequest"
+            }
+          }
+        ],
         "tags": [
-          "InstallService"
+          "EnterpriseLogService"
         ]
-      },
+      }
+    },
+    "/log/audit/chartdata": {
       "post": {
-        "summary": "Post values to be saved for install",
-        "operationId": "PostInstall",
+        "summary": "Retrieves aggregated audit logs to generate charts",
+        "operationId": "AuditChartData",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/installInstallResponse"
+              "$ref": "#/definitions/restTimeRangeResultCollection"
             }
           }
         },
@@ -1383,41 +1965,51 @@ var SwaggerJson = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/installInstallRequest"
+              "$ref": "#/definitions/logTimeRangeRequest"
             }
           }
         ],
         "tags": [
-          "InstallService"
+          "EnterpriseLogService"
         ]
       }
     },
-    "/install/agreement": {
-      "get": {
-        "summary": "Perform a check during install (like DB connection, php-fpm detection, etc)",
-        "operationId": "GetAgreement",
+    "/log/audit/export": {
+      "post": {
+        "summary": "Auditable Logs, in Json or CSV format",
+        "operationId": "AuditExport",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/installGetAgreementResponse"
+              "$ref": "#/definitions/restLogMessageCollection"
             }
           }
         },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logListLogRequest"
+            }
+          }
+        ],
         "tags": [
-          "InstallService"
+          "EnterpriseLogService"
         ]
       }
     },
-    "/install/check": {
+    "/log/audit/verify": {
       "post": {
-        "summary": "Perform a check during install (like DB connection, php-fpm detection, etc)",
-        "operationId": "PerformInstallCheck",
+        "summary": "Verify the hash chain of audit logs over a time range and report the first break",
+        "operationId": "AuditVerify",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/installPerformCheckResponse"
+              "$ref": "#/definitions/logVerifyAuditChainResponse"
             }
           }
         },
@@ -1427,24 +2019,24 @@ var SwaggerJson = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/installPerformCheckRequest"
+              "$ref": "#/definitions/logVerifyAuditChainRequest"
             }
           }
         ],
         "tags": [
-          "InstallService"
+          "LogService"
         ]
       }
     },
-    "/jobs/tasks/delete": {
+    "/log/export": {
       "post": {
-        "summary": "Send a control command to clean tasks on a given job",
-        "operationId": "UserDeleteTasks",
+        "summary": "Stream all logs matching the query and typed filters as CSV or JSON, for auditors",
+        "operationId": "ExportLogs",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/jobsDeleteTasksResponse"
+              "$ref": "#/definitions/restLogMessageCollection"
             }
           }
         },
@@ -1454,24 +2046,24 @@ var SwaggerJson = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/jobsDeleteTasksRequest"
+              "$ref": "#/definitions/logListLogRequest"
             }
           }
         ],
         "tags": [
-          "JobsService"
+          "LogService"
         ]
       }
     },
-    "/jobs/user": {
+    "/log/sys": {
       "post": {
-        "summary": "List jobs associated with current user",
-        "operationId": "UserListJobs",
+        "summary": "Technical Logs, in Json or CSV format",
+        "operationId": "Syslog",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/restUserJobsCollection"
+              "$ref": "#/definitions/restLogMessageCollection"
             }
           }
         },
@@ -1481,22 +2073,24 @@ var SwaggerJson = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/jobsListJobsRequest"
+              "$ref": "#/definitions/logListLogRequest"
             }
           }
         ],
         "tags": [
-          "JobsService"
+          "LogService"
         ]
-      },
-      "put": {
-        "summary": "Send Control Commands to one or many jobs / tasks",
-        "operationId": "UserControlJob",
+      }
+    },
+    "/log/sys/export": {
+      "post": {
+        "summary": "Technical Logs, in Json or CSV format",
+        "operationId": "SyslogExport",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/jobsCtrlCommandResponse"
+              "$ref": "#/definitions/restLogMessageCollection"
             }
           }
         },
@@ -1506,83 +2100,148 @@ var SwaggerJson = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/jobsCtrlCommand"
+              "$ref": "#/definitions/logListLogRequest"
             }
           }
         ],
         "tags": [
-          "JobsService"
+          "EnterpriseLogService"
         ]
       }
     },
-    "/jobs/user/{JobName}": {
-      "put": {
-        "summary": "Create a predefined job to be run directly",
-        "operationId": "UserCreateJob",
+    "/mailer/deliveries": {
+      "get": {
+        "summary": "List delivery records of outgoing mails, most recent first",
+        "operationId": "ListMailDeliveries",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/restUserJobResponse"
+              "$ref": "#/definitions/mailerListDeliveriesResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "JobName",
-            "in": "path",
-            "required": true,
+            "name": "Status",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string",
+              "enum": [
+                "QUEUED",
+                "SENT",
+                "RETRYING",
+                "FAILED",
+                "DELIVERED",
+                "BOUNCED",
+                "COMPLAINED"
+              ]
+            }
+          },
+          {
+            "name": "Recipient",
+            "in": "query",
+            "required": false,
             "type": "string"
           },
+          {
+            "name": "DeadLetters",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "Offset",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int32"
+          },
+          {
+            "name": "Limit",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int32"
+          }
+        ],
+        "tags": [
+          "MailerService"
+        ]
+      }
+    },
+    "/mailer/deliveries/resend": {
+      "post": {
+        "summary": "Queue mails that exhausted their attempts again",
+        "operationId": "ResendMails",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/mailerResendResponse"
+            }
+          }
+        },
+        "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/restUserJobRequest"
+              "$ref": "#/definitions/mailerResendRequest"
             }
           }
         ],
         "tags": [
-          "JobsService"
+          "MailerService"
         ]
       }
     },
-    "/license/stats": {
-      "get": {
-        "summary": "[Enterprise Only] Display statistics about licenses usage",
-        "operationId": "LicenseStats",
+    "/mailer/events/{Provider}": {
+      "post": {
+        "summary": "Inbound webhook for bounces and complaints reported by the mail provider",
+        "operationId": "IngestMailEvents",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/certLicenseStatsResponse"
+              "$ref": "#/definitions/mailerProcessDeliveryEventsResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "ForceRefresh",
-            "in": "query",
-            "required": false,
-            "type": "boolean",
-            "format": "boolean"
+            "name": "Provider",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restMailEventsRequest"
+            }
           }
         ],
         "tags": [
-          "LicenseService"
+          "MailerService"
         ]
       }
     },
-    "/log/audit": {
+    "/mailer/send": {
       "post": {
-        "summary": "Auditable Logs, in Json or CSV format",
-        "operationId": "Audit",
+        "summary": "Send an email to a user or any email address",
+        "operationId": "Send",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/restLogMessageCollection"
+              "$ref": "#/definitions/mailerSendMailResponse"
             }
           }
         },
@@ -1592,51 +2251,47 @@ var SwaggerJson = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logListLogRequest"
+              "$ref": "#/definitions/mailerMail"
             }
           }
         ],
         "tags": [
-          "EnterpriseLogService"
+          "MailerService"
         ]
       }
     },
-    "/log/audit/chartdata": {
-      "post": {
-        "summary": "Retrieves aggregated audit logs to generate charts",
-        "operationId": "AuditChartData",
+    "/mailer/templates": {
+      "get": {
+        "summary": "List mail templates, with their custom variant or their default strings for a given language",
+        "operationId": "ListMailTemplates",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/restTimeRangeResultCollection"
+              "$ref": "#/definitions/restMailTemplatesCollection"
             }
           }
         },
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/logTimeRangeRequest"
-            }
+            "name": "Language",
+            "in": "query",
+            "required": false,
+            "type": "string"
           }
         ],
         "tags": [
-          "EnterpriseLogService"
+          "MailerService"
         ]
-      }
-    },
-    "/log/audit/export": {
-      "post": {
-        "summary": "Auditable Logs, in Json or CSV format",
-        "operationId": "AuditExport",
+      },
+      "put": {
+        "summary": "Store a custom variant of a mail template",
+        "operationId": "PutMailTemplate",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/restLogMessageCollection"
+              "$ref": "#/definitions/mailerMailTemplate"
             }
           }
         },
@@ -1646,24 +2301,24 @@ var SwaggerJson = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logListLogRequest"
+              "$ref": "#/definitions/mailerMailTemplate"
             }
           }
         ],
         "tags": [
-          "EnterpriseLogService"
+          "MailerService"
         ]
       }
     },
-    "/log/sys": {
+    "/mailer/templates/preview": {
       "post": {
-        "summary": "Technical Logs, in Json or CSV format",
-        "operationId": "Syslog",
+        "summary": "Render a mail template with sample data",
+        "operationId": "PreviewMailTemplate",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/restLogMessageCollection"
+              "$ref": "#/definitions/restMailTemplatePreviewResponse"
             }
           }
         },
@@ -1673,24 +2328,24 @@ var SwaggerJson = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logListLogRequest"
+              "$ref": "#/definitions/restMailTemplatePreviewRequest"
             }
           }
         ],
         "tags": [
-          "LogService"
+          "MailerService"
         ]
       }
     },
-    "/log/sys/export": {
+    "/mailer/templates/test": {
       "post": {
-        "summary": "Technical Logs, in Json or CSV format",
-        "operationId": "SyslogExport",
+        "summary": "Send a rendered mail template to the current user",
+        "operationId": "SendTestMailTemplate",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/restLogMessageCollection"
+              "$ref": "#/definitions/mailerSendMailResponse"
             }
           }
         },
@@ -1700,19 +2355,19 @@ var SwaggerJson = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logListLogRequest"
+              "$ref": "#/definitions/restMailTemplatePreviewRequest"
             }
           }
         ],
         "tags": [
-          "EnterpriseLogService"
+          "MailerService"
         ]
       }
     },
-    "/mailer/send": {
-      "post": {
-        "summary": "Send an email to a user or any email address",
-        "operationId": "Send",
+    "/mailer/templates/{TemplateId}": {
+      "delete": {
+        "summary": "Remove a custom variant of a mail template, reverting to the default strings",
+        "operationId": "DeleteMailTemplate",
         "responses": {
           "200": {
             "description": "",
@@ -1723,12 +2378,16 @@ var SwaggerJson = `{
         },
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
+            "name": "TemplateId",
+            "in": "path",
             "required": true,
-            "schema": {
-              "$ref": "#/definitions/mailerMail"
-            }
+            "type": "string"
+          },
+          {
+            "name": "Language",
+            "in": "query",
+            "required": false,
+            "type": "string"
           }
         ],
         "tags": [
@@ -2326,6 +2985,32 @@ var SwaggerJson = `{
         ]
       }
     },
+    "/tree/admin/recycle-bins": {
+      "get": {
+        "summary": "Report size and entries of recycle bins per workspace",
+        "operationId": "RecycleBinsReport",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restRecycleBinsCollection"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "WorkspaceUuid",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "title": "Restrict report to a given workspace"
+          }
+        ],
+        "tags": [
+          "AdminTreeService"
+        ]
+      }
+    },
     "/tree/admin/stat": {
       "post": {
         "summary": "Read a node information inside the admin tree",
@@ -2731,30 +3416,219 @@ var SwaggerJson = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/idmUser"
+              "$ref": "#/definitions/idmUser"
+            }
+          }
+        ],
+        "tags": [
+          "UserService"
+        ]
+      }
+    },
+    "/user/{Login}/bind": {
+      "post": {
+        "summary": "Bind a user with her login and password",
+        "operationId": "BindUser",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restBindResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "Login",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/idmUser"
+            }
+          }
+        ],
+        "tags": [
+          "UserService"
+        ]
+      }
+    },
+    "/webhooks": {
+      "get": {
+        "summary": "List all registered webhooks",
+        "operationId": "ListWebhooks",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/webhookListWebhooksResponse"
+            }
+          }
+        },
+        "tags": [
+          "WebhookService"
+        ]
+      },
+      "put": {
+        "summary": "Create or update a webhook",
+        "operationId": "PutWebhook",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/webhookWebhook"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhookWebhook"
+            }
+          }
+        ],
+        "tags": [
+          "WebhookService"
+        ]
+      }
+    },
+    "/webhooks/{Uuid}": {
+      "get": {
+        "summary": "Load a webhook by its Uuid",
+        "operationId": "GetWebhook",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/webhookWebhook"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "Uuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "WebhookService"
+        ]
+      },
+      "delete": {
+        "summary": "Delete a webhook with its delivery log and dead letters",
+        "operationId": "DeleteWebhook",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/webhookDeleteWebhookResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "Uuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "WebhookService"
+        ]
+      }
+    },
+    "/webhooks/{WebhookUuid}/dead-letters/purge": {
+      "post": {
+        "summary": "Delete dead letters of a webhook",
+        "operationId": "PurgeWebhookDeadLetters",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/webhookPurgeDeadLettersResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "WebhookUuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhookPurgeDeadLettersRequest"
+            }
+          }
+        ],
+        "tags": [
+          "WebhookService"
+        ]
+      }
+    },
+    "/webhooks/{WebhookUuid}/deliveries": {
+      "post": {
+        "summary": "List the delivery log or the dead letters of a webhook",
+        "operationId": "ListWebhookDeliveries",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/webhookListDeliveriesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "WebhookUuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhookListDeliveriesRequest"
             }
           }
         ],
         "tags": [
-          "UserService"
+          "WebhookService"
         ]
       }
     },
-    "/user/{Login}/bind": {
+    "/webhooks/{WebhookUuid}/redeliver": {
       "post": {
-        "summary": "Bind a user with her login and password",
-        "operationId": "BindUser",
+        "summary": "Queue dead letters again for delivery",
+        "operationId": "RedeliverWebhook",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/restBindResponse"
+              "$ref": "#/definitions/webhookRedeliverResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "Login",
+            "name": "WebhookUuid",
             "in": "path",
             "required": true,
             "type": "string"
@@ -2764,12 +3638,12 @@ var SwaggerJson = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/idmUser"
+              "$ref": "#/definitions/webhookRedeliverRequest"
             }
           }
         ],
         "tags": [
-          "UserService"
+          "WebhookService"
         ]
       }
     },
@@ -2942,6 +3816,37 @@ var SwaggerJson = `{
       ],
       "default": "PUT"
     },
+    "activityNotificationDelivery": {
+      "type": "string",
+      "enum": [
+        "DEFAULT",
+        "IN_APP",
+        "DIGEST",
+        "INSTANT"
+      ],
+      "default": "DEFAULT",
+      "title": "How a user is notified of the activities posted to its inbox"
+    },
+    "activityNotificationPreferences": {
+      "type": "object",
+      "properties": {
+        "UserId": {
+          "type": "string"
+        },
+        "DefaultDelivery": {
+          "$ref": "#/definitions/activityNotificationDelivery"
+        },
+        "EventsDelivery": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/activityNotificationDelivery"
+          }
+        },
+        "QuietHours": {
+          "$ref": "#/definitions/activityQuietHours"
+        }
+      }
+    },
     "activityObject": {
       "type": "object",
       "properties": {
@@ -3226,6 +4131,23 @@ var SwaggerJson = `{
       ],
       "default": "NODE"
     },
+    "activityQuietHours": {
+      "type": "object",
+      "properties": {
+        "StartMinute": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "EndMinute": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "TimeZone": {
+          "type": "string"
+        }
+      },
+      "title": "Daily time range during which instant emails are deferred to the digest"
+    },
     "activitySearchSubscriptionsRequest": {
       "type": "object",
       "properties": {
@@ -3314,6 +4236,9 @@ var SwaggerJson = `{
           "items": {
             "type": "string"
           }
+        },
+        "Delivery": {
+          "$ref": "#/definitions/activityNotificationDelivery"
         }
       }
     },
@@ -3454,57 +4379,324 @@ var SwaggerJson = `{
         "SchedulerDetails": {
           "type": "string"
         }
-      }
+      }
+    },
+    "authPersonalToken": {
+      "type": "object",
+      "properties": {
+        "Uuid": {
+          "type": "string"
+        },
+        "Label": {
+          "type": "string"
+        },
+        "UserUuid": {
+          "type": "string"
+        },
+        "UserLogin": {
+          "type": "string"
+        },
+        "Scopes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "CreatedAt": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "ExpiresAt": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "LastUsedAt": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "Impersonator": {
+          "type": "string"
+        },
+        "Reason": {
+          "type": "string"
+        }
+      }
+    },
+    "authSession": {
+      "type": "object",
+      "properties": {
+        "Uuid": {
+          "type": "string"
+        },
+        "UserUuid": {
+          "type": "string"
+        },
+        "UserLogin": {
+          "type": "string"
+        },
+        "ConnectorId": {
+          "type": "string"
+        },
+        "ClientApp": {
+          "type": "string"
+        },
+        "UserAgent": {
+          "type": "string"
+        },
+        "RemoteAddress": {
+          "type": "string"
+        },
+        "CreatedAt": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "LastSeenAt": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "RefreshId": {
+          "type": "string"
+        },
+        "Current": {
+          "type": "boolean",
+          "format": "boolean"
+        }
+      }
+    },
+    "certLicenseInfo": {
+      "type": "object",
+      "properties": {
+        "Id": {
+          "type": "string"
+        },
+        "AccountName": {
+          "type": "string"
+        },
+        "ServerDomain": {
+          "type": "string"
+        },
+        "IssueTime": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "ExpireTime": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "MaxUsers": {
+          "type": "string",
+          "format": "int64"
+        },
+        "MaxPeers": {
+          "type": "string",
+          "format": "int64"
+        },
+        "Features": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "certLicenseStatsResponse": {
+      "type": "object",
+      "properties": {
+        "License": {
+          "$ref": "#/definitions/certLicenseInfo"
+        },
+        "ActiveUsers": {
+          "type": "string",
+          "format": "int64"
+        },
+        "ActivePeers": {
+          "type": "string",
+          "format": "int64"
+        }
+      }
+    },
+    "chatChatMessage": {
+      "type": "object",
+      "properties": {
+        "Uuid": {
+          "type": "string"
+        },
+        "RoomUuid": {
+          "type": "string"
+        },
+        "Message": {
+          "type": "string"
+        },
+        "Author": {
+          "type": "string"
+        },
+        "Timestamp": {
+          "type": "string",
+          "format": "int64"
+        },
+        "Activity": {
+          "$ref": "#/definitions/activityObject"
+        },
+        "ThreadUuid": {
+          "type": "string",
+          "title": "Uuid of the first message of the thread this message replies to"
+        },
+        "EditedAt": {
+          "type": "string",
+          "format": "int64",
+          "title": "Last edition time, zero if the message was never edited"
+        },
+        "History": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/chatChatMessageRevision"
+          },
+          "title": "Previous versions of the message, oldest first"
+        },
+        "Reactions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/chatChatReaction"
+          }
+        },
+        "Mentions": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Logins of the users mentioned with @login in the message"
+        }
+      }
+    },
+    "chatChatMessageRevision": {
+      "type": "object",
+      "properties": {
+        "Message": {
+          "type": "string"
+        },
+        "Timestamp": {
+          "type": "string",
+          "format": "int64"
+        }
+      }
+    },
+    "chatChatReaction": {
+      "type": "object",
+      "properties": {
+        "Emoji": {
+          "type": "string"
+        },
+        "Users": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "chatChatRoom": {
+      "type": "object",
+      "properties": {
+        "Uuid": {
+          "type": "string"
+        },
+        "Type": {
+          "$ref": "#/definitions/chatRoomType"
+        },
+        "RoomTypeObject": {
+          "type": "string"
+        },
+        "RoomLabel": {
+          "type": "string"
+        },
+        "Users": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "LastUpdated": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "chatChatTranscript": {
+      "type": "object",
+      "properties": {
+        "Room": {
+          "$ref": "#/definitions/chatChatRoom"
+        },
+        "Messages": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/chatChatMessage"
+          }
+        },
+        "ExportedBy": {
+          "type": "string"
+        },
+        "ExportedAt": {
+          "type": "string",
+          "format": "int64"
+        }
+      },
+      "title": "Full transcript of a room, as exported by the REST API"
     },
-    "certLicenseInfo": {
+    "chatRoomType": {
+      "type": "string",
+      "enum": [
+        "GLOBAL",
+        "WORKSPACE",
+        "USER",
+        "NODE"
+      ],
+      "default": "GLOBAL"
+    },
+    "chatSearchMessagesRequest": {
       "type": "object",
       "properties": {
-        "Id": {
-          "type": "string"
+        "Query": {
+          "type": "string",
+          "title": "Words to look for, the last one is matched as a prefix"
         },
-        "AccountName": {
-          "type": "string"
+        "RoomUuids": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Restrict search to these rooms"
         },
-        "ServerDomain": {
+        "Author": {
           "type": "string"
         },
-        "IssueTime": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "ExpireTime": {
-          "type": "integer",
-          "format": "int32"
+        "AfterTimestamp": {
+          "type": "string",
+          "format": "int64"
         },
-        "MaxUsers": {
+        "BeforeTimestamp": {
           "type": "string",
           "format": "int64"
         },
-        "MaxPeers": {
+        "Limit": {
           "type": "string",
           "format": "int64"
         },
-        "Features": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
+        "Offset": {
+          "type": "string",
+          "format": "int64",
+          "title": "Number of results to skip, most recent first"
         }
       }
     },
-    "certLicenseStatsResponse": {
+    "chatSearchMessagesResponse": {
       "type": "object",
       "properties": {
-        "License": {
-          "$ref": "#/definitions/certLicenseInfo"
-        },
-        "ActiveUsers": {
-          "type": "string",
-          "format": "int64"
+        "Message": {
+          "$ref": "#/definitions/chatChatMessage"
         },
-        "ActivePeers": {
-          "type": "string",
-          "format": "int64"
+        "Room": {
+          "$ref": "#/definitions/chatChatRoom"
         }
       }
     },
@@ -5024,6 +6216,26 @@ var SwaggerJson = `{
         }
       }
     },
+    "logAuditChainBreak": {
+      "type": "object",
+      "properties": {
+        "Sequence": {
+          "type": "string",
+          "format": "int64",
+          "title": "Sequence of the message where the chain breaks"
+        },
+        "Ts": {
+          "type": "integer",
+          "format": "int32",
+          "title": "Timestamp of the message, if it is still present"
+        },
+        "Reason": {
+          "type": "string",
+          "title": "Human readable explanation"
+        }
+      },
+      "description": "AuditChainBreak describes the first inconsistency found in the audit chain."
+    },
     "logListLogRequest": {
       "type": "object",
       "properties": {
@@ -5043,6 +6255,49 @@ var SwaggerJson = `{
         },
         "Format": {
           "$ref": "#/definitions/ListLogRequestLogFormat"
+        },
+        "UserName": {
+          "type": "string",
+          "title": "Typed filters, combined with Query"
+        },
+        "UserUuid": {
+          "type": "string"
+        },
+        "NodeUuid": {
+          "type": "string"
+        },
+        "NodePath": {
+          "type": "string"
+        },
+        "WsUuid": {
+          "type": "string"
+        },
+        "MsgIds": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "From": {
+          "type": "integer",
+          "format": "int32",
+          "title": "Time range as unix timestamps, zero values are unbounded"
+        },
+        "To": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "AuditContext": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "title": "Filter on audit context values by key"
+        },
+        "AuditOnly": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Only search audit logs"
         }
       },
       "description": "ListLogRequest launches a parameterised query in the log repository and streams the results."
@@ -5087,6 +6342,10 @@ var SwaggerJson = `{
             "type": "string"
           }
         },
+        "Impersonator": {
+          "type": "string",
+          "title": "Login of the admin acting on behalf of UserName, if any"
+        },
         "RemoteAddress": {
           "type": "string",
           "title": "Client info"
@@ -5117,89 +6376,262 @@ var SwaggerJson = `{
         "SpanParentUuid": {
           "type": "string"
         },
-        "SpanRootUuid": {
-          "type": "string"
-        }
-      },
-      "description": "LogMessage is the format used to transmit log messages to clients via the REST API."
-    },
-    "logRelType": {
-      "type": "string",
-      "enum": [
-        "NONE",
-        "FIRST",
-        "PREV",
-        "NEXT",
-        "LAST"
-      ],
-      "default": "NONE",
-      "description": "Relative links types.\nNote that First is time.Now() and last time.Unix(0).\nWe added an unused NONE enum with value 0 to workaround 0 issues between JSON and proto3."
-    },
-    "logTimeRangeCursor": {
-      "type": "object",
-      "properties": {
-        "Rel": {
-          "$ref": "#/definitions/logRelType"
+        "SpanRootUuid": {
+          "type": "string"
+        },
+        "ChainSeq": {
+          "type": "string",
+          "format": "int64",
+          "title": "Audit chain: position of the message, hash of the previous message and hash of this one"
+        },
+        "ChainPrevHash": {
+          "type": "string"
+        },
+        "ChainHash": {
+          "type": "string"
+        },
+        "AuditContext": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "title": "Structured context of audit messages (share, target user, ACL, job...) referenced by key"
+        }
+      },
+      "description": "LogMessage is the format used to transmit log messages to clients via the REST API."
+    },
+    "logRelType": {
+      "type": "string",
+      "enum": [
+        "NONE",
+        "FIRST",
+        "PREV",
+        "NEXT",
+        "LAST"
+      ],
+      "default": "NONE",
+      "description": "Relative links types.\nNote that First is time.Now() and last time.Unix(0).\nWe added an unused NONE enum with value 0 to workaround 0 issues between JSON and proto3."
+    },
+    "logTimeRangeCursor": {
+      "type": "object",
+      "properties": {
+        "Rel": {
+          "$ref": "#/definitions/logRelType"
+        },
+        "RefTime": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "Count": {
+          "type": "integer",
+          "format": "int32"
+        }
+      },
+      "description": "Ease implementation of data navigation for a chart."
+    },
+    "logTimeRangeRequest": {
+      "type": "object",
+      "properties": {
+        "MsgId": {
+          "type": "string",
+          "title": "Type of event we are auditing"
+        },
+        "TimeRangeType": {
+          "type": "string",
+          "title": "Known types: H, D, W, M or Y"
+        },
+        "RefTime": {
+          "type": "integer",
+          "format": "int32",
+          "title": "Upper bound for our request"
+        }
+      },
+      "description": "TimeRangeRequest contains the parameter to configure the query to \nretrieve the number of audit events of this type for a given time range\ndefined by last timestamp and a range type."
+    },
+    "logTimeRangeResult": {
+      "type": "object",
+      "properties": {
+        "Name": {
+          "type": "string",
+          "title": "a label for this time range"
+        },
+        "Start": {
+          "type": "integer",
+          "format": "int32",
+          "title": "begin timestamp"
+        },
+        "End": {
+          "type": "integer",
+          "format": "int32",
+          "title": "end timestamp"
+        },
+        "Count": {
+          "type": "integer",
+          "format": "int32",
+          "title": "nb of occurrences found within this range"
+        },
+        "Relevance": {
+          "type": "integer",
+          "format": "int32",
+          "title": "a score between 1 and 100 that gives the relevance of this result:\nif End \u003e now, we ponderate the returned count with the duration of the last time range\nfor instance for a hour range if now is 6PM, last count will be \nmultiplied by 4/3 and have a relevance of 75. \nRelevance will be almost always equals to 100"
+        }
+      },
+      "description": "TimeRangeResult represents one point of a graph."
+    },
+    "logVerifyAuditChainRequest": {
+      "type": "object",
+      "properties": {
+        "From": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "To": {
+          "type": "integer",
+          "format": "int32"
+        }
+      },
+      "description": "VerifyAuditChainRequest selects the audit messages to verify by timestamp. Zero values are unbounded."
+    },
+    "logVerifyAuditChainResponse": {
+      "type": "object",
+      "properties": {
+        "Valid": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "Checked": {
+          "type": "string",
+          "format": "int64",
+          "title": "Number of messages checked"
+        },
+        "Checkpoints": {
+          "type": "string",
+          "format": "int64",
+          "title": "Number of signed checkpoints checked"
+        },
+        "FirstSequence": {
+          "type": "string",
+          "format": "int64"
+        },
+        "LastSequence": {
+          "type": "string",
+          "format": "int64"
+        },
+        "Break": {
+          "$ref": "#/definitions/logAuditChainBreak",
+          "title": "First break found, if the chain is not valid"
+        }
+      },
+      "description": "VerifyAuditChainResponse is the verification report."
+    },
+    "mailerDelivery": {
+      "type": "object",
+      "properties": {
+        "Uuid": {
+          "type": "string"
+        },
+        "TemplateId": {
+          "type": "string"
+        },
+        "Subject": {
+          "type": "string"
+        },
+        "Recipients": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "Status": {
+          "$ref": "#/definitions/mailerDeliveryStatus"
+        },
+        "Attempts": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "LastError": {
+          "type": "string"
+        },
+        "ProviderMessageId": {
+          "type": "string",
+          "title": "Identifier of the message for the mail provider, used to match bounces and complaints"
+        },
+        "CreatedAt": {
+          "type": "integer",
+          "format": "int32"
         },
-        "RefTime": {
+        "LastAttemptAt": {
           "type": "integer",
           "format": "int32"
         },
-        "Count": {
+        "NextAttemptAt": {
           "type": "integer",
           "format": "int32"
+        },
+        "DeadLetter": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Whether the mail is kept in the dead letters and can be resent"
+        },
+        "Seq": {
+          "type": "string",
+          "format": "uint64"
         }
       },
-      "description": "Ease implementation of data navigation for a chart."
+      "title": "Delivery records the outcome of a mail sent by the service"
     },
-    "logTimeRangeRequest": {
+    "mailerDeliveryEvent": {
       "type": "object",
       "properties": {
-        "MsgId": {
+        "MessageId": {
           "type": "string",
-          "title": "Type of event we are auditing"
+          "title": "Delivery Uuid, provider message id or Message-ID header"
         },
-        "TimeRangeType": {
-          "type": "string",
-          "title": "Known types: H, D, W, M or Y"
+        "Recipient": {
+          "type": "string"
         },
-        "RefTime": {
+        "Status": {
+          "$ref": "#/definitions/mailerDeliveryStatus",
+          "title": "One of DELIVERED, BOUNCED or COMPLAINED"
+        },
+        "Reason": {
+          "type": "string"
+        },
+        "Timestamp": {
           "type": "integer",
-          "format": "int32",
-          "title": "Upper bound for our request"
+          "format": "int32"
         }
       },
-      "description": "TimeRangeRequest contains the parameter to configure the query to \nretrieve the number of audit events of this type for a given time range\ndefined by last timestamp and a range type."
+      "title": "DeliveryEvent is a status reported by the mail provider for a sent message"
     },
-    "logTimeRangeResult": {
+    "mailerDeliveryStatus": {
+      "type": "string",
+      "enum": [
+        "QUEUED",
+        "SENT",
+        "RETRYING",
+        "FAILED",
+        "DELIVERED",
+        "BOUNCED",
+        "COMPLAINED"
+      ],
+      "default": "QUEUED",
+      "title": "- FAILED: All attempts failed, the mail is kept in the dead letters\n - DELIVERED: Statuses reported by the provider after the mail was sent"
+    },
+    "mailerListDeliveriesResponse": {
       "type": "object",
       "properties": {
-        "Name": {
-          "type": "string",
-          "title": "a label for this time range"
-        },
-        "Start": {
-          "type": "integer",
-          "format": "int32",
-          "title": "begin timestamp"
-        },
-        "End": {
-          "type": "integer",
-          "format": "int32",
-          "title": "end timestamp"
-        },
-        "Count": {
-          "type": "integer",
-          "format": "int32",
-          "title": "nb of occurrences found within this range"
+        "Deliveries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/mailerDelivery"
+          }
         },
-        "Relevance": {
+        "Total": {
           "type": "integer",
-          "format": "int32",
-          "title": "a score between 1 and 100 that gives the relevance of this result:\nif End \u003e now, we ponderate the returned count with the duration of the last time range\nfor instance for a hour range if now is 6PM, last count will be \nmultiplied by 4/3 and have a relevance of 75. \nRelevance will be almost always equals to 100"
+          "format": "int32"
         }
-      },
-      "description": "TimeRangeResult represents one point of a graph."
+      }
     },
     "mailerMail": {
       "type": "object",
@@ -5266,6 +6698,88 @@ var SwaggerJson = `{
           "items": {
             "type": "string"
           }
+        },
+        "Uuid": {
+          "type": "string",
+          "title": "Identifier of the delivery record, assigned when the mail is accepted by the service"
+        },
+        "NextAttemptAt": {
+          "type": "integer",
+          "format": "int32",
+          "title": "Unix time before which a failed mail is not retried"
+        }
+      }
+    },
+    "mailerMailTemplate": {
+      "type": "object",
+      "properties": {
+        "TemplateId": {
+          "type": "string"
+        },
+        "Language": {
+          "type": "string",
+          "title": "Language code this variant applies to, empty for all languages"
+        },
+        "Subject": {
+          "type": "string"
+        },
+        "ContentMarkdown": {
+          "type": "string",
+          "title": "Replaces the bundle Intros and Outros"
+        },
+        "ContentHtml": {
+          "type": "string",
+          "title": "Full HTML document, bypassing the hermes layout"
+        },
+        "LinkLabel": {
+          "type": "string"
+        },
+        "LinkInstructions": {
+          "type": "string"
+        },
+        "Variables": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/mailerTemplateVariable"
+          },
+          "title": "Read-only: variables available for this template"
+        },
+        "Custom": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Read-only: whether this variant is stored or built from the bundle"
+        }
+      },
+      "description": "MailTemplate is an admin-defined variant of a template, replacing the strings of the i18n bundle.\nSubject, ContentMarkdown, LinkLabel and LinkInstructions are go templates receiving\n.TplData, .User and .Configs, like the i18n strings. Empty fields fall back to the bundle."
+    },
+    "mailerProcessDeliveryEventsResponse": {
+      "type": "object",
+      "properties": {
+        "Count": {
+          "type": "integer",
+          "format": "int32",
+          "title": "Number of events matching a known delivery"
+        }
+      }
+    },
+    "mailerResendRequest": {
+      "type": "object",
+      "properties": {
+        "Uuids": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Dead letters to queue again. Empty means all dead letters."
+        }
+      }
+    },
+    "mailerResendResponse": {
+      "type": "object",
+      "properties": {
+        "Count": {
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
@@ -5278,6 +6792,22 @@ var SwaggerJson = `{
         }
       }
     },
+    "mailerTemplateVariable": {
+      "type": "object",
+      "properties": {
+        "Name": {
+          "type": "string"
+        },
+        "Description": {
+          "type": "string"
+        },
+        "Sample": {
+          "type": "string",
+          "title": "Value used when rendering a preview"
+        }
+      },
+      "title": "TemplateVariable describes one of the TplData keys that a template expects"
+    },
     "mailerUser": {
       "type": "object",
       "properties": {
@@ -5540,6 +7070,22 @@ var SwaggerJson = `{
         }
       }
     },
+    "restChatSearchCollection": {
+      "type": "object",
+      "properties": {
+        "Results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/chatSearchMessagesResponse"
+          }
+        },
+        "Total": {
+          "type": "integer",
+          "format": "int32"
+        }
+      },
+      "title": "Messages found in the chat rooms visible by the current user, most recent first"
+    },
     "restConfiguration": {
       "type": "object",
       "properties": {
@@ -5566,6 +7112,37 @@ var SwaggerJson = `{
         }
       }
     },
+    "restCreatePersonalTokenRequest": {
+      "type": "object",
+      "properties": {
+        "Label": {
+          "type": "string"
+        },
+        "ExpiresAt": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "Scopes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      },
+      "title": "Request to create a personal access token for the current user"
+    },
+    "restCreatePersonalTokenResponse": {
+      "type": "object",
+      "properties": {
+        "Token": {
+          "$ref": "#/definitions/authPersonalToken"
+        },
+        "AccessToken": {
+          "type": "string"
+        }
+      },
+      "title": "Rest response, the AccessToken value cannot be retrieved later on"
+    },
     "restDataSourceCollection": {
       "type": "object",
       "properties": {
@@ -5784,6 +7361,40 @@ var SwaggerJson = `{
         }
       }
     },
+    "restImpersonateRequest": {
+      "type": "object",
+      "properties": {
+        "UserLogin": {
+          "type": "string"
+        },
+        "Duration": {
+          "type": "integer",
+          "format": "int32",
+          "title": "Session duration in seconds, defaults to 30 minutes"
+        },
+        "AllowWrite": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Sessions are read-only unless explicitly allowed"
+        },
+        "Reason": {
+          "type": "string",
+          "title": "Justification recorded in the audit log and visible to the user"
+        }
+      },
+      "title": "Request to log in as another user, for admins only"
+    },
+    "restImpersonateResponse": {
+      "type": "object",
+      "properties": {
+        "Token": {
+          "$ref": "#/definitions/authPersonalToken"
+        },
+        "AccessToken": {
+          "type": "string"
+        }
+      }
+    },
     "restListDocstoreRequest": {
       "type": "object",
       "properties": {
@@ -5884,17 +7495,78 @@ var SwaggerJson = `{
       "default": "DEBUG",
       "title": "Frontend Log Level"
     },
-    "restLogMessageCollection": {
+    "restLogMessageCollection": {
+      "type": "object",
+      "properties": {
+        "Logs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/logLogMessage"
+          }
+        }
+      },
+      "title": "Collection of serialized log messages"
+    },
+    "restMailEventsRequest": {
+      "type": "object",
+      "properties": {
+        "Provider": {
+          "type": "string"
+        },
+        "Token": {
+          "type": "string",
+          "title": "Shared secret configured in the mailer REST service"
+        },
+        "Events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/mailerDeliveryEvent"
+          }
+        }
+      },
+      "description": "Delivery events posted by a mail provider. The body format depends on the provider:\n\"generic\" expects this message, \"sendgrid\" expects the payload of the sendgrid event webhook."
+    },
+    "restMailTemplatePreviewRequest": {
+      "type": "object",
+      "properties": {
+        "Template": {
+          "$ref": "#/definitions/mailerMailTemplate"
+        },
+        "TemplateData": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      },
+      "description": "Request for rendering a mail template, saved or not. Sample data is used for missing TemplateData keys."
+    },
+    "restMailTemplatePreviewResponse": {
+      "type": "object",
+      "properties": {
+        "Subject": {
+          "type": "string"
+        },
+        "ContentHtml": {
+          "type": "string"
+        },
+        "ContentPlain": {
+          "type": "string"
+        }
+      },
+      "title": "Rendered mail template"
+    },
+    "restMailTemplatesCollection": {
       "type": "object",
       "properties": {
-        "Logs": {
+        "Templates": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/logLogMessage"
+            "$ref": "#/definitions/mailerMailTemplate"
           }
         }
       },
-      "title": "Collection of serialized log messages"
+      "title": "Collection of mail templates"
     },
     "restMetaCollection": {
       "type": "object",
@@ -5952,6 +7624,17 @@ var SwaggerJson = `{
     "restOpenApiResponse": {
       "type": "object"
     },
+    "restPersonalTokenCollection": {
+      "type": "object",
+      "properties": {
+        "Tokens": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/authPersonalToken"
+          }
+        }
+      }
+    },
     "restPutCellRequest": {
       "type": "object",
       "properties": {
@@ -5985,6 +7668,51 @@ var SwaggerJson = `{
         }
       }
     },
+    "restRecycleBin": {
+      "type": "object",
+      "properties": {
+        "WorkspaceUuid": {
+          "type": "string"
+        },
+        "WorkspaceLabel": {
+          "type": "string"
+        },
+        "Path": {
+          "type": "string"
+        },
+        "RetentionDays": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "Size": {
+          "type": "string",
+          "format": "int64"
+        },
+        "EntriesCount": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "OldestDeletion": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "restRecycleBinsCollection": {
+      "type": "object",
+      "properties": {
+        "Bins": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/restRecycleBin"
+          }
+        },
+        "TotalSize": {
+          "type": "string",
+          "format": "int64"
+        }
+      }
+    },
     "restRelationResponse": {
       "type": "object",
       "properties": {
@@ -6074,6 +7802,20 @@ var SwaggerJson = `{
       },
       "title": "Rest response"
     },
+    "restRevokeSessionsRequest": {
+      "type": "object",
+      "properties": {
+        "UserLogin": {
+          "type": "string"
+        },
+        "KeepCurrent": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Keep the session sending this request, to log out everywhere else"
+        }
+      },
+      "title": "Revoke all sessions of a user, typically after a password change"
+    },
     "restRolesCollection": {
       "type": "object",
       "properties": {
@@ -6249,6 +7991,17 @@ var SwaggerJson = `{
         }
       }
     },
+    "restSessionCollection": {
+      "type": "object",
+      "properties": {
+        "Sessions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/authSession"
+          }
+        }
+      }
+    },
     "restSettingsEntry": {
       "type": "object",
       "properties": {
@@ -6525,6 +8278,18 @@ var SwaggerJson = `{
           "additionalProperties": {
             "type": "string"
           }
+        },
+        "Impersonator": {
+          "type": "string",
+          "title": "Set when an admin is currently logged in as this user"
+        },
+        "ImpersonationExpiresAt": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "ReadOnly": {
+          "type": "boolean",
+          "format": "boolean"
         }
       }
     },
@@ -7197,11 +8962,244 @@ var SwaggerJson = `{
           "title": "List of available binaries"
         }
       }
+    },
+    "webhookDeleteWebhookResponse": {
+      "type": "object",
+      "properties": {
+        "Success": {
+          "type": "boolean",
+          "format": "boolean"
+        }
+      }
+    },
+    "webhookDelivery": {
+      "type": "object",
+      "properties": {
+        "Uuid": {
+          "type": "string"
+        },
+        "WebhookUuid": {
+          "type": "string"
+        },
+        "EventType": {
+          "type": "string"
+        },
+        "Payload": {
+          "type": "string"
+        },
+        "Status": {
+          "$ref": "#/definitions/webhookDeliveryStatus"
+        },
+        "Attempts": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "LastStatusCode": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "LastError": {
+          "type": "string"
+        },
+        "CreatedAt": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "LastAttemptAt": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "NextAttemptAt": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "Duration": {
+          "type": "string",
+          "format": "int64",
+          "title": "Duration of the last attempt, in milliseconds"
+        },
+        "Seq": {
+          "type": "string",
+          "format": "uint64"
+        }
+      },
+      "description": "Delivery records the payload sent for one event to one webhook and the outcome of its attempts."
+    },
+    "webhookDeliveryStatus": {
+      "type": "string",
+      "enum": [
+        "PENDING",
+        "SUCCESS",
+        "RETRYING",
+        "DEAD"
+      ],
+      "default": "PENDING"
+    },
+    "webhookListDeliveriesRequest": {
+      "type": "object",
+      "properties": {
+        "WebhookUuid": {
+          "type": "string"
+        },
+        "DeadLetters": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "List dead letters instead of the delivery log"
+        },
+        "Status": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/webhookDeliveryStatus"
+          }
+        },
+        "Offset": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "Limit": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "webhookListDeliveriesResponse": {
+      "type": "object",
+      "properties": {
+        "Deliveries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/webhookDelivery"
+          }
+        },
+        "Total": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "webhookListWebhooksResponse": {
+      "type": "object",
+      "properties": {
+        "Webhooks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/webhookWebhook"
+          }
+        }
+      }
+    },
+    "webhookPurgeDeadLettersRequest": {
+      "type": "object",
+      "properties": {
+        "WebhookUuid": {
+          "type": "string"
+        },
+        "DeliveryUuids": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "description": "Dead letters to delete. Empty means all dead letters of the webhook."
+        }
+      }
+    },
+    "webhookPurgeDeadLettersResponse": {
+      "type": "object",
+      "properties": {
+        "Count": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "webhookRedeliverRequest": {
+      "type": "object",
+      "properties": {
+        "WebhookUuid": {
+          "type": "string"
+        },
+        "DeliveryUuids": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "description": "Dead letters to deliver again. Empty means all dead letters of the webhook."
+        }
+      }
+    },
+    "webhookRedeliverResponse": {
+      "type": "object",
+      "properties": {
+        "Count": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "webhookWebhook": {
+      "type": "object",
+      "properties": {
+        "Uuid": {
+          "type": "string"
+        },
+        "Label": {
+          "type": "string"
+        },
+        "Url": {
+          "type": "string",
+          "title": "URL receiving the JSON payloads with POST requests"
+        },
+        "Secret": {
+          "type": "string",
+          "title": "Secret used to sign the payloads with HMAC-SHA256"
+        },
+        "Disabled": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "EventTypes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "description": "Event types to deliver, like \"tree:create\" or \"idm:user:*\". Empty means all events."
+        },
+        "Workspaces": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "description": "Restrict tree events to nodes visible in these workspaces, and other events to these workspaces."
+        },
+        "PathPatterns": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "description": "Glob patterns matched against the node path or any of its parents."
+        },
+        "MaxAttempts": {
+          "type": "integer",
+          "format": "int32",
+          "description": "Number of attempts before a delivery is moved to the dead letters. Zero uses the service default."
+        },
+        "Owner": {
+          "type": "string"
+        },
+        "CreatedAt": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "UpdatedAt": {
+          "type": "integer",
+          "format": "int32"
+        }
+      },
+      "description": "Webhook is an external endpoint notified of application events."
     }
   },
   "externalDocs": {
     "description": "More about Pydio Cells Apis",
     "url": "https://pydio.com"
   }
-}
-`
\ No newline at end of file
+}`
\ No newline at end of file
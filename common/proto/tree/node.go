@@ -105,6 +105,30 @@ func (node *Node) HasMetaKey(keyName string) bool {
 	return ok
 }
 
+// GetGeoPoint reads the GPS position stored in the geolocation metadata, if it is valid.
+func (node *Node) GetGeoPoint() (*GeoPoint, bool) {
+	var location struct {
+		Lat *float64 `json:"lat"`
+		Lon *float64 `json:"lon"`
+	}
+	if e := node.GetMeta(common.META_NAMESPACE_GEOLOCATION, &location); e != nil || location.Lat == nil || location.Lon == nil {
+		return nil, false
+	}
+	point := &GeoPoint{Lat: *location.Lat, Lon: *location.Lon}
+	if !point.IsValid() {
+		return nil, false
+	}
+	return point, true
+}
+
+// SetGeoPoint stores a GPS position in the geolocation metadata, as indexed by the search engine.
+func (node *Node) SetGeoPoint(point *GeoPoint) error {
+	return node.SetMeta(common.META_NAMESPACE_GEOLOCATION, map[string]float64{
+		"lat": point.Lat,
+		"lon": point.Lon,
+	})
+}
+
 // AllMetaDeserialized unmarshall all defined metadata to JSON objects,
 // skipping reserved meta (e.g. meta that have a key prefixed by "pydio:")
 func (node *Node) AllMetaDeserialized() map[string]interface{} {
@@ -174,6 +198,11 @@ func (node *Node) MarshalJSONPB(marshaler *jsonpb.Marshaler) ([]byte, error) {
 }
 */
 
+// IsValid checks that the point coordinates are in the latitude and longitude ranges.
+func (p *GeoPoint) IsValid() bool {
+	return p.Lat >= -90 && p.Lat <= 90 && p.Lon >= -180 && p.Lon <= 180
+}
+
 /* LOGGING SUPPORT */
 
 // Zap simply returns a zapcore.Field object populated with this node and with a standard key
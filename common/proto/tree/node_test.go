@@ -114,3 +114,29 @@ func TestNodeMeta(t *testing.T) {
 	})
 
 }
+
+func TestNodeGeoPoint(t *testing.T) {
+
+	Convey("Test Set and Get GeoPoint", t, func() {
+
+		node := &Node{}
+		_, ok := node.GetGeoPoint()
+		So(ok, ShouldBeFalse)
+
+		node.SetGeoPoint(&GeoPoint{Lat: 45.188529, Lon: 5.724524})
+		So(node.GetStringMeta(common.META_NAMESPACE_GEOLOCATION), ShouldBeEmpty)
+		point, ok := node.GetGeoPoint()
+		So(ok, ShouldBeTrue)
+		So(point, ShouldResemble, &GeoPoint{Lat: 45.188529, Lon: 5.724524})
+
+		node.SetMeta(common.META_NAMESPACE_GEOLOCATION, map[string]float64{"lat": 45.1})
+		_, ok = node.GetGeoPoint()
+		So(ok, ShouldBeFalse)
+
+		node.SetGeoPoint(&GeoPoint{Lat: 120, Lon: 5})
+		_, ok = node.GetGeoPoint()
+		So(ok, ShouldBeFalse)
+
+	})
+
+}
@@ -0,0 +1,310 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package retention implements WORM retention rules and legal holds on nodes.
+//
+// A rule is stored as an ACL carrying the "retention" action on the protected node. While a rule is
+// active, the node (and its children when the rule is inherited) cannot be deleted, moved or overwritten,
+// and its versions cannot be pruned.
+package retention
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/micro/go-micro/errors"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/service/defaults"
+	"github.com/pydio/cells/common/service/proto"
+	"github.com/pydio/cells/common/utils"
+)
+
+// Rule protects a node until a given date, or indefinitely when a legal hold is set.
+type Rule struct {
+	NodeUuid string `json:"-"`
+	// Unix timestamp until which the node is protected
+	HoldUntil int64 `json:"HoldUntil,omitempty"`
+	// Legal hold protects the node until it is explicitly lifted
+	LegalHold bool `json:"LegalHold,omitempty"`
+	// Inherit applies the rule to all the children of the node
+	Inherit bool `json:"Inherit,omitempty"`
+}
+
+// ParseRule reads a rule from a retention ACL.
+func ParseRule(acl *idm.ACL) (*Rule, error) {
+	r := &Rule{}
+	if e := json.Unmarshal([]byte(acl.Action.Value), r); e != nil {
+		return nil, e
+	}
+	r.NodeUuid = acl.NodeID
+	return r, nil
+}
+
+// ACL builds the ACL storing this rule.
+func (r *Rule) ACL() *idm.ACL {
+	value, _ := json.Marshal(r)
+	return &idm.ACL{
+		NodeID: r.NodeUuid,
+		Action: &idm.ACLAction{Name: utils.ACL_RETENTION.Name, Value: string(value)},
+	}
+}
+
+// cacheKey identifies the node and the value of the rule.
+func (r *Rule) cacheKey() string {
+	return r.NodeUuid + ":" + r.ACL().Action.Value
+}
+
+// Active checks if the rule still protects its nodes at the given time.
+func (r *Rule) Active(now time.Time) bool {
+	return r.LegalHold || r.HoldUntil > now.Unix()
+}
+
+// Applies checks if the rule protects the node with the given uuid, directly or by inheritance.
+func (r *Rule) Applies(nodeUuid string) bool {
+	return r.NodeUuid == nodeUuid || r.Inherit
+}
+
+// Error builds the error returned when an operation is refused because of this rule.
+func (r *Rule) Error(id string, operation string) error {
+	if r.LegalHold {
+		return errors.Forbidden(id, "Cannot %s this node as it is under legal hold", operation)
+	}
+	return errors.Forbidden(id, "Cannot %s this node as it is retained until %s", operation, time.Unix(r.HoldUntil, 0).Format(time.RFC3339))
+}
+
+// LegalHoldRole returns the uuid of the role allowed to set and lift legal holds, read from the
+// defaults/retention/legalHoldRole configuration.
+func LegalHoldRole() string {
+	return config.Get("defaults", "retention", "legalHoldRole").String("LEGAL_HOLD_OFFICER")
+}
+
+// CanManageLegalHold checks if the user found in context has the legal hold role.
+func CanManageLegalHold(ctx context.Context) bool {
+	claims, ok := ctx.Value(claim.ContextKey).(claim.Claims)
+	if !ok {
+		return false
+	}
+	role := LegalHoldRole()
+	for _, r := range strings.Split(claims.Roles, ",") {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckUpdate validates the replacement of a rule by another one. Previous or next may be nil when a rule
+// is created or deleted. Retention periods can only be extended, and legal holds can only be set or lifted
+// by users having the legal hold role.
+func CheckUpdate(ctx context.Context, previous *Rule, next *Rule, now time.Time) error {
+	wasHold := previous != nil && previous.LegalHold
+	isHold := next != nil && next.LegalHold
+	if wasHold != isHold && !CanManageLegalHold(ctx) {
+		return errors.Forbidden(common.SERVICE_ACL, "Only users with the legal hold role can set or lift a legal hold")
+	}
+	if previous == nil || previous.HoldUntil <= now.Unix() {
+		return nil
+	}
+	if next == nil || next.HoldUntil < previous.HoldUntil {
+		return errors.Forbidden(common.SERVICE_ACL, "Retention period cannot be shortened before it expires")
+	}
+	if previous.Inherit && !next.Inherit {
+		return errors.Forbidden(common.SERVICE_ACL, "Retention cannot be restricted to the node before it expires")
+	}
+	return nil
+}
+
+// Resolver finds the rules applying to nodes.
+type Resolver struct {
+	ACLClient idm.ACLServiceClient
+}
+
+// NewResolver creates a Resolver with a default ACL client.
+func NewResolver() *Resolver {
+	return &Resolver{
+		ACLClient: idm.NewACLServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_ACL, defaults.NewClient()),
+	}
+}
+
+// Rules lists the rules attached to the given nodes uuids.
+func (r *Resolver) Rules(ctx context.Context, uuids ...string) (rules []*Rule, err error) {
+	if len(uuids) == 0 {
+		return
+	}
+	q, _ := ptypes.MarshalAny(&idm.ACLSingleQuery{NodeIDs: uuids, Actions: []*idm.ACLAction{{Name: utils.ACL_RETENTION.Name}}})
+	stream, err := r.ACLClient.SearchACL(ctx, &idm.SearchACLRequest{Query: &service.Query{SubQueries: []*any.Any{q}}})
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp == nil {
+			continue
+		}
+		if rule, e := ParseRule(resp.ACL); e == nil {
+			rules = append(rules, rule)
+		}
+	}
+	return
+}
+
+// Find returns the active rule protecting a node, either attached to the node itself or inherited from
+// one of its ancestors. It returns nil if the node is not protected.
+func (r *Resolver) Find(ctx context.Context, node *tree.Node, ancestors ...*tree.Node) (*Rule, error) {
+	uuids := []string{}
+	if node.Uuid != "" {
+		uuids = append(uuids, node.Uuid)
+	}
+	for _, a := range ancestors {
+		if a.Uuid != "" && a.Uuid != node.Uuid {
+			uuids = append(uuids, a.Uuid)
+		}
+	}
+	rules, err := r.Rules(ctx, uuids...)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for _, rule := range rules {
+		if rule.Active(now) && rule.Applies(node.Uuid) {
+			return rule, nil
+		}
+	}
+	return nil, nil
+}
+
+// rulePaths caches the path of the nodes carrying an active rule. As these nodes cannot be moved
+// while the rule is active, their path is resolved only once. Entries are keyed by node and rule
+// value, and dropped as soon as the rule is not active anymore, as the node may then be moved.
+var rulePaths = struct {
+	sync.RWMutex
+	paths map[string]string
+}{paths: make(map[string]string)}
+
+// FindInTree returns an active rule attached to one of the descendants of a folder, if any.
+func (r *Resolver) FindInTree(ctx context.Context, treeClient tree.NodeProviderClient, folder *tree.Node) (*Rule, error) {
+	q, _ := ptypes.MarshalAny(&idm.ACLSingleQuery{Actions: []*idm.ACLAction{{Name: utils.ACL_RETENTION.Name}}})
+	stream, err := r.ACLClient.SearchACL(ctx, &idm.SearchACLRequest{Query: &service.Query{SubQueries: []*any.Any{q}}})
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+	now := time.Now()
+	var active []*Rule
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp == nil || resp.ACL.NodeID == "" || resp.ACL.NodeID == folder.Uuid {
+			continue
+		}
+		if rule, e := ParseRule(resp.ACL); e == nil && rule.Active(now) {
+			active = append(active, rule)
+		}
+	}
+
+	// Forget paths of nodes that are not protected anymore
+	keys := make(map[string]bool, len(active))
+	for _, rule := range active {
+		keys[rule.cacheKey()] = true
+	}
+	rulePaths.Lock()
+	for k := range rulePaths.paths {
+		if !keys[k] {
+			delete(rulePaths.paths, k)
+		}
+	}
+	rulePaths.Unlock()
+
+	if len(active) == 0 {
+		return nil, nil
+	}
+
+	// Index rules by path, sorted so that all descendants of the folder are contiguous
+	type indexed struct {
+		path string
+		rule *Rule
+	}
+	var index []indexed
+	for _, rule := range active {
+		key := rule.cacheKey()
+		rulePaths.RLock()
+		p, ok := rulePaths.paths[key]
+		rulePaths.RUnlock()
+		if !ok {
+			resp, e := treeClient.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: rule.NodeUuid}})
+			if e != nil {
+				if errors.Parse(e.Error()).Code == 404 {
+					// Protected node does not exist anymore, it cannot be inside the folder
+					continue
+				}
+				return nil, e
+			}
+			p = strings.Trim(resp.Node.Path, "/")
+			rulePaths.Lock()
+			rulePaths.paths[key] = p
+			rulePaths.Unlock()
+		}
+		index = append(index, indexed{path: p, rule: rule})
+	}
+	sort.Slice(index, func(i, j int) bool {
+		return index[i].path < index[j].path
+	})
+	prefix := strings.Trim(folder.Path, "/") + "/"
+	i := sort.Search(len(index), func(i int) bool {
+		return index[i].path >= prefix
+	})
+	if i < len(index) && strings.HasPrefix(index[i].path, prefix) {
+		return index[i].rule, nil
+	}
+	return nil, nil
+}
+
+// Protecting returns the active rule protecting an existing node, attached to the node or inherited from one
+// of its ancestors. When withChildren is set, rules attached to the children of a folder are considered as well.
+func (r *Resolver) Protecting(ctx context.Context, treeClient tree.NodeProviderClient, node *tree.Node, withChildren bool) (*Rule, error) {
+	ancestors, e := utils.BuildAncestorsList(ctx, treeClient, node)
+	if e != nil {
+		return nil, e
+	}
+	if rule, e := r.Find(ctx, node, ancestors...); e != nil || rule != nil {
+		return rule, e
+	}
+	if withChildren && !node.IsLeaf() {
+		return r.FindInTree(ctx, treeClient, node)
+	}
+	return nil, nil
+}
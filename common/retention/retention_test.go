@@ -0,0 +1,195 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package retention
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/utils"
+)
+
+type aclClientMock struct {
+	idm.ACLServiceClient
+	acls []*idm.ACL
+}
+
+func (m *aclClientMock) SearchACL(ctx context.Context, in *idm.SearchACLRequest, opts ...client.CallOption) (idm.ACLService_SearchACLClient, error) {
+	return &aclStreamMock{acls: m.acls}, nil
+}
+
+type aclStreamMock struct {
+	idm.ACLService_SearchACLClient
+	acls []*idm.ACL
+}
+
+func (s *aclStreamMock) Recv() (*idm.SearchACLResponse, error) {
+	if len(s.acls) == 0 {
+		return nil, io.EOF
+	}
+	a := s.acls[0]
+	s.acls = s.acls[1:]
+	return &idm.SearchACLResponse{ACL: a}, nil
+}
+
+func (s *aclStreamMock) Close() error {
+	return nil
+}
+
+type treeClientMock struct {
+	tree.NodeProviderClient
+	paths map[string]string
+	reads int
+	err   error
+}
+
+func (m *treeClientMock) ReadNode(ctx context.Context, in *tree.ReadNodeRequest, opts ...client.CallOption) (*tree.ReadNodeResponse, error) {
+	m.reads++
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &tree.ReadNodeResponse{Node: &tree.Node{Uuid: in.Node.Uuid, Path: m.paths[in.Node.Uuid]}}, nil
+}
+
+func TestRule(t *testing.T) {
+
+	Convey("Test rule serialization", t, func() {
+		rule := &Rule{NodeUuid: "node-uuid", HoldUntil: 2000000000, Inherit: true}
+		acl := rule.ACL()
+		So(acl.NodeID, ShouldEqual, "node-uuid")
+		So(acl.Action.Name, ShouldEqual, utils.ACL_RETENTION.Name)
+		parsed, e := ParseRule(acl)
+		So(e, ShouldBeNil)
+		So(parsed, ShouldResemble, rule)
+
+		_, e = ParseRule(&idm.ACL{Action: &idm.ACLAction{Name: utils.ACL_RETENTION.Name, Value: "not json"}})
+		So(e, ShouldNotBeNil)
+	})
+
+	Convey("Test rule activity", t, func() {
+		now := time.Now()
+		So((&Rule{HoldUntil: now.Add(time.Hour).Unix()}).Active(now), ShouldBeTrue)
+		So((&Rule{HoldUntil: now.Add(-time.Hour).Unix()}).Active(now), ShouldBeFalse)
+		So((&Rule{LegalHold: true}).Active(now), ShouldBeTrue)
+
+		So((&Rule{NodeUuid: "a"}).Applies("a"), ShouldBeTrue)
+		So((&Rule{NodeUuid: "a"}).Applies("b"), ShouldBeFalse)
+		So((&Rule{NodeUuid: "a", Inherit: true}).Applies("b"), ShouldBeTrue)
+	})
+
+}
+
+func TestCheckUpdate(t *testing.T) {
+
+	now := time.Now()
+	future := now.Add(24 * time.Hour).Unix()
+	past := now.Add(-24 * time.Hour).Unix()
+	ctx := context.WithValue(context.Background(), claim.ContextKey, claim.Claims{Name: "user", Roles: "ROOT_GROUP,user"})
+	officerCtx := context.WithValue(context.Background(), claim.ContextKey, claim.Claims{Name: "officer", Roles: "ROOT_GROUP," + LegalHoldRole()})
+
+	Convey("Retention periods can only be extended", t, func() {
+		So(CheckUpdate(ctx, nil, &Rule{HoldUntil: future}, now), ShouldBeNil)
+		So(CheckUpdate(ctx, &Rule{HoldUntil: future}, &Rule{HoldUntil: future + 10}, now), ShouldBeNil)
+		So(CheckUpdate(ctx, &Rule{HoldUntil: future}, &Rule{HoldUntil: future - 10}, now), ShouldNotBeNil)
+		So(CheckUpdate(ctx, &Rule{HoldUntil: future}, nil, now), ShouldNotBeNil)
+		So(CheckUpdate(ctx, &Rule{HoldUntil: future, Inherit: true}, &Rule{HoldUntil: future}, now), ShouldNotBeNil)
+		So(CheckUpdate(ctx, &Rule{HoldUntil: past}, nil, now), ShouldBeNil)
+	})
+
+	Convey("Legal holds require the legal hold role", t, func() {
+		So(CheckUpdate(ctx, nil, &Rule{LegalHold: true}, now), ShouldNotBeNil)
+		So(CheckUpdate(ctx, &Rule{LegalHold: true}, nil, now), ShouldNotBeNil)
+		So(CheckUpdate(ctx, &Rule{LegalHold: true}, &Rule{LegalHold: true, HoldUntil: future}, now), ShouldBeNil)
+		So(CheckUpdate(officerCtx, nil, &Rule{LegalHold: true}, now), ShouldBeNil)
+		So(CheckUpdate(officerCtx, &Rule{LegalHold: true}, nil, now), ShouldBeNil)
+		So(CheckUpdate(officerCtx, &Rule{LegalHold: true, HoldUntil: future}, nil, now), ShouldNotBeNil)
+	})
+
+}
+
+func TestFindInTree(t *testing.T) {
+
+	Convey("Test finding rules attached to the children of a folder", t, func() {
+		hold := (&Rule{NodeUuid: "report", LegalHold: true}).ACL()
+		expired := (&Rule{NodeUuid: "old", HoldUntil: 1000}).ACL()
+		other := (&Rule{NodeUuid: "other", LegalHold: true}).ACL()
+		resolver := &Resolver{ACLClient: &aclClientMock{acls: []*idm.ACL{hold, expired, other}}}
+		treeClient := &treeClientMock{paths: map[string]string{
+			"report": "ds/finance/2018/report.pdf",
+			"old":    "ds/finance/old.pdf",
+			"other":  "ds/financial/file.pdf",
+		}}
+
+		rule, err := resolver.FindInTree(context.Background(), treeClient, &tree.Node{Uuid: "finance", Path: "ds/finance"})
+		So(err, ShouldBeNil)
+		So(rule, ShouldNotBeNil)
+		So(rule.NodeUuid, ShouldEqual, "report")
+		So(treeClient.reads, ShouldEqual, 2)
+
+		resolver.ACLClient = &aclClientMock{acls: []*idm.ACL{hold, expired, other}}
+		rule, err = resolver.FindInTree(context.Background(), treeClient, &tree.Node{Uuid: "sales", Path: "ds/sales"})
+		So(err, ShouldBeNil)
+		So(rule, ShouldBeNil)
+		// Paths of retained nodes are resolved once
+		So(treeClient.reads, ShouldEqual, 2)
+	})
+
+	Convey("Test tree errors are returned", t, func() {
+		hold := (&Rule{NodeUuid: "contract", LegalHold: true}).ACL()
+		resolver := &Resolver{ACLClient: &aclClientMock{acls: []*idm.ACL{hold}}}
+		treeClient := &treeClientMock{err: errors.InternalServerError("tree", "unavailable")}
+
+		_, err := resolver.FindInTree(context.Background(), treeClient, &tree.Node{Uuid: "legal", Path: "ds/legal"})
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Test nodes moved after their rule expired are found under their new parent", t, func() {
+		first := (&Rule{NodeUuid: "invoice", HoldUntil: time.Now().Add(time.Hour).Unix()}).ACL()
+		resolver := &Resolver{ACLClient: &aclClientMock{acls: []*idm.ACL{first}}}
+		treeClient := &treeClientMock{paths: map[string]string{"invoice": "ds/accounting/invoice.pdf"}}
+		rule, err := resolver.FindInTree(context.Background(), treeClient, &tree.Node{Uuid: "accounting", Path: "ds/accounting"})
+		So(err, ShouldBeNil)
+		So(rule, ShouldNotBeNil)
+
+		// Rule expires, then node is moved
+		resolver.ACLClient = &aclClientMock{}
+		rule, err = resolver.FindInTree(context.Background(), treeClient, &tree.Node{Uuid: "accounting", Path: "ds/accounting"})
+		So(err, ShouldBeNil)
+		So(rule, ShouldBeNil)
+		treeClient.paths["invoice"] = "ds/archives/invoice.pdf"
+
+		// A new rule is set
+		resolver.ACLClient = &aclClientMock{acls: []*idm.ACL{first}}
+		rule, err = resolver.FindInTree(context.Background(), treeClient, &tree.Node{Uuid: "archives", Path: "ds/archives"})
+		So(err, ShouldBeNil)
+		So(rule, ShouldNotBeNil)
+		So(rule.NodeUuid, ShouldEqual, "invoice")
+	})
+}
@@ -24,6 +24,7 @@ package servicecontext
 import (
 	"context"
 	"encoding/json"
+	"sync"
 	"sync/atomic"
 
 	"github.com/pkg/errors"
@@ -42,6 +43,7 @@ const (
 	daoKey
 	connKey
 	configKey
+	requestValuesKey
 )
 
 var serviceColorCount uint64 = 30
@@ -118,6 +120,21 @@ func GetSessionID(ctx context.Context) string {
 	return ""
 }
 
+// WithRequestValues attaches a store shared by all the calls made with the context of an incoming request,
+// so that values computed by one call can be read by the next ones.
+func WithRequestValues(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(requestValuesKey).(*sync.Map); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, requestValuesKey, &sync.Map{})
+}
+
+// GetRequestValues returns the store attached by WithRequestValues, if any.
+func GetRequestValues(ctx context.Context) (*sync.Map, bool) {
+	values, ok := ctx.Value(requestValuesKey).(*sync.Map)
+	return values, ok
+}
+
 // GetDAO returns the dao from the context in argument
 func GetDAO(ctx context.Context) dao.DAO {
 	if db, ok := ctx.Value(daoKey).(dao.DAO); ok {
@@ -37,12 +37,14 @@ const (
 	HttpMetaProtocol       = "HttpProtocol"
 	HttpMetaUserAgent      = "UserAgent"
 	HttpMetaContentType    = "ContentType"
+	HttpMetaAccept         = "HttpAccept"
 	HttpMetaCoookiesString = "CookiesString"
 	ClientTime             = "ClientTime"
 	ServerTime             = "ServerTime"
 )
 
-// Try to extract as much HTTP metadata as possible and store it in context metadata
+// Try to extract as much HTTP metadata as possible and store it in context metadata. The context also
+// receives a store for values shared by the calls made while serving the request, see WithRequestValues.
 func HttpRequestInfoToMetadata(ctx context.Context, req *http.Request) context.Context {
 
 	meta := metadata.Metadata{}
@@ -80,6 +82,9 @@ func HttpRequestInfoToMetadata(ctx context.Context, req *http.Request) context.C
 	if h, ok := req.Header["Content-Type"]; ok {
 		meta[HttpMetaContentType] = strings.Join(h, "")
 	}
+	if h, ok := req.Header["Accept"]; ok {
+		meta[HttpMetaAccept] = strings.Join(h, ",")
+	}
 	if h, ok := req.Header["X-Pydio-Span-Id"]; ok {
 		meta[SpanMetadataId] = strings.Join(h, "")
 	}
@@ -105,7 +110,7 @@ func HttpRequestInfoToMetadata(ctx context.Context, req *http.Request) context.C
 		meta[HttpMetaCoookiesString] = strings.Join(cString, "//")
 	}
 
-	return metadata.NewContext(ctx, meta)
+	return metadata.NewContext(WithRequestValues(ctx), meta)
 }
 
 // Extract data from request and put it in context Metadata field
@@ -101,6 +101,7 @@ func JWTHttpWrapper(h http.Handler) http.Handler {
 
 				return
 			}
+			auth.AuditImpersonatedRequest(c, r.Method, r.URL.Path)
 
 		}
 
@@ -22,6 +22,8 @@ package service
 
 import (
 	"net/http"
+	"regexp"
+	"strings"
 
 	"go.uber.org/zap"
 
@@ -36,8 +38,22 @@ import (
 var (
 	HttpMetaJwtClientApp = "JwtClientApp"
 	HttpMetaJwtIssuer    = "JwtIssuer"
+
+	// readOnlyPostRoutes are the POST routes that only search or read data
+	readOnlyPostRoutes = regexp.MustCompile(`^/(role|user|acl|policy|workspace|search/nodes|chat/search|activity/(stream|subscriptions)|log/.+|config/encryption/list|config/peers/.+|webhooks/[^/]+/deliveries|meta/(get/.+|bulk/get)|user-meta/(search|bookmarks)|jobs/user|tree/admin/(list|stat)|changes/.+|share/resources|auth/token/revoke|docstore/[^/]+)$`)
 )
 
+// readOnlyAllowed checks if a request can be performed with a token restricted to the read-only scope.
+func readOnlyAllowed(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	case http.MethodPost:
+		return readOnlyPostRoutes.MatchString(strings.SplitN(r.RequestURI, "?", 2)[0])
+	}
+	return false
+}
+
 // PolicyHttpWrapper applies relevant policy rules and blocks the request if necessary
 func PolicyHttpWrapper(h http.Handler) http.Handler {
 
@@ -52,6 +68,12 @@ func PolicyHttpWrapper(h http.Handler) http.Handler {
 		if cValue := c.Value(claim.ContextKey); cValue != nil {
 			if claims, ok := cValue.(claim.Claims); ok {
 				log.Logger(c).Debug("Got Claims", zap.Any("claims", claims))
+				if claims.HasScope(claim.ScopeReadOnly) && !readOnlyAllowed(r) {
+					log.Logger(c).Error("PolicyHttpHandlerWrapper: write operation refused for a read-only token", zap.String("uri", r.RequestURI), zap.String("method", r.Method))
+					w.WriteHeader(403)
+					w.Write([]byte("Forbidden: read-only token.\n"))
+					return
+				}
 				policyRequestContext[HttpMetaJwtClientApp] = claims.ClientApp
 				policyRequestContext[HttpMetaJwtIssuer] = claims.Issuer
 				subjects = utils.PolicyRequestSubjectsFromClaims(claims)
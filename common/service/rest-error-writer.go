@@ -22,6 +22,7 @@ package service
 
 import (
 	"github.com/emicklei/go-restful"
+	"github.com/micro/go-micro/errors"
 	"github.com/pydio/cells/common/log"
 	"go.uber.org/zap"
 )
@@ -40,3 +41,14 @@ func RestError403(req *restful.Request, resp *restful.Response, err error) {
 	log.Logger(req.Request.Context()).Error("Rest Error 403", zap.Error(err))
 	resp.WriteError(403, err)
 }
+
+// RestErrorDetect writes the code carried by a micro error, or a 500 error for other errors.
+func RestErrorDetect(req *restful.Request, resp *restful.Response, err error) {
+	code := errors.Parse(err.Error()).Code
+	if code < 400 || code > 599 {
+		RestError500(req, resp, err)
+		return
+	}
+	log.Logger(req.Request.Context()).Error("Rest Error", zap.Int32("code", code), zap.Error(err))
+	resp.WriteError(int(code), err)
+}
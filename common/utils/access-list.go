@@ -73,6 +73,7 @@ var (
 	ACL_POLICY       = &idm.ACLAction{Name: "policy"}
 	ACL_QUOTA        = &idm.ACLAction{Name: "quota"}
 	ACL_CONTENT_LOCK = &idm.ACLAction{Name: "content_lock"}
+	ACL_RETENTION    = &idm.ACLAction{Name: "retention"}
 	// Not used yet
 	ACL_DELETE           = &idm.ACLAction{Name: "delete", Value: "1"}
 	ACL_LIST             = &idm.ACLAction{Name: "list", Value: "1"}
@@ -170,6 +171,8 @@ type AccessList struct {
 	NodesAcls       map[string]Bitmask
 	WorkspacesNodes map[string]map[string]Bitmask
 	OrderedRoles    []*idm.Role
+	// ReadOnly denies all write operations, whatever the ACLs say
+	ReadOnly bool
 }
 
 // NewAccessList creates a new AccessList.
@@ -352,10 +355,31 @@ func (a *AccessList) CanRead(ctx context.Context, nodes ...*tree.Node) bool {
 
 // CanWrite checks if a node has WRITE access.
 func (a *AccessList) CanWrite(ctx context.Context, nodes ...*tree.Node) bool {
+	if a.ReadOnly {
+		return false
+	}
 	deny, mask := a.ParentMaskOrDeny(ctx, nodes...)
 	return !deny && mask.HasFlag(ctx, FLAG_WRITE, nodes[0])
 }
 
+// RestrictToWorkspaces removes from the list all workspaces that do not match one of the given UUIDs or slugs.
+func (a *AccessList) RestrictToWorkspaces(uuidsOrSlugs ...string) {
+	allowed := make(map[string]bool, len(uuidsOrSlugs))
+	for _, s := range uuidsOrSlugs {
+		allowed[s] = true
+	}
+	for wsId, ws := range a.Workspaces {
+		if !allowed[wsId] && !allowed[ws.Slug] {
+			delete(a.Workspaces, wsId)
+		}
+	}
+	for wsId := range a.WorkspacesNodes {
+		if _, ok := a.Workspaces[wsId]; !ok && !allowed[wsId] {
+			delete(a.WorkspacesNodes, wsId)
+		}
+	}
+}
+
 // BelongsToWorkspaces finds corresponding workspace parents for this node.
 func (a *AccessList) BelongsToWorkspaces(ctx context.Context, nodes ...*tree.Node) (workspaces []*idm.Workspace, workspacesRoots map[string]string) {
 
@@ -152,3 +152,25 @@ func TestAccessList_Flatten(t *testing.T) {
 
 	})
 }
+
+func TestAccessList_Restrictions(t *testing.T) {
+	Convey("Test ReadOnly and Workspace restrictions", t, func() {
+		ctx := context.Background()
+		list := NewAccessList(roles)
+		list.Append(acls)
+		list.Flatten(ctx)
+		list.Workspaces["ws1"] = &idm.Workspace{UUID: "ws1", Slug: "first"}
+		list.Workspaces["ws2"] = &idm.Workspace{UUID: "ws2", Slug: "second"}
+
+		testReadWrite := listParents("root/folder1/subfolder2/file1")
+		list.ReadOnly = true
+		So(list.CanRead(ctx, testReadWrite...), ShouldBeTrue)
+		So(list.CanWrite(ctx, testReadWrite...), ShouldBeFalse)
+
+		list.RestrictToWorkspaces("second")
+		So(list.Workspaces, ShouldHaveLength, 1)
+		So(list.Workspaces, ShouldContainKey, "ws2")
+		So(list.GetWorkspacesNodes(), ShouldHaveLength, 1)
+		So(list.GetAccessibleWorkspaces(ctx), ShouldResemble, map[string]string{"ws2": "read"})
+	})
+}
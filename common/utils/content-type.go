@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+)
+
+// SniffLength is the number of bytes needed by SniffContentType.
+const SniffLength = 512
+
+type contentSignature struct {
+	magic []byte
+	mime  string
+	// extensions are the only ones accepted for this content, if any
+	extensions []string
+}
+
+// Signatures that are not detected by http.DetectContentType, mainly executables and scripts.
+// Their extensions list is used to detect contents uploaded under a forged extension.
+var contentSignatures = []contentSignature{
+	{magic: []byte("MZ"), mime: "application/x-msdownload", extensions: []string{"exe", "dll", "sys", "com", "scr", "cpl", "ocx", "efi", "msu"}},
+	{magic: []byte("\x7fELF"), mime: "application/x-executable", extensions: []string{"", "so", "bin", "elf", "o", "run", "axf", "prx", "ko"}},
+	{magic: []byte("\xfe\xed\xfa\xce"), mime: "application/x-mach-binary", extensions: []string{"", "dylib", "bundle", "o"}},
+	{magic: []byte("\xfe\xed\xfa\xcf"), mime: "application/x-mach-binary", extensions: []string{"", "dylib", "bundle", "o"}},
+	{magic: []byte("\xce\xfa\xed\xfe"), mime: "application/x-mach-binary", extensions: []string{"", "dylib", "bundle", "o"}},
+	{magic: []byte("\xcf\xfa\xed\xfe"), mime: "application/x-mach-binary", extensions: []string{"", "dylib", "bundle", "o"}},
+	{magic: []byte("\xca\xfe\xba\xbe"), mime: "application/java-vm", extensions: []string{"class", "dylib", "bundle", ""}},
+	{magic: []byte("#!"), mime: "text/x-shellscript", extensions: []string{"", "sh", "bash", "zsh", "ksh", "csh", "py", "pl", "rb", "php", "js", "cgi", "command", "tcl", "lua", "awk"}},
+	{magic: []byte("\xd0\xcf\x11\xe0\xa1\xb1\x1a\xe1"), mime: "application/x-ole-storage"},
+	{magic: []byte("7z\xbc\xaf\x27\x1c"), mime: "application/x-7z-compressed"},
+	{magic: []byte("Rar!\x1a\x07"), mime: "application/x-rar-compressed"},
+}
+
+// SniffContentType detects the MIME type of a content from its first bytes (at most SniffLength are considered).
+// It complements http.DetectContentType with executable formats.
+func SniffContentType(head []byte) string {
+	if len(head) > SniffLength {
+		head = head[:SniffLength]
+	}
+	if s, ok := findContentSignature(head); ok {
+		return s.mime
+	}
+	ct := http.DetectContentType(head)
+	if i := strings.Index(ct, ";"); i > 0 {
+		ct = ct[:i]
+	}
+	return ct
+}
+
+// ContentMatchesExtension checks that a sniffed content is consistent with the file extension (without the leading dot).
+// It only fails for formats that are strictly bound to a set of extensions, like executables.
+func ContentMatchesExtension(head []byte, extension string) bool {
+	s, ok := findContentSignature(head)
+	if !ok || len(s.extensions) == 0 {
+		return true
+	}
+	extension = strings.ToLower(strings.TrimPrefix(extension, "."))
+	for _, e := range s.extensions {
+		if e == extension {
+			return true
+		}
+	}
+	return false
+}
+
+func findContentSignature(head []byte) (contentSignature, bool) {
+	for _, s := range contentSignatures {
+		if bytes.HasPrefix(head, s.magic) {
+			return s, true
+		}
+	}
+	return contentSignature{}, false
+}
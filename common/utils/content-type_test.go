@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package utils
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSniffContentType(t *testing.T) {
+
+	Convey("Test sniffing of common and executable contents", t, func() {
+		So(SniffContentType([]byte("%PDF-1.4\n%âãÏÓ")), ShouldEqual, "application/pdf")
+		So(SniffContentType([]byte("\x89PNG\x0d\x0a\x1a\x0a\x00\x00")), ShouldEqual, "image/png")
+		So(SniffContentType([]byte("hello world")), ShouldEqual, "text/plain")
+		So(SniffContentType([]byte("MZ\x90\x00\x03\x00\x00\x00")), ShouldEqual, "application/x-msdownload")
+		So(SniffContentType([]byte("\x7fELF\x02\x01\x01")), ShouldEqual, "application/x-executable")
+		So(SniffContentType([]byte("#!/bin/sh\nrm -rf /")), ShouldEqual, "text/x-shellscript")
+	})
+
+	Convey("Test forged extensions", t, func() {
+		exe := []byte("MZ\x90\x00\x03\x00\x00\x00")
+		So(ContentMatchesExtension(exe, "exe"), ShouldBeTrue)
+		So(ContentMatchesExtension(exe, ".EXE"), ShouldBeTrue)
+		So(ContentMatchesExtension(exe, "pdf"), ShouldBeFalse)
+		So(ContentMatchesExtension([]byte("#!/bin/sh"), "txt"), ShouldBeFalse)
+		So(ContentMatchesExtension([]byte("#!/bin/sh"), "sh"), ShouldBeTrue)
+		// Contents that are not bound to extensions always match
+		So(ContentMatchesExtension([]byte("%PDF-1.4"), "txt"), ShouldBeTrue)
+		So(ContentMatchesExtension([]byte("hello"), "md"), ShouldBeTrue)
+	})
+
+}
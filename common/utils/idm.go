@@ -352,6 +352,12 @@ func AccessListFromContextClaims(ctx context.Context) (accessList *AccessList, e
 		accessList.Workspaces[workspace.UUID] = workspace
 	}
 
+	// Apply restrictions carried by the claims, e.g. from a personal access token
+	accessList.ReadOnly = claims.HasScope(claim.ScopeReadOnly)
+	if wsScopes := claims.WorkspaceScopes(); len(wsScopes) > 0 {
+		accessList.RestrictToWorkspaces(wsScopes...)
+	}
+
 	return accessList, nil
 }
 
@@ -23,6 +23,7 @@ package utils
 import (
 	"context"
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/micro/go-micro/metadata"
@@ -98,9 +99,9 @@ func PolicyContextFromMetadata(policyContext map[string]string, ctx context.Cont
 
 // PolicyContextFromNode extracts metadata from the Node and enriches the passed policyContext.
 func PolicyContextFromNode(policyContext map[string]string, node *tree.Node) {
-	// TODO: add file extension
 	policyContext[PolicyNodeMetaName] = node.GetStringMeta("name")
 	policyContext[PolicyNodeMetaPath] = node.Path
+	policyContext[PolicyNodeMetaExtension] = strings.ToLower(strings.TrimPrefix(path.Ext(node.Path), "."))
 	policyContext[PolicyNodeMetaMTime] = string(node.MTime)
 	policyContext[PolicyNodeMetaSize] = string(node.Size)
 }
@@ -0,0 +1,326 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package views
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/micro/go-micro/errors"
+	"github.com/pydio/minio-go"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/antivirus"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/service/defaults"
+)
+
+// AntivirusHandler sends uploaded contents to a ClamAV daemon. Infected files are either
+// refused (reject) or kept but forbidden for download (quarantine), depending on the configuration.
+// When files may be refused, simple uploads are staged and scanned before being committed.
+type AntivirusHandler struct {
+	AbstractHandler
+	// Config and Scanner are loaded from the configuration if they are not set.
+	Config  *antivirus.Config
+	Scanner antivirus.Scanner
+
+	versionClient  tree.NodeVersionerClient
+	storeStatus    func(ctx context.Context, node *tree.Node, status *antivirus.ScanStatus) error
+	reportInfected func(ctx context.Context, node *tree.Node, status *antivirus.ScanStatus)
+}
+
+type scanResult struct {
+	result *antivirus.Result
+	err    error
+}
+
+func (a *AntivirusHandler) settings() (*antivirus.Config, antivirus.Scanner) {
+	cfg := a.Config
+	if cfg == nil {
+		cfg = antivirus.LoadConfig()
+	}
+	scanner := a.Scanner
+	if scanner == nil {
+		scanner = cfg.Scanner()
+	}
+	return cfg, scanner
+}
+
+func (a *AntivirusHandler) getVersionClient() tree.NodeVersionerClient {
+	if a.versionClient == nil {
+		a.versionClient = tree.NewNodeVersionerClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_VERSIONS, defaults.NewClient())
+	}
+	return a.versionClient
+}
+
+func (a *AntivirusHandler) skipNode(ctx context.Context, cfg *antivirus.Config, identifier string, node *tree.Node, size int64) bool {
+	if !cfg.Enabled {
+		return true
+	}
+	if branchInfo, ok := GetBranchInfo(ctx, identifier); ok && branchInfo.Binary {
+		return true
+	}
+	if strings.HasSuffix(node.Path, common.PYDIO_SYNC_HIDDEN_FILE_META) {
+		return true
+	}
+	return cfg.MaxSize > 0 && size > cfg.MaxSize
+}
+
+// PutObject scans the content before or while it is sent to the next handler.
+func (a *AntivirusHandler) PutObject(ctx context.Context, node *tree.Node, reader io.Reader, requestData *PutRequestData) (int64, error) {
+
+	cfg, scanner := a.settings()
+	if a.skipNode(ctx, cfg, "in", node, requestData.Size) {
+		return a.next.PutObject(ctx, node, reader, requestData)
+	}
+	if cfg.Action == antivirus.ActionReject || cfg.RejectOnError {
+		return a.scanBeforePut(ctx, cfg, scanner, node, reader, requestData)
+	}
+
+	// Infected files are kept: scan while uploading
+	pr, pw := io.Pipe()
+	results := make(chan scanResult, 1)
+	go func() {
+		r, e := scanner.Scan(ctx, pr)
+		// Make sure the upload is never blocked by the scanner
+		io.Copy(ioutil.Discard, pr)
+		results <- scanResult{result: r, err: e}
+	}()
+
+	size, err := a.next.PutObject(ctx, node, io.TeeReader(reader, pw), requestData)
+	if err != nil {
+		pw.CloseWithError(err)
+		<-results
+		return size, err
+	}
+	pw.Close()
+	status, _ := a.verdict(ctx, cfg, scanner, node, <-results)
+	a.storeResult(ctx, node, status)
+
+	return size, nil
+}
+
+// scanBeforePut stages the content in a temporary file while it is scanned, and only sends it to
+// the next handler if it is accepted: refused files never reach the storage nor overwrite existing contents.
+func (a *AntivirusHandler) scanBeforePut(ctx context.Context, cfg *antivirus.Config, scanner antivirus.Scanner, node *tree.Node, reader io.Reader, requestData *PutRequestData) (int64, error) {
+
+	staging, err := ioutil.TempFile("", "pydio-antivirus-")
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		staging.Close()
+		os.Remove(staging.Name())
+	}()
+
+	var res scanResult
+	res.result, res.err = scanner.Scan(ctx, io.TeeReader(reader, staging))
+	// Stage what the scanner did not read, e.g. if it failed
+	if _, e := io.Copy(staging, reader); e != nil {
+		return 0, e
+	}
+	status, err := a.verdict(ctx, cfg, scanner, node, res)
+	if err != nil {
+		return 0, err
+	}
+	if _, e := staging.Seek(0, io.SeekStart); e != nil {
+		return 0, e
+	}
+
+	size, err := a.next.PutObject(ctx, node, staging, requestData)
+	if err != nil {
+		return size, err
+	}
+	a.storeResult(ctx, node, status)
+	return size, nil
+}
+
+// MultipartComplete scans the whole object once all its parts are assembled. As it is already committed,
+// a refused object is replaced by the content it has overwritten, or deleted if there was none.
+func (a *AntivirusHandler) MultipartComplete(ctx context.Context, target *tree.Node, uploadID string, uploadedParts []minio.CompletePart) (minio.ObjectInfo, error) {
+
+	cfg, scanner := a.settings()
+	var previous *tree.Node
+	if cfg.Enabled {
+		if resp, e := a.next.ReadNode(ctx, &tree.ReadNodeRequest{Node: target}); e == nil && resp.Node.IsLeaf() {
+			previous = resp.Node
+		}
+	}
+
+	info, err := a.next.MultipartComplete(ctx, target, uploadID, uploadedParts)
+	if err != nil {
+		return info, err
+	}
+	if a.skipNode(ctx, cfg, "in", target, info.Size) {
+		return info, nil
+	}
+
+	var res scanResult
+	if reader, e := a.next.GetObject(ctx, target, &GetRequestData{Length: -1}); e != nil {
+		res.err = e
+	} else {
+		res.result, res.err = scanner.Scan(ctx, reader)
+		reader.Close()
+	}
+	if target.Uuid == "" {
+		if resp, e := a.next.ReadNode(ctx, &tree.ReadNodeRequest{Node: target}); e == nil {
+			target.Uuid = resp.Node.Uuid
+		}
+	}
+
+	status, err := a.verdict(ctx, cfg, scanner, target, res)
+	if err != nil {
+		a.revert(ctx, target, previous)
+		return info, err
+	}
+	a.storeResult(ctx, target, status)
+	return info, nil
+}
+
+// GetObject refuses to serve files kept in quarantine.
+func (a *AntivirusHandler) GetObject(ctx context.Context, node *tree.Node, requestData *GetRequestData) (io.ReadCloser, error) {
+	if err := a.checkQuarantine(ctx, "in", node); err != nil {
+		return nil, err
+	}
+	return a.next.GetObject(ctx, node, requestData)
+}
+
+// CopyObject refuses to copy files kept in quarantine, as the copy would not carry the scan status.
+func (a *AntivirusHandler) CopyObject(ctx context.Context, from *tree.Node, to *tree.Node, requestData *CopyRequestData) (int64, error) {
+	if err := a.checkQuarantine(ctx, "from", from); err != nil {
+		return 0, err
+	}
+	return a.next.CopyObject(ctx, from, to, requestData)
+}
+
+func (a *AntivirusHandler) checkQuarantine(ctx context.Context, identifier string, node *tree.Node) error {
+	cfg, _ := a.settings()
+	if a.skipNode(ctx, cfg, identifier, node, 0) {
+		return nil
+	}
+	status := antivirus.GetStatus(node)
+	if status == nil {
+		if resp, e := a.next.ReadNode(ctx, &tree.ReadNodeRequest{Node: node}); e == nil {
+			status = antivirus.GetStatus(resp.Node)
+		}
+	}
+	if status != nil && status.Status == antivirus.StatusInfected && status.Action == antivirus.ActionQuarantine {
+		return errors.Forbidden(VIEWS_LIBRARY_NAME, "File is in quarantine: %s was detected by the antivirus", status.Signature)
+	}
+	return nil
+}
+
+// verdict builds the scan status and returns an error if the file must be refused.
+func (a *AntivirusHandler) verdict(ctx context.Context, cfg *antivirus.Config, scanner antivirus.Scanner, node *tree.Node, res scanResult) (*antivirus.ScanStatus, error) {
+
+	if res.err != nil {
+		log.Logger(ctx).Error("Antivirus scan failed", node.ZapPath(), zap.Error(res.err))
+		if cfg.RejectOnError {
+			return nil, errors.Forbidden(VIEWS_LIBRARY_NAME, "File could not be scanned by the antivirus")
+		}
+		return nil, nil
+	}
+
+	engine, e := scanner.Version(ctx)
+	if e != nil {
+		log.Logger(ctx).Debug("Cannot read antivirus version", zap.Error(e))
+	}
+	status := antivirus.NewStatus(res.result, engine, cfg.Action)
+
+	if res.result.Infected {
+		a.report(ctx, node, status)
+		if cfg.Action == antivirus.ActionReject {
+			return status, errors.Forbidden(VIEWS_LIBRARY_NAME, "File is infected: %s was detected by the antivirus", status.Signature)
+		}
+	}
+	return status, nil
+}
+
+func (a *AntivirusHandler) storeResult(ctx context.Context, node *tree.Node, status *antivirus.ScanStatus) {
+	if status == nil || node.Uuid == "" {
+		return
+	}
+	if e := a.store(ctx, node, status); e != nil {
+		log.Logger(ctx).Error("Cannot store antivirus scan status", node.ZapPath(), zap.Error(e))
+	}
+}
+
+// revert restores the previous content of a refused object from the versions store. The object is
+// deleted if it did not exist before, or if its previous content cannot be found.
+func (a *AntivirusHandler) revert(ctx context.Context, node *tree.Node, previous *tree.Node) {
+	if previous != nil && previous.Etag != "" {
+		if versionId := a.findVersion(ctx, previous); versionId != "" {
+			_, e := a.next.CopyObject(ctx, previous, previous, &CopyRequestData{SrcVersionId: versionId})
+			if e == nil {
+				return
+			}
+			log.Logger(ctx).Error("Cannot restore previous version of refused file", node.ZapPath(), zap.Error(e))
+		} else {
+			log.Logger(ctx).Warn("Cannot find previous version of refused file, it will be deleted", node.ZapPath())
+		}
+	}
+	if _, e := a.next.DeleteNode(ctx, &tree.DeleteNodeRequest{Node: node}); e != nil {
+		log.Logger(ctx).Error("Cannot delete refused file", node.ZapPath(), zap.Error(e))
+	}
+}
+
+// findVersion looks up the version matching the content of a node.
+func (a *AntivirusHandler) findVersion(ctx context.Context, node *tree.Node) string {
+	if node.Uuid == "" {
+		return ""
+	}
+	stream, e := a.getVersionClient().ListVersions(ctx, &tree.ListVersionsRequest{Node: node})
+	if e != nil {
+		log.Logger(ctx).Error("Cannot list versions", node.ZapPath(), zap.Error(e))
+		return ""
+	}
+	defer stream.Close()
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp != nil && resp.Version != nil && string(resp.Version.Data) == node.Etag {
+			return resp.Version.Uuid
+		}
+	}
+	return ""
+}
+
+func (a *AntivirusHandler) store(ctx context.Context, node *tree.Node, status *antivirus.ScanStatus) error {
+	if a.storeStatus != nil {
+		return a.storeStatus(ctx, node, status)
+	}
+	return antivirus.StoreStatus(ctx, node, status)
+}
+
+func (a *AntivirusHandler) report(ctx context.Context, node *tree.Node, status *antivirus.ScanStatus) {
+	if a.reportInfected != nil {
+		a.reportInfected(ctx, node, status)
+		return
+	}
+	antivirus.ReportInfected(ctx, node, status)
+}
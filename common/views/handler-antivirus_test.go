@@ -0,0 +1,215 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package views
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/micro/go-micro/client"
+	"github.com/pydio/minio-go"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/antivirus"
+	"github.com/pydio/cells/common/proto/tree"
+)
+
+type scannerMock struct {
+	failing bool
+}
+
+func (s *scannerMock) Scan(ctx context.Context, reader io.Reader) (*antivirus.Result, error) {
+	if s.failing {
+		return nil, fmt.Errorf("clamd is down")
+	}
+	data, e := ioutil.ReadAll(reader)
+	if e != nil {
+		return nil, e
+	}
+	if strings.Contains(string(data), "virus") {
+		return &antivirus.Result{Infected: true, Signature: "Test-Signature"}, nil
+	}
+	return &antivirus.Result{}, nil
+}
+
+func (s *scannerMock) Version(ctx context.Context) (string, error) {
+	return "ClamAV 0.100.0/24000", nil
+}
+
+// storageMock consumes the uploaded content and records deletions.
+type storageMock struct {
+	*HandlerMock
+	received string
+	deleted  []string
+	restored string
+}
+
+func (s *storageMock) CopyObject(ctx context.Context, from *tree.Node, to *tree.Node, requestData *CopyRequestData) (int64, error) {
+	s.restored = requestData.SrcVersionId
+	return 0, nil
+}
+
+type versionClientMock struct {
+	tree.NodeVersionerClient
+	versions []*tree.ChangeLog
+}
+
+func (v *versionClientMock) ListVersions(ctx context.Context, in *tree.ListVersionsRequest, opts ...client.CallOption) (tree.NodeVersioner_ListVersionsClient, error) {
+	return &versionStreamMock{versions: v.versions}, nil
+}
+
+type versionStreamMock struct {
+	tree.NodeVersioner_ListVersionsClient
+	versions []*tree.ChangeLog
+}
+
+func (s *versionStreamMock) Recv() (*tree.ListVersionsResponse, error) {
+	if len(s.versions) == 0 {
+		return nil, io.EOF
+	}
+	v := s.versions[0]
+	s.versions = s.versions[1:]
+	return &tree.ListVersionsResponse{Version: v}, nil
+}
+
+func (s *versionStreamMock) Close() error {
+	return nil
+}
+
+func (s *storageMock) PutObject(ctx context.Context, node *tree.Node, reader io.Reader, requestData *PutRequestData) (int64, error) {
+	data, e := ioutil.ReadAll(reader)
+	s.received = string(data)
+	return int64(len(data)), e
+}
+
+func (s *storageMock) DeleteNode(ctx context.Context, in *tree.DeleteNodeRequest, opts ...client.CallOption) (*tree.DeleteNodeResponse, error) {
+	s.deleted = append(s.deleted, in.Node.Path)
+	return &tree.DeleteNodeResponse{Success: true}, nil
+}
+
+func (s *storageMock) MultipartComplete(ctx context.Context, target *tree.Node, uploadID string, uploadedParts []minio.CompletePart) (minio.ObjectInfo, error) {
+	return minio.ObjectInfo{Size: 10}, nil
+}
+
+func newAntivirusTestHandler(action string) (*AntivirusHandler, *storageMock, map[string]*antivirus.ScanStatus) {
+	statuses := make(map[string]*antivirus.ScanStatus)
+	storage := &storageMock{HandlerMock: NewHandlerMock()}
+	handler := &AntivirusHandler{
+		Config:  &antivirus.Config{Enabled: true, Action: action, MaxSize: 1024, RejectOnError: true},
+		Scanner: &scannerMock{},
+		storeStatus: func(ctx context.Context, node *tree.Node, status *antivirus.ScanStatus) error {
+			statuses[node.Path] = status
+			return nil
+		},
+		reportInfected: func(ctx context.Context, node *tree.Node, status *antivirus.ScanStatus) {},
+	}
+	handler.SetNextHandler(storage)
+	return handler, storage, statuses
+}
+
+func TestAntivirusHandler_PutObject(t *testing.T) {
+
+	ctx := context.Background()
+
+	Convey("Test clean upload", t, func() {
+		handler, storage, statuses := newAntivirusTestHandler(antivirus.ActionReject)
+		size, e := handler.PutObject(ctx, &tree.Node{Path: "clean", Uuid: "uuid"}, strings.NewReader("clean content"), &PutRequestData{Size: 13})
+		So(e, ShouldBeNil)
+		So(size, ShouldEqual, 13)
+		So(storage.received, ShouldEqual, "clean content")
+		So(storage.deleted, ShouldBeEmpty)
+		So(statuses["clean"].Status, ShouldEqual, antivirus.StatusClean)
+		So(statuses["clean"].Engine, ShouldEqual, "ClamAV 0.100.0/24000")
+	})
+
+	Convey("Test infected upload is rejected before reaching the storage", t, func() {
+		handler, storage, statuses := newAntivirusTestHandler(antivirus.ActionReject)
+		_, e := handler.PutObject(ctx, &tree.Node{Path: "infected", Uuid: "uuid"}, strings.NewReader("a virus"), &PutRequestData{Size: 7})
+		So(e, ShouldNotBeNil)
+		So(storage.received, ShouldBeEmpty)
+		So(storage.deleted, ShouldBeEmpty)
+		So(statuses, ShouldBeEmpty)
+	})
+
+	Convey("Test upload is rejected when the scan fails", t, func() {
+		handler, storage, _ := newAntivirusTestHandler(antivirus.ActionQuarantine)
+		handler.Scanner = &scannerMock{failing: true}
+		_, e := handler.PutObject(ctx, &tree.Node{Path: "unscanned", Uuid: "uuid"}, strings.NewReader("content"), &PutRequestData{Size: 7})
+		So(e, ShouldNotBeNil)
+		So(storage.received, ShouldBeEmpty)
+
+		handler.Config.RejectOnError = false
+		_, e = handler.PutObject(ctx, &tree.Node{Path: "unscanned", Uuid: "uuid"}, strings.NewReader("content"), &PutRequestData{Size: 7})
+		So(e, ShouldBeNil)
+		So(storage.received, ShouldEqual, "content")
+	})
+
+	Convey("Test infected upload is quarantined", t, func() {
+		handler, storage, statuses := newAntivirusTestHandler(antivirus.ActionQuarantine)
+		_, e := handler.PutObject(ctx, &tree.Node{Path: "infected", Uuid: "uuid"}, strings.NewReader("a virus"), &PutRequestData{Size: 7})
+		So(e, ShouldBeNil)
+		So(storage.deleted, ShouldBeEmpty)
+		So(statuses["infected"].Status, ShouldEqual, antivirus.StatusInfected)
+		So(statuses["infected"].Signature, ShouldEqual, "Test-Signature")
+
+		node := &tree.Node{Path: "infected"}
+		node.SetMeta(antivirus.MetaScanStatus, statuses["infected"])
+		_, e = handler.GetObject(ctx, node, &GetRequestData{Length: -1})
+		So(e, ShouldNotBeNil)
+		_, e = handler.CopyObject(ctx, node, &tree.Node{Path: "copy"}, &CopyRequestData{})
+		So(e, ShouldNotBeNil)
+	})
+
+	Convey("Test big files are not scanned", t, func() {
+		handler, storage, statuses := newAntivirusTestHandler(antivirus.ActionReject)
+		content := "virus" + strings.Repeat("-", 2048)
+		_, e := handler.PutObject(ctx, &tree.Node{Path: "big", Uuid: "uuid"}, strings.NewReader(content), &PutRequestData{Size: int64(len(content))})
+		So(e, ShouldBeNil)
+		So(storage.received, ShouldEqual, content)
+		So(statuses, ShouldBeEmpty)
+	})
+
+	Convey("Test multipart upload is scanned once complete", t, func() {
+		handler, storage, _ := newAntivirusTestHandler(antivirus.ActionReject)
+		storage.Nodes["virus"] = &tree.Node{Path: "virus", Uuid: "uuid"}
+		_, e := handler.MultipartComplete(ctx, &tree.Node{Path: "virus", Uuid: "uuid"}, "upload", nil)
+		So(e, ShouldNotBeNil)
+		So(storage.deleted, ShouldResemble, []string{"virus"})
+	})
+
+	Convey("Test multipart upload overwriting a clean file restores its previous version", t, func() {
+		handler, storage, _ := newAntivirusTestHandler(antivirus.ActionReject)
+		handler.versionClient = &versionClientMock{versions: []*tree.ChangeLog{
+			{Uuid: "v2", Data: []byte("etag2")},
+			{Uuid: "v1", Data: []byte("etag1")},
+		}}
+		storage.Nodes["virus"] = &tree.Node{Path: "virus", Uuid: "uuid", Etag: "etag1", Type: tree.NodeType_LEAF}
+		_, e := handler.MultipartComplete(ctx, &tree.Node{Path: "virus", Uuid: "uuid"}, "upload", nil)
+		So(e, ShouldNotBeNil)
+		So(storage.restored, ShouldEqual, "v1")
+		So(storage.deleted, ShouldBeEmpty)
+	})
+
+}
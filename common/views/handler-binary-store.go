@@ -24,18 +24,26 @@ import (
 	"context"
 	"io"
 	"path"
+	"regexp"
 	"strings"
 
 	"github.com/micro/go-micro/client"
 	"github.com/micro/go-micro/errors"
+	"github.com/micro/go-micro/metadata"
 	"github.com/pydio/minio-go"
 	"go.uber.org/zap"
 
 	"github.com/pydio/cells/common"
 	"github.com/pydio/cells/common/log"
 	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/service/context"
+	"github.com/pydio/cells/common/utils"
 )
 
+// previewObject matches the document previews stored in the thumbnails store: the PDF rendition
+// (<uuid>-preview.pdf) and the pages images (<uuid>-page-<n>.jpg and their variants).
+var previewObject = regexp.MustCompile(`^(.+)-(preview\.pdf|page-[0-9]+\.[a-z]+)$`)
+
 type BinaryStoreHandler struct {
 	AbstractHandler
 	StoreName     string
@@ -55,6 +63,84 @@ func (a *BinaryStoreHandler) checkContextForAnonRead(ctx context.Context) error
 	return nil
 }
 
+// checkPreviewAccess restricts document previews to the users who can read the original node, found by the
+// Uuid prefixing the object name. Unlike thumbnails, previews expose the whole content of the document.
+func (a *BinaryStoreHandler) checkPreviewAccess(ctx context.Context, objectName string) error {
+	if a.StoreName != common.PYDIO_THUMBSTORE_NAMESPACE {
+		return nil
+	}
+	matches := previewObject.FindStringSubmatch(objectName)
+	if matches == nil {
+		return nil
+	}
+	if isAdmin, ok := ctx.Value(ctxAdminContextKey{}).(bool); ok && isAdmin {
+		return nil
+	}
+	accessList, ok := ctx.Value(ctxUserAccessListKey{}).(*utils.AccessList)
+	if !ok {
+		return errors.Forbidden(VIEWS_LIBRARY_NAME, "you are not allowed to access this content")
+	}
+	treeClient := a.clientsPool.GetTreeClient()
+	resp, err := treeClient.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: matches[1]}})
+	if err != nil {
+		return errors.Forbidden(VIEWS_LIBRARY_NAME, "you are not allowed to access this content")
+	}
+	// Update Access List with resolved virtual nodes
+	virtualManager := GetVirtualNodesManager()
+	for _, vNode := range virtualManager.ListNodes() {
+		if aclNodeMask, has := accessList.GetNodesBitmasks()[vNode.Uuid]; has {
+			if resolvedRoot, e := virtualManager.ResolveInContext(ctx, vNode, a.clientsPool, false); e == nil {
+				accessList.GetNodesBitmasks()[resolvedRoot.Uuid] = aclNodeMask
+			}
+		}
+	}
+	parents, err := utils.BuildAncestorsList(ctx, treeClient, resp.Node)
+	if err != nil {
+		return err
+	}
+	if !accessList.CanRead(ctx, parents...) {
+		return errors.Forbidden(VIEWS_LIBRARY_NAME, "you are not allowed to access this content")
+	}
+	return nil
+}
+
+// negotiateVariant looks for a variant of a JPEG thumbnail in a format listed in the Accept header of
+// the original request (e.g. <uuid>-512.webp for <uuid>-512.jpg). It returns the original name if
+// the client does not accept other formats or if no variant was generated. The choice is kept in the
+// request values, so that ReadNode and GetObject serve the same object for a given request.
+func (a *BinaryStoreHandler) negotiateVariant(ctx context.Context, s3client *minio.Core, bucket string, objectName string) string {
+	if a.StoreName != common.PYDIO_THUMBSTORE_NAMESPACE || !strings.HasSuffix(objectName, ".jpg") {
+		return objectName
+	}
+	meta, ok := metadata.FromContext(ctx)
+	if !ok || meta[servicecontext.HttpMetaAccept] == "" {
+		return objectName
+	}
+	values, hasValues := servicecontext.GetRequestValues(ctx)
+	valueKey := "binary-store-variant:" + objectName
+	if hasValues {
+		if chosen, ok := values.Load(valueKey); ok {
+			return chosen.(string)
+		}
+	}
+	chosen := objectName
+	accept := meta[servicecontext.HttpMetaAccept]
+	for _, format := range []string{"avif", "webp"} {
+		if !strings.Contains(accept, "image/"+format) {
+			continue
+		}
+		variant := strings.TrimSuffix(objectName, ".jpg") + "." + format
+		if _, e := s3client.StatObject(bucket, variant, minio.StatObjectOptions{}); e == nil {
+			chosen = variant
+			break
+		}
+	}
+	if hasValues {
+		values.Store(valueKey, chosen)
+	}
+	return chosen
+}
+
 // Listing of Thumbs Store : do not display content
 func (a *BinaryStoreHandler) ListNodes(ctx context.Context, in *tree.ListNodesRequest, opts ...client.CallOption) (c tree.NodeProvider_ListNodesClient, e error) {
 	if a.isStorePath(in.Node.Path) {
@@ -75,12 +161,16 @@ func (a *BinaryStoreHandler) ReadNode(ctx context.Context, in *tree.ReadNodeRequ
 		if e := a.checkContextForAnonRead(ctx); e != nil {
 			return nil, e
 		}
+		if e := a.checkPreviewAccess(ctx, path.Base(in.Node.Path)); e != nil {
+			return nil, e
+		}
 		s3client := source.Client
 		if meta, mOk := MinioMetaFromContext(ctx); mOk {
 			s3client.PrepareMetadata(meta)
 			defer s3client.ClearMetadata()
 		}
-		objectInfo, err := s3client.StatObject(source.ObjectsBucket, path.Base(in.Node.Path), minio.StatObjectOptions{})
+		objectName := a.negotiateVariant(ctx, s3client, source.ObjectsBucket, path.Base(in.Node.Path))
+		objectInfo, err := s3client.StatObject(source.ObjectsBucket, objectName, minio.StatObjectOptions{})
 		if err != nil {
 			return nil, err
 		}
@@ -107,10 +197,13 @@ func (a *BinaryStoreHandler) GetObject(ctx context.Context, node *tree.Node, req
 		if e := a.checkContextForAnonRead(ctx); e != nil {
 			return nil, e
 		}
+		if e := a.checkPreviewAccess(ctx, path.Base(node.Path)); e != nil {
+			return nil, e
+		}
 		if er == nil {
 			ctx = WithBranchInfo(ctx, "in", BranchInfo{LoadedSource: source, Binary: true})
 			filter := node.Clone()
-			filter.SetMeta(common.META_NAMESPACE_DATASOURCE_PATH, path.Base(node.Path))
+			filter.SetMeta(common.META_NAMESPACE_DATASOURCE_PATH, a.negotiateVariant(ctx, source.Client, source.ObjectsBucket, path.Base(node.Path)))
 			return a.next.GetObject(ctx, filter, requestData)
 		}
 	}
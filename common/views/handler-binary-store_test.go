@@ -26,8 +26,11 @@ import (
 	"testing"
 
 	"github.com/micro/go-micro/errors"
+	"github.com/micro/go-micro/metadata"
+	"github.com/pydio/cells/common"
 	"github.com/pydio/cells/common/proto/object"
 	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/service/context"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -168,3 +171,50 @@ func TestBinaryStoreHandler_WriteOperations(t *testing.T) {
 	})
 
 }
+
+func TestBinaryStoreHandler_NegotiateVariant(t *testing.T) {
+
+	handler := &BinaryStoreHandler{StoreName: common.PYDIO_THUMBSTORE_NAMESPACE}
+
+	Convey("Test negotiation is skipped without Accept header", t, func() {
+		So(handler.negotiateVariant(context.Background(), nil, "bucket", "uuid-512.jpg"), ShouldEqual, "uuid-512.jpg")
+		ctx := metadata.NewContext(context.Background(), metadata.Metadata{servicecontext.HttpMetaAccept: "image/webp"})
+		So(handler.negotiateVariant(ctx, nil, "bucket", "uuid-512.png"), ShouldEqual, "uuid-512.png")
+	})
+
+	Convey("Test negotiated variant is reused within a request", t, func() {
+		ctx := servicecontext.WithRequestValues(context.Background())
+		ctx = metadata.NewContext(ctx, metadata.Metadata{servicecontext.HttpMetaAccept: "image/webp,*/*"})
+		values, ok := servicecontext.GetRequestValues(ctx)
+		So(ok, ShouldBeTrue)
+		values.Store("binary-store-variant:uuid-512.jpg", "uuid-512.webp")
+		// No S3 client is required as the previous choice is found
+		So(handler.negotiateVariant(ctx, nil, "bucket", "uuid-512.jpg"), ShouldEqual, "uuid-512.webp")
+	})
+
+}
+
+func TestBinaryStoreHandler_CheckPreviewAccess(t *testing.T) {
+
+	handler := &BinaryStoreHandler{StoreName: common.PYDIO_THUMBSTORE_NAMESPACE}
+
+	Convey("Test thumbnails are not restricted", t, func() {
+		So(handler.checkPreviewAccess(context.Background(), "uuid-512.jpg"), ShouldBeNil)
+		So(handler.checkPreviewAccess(context.Background(), "uuid-512.webp"), ShouldBeNil)
+	})
+
+	Convey("Test previews require an access list", t, func() {
+		e := handler.checkPreviewAccess(context.Background(), "uuid-preview.pdf")
+		So(e, ShouldNotBeNil)
+		So(errors.Parse(e.Error()).Code, ShouldEqual, 403)
+		e = handler.checkPreviewAccess(context.Background(), "uuid-page-3.jpg")
+		So(e, ShouldNotBeNil)
+		So(errors.Parse(e.Error()).Code, ShouldEqual, 403)
+	})
+
+	Convey("Test previews are readable in admin view", t, func() {
+		ctx := context.WithValue(context.Background(), ctxAdminContextKey{}, true)
+		So(handler.checkPreviewAccess(ctx, "uuid-preview.pdf"), ShouldBeNil)
+	})
+
+}
@@ -21,71 +21,174 @@
 package views
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
+	"net/http"
+	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
 
 	"github.com/micro/go-micro/errors"
-
 	"github.com/pydio/minio-go"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth/claim"
 	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/idm"
 	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/service/defaults"
+	"github.com/pydio/cells/common/utils"
 )
 
+const (
+	// UploadPolicyResource is the resource checked against the policy engine for each upload, suffixed
+	// by the workspace UUID, e.g. "upload:my-files". Policies can deny uploads per workspace (resource),
+	// per user, role or profile (subjects), and per content using conditions on the NodeMetaMimeType,
+	// NodeMetaExtension, NodeMetaName or NodeMetaSize context keys. Only explicit denials block uploads.
+	UploadPolicyResource = "upload:"
+	// UploadPolicyAction is the action checked against the policy engine for each upload.
+	UploadPolicyAction = "PUT"
+)
+
+// UploadLimitFilter checks uploads size and extension against the frontend configuration, then sniffs
+// the first bytes of the content to detect its actual type and checks it against the upload policies.
 type UploadLimitFilter struct {
 	AbstractHandler
+	// resolvePolicy can be replaced for tests
+	resolvePolicy func(ctx context.Context, request *idm.PolicyEngineRequest) (*idm.PolicyEngineResponse, error)
 }
 
-// Check Upload Limits (size, extension) defined in the frontend on PutObject operation
+// PutObject checks Upload Limits and Policies.
 func (a *UploadLimitFilter) PutObject(ctx context.Context, node *tree.Node, reader io.Reader, requestData *PutRequestData) (int64, error) {
 
-	size, exts := a.getUploadLimits()
-	if size > 0 && requestData.Size > size {
-		return 0, errors.Forbidden(VIEWS_LIBRARY_NAME, fmt.Sprintf("Upload limit is %d", size))
+	// Multipart parts are sent through PutObject by the S3 gateway, only the first one holds the file header
+	checked, err := a.checkUpload(ctx, node, reader, requestData.Size, requestData.MultipartPartID <= 1)
+	if err != nil {
+		return 0, err
+	}
+	return a.next.PutObject(ctx, node, checked, requestData)
+}
+
+// MultipartPutObjectPart checks Upload Limits and Policies.
+func (a *UploadLimitFilter) MultipartPutObjectPart(ctx context.Context, target *tree.Node, uploadID string, partNumberMarker int, reader io.Reader, requestData *PutRequestData) (minio.ObjectPart, error) {
+
+	checked, err := a.checkUpload(ctx, target, reader, requestData.Size, partNumberMarker <= 1)
+	if err != nil {
+		return minio.ObjectPart{}, err
+	}
+	return a.next.MultipartPutObjectPart(ctx, target, uploadID, partNumberMarker, checked, requestData)
+}
+
+// checkUpload applies the limits and returns a reader replaying the sniffed bytes.
+func (a *UploadLimitFilter) checkUpload(ctx context.Context, node *tree.Node, reader io.Reader, size int64, firstPart bool) (io.Reader, error) {
+
+	if branchInfo, ok := GetBranchInfo(ctx, "in"); ok && branchInfo.Binary {
+		return reader, nil
 	}
+	limit, exts := a.getUploadLimits()
+	if limit > 0 && size > limit {
+		return nil, a.deny(ctx, node, "", http.StatusRequestEntityTooLarge, fmt.Sprintf("Upload limit is %d", limit))
+	}
+	// Beware, Ext function includes the leading dot
+	nodeExt := strings.ToLower(strings.TrimPrefix(filepath.Ext(node.GetPath()), "."))
 	if len(exts) > 0 {
-		// Beware, Ext function includes the leading dot
-		nodeExt := filepath.Ext(node.GetPath())
 		allowed := false
 		for _, e := range exts {
-			if "."+strings.ToLower(e) == strings.ToLower(nodeExt) {
+			if strings.ToLower(strings.TrimPrefix(strings.TrimSpace(e), ".")) == nodeExt {
 				allowed = true
 				break
 			}
 		}
 		if !allowed {
-			return 0, errors.Forbidden(VIEWS_LIBRARY_NAME, fmt.Sprintf("Extension %s is not allowed!", nodeExt))
+			return nil, a.deny(ctx, node, "", http.StatusUnsupportedMediaType, fmt.Sprintf("Extension %s is not allowed!", nodeExt))
 		}
 	}
+	if !firstPart {
+		return reader, nil
+	}
 
-	return a.next.PutObject(ctx, node, reader, requestData)
+	head := make([]byte, utils.SniffLength)
+	n, e := io.ReadFull(reader, head)
+	if e != nil && e != io.EOF && e != io.ErrUnexpectedEOF {
+		return nil, e
+	}
+	head = head[:n]
+	contentType := utils.SniffContentType(head)
+	if len(exts) > 0 && !utils.ContentMatchesExtension(head, nodeExt) {
+		return nil, a.deny(ctx, node, contentType, http.StatusUnsupportedMediaType, fmt.Sprintf("Content type %s does not match extension %s", contentType, nodeExt))
+	}
+	if err := a.checkPolicies(ctx, node, contentType, size); err != nil {
+		return nil, err
+	}
+
+	return io.MultiReader(bytes.NewReader(head), reader), nil
 }
 
-// Check Upload Limits (size, extension) defined in the frontend on MultipartPutObjectPart
-func (a *UploadLimitFilter) MultipartPutObjectPart(ctx context.Context, target *tree.Node, uploadID string, partNumberMarker int, reader io.Reader, requestData *PutRequestData) (minio.ObjectPart, error) {
+// checkPolicies sends the upload to the policy engine, with the sniffed content type in the request context.
+func (a *UploadLimitFilter) checkPolicies(ctx context.Context, node *tree.Node, contentType string, size int64) error {
 
-	size, exts := a.getUploadLimits()
-	if size > 0 && requestData.Size > size {
-		return minio.ObjectPart{}, errors.Forbidden(VIEWS_LIBRARY_NAME, fmt.Sprintf("Upload limit is %d", size))
+	claims, ok := ctx.Value(claim.ContextKey).(claim.Claims)
+	if !ok {
+		return nil
 	}
-	if len(exts) > 0 {
-		nodeExt := filepath.Ext(target.GetPath())
-		allowed := false
-		for _, e := range exts {
-			if strings.ToLower(e) == strings.ToLower(nodeExt) {
-				allowed = true
-				break
-			}
-		}
-		if !allowed {
-			return minio.ObjectPart{}, errors.Forbidden(VIEWS_LIBRARY_NAME, fmt.Sprintf("Extension %s is not allowed!", nodeExt))
+	wsId := ""
+	if branchInfo, ok := GetBranchInfo(ctx, "in"); ok {
+		wsId = branchInfo.UUID
+	}
+	policyContext := make(map[string]string)
+	utils.PolicyContextFromMetadata(policyContext, ctx)
+	policyContext[utils.PolicyNodeMetaName] = path.Base(node.Path)
+	policyContext[utils.PolicyNodeMetaPath] = node.Path
+	policyContext[utils.PolicyNodeMetaExtension] = strings.ToLower(strings.TrimPrefix(path.Ext(node.Path), "."))
+	policyContext[utils.PolicyNodeMetaMimeType] = contentType
+	policyContext[utils.PolicyNodeMetaSize] = strconv.FormatInt(size, 10)
+	request := &idm.PolicyEngineRequest{
+		Subjects: utils.PolicyRequestSubjectsFromClaims(claims),
+		Resource: UploadPolicyResource + wsId,
+		Action:   UploadPolicyAction,
+		Context:  policyContext,
+	}
+
+	resolve := a.resolvePolicy
+	if resolve == nil {
+		resolve = func(ctx context.Context, request *idm.PolicyEngineRequest) (*idm.PolicyEngineResponse, error) {
+			cli := idm.NewPolicyEngineServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_POLICY, defaults.NewClient())
+			return cli.IsAllowed(ctx, request)
 		}
 	}
+	resp, err := resolve(ctx, request)
+	if err != nil {
+		log.Logger(ctx).Error("Cannot check upload policies", zap.Any(common.KEY_POLICY_REQUEST, request), zap.Error(err))
+		return errors.InternalServerError(VIEWS_LIBRARY_NAME, "Cannot check upload policies")
+	}
+	if resp.ExplicitDeny {
+		return a.deny(ctx, node, contentType, http.StatusForbidden, fmt.Sprintf("Upload of %s content is not allowed", contentType))
+	}
+	return nil
+}
+
+// deny writes an audit log and builds the error returned to the client.
+func (a *UploadLimitFilter) deny(ctx context.Context, node *tree.Node, contentType string, code int32, message string) error {
+
+	fields := []zapcore.Field{
+		log.GetAuditId(common.AUDIT_OBJECT_UPLOAD_DENY),
+		node.ZapPath(),
+	}
+	if branchInfo, ok := GetBranchInfo(ctx, "in"); ok && branchInfo.UUID != "" {
+		fields = append(fields, zap.String(common.KEY_WORKSPACE_UUID, branchInfo.UUID))
+	}
+	if contentType != "" {
+		fields = append(fields, log.GetAuditContext("ContentType", contentType))
+	}
+	log.Auditer(ctx).Error(fmt.Sprintf("Upload of %s denied: %s", node.Path, message), fields...)
 
-	return a.next.MultipartPutObjectPart(ctx, target, uploadID, partNumberMarker, reader, requestData)
+	return errors.New(VIEWS_LIBRARY_NAME, message, code)
 }
 
 // Parse Upload Limits from config
@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package views
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/micro/go-micro/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/utils"
+)
+
+// uploadStorageMock records the uploaded content.
+type uploadStorageMock struct {
+	*HandlerMock
+	received string
+}
+
+func (s *uploadStorageMock) PutObject(ctx context.Context, node *tree.Node, reader io.Reader, requestData *PutRequestData) (int64, error) {
+	data, e := ioutil.ReadAll(reader)
+	s.received = string(data)
+	return int64(len(data)), e
+}
+
+func TestUploadLimitFilter_PutObject(t *testing.T) {
+
+	var lastRequest *idm.PolicyEngineRequest
+	filter := &UploadLimitFilter{
+		resolvePolicy: func(ctx context.Context, request *idm.PolicyEngineRequest) (*idm.PolicyEngineResponse, error) {
+			lastRequest = request
+			// Deny executables in the "restricted" workspace
+			if request.Resource == UploadPolicyResource+"restricted" && request.Context[utils.PolicyNodeMetaMimeType] == "application/x-msdownload" {
+				return &idm.PolicyEngineResponse{ExplicitDeny: true}, nil
+			}
+			return &idm.PolicyEngineResponse{DefaultDeny: true}, nil
+		},
+	}
+	storage := &uploadStorageMock{HandlerMock: NewHandlerMock()}
+	filter.SetNextHandler(storage)
+
+	ctx := context.WithValue(context.Background(), claim.ContextKey, claim.Claims{Name: "john", Profile: "standard", Roles: "ROOT_GROUP,john"})
+	restricted := WithBranchInfo(ctx, "in", BranchInfo{Workspace: idm.Workspace{UUID: "restricted"}})
+	open := WithBranchInfo(ctx, "in", BranchInfo{Workspace: idm.Workspace{UUID: "open"}})
+	exe := "MZ\x90\x00\x03\x00\x00\x00 forged executable"
+
+	Convey("Test content is sniffed and fully forwarded", t, func() {
+		content := "%PDF-1.4\n" + strings.Repeat("pdf content ", 100)
+		size, e := filter.PutObject(restricted, &tree.Node{Path: "ws/doc.pdf"}, strings.NewReader(content), &PutRequestData{Size: int64(len(content))})
+		So(e, ShouldBeNil)
+		So(size, ShouldEqual, len(content))
+		So(storage.received, ShouldEqual, content)
+		So(lastRequest.Context[utils.PolicyNodeMetaMimeType], ShouldEqual, "application/pdf")
+		So(lastRequest.Context[utils.PolicyNodeMetaExtension], ShouldEqual, "pdf")
+		So(lastRequest.Subjects, ShouldContain, "role:john")
+	})
+
+	Convey("Test renamed executable is denied by policy", t, func() {
+		storage.received = ""
+		_, e := filter.PutObject(restricted, &tree.Node{Path: "ws/evil.pdf"}, strings.NewReader(exe), &PutRequestData{Size: int64(len(exe))})
+		So(e, ShouldNotBeNil)
+		So(errors.Parse(e.Error()).Code, ShouldEqual, 403)
+		So(storage.received, ShouldBeEmpty)
+
+		_, e = filter.PutObject(open, &tree.Node{Path: "ws/evil.pdf"}, strings.NewReader(exe), &PutRequestData{Size: int64(len(exe))})
+		So(e, ShouldBeNil)
+		So(storage.received, ShouldEqual, exe)
+	})
+
+	Convey("Test only first part of multipart uploads is sniffed", t, func() {
+		_, e := filter.PutObject(restricted, &tree.Node{Path: "ws/evil.pdf"}, strings.NewReader(exe), &PutRequestData{Size: int64(len(exe)), MultipartPartID: 2})
+		So(e, ShouldBeNil)
+	})
+
+	Convey("Test short and empty contents", t, func() {
+		_, e := filter.PutObject(restricted, &tree.Node{Path: "ws/empty.txt"}, strings.NewReader(""), &PutRequestData{})
+		So(e, ShouldBeNil)
+		So(storage.received, ShouldBeEmpty)
+		_, e = filter.PutObject(restricted, &tree.Node{Path: "ws/short.txt"}, strings.NewReader("hi"), &PutRequestData{Size: 2})
+		So(e, ShouldBeNil)
+		So(storage.received, ShouldEqual, "hi")
+	})
+
+}
@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package views
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/micro/go-micro/client"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/retention"
+)
+
+// RetentionFilter refuses to delete, move or overwrite nodes protected by a retention rule or a legal hold.
+// Creating new nodes inside a protected folder is still allowed.
+type RetentionFilter struct {
+	AbstractHandler
+	findRule func(ctx context.Context, node *tree.Node, withChildren bool) (*retention.Rule, error)
+}
+
+// protectingRule finds the active rule protecting an existing node. When withChildren is set, rules
+// attached to the children of a folder are considered as well.
+func (a *RetentionFilter) protectingRule(ctx context.Context, node *tree.Node, withChildren bool) (*retention.Rule, error) {
+	if a.findRule != nil {
+		return a.findRule(ctx, node, withChildren)
+	}
+	treeClient := a.clientsPool.GetTreeClient()
+	resp, e := treeClient.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Path: node.Path, Uuid: node.Uuid}})
+	if e != nil || resp.Node == nil || resp.Node.Etag == common.NODE_FLAG_ETAG_TEMPORARY {
+		// Node does not exist yet
+		return nil, nil
+	}
+	return retention.NewResolver().Protecting(ctx, treeClient, resp.Node, withChildren)
+}
+
+func (a *RetentionFilter) check(ctx context.Context, identifier string, node *tree.Node, withChildren bool, operation string) error {
+	if branchInfo, ok := GetBranchInfo(ctx, identifier); ok && branchInfo.Binary {
+		return nil
+	}
+	if strings.HasSuffix(node.Path, common.PYDIO_SYNC_HIDDEN_FILE_META) {
+		return nil
+	}
+	rule, e := a.protectingRule(ctx, node, withChildren)
+	if e != nil {
+		return e
+	}
+	if rule != nil {
+		return rule.Error(VIEWS_LIBRARY_NAME, operation)
+	}
+	return nil
+}
+
+// DeleteNode refuses deletion of protected nodes, or of folders containing protected nodes.
+func (a *RetentionFilter) DeleteNode(ctx context.Context, in *tree.DeleteNodeRequest, opts ...client.CallOption) (*tree.DeleteNodeResponse, error) {
+	if err := a.check(ctx, "in", in.Node, true, "delete"); err != nil {
+		return nil, err
+	}
+	return a.next.DeleteNode(ctx, in, opts...)
+}
+
+// UpdateNode refuses move or rename of protected nodes.
+func (a *RetentionFilter) UpdateNode(ctx context.Context, in *tree.UpdateNodeRequest, opts ...client.CallOption) (*tree.UpdateNodeResponse, error) {
+	if err := a.check(ctx, "from", in.From, true, "move"); err != nil {
+		return nil, err
+	}
+	if err := a.check(ctx, "to", &tree.Node{Path: in.To.Path}, false, "overwrite"); err != nil {
+		return nil, err
+	}
+	return a.next.UpdateNode(ctx, in, opts...)
+}
+
+// PutObject refuses to overwrite protected files.
+func (a *RetentionFilter) PutObject(ctx context.Context, node *tree.Node, reader io.Reader, requestData *PutRequestData) (int64, error) {
+	if err := a.check(ctx, "in", node, false, "overwrite"); err != nil {
+		return 0, err
+	}
+	return a.next.PutObject(ctx, node, reader, requestData)
+}
+
+// MultipartCreate refuses to overwrite protected files.
+func (a *RetentionFilter) MultipartCreate(ctx context.Context, target *tree.Node, requestData *MultipartRequestData) (string, error) {
+	if err := a.check(ctx, "in", target, false, "overwrite"); err != nil {
+		return "", err
+	}
+	return a.next.MultipartCreate(ctx, target, requestData)
+}
+
+// CopyObject refuses to overwrite protected files. The copy-move action performs moves by copying with the
+// "COPY" metadata directive before deleting the source: the source is checked first in that case, to avoid
+// leaving a duplicate behind. Plain copies of protected files are allowed.
+func (a *RetentionFilter) CopyObject(ctx context.Context, from *tree.Node, to *tree.Node, requestData *CopyRequestData) (int64, error) {
+	if requestData != nil && requestData.Metadata["X-Amz-Metadata-Directive"] == "COPY" {
+		if err := a.check(ctx, "from", from, false, "move"); err != nil {
+			return 0, err
+		}
+	}
+	if err := a.check(ctx, "to", &tree.Node{Path: to.Path}, false, "overwrite"); err != nil {
+		return 0, err
+	}
+	return a.next.CopyObject(ctx, from, to, requestData)
+}
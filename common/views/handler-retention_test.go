@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package views
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/micro/go-micro/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/retention"
+)
+
+func newRetentionTestHandler() (*RetentionFilter, *HandlerMock) {
+	mock := NewHandlerMock()
+	handler := &RetentionFilter{
+		findRule: func(ctx context.Context, node *tree.Node, withChildren bool) (*retention.Rule, error) {
+			if strings.HasPrefix(node.Path, "ds/finance/") {
+				return &retention.Rule{NodeUuid: "finance", HoldUntil: 4000000000, Inherit: true}, nil
+			}
+			if withChildren && node.Path == "ds" {
+				return &retention.Rule{NodeUuid: "finance", LegalHold: true}, nil
+			}
+			return nil, nil
+		},
+	}
+	handler.SetNextHandler(mock)
+	return handler, mock
+}
+
+func TestRetentionFilter_DeleteNode(t *testing.T) {
+
+	Convey("Test delete on protected nodes", t, func() {
+		handler, _ := newRetentionTestHandler()
+		ctx := context.Background()
+
+		_, e := handler.DeleteNode(ctx, &tree.DeleteNodeRequest{Node: &tree.Node{Path: "ds/finance/report.pdf"}})
+		So(e, ShouldNotBeNil)
+		So(errors.Parse(e.Error()).Code, ShouldEqual, 403)
+
+		_, e = handler.DeleteNode(ctx, &tree.DeleteNodeRequest{Node: &tree.Node{Path: "ds"}})
+		So(e, ShouldNotBeNil)
+		So(e.Error(), ShouldContainSubstring, "legal hold")
+
+		_, e = handler.DeleteNode(ctx, &tree.DeleteNodeRequest{Node: &tree.Node{Path: "ds/other/file.txt"}})
+		So(e, ShouldBeNil)
+	})
+
+}
+
+func TestRetentionFilter_Write(t *testing.T) {
+
+	Convey("Test overwrite and move of protected nodes", t, func() {
+		handler, mock := newRetentionTestHandler()
+		ctx := context.Background()
+
+		_, e := handler.PutObject(ctx, &tree.Node{Path: "ds/finance/report.pdf"}, strings.NewReader("content"), &PutRequestData{})
+		So(e, ShouldNotBeNil)
+		_, e = handler.PutObject(ctx, &tree.Node{Path: "ds/other/report.pdf"}, strings.NewReader("content"), &PutRequestData{})
+		So(e, ShouldBeNil)
+		So(mock.Nodes["in"].Path, ShouldEqual, "ds/other/report.pdf")
+
+		_, e = handler.UpdateNode(ctx, &tree.UpdateNodeRequest{From: &tree.Node{Path: "ds/finance/report.pdf"}, To: &tree.Node{Path: "ds/other/report.pdf"}})
+		So(e, ShouldNotBeNil)
+		_, e = handler.UpdateNode(ctx, &tree.UpdateNodeRequest{From: &tree.Node{Path: "ds/other/report.pdf"}, To: &tree.Node{Path: "ds/other/renamed.pdf"}})
+		So(e, ShouldBeNil)
+
+		_, e = handler.CopyObject(ctx, &tree.Node{Path: "ds/other/report.pdf"}, &tree.Node{Path: "ds/finance/report.pdf"}, &CopyRequestData{})
+		So(e, ShouldNotBeNil)
+		_, e = handler.CopyObject(ctx, &tree.Node{Path: "ds/finance/report.pdf"}, &tree.Node{Path: "ds/other/copy.pdf"}, &CopyRequestData{})
+		So(e, ShouldBeNil)
+		_, e = handler.CopyObject(ctx, &tree.Node{Path: "ds/finance/report.pdf"}, &tree.Node{Path: "ds/other/copy.pdf"}, &CopyRequestData{Metadata: map[string]string{"X-Amz-Metadata-Directive": "COPY"}})
+		So(e, ShouldNotBeNil)
+	})
+
+}
@@ -0,0 +1,290 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package recycle locates the recycle bins of the workspaces and manages the metadata recorded
+// on nodes when they are moved to a recycle bin.
+package recycle
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/service/defaults"
+	"github.com/pydio/cells/common/service/proto"
+	"github.com/pydio/cells/common/views"
+)
+
+const (
+	// BinName is the name of the recycle bin folder, at the root of each workspace
+	BinName = "recycle_bin"
+	// MetaRestorePath stores the original path of a node moved to a recycle bin
+	MetaRestorePath = "recycle_restore"
+	// MetaDeletedAt stores the time at which a node was moved to a recycle bin
+	MetaDeletedAt = "recycle_deleted"
+	// RetentionAttribute is the workspace attribute holding the retention period of its recycle bin, in days
+	RetentionAttribute = "RECYCLE_RETENTION"
+
+	// userPlaceholder is used to resolve the virtual roots that depend on the user, like my-files
+	userPlaceholder = "__RECYCLE_USER__"
+)
+
+// BinPath returns the path of the recycle bin containing nodePath, if any.
+func BinPath(nodePath string) (string, bool) {
+	parts := strings.Split(strings.Trim(nodePath, "/"), "/")
+	for i, p := range parts {
+		if p == BinName {
+			return strings.Join(parts[:i+1], "/"), true
+		}
+	}
+	return "", false
+}
+
+// InBin checks if nodePath is a recycle bin or is inside a recycle bin.
+func InBin(nodePath string) bool {
+	_, ok := BinPath(nodePath)
+	return ok
+}
+
+// RecordDeletion stores the original path and the deletion time on a node moved to a recycle bin.
+func RecordDeletion(ctx context.Context, metaClient tree.NodeReceiverClient, nodeUuid string, originalPath string) error {
+	node := &tree.Node{Uuid: nodeUuid}
+	node.SetMeta(MetaRestorePath, originalPath)
+	node.SetMeta(MetaDeletedAt, time.Now().Unix())
+	_, e := metaClient.UpdateNode(ctx, &tree.UpdateNodeRequest{From: node, To: node})
+	return e
+}
+
+// RestorePath returns the path recorded when the node was moved to the recycle bin.
+func RestorePath(node *tree.Node) (string, bool) {
+	p := node.GetStringMeta(MetaRestorePath)
+	return p, p != ""
+}
+
+// DeletedAt returns the time at which the node was moved to the recycle bin. For nodes deleted before
+// this time was recorded, it falls back to the node modification time.
+func DeletedAt(node *tree.Node) time.Time {
+	var ts int64
+	if e := node.GetMeta(MetaDeletedAt, &ts); e == nil && ts > 0 {
+		return time.Unix(ts, 0)
+	}
+	return node.GetModTime()
+}
+
+// Retention reads the retention period of a workspace recycle bin, in days, from the workspace attributes.
+// It returns defaultDays if the attribute is not set.
+func Retention(workspace *idm.Workspace, defaultDays int) int {
+	if workspace.Attributes == "" {
+		return defaultDays
+	}
+	var attributes map[string]interface{}
+	if e := json.Unmarshal([]byte(workspace.Attributes), &attributes); e != nil {
+		return defaultDays
+	}
+	switch v := attributes[RetentionAttribute].(type) {
+	case float64:
+		return int(v)
+	case string:
+		if d, e := strconv.Atoi(v); e == nil {
+			return d
+		}
+	}
+	return defaultDays
+}
+
+// Bin is a recycle bin found at the root of a workspace.
+type Bin struct {
+	Workspace *idm.Workspace
+	Node      *tree.Node
+}
+
+// Locator finds recycle bins and their entries, using the admin view of the tree.
+type Locator struct {
+	Router          views.Handler
+	Pool            *views.ClientsPool
+	WorkspaceClient idm.WorkspaceServiceClient
+	ACLClient       idm.ACLServiceClient
+}
+
+// NewLocator creates a Locator with default clients.
+func NewLocator() *Locator {
+	return &Locator{
+		Router:          views.NewStandardRouter(views.RouterOptions{AdminView: true}),
+		Pool:            views.NewClientsPool(false),
+		WorkspaceClient: idm.NewWorkspaceServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_WORKSPACE, defaults.NewClient()),
+		ACLClient:       idm.NewACLServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_ACL, defaults.NewClient()),
+	}
+}
+
+// Bins lists the existing recycle bins of all admin workspaces. Workspaces whose roots depend on the
+// user (e.g. my-files) have one bin per user folder.
+func (l *Locator) Bins(ctx context.Context) ([]*Bin, error) {
+
+	q, _ := ptypes.MarshalAny(&idm.WorkspaceSingleQuery{Scope: idm.WorkspaceScope_ADMIN})
+	stream, err := l.WorkspaceClient.SearchWorkspace(ctx, &idm.SearchWorkspaceRequest{Query: &service.Query{SubQueries: []*any.Any{q}}})
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+	var workspaces []*idm.Workspace
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp == nil {
+			continue
+		}
+		workspaces = append(workspaces, resp.Workspace)
+	}
+
+	var bins []*Bin
+	found := make(map[string]bool)
+	for _, ws := range workspaces {
+		for _, root := range ws.RootNodes {
+			for _, binPath := range l.binsForRoot(ctx, root) {
+				if found[binPath] {
+					continue
+				}
+				if resp, e := l.Router.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Path: binPath}}); e == nil && !resp.Node.IsLeaf() {
+					found[binPath] = true
+					bins = append(bins, &Bin{Workspace: ws, Node: resp.Node})
+				}
+			}
+		}
+	}
+	return bins, nil
+}
+
+// binsForRoot computes the candidate bin paths for a workspace root.
+func (l *Locator) binsForRoot(ctx context.Context, root string) (paths []string) {
+
+	vManager := views.GetVirtualNodesManager()
+	vNode, isVirtual := vManager.ByUuid(root)
+	if !isVirtual {
+		resp, e := l.Pool.GetTreeClient().ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: root}})
+		if e != nil {
+			log.Logger(ctx).Debug("Cannot find workspace root", zap.String("uuid", root), zap.Error(e))
+			return
+		}
+		return []string{path.Join(resp.Node.Path, BinName)}
+	}
+
+	// Resolve with a placeholder user, then list the folders found at its position
+	userCtx := context.WithValue(ctx, claim.ContextKey, claim.Claims{Name: userPlaceholder})
+	resolved, e := vManager.ResolveInContext(userCtx, vNode, l.Pool, false)
+	if e != nil {
+		log.Logger(ctx).Error("Cannot resolve virtual root", zap.String("uuid", root), zap.Error(e))
+		return
+	}
+	resolvedPath := strings.Trim(resolved.Path, "/")
+	idx := strings.Index(resolvedPath, userPlaceholder)
+	if idx < 0 {
+		return []string{path.Join(resolvedPath, BinName)}
+	}
+	parent := strings.TrimRight(resolvedPath[:idx], "/")
+	prefix := resolvedPath[len(parent):idx]
+	suffix := resolvedPath[idx+len(userPlaceholder):]
+	if strings.Contains(suffix, userPlaceholder) || strings.Contains(strings.TrimLeft(prefix, "/"), "/") {
+		return
+	}
+	children, e := l.Router.ListNodes(ctx, &tree.ListNodesRequest{Node: &tree.Node{Path: parent}})
+	if e != nil {
+		return
+	}
+	defer children.Close()
+	for {
+		resp, er := children.Recv()
+		if er != nil {
+			break
+		}
+		if resp == nil || resp.Node.IsLeaf() {
+			continue
+		}
+		name := path.Base(resp.Node.Path)
+		if !strings.HasPrefix(name, strings.TrimLeft(prefix, "/")) {
+			continue
+		}
+		paths = append(paths, path.Join(resp.Node.Path+suffix, BinName))
+	}
+	return
+}
+
+// Entries lists the nodes directly contained in a recycle bin.
+func (l *Locator) Entries(ctx context.Context, bin *Bin) ([]*tree.Node, error) {
+	stream, err := l.Router.ListNodes(ctx, &tree.ListNodesRequest{Node: bin.Node})
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+	var entries []*tree.Node
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp == nil || path.Base(resp.Node.Path) == common.PYDIO_SYNC_HIDDEN_FILE_META {
+			continue
+		}
+		entries = append(entries, resp.Node)
+	}
+	return entries, nil
+}
+
+// Shared checks if one of the given nodes is the root of a workspace, a cell or a share link.
+func (l *Locator) Shared(ctx context.Context, nodes ...*tree.Node) (bool, error) {
+	var ids []string
+	for _, n := range nodes {
+		if n.Uuid != "" {
+			ids = append(ids, n.Uuid)
+		}
+	}
+	if len(ids) == 0 {
+		return false, nil
+	}
+	q, _ := ptypes.MarshalAny(&idm.ACLSingleQuery{NodeIDs: ids})
+	stream, err := l.ACLClient.SearchACL(ctx, &idm.SearchACLRequest{Query: &service.Query{SubQueries: []*any.Any{q}}})
+	if err != nil {
+		return false, err
+	}
+	defer stream.Close()
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp != nil && resp.ACL.WorkspaceID != "" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
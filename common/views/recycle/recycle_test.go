@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package recycle
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/tree"
+)
+
+func TestBinPath(t *testing.T) {
+
+	Convey("Test bin paths", t, func() {
+		p, ok := BinPath("pydiods1/recycle_bin/file.txt")
+		So(ok, ShouldBeTrue)
+		So(p, ShouldEqual, "pydiods1/recycle_bin")
+
+		p, ok = BinPath("/personal/admin/recycle_bin/folder/sub")
+		So(ok, ShouldBeTrue)
+		So(p, ShouldEqual, "personal/admin/recycle_bin")
+
+		So(InBin("pydiods1/recycle_bin"), ShouldBeTrue)
+		So(InBin("pydiods1/recycle_bin_old/file.txt"), ShouldBeFalse)
+		So(InBin("pydiods1/folder/file.txt"), ShouldBeFalse)
+	})
+
+}
+
+func TestRetention(t *testing.T) {
+
+	Convey("Test retention attribute", t, func() {
+		So(Retention(&idm.Workspace{}, 30), ShouldEqual, 30)
+		So(Retention(&idm.Workspace{Attributes: `{"RECYCLE_RETENTION":7}`}, 30), ShouldEqual, 7)
+		So(Retention(&idm.Workspace{Attributes: `{"RECYCLE_RETENTION":"15"}`}, 30), ShouldEqual, 15)
+		So(Retention(&idm.Workspace{Attributes: `{"RECYCLE_RETENTION":"never"}`}, 30), ShouldEqual, 30)
+		So(Retention(&idm.Workspace{Attributes: `not json`}, 30), ShouldEqual, 30)
+	})
+
+}
+
+func TestDeletedAt(t *testing.T) {
+
+	Convey("Test deletion time", t, func() {
+		node := &tree.Node{MTime: 1000}
+		So(DeletedAt(node).Unix(), ShouldEqual, 1000)
+
+		now := time.Now().Unix()
+		node.SetMeta(MetaDeletedAt, now)
+		So(DeletedAt(node).Unix(), ShouldEqual, now)
+
+		node.SetMeta(MetaRestorePath, "pydiods1/folder/file.txt")
+		p, ok := RestorePath(node)
+		So(ok, ShouldBeTrue)
+		So(p, ShouldEqual, "pydiods1/folder/file.txt")
+	})
+
+}
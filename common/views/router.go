@@ -77,10 +77,12 @@ func NewStandardRouter(options RouterOptions) *Router {
 		handlers = append(handlers, &HandlerEventRead{})
 	}
 	handlers = append(handlers, &PutHandler{})
+	handlers = append(handlers, &RetentionFilter{})
 	if !options.AdminView {
 		handlers = append(handlers, &UploadLimitFilter{})
 		handlers = append(handlers, &AclLockFilter{})
 		handlers = append(handlers, &AclQuotaFilter{})
+		handlers = append(handlers, &AntivirusHandler{})
 	}
 	handlers = append(handlers, &EncryptionHandler{})
 	handlers = append(handlers, &VersionHandler{})
@@ -107,10 +109,12 @@ func NewUuidRouter(options RouterOptions) *Router {
 		handlers = append(handlers, &AclFilterHandler{})
 	}
 	handlers = append(handlers, &PutHandler{}) // adds a node precreation on PUT file request
+	handlers = append(handlers, &RetentionFilter{})
 	if !options.AdminView {
 		handlers = append(handlers, &UploadLimitFilter{})
 		handlers = append(handlers, &AclLockFilter{})
 		handlers = append(handlers, &AclQuotaFilter{})
+		handlers = append(handlers, &AntivirusHandler{})
 	}
 	handlers = append(handlers, &EncryptionHandler{}) // retrieves encryption materials from encryption service
 	handlers = append(handlers, &VersionHandler{})
@@ -29,6 +29,9 @@ const (
 	KEY_LOGGER = "Logger"
 	KEY_MSG    = "Msg"
 
+	// Prefix of the fields stored in the structured audit context
+	KEY_AUDIT_CONTEXT = "AuditContext"
+
 	/* AUDIT MANAGEMENT */
 
 	// Known audit message IDs
@@ -37,8 +40,12 @@ const (
 	AUDIT_LOGIN_FAILED        = "2"
 	AUDIT_LOGIN_POLICY_DENIAL = "3"
 	AUDIT_INVALID_JWT         = "4"
+	AUDIT_IMPERSONATE_START   = "5"
+	AUDIT_IMPERSONATE_REQUEST = "6"
 	AUDIT_OBJECT_GET          = "21"
 	AUDIT_OBJECT_PUT          = "22"
+	AUDIT_OBJECT_INFECTED     = "23"
+	AUDIT_OBJECT_UPLOAD_DENY  = "24"
 	// Tree events
 	AUDIT_NODE_CREATE = "11"
 	AUDIT_NODE_READ   = "12"
@@ -61,6 +68,9 @@ const (
 	AUDIT_ROLE_READ    = "52"
 	AUDIT_ROLE_UPDATE  = "53"
 	AUDIT_ROLE_DELETE  = "54"
+	// ACLs
+	AUDIT_ACL_STORE  = "55"
+	AUDIT_ACL_DELETE = "56"
 	// POLICIES
 	AUDIT_POLICY_GROUP_STORE  = "61"
 	AUDIT_POLICY_GROUP_DELETE = "62"
@@ -75,6 +85,17 @@ const (
 	AUDIT_LINK_READ   = "76"
 	AUDIT_LINK_UPDATE = "77"
 	AUDIT_LINK_DELTE  = "78"
+	// Jobs and Tasks
+	AUDIT_JOB_CREATE  = "81"
+	AUDIT_JOB_CONTROL = "82"
+	AUDIT_TASK_DELETE = "83"
+
+	// Well-known keys of the audit context
+	AUDIT_CONTEXT_SHARE_ID    = "ShareId"
+	AUDIT_CONTEXT_TARGET_USER = "TargetUser"
+	AUDIT_CONTEXT_OLD_ACL     = "OldAcl"
+	AUDIT_CONTEXT_NEW_ACL     = "NewAcl"
+	AUDIT_CONTEXT_JOB_ID      = "JobId"
 
 	/* BACK END */
 
@@ -112,6 +133,8 @@ const (
 	KEY_USERNAME  = "UserName"
 	KEY_USER_UUID = "UserUuid"
 
+	KEY_IMPERSONATOR = "Impersonator"
+
 	KEY_GROUP_PATH = "GroupPath"
 
 	KEY_CONNECTOR = "Connector"
@@ -164,14 +187,23 @@ const (
 
 var (
 	LogEventLabels = map[string]string{
-		AUDIT_LOGIN_SUCCEED: "Login succeed",
-		AUDIT_LOGIN_FAILED:  "Login failed",
-		AUDIT_NODE_CREATE:   "Create Node",
-		AUDIT_NODE_READ:     "Read Node",
-		AUDIT_NODE_LIST:     "List Node",
-		AUDIT_NODE_UPDATE:   "Upadate Node",
-		AUDIT_NODE_DELETE:   "Delete Node",
-		AUDIT_OBJECT_GET:    "Get Object",
-		AUDIT_OBJECT_PUT:    "Put Object",
+		AUDIT_LOGIN_SUCCEED:       "Login succeed",
+		AUDIT_LOGIN_FAILED:        "Login failed",
+		AUDIT_IMPERSONATE_START:   "Impersonation started",
+		AUDIT_IMPERSONATE_REQUEST: "Impersonated request",
+		AUDIT_NODE_CREATE:         "Create Node",
+		AUDIT_NODE_READ:           "Read Node",
+		AUDIT_NODE_LIST:           "List Node",
+		AUDIT_NODE_UPDATE:         "Upadate Node",
+		AUDIT_NODE_DELETE:         "Delete Node",
+		AUDIT_OBJECT_GET:          "Get Object",
+		AUDIT_OBJECT_PUT:          "Put Object",
+		AUDIT_OBJECT_INFECTED:     "Infected Object",
+		AUDIT_OBJECT_UPLOAD_DENY:  "Upload Denied",
+		AUDIT_ACL_STORE:           "Store ACL",
+		AUDIT_ACL_DELETE:          "Delete ACL",
+		AUDIT_JOB_CREATE:          "Create Job",
+		AUDIT_JOB_CONTROL:         "Control Job",
+		AUDIT_TASK_DELETE:         "Delete Tasks",
 	}
 )
@@ -31,11 +31,15 @@ import (
 
 	"github.com/blevesearch/bleve"
 	_ "github.com/blevesearch/bleve/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/geo"
+	bsearch "github.com/blevesearch/bleve/search"
 	"github.com/blevesearch/bleve/search/query"
+	"github.com/micro/go-micro/errors"
 	"github.com/sajari/docconv"
 	"go.uber.org/zap"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/pydio/cells/common"
 	"github.com/pydio/cells/common/log"
 	"github.com/pydio/cells/common/proto/tree"
 	"github.com/pydio/cells/common/views"
@@ -140,7 +144,9 @@ func (s *BleveServer) MakeIndexableNode(ctx context.Context, node *tree.Node) *I
 	} else {
 		indexNode.NodeType = "folder"
 	}
-	indexNode.GetMeta("GeoLocation", &indexNode.GeoPoint)
+	if point, ok := indexNode.GetGeoPoint(); ok {
+		indexNode.GeoPoint = map[string]interface{}{"lat": point.Lat, "lon": point.Lon}
+	}
 
 	if s.IndexContent && indexNode.IsLeaf() {
 		if s.Router == nil {
@@ -271,17 +277,26 @@ func (s *BleveServer) SearchNodes(c context.Context, queryObject *tree.Query, fr
 		boolean.AddMust(qStringQuery)
 	}
 
-	if queryObject.GeoQuery != nil {
-		if queryObject.GeoQuery.Center != nil && len(queryObject.GeoQuery.Distance) > 0 {
-			distanceQuery := bleve.NewGeoDistanceQuery(queryObject.GeoQuery.Center.Lon, queryObject.GeoQuery.Center.Lat, queryObject.GeoQuery.Distance)
+	var geoSort bsearch.SearchSort
+	if geoQuery := queryObject.GeoQuery; geoQuery != nil {
+		if e := validateGeoQuery(geoQuery); e != nil {
+			doneChan <- true
+			return e
+		}
+		if geoQuery.Center != nil {
+			distanceQuery := bleve.NewGeoDistanceQuery(geoQuery.Center.Lon, geoQuery.Center.Lat, geoQuery.Distance)
 			distanceQuery.SetField("GeoPoint")
 			boolean.AddMust(distanceQuery)
-		} else if queryObject.GeoQuery.TopLeft != nil && queryObject.GeoQuery.BottomRight != nil {
+			// Nearest nodes first
+			if distanceSort, e := bsearch.NewSortGeoDistance("GeoPoint", "m", geoQuery.Center.Lon, geoQuery.Center.Lat, false); e == nil {
+				geoSort = distanceSort
+			}
+		} else {
 			boundingBoxQuery := bleve.NewGeoBoundingBoxQuery(
-				queryObject.GeoQuery.TopLeft.Lon,
-				queryObject.GeoQuery.TopLeft.Lat,
-				queryObject.GeoQuery.BottomRight.Lon,
-				queryObject.GeoQuery.BottomRight.Lat,
+				geoQuery.TopLeft.Lon,
+				geoQuery.TopLeft.Lat,
+				geoQuery.BottomRight.Lon,
+				geoQuery.BottomRight.Lat,
 			)
 			boundingBoxQuery.SetField("GeoPoint")
 			boolean.AddMust(boundingBoxQuery)
@@ -294,6 +309,9 @@ func (s *BleveServer) SearchNodes(c context.Context, queryObject *tree.Query, fr
 		searchRequest.Size = int(size)
 	}
 	searchRequest.From = int(from)
+	if geoSort != nil {
+		searchRequest.SortByCustom(bsearch.SortOrder{geoSort})
+	}
 	searchResult, err := s.Engine.SearchInContext(c, searchRequest)
 	if err != nil {
 		doneChan <- true
@@ -338,3 +356,26 @@ func (s *BleveServer) SearchNodes(c context.Context, queryObject *tree.Query, fr
 	return nil
 
 }
+
+// validateGeoQuery checks that a GeoQuery defines either a valid center and distance, or a valid bounding box.
+func validateGeoQuery(q *tree.GeoQuery) error {
+	if q.Center != nil {
+		if !q.Center.IsValid() {
+			return errors.BadRequest(common.SERVICE_SEARCH, "invalid coordinates for GeoQuery center")
+		}
+		if _, e := geo.ParseDistance(q.Distance); q.Distance == "" || e != nil {
+			return errors.BadRequest(common.SERVICE_SEARCH, "invalid distance for GeoQuery: %s", q.Distance)
+		}
+		return nil
+	}
+	if q.TopLeft == nil || q.BottomRight == nil {
+		return errors.BadRequest(common.SERVICE_SEARCH, "GeoQuery requires a center and a distance, or TopLeft and BottomRight points")
+	}
+	if !q.TopLeft.IsValid() || !q.BottomRight.IsValid() {
+		return errors.BadRequest(common.SERVICE_SEARCH, "invalid bounding box for GeoQuery")
+	}
+	if q.TopLeft.Lat < q.BottomRight.Lat {
+		return errors.BadRequest(common.SERVICE_SEARCH, "invalid bounding box for GeoQuery: TopLeft must be north of BottomRight")
+	}
+	return nil
+}
@@ -461,11 +461,11 @@ func TestSearchNode(t *testing.T) {
 			GeoQuery: &tree.GeoQuery{
 				TopLeft: &tree.GeoPoint{
 					Lon: 8.372777777777776,
-					Lat: 47.10358888888888,
+					Lat: 47.10358888888890,
 				},
 				BottomRight: &tree.GeoPoint{
 					Lon: 8.372777777777778,
-					Lat: 47.10358888888890,
+					Lat: 47.10358888888888,
 				},
 			},
 		}
@@ -478,6 +478,77 @@ func TestSearchNode(t *testing.T) {
 
 }
 
+func TestSearchByGeoDistance(t *testing.T) {
+
+	Convey("Search Nodes sorted by distance", t, func() {
+
+		server, tmpDir := getTmpIndex(false)
+		defer func() {
+			server.Close()
+			os.RemoveAll(tmpDir)
+		}()
+
+		ctx := context.Background()
+		points := map[string]*tree.GeoPoint{
+			"lyon":     {Lat: 45.764043, Lon: 4.835659},
+			"paris":    {Lat: 48.856614, Lon: 2.352222},
+			"grenoble": {Lat: 45.188529, Lon: 5.724524},
+			"invalid":  {Lat: 200, Lon: 4.8},
+		}
+		for name, point := range points {
+			node := &tree.Node{Uuid: name, Path: "/photos/" + name + ".jpg", Type: 1, MTime: time.Now().Unix()}
+			node.SetMeta("name", name+".jpg")
+			node.SetGeoPoint(point)
+			So(server.IndexNode(ctx, node), ShouldBeNil)
+		}
+
+		results, e := search(ctx, server, &tree.Query{
+			GeoQuery: &tree.GeoQuery{
+				Center:   &tree.GeoPoint{Lat: 45.5, Lon: 5.5},
+				Distance: "100km",
+			},
+		})
+		So(e, ShouldBeNil)
+		So(results, ShouldHaveLength, 2)
+		So(results[0].Uuid, ShouldEqual, "grenoble")
+		So(results[1].Uuid, ShouldEqual, "lyon")
+
+		results, e = search(ctx, server, &tree.Query{
+			GeoQuery: &tree.GeoQuery{
+				TopLeft:     &tree.GeoPoint{Lat: 89, Lon: -179},
+				BottomRight: &tree.GeoPoint{Lat: -89, Lon: 179},
+			},
+		})
+		So(e, ShouldBeNil)
+		So(results, ShouldHaveLength, 3)
+
+		_, e = search(ctx, server, &tree.Query{
+			GeoQuery: &tree.GeoQuery{Center: &tree.GeoPoint{Lat: 45.5, Lon: 5.5}},
+		})
+		So(e, ShouldNotBeNil)
+
+		_, e = search(ctx, server, &tree.Query{
+			GeoQuery: &tree.GeoQuery{Center: &tree.GeoPoint{Lat: 45.5, Lon: 5.5}, Distance: "far away"},
+		})
+		So(e, ShouldNotBeNil)
+
+		_, e = search(ctx, server, &tree.Query{
+			GeoQuery: &tree.GeoQuery{TopLeft: &tree.GeoPoint{Lat: 45.5, Lon: 5.5}},
+		})
+		So(e, ShouldNotBeNil)
+
+		_, e = search(ctx, server, &tree.Query{
+			GeoQuery: &tree.GeoQuery{
+				TopLeft:     &tree.GeoPoint{Lat: -89, Lon: -179},
+				BottomRight: &tree.GeoPoint{Lat: 89, Lon: 179},
+			},
+		})
+		So(e, ShouldNotBeNil)
+
+	})
+
+}
+
 func TestDeleteNode(t *testing.T) {
 
 	Convey("Delete Node", t, func() {
@@ -26,6 +26,7 @@ import (
 	"github.com/pydio/cells/common/proto/rest"
 	"github.com/pydio/cells/common/proto/tree"
 	"github.com/pydio/cells/common/views"
+	"github.com/pydio/cells/common/views/recycle"
 )
 
 type Handler struct{}
@@ -110,3 +111,45 @@ func (h *Handler) StatAdminTree(req *restful.Request, resp *restful.Response) {
 	resp.WriteEntity(response)
 
 }
+
+// RecycleBinsReport lists the recycle bins of all workspaces with their size and entries.
+func (h *Handler) RecycleBinsReport(req *restful.Request, resp *restful.Response) {
+
+	input := rest.RecycleBinsRequest{WorkspaceUuid: req.QueryParameter("WorkspaceUuid")}
+	ctx := req.Request.Context()
+
+	locator := recycle.NewLocator()
+	bins, err := locator.Bins(ctx)
+	if err != nil {
+		resp.WriteError(500, err)
+		return
+	}
+
+	output := &rest.RecycleBinsCollection{}
+	for _, bin := range bins {
+		if input.WorkspaceUuid != "" && bin.Workspace.UUID != input.WorkspaceUuid {
+			continue
+		}
+		report := &rest.RecycleBin{
+			WorkspaceUuid:  bin.Workspace.UUID,
+			WorkspaceLabel: bin.Workspace.Label,
+			Path:           bin.Node.Path,
+			RetentionDays:  int32(recycle.Retention(bin.Workspace, 0)),
+			Size:           bin.Node.Size,
+		}
+		if entries, e := locator.Entries(ctx, bin); e == nil {
+			report.EntriesCount = int32(len(entries))
+			for _, entry := range entries {
+				deleted := int32(recycle.DeletedAt(entry).Unix())
+				if report.OldestDeletion == 0 || deleted < report.OldestDeletion {
+					report.OldestDeletion = deleted
+				}
+			}
+		}
+		output.Bins = append(output.Bins, report)
+		output.TotalSize += report.Size
+	}
+
+	resp.WriteEntity(output)
+
+}
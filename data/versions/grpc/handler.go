@@ -39,6 +39,7 @@ import (
 	activity2 "github.com/pydio/cells/common/proto/activity"
 	"github.com/pydio/cells/common/proto/docstore"
 	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/retention"
 	"github.com/pydio/cells/common/service/defaults"
 	"github.com/pydio/cells/common/utils"
 	"github.com/pydio/cells/data/versions"
@@ -150,6 +151,10 @@ func (h *Handler) StoreVersion(ctx context.Context, request *tree.StoreVersionRe
 		out, _ := versions.PruneAllWithMaxSize(pruningPeriods, p.MaxSizePerFile)
 		toRemove = append(toRemove, out...)
 	}
+	if len(toRemove) > 0 && h.isRetained(ctx, request.Node) {
+		log.Logger(ctx).Info("[VERSION] Node is under retention, versions are not pruned", request.Node.ZapUuid())
+		toRemove = nil
+	}
 	if len(toRemove) > 0 {
 		log.Logger(ctx).Debug("[VERSION] Pruning should remove", zap.Any("r", toRemove))
 		if err := h.db.DeleteVersionsForNode(request.Node.Uuid, toRemove...); err != nil {
@@ -206,6 +211,10 @@ func (h *Handler) PruneVersions(ctx context.Context, request *tree.PruneVersions
 
 	for _, i := range idsToDelete {
 
+		if h.isRetained(ctx, &tree.Node{Uuid: i}) {
+			log.Logger(ctx).Info("[VERSION] Node is under retention, versions are not pruned", zap.String("uuid", i))
+			continue
+		}
 		allLogs, done := h.db.GetVersions(i)
 		wg := &sync.WaitGroup{}
 		wg.Add(1)
@@ -231,6 +240,22 @@ func (h *Handler) PruneVersions(ctx context.Context, request *tree.PruneVersions
 	return nil
 }
 
+// isRetained checks if a node is protected by a retention rule or a legal hold, in which case none of its
+// versions can be removed. Nodes that cannot be checked are considered as retained.
+func (h *Handler) isRetained(ctx context.Context, node *tree.Node) bool {
+	var ancestors []*tree.Node
+	cl := tree.NewNodeProviderClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_TREE, defaults.NewClient())
+	if resp, e := cl.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: node.Uuid}}); e == nil {
+		ancestors, _ = utils.BuildAncestorsList(ctx, cl, resp.Node)
+	}
+	rule, err := retention.NewResolver().Find(ctx, node, ancestors...)
+	if err != nil {
+		log.Logger(ctx).Error("[VERSION] Cannot check retention rules", node.ZapUuid(), zap.Error(err))
+		return true
+	}
+	return rule != nil
+}
+
 func (h *Handler) findPolicyForNode(ctx context.Context, node *tree.Node) *tree.VersioningPolicy {
 
 	if policiesCache == nil {
@@ -108,6 +108,10 @@ func addDatasourceLocal(c *install.InstallConfig) (*object.DataSource, error) {
 		return nil, err
 	}
 
+	if err := os.MkdirAll(filepath.Join(folder, "logs"), 0755); err != nil {
+		return nil, err
+	}
+
 	if err := os.MkdirAll(filepath.Join(folder, "personal"), 0755); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,340 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package scim
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+
+	"github.com/pydio/cells/common/proto/idm"
+	service "github.com/pydio/cells/common/service/proto"
+)
+
+// GroupResolver finds the GroupPath corresponding to a group Uuid.
+type GroupResolver func(uuid string) (string, error)
+
+// MemberResolver finds the full path of the group a user belongs to, given the user Uuid. It returns
+// an empty path for users that do not belong to any group.
+type MemberResolver func(uuid string) (string, error)
+
+type filterToken struct {
+	value  string
+	quoted bool
+}
+
+// filterParser implements a recursive descent parser for the SCIM filter grammar
+// (RFC 7644 section 3.4.2.2) and directly translates it into idm queries.
+type filterParser struct {
+	tokens   []filterToken
+	pos      int
+	isGroup  bool
+	resolver GroupResolver
+	members  MemberResolver
+}
+
+// ParseFilter transforms a SCIM filter expression into an idm query usable by the
+// user service. An empty filter returns a nil query. Resolvers are required to filter users on their
+// groups and groups on their members.
+func ParseFilter(filter string, isGroup bool, resolver GroupResolver, members MemberResolver) (*any.Any, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+	tokens, e := tokenizeFilter(filter)
+	if e != nil {
+		return nil, e
+	}
+	p := &filterParser{tokens: tokens, isGroup: isGroup, resolver: resolver, members: members}
+	q, e := p.parseOr()
+	if e != nil {
+		return nil, e
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected token %s", p.tokens[p.pos].value)
+	}
+	return q, nil
+}
+
+func tokenizeFilter(filter string) (tokens []filterToken, e error) {
+	runes := []rune(filter)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')':
+			tokens = append(tokens, filterToken{value: string(r)})
+			i++
+		case r == '"':
+			var b strings.Builder
+			i++
+			closed := false
+			for i < len(runes) {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					b.WriteRune(runes[i+1])
+					i += 2
+					continue
+				}
+				if runes[i] == '"' {
+					closed = true
+					i++
+					break
+				}
+				b.WriteRune(runes[i])
+				i++
+			}
+			if !closed {
+				return nil, fmt.Errorf("unterminated string in filter")
+			}
+			tokens = append(tokens, filterToken{value: b.String(), quoted: true})
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && runes[i] != '(' && runes[i] != ')' && runes[i] != '"' {
+				i++
+			}
+			tokens = append(tokens, filterToken{value: string(runes[start:i])})
+		}
+	}
+	return
+}
+
+func (p *filterParser) peek() (filterToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return filterToken{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *filterParser) next() (filterToken, error) {
+	if p.pos >= len(p.tokens) {
+		return filterToken{}, fmt.Errorf("unexpected end of filter")
+	}
+	t := p.tokens[p.pos]
+	p.pos++
+	return t, nil
+}
+
+func (p *filterParser) isKeyword(word string) bool {
+	t, ok := p.peek()
+	return ok && !t.quoted && strings.EqualFold(t.value, word)
+}
+
+func (p *filterParser) parseOr() (*any.Any, error) {
+	return p.parseLogical("or", service.OperationType_OR, p.parseAnd)
+}
+
+func (p *filterParser) parseAnd() (*any.Any, error) {
+	return p.parseLogical("and", service.OperationType_AND, p.parseFactor)
+}
+
+func (p *filterParser) parseLogical(keyword string, op service.OperationType, sub func() (*any.Any, error)) (*any.Any, error) {
+	first, e := sub()
+	if e != nil {
+		return nil, e
+	}
+	queries := []*any.Any{first}
+	for p.isKeyword(keyword) {
+		p.pos++
+		q, e := sub()
+		if e != nil {
+			return nil, e
+		}
+		queries = append(queries, q)
+	}
+	if len(queries) == 1 {
+		return first, nil
+	}
+	return ptypes.MarshalAny(&service.Query{SubQueries: queries, Operation: op})
+}
+
+func (p *filterParser) parseFactor() (*any.Any, error) {
+	if p.isKeyword("not") {
+		p.pos++
+		q, e := p.parseGroup()
+		if e != nil {
+			return nil, e
+		}
+		single := &idm.UserSingleQuery{}
+		if e := ptypes.UnmarshalAny(q, single); e != nil {
+			return nil, fmt.Errorf("not() is only supported on a single comparison")
+		}
+		single.Not = !single.Not
+		return ptypes.MarshalAny(single)
+	}
+	if t, ok := p.peek(); ok && !t.quoted && t.value == "(" {
+		return p.parseGroup()
+	}
+	return p.parseComparison()
+}
+
+func (p *filterParser) parseGroup() (*any.Any, error) {
+	if t, e := p.next(); e != nil || t.value != "(" {
+		return nil, fmt.Errorf("expected (")
+	}
+	q, e := p.parseOr()
+	if e != nil {
+		return nil, e
+	}
+	if t, e := p.next(); e != nil || t.value != ")" {
+		return nil, fmt.Errorf("expected )")
+	}
+	return q, nil
+}
+
+func (p *filterParser) parseComparison() (*any.Any, error) {
+	attr, e := p.next()
+	if e != nil {
+		return nil, e
+	}
+	opToken, e := p.next()
+	if e != nil {
+		return nil, e
+	}
+	op := strings.ToLower(opToken.value)
+	var value string
+	if op != "pr" {
+		v, e := p.next()
+		if e != nil {
+			return nil, e
+		}
+		value = v.value
+	}
+	single, e := p.comparisonToQuery(strings.ToLower(attr.value), op, value)
+	if e != nil {
+		return nil, e
+	}
+	return ptypes.MarshalAny(single)
+}
+
+func (p *filterParser) comparisonToQuery(attr, op, value string) (*idm.UserSingleQuery, error) {
+	q := &idm.UserSingleQuery{}
+	pattern, not, e := valuePattern(op, value)
+	if e != nil {
+		return nil, e
+	}
+	q.Not = not
+	// Strip optional schema prefix
+	if strings.HasPrefix(attr, "urn:") {
+		attr = attr[strings.LastIndex(attr, ":")+1:]
+	}
+	switch attr {
+	case "id":
+		q.Uuid = pattern
+	case "externalid":
+		q.AttributeName = AttributeExternalId
+	case "username":
+		if p.isGroup {
+			return nil, fmt.Errorf("unsupported attribute userName on groups")
+		}
+		q.Login = pattern
+	case "displayname", "name.formatted":
+		q.AttributeName = AttributeDisplayName
+	case "name.givenname":
+		q.AttributeName = AttributeGivenName
+	case "name.familyname":
+		q.AttributeName = AttributeFamilyName
+	case "emails", "emails.value":
+		q.AttributeName = AttributeEmail
+	case "active":
+		if op != "eq" && op != "ne" {
+			return nil, fmt.Errorf("only eq and ne are supported on active")
+		}
+		q.AttributeName = AttributeLocks
+		q.AttributeValue = "*" + LockLogout + "*"
+		// active eq true is equivalent to "not locked"
+		if strings.EqualFold(value, "true") {
+			q.Not = !q.Not
+		}
+		return q, nil
+	case "roles", "roles.value":
+		q.HasRole = pattern
+	case "groups", "groups.value":
+		if p.isGroup {
+			return nil, fmt.Errorf("unsupported attribute groups on groups")
+		}
+		if op != "eq" {
+			return nil, fmt.Errorf("only eq is supported on group membership")
+		}
+		if p.resolver == nil {
+			return nil, fmt.Errorf("cannot resolve groups")
+		}
+		gPath, e := p.resolver(value)
+		if e != nil {
+			return nil, e
+		}
+		q.GroupPath = gPath
+	case "members", "members.value":
+		if !p.isGroup {
+			return nil, fmt.Errorf("unsupported attribute members on users")
+		}
+		if op != "eq" {
+			return nil, fmt.Errorf("only eq is supported on group membership")
+		}
+		if p.members == nil {
+			return nil, fmt.Errorf("cannot resolve members")
+		}
+		// A user is member of the group whose full path is its GroupPath
+		gPath, e := p.members(value)
+		if e != nil {
+			return nil, e
+		}
+		if gPath == "" {
+			// No group has a user Uuid, so that the query matches nothing
+			q.Uuid = value
+		} else {
+			q.FullPath = gPath
+		}
+	default:
+		return nil, fmt.Errorf("unsupported filter attribute %s", attr)
+	}
+	if q.AttributeName != "" {
+		if op == "pr" {
+			q.AttributeAnyValue = true
+		} else {
+			q.AttributeValue = pattern
+		}
+	} else if op == "pr" {
+		return nil, fmt.Errorf("pr is not supported on attribute %s", attr)
+	}
+	return q, nil
+}
+
+// valuePattern converts a SCIM operator and value to a wildcard pattern understood by the SQL layer.
+func valuePattern(op, value string) (pattern string, not bool, e error) {
+	switch op {
+	case "eq":
+		return value, false, nil
+	case "ne":
+		return value, true, nil
+	case "co":
+		return "*" + value + "*", false, nil
+	case "sw":
+		return value + "*", false, nil
+	case "ew":
+		return "*" + value, false, nil
+	case "pr":
+		return "", false, nil
+	}
+	return "", false, fmt.Errorf("unsupported filter operator %s", op)
+}
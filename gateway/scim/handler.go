@@ -0,0 +1,691 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package scim
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/idm"
+	service "github.com/pydio/cells/common/service/proto"
+)
+
+const (
+	contentType     = "application/scim+json"
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// Handler serves the SCIM 2.0 /Users and /Groups endpoints on top of the user service.
+type Handler struct {
+	UserClient idm.UserServiceClient
+	RoleClient idm.RoleServiceClient
+	// Token is the dedicated bearer token expected in the Authorization header
+	Token string
+	// BaseURL is used to build resources locations
+	BaseURL string
+
+	router *mux.Router
+}
+
+// NewHandler creates a SCIM handler and registers its routes.
+func NewHandler(userClient idm.UserServiceClient, roleClient idm.RoleServiceClient, token string) *Handler {
+	h := &Handler{
+		UserClient: userClient,
+		RoleClient: roleClient,
+		Token:      token,
+		BaseURL:    "/scim/v2",
+	}
+	r := mux.NewRouter().StrictSlash(true)
+	s := r.PathPrefix("/v2").Subrouter()
+	s.Methods("GET").Path("/ServiceProviderConfig").HandlerFunc(h.serviceProviderConfig)
+	s.Methods("GET").Path("/ResourceTypes").HandlerFunc(h.resourceTypes)
+	s.Methods("GET").Path("/Users").HandlerFunc(h.listUsers)
+	s.Methods("POST").Path("/Users").HandlerFunc(h.createUser)
+	s.Methods("GET").Path("/Users/{id}").HandlerFunc(h.getUser)
+	s.Methods("PUT").Path("/Users/{id}").HandlerFunc(h.replaceUser)
+	s.Methods("PATCH").Path("/Users/{id}").HandlerFunc(h.patchUser)
+	s.Methods("DELETE").Path("/Users/{id}").HandlerFunc(h.deleteUser)
+	s.Methods("GET").Path("/Groups").HandlerFunc(h.listGroups)
+	s.Methods("POST").Path("/Groups").HandlerFunc(h.createGroup)
+	s.Methods("GET").Path("/Groups/{id}").HandlerFunc(h.getGroup)
+	s.Methods("PUT").Path("/Groups/{id}").HandlerFunc(h.replaceGroup)
+	s.Methods("PATCH").Path("/Groups/{id}").HandlerFunc(h.patchGroup)
+	s.Methods("DELETE").Path("/Groups/{id}").HandlerFunc(h.deleteGroup)
+	h.router = r
+	return h
+}
+
+// ServeHTTP checks the bearer token and dispatches the request.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	bearer := strings.TrimSpace(strings.TrimPrefix(auth, "Bearer "))
+	if h.Token == "" || !strings.HasPrefix(auth, "Bearer ") || subtle.ConstantTimeCompare([]byte(bearer), []byte(h.Token)) != 1 {
+		log.Logger(r.Context()).Error("SCIM token validation failed, cannot process request", zap.String("path", r.URL.Path))
+		w.Header().Set("WWW-Authenticate", `Bearer realm="SCIM"`)
+		writeError(w, http.StatusUnauthorized, "", "invalid or missing bearer token")
+		return
+	}
+	log.Logger(r.Context()).Debug("SCIM request", zap.String("method", r.Method), zap.String("path", r.URL.Path))
+	h.router.ServeHTTP(w, r)
+}
+
+func writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
+
+func writeError(w http.ResponseWriter, status int, scimType string, detail string) {
+	body := map[string]interface{}{
+		"schemas": []string{SchemaError},
+		"status":  strconv.Itoa(status),
+		"detail":  detail,
+	}
+	if scimType != "" {
+		body["scimType"] = scimType
+	}
+	writeJSON(w, status, body)
+}
+
+func readJSON(r *http.Request, target interface{}) error {
+	defer r.Body.Close()
+	return json.NewDecoder(r.Body).Decode(target)
+}
+
+func (h *Handler) serviceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"schemas":        []string{SchemaSPConfig},
+		"patch":          map[string]bool{"supported": true},
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": maxPageSize},
+		"changePassword": map[string]bool{"supported": true},
+		"sort":           map[string]bool{"supported": false},
+		"etag":           map[string]bool{"supported": false},
+		"authenticationSchemes": []map[string]interface{}{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication scheme using a dedicated bearer token",
+		}},
+	})
+}
+
+func (h *Handler) resourceTypes(w http.ResponseWriter, r *http.Request) {
+	types := []interface{}{
+		map[string]interface{}{"schemas": []string{SchemaResourceType}, "id": "User", "name": "User", "endpoint": "/Users", "schema": SchemaUser},
+		map[string]interface{}{"schemas": []string{SchemaResourceType}, "id": "Group", "name": "Group", "endpoint": "/Groups", "schema": SchemaGroup},
+	}
+	writeJSON(w, http.StatusOK, &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: int32(len(types)),
+		StartIndex:   1,
+		ItemsPerPage: int32(len(types)),
+		Resources:    types,
+	})
+}
+
+// pagination reads startIndex (1-based) and count parameters.
+func pagination(r *http.Request) (offset int64, limit int64) {
+	limit = defaultPageSize
+	if s, e := strconv.ParseInt(r.URL.Query().Get("startIndex"), 10, 64); e == nil && s > 1 {
+		offset = s - 1
+	}
+	if c, e := strconv.ParseInt(r.URL.Query().Get("count"), 10, 64); e == nil && c >= 0 {
+		limit = c
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+	return
+}
+
+// buildQuery combines the node type restriction with an optional parsed filter.
+func (h *Handler) buildQuery(ctx context.Context, r *http.Request, nodeType idm.NodeType) (*service.Query, error) {
+	typeQuery, _ := ptypes.MarshalAny(&idm.UserSingleQuery{NodeType: nodeType})
+	filter, e := ParseFilter(r.URL.Query().Get("filter"), nodeType == idm.NodeType_GROUP, func(uuid string) (string, error) {
+		g, e := h.loadOne(ctx, &idm.UserSingleQuery{Uuid: uuid, NodeType: idm.NodeType_GROUP})
+		if e != nil {
+			return "", e
+		}
+		if g == nil {
+			return "", fmt.Errorf("cannot find group %s", uuid)
+		}
+		return groupFullPath(g), nil
+	}, func(uuid string) (string, error) {
+		u, e := h.loadOne(ctx, &idm.UserSingleQuery{Uuid: uuid, NodeType: idm.NodeType_USER})
+		if e != nil {
+			return "", e
+		}
+		if u == nil {
+			return "", fmt.Errorf("cannot find user %s", uuid)
+		}
+		if p := safePath(u.GroupPath); p != "/" {
+			return p, nil
+		}
+		// Users at the root are not member of any group
+		return "", nil
+	})
+	if e != nil {
+		return nil, e
+	}
+	query := &service.Query{
+		SubQueries: []*any.Any{typeQuery},
+		Operation:  service.OperationType_AND,
+	}
+	if filter != nil {
+		query.SubQueries = append(query.SubQueries, filter)
+	}
+	return query, nil
+}
+
+// search streams users or groups matching a query.
+func (h *Handler) search(ctx context.Context, query *service.Query) (results []*idm.User, e error) {
+	stream, e := h.UserClient.SearchUser(ctx, &idm.SearchUserRequest{Query: query})
+	if e != nil {
+		return nil, e
+	}
+	defer stream.Close()
+	for {
+		rsp, er := stream.Recv()
+		if er != nil {
+			break
+		}
+		if rsp == nil || rsp.User == nil {
+			continue
+		}
+		results = append(results, rsp.User)
+	}
+	return
+}
+
+// loadOne finds a single user or group.
+func (h *Handler) loadOne(ctx context.Context, single *idm.UserSingleQuery) (*idm.User, error) {
+	q, _ := ptypes.MarshalAny(single)
+	results, e := h.search(ctx, &service.Query{SubQueries: []*any.Any{q}, Limit: 1})
+	if e != nil || len(results) == 0 {
+		return nil, e
+	}
+	return results[0], nil
+}
+
+// groupsFor loads the groups matching users GroupPath, to fill the read-only "groups" attribute.
+func (h *Handler) groupsFor(ctx context.Context, users ...*idm.User) (groups []*idm.User) {
+	seen := make(map[string]bool)
+	for _, u := range users {
+		p := safePath(u.GroupPath)
+		if p == "/" || seen[p] {
+			continue
+		}
+		seen[p] = true
+		if g, e := h.loadOne(ctx, &idm.UserSingleQuery{FullPath: p, NodeType: idm.NodeType_GROUP}); e == nil && g != nil {
+			groups = append(groups, g)
+		}
+	}
+	return
+}
+
+// membersOf lists the users directly attached to a group. The root is not a group and has no members.
+func (h *Handler) membersOf(ctx context.Context, g *idm.User) ([]*idm.User, error) {
+	if groupFullPath(g) == "/" {
+		return nil, nil
+	}
+	q, _ := ptypes.MarshalAny(&idm.UserSingleQuery{GroupPath: groupFullPath(g), NodeType: idm.NodeType_USER})
+	return h.search(ctx, &service.Query{SubQueries: []*any.Any{q}, Limit: maxPageSize})
+}
+
+/////////////////
+// USERS
+/////////////////
+
+func (h *Handler) listUsers(w http.ResponseWriter, r *http.Request) {
+	h.list(w, r, idm.NodeType_USER)
+}
+
+func (h *Handler) listGroups(w http.ResponseWriter, r *http.Request) {
+	h.list(w, r, idm.NodeType_GROUP)
+}
+
+func (h *Handler) list(w http.ResponseWriter, r *http.Request, nodeType idm.NodeType) {
+	ctx := r.Context()
+	query, e := h.buildQuery(ctx, r, nodeType)
+	if e != nil {
+		writeError(w, http.StatusBadRequest, "invalidFilter", e.Error())
+		return
+	}
+	count, e := h.UserClient.CountUser(ctx, &idm.SearchUserRequest{Query: query})
+	if e != nil {
+		writeError(w, http.StatusInternalServerError, "", e.Error())
+		return
+	}
+	offset, limit := pagination(r)
+	response := &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: count.Count,
+		StartIndex:   int32(offset + 1),
+		Resources:    []interface{}{},
+	}
+	if limit > 0 {
+		query.Offset = offset
+		query.Limit = limit
+		results, e := h.search(ctx, query)
+		if e != nil {
+			writeError(w, http.StatusInternalServerError, "", e.Error())
+			return
+		}
+		if nodeType == idm.NodeType_USER {
+			groups := h.groupsFor(ctx, results...)
+			for _, u := range results {
+				response.Resources = append(response.Resources, userToScim(u, h.BaseURL, groups...))
+			}
+		} else {
+			for _, g := range results {
+				members, _ := h.membersOf(ctx, g)
+				response.Resources = append(response.Resources, groupToScim(g, h.BaseURL, members))
+			}
+		}
+	}
+	response.ItemsPerPage = int32(len(response.Resources))
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (h *Handler) loadUser(w http.ResponseWriter, r *http.Request) (*idm.User, bool) {
+	id := mux.Vars(r)["id"]
+	u, e := h.loadOne(r.Context(), &idm.UserSingleQuery{Uuid: id, NodeType: idm.NodeType_USER})
+	if e != nil {
+		writeError(w, http.StatusInternalServerError, "", e.Error())
+		return nil, false
+	}
+	if u == nil {
+		writeError(w, http.StatusNotFound, "", fmt.Sprintf("User %s not found", id))
+		return nil, false
+	}
+	return u, true
+}
+
+func (h *Handler) writeUser(w http.ResponseWriter, r *http.Request, status int, u *idm.User) {
+	writeJSON(w, status, userToScim(u, h.BaseURL, h.groupsFor(r.Context(), u)...))
+}
+
+func (h *Handler) getUser(w http.ResponseWriter, r *http.Request) {
+	if u, ok := h.loadUser(w, r); ok {
+		h.writeUser(w, r, http.StatusOK, u)
+	}
+}
+
+func (h *Handler) createUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var in User
+	if e := readJSON(r, &in); e != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", e.Error())
+		return
+	}
+	if in.UserName == "" {
+		writeError(w, http.StatusBadRequest, "invalidValue", "userName is required")
+		return
+	}
+	if existing, e := h.loadOne(ctx, &idm.UserSingleQuery{Login: in.UserName}); e != nil {
+		writeError(w, http.StatusInternalServerError, "", e.Error())
+		return
+	} else if existing != nil {
+		writeError(w, http.StatusConflict, "uniqueness", fmt.Sprintf("User %s already exists", in.UserName))
+		return
+	}
+	u := &idm.User{GroupPath: "/"}
+	applyScimToUser(&in, u)
+	u.Policies = service.NewResourcePoliciesBuilder().WithStandardUserPolicies(u.Login).Policies()
+	resp, e := h.UserClient.CreateUser(ctx, &idm.CreateUserRequest{User: u})
+	if e != nil {
+		writeError(w, http.StatusInternalServerError, "", e.Error())
+		return
+	}
+	if _, e := h.RoleClient.CreateRole(ctx, &idm.CreateRoleRequest{Role: &idm.Role{
+		Uuid:     resp.User.Uuid,
+		Label:    resp.User.Login,
+		UserRole: true,
+		Policies: u.Policies,
+	}}); e != nil {
+		writeError(w, http.StatusInternalServerError, "", e.Error())
+		return
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("SCIM provisioning created user %s", resp.User.Login),
+		log.GetAuditId(common.AUDIT_USER_CREATE),
+		resp.User.ZapUuid(),
+	)
+	h.writeUser(w, r, http.StatusCreated, resp.User)
+}
+
+// saveUser stores an updated user, keeping its uuid and group path.
+func (h *Handler) saveUser(w http.ResponseWriter, r *http.Request, existing *idm.User, in *User) {
+	ctx := r.Context()
+	if in.UserName == "" {
+		writeError(w, http.StatusBadRequest, "invalidValue", "userName is required")
+		return
+	}
+	if in.UserName != existing.Login {
+		if other, e := h.loadOne(ctx, &idm.UserSingleQuery{Login: in.UserName}); e == nil && other != nil && other.Uuid != existing.Uuid {
+			writeError(w, http.StatusConflict, "uniqueness", fmt.Sprintf("User %s already exists", in.UserName))
+			return
+		}
+	}
+	applyScimToUser(in, existing)
+	resp, e := h.UserClient.CreateUser(ctx, &idm.CreateUserRequest{User: existing})
+	if e != nil {
+		writeError(w, http.StatusInternalServerError, "", e.Error())
+		return
+	}
+	resp.User.Roles = existing.Roles
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("SCIM provisioning updated user %s", resp.User.Login),
+		log.GetAuditId(common.AUDIT_USER_UPDATE),
+		resp.User.ZapUuid(),
+	)
+	h.writeUser(w, r, http.StatusOK, resp.User)
+}
+
+func (h *Handler) replaceUser(w http.ResponseWriter, r *http.Request) {
+	existing, ok := h.loadUser(w, r)
+	if !ok {
+		return
+	}
+	var in User
+	if e := readJSON(r, &in); e != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", e.Error())
+		return
+	}
+	h.saveUser(w, r, existing, &in)
+}
+
+func (h *Handler) patchUser(w http.ResponseWriter, r *http.Request) {
+	existing, ok := h.loadUser(w, r)
+	if !ok {
+		return
+	}
+	var patch PatchRequest
+	if e := readJSON(r, &patch); e != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", e.Error())
+		return
+	}
+	current := userToScim(existing, h.BaseURL)
+	if e := ApplyPatch(current, patch.Operations); e != nil {
+		writeError(w, http.StatusBadRequest, "invalidPath", e.Error())
+		return
+	}
+	h.saveUser(w, r, existing, current)
+}
+
+func (h *Handler) deleteUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	existing, ok := h.loadUser(w, r)
+	if !ok {
+		return
+	}
+	q, _ := ptypes.MarshalAny(&idm.UserSingleQuery{Uuid: existing.Uuid})
+	if _, e := h.UserClient.DeleteUser(ctx, &idm.DeleteUserRequest{Query: &service.Query{SubQueries: []*any.Any{q}}}); e != nil {
+		writeError(w, http.StatusInternalServerError, "", e.Error())
+		return
+	}
+	h.deleteRole(ctx, existing.Uuid)
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("SCIM provisioning deleted user %s", existing.Login),
+		log.GetAuditId(common.AUDIT_USER_DELETE),
+		existing.ZapUuid(),
+	)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) deleteRole(ctx context.Context, uuid string) {
+	q, _ := ptypes.MarshalAny(&idm.RoleSingleQuery{Uuid: []string{uuid}})
+	if _, e := h.RoleClient.DeleteRole(ctx, &idm.DeleteRoleRequest{Query: &service.Query{SubQueries: []*any.Any{q}}}); e != nil {
+		log.Logger(ctx).Error("cannot delete role "+uuid, zap.Error(e))
+	}
+}
+
+/////////////////
+// GROUPS
+/////////////////
+
+func (h *Handler) loadGroup(w http.ResponseWriter, r *http.Request) (*idm.User, bool) {
+	id := mux.Vars(r)["id"]
+	g, e := h.loadOne(r.Context(), &idm.UserSingleQuery{Uuid: id, NodeType: idm.NodeType_GROUP})
+	if e != nil {
+		writeError(w, http.StatusInternalServerError, "", e.Error())
+		return nil, false
+	}
+	if g == nil {
+		writeError(w, http.StatusNotFound, "", fmt.Sprintf("Group %s not found", id))
+		return nil, false
+	}
+	return g, true
+}
+
+func (h *Handler) writeGroup(w http.ResponseWriter, r *http.Request, status int, g *idm.User) {
+	members, e := h.membersOf(r.Context(), g)
+	if e != nil {
+		writeError(w, http.StatusInternalServerError, "", e.Error())
+		return
+	}
+	writeJSON(w, status, groupToScim(g, h.BaseURL, members))
+}
+
+func (h *Handler) getGroup(w http.ResponseWriter, r *http.Request) {
+	if g, ok := h.loadGroup(w, r); ok {
+		h.writeGroup(w, r, http.StatusOK, g)
+	}
+}
+
+func (h *Handler) createGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var in Group
+	if e := readJSON(r, &in); e != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", e.Error())
+		return
+	}
+	if in.DisplayName == "" {
+		writeError(w, http.StatusBadRequest, "invalidValue", "displayName is required")
+		return
+	}
+	g := &idm.User{}
+	applyScimToGroup(&in, g)
+	if existing, e := h.loadOne(ctx, &idm.UserSingleQuery{FullPath: g.GroupPath, NodeType: idm.NodeType_GROUP}); e != nil {
+		writeError(w, http.StatusInternalServerError, "", e.Error())
+		return
+	} else if existing != nil {
+		writeError(w, http.StatusConflict, "uniqueness", fmt.Sprintf("Group %s already exists", in.DisplayName))
+		return
+	}
+	g.Policies = service.NewResourcePoliciesBuilder().WithProfileRead(common.PYDIO_PROFILE_STANDARD).WithProfileWrite(common.PYDIO_PROFILE_ADMIN).Policies()
+	resp, e := h.UserClient.CreateUser(ctx, &idm.CreateUserRequest{User: g})
+	if e != nil {
+		writeError(w, http.StatusInternalServerError, "", e.Error())
+		return
+	}
+	if _, e := h.RoleClient.CreateRole(ctx, &idm.CreateRoleRequest{Role: &idm.Role{
+		Uuid:      resp.User.Uuid,
+		Label:     g.GroupLabel,
+		GroupRole: true,
+		Policies:  g.Policies,
+	}}); e != nil {
+		writeError(w, http.StatusInternalServerError, "", e.Error())
+		return
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("SCIM provisioning created group %s", in.DisplayName),
+		log.GetAuditId(common.AUDIT_GROUP_CREATE),
+		resp.User.ZapUuid(),
+	)
+	if e := h.setMembers(ctx, resp.User, nil, in.Members); e != nil {
+		writeError(w, http.StatusBadRequest, "invalidValue", e.Error())
+		return
+	}
+	h.writeGroup(w, r, http.StatusCreated, resp.User)
+}
+
+// saveGroup stores group attributes and applies the membership changes.
+func (h *Handler) saveGroup(w http.ResponseWriter, r *http.Request, existing *idm.User, in *Group) {
+	ctx := r.Context()
+	members, e := h.membersOf(ctx, existing)
+	if e != nil {
+		writeError(w, http.StatusInternalServerError, "", e.Error())
+		return
+	}
+	applyScimToGroup(in, existing)
+	if _, e := h.UserClient.CreateUser(ctx, &idm.CreateUserRequest{User: existing}); e != nil {
+		writeError(w, http.StatusInternalServerError, "", e.Error())
+		return
+	}
+	if e := h.setMembers(ctx, existing, members, in.Members); e != nil {
+		writeError(w, http.StatusBadRequest, "invalidValue", e.Error())
+		return
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("SCIM provisioning updated group %s", in.DisplayName),
+		log.GetAuditId(common.AUDIT_GROUP_UPDATE),
+		existing.ZapUuid(),
+	)
+	h.writeGroup(w, r, http.StatusOK, existing)
+}
+
+// setMembers moves users in or out of the group path. As users belong to exactly one group,
+// adding a member moves it from its previous group, and removing it moves it back to the root.
+func (h *Handler) setMembers(ctx context.Context, g *idm.User, current []*idm.User, target []MultiValue) error {
+	wanted := make(map[string]bool, len(target))
+	for _, m := range target {
+		wanted[m.Value] = true
+	}
+	has := make(map[string]bool, len(current))
+	for _, u := range current {
+		has[u.Uuid] = true
+		if !wanted[u.Uuid] {
+			if e := h.moveUser(ctx, u, "/"); e != nil {
+				return e
+			}
+		}
+	}
+	for id := range wanted {
+		if has[id] {
+			continue
+		}
+		u, e := h.loadOne(ctx, &idm.UserSingleQuery{Uuid: id, NodeType: idm.NodeType_USER})
+		if e != nil {
+			return e
+		}
+		if u == nil {
+			return fmt.Errorf("cannot find member %s", id)
+		}
+		if e := h.moveUser(ctx, u, groupFullPath(g)); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+func (h *Handler) moveUser(ctx context.Context, u *idm.User, groupPath string) error {
+	u.GroupPath = groupPath
+	var roles []*idm.Role
+	for _, r := range u.Roles {
+		// Group roles are recomputed from the new path
+		if !r.GroupRole && len(r.AutoApplies) == 0 {
+			roles = append(roles, r)
+		}
+	}
+	u.Roles = roles
+	_, e := h.UserClient.CreateUser(ctx, &idm.CreateUserRequest{User: u})
+	return e
+}
+
+func (h *Handler) replaceGroup(w http.ResponseWriter, r *http.Request) {
+	existing, ok := h.loadGroup(w, r)
+	if !ok {
+		return
+	}
+	var in Group
+	if e := readJSON(r, &in); e != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", e.Error())
+		return
+	}
+	h.saveGroup(w, r, existing, &in)
+}
+
+func (h *Handler) patchGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	existing, ok := h.loadGroup(w, r)
+	if !ok {
+		return
+	}
+	var patch PatchRequest
+	if e := readJSON(r, &patch); e != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", e.Error())
+		return
+	}
+	members, e := h.membersOf(ctx, existing)
+	if e != nil {
+		writeError(w, http.StatusInternalServerError, "", e.Error())
+		return
+	}
+	current := groupToScim(existing, h.BaseURL, members)
+	if e := ApplyPatch(current, patch.Operations); e != nil {
+		writeError(w, http.StatusBadRequest, "invalidPath", e.Error())
+		return
+	}
+	h.saveGroup(w, r, existing, current)
+}
+
+func (h *Handler) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	existing, ok := h.loadGroup(w, r)
+	if !ok {
+		return
+	}
+	// Detach members first, as deleting a group node is recursive
+	members, e := h.membersOf(ctx, existing)
+	if e == nil {
+		e = h.setMembers(ctx, existing, members, nil)
+	}
+	if e != nil {
+		writeError(w, http.StatusInternalServerError, "", e.Error())
+		return
+	}
+	q, _ := ptypes.MarshalAny(&idm.UserSingleQuery{Uuid: existing.Uuid})
+	if _, e := h.UserClient.DeleteUser(ctx, &idm.DeleteUserRequest{Query: &service.Query{SubQueries: []*any.Any{q}}}); e != nil {
+		writeError(w, http.StatusInternalServerError, "", e.Error())
+		return
+	}
+	h.deleteRole(ctx, existing.Uuid)
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("SCIM provisioning deleted group %s", groupDisplayName(existing)),
+		log.GetAuditId(common.AUDIT_GROUP_DELETE),
+		existing.ZapUuid(),
+	)
+	w.WriteHeader(http.StatusNoContent)
+}
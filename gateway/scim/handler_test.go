@@ -0,0 +1,328 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/pydio/cells/common/proto/idm"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const testToken = "scim-test-token"
+
+// replayStep is a recorded SCIM request, as sent by an identity provider, with its expected status.
+// Occurrences of {user} and {group} in path and body are replaced by the ids captured in previous steps.
+type replayStep struct {
+	name    string
+	method  string
+	path    string
+	body    string
+	token   string
+	status  int
+	capture string
+	check   func(body map[string]interface{})
+}
+
+func replay(h http.Handler, steps []replayStep) {
+	ids := map[string]string{}
+	expand := func(s string) string {
+		for k, v := range ids {
+			s = strings.Replace(s, "{"+k+"}", v, -1)
+		}
+		return s
+	}
+	for _, step := range steps {
+		req := httptest.NewRequest(step.method, expand(step.path), strings.NewReader(expand(step.body)))
+		req.Header.Set("Content-Type", contentType)
+		token := step.token
+		if token == "" {
+			token = testToken
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		// Prefix with step name so that failures are easy to locate
+		So(fmt.Sprintf("%s: %d", step.name, rec.Code), ShouldEqual, fmt.Sprintf("%s: %d", step.name, step.status))
+		var body map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &body)
+		if step.capture != "" && body != nil {
+			ids[step.capture], _ = body["id"].(string)
+		}
+		if step.check != nil {
+			step.check(body)
+		}
+	}
+}
+
+func newTestHandler() (*Handler, *userClientMock, *roleClientMock) {
+	users := &userClientMock{}
+	roles := &roleClientMock{roles: map[string]*idm.Role{}}
+	return NewHandler(users, roles, testToken), users, roles
+}
+
+func TestProvisioningReplay(t *testing.T) {
+
+	Convey("Replay an identity provider provisioning session", t, func() {
+
+		h, users, roles := newTestHandler()
+
+		replay(h, []replayStep{
+			{name: "Invalid token is rejected", method: "GET", path: "/v2/Users", token: "wrong", status: http.StatusUnauthorized},
+			{name: "Service provider config", method: "GET", path: "/v2/ServiceProviderConfig", status: http.StatusOK},
+			{
+				name:    "Create user",
+				method:  "POST",
+				path:    "/v2/Users",
+				body:    `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"userName":"alice","name":{"givenName":"Alice","familyName":"Liddell"},"emails":[{"primary":true,"value":"alice@example.com","type":"work"}],"externalId":"00u1","active":true}`,
+				status:  http.StatusCreated,
+				capture: "user",
+				check: func(body map[string]interface{}) {
+					So(body["userName"], ShouldEqual, "alice")
+					So(body["displayName"], ShouldEqual, "Alice Liddell")
+				},
+			},
+			{name: "Duplicate user", method: "POST", path: "/v2/Users", body: `{"userName":"alice"}`, status: http.StatusConflict},
+			{name: "Create second user", method: "POST", path: "/v2/Users", body: `{"userName":"bob","emails":[{"value":"bob@example.com"}]}`, status: http.StatusCreated, capture: "bob"},
+			{
+				name:   "Filter by userName",
+				method: "GET",
+				path:   `/v2/Users?filter=userName+eq+%22alice%22`,
+				status: http.StatusOK,
+				check: func(body map[string]interface{}) {
+					So(body["totalResults"], ShouldEqual, 1)
+				},
+			},
+			{
+				name:   "Paginate",
+				method: "GET",
+				path:   `/v2/Users?startIndex=2&count=1`,
+				status: http.StatusOK,
+				check: func(body map[string]interface{}) {
+					So(body["totalResults"], ShouldEqual, 2)
+					So(body["itemsPerPage"], ShouldEqual, 1)
+					So(body["startIndex"], ShouldEqual, 2)
+				},
+			},
+			{name: "Invalid filter", method: "GET", path: `/v2/Users?filter=meta.created+gt+%222018%22`, status: http.StatusBadRequest},
+			{
+				name:    "Create group with member",
+				method:  "POST",
+				path:    "/v2/Groups",
+				body:    `{"displayName":"Sales Team","members":[{"value":"{user}"}]}`,
+				status:  http.StatusCreated,
+				capture: "group",
+				check: func(body map[string]interface{}) {
+					So(body["members"], ShouldHaveLength, 1)
+				},
+			},
+			{
+				name:   "User lists its group",
+				method: "GET",
+				path:   "/v2/Users/{user}",
+				status: http.StatusOK,
+				check: func(body map[string]interface{}) {
+					So(body["groups"], ShouldHaveLength, 1)
+				},
+			},
+			{
+				name:   "Filter users by group",
+				method: "GET",
+				path:   `/v2/Users?filter=groups.value+eq+%22{group}%22`,
+				status: http.StatusOK,
+				check: func(body map[string]interface{}) {
+					So(body["totalResults"], ShouldEqual, 1)
+				},
+			},
+			{
+				name:   "Filter groups by member",
+				method: "GET",
+				path:   `/v2/Groups?filter=members+eq+%22{user}%22`,
+				status: http.StatusOK,
+				check: func(body map[string]interface{}) {
+					So(body["totalResults"], ShouldEqual, 1)
+				},
+			},
+			{
+				name:   "User at the root is member of no group",
+				method: "GET",
+				path:   `/v2/Groups?filter=members+eq+%22{bob}%22`,
+				status: http.StatusOK,
+				check: func(body map[string]interface{}) {
+					So(body["totalResults"], ShouldEqual, 0)
+				},
+			},
+			{
+				name:   "Deactivate user",
+				method: "PATCH",
+				path:   "/v2/Users/{user}",
+				body:   `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"replace","value":{"active":false}}]}`,
+				status: http.StatusOK,
+				check: func(body map[string]interface{}) {
+					So(body["active"], ShouldEqual, false)
+					So(body["groups"], ShouldHaveLength, 1)
+				},
+			},
+			{
+				name:   "Filter inactive users",
+				method: "GET",
+				path:   `/v2/Users?filter=active+eq+false`,
+				status: http.StatusOK,
+				check: func(body map[string]interface{}) {
+					So(body["totalResults"], ShouldEqual, 1)
+				},
+			},
+			{
+				name:   "Change email through value filter path",
+				method: "PATCH",
+				path:   "/v2/Users/{user}",
+				body:   `{"Operations":[{"op":"replace","path":"emails[type eq \"work\"].value","value":"alice@corp.example.com"}]}`,
+				status: http.StatusOK,
+				check: func(body map[string]interface{}) {
+					emails := body["emails"].([]interface{})
+					So(emails[0].(map[string]interface{})["value"], ShouldEqual, "alice@corp.example.com")
+				},
+			},
+			{
+				name:   "Add member to group",
+				method: "PATCH",
+				path:   "/v2/Groups/{group}",
+				body:   `{"Operations":[{"op":"add","path":"members","value":[{"value":"{bob}"}]}]}`,
+				status: http.StatusOK,
+				check: func(body map[string]interface{}) {
+					So(body["members"], ShouldHaveLength, 2)
+				},
+			},
+			{
+				name:   "Remove member from group",
+				method: "PATCH",
+				path:   "/v2/Groups/{group}",
+				body:   `{"Operations":[{"op":"remove","path":"members[value eq \"{user}\"]"}]}`,
+				status: http.StatusOK,
+				check: func(body map[string]interface{}) {
+					So(body["members"], ShouldHaveLength, 1)
+				},
+			},
+			{name: "Delete group", method: "DELETE", path: "/v2/Groups/{group}", status: http.StatusNoContent},
+			{name: "Delete user", method: "DELETE", path: "/v2/Users/{user}", status: http.StatusNoContent},
+			{name: "Deleted user is gone", method: "GET", path: "/v2/Users/{user}", status: http.StatusNotFound},
+		})
+
+		So(users.users, ShouldHaveLength, 1)
+		So(users.users[0].Login, ShouldEqual, "bob")
+		So(users.users[0].GroupPath, ShouldEqual, "/")
+		So(roles.roles, ShouldHaveLength, 1)
+	})
+}
+
+func TestParseFilter(t *testing.T) {
+
+	Convey("Parse SCIM filters", t, func() {
+
+		q, e := ParseFilter(`userName sw "j" and (emails co "example.com" or emails.value ew ".org")`, false, nil, nil)
+		So(e, ShouldBeNil)
+		So(q, ShouldNotBeNil)
+
+		q, e = ParseFilter(`not (userName eq "john")`, false, nil, nil)
+		So(e, ShouldBeNil)
+		single := &idm.UserSingleQuery{}
+		So(ptypes.UnmarshalAny(q, single), ShouldBeNil)
+		So(single.Login, ShouldEqual, "john")
+		So(single.Not, ShouldBeTrue)
+
+		q, e = ParseFilter(`externalId pr`, false, nil, nil)
+		So(e, ShouldBeNil)
+		So(ptypes.UnmarshalAny(q, single), ShouldBeNil)
+		So(single.AttributeAnyValue, ShouldBeTrue)
+
+		_, e = ParseFilter(`userName eq "unterminated`, false, nil, nil)
+		So(e, ShouldNotBeNil)
+
+		_, e = ParseFilter(`userName eq "john" and`, false, nil, nil)
+		So(e, ShouldNotBeNil)
+
+		_, e = ParseFilter(`userName eq "john"`, true, nil, nil)
+		So(e, ShouldNotBeNil)
+
+		// Groups are filtered on their members through the group path of the user
+		members := func(uuid string) (string, error) {
+			switch uuid {
+			case "user-uuid":
+				return "/sales", nil
+			case "root-uuid":
+				return "", nil
+			}
+			return "", fmt.Errorf("cannot find user %s", uuid)
+		}
+		q, e = ParseFilter(`members.value eq "user-uuid"`, true, nil, members)
+		So(e, ShouldBeNil)
+		single = &idm.UserSingleQuery{}
+		So(ptypes.UnmarshalAny(q, single), ShouldBeNil)
+		So(single.FullPath, ShouldEqual, "/sales")
+		So(single.GroupPath, ShouldBeEmpty)
+		q, e = ParseFilter(`members eq "root-uuid"`, true, nil, members)
+		So(e, ShouldBeNil)
+		single = &idm.UserSingleQuery{}
+		So(ptypes.UnmarshalAny(q, single), ShouldBeNil)
+		So(single.FullPath, ShouldBeEmpty)
+		So(single.Uuid, ShouldEqual, "root-uuid")
+		_, e = ParseFilter(`members eq "unknown"`, true, nil, members)
+		So(e, ShouldNotBeNil)
+		_, e = ParseFilter(`members eq "user-uuid"`, true, nil, nil)
+		So(e, ShouldNotBeNil)
+		_, e = ParseFilter(`members eq "user-uuid"`, false, nil, members)
+		So(e, ShouldNotBeNil)
+	})
+}
+
+func TestApplyPatch(t *testing.T) {
+
+	Convey("Apply PATCH operations", t, func() {
+
+		active := true
+		u := &User{UserName: "john", Active: &active, Emails: []MultiValue{{Value: "a@b.c", Type: "work"}}}
+
+		e := ApplyPatch(u, []PatchOperation{
+			{Op: "Replace", Path: "active", Value: json.RawMessage(`"False"`)},
+			{Op: "add", Path: "name.givenName", Value: json.RawMessage(`"John"`)},
+			{Op: "add", Path: "emails", Value: json.RawMessage(`[{"value":"home@b.c","type":"home"}]`)},
+		})
+		So(e, ShouldBeNil)
+		So(u.IsActive(), ShouldBeFalse)
+		So(u.Name.GivenName, ShouldEqual, "John")
+		So(u.Emails, ShouldHaveLength, 2)
+
+		e = ApplyPatch(u, []PatchOperation{{Op: "remove", Path: `emails[type eq "home"]`}})
+		So(e, ShouldBeNil)
+		So(u.Emails, ShouldHaveLength, 1)
+
+		e = ApplyPatch(u, []PatchOperation{{Op: "remove"}})
+		So(e, ShouldNotBeNil)
+	})
+}
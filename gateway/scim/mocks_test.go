@@ -0,0 +1,253 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package scim
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/micro/go-micro/client"
+	"github.com/pborman/uuid"
+
+	"github.com/pydio/cells/common/proto/idm"
+	service "github.com/pydio/cells/common/service/proto"
+)
+
+// userClientMock is an in-memory implementation of idm.UserServiceClient.
+type userClientMock struct {
+	users []*idm.User
+}
+
+type searchStreamMock struct {
+	results []*idm.User
+}
+
+func (s *searchStreamMock) SendMsg(interface{}) error { return nil }
+func (s *searchStreamMock) RecvMsg(interface{}) error { return nil }
+func (s *searchStreamMock) Close() error              { return nil }
+func (s *searchStreamMock) Recv() (*idm.SearchUserResponse, error) {
+	if len(s.results) == 0 {
+		return nil, io.EOF
+	}
+	u := s.results[0]
+	s.results = s.results[1:]
+	return &idm.SearchUserResponse{User: u}, nil
+}
+
+func (m *userClientMock) CreateUser(ctx context.Context, in *idm.CreateUserRequest, opts ...client.CallOption) (*idm.CreateUserResponse, error) {
+	u := proto.Clone(in.User).(*idm.User)
+	u.GroupPath = safePath(u.GroupPath)
+	u.Password = ""
+	var roles []*idm.Role
+	for _, r := range u.Roles {
+		if !r.UserRole && !r.GroupRole {
+			roles = append(roles, r)
+		}
+	}
+	u.Roles = roles
+	if u.Uuid == "" {
+		u.Uuid = uuid.New()
+	}
+	for i, existing := range m.users {
+		if existing.Uuid == u.Uuid {
+			m.users[i] = u
+			return &idm.CreateUserResponse{User: m.withGroupRoles(u)}, nil
+		}
+	}
+	m.users = append(m.users, u)
+	return &idm.CreateUserResponse{User: m.withGroupRoles(u)}, nil
+}
+
+func (m *userClientMock) DeleteUser(ctx context.Context, in *idm.DeleteUserRequest, opts ...client.CallOption) (*idm.DeleteUserResponse, error) {
+	var kept []*idm.User
+	var count int64
+	for _, u := range m.users {
+		if matchQuery(u, in.Query) {
+			count++
+		} else {
+			kept = append(kept, u)
+		}
+	}
+	m.users = kept
+	return &idm.DeleteUserResponse{RowsDeleted: count}, nil
+}
+
+func (m *userClientMock) BindUser(ctx context.Context, in *idm.BindUserRequest, opts ...client.CallOption) (*idm.BindUserResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *userClientMock) CountUser(ctx context.Context, in *idm.SearchUserRequest, opts ...client.CallOption) (*idm.CountUserResponse, error) {
+	var count int32
+	for _, u := range m.users {
+		if matchQuery(u, in.Query) {
+			count++
+		}
+	}
+	return &idm.CountUserResponse{Count: count}, nil
+}
+
+func (m *userClientMock) SearchUser(ctx context.Context, in *idm.SearchUserRequest, opts ...client.CallOption) (idm.UserService_SearchUserClient, error) {
+	var results []*idm.User
+	for _, u := range m.users {
+		if matchQuery(u, in.Query) {
+			results = append(results, m.withGroupRoles(proto.Clone(u).(*idm.User)))
+		}
+	}
+	offset, limit := int(in.Query.GetOffset()), int(in.Query.GetLimit())
+	if offset > len(results) {
+		offset = len(results)
+	}
+	results = results[offset:]
+	if limit > 0 && limit < len(results) {
+		results = results[:limit]
+	}
+	return &searchStreamMock{results: results}, nil
+}
+
+func (m *userClientMock) StreamUser(ctx context.Context, opts ...client.CallOption) (idm.UserService_StreamUserClient, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// withGroupRoles mimics the DAO that prepends the role of the parent group.
+func (m *userClientMock) withGroupRoles(u *idm.User) *idm.User {
+	if u.IsGroup {
+		return u
+	}
+	for _, g := range m.users {
+		if g.IsGroup && g.GroupPath == u.GroupPath {
+			u.Roles = append([]*idm.Role{{Uuid: g.Uuid, GroupRole: true}}, u.Roles...)
+		}
+	}
+	return u
+}
+
+func matchQuery(u *idm.User, q *service.Query) bool {
+	if q == nil || len(q.SubQueries) == 0 {
+		return true
+	}
+	for _, sub := range q.SubQueries {
+		matched := matchAny(u, sub)
+		if q.Operation == service.OperationType_OR && matched {
+			return true
+		}
+		if q.Operation != service.OperationType_OR && !matched {
+			return false
+		}
+	}
+	return q.Operation != service.OperationType_OR
+}
+
+func matchAny(u *idm.User, a *any.Any) bool {
+	single := &idm.UserSingleQuery{}
+	if ptypes.Is(a, single) {
+		ptypes.UnmarshalAny(a, single)
+		return matchSingle(u, single) != single.Not
+	}
+	sub := &service.Query{}
+	ptypes.UnmarshalAny(a, sub)
+	return matchQuery(u, sub)
+}
+
+func matchWildcard(pattern, value string) bool {
+	expr := "^" + strings.Replace(regexp.QuoteMeta(pattern), `\*`, ".*", -1) + "$"
+	return regexp.MustCompile("(?i)" + expr).MatchString(value)
+}
+
+func matchSingle(u *idm.User, q *idm.UserSingleQuery) bool {
+	if q.Uuid != "" && u.Uuid != q.Uuid {
+		return false
+	}
+	if q.Login != "" && (u.IsGroup || !matchWildcard(q.Login, u.Login)) {
+		return false
+	}
+	if q.NodeType == idm.NodeType_USER && u.IsGroup || q.NodeType == idm.NodeType_GROUP && !u.IsGroup {
+		return false
+	}
+	if q.FullPath != "" && (!u.IsGroup || u.GroupPath != safePath(q.FullPath)) {
+		return false
+	}
+	if q.GroupPath != "" {
+		parent := u.GroupPath
+		if u.IsGroup {
+			parent = safePath(parent[:strings.LastIndex(parent, "/")])
+		}
+		if parent != safePath(q.GroupPath) {
+			return false
+		}
+	}
+	if q.AttributeName != "" {
+		v, ok := u.Attributes[q.AttributeName]
+		if !ok || !q.AttributeAnyValue && !matchWildcard(q.AttributeValue, v) {
+			return false
+		}
+	}
+	if q.HasRole != "" {
+		found := false
+		for _, r := range u.Roles {
+			if matchWildcard(q.HasRole, r.Uuid) {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// roleClientMock records created and deleted roles.
+type roleClientMock struct {
+	roles map[string]*idm.Role
+}
+
+func (m *roleClientMock) CreateRole(ctx context.Context, in *idm.CreateRoleRequest, opts ...client.CallOption) (*idm.CreateRoleResponse, error) {
+	m.roles[in.Role.Uuid] = in.Role
+	return &idm.CreateRoleResponse{Role: in.Role}, nil
+}
+
+func (m *roleClientMock) DeleteRole(ctx context.Context, in *idm.DeleteRoleRequest, opts ...client.CallOption) (*idm.DeleteRoleResponse, error) {
+	var count int64
+	for _, sub := range in.Query.SubQueries {
+		q := &idm.RoleSingleQuery{}
+		ptypes.UnmarshalAny(sub, q)
+		for _, id := range q.Uuid {
+			if _, ok := m.roles[id]; ok {
+				delete(m.roles, id)
+				count++
+			}
+		}
+	}
+	return &idm.DeleteRoleResponse{RowsDeleted: count}, nil
+}
+
+func (m *roleClientMock) SearchRole(ctx context.Context, in *idm.SearchRoleRequest, opts ...client.CallOption) (idm.RoleService_SearchRoleClient, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *roleClientMock) StreamRole(ctx context.Context, opts ...client.CallOption) (idm.RoleService_StreamRoleClient, error) {
+	return nil, fmt.Errorf("not implemented")
+}
@@ -0,0 +1,281 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	valuePathRegexp = regexp.MustCompile(`^([^\[\]]+)\[\s*([^\s]+)\s+eq\s+"?([^"]*)"?\s*\](?:\.(.+))?$`)
+)
+
+// patchPath is a parsed PATCH path of the form attr[.sub] or attr[sub eq "value"][.sub].
+type patchPath struct {
+	attr        string
+	sub         string
+	filterAttr  string
+	filterValue string
+	hasFilter   bool
+}
+
+func parsePatchPath(path string) (*patchPath, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return nil, nil
+	}
+	// Strip optional schema prefix
+	if strings.HasPrefix(strings.ToLower(path), "urn:") {
+		if idx := strings.LastIndex(path, ":"); idx > -1 {
+			path = path[idx+1:]
+		}
+	}
+	if matches := valuePathRegexp.FindStringSubmatch(path); len(matches) > 0 {
+		return &patchPath{
+			attr:        matches[1],
+			filterAttr:  matches[2],
+			filterValue: matches[3],
+			sub:         matches[4],
+			hasFilter:   true,
+		}, nil
+	}
+	if strings.ContainsAny(path, "[]") {
+		return nil, fmt.Errorf("unsupported path %s", path)
+	}
+	pp := &patchPath{attr: path}
+	if parts := strings.SplitN(path, ".", 2); len(parts) == 2 {
+		pp.attr = parts[0]
+		pp.sub = parts[1]
+	}
+	return pp, nil
+}
+
+// ApplyPatch applies a list of PATCH operations on a resource. The resource is passed
+// as a pointer to a User or a Group and is modified in place.
+func ApplyPatch(resource interface{}, operations []PatchOperation) error {
+	data, e := json.Marshal(resource)
+	if e != nil {
+		return e
+	}
+	doc := map[string]interface{}{}
+	if e := json.Unmarshal(data, &doc); e != nil {
+		return e
+	}
+	for _, op := range operations {
+		if e := applyOperation(doc, op); e != nil {
+			return e
+		}
+	}
+	// Some providers send booleans as strings
+	if k := findKey(doc, "active"); doc[k] != nil {
+		if s, ok := doc[k].(string); ok {
+			doc[k] = strings.EqualFold(s, "true")
+		}
+	}
+	if data, e = json.Marshal(doc); e != nil {
+		return e
+	}
+	return json.Unmarshal(data, resource)
+}
+
+func applyOperation(doc map[string]interface{}, op PatchOperation) error {
+	pp, e := parsePatchPath(op.Path)
+	if e != nil {
+		return e
+	}
+	var value interface{}
+	if len(op.Value) > 0 {
+		if e := json.Unmarshal(op.Value, &value); e != nil {
+			return e
+		}
+	}
+	switch strings.ToLower(op.Op) {
+	case "add", "replace":
+		replace := strings.EqualFold(op.Op, "replace")
+		if pp == nil {
+			obj, ok := value.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("operation %s without path requires an object value", op.Op)
+			}
+			for k, v := range obj {
+				kp, e := parsePatchPath(k)
+				if e != nil {
+					return e
+				}
+				if e := setValue(doc, kp, v, replace); e != nil {
+					return e
+				}
+			}
+			return nil
+		}
+		return setValue(doc, pp, value, replace)
+	case "remove":
+		if pp == nil {
+			return fmt.Errorf("remove operation requires a path")
+		}
+		return removeValue(doc, pp, value)
+	}
+	return fmt.Errorf("unsupported operation %s", op.Op)
+}
+
+// findKey returns the actual key in map matching name case-insensitively.
+func findKey(m map[string]interface{}, name string) string {
+	for k := range m {
+		if strings.EqualFold(k, name) {
+			return k
+		}
+	}
+	return name
+}
+
+func matchesFilter(item interface{}, pp *patchPath) bool {
+	obj, ok := item.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	v, ok := obj[findKey(obj, pp.filterAttr)]
+	if !ok {
+		return false
+	}
+	return strings.EqualFold(fmt.Sprintf("%v", v), pp.filterValue)
+}
+
+func setValue(doc map[string]interface{}, pp *patchPath, value interface{}, replace bool) error {
+	key := findKey(doc, pp.attr)
+	if pp.hasFilter {
+		items, _ := doc[key].([]interface{})
+		found := false
+		for i, item := range items {
+			if !matchesFilter(item, pp) {
+				continue
+			}
+			found = true
+			if pp.sub == "" {
+				items[i] = value
+			} else if obj, ok := item.(map[string]interface{}); ok {
+				obj[findKey(obj, pp.sub)] = value
+			}
+		}
+		if !found {
+			return fmt.Errorf("no value matches path %s", pp.attr)
+		}
+		return nil
+	}
+	if pp.sub != "" {
+		obj, ok := doc[key].(map[string]interface{})
+		if !ok {
+			obj = map[string]interface{}{}
+			doc[key] = obj
+		}
+		obj[findKey(obj, pp.sub)] = value
+		return nil
+	}
+	existing, isArray := doc[key].([]interface{})
+	if replace || !isArray {
+		if _, valueIsArray := value.([]interface{}); isArray && !valueIsArray {
+			value = []interface{}{value}
+		}
+		doc[key] = value
+		return nil
+	}
+	// Add to multi-valued attribute, skipping duplicates
+	var values []interface{}
+	if arr, ok := value.([]interface{}); ok {
+		values = arr
+	} else {
+		values = []interface{}{value}
+	}
+	for _, v := range values {
+		if !containsValue(existing, v) {
+			existing = append(existing, v)
+		}
+	}
+	doc[key] = existing
+	return nil
+}
+
+func removeValue(doc map[string]interface{}, pp *patchPath, value interface{}) error {
+	key := findKey(doc, pp.attr)
+	if pp.hasFilter {
+		items, _ := doc[key].([]interface{})
+		var kept []interface{}
+		for _, item := range items {
+			if !matchesFilter(item, pp) {
+				kept = append(kept, item)
+				continue
+			}
+			if pp.sub != "" {
+				if obj, ok := item.(map[string]interface{}); ok {
+					delete(obj, findKey(obj, pp.sub))
+				}
+				kept = append(kept, item)
+			}
+		}
+		doc[key] = kept
+		return nil
+	}
+	if pp.sub != "" {
+		if obj, ok := doc[key].(map[string]interface{}); ok {
+			delete(obj, findKey(obj, pp.sub))
+		}
+		return nil
+	}
+	// Some providers send the values to remove in the body instead of a filter
+	if items, ok := doc[key].([]interface{}); ok && value != nil {
+		var toRemove []interface{}
+		if arr, ok := value.([]interface{}); ok {
+			toRemove = arr
+		} else {
+			toRemove = []interface{}{value}
+		}
+		var kept []interface{}
+		for _, item := range items {
+			if !containsValue(toRemove, item) {
+				kept = append(kept, item)
+			}
+		}
+		doc[key] = kept
+		return nil
+	}
+	delete(doc, key)
+	return nil
+}
+
+// containsValue compares multi-valued items on their "value" key, or directly if they are scalars.
+func containsValue(items []interface{}, v interface{}) bool {
+	ref := itemValue(v)
+	for _, item := range items {
+		if itemValue(item) == ref {
+			return true
+		}
+	}
+	return false
+}
+
+func itemValue(v interface{}) string {
+	if obj, ok := v.(map[string]interface{}); ok {
+		return fmt.Sprintf("%v", obj[findKey(obj, "value")])
+	}
+	return fmt.Sprintf("%v", v)
+}
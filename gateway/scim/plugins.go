@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package scim exposes users and groups through the SCIM 2.0 provisioning protocol (RFC 7643 / 7644).
+package scim
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pydio/cells/common"
+	config2 "github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/service"
+	"github.com/pydio/cells/common/service/context"
+	"github.com/pydio/cells/common/service/defaults"
+)
+
+func init() {
+	service.NewService(
+		service.Name(common.SERVICE_REST_NAMESPACE_+common.SERVICE_GATEWAY_SCIM),
+		service.Tag(common.SERVICE_TAG_GATEWAY),
+		service.Description("SCIM 2.0 provisioning gateway to users service"),
+		service.Dependency(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_USER, []string{}),
+		service.Dependency(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_ROLE, []string{}),
+		service.WithGeneric(func(ctx context.Context, cancel context.CancelFunc) (service.Runner, service.Checker, service.Stopper, error) {
+			config := servicecontext.GetConfig(ctx)
+			port := config.Int("port", 5015)
+
+			handler := NewHandler(
+				idm.NewUserServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_USER, defaults.NewClient()),
+				idm.NewRoleServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_ROLE, defaults.NewClient()),
+				config.String("token"),
+			)
+			if u := config2.Get("defaults", "url").String(""); u != "" {
+				handler.BaseURL = strings.TrimRight(u, "/") + handler.BaseURL
+			}
+			if handler.Token == "" {
+				log.Logger(ctx).Warn("No bearer token is configured for SCIM gateway, all requests will be rejected")
+			}
+
+			// Only reachable through the proxy
+			addr := fmt.Sprintf("%s:%d", config2.GetServicesHost(), port)
+			log.Logger(ctx).Debug(fmt.Sprintf("Starting SCIM Server on %s", addr))
+
+			return service.RunnerFunc(func() error {
+					return http.ListenAndServe(addr, handler)
+				}), service.CheckerFunc(func() error {
+					return nil
+				}), service.StopperFunc(func() error {
+					return nil
+				}), nil
+		}),
+	)
+}
@@ -0,0 +1,301 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package scim
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/gosimple/slug"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/proto/idm"
+)
+
+const (
+	SchemaUser         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaListResponse = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp      = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError        = "urn:ietf:params:scim:api:messages:2.0:Error"
+	SchemaSPConfig     = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	SchemaResourceType = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+
+	// Attribute names used to store SCIM-specific values on idm.User
+	AttributeExternalId  = "scim:externalId"
+	AttributeGivenName   = "scim:givenName"
+	AttributeFamilyName  = "scim:familyName"
+	AttributeLocks       = idm.UserAttrLocks
+	AttributeDisplayName = "displayName"
+	AttributeEmail       = "email"
+	AttributeProfile     = "profile"
+
+	// LockLogout is the lock value that prevents a user from logging in.
+	LockLogout = idm.UserLockLogout
+)
+
+// Meta describes a SCIM resource metadata block.
+type Meta struct {
+	ResourceType string `json:"resourceType"`
+	Location     string `json:"location,omitempty"`
+}
+
+// Name is the complex "name" attribute of a SCIM User.
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// MultiValue is a generic SCIM multi-valued attribute item (emails, roles, groups, members).
+type MultiValue struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// User is the SCIM representation of a non-group idm.User.
+type User struct {
+	Schemas     []string     `json:"schemas"`
+	Id          string       `json:"id,omitempty"`
+	ExternalId  string       `json:"externalId,omitempty"`
+	UserName    string       `json:"userName"`
+	Name        *Name        `json:"name,omitempty"`
+	DisplayName string       `json:"displayName,omitempty"`
+	Password    string       `json:"password,omitempty"`
+	Active      *bool        `json:"active,omitempty"`
+	Emails      []MultiValue `json:"emails,omitempty"`
+	Roles       []MultiValue `json:"roles,omitempty"`
+	Groups      []MultiValue `json:"groups,omitempty"`
+	Meta        *Meta        `json:"meta,omitempty"`
+}
+
+// Group is the SCIM representation of a group idm.User.
+type Group struct {
+	Schemas     []string     `json:"schemas"`
+	Id          string       `json:"id,omitempty"`
+	ExternalId  string       `json:"externalId,omitempty"`
+	DisplayName string       `json:"displayName"`
+	Members     []MultiValue `json:"members,omitempty"`
+	Meta        *Meta        `json:"meta,omitempty"`
+}
+
+// ListResponse wraps paginated results.
+type ListResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int32         `json:"totalResults"`
+	StartIndex   int32         `json:"startIndex"`
+	ItemsPerPage int32         `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+// PatchOperation is a single operation of a PatchOp request.
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// PatchRequest is the body of a PATCH request.
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// IsActive returns false only if active was explicitly set to false.
+func (u *User) IsActive() bool {
+	return u.Active == nil || *u.Active
+}
+
+// PrimaryEmail returns the primary email or the first one.
+func (u *User) PrimaryEmail() string {
+	for _, e := range u.Emails {
+		if e.Primary {
+			return e.Value
+		}
+	}
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+	return ""
+}
+
+// userToScim converts an idm.User into its SCIM representation.
+func userToScim(u *idm.User, baseUrl string, groups ...*idm.User) *User {
+	att := u.Attributes
+	if att == nil {
+		att = map[string]string{}
+	}
+	active := !u.IsLockedOut()
+	out := &User{
+		Schemas:     []string{SchemaUser},
+		Id:          u.Uuid,
+		ExternalId:  att[AttributeExternalId],
+		UserName:    u.Login,
+		DisplayName: att[AttributeDisplayName],
+		Active:      &active,
+		Meta: &Meta{
+			ResourceType: "User",
+			Location:     baseUrl + "/Users/" + u.Uuid,
+		},
+	}
+	if given, family := att[AttributeGivenName], att[AttributeFamilyName]; given != "" || family != "" || out.DisplayName != "" {
+		out.Name = &Name{
+			Formatted:  out.DisplayName,
+			GivenName:  given,
+			FamilyName: family,
+		}
+	}
+	if email := att[AttributeEmail]; email != "" {
+		out.Emails = []MultiValue{{Value: email, Type: "work", Primary: true}}
+	}
+	for _, r := range u.Roles {
+		if r.UserRole || r.GroupRole || len(r.AutoApplies) > 0 {
+			continue
+		}
+		out.Roles = append(out.Roles, MultiValue{Value: r.Uuid, Display: r.Label})
+	}
+	for _, g := range groups {
+		if p := safePath(u.GroupPath); p != "/" && groupFullPath(g) == p {
+			out.Groups = append(out.Groups, MultiValue{
+				Value:   g.Uuid,
+				Display: groupDisplayName(g),
+				Ref:     baseUrl + "/Groups/" + g.Uuid,
+			})
+		}
+	}
+	return out
+}
+
+// applyScimToUser updates an idm.User with the values of a SCIM User. Existing values
+// that are not handled by SCIM (profile, group roles, etc) are preserved.
+func applyScimToUser(in *User, u *idm.User) {
+	if u.Attributes == nil {
+		u.Attributes = map[string]string{}
+	}
+	u.IsGroup = false
+	u.Login = in.UserName
+	u.Password = in.Password
+	setOrDelete(u.Attributes, AttributeExternalId, in.ExternalId)
+	displayName := in.DisplayName
+	if in.Name != nil {
+		setOrDelete(u.Attributes, AttributeGivenName, in.Name.GivenName)
+		setOrDelete(u.Attributes, AttributeFamilyName, in.Name.FamilyName)
+		if displayName == "" {
+			displayName = in.Name.Formatted
+		}
+		if displayName == "" {
+			displayName = strings.TrimSpace(in.Name.GivenName + " " + in.Name.FamilyName)
+		}
+	} else {
+		delete(u.Attributes, AttributeGivenName)
+		delete(u.Attributes, AttributeFamilyName)
+	}
+	setOrDelete(u.Attributes, AttributeDisplayName, displayName)
+	setOrDelete(u.Attributes, AttributeEmail, in.PrimaryEmail())
+	if _, ok := u.Attributes[AttributeProfile]; !ok {
+		u.Attributes[AttributeProfile] = common.PYDIO_PROFILE_STANDARD
+	}
+	u.SetLockedOut(!in.IsActive())
+
+	// Replace admin-assigned roles but keep group and user roles. Auto-applied
+	// roles are dropped as they are computed at read time.
+	var roles []*idm.Role
+	for _, r := range u.Roles {
+		if r.UserRole || r.GroupRole {
+			roles = append(roles, r)
+		}
+	}
+	for _, r := range in.Roles {
+		if r.Value != "" {
+			roles = append(roles, &idm.Role{Uuid: r.Value, Label: r.Display})
+		}
+	}
+	u.Roles = roles
+}
+
+// groupToScim converts a group idm.User and its members into a SCIM Group.
+func groupToScim(g *idm.User, baseUrl string, members []*idm.User) *Group {
+	out := &Group{
+		Schemas:     []string{SchemaGroup},
+		Id:          g.Uuid,
+		DisplayName: g.GroupLabel,
+		Meta: &Meta{
+			ResourceType: "Group",
+			Location:     baseUrl + "/Groups/" + g.Uuid,
+		},
+	}
+	if g.Attributes != nil {
+		out.ExternalId = g.Attributes[AttributeExternalId]
+		if d := g.Attributes[AttributeDisplayName]; d != "" {
+			out.DisplayName = d
+		}
+	}
+	for _, m := range members {
+		out.Members = append(out.Members, MultiValue{
+			Value:   m.Uuid,
+			Display: m.Login,
+			Ref:     baseUrl + "/Users/" + m.Uuid,
+		})
+	}
+	return out
+}
+
+// applyScimToGroup updates a group idm.User with the values of a SCIM Group. The group
+// label is only set at creation time as it defines the group path.
+func applyScimToGroup(in *Group, g *idm.User) {
+	if g.Attributes == nil {
+		g.Attributes = map[string]string{}
+	}
+	g.IsGroup = true
+	if g.GroupLabel == "" {
+		g.GroupLabel = slug.Make(in.DisplayName)
+		g.GroupPath = "/" + g.GroupLabel
+	}
+	setOrDelete(g.Attributes, AttributeDisplayName, in.DisplayName)
+	setOrDelete(g.Attributes, AttributeExternalId, in.ExternalId)
+}
+
+func groupDisplayName(g *idm.User) string {
+	if g.Attributes != nil && g.Attributes[AttributeDisplayName] != "" {
+		return g.Attributes[AttributeDisplayName]
+	}
+	return g.GroupLabel
+}
+
+// groupFullPath computes the path that members of this group have as GroupPath.
+func groupFullPath(g *idm.User) string {
+	return safePath(g.GroupPath)
+}
+
+func safePath(p string) string {
+	return "/" + strings.Trim(p, "/")
+}
+
+func setOrDelete(m map[string]string, key, value string) {
+	if value == "" {
+		delete(m, key)
+	} else {
+		m[key] = value
+	}
+}
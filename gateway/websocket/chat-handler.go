@@ -99,6 +99,7 @@ func (c *ChatHandler) InitHandlers(serviceCtx context.Context) {
 					return
 				}
 				UpdateSessionFromClaims(session, claims, c.Pool)
+				AuditImpersonatedSession(session, "SUBSCRIBE")
 				return
 
 			case MsgUnsubscribe:
@@ -122,6 +123,8 @@ func (c *ChatHandler) InitHandlers(serviceCtx context.Context) {
 				userName = userData.(string)
 			}
 
+			AuditImpersonatedSession(session, "CHAT "+chatMsg.Type.String())
+
 			switch chatMsg.Type {
 
 			case chat.WsMessageType_JOIN:
@@ -159,8 +162,12 @@ func (c *ChatHandler) InitHandlers(serviceCtx context.Context) {
 					break
 				}
 				chatClient := c.getChatClient()
-				// List existing Messages
-				stream, e2 := chatClient.ListMessages(ctx, &chat.ListMessagesRequest{RoomUuid: foundRoom.Uuid})
+				// List existing Messages, or only the replies of a thread
+				listRequest := &chat.ListMessagesRequest{RoomUuid: foundRoom.Uuid}
+				if chatMsg.Message != nil {
+					listRequest.ThreadUuid = chatMsg.Message.ThreadUuid
+				}
+				stream, e2 := chatClient.ListMessages(ctx, listRequest)
 				if e2 == nil {
 					defer stream.Close()
 					for {
@@ -200,6 +207,36 @@ func (c *ChatHandler) InitHandlers(serviceCtx context.Context) {
 					}
 				}
 
+			case chat.WsMessageType_EDIT_MSG:
+
+				log.Logger(serviceCtx).Debug("Edit", zap.Any("msg", chatMsg))
+				message := chatMsg.Message
+				if message == nil {
+					break
+				}
+				// Author is checked against the stored message by the chat service
+				message.Author = userName
+				_, e := c.getChatClient().EditMessage(ctx, &chat.EditMessageRequest{Message: message})
+				if e != nil {
+					log.Logger(ctx).Error("Error while editing message", zap.Any("msg", message), zap.Error(e))
+				}
+
+			case chat.WsMessageType_REACT:
+
+				log.Logger(serviceCtx).Debug("React", zap.Any("msg", chatMsg))
+				if chatMsg.Message == nil || userName == "" {
+					break
+				}
+				_, e := c.getChatClient().ReactToMessage(ctx, &chat.ReactToMessageRequest{
+					RoomUuid:    chatMsg.Message.RoomUuid,
+					MessageUuid: chatMsg.Message.Uuid,
+					Emoji:       chatMsg.Emoji,
+					User:        userName,
+				})
+				if e != nil {
+					log.Logger(ctx).Error("Error while reacting to message", zap.Any("msg", chatMsg.Message), zap.Error(e))
+				}
+
 			}
 
 		} else {
@@ -326,13 +363,24 @@ func (c *ChatHandler) BroadcastChatMessage(ctx context.Context, msg *chat.ChatEv
 
 	if msg.Message != nil {
 
-		if msg.Details == "DELETE" {
+		switch msg.Details {
+		case "DELETE":
 			wsMessage := &chat.WebSocketMessage{
 				Type:    chat.WsMessageType_DELETE_MSG,
 				Message: msg.Message,
 			}
 			marshaller.Marshal(buff, wsMessage)
-		} else {
+		case "EDIT":
+			marshaller.Marshal(buff, &chat.WebSocketMessage{
+				Type:    chat.WsMessageType_EDIT_MSG,
+				Message: msg.Message,
+			})
+		case "REACT":
+			marshaller.Marshal(buff, &chat.WebSocketMessage{
+				Type:    chat.WsMessageType_REACT,
+				Message: msg.Message,
+			})
+		default:
 			marshaller.Marshal(buff, msg.Message)
 		}
 
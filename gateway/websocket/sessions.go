@@ -27,6 +27,7 @@ import (
 	"go.uber.org/zap"
 	"gopkg.in/olahol/melody.v1"
 
+	"github.com/pydio/cells/common/auth"
 	"github.com/pydio/cells/common/auth/claim"
 	"github.com/pydio/cells/common/log"
 	"github.com/pydio/cells/common/utils"
@@ -37,6 +38,7 @@ const SessionRolesKey = "roles"
 const SessionWorkspacesKey = "workspaces"
 const SessionUsernameKey = "user"
 const SessionProfileKey = "profile"
+const SessionClaimsKey = "claims"
 
 func UpdateSessionFromClaims(session *melody.Session, claims claim.Claims, pool *views.ClientsPool) {
 
@@ -64,6 +66,7 @@ func UpdateSessionFromClaims(session *melody.Session, claims claim.Claims, pool
 		session.Set(SessionWorkspacesKey, workspaces)
 		session.Set(SessionUsernameKey, claims.Name)
 		session.Set(SessionProfileKey, claims.Profile)
+		session.Set(SessionClaimsKey, claims)
 	} else {
 		log.Logger(ctx).Error("Error while setting workspaces in session", zap.Error(err))
 		ClearSession(session)
@@ -77,5 +80,22 @@ func ClearSession(session *melody.Session) {
 	session.Set(SessionWorkspacesKey, nil)
 	session.Set(SessionUsernameKey, nil)
 	session.Set(SessionProfileKey, nil)
+	session.Set(SessionClaimsKey, nil)
+
+}
+
+// AuditImpersonatedSession records an action performed through the websocket during an admin "login as" session,
+// as done for REST requests.
+func AuditImpersonatedSession(session *melody.Session, action string) {
+
+	value, ok := session.Get(SessionClaimsKey)
+	if !ok || value == nil {
+		return
+	}
+	var p string
+	if session.Request != nil {
+		p = session.Request.URL.Path
+	}
+	auth.AuditImpersonatedRequest(context.WithValue(context.Background(), claim.ContextKey, value.(claim.Claims)), action, p)
 
 }
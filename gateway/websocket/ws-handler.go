@@ -93,6 +93,7 @@ func (w *WebsocketHandler) InitHandlers(serviceCtx context.Context) {
 				return
 			}
 			UpdateSessionFromClaims(session, claims, w.EventRouter.GetClientsPool())
+			AuditImpersonatedSession(session, "SUBSCRIBE")
 
 		case MsgUnsubscribe:
 
@@ -31,6 +31,7 @@ import (
 	"strconv"
 
 	"github.com/gorilla/mux"
+	"github.com/micro/go-micro/errors"
 	"go.uber.org/zap"
 
 	"github.com/pydio/cells/common"
@@ -118,7 +119,10 @@ func uploadStream(w http.ResponseWriter, r *http.Request) {
 	})
 	if err != nil {
 		log.Logger(r.Context()).Error("cannot put object", zap.Int64("already written data Length", written), zap.Error(err))
-		if written == 0 {
+		if parsed := errors.Parse(err.Error()); written == 0 && parsed.Code >= 400 && parsed.Code < 500 {
+			// Upload refused by the views handlers (limits, policies, antivirus)
+			w.WriteHeader(int(parsed.Code))
+		} else if written == 0 {
 			w.WriteHeader(http.StatusForbidden)
 		} else {
 			w.WriteHeader(http.StatusInternalServerError)
@@ -42,6 +42,7 @@ func auth(inner http.Handler) http.Handler {
 			var claims claim.Claims
 			ctx, claims, err = jwtVerifier.Verify(ctx, bearer)
 			if err == nil && claims.Name != "" {
+				commonauth.AuditImpersonatedRequest(ctx, r.Method, r.URL.Path)
 				r = r.WithContext(ctx)
 				inner.ServeHTTP(w, r)
 				return
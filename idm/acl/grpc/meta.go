@@ -22,6 +22,7 @@ package grpc
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
@@ -30,6 +31,7 @@ import (
 	"github.com/pydio/cells/common/proto/tree"
 	"github.com/pydio/cells/common/service/context"
 	"github.com/pydio/cells/common/service/proto"
+	"github.com/pydio/cells/common/utils"
 	"github.com/pydio/cells/idm/acl"
 )
 
@@ -54,13 +56,17 @@ func (h *Handler) ReadNodeStream(ctx context.Context, stream tree.NodeProviderSt
 			NodeIDs: []string{node.Uuid},
 			Actions: []*idm.ACLAction{
 				{Name: "content_lock"},
+				{Name: utils.ACL_RETENTION.Name},
 			},
 		})
 		dao.Search(&service.Query{SubQueries: []*any.Any{q}}, acls)
 		for _, in := range *acls {
 			val, _ := in.(*idm.ACL)
-			node.SetMeta("content_lock", val.Action.Value)
-			break
+			if val.Action.Name == utils.ACL_RETENTION.Name {
+				node.SetMeta(utils.ACL_RETENTION.Name, json.RawMessage(val.Action.Value))
+			} else if node.GetStringMeta("content_lock") == "" {
+				node.SetMeta("content_lock", val.Action.Value)
+			}
 		}
 		stream.Send(&tree.ReadNodeResponse{Node: node})
 	}
@@ -21,19 +21,24 @@
 package rest
 
 import (
+	"fmt"
+
 	"github.com/emicklei/go-restful"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 
 	"github.com/pydio/cells/common"
 	"github.com/pydio/cells/common/log"
 	"github.com/pydio/cells/common/proto/idm"
 	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/retention"
 	service2 "github.com/pydio/cells/common/service"
 	"github.com/pydio/cells/common/service/defaults"
 	"github.com/pydio/cells/common/service/proto"
 	"github.com/pydio/cells/common/service/resources"
+	"github.com/pydio/cells/common/utils"
 )
 
 // Handler for the rest package
@@ -65,18 +70,50 @@ func (a *Handler) PutAcl(req *restful.Request, rsp *restful.Response) {
 	log.Logger(req.Request.Context()).Debug("Received ACL.Put API request", zap.Any("inputACL", inputACL))
 	if er := a.WriteAllowed(ctx, &inputACL); er != nil {
 		service2.RestError403(req, rsp, er)
+		return
+	}
+	previousRule, er := a.RetentionAllowed(ctx, &inputACL, false)
+	if er != nil {
+		service2.RestErrorDetect(req, rsp, er)
+		return
 	}
 
 	aclClient := idm.NewACLServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_ACL, defaults.NewClient())
+	var previousValue string
+	if inputACL.Action.Name == utils.ACL_RETENTION.Name {
+		// Store a normalized value, the previous rule is replaced once the new one is stored
+		rule, _ := retention.ParseRule(&inputACL)
+		inputACL.Action = rule.ACL().Action
+		if previousRule != nil {
+			previousValue = previousRule.ACL().Action.Value
+		}
+	}
 	response, er := aclClient.CreateACL(req.Request.Context(), &idm.CreateACLRequest{
 		ACL: &inputACL,
 	})
 	if er != nil {
 		rsp.WriteError(500, er)
-	} else {
-		a := response.ACL
-		rsp.WriteEntity(a)
+		return
+	}
+	if previousValue != "" && previousValue != inputACL.Action.Value {
+		if e := deleteRetentionRule(ctx, aclClient, inputACL.NodeID, previousValue); e != nil {
+			// Do not leave two rules on the node: drop the new one, the previous rule still applies
+			if e2 := deleteRetentionRule(ctx, aclClient, inputACL.NodeID, inputACL.Action.Value); e2 != nil {
+				log.Logger(ctx).Error("Cannot remove retention rule after a failed replacement", zap.Error(e2))
+			}
+			service2.RestError500(req, rsp, e)
+			return
+		}
+	}
+	auditFields := []zapcore.Field{
+		log.GetAuditId(common.AUDIT_ACL_STORE),
+		log.GetAuditContext(common.AUDIT_CONTEXT_NEW_ACL, response.ACL),
 	}
+	if previousRule != nil {
+		auditFields = append(auditFields, log.GetAuditContext(common.AUDIT_CONTEXT_OLD_ACL, previousRule.ACL()))
+	}
+	log.Auditer(ctx).Info(fmt.Sprintf("Stored ACL %s on node %s", inputACL.Action.Name, inputACL.NodeID), auditFields...)
+	rsp.WriteEntity(response.ACL)
 
 }
 
@@ -94,6 +131,11 @@ func (a *Handler) DeleteAcl(req *restful.Request, rsp *restful.Response) {
 	log.Logger(req.Request.Context()).Debug("Received ACL.Delete API request", zap.Any("inputACL", inputACL))
 	if er := a.WriteAllowed(ctx, &inputACL); er != nil {
 		service2.RestError403(req, rsp, er)
+		return
+	}
+	if _, er := a.RetentionAllowed(ctx, &inputACL, true); er != nil {
+		service2.RestErrorDetect(req, rsp, er)
+		return
 	}
 
 	q := &idm.ACLSingleQuery{}
@@ -122,6 +164,11 @@ func (a *Handler) DeleteAcl(req *restful.Request, rsp *restful.Response) {
 	if err != nil {
 		rsp.WriteError(500, err)
 	} else {
+		log.Auditer(ctx).Info(
+			fmt.Sprintf("Deleted %d ACL(s)", response.RowsDeleted),
+			log.GetAuditId(common.AUDIT_ACL_DELETE),
+			log.GetAuditContext(common.AUDIT_CONTEXT_OLD_ACL, inputACL),
+		)
 		restResp := &rest.DeleteResponse{
 			Success: true,
 			NumRows: response.RowsDeleted,
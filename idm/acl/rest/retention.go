@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/micro/go-micro/errors"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/retention"
+	"github.com/pydio/cells/common/service/proto"
+	"github.com/pydio/cells/common/utils"
+)
+
+// RetentionAllowed checks that an ACL put or delete does not weaken an active retention rule. Retention
+// rules can only be managed by admins or by users having the legal hold role. It returns the rule currently
+// attached to the node, if any, so that it can be replaced.
+func (a *Handler) RetentionAllowed(ctx context.Context, acl *idm.ACL, deletion bool) (*retention.Rule, error) {
+
+	touchesRetention := acl.Action != nil && acl.Action.Name == utils.ACL_RETENTION.Name
+	if !deletion && !touchesRetention {
+		return nil, nil
+	}
+	if acl.NodeID == "" {
+		if touchesRetention {
+			return nil, errors.BadRequest(common.SERVICE_ACL, "Retention rules must be attached to a node")
+		}
+		return nil, nil
+	}
+	if touchesRetention && !retention.CanManageLegalHold(ctx) {
+		claims, ok := ctx.Value(claim.ContextKey).(claim.Claims)
+		if !ok || claims.Profile != common.PYDIO_PROFILE_ADMIN {
+			return nil, errors.Forbidden(common.SERVICE_ACL, "You are not allowed to manage retention rules")
+		}
+	}
+
+	rules, err := retention.NewResolver().Rules(ctx, acl.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	var previous *retention.Rule
+	if len(rules) > 0 {
+		previous = rules[0]
+	}
+	var next *retention.Rule
+	if !deletion {
+		next, err = retention.ParseRule(acl)
+		if err != nil {
+			return nil, errors.BadRequest(common.SERVICE_ACL, "Invalid retention rule: %s", err.Error())
+		}
+	} else if acl.Action != nil && !touchesRetention {
+		// Deleting another action does not affect the retention rule
+		return previous, nil
+	}
+	if err := retention.CheckUpdate(ctx, previous, next, time.Now()); err != nil {
+		return nil, err
+	}
+	return previous, nil
+}
+
+// deleteRetentionRule removes the retention ACL with the given value from a node, once it is replaced.
+func deleteRetentionRule(ctx context.Context, aclClient idm.ACLServiceClient, nodeId string, value string) error {
+	q, _ := ptypes.MarshalAny(&idm.ACLSingleQuery{NodeIDs: []string{nodeId}, Actions: []*idm.ACLAction{{Name: utils.ACL_RETENTION.Name, Value: value}}})
+	_, err := aclClient.DeleteACL(ctx, &idm.DeleteACLRequest{Query: &service.Query{SubQueries: []*any.Any{q}}})
+	return err
+}
@@ -22,14 +22,22 @@ package auth
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
 	"github.com/pydio/cells/common/log"
 	"github.com/pydio/cells/common/proto/auth"
 	"go.uber.org/zap"
 )
 
+var (
+	personalTokensBucket     = []byte("personal-tokens")
+	sessionsBucket           = []byte("sessions")
+	sessionRevocationsBucket = []byte("session-revocations")
+)
+
 type BoltStore struct {
 	db         *bolt.DB
 	bucketName []byte
@@ -53,6 +61,11 @@ func NewBoltStore(bucket string, filename string) (*BoltStore, error) {
 		if err != nil {
 			return err
 		}
+		for _, b := range [][]byte{personalTokensBucket, sessionsBucket, sessionRevocationsBucket} {
+			if _, err := tx.CreateBucketIfNotExists(b); err != nil {
+				return err
+			}
+		}
 		return nil
 	})
 
@@ -131,3 +144,190 @@ func (b *BoltStore) ListTokens(offset int, count int) (chan *auth.Token, error)
 
 	return tc, e
 }
+
+func (b *BoltStore) PutPersonalToken(hash string, t *auth.PersonalToken) error {
+	data, err := proto.Marshal(t)
+	if err != nil {
+		return err
+	}
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(personalTokensBucket).Put([]byte(hash), data)
+	})
+}
+
+// GetPersonalToken returns nil if no token is stored under this hash
+func (b *BoltStore) GetPersonalToken(hash string) (*auth.PersonalToken, error) {
+	var token *auth.PersonalToken
+	e := b.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(personalTokensBucket).Get([]byte(hash))
+		if data == nil {
+			return nil
+		}
+		token = &auth.PersonalToken{}
+		return proto.Unmarshal(data, token)
+	})
+	return token, e
+}
+
+// ListPersonalTokens returns tokens indexed by their hash, for all users if userUuid is empty
+func (b *BoltStore) ListPersonalTokens(userUuid string) (map[string]*auth.PersonalToken, error) {
+	tokens := make(map[string]*auth.PersonalToken)
+	e := b.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(personalTokensBucket).ForEach(func(k, v []byte) error {
+			token := &auth.PersonalToken{}
+			if err := proto.Unmarshal(v, token); err != nil {
+				return err
+			}
+			if userUuid == "" || token.UserUuid == userUuid {
+				tokens[string(k)] = token
+			}
+			return nil
+		})
+	})
+	return tokens, e
+}
+
+func (b *BoltStore) DeletePersonalToken(hash string) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(personalTokensBucket).Delete([]byte(hash))
+	})
+}
+
+func (b *BoltStore) PutSession(s *auth.Session) error {
+	data, err := proto.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(sessionsBucket).Put([]byte(s.Uuid), data)
+	})
+}
+
+// TouchSessions updates the last seen date, user agent and remote address of many sessions in a single
+// transaction. Sessions that are not registered yet are stored as is.
+func (b *BoltStore) TouchSessions(sessions []*auth.Session) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(sessionsBucket)
+		for _, s := range sessions {
+			session := s
+			if data := bucket.Get([]byte(s.Uuid)); data != nil {
+				session = &auth.Session{}
+				if err := proto.Unmarshal(data, session); err != nil {
+					return err
+				}
+				if s.LastSeenAt > session.LastSeenAt {
+					session.LastSeenAt = s.LastSeenAt
+				}
+				if s.UserAgent != "" {
+					session.UserAgent = s.UserAgent
+				}
+				if s.RemoteAddress != "" {
+					session.RemoteAddress = s.RemoteAddress
+				}
+			}
+			data, err := proto.Marshal(session)
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put([]byte(s.Uuid), data); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetSession returns nil if the session is not registered
+func (b *BoltStore) GetSession(uuid string) (*auth.Session, error) {
+	var session *auth.Session
+	e := b.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(sessionsBucket).Get([]byte(uuid))
+		if data == nil {
+			return nil
+		}
+		session = &auth.Session{}
+		return proto.Unmarshal(data, session)
+	})
+	return session, e
+}
+
+// ListSessions returns the sessions of a user, or of all users if userUuid is empty
+func (b *BoltStore) ListSessions(userUuid string) (sessions []*auth.Session, e error) {
+	e = b.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(sessionsBucket).ForEach(func(k, v []byte) error {
+			session := &auth.Session{}
+			if err := proto.Unmarshal(v, session); err != nil {
+				return err
+			}
+			if userUuid == "" || session.UserUuid == userUuid {
+				sessions = append(sessions, session)
+			}
+			return nil
+		})
+	})
+	return
+}
+
+func (b *BoltStore) DeleteSession(uuid string) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(sessionsBucket).Delete([]byte(uuid))
+	})
+}
+
+func (b *BoltStore) PutRevocation(key string, revokedAt int32) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(sessionRevocationsBucket).Put([]byte(key), []byte(strconv.Itoa(int(revokedAt))))
+	})
+}
+
+// GetRevocation returns 0 if nothing was revoked for this key
+func (b *BoltStore) GetRevocation(key string) (revokedAt int32, e error) {
+	e = b.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(sessionRevocationsBucket).Get([]byte(key))
+		if data == nil {
+			return nil
+		}
+		i, err := strconv.Atoi(string(data))
+		revokedAt = int32(i)
+		return err
+	})
+	return
+}
+
+// ListRevocations returns all revocation dates, indexed by session or user Uuid
+func (b *BoltStore) ListRevocations() (revocations map[string]int32, e error) {
+	revocations = make(map[string]int32)
+	e = b.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(sessionRevocationsBucket).ForEach(func(k, v []byte) error {
+			i, err := strconv.Atoi(string(v))
+			if err != nil {
+				return err
+			}
+			revocations[string(k)] = int32(i)
+			return nil
+		})
+	})
+	return
+}
+
+// PruneRevocations removes revocations older than the given date, returning the number of deleted entries
+func (b *BoltStore) PruneRevocations(before int32) (count int, e error) {
+	e = b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(sessionRevocationsBucket)
+		var keys [][]byte
+		bucket.ForEach(func(k, v []byte) error {
+			if i, err := strconv.Atoi(string(v)); err == nil && int32(i) < before {
+				keys = append(keys, append([]byte{}, k...))
+			}
+			return nil
+		})
+		for _, k := range keys {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		count = len(keys)
+		return nil
+	})
+	return
+}
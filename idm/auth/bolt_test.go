@@ -111,3 +111,121 @@ func TestBoltStore(t *testing.T) {
 
 	defer os.Remove(dbFile)
 }
+
+func TestBoltStorePersonalTokens(t *testing.T) {
+
+	file := os.TempDir() + "/bolt-test-pat.db"
+	defer os.Remove(file)
+
+	s, e := NewBoltStore("tokens", file)
+	if e != nil {
+		t.Fatal(e)
+	}
+	defer s.Close()
+
+	convey.Convey("Test Put and Get personal tokens", t, func() {
+		err := s.PutPersonalToken("h1", &auth.PersonalToken{Uuid: "p1", Label: "sync", UserUuid: "u1"})
+		convey.So(err, convey.ShouldBeNil)
+		err = s.PutPersonalToken("h2", &auth.PersonalToken{Uuid: "p2", Label: "script", UserUuid: "u2", Scopes: []string{"readonly"}})
+		convey.So(err, convey.ShouldBeNil)
+
+		token, err := s.GetPersonalToken("h2")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(token, convey.ShouldNotBeNil)
+		convey.So(token.Label, convey.ShouldEqual, "script")
+		convey.So(token.Scopes, convey.ShouldResemble, []string{"readonly"})
+
+		token, err = s.GetPersonalToken("unknown")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(token, convey.ShouldBeNil)
+	})
+
+	convey.Convey("Test List and Delete personal tokens", t, func() {
+		all, err := s.ListPersonalTokens("")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(all, convey.ShouldHaveLength, 2)
+
+		mine, err := s.ListPersonalTokens("u1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(mine, convey.ShouldHaveLength, 1)
+		convey.So(mine["h1"].Uuid, convey.ShouldEqual, "p1")
+
+		convey.So(s.DeletePersonalToken("h1"), convey.ShouldBeNil)
+		mine, _ = s.ListPersonalTokens("u1")
+		convey.So(mine, convey.ShouldBeEmpty)
+	})
+}
+
+func TestBoltStoreSessions(t *testing.T) {
+
+	file := os.TempDir() + "/bolt-test-sessions.db"
+	defer os.Remove(file)
+
+	s, e := NewBoltStore("tokens", file)
+	if e != nil {
+		t.Fatal(e)
+	}
+	defer s.Close()
+
+	convey.Convey("Test Put, Get and List sessions", t, func() {
+		convey.So(s.PutSession(&auth.Session{Uuid: "s1", UserUuid: "u1", ClientApp: "cells-front"}), convey.ShouldBeNil)
+		convey.So(s.PutSession(&auth.Session{Uuid: "s2", UserUuid: "u1", ClientApp: "cells-sync"}), convey.ShouldBeNil)
+		convey.So(s.PutSession(&auth.Session{Uuid: "s3", UserUuid: "u2", ClientApp: "cells-front"}), convey.ShouldBeNil)
+
+		session, err := s.GetSession("s2")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(session, convey.ShouldNotBeNil)
+		convey.So(session.ClientApp, convey.ShouldEqual, "cells-sync")
+
+		session, err = s.GetSession("unknown")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(session, convey.ShouldBeNil)
+
+		all, _ := s.ListSessions("")
+		convey.So(all, convey.ShouldHaveLength, 3)
+		mine, _ := s.ListSessions("u1")
+		convey.So(mine, convey.ShouldHaveLength, 2)
+
+		convey.So(s.DeleteSession("s1"), convey.ShouldBeNil)
+		mine, _ = s.ListSessions("u1")
+		convey.So(mine, convey.ShouldHaveLength, 1)
+	})
+
+	convey.Convey("Test touching sessions in batch", t, func() {
+		convey.So(s.PutSession(&auth.Session{Uuid: "s4", UserUuid: "u3", RefreshId: "refresh", LastSeenAt: 100}), convey.ShouldBeNil)
+		convey.So(s.TouchSessions([]*auth.Session{
+			{Uuid: "s4", UserUuid: "u3", LastSeenAt: 200, UserAgent: "agent"},
+			{Uuid: "s5", UserUuid: "u3", LastSeenAt: 300, CreatedAt: 250},
+		}), convey.ShouldBeNil)
+
+		session, _ := s.GetSession("s4")
+		convey.So(session.LastSeenAt, convey.ShouldEqual, 200)
+		convey.So(session.UserAgent, convey.ShouldEqual, "agent")
+		convey.So(session.RefreshId, convey.ShouldEqual, "refresh")
+		session, _ = s.GetSession("s5")
+		convey.So(session, convey.ShouldNotBeNil)
+		convey.So(session.CreatedAt, convey.ShouldEqual, 250)
+	})
+
+	convey.Convey("Test session revocations", t, func() {
+		revokedAt, err := s.GetRevocation("s2")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(revokedAt, convey.ShouldEqual, 0)
+
+		convey.So(s.PutRevocation("s2", 1000), convey.ShouldBeNil)
+		convey.So(s.PutRevocation("u2", 2000), convey.ShouldBeNil)
+		revokedAt, _ = s.GetRevocation("s2")
+		convey.So(revokedAt, convey.ShouldEqual, 1000)
+
+		count, err := s.PruneRevocations(1500)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(count, convey.ShouldEqual, 1)
+		revokedAt, _ = s.GetRevocation("s2")
+		convey.So(revokedAt, convey.ShouldEqual, 0)
+		revokedAt, _ = s.GetRevocation("u2")
+		convey.So(revokedAt, convey.ShouldEqual, 2000)
+		all, err := s.ListRevocations()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(all, convey.ShouldResemble, map[string]int32{"u2": 2000})
+	})
+}
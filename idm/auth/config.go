@@ -34,8 +34,22 @@ import (
 	"github.com/coreos/dex/storage/kubernetes"
 	"github.com/coreos/dex/storage/memory"
 	"github.com/coreos/dex/storage/sql"
+
+	"github.com/pydio/cells/common/auth/dex"
 )
 
+func init() {
+	// Expose the connectors registered by Pydio packages (pydio-oidc, pydio-saml...)
+	// as first-class dex connector types.
+	for name, provider := range dex.PydioConnectorsConfig {
+		if _, ok := server.ConnectorsConfig[name]; ok {
+			continue
+		}
+		configProvider := provider
+		server.ConnectorsConfig[name] = func() server.ConnectorConfig { return configProvider() }
+	}
+}
+
 // Config is the config format for the main application.
 type Config struct {
 	Issuer  string  `json:"issuer"`
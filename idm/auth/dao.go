@@ -29,3 +29,25 @@ type DAO interface {
 	DeleteToken(t string) error
 	ListTokens(offset int, count int) (chan *auth.Token, error)
 }
+
+// PersonalTokenDAO stores personal access tokens, indexed by the hash of their value
+type PersonalTokenDAO interface {
+	PutPersonalToken(hash string, t *auth.PersonalToken) error
+	GetPersonalToken(hash string) (*auth.PersonalToken, error)
+	ListPersonalTokens(userUuid string) (map[string]*auth.PersonalToken, error)
+	DeletePersonalToken(hash string) error
+}
+
+// SessionDAO is the registry of sessions opened by users, indexed by session Uuid.
+// Revocations record the date before which tokens of a session, or of all sessions of a user, are rejected.
+type SessionDAO interface {
+	PutSession(s *auth.Session) error
+	TouchSessions(sessions []*auth.Session) error
+	GetSession(uuid string) (*auth.Session, error)
+	ListSessions(userUuid string) ([]*auth.Session, error)
+	DeleteSession(uuid string) error
+	PutRevocation(key string, revokedAt int32) error
+	GetRevocation(key string) (int32, error)
+	ListRevocations() (map[string]int32, error)
+	PruneRevocations(before int32) (int, error)
+}
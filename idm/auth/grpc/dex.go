@@ -46,7 +46,7 @@ import (
 	"github.com/pydio/cells/idm/auth"
 )
 
-func serve(c auth.Config, pydioSrvContext context.Context, pydioLogger *zap.Logger) error {
+func serve(c auth.Config, pydioSrvContext context.Context, pydioLogger *zap.Logger, h *TokenRevokerHandler) error {
 
 	logger, err := newLogger(c.Logger.Level, c.Logger.Format, pydioLogger)
 	if err != nil {
@@ -165,6 +165,10 @@ func serve(c auth.Config, pydioSrvContext context.Context, pydioLogger *zap.Logg
 
 	s = storage.WithStaticConnectors(s, storageConnectors)
 
+	// Feed the sessions registry and let the handler delete refresh tokens when revoking sessions
+	s = auth.WithSessionRegistry(s, h.sessions)
+	h.setRefreshStorage(s)
+
 	if len(c.OAuth2.ResponseTypes) > 0 {
 		logger.Infof("config response types accepted: %s", c.OAuth2.ResponseTypes)
 	}
@@ -24,16 +24,35 @@ import (
 	"context"
 	"encoding/json"
 	"path"
+	"sort"
+	"sync"
 	"time"
 
+	"github.com/coreos/dex/storage"
+	"github.com/micro/go-micro/errors"
+	"github.com/micro/go-micro/metadata"
+	"github.com/pborman/uuid"
+	"go.uber.org/zap"
+
 	"github.com/pydio/cells/common"
 	"github.com/pydio/cells/common/auth/claim"
 	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/log"
 	proto "github.com/pydio/cells/common/proto/auth"
+	"github.com/pydio/cells/common/service/context"
 	"github.com/pydio/cells/idm/auth"
 )
 
-func NewAuthTokenRevokerHandler() (proto.AuthTokenRevokerHandler, error) {
+const (
+	// lastUsedPrecision avoids writing to the store each time a personal token or a session is used
+	lastUsedPrecision = 60
+	// sessionRevocationTTL is how long session revocations are kept: tokens issued before are expired by then
+	sessionRevocationTTL = 24 * 60 * 60
+	// sessionsFlushInterval is the delay between two writes of the sessions last seen dates
+	sessionsFlushInterval = lastUsedPrecision * time.Second
+)
+
+func NewAuthTokenRevokerHandler() (*TokenRevokerHandler, error) {
 	h := new(TokenRevokerHandler)
 	dataDir, e := config.ServiceDataDir(common.SERVICE_GRPC_NAMESPACE_ + common.SERVICE_AUTH)
 	if e != nil {
@@ -45,11 +64,28 @@ func NewAuthTokenRevokerHandler() (proto.AuthTokenRevokerHandler, error) {
 	}
 
 	h.dao = dao
+	h.tokens = dao
+	h.sessions = dao
+	if h.revocations, err = dao.ListRevocations(); err != nil {
+		return nil, err
+	}
+	h.touched = make(map[string]*proto.Session)
 	return h, nil
 }
 
 type TokenRevokerHandler struct {
-	dao auth.DAO
+	dao      auth.DAO
+	tokens   auth.PersonalTokenDAO
+	sessions auth.SessionDAO
+	// refreshTokens is the dex storage, set from the dex goroutine once the server is started
+	refreshLock   sync.RWMutex
+	refreshTokens storage.Storage
+
+	// revocations mirrors the session revocations of the store, they are checked on each request.
+	// touched holds the sessions seen since the last flush, they are written in batch.
+	sessionsLock sync.Mutex
+	revocations  map[string]int32
+	touched      map[string]*proto.Session
 }
 
 func (h *TokenRevokerHandler) MatchInvalid(ctx context.Context, in *proto.MatchInvalidTokenRequest, out *proto.MatchInvalidTokenResponse) error {
@@ -60,6 +96,10 @@ func (h *TokenRevokerHandler) MatchInvalid(ctx context.Context, in *proto.MatchI
 		out.State = proto.State_REVOKED
 	}
 	out.RevocationInfo = info
+	if out.State == proto.State_NO_MATCH && !proto.IsPersonalToken(in.Token) && h.sessionRevoked(ctx, in.Token) {
+		out.State = proto.State_REVOKED
+		out.RevocationInfo = "session revoked"
+	}
 	return nil
 }
 
@@ -95,5 +135,271 @@ func (h *TokenRevokerHandler) PruneTokens(ctx context.Context, in *proto.PruneTo
 			done = true
 		}
 	}
+
+	// Forget old session revocations and sessions that cannot be refreshed anymore
+	expired := int32(time.Now().Unix()) - sessionRevocationTTL
+	if e := h.pruneRevocations(expired); e != nil {
+		return e
+	}
+	h.flushSessions(ctx)
+	sessions, e := h.sessions.ListSessions("")
+	if e != nil {
+		return e
+	}
+	for _, s := range sessions {
+		if s.RefreshId == "" && s.LastSeenAt < expired {
+			if e := h.sessions.DeleteSession(s.Uuid); e == nil {
+				out.Tokens = append(out.Tokens, s.Uuid)
+			}
+		}
+	}
+
+	// Remove expired personal tokens as well
+	personalTokens, e := h.tokens.ListPersonalTokens("")
+	if e != nil {
+		return e
+	}
+	now := time.Now()
+	for hash, t := range personalTokens {
+		if t.IsExpired(now) {
+			if e := h.tokens.DeletePersonalToken(hash); e == nil {
+				out.Tokens = append(out.Tokens, t.Uuid)
+			}
+		}
+	}
+	return nil
+}
+
+// GeneratePersonalToken creates a random value for the token and only stores its hash
+func (h *TokenRevokerHandler) GeneratePersonalToken(ctx context.Context, in *proto.GeneratePersonalTokenRequest, out *proto.GeneratePersonalTokenResponse) error {
+	t := in.GetToken()
+	if t == nil || t.UserUuid == "" || t.UserLogin == "" {
+		return errors.BadRequest(common.SERVICE_AUTH, "personal token must be attached to a user")
+	}
+	if t.IsExpired(time.Now()) {
+		return errors.BadRequest(common.SERVICE_AUTH, "personal token expiration date must be in the future")
+	}
+	value, err := proto.NewPersonalTokenValue()
+	if err != nil {
+		return err
+	}
+	t.Uuid = uuid.New()
+	t.CreatedAt = int32(time.Now().Unix())
+	t.LastUsedAt = 0
+	if err := h.tokens.PutPersonalToken(proto.HashPersonalToken(value), t); err != nil {
+		return err
+	}
+	out.Token = t
+	out.AccessToken = value
+	return nil
+}
+
+// VerifyPersonalToken returns an Unauthorized error if the token is unknown or expired
+func (h *TokenRevokerHandler) VerifyPersonalToken(ctx context.Context, in *proto.VerifyPersonalTokenRequest, out *proto.VerifyPersonalTokenResponse) error {
+	hash := proto.HashPersonalToken(in.AccessToken)
+	t, err := h.tokens.GetPersonalToken(hash)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if t == nil || t.IsExpired(now) {
+		return errors.Unauthorized(common.SERVICE_AUTH, "invalid or expired personal token")
+	}
+	if now.Unix()-int64(t.LastUsedAt) > lastUsedPrecision {
+		t.LastUsedAt = int32(now.Unix())
+		if e := h.tokens.PutPersonalToken(hash, t); e != nil {
+			log.Logger(ctx).Error("cannot update personal token usage", zap.Error(e))
+		}
+	}
+	out.Token = t
+	return nil
+}
+
+// ListPersonalTokens sends tokens sorted by creation date
+func (h *TokenRevokerHandler) ListPersonalTokens(ctx context.Context, in *proto.ListPersonalTokensRequest, out *proto.ListPersonalTokensResponse) error {
+	tokens, err := h.tokens.ListPersonalTokens(in.UserUuid)
+	if err != nil {
+		return err
+	}
+	for _, t := range tokens {
+		out.Tokens = append(out.Tokens, t)
+	}
+	sort.Slice(out.Tokens, func(i, j int) bool {
+		return out.Tokens[i].CreatedAt < out.Tokens[j].CreatedAt
+	})
+	return nil
+}
+
+func (h *TokenRevokerHandler) RevokePersonalToken(ctx context.Context, in *proto.RevokePersonalTokenRequest, out *proto.RevokePersonalTokenResponse) error {
+	tokens, err := h.tokens.ListPersonalTokens(in.UserUuid)
+	if err != nil {
+		return err
+	}
+	for hash, t := range tokens {
+		if t.Uuid == in.Uuid {
+			if err := h.tokens.DeletePersonalToken(hash); err != nil {
+				return err
+			}
+			out.Success = true
+			return nil
+		}
+	}
+	return errors.NotFound(common.SERVICE_AUTH, "cannot find personal token %s", in.Uuid)
+}
+
+// sessionRevoked checks an ID token against the session revocations. If it is still valid, the last seen date,
+// user agent and remote address of the session are recorded in memory, to be flushed by flushSessions.
+func (h *TokenRevokerHandler) sessionRevoked(ctx context.Context, rawIDToken string) bool {
+	session, issuedAt, err := auth.SessionFromToken(rawIDToken)
+	if err != nil {
+		log.Logger(ctx).Debug("cannot find session for token", zap.Error(err))
+		return false
+	}
+	h.sessionsLock.Lock()
+	defer h.sessionsLock.Unlock()
+	for _, key := range []string{session.Uuid, session.UserUuid} {
+		if revokedAt := h.revocations[key]; revokedAt > 0 && issuedAt <= revokedAt {
+			return true
+		}
+	}
+
+	// Tokens issued without refresh token are registered on first use
+	session.CreatedAt = issuedAt
+	session.LastSeenAt = int32(time.Now().Unix())
+	if md, ok := metadata.FromContext(ctx); ok {
+		session.UserAgent = md[servicecontext.HttpMetaUserAgent]
+		session.RemoteAddress = md[servicecontext.HttpMetaRemoteAddress]
+	}
+	h.touched[session.Uuid] = session
+	return false
+}
+
+// flushSessions writes the sessions seen since the last flush in a single transaction.
+func (h *TokenRevokerHandler) flushSessions(ctx context.Context) {
+	h.sessionsLock.Lock()
+	defer h.sessionsLock.Unlock()
+	if len(h.touched) == 0 {
+		return
+	}
+	var sessions []*proto.Session
+	for _, s := range h.touched {
+		if h.revocations[s.Uuid] >= s.CreatedAt || h.revocations[s.UserUuid] >= s.CreatedAt {
+			continue
+		}
+		sessions = append(sessions, s)
+	}
+	if e := h.sessions.TouchSessions(sessions); e != nil {
+		log.Logger(ctx).Error("cannot update sessions", zap.Error(e))
+		return
+	}
+	h.touched = make(map[string]*proto.Session)
+}
+
+// FlushSessionsPeriodically flushes the sessions last seen dates until the context is done.
+func (h *TokenRevokerHandler) FlushSessionsPeriodically(ctx context.Context) {
+	ticker := time.NewTicker(sessionsFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			h.flushSessions(ctx)
+		case <-ctx.Done():
+			h.flushSessions(ctx)
+			return
+		}
+	}
+}
+
+// putRevocation stores a revocation and forgets pending updates of the revoked session.
+func (h *TokenRevokerHandler) putRevocation(key string, revokedAt int32) error {
+	h.sessionsLock.Lock()
+	defer h.sessionsLock.Unlock()
+	if err := h.sessions.PutRevocation(key, revokedAt); err != nil {
+		return err
+	}
+	h.revocations[key] = revokedAt
+	delete(h.touched, key)
+	return nil
+}
+
+func (h *TokenRevokerHandler) pruneRevocations(before int32) error {
+	h.sessionsLock.Lock()
+	defer h.sessionsLock.Unlock()
+	if _, e := h.sessions.PruneRevocations(before); e != nil {
+		return e
+	}
+	for key, revokedAt := range h.revocations {
+		if revokedAt < before {
+			delete(h.revocations, key)
+		}
+	}
+	return nil
+}
+
+// ListSessions sends sessions sorted by last seen date, most recent first
+func (h *TokenRevokerHandler) ListSessions(ctx context.Context, in *proto.ListSessionsRequest, out *proto.ListSessionsResponse) error {
+	h.flushSessions(ctx)
+	sessions, err := h.sessions.ListSessions(in.UserUuid)
+	if err != nil {
+		return err
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenAt > sessions[j].LastSeenAt
+	})
+	out.Sessions = sessions
+	return nil
+}
+
+// setRefreshStorage registers the dex storage used to delete refresh tokens.
+func (h *TokenRevokerHandler) setRefreshStorage(s storage.Storage) {
+	h.refreshLock.Lock()
+	defer h.refreshLock.Unlock()
+	h.refreshTokens = s
+}
+
+// refreshStorage returns the dex storage, or nil if dex is not started yet.
+func (h *TokenRevokerHandler) refreshStorage() storage.Storage {
+	h.refreshLock.RLock()
+	defer h.refreshLock.RUnlock()
+	return h.refreshTokens
+}
+
+// RevokeSessions deletes the refresh tokens of the sessions and records revocations, so that ID tokens
+// already issued for these sessions are rejected as well.
+func (h *TokenRevokerHandler) RevokeSessions(ctx context.Context, in *proto.RevokeSessionsRequest, out *proto.RevokeSessionsResponse) error {
+	if in.Uuid == "" && in.UserUuid == "" {
+		return errors.BadRequest(common.SERVICE_AUTH, "please provide a session or a user")
+	}
+	sessions, err := h.sessions.ListSessions(in.UserUuid)
+	if err != nil {
+		return err
+	}
+	now := int32(time.Now().Unix())
+	if in.Uuid == "" && in.ExceptUuid == "" {
+		// Also covers tokens that were issued but never seen by the gateways
+		if err := h.putRevocation(in.UserUuid, now); err != nil {
+			return err
+		}
+	}
+	for _, s := range sessions {
+		if (in.Uuid != "" && s.Uuid != in.Uuid) || s.Uuid == in.ExceptUuid {
+			continue
+		}
+		if err := h.putRevocation(s.Uuid, now); err != nil {
+			return err
+		}
+		if rt := h.refreshStorage(); s.RefreshId != "" && rt != nil {
+			if err := rt.DeleteRefresh(s.RefreshId); err != nil && err != storage.ErrNotFound {
+				log.Logger(ctx).Error("cannot delete refresh token", zap.Error(err))
+			}
+		}
+		if err := h.sessions.DeleteSession(s.Uuid); err != nil {
+			return err
+		}
+		out.Sessions = append(out.Sessions, s.Uuid)
+	}
+	if in.Uuid != "" && len(out.Sessions) == 0 {
+		return errors.NotFound(common.SERVICE_AUTH, "cannot find session %s", in.Uuid)
+	}
 	return nil
 }
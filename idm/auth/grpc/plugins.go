@@ -56,6 +56,7 @@ func init() {
 				return err
 			}
 			proto.RegisterAuthTokenRevokerHandler(m.Options().Server, tokenRevokerHandler)
+			go tokenRevokerHandler.FlushSessionsPeriodically(m.Options().Context)
 
 			m.Init(micro.AfterStart(func() error {
 				ctx := m.Options().Context
@@ -94,7 +95,7 @@ func init() {
 				}
 
 				go func() {
-					err := serve(c, ctx, log.Logger(ctx))
+					err := serve(c, ctx, log.Logger(ctx), tokenRevokerHandler)
 					if err != nil {
 						log.Logger(ctx).Error("ERROR STARTING DEX SERVER", zap.Error(err))
 					}
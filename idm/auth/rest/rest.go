@@ -23,14 +23,19 @@ package rest
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/emicklei/go-restful"
 	"github.com/micro/go-micro/errors"
 	"github.com/pborman/uuid"
+	"go.uber.org/zap"
 
 	"github.com/pydio/cells/common"
+	commonauth "github.com/pydio/cells/common/auth"
 	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/log"
 	"github.com/pydio/cells/common/proto/auth"
 	"github.com/pydio/cells/common/proto/docstore"
 	"github.com/pydio/cells/common/proto/idm"
@@ -42,6 +47,10 @@ import (
 	"github.com/pydio/cells/common/utils"
 )
 
+// defaultPersonalTokenMaxLifetime is the maximum validity of personal tokens, in seconds, unless overridden by the
+// personalTokens/maxLifetime key of this service configuration.
+const defaultPersonalTokenMaxLifetime = 365 * 24 * 60 * 60
+
 type TokenHandler struct{}
 
 // SwaggerTags list the names of the service tags declared in the swagger json implemented by this service
@@ -95,6 +104,340 @@ func (a *TokenHandler) Revoke(req *restful.Request, resp *restful.Response) {
 
 }
 
+// CreatePersonalToken generates a new personal access token for the current user. The clear value
+// is only sent back once, it cannot be retrieved later on.
+func (a *TokenHandler) CreatePersonalToken(req *restful.Request, resp *restful.Response) {
+
+	ctx := req.Request.Context()
+	claims, ok := ctx.Value(claim.ContextKey).(claim.Claims)
+	if !ok || !claims.Verified {
+		resp.WriteError(403, errors.Forbidden(common.SERVICE_AUTH, "invalid token"))
+		return
+	}
+	if claims.AuthSource == commonauth.PersonalTokenAuthSource || claims.Impersonator != "" {
+		resp.WriteError(403, errors.Forbidden(common.SERVICE_AUTH, "personal tokens cannot be used to create other tokens"))
+		return
+	}
+
+	var input rest.CreatePersonalTokenRequest
+	if e := req.ReadEntity(&input); e != nil {
+		service.RestError500(req, resp, errors.BadRequest(common.SERVICE_AUTH, "Cannot decode input request"))
+		return
+	}
+	if !time.Unix(int64(input.ExpiresAt), 0).After(time.Now()) {
+		service.RestError500(req, resp, errors.BadRequest(common.SERVICE_AUTH, "Please provide an expiration date in the future"))
+		return
+	}
+	maxLifetime := config.Get("services", common.SERVICE_REST_NAMESPACE_+common.SERVICE_AUTH, "personalTokens", "maxLifetime").Int(defaultPersonalTokenMaxLifetime)
+	if int64(input.ExpiresAt) > time.Now().Unix()+int64(maxLifetime) {
+		service.RestError500(req, resp, errors.BadRequest(common.SERVICE_AUTH, "Personal tokens cannot be valid for more than %d days", maxLifetime/(24*60*60)))
+		return
+	}
+	for _, s := range input.Scopes {
+		if s != claim.ScopeReadOnly && s != claim.ScopeNoAdmin && (!strings.HasPrefix(s, claim.ScopeWorkspacePrefix) || s == claim.ScopeWorkspacePrefix) {
+			service.RestError500(req, resp, errors.BadRequest(common.SERVICE_AUTH, "Unsupported scope %s", s))
+			return
+		}
+	}
+	userUuid, e := claims.DecodeUserUuid()
+	if e != nil {
+		service.RestError500(req, resp, e)
+		return
+	}
+
+	revokerClient := auth.NewAuthTokenRevokerClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_AUTH, defaults.NewClient())
+	response, e := revokerClient.GeneratePersonalToken(ctx, &auth.GeneratePersonalTokenRequest{
+		Token: &auth.PersonalToken{
+			Label:     input.Label,
+			UserUuid:  userUuid,
+			UserLogin: claims.Name,
+			Scopes:    input.Scopes,
+			ExpiresAt: input.ExpiresAt,
+		},
+	})
+	if e != nil {
+		service.RestError500(req, resp, e)
+		return
+	}
+
+	resp.WriteEntity(&rest.CreatePersonalTokenResponse{Token: response.Token, AccessToken: response.AccessToken})
+
+}
+
+// ListPersonalTokens lists the tokens of the current user. Admins can list the tokens of any user.
+func (a *TokenHandler) ListPersonalTokens(req *restful.Request, resp *restful.Response) {
+
+	ctx := req.Request.Context()
+	claims, ok := ctx.Value(claim.ContextKey).(claim.Claims)
+	if !ok || !claims.Verified {
+		resp.WriteError(403, errors.Forbidden(common.SERVICE_AUTH, "invalid token"))
+		return
+	}
+
+	userUuid, ok := targetUserUuid(req, resp, claims, req.QueryParameter("UserLogin"))
+	if !ok {
+		return
+	}
+
+	revokerClient := auth.NewAuthTokenRevokerClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_AUTH, defaults.NewClient())
+	response, e := revokerClient.ListPersonalTokens(ctx, &auth.ListPersonalTokensRequest{UserUuid: userUuid})
+	if e != nil {
+		service.RestError500(req, resp, e)
+		return
+	}
+
+	resp.WriteEntity(&rest.PersonalTokenCollection{Tokens: response.Tokens})
+
+}
+
+// RevokePersonalToken deletes a personal token. Users can only revoke their own tokens, unless they are admins.
+func (a *TokenHandler) RevokePersonalToken(req *restful.Request, resp *restful.Response) {
+
+	ctx := req.Request.Context()
+	claims, ok := ctx.Value(claim.ContextKey).(claim.Claims)
+	if !ok || !claims.Verified {
+		resp.WriteError(403, errors.Forbidden(common.SERVICE_AUTH, "invalid token"))
+		return
+	}
+
+	revokeRequest := &auth.RevokePersonalTokenRequest{Uuid: req.PathParameter("Uuid")}
+	if claims.Profile != common.PYDIO_PROFILE_ADMIN {
+		userUuid, e := claims.DecodeUserUuid()
+		if e != nil {
+			service.RestError500(req, resp, e)
+			return
+		}
+		revokeRequest.UserUuid = userUuid
+	}
+
+	revokerClient := auth.NewAuthTokenRevokerClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_AUTH, defaults.NewClient())
+	if _, e := revokerClient.RevokePersonalToken(ctx, revokeRequest); e != nil {
+		if errors.Parse(e.Error()).Code == 404 {
+			service.RestError404(req, resp, e)
+		} else {
+			service.RestError500(req, resp, e)
+		}
+		return
+	}
+
+	resp.WriteEntity(&rest.RevokeResponse{Success: true, Message: "Personal token successfully revoked"})
+
+}
+
+// targetUserUuid finds the user whose tokens or sessions are managed: the current user, or any user for admins.
+// It writes an error to the response if the user cannot be found or accessed.
+func targetUserUuid(req *restful.Request, resp *restful.Response, claims claim.Claims, login string) (string, bool) {
+	if login != "" && login != claims.Name {
+		if claims.Profile != common.PYDIO_PROFILE_ADMIN {
+			resp.WriteError(403, errors.Forbidden(common.SERVICE_AUTH, "only admins can manage other users"))
+			return "", false
+		}
+		u, e := utils.SearchUniqueUser(req.Request.Context(), login, "")
+		if e != nil {
+			service.RestError404(req, resp, e)
+			return "", false
+		}
+		return u.Uuid, true
+	}
+	userUuid, e := claims.DecodeUserUuid()
+	if e != nil {
+		service.RestError500(req, resp, e)
+		return "", false
+	}
+	return userUuid, true
+}
+
+// currentSessionUuid computes the uuid of the session that sent the request, if any
+func currentSessionUuid(claims claim.Claims) string {
+	subject, e := claims.DecodeSubject()
+	if e != nil {
+		return ""
+	}
+	return auth.SessionUuid(subject.UserId, subject.ConnId, claims.ClientApp)
+}
+
+// ListSessions lists the sessions of the current user, flagging the one that sent the request.
+// Admins can list the sessions of any user.
+func (a *TokenHandler) ListSessions(req *restful.Request, resp *restful.Response) {
+
+	ctx := req.Request.Context()
+	claims, ok := ctx.Value(claim.ContextKey).(claim.Claims)
+	if !ok || !claims.Verified {
+		resp.WriteError(403, errors.Forbidden(common.SERVICE_AUTH, "invalid token"))
+		return
+	}
+	userUuid, ok := targetUserUuid(req, resp, claims, req.QueryParameter("UserLogin"))
+	if !ok {
+		return
+	}
+
+	revokerClient := auth.NewAuthTokenRevokerClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_AUTH, defaults.NewClient())
+	response, e := revokerClient.ListSessions(ctx, &auth.ListSessionsRequest{UserUuid: userUuid})
+	if e != nil {
+		service.RestError500(req, resp, e)
+		return
+	}
+	current := currentSessionUuid(claims)
+	for _, s := range response.Sessions {
+		s.Current = s.Uuid == current
+	}
+
+	resp.WriteEntity(&rest.SessionCollection{Sessions: response.Sessions})
+
+}
+
+// RevokeSession logs out a single session. Users can only revoke their own sessions, unless they are admins.
+func (a *TokenHandler) RevokeSession(req *restful.Request, resp *restful.Response) {
+
+	ctx := req.Request.Context()
+	claims, ok := ctx.Value(claim.ContextKey).(claim.Claims)
+	if !ok || !claims.Verified {
+		resp.WriteError(403, errors.Forbidden(common.SERVICE_AUTH, "invalid token"))
+		return
+	}
+
+	revokeRequest := &auth.RevokeSessionsRequest{Uuid: req.PathParameter("Uuid")}
+	if claims.Profile != common.PYDIO_PROFILE_ADMIN {
+		userUuid, e := claims.DecodeUserUuid()
+		if e != nil {
+			service.RestError500(req, resp, e)
+			return
+		}
+		revokeRequest.UserUuid = userUuid
+	}
+
+	revokerClient := auth.NewAuthTokenRevokerClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_AUTH, defaults.NewClient())
+	if _, e := revokerClient.RevokeSessions(ctx, revokeRequest); e != nil {
+		if errors.Parse(e.Error()).Code == 404 {
+			service.RestError404(req, resp, e)
+		} else {
+			service.RestError500(req, resp, e)
+		}
+		return
+	}
+
+	resp.WriteEntity(&rest.RevokeResponse{Success: true, Message: "Session successfully revoked"})
+
+}
+
+// RevokeSessions logs out all sessions of a user. With KeepCurrent, the session sending the request is kept,
+// which is what clients should offer after a password change.
+func (a *TokenHandler) RevokeSessions(req *restful.Request, resp *restful.Response) {
+
+	ctx := req.Request.Context()
+	claims, ok := ctx.Value(claim.ContextKey).(claim.Claims)
+	if !ok || !claims.Verified {
+		resp.WriteError(403, errors.Forbidden(common.SERVICE_AUTH, "invalid token"))
+		return
+	}
+
+	var input rest.RevokeSessionsRequest
+	if e := req.ReadEntity(&input); e != nil {
+		service.RestError500(req, resp, errors.BadRequest(common.SERVICE_AUTH, "Cannot decode input request"))
+		return
+	}
+	userUuid, ok := targetUserUuid(req, resp, claims, input.UserLogin)
+	if !ok {
+		return
+	}
+	revokeRequest := &auth.RevokeSessionsRequest{UserUuid: userUuid}
+	if input.KeepCurrent {
+		revokeRequest.ExceptUuid = currentSessionUuid(claims)
+	}
+
+	revokerClient := auth.NewAuthTokenRevokerClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_AUTH, defaults.NewClient())
+	response, e := revokerClient.RevokeSessions(ctx, revokeRequest)
+	if e != nil {
+		service.RestError500(req, resp, e)
+		return
+	}
+
+	resp.WriteEntity(&rest.RevokeResponse{Success: true, Message: fmt.Sprintf("%d session(s) revoked", len(response.Sessions))})
+
+}
+
+const (
+	defaultImpersonationDuration = 30 * 60
+	maxImpersonationDuration     = 4 * 60 * 60
+)
+
+// Impersonate lets an admin log in as another user for a limited time, typically to debug permissions.
+// Sessions are read-only unless AllowWrite is set, and the reason is stored along with the token, so that
+// the user can later see who logged in as them and why.
+func (a *TokenHandler) Impersonate(req *restful.Request, resp *restful.Response) {
+
+	ctx := req.Request.Context()
+	claims, ok := ctx.Value(claim.ContextKey).(claim.Claims)
+	if !ok || !claims.Verified || claims.Profile != common.PYDIO_PROFILE_ADMIN {
+		resp.WriteError(403, errors.Forbidden(common.SERVICE_AUTH, "only admins can impersonate users"))
+		return
+	}
+	if claims.AuthSource == commonauth.PersonalTokenAuthSource || claims.Impersonator != "" {
+		resp.WriteError(403, errors.Forbidden(common.SERVICE_AUTH, "impersonation requires an interactive session"))
+		return
+	}
+
+	var input rest.ImpersonateRequest
+	if e := req.ReadEntity(&input); e != nil {
+		service.RestError500(req, resp, errors.BadRequest(common.SERVICE_AUTH, "Cannot decode input request"))
+		return
+	}
+	if input.UserLogin == "" || input.Reason == "" {
+		service.RestError500(req, resp, errors.BadRequest(common.SERVICE_AUTH, "Please provide a user login and a reason"))
+		return
+	}
+	if input.UserLogin == claims.Name {
+		service.RestError500(req, resp, errors.BadRequest(common.SERVICE_AUTH, "Cannot impersonate yourself"))
+		return
+	}
+	duration := input.Duration
+	if duration <= 0 {
+		duration = defaultImpersonationDuration
+	} else if duration > maxImpersonationDuration {
+		service.RestError500(req, resp, errors.BadRequest(common.SERVICE_AUTH, "Impersonation cannot last more than %d seconds", maxImpersonationDuration))
+		return
+	}
+	u, e := utils.SearchUniqueUser(ctx, input.UserLogin, "")
+	if e != nil {
+		service.RestError404(req, resp, e)
+		return
+	}
+
+	scopes := []string{claim.ScopeNoAdmin}
+	if !input.AllowWrite {
+		scopes = append(scopes, claim.ScopeReadOnly)
+	}
+	revokerClient := auth.NewAuthTokenRevokerClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_AUTH, defaults.NewClient())
+	response, e := revokerClient.GeneratePersonalToken(ctx, &auth.GeneratePersonalTokenRequest{
+		Token: &auth.PersonalToken{
+			Label:        fmt.Sprintf("Impersonation by %s", claims.Name),
+			UserUuid:     u.Uuid,
+			UserLogin:    u.Login,
+			Scopes:       scopes,
+			ExpiresAt:    int32(time.Now().Unix()) + duration,
+			Impersonator: claims.Name,
+			Reason:       input.Reason,
+		},
+	})
+	if e != nil {
+		service.RestError500(req, resp, e)
+		return
+	}
+
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("%s started an impersonation session as %s", claims.Name, u.Login),
+		log.GetAuditId(common.AUDIT_IMPERSONATE_START),
+		u.ZapUuid(),
+		log.GetAuditContext(common.AUDIT_CONTEXT_TARGET_USER, u.Login),
+		log.GetAuditContext("Reason", input.Reason),
+		log.GetAuditContext("AllowWrite", input.AllowWrite),
+		log.GetAuditContext("Duration", duration),
+	)
+
+	resp.WriteEntity(&rest.ImpersonateResponse{Token: response.Token, AccessToken: response.AccessToken})
+
+}
+
 type ResetToken struct {
 	UserLogin  string `json:"user_login"`
 	Expiration int32  `json:"expiration"`
@@ -219,6 +562,11 @@ func (a *TokenHandler) ResetPassword(req *restful.Request, resp *restful.Respons
 		service.RestError500(req, resp, fmt.Errorf("Error while trying to set new password!"))
 		return
 	}
+	// Whoever knew the previous password must not stay logged in
+	revokerClient := auth.NewAuthTokenRevokerClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_AUTH, defaults.NewClient())
+	if _, e := revokerClient.RevokeSessions(ctx, &auth.RevokeSessionsRequest{UserUuid: u.Uuid}); e != nil {
+		log.Logger(ctx).Error("cannot revoke sessions after password reset", zap.Error(e))
+	}
 
 	go func() {
 		// Send email
@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/coreos/dex/storage"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/auth"
+)
+
+// WithSessionRegistry wraps a dex storage so that refresh tokens created, used or deleted by dex
+// are reflected in the sessions registry.
+func WithSessionRegistry(s storage.Storage, sessions SessionDAO) storage.Storage {
+	return &registryStorage{Storage: s, sessions: sessions}
+}
+
+type registryStorage struct {
+	storage.Storage
+	sessions SessionDAO
+}
+
+// CreateRefresh registers a new session, or attaches the new refresh token to the existing one:
+// dex replaces the refresh token of a user on a given client each time this user logs in again.
+func (r *registryStorage) CreateRefresh(t storage.RefreshToken) error {
+	if err := r.Storage.CreateRefresh(t); err != nil {
+		return err
+	}
+	uuid := auth.SessionUuid(t.Claims.UserID, t.ConnectorID, t.ClientID)
+	session, err := r.sessions.GetSession(uuid)
+	if err != nil {
+		log.Logger(context.Background()).Error("cannot load session", zap.Error(err))
+		return nil
+	}
+	if session == nil {
+		session = &auth.Session{
+			Uuid:        uuid,
+			UserUuid:    t.Claims.UserID,
+			UserLogin:   t.Claims.Username,
+			ConnectorId: t.ConnectorID,
+			ClientApp:   t.ClientID,
+			CreatedAt:   int32(t.CreatedAt.Unix()),
+		}
+	}
+	session.RefreshId = t.ID
+	session.LastSeenAt = int32(t.LastUsed.Unix())
+	if err := r.sessions.PutSession(session); err != nil {
+		log.Logger(context.Background()).Error("cannot register session", zap.Error(err))
+	}
+	return nil
+}
+
+// UpdateRefreshToken is called by dex when a refresh token is used
+func (r *registryStorage) UpdateRefreshToken(id string, updater func(storage.RefreshToken) (storage.RefreshToken, error)) error {
+	var updated storage.RefreshToken
+	err := r.Storage.UpdateRefreshToken(id, func(old storage.RefreshToken) (storage.RefreshToken, error) {
+		t, e := updater(old)
+		updated = t
+		return t, e
+	})
+	if err != nil {
+		return err
+	}
+	uuid := auth.SessionUuid(updated.Claims.UserID, updated.ConnectorID, updated.ClientID)
+	if session, e := r.sessions.GetSession(uuid); e == nil && session != nil {
+		session.LastSeenAt = int32(updated.LastUsed.Unix())
+		if e := r.sessions.PutSession(session); e != nil {
+			log.Logger(context.Background()).Error("cannot update session", zap.Error(e))
+		}
+	}
+	return nil
+}
+
+// DeleteRefresh removes the session if the deleted token is still its current refresh token
+func (r *registryStorage) DeleteRefresh(id string) error {
+	if err := r.Storage.DeleteRefresh(id); err != nil {
+		return err
+	}
+	sessions, err := r.sessions.ListSessions("")
+	if err != nil {
+		log.Logger(context.Background()).Error("cannot list sessions", zap.Error(err))
+		return nil
+	}
+	for _, s := range sessions {
+		if s.RefreshId == id {
+			if e := r.sessions.DeleteSession(s.Uuid); e != nil {
+				log.Logger(context.Background()).Error("cannot delete session", zap.Error(e))
+			}
+		}
+	}
+	return nil
+}
+
+// SessionFromToken reads the claims of an ID token to find the session it belongs to. The token signature
+// must have been verified by the caller. It returns the session as it would be registered and the token issue date.
+func SessionFromToken(rawIDToken string) (*auth.Session, int32, error) {
+	parts := strings.Split(rawIDToken, ".")
+	if len(parts) != 3 {
+		return nil, 0, fmt.Errorf("malformed jwt, expected 3 parts got %d", len(parts))
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, 0, fmt.Errorf("malformed jwt payload: %v", err)
+	}
+	var claims struct {
+		Subject  string          `json:"sub"`
+		Audience json.RawMessage `json:"aud"`
+		IssuedAt int64           `json:"iat"`
+		Name     string          `json:"name"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, 0, fmt.Errorf("malformed jwt payload: %v", err)
+	}
+	// Audience is either a single string or a list of strings
+	var clientApp string
+	if err := json.Unmarshal(claims.Audience, &clientApp); err != nil {
+		var audiences []string
+		if err := json.Unmarshal(claims.Audience, &audiences); err != nil || len(audiences) == 0 {
+			return nil, 0, fmt.Errorf("cannot find audience in jwt")
+		}
+		clientApp = audiences[0]
+	}
+	subjectData, err := base64.RawURLEncoding.DecodeString(claims.Subject)
+	if err != nil {
+		return nil, 0, fmt.Errorf("malformed jwt subject: %v", err)
+	}
+	var subject claim.IDTokenSubject
+	if err := proto.Unmarshal(subjectData, &subject); err != nil {
+		return nil, 0, fmt.Errorf("malformed jwt subject: %v", err)
+	}
+
+	return &auth.Session{
+		Uuid:        auth.SessionUuid(subject.UserId, subject.ConnId, clientApp),
+		UserUuid:    subject.UserId,
+		UserLogin:   claims.Name,
+		ConnectorId: subject.ConnId,
+		ClientApp:   clientApp,
+	}, int32(claims.IssuedAt), nil
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/coreos/dex/storage"
+	"github.com/coreos/dex/storage/memory"
+	"github.com/golang/protobuf/proto"
+	"github.com/sirupsen/logrus"
+
+	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/proto/auth"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func testIDToken(userId, connId, aud string, iat int64) string {
+	sub, _ := proto.Marshal(&claim.IDTokenSubject{UserId: userId, ConnId: connId})
+	payload := fmt.Sprintf(`{"sub":"%s","aud":%s,"iat":%d,"name":"jenny"}`, base64.RawURLEncoding.EncodeToString(sub), aud, iat)
+	return "header." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature"
+}
+
+func TestSessionFromToken(t *testing.T) {
+
+	Convey("Find session from an ID token", t, func() {
+		session, iat, err := SessionFromToken(testIDToken("u1", "pydio", `"cells-front"`, 1520512338))
+		So(err, ShouldBeNil)
+		So(iat, ShouldEqual, 1520512338)
+		So(session.UserUuid, ShouldEqual, "u1")
+		So(session.UserLogin, ShouldEqual, "jenny")
+		So(session.ClientApp, ShouldEqual, "cells-front")
+		So(session.Uuid, ShouldEqual, auth.SessionUuid("u1", "pydio", "cells-front"))
+
+		multiple, _, err := SessionFromToken(testIDToken("u1", "pydio", `["cells-front","other"]`, 1520512338))
+		So(err, ShouldBeNil)
+		So(multiple.Uuid, ShouldEqual, session.Uuid)
+
+		other, _, _ := SessionFromToken(testIDToken("u1", "pydio", `"cells-sync"`, 1520512338))
+		So(other.Uuid, ShouldNotEqual, session.Uuid)
+	})
+
+	Convey("Reject malformed tokens", t, func() {
+		_, _, err := SessionFromToken("not-a-jwt")
+		So(err, ShouldNotBeNil)
+		_, _, err = SessionFromToken("header.%%%.signature")
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestSessionRegistryStorage(t *testing.T) {
+
+	file := os.TempDir() + "/bolt-test-registry.db"
+	defer os.Remove(file)
+	sessions, e := NewBoltStore("tokens", file)
+	if e != nil {
+		t.Fatal(e)
+	}
+	defer sessions.Close()
+
+	s := WithSessionRegistry(memory.New(logrus.New()), sessions)
+	now := time.Now()
+	refresh := func(id string) storage.RefreshToken {
+		return storage.RefreshToken{
+			ID:          id,
+			Token:       "token-" + id,
+			ClientID:    "cells-front",
+			ConnectorID: "pydio",
+			Claims:      storage.Claims{UserID: "u1", Username: "jenny"},
+			CreatedAt:   now,
+			LastUsed:    now,
+		}
+	}
+	uuid := auth.SessionUuid("u1", "pydio", "cells-front")
+
+	Convey("Refresh tokens issued by dex open sessions", t, func() {
+		So(s.CreateRefresh(refresh("r1")), ShouldBeNil)
+		session, err := sessions.GetSession(uuid)
+		So(err, ShouldBeNil)
+		So(session, ShouldNotBeNil)
+		So(session.UserLogin, ShouldEqual, "jenny")
+		So(session.RefreshId, ShouldEqual, "r1")
+	})
+
+	Convey("A new login replaces the refresh token of the session", t, func() {
+		So(s.CreateRefresh(refresh("r2")), ShouldBeNil)
+		So(s.DeleteRefresh("r1"), ShouldBeNil)
+		session, _ := sessions.GetSession(uuid)
+		So(session, ShouldNotBeNil)
+		So(session.RefreshId, ShouldEqual, "r2")
+	})
+
+	Convey("Using the refresh token updates the last seen date", t, func() {
+		later := now.Add(time.Hour)
+		err := s.UpdateRefreshToken("r2", func(old storage.RefreshToken) (storage.RefreshToken, error) {
+			old.LastUsed = later
+			return old, nil
+		})
+		So(err, ShouldBeNil)
+		session, _ := sessions.GetSession(uuid)
+		So(session.LastSeenAt, ShouldEqual, int32(later.Unix()))
+	})
+
+	Convey("Deleting the refresh token closes the session", t, func() {
+		So(s.DeleteRefresh("r2"), ShouldBeNil)
+		session, _ := sessions.GetSession(uuid)
+		So(session, ShouldBeNil)
+	})
+}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/pydio/cells/common"
 	"github.com/pydio/cells/common/auth"
+	"github.com/pydio/cells/common/auth/claim"
 	"github.com/pydio/cells/common/log"
 	"github.com/pydio/cells/common/proto/idm"
 	"github.com/pydio/cells/common/proto/rest"
@@ -74,6 +75,13 @@ func (h *GraphHandler) UserState(req *restful.Request, rsp *restful.Response) {
 		Workspaces:         []*idm.Workspace{},
 		WorkspacesAccesses: make(map[string]string),
 	}
+	if claims, ok := ctx.Value(claim.ContextKey).(claim.Claims); ok {
+		state.ReadOnly = claims.HasScope(claim.ScopeReadOnly)
+		if claims.Impersonator != "" {
+			state.Impersonator = claims.Impersonator
+			state.ImpersonationExpiresAt = int32(claims.Expiry.Unix())
+		}
+	}
 	accessListWsNodes := accessList.GetWorkspacesNodes()
 	state.WorkspacesAccesses = accessList.GetAccessibleWorkspaces(ctx)
 
@@ -54,6 +54,22 @@ var (
 					Actions:     []string{"PUT", "POST"},
 					Effect:      ladon.AllowAccess,
 				}),
+				LadonToProtoPolicy(&ladon.DefaultPolicy{
+					ID:          "activity-feeds-policy",
+					Description: "PolicyGroup.PublicAccess.Rule3",
+					Subjects:    []string{"profile:anon"},
+					Resources:   []string{"rest:/activity/feeds/<.+>"},
+					Actions:     []string{"GET"},
+					Effect:      ladon.AllowAccess,
+				}),
+				LadonToProtoPolicy(&ladon.DefaultPolicy{
+					ID:          "mailer-events-policy",
+					Description: "PolicyGroup.PublicAccess.Rule4",
+					Subjects:    []string{"profile:anon"},
+					Resources:   []string{"rest:/mailer/events/<.+>"},
+					Actions:     []string{"POST"},
+					Effect:      ladon.AllowAccess,
+				}),
 			},
 		},
 
@@ -153,6 +169,9 @@ var (
 						"rest:/search/nodes",
 						"rest:/share<.+>",
 						"rest:/activity<.+>",
+						"rest:/chat<.+>",
+						"rest:/auth/personal-tokens<.*>",
+						"rest:/auth/sessions<.*>",
 					},
 					Actions: []string{"GET", "POST", "DELETE", "PUT", "PATCH"},
 					Effect:  ladon.AllowAccess,
@@ -338,3 +357,44 @@ var (
 		},
 	}
 )
+
+// MergeDefaultResources compares stored policy groups with the defaults and adds the
+// resources that were introduced by newer versions to the default policies that are
+// still stored. Groups or policies removed by an administrator are not restored.
+// It returns the groups that were modified.
+func MergeDefaultResources(stored []*idm.PolicyGroup, defaults []*idm.PolicyGroup) (changed []*idm.PolicyGroup) {
+	defaultPolicies := make(map[string]*idm.Policy)
+	for _, group := range defaults {
+		for _, pol := range group.Policies {
+			defaultPolicies[pol.Id] = pol
+		}
+	}
+	for _, group := range stored {
+		modified := false
+		for _, pol := range group.Policies {
+			def, ok := defaultPolicies[pol.Id]
+			if !ok {
+				continue
+			}
+			for _, res := range def.Resources {
+				if !containsResource(pol.Resources, res) {
+					pol.Resources = append(pol.Resources, res)
+					modified = true
+				}
+			}
+		}
+		if modified {
+			changed = append(changed, group)
+		}
+	}
+	return
+}
+
+func containsResource(resources []string, resource string) bool {
+	for _, r := range resources {
+		if r == resource {
+			return true
+		}
+	}
+	return false
+}
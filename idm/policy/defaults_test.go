@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package policy
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/idm"
+)
+
+func TestMergeDefaultResources(t *testing.T) {
+
+	Convey("Test merging new default resources into stored policies", t, func() {
+
+		defaults := []*idm.PolicyGroup{
+			{Uuid: "group", Policies: []*idm.Policy{
+				{Id: "policy1", Resources: []string{"rest:/a", "rest:/b"}},
+				{Id: "policy2", Resources: []string{"rest:/c"}},
+			}},
+			{Uuid: "removed-group", Policies: []*idm.Policy{
+				{Id: "policy3", Resources: []string{"rest:/d"}},
+			}},
+		}
+		stored := []*idm.PolicyGroup{
+			{Uuid: "group", Policies: []*idm.Policy{
+				{Id: "policy1", Resources: []string{"rest:/a", "rest:/custom"}},
+				{Id: "policy2", Resources: []string{"rest:/c"}},
+			}},
+			{Uuid: "custom-group", Policies: []*idm.Policy{
+				{Id: "custom", Resources: []string{"rest:/x"}},
+			}},
+		}
+
+		changed := MergeDefaultResources(stored, defaults)
+		So(changed, ShouldHaveLength, 1)
+		So(changed[0].Uuid, ShouldEqual, "group")
+		So(changed[0].Policies[0].Resources, ShouldResemble, []string{"rest:/a", "rest:/custom", "rest:/b"})
+		So(changed[0].Policies[1].Resources, ShouldResemble, []string{"rest:/c"})
+
+		So(MergeDefaultResources(stored, defaults), ShouldBeEmpty)
+	})
+
+	Convey("Test default user policy allows personal tokens and sessions", t, func() {
+
+		var resources []string
+		for _, g := range DefaultPolicyGroups {
+			for _, p := range g.Policies {
+				if p.Id == "user-default-policy" {
+					resources = p.Resources
+				}
+			}
+		}
+		So(resources, ShouldContain, "rest:/auth/personal-tokens<.*>")
+		So(resources, ShouldContain, "rest:/auth/sessions<.*>")
+	})
+}
@@ -49,6 +49,10 @@ func init() {
 				TargetVersion: service.FirstRun(),
 				Up:            InitDefaults,
 			},
+			{
+				TargetVersion: service.Latest(),
+				Up:            UpgradeDefaults,
+			},
 		}),
 		service.WithMicro(func(m micro.Service) error {
 			handler := new(Handler)
@@ -91,3 +95,25 @@ func InitDefaults(ctx context.Context) error {
 	log.Logger(ctx).Info("Successfully inserted default policies")
 	return nil
 }
+
+// UpgradeDefaults adds the resources introduced by new REST APIs to the default policies
+// of an existing install, as DefaultPolicyGroups are only stored at first run.
+func UpgradeDefaults(ctx context.Context) error {
+
+	dao := servicecontext.GetDAO(ctx).(policy.DAO)
+	if dao == nil {
+		return fmt.Errorf("cannot find DAO for policies upgrade")
+	}
+	stored, err := dao.ListPolicyGroups(ctx)
+	if err != nil {
+		return err
+	}
+	for _, policyGroup := range policy.MergeDefaultResources(stored, policy.DefaultPolicyGroups) {
+		if _, er := dao.StorePolicyGroup(ctx, policyGroup); er != nil {
+			log.Logger(ctx).Error("Could not upgrade default policy!", zap.Any("policy", policyGroup), zap.Error(er))
+			return er
+		}
+		log.Logger(ctx).Info("Upgraded default policy group " + policyGroup.Uuid)
+	}
+	return nil
+}
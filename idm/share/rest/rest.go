@@ -202,12 +202,14 @@ func (h *SharesHandler) PutCell(req *restful.Request, rsp *restful.Response) {
 			fmt.Sprintf("Cell %s has been created", shareRequest.Room.Label),
 			log.GetAuditId(common.AUDIT_CELL_CREATE),
 			zap.String(common.KEY_CELL_UUID, shareRequest.Room.Uuid),
+			log.GetAuditContext(common.AUDIT_CONTEXT_SHARE_ID, shareRequest.Room.Uuid),
 		)
 	} else {
 		log.Auditer(ctx).Info(
 			fmt.Sprintf("Cell %s has been updated", shareRequest.Room.Label),
 			log.GetAuditId(common.AUDIT_CELL_UPDATE),
 			zap.String(common.KEY_CELL_UUID, shareRequest.Room.Uuid),
+			log.GetAuditContext(common.AUDIT_CONTEXT_SHARE_ID, shareRequest.Room.Uuid),
 		)
 	}
 
@@ -271,6 +273,7 @@ func (h *SharesHandler) DeleteCell(req *restful.Request, rsp *restful.Response)
 		fmt.Sprintf("Cell %s has been removed", currWsLabel),
 		log.GetAuditId(common.AUDIT_CELL_DELETE),
 		zap.String(common.KEY_CELL_UUID, id),
+		log.GetAuditContext(common.AUDIT_CONTEXT_SHARE_ID, id),
 	)
 
 	rsp.WriteEntity(&rest.DeleteCellResponse{
@@ -388,6 +391,7 @@ func (h *SharesHandler) PutShareLink(req *restful.Request, rsp *restful.Response
 			fmt.Sprintf("ShareLink %s has been created", link.Label),
 			log.GetAuditId(common.AUDIT_LINK_CREATE),
 			zap.String(common.KEY_LINK_UUID, link.Uuid),
+			log.GetAuditContext(common.AUDIT_CONTEXT_SHARE_ID, link.Uuid),
 		)
 		track("Auditer")
 	} else {
@@ -395,6 +399,7 @@ func (h *SharesHandler) PutShareLink(req *restful.Request, rsp *restful.Response
 			fmt.Sprintf("ShareLink %s has been updated", link.Label),
 			log.GetAuditId(common.AUDIT_LINK_UPDATE),
 			zap.String(common.KEY_LINK_UUID, link.Uuid),
+			log.GetAuditContext(common.AUDIT_CONTEXT_SHARE_ID, link.Uuid),
 		)
 	}
 
@@ -467,6 +472,7 @@ func (h *SharesHandler) DeleteShareLink(req *restful.Request, rsp *restful.Respo
 		fmt.Sprintf("ShareLink %s has been removed", id),
 		log.GetAuditId(common.AUDIT_LINK_UPDATE),
 		zap.String(common.KEY_LINK_UUID, id),
+		log.GetAuditContext(common.AUDIT_CONTEXT_SHARE_ID, id),
 	)
 
 	rsp.WriteEntity(&rest.DeleteShareLinkResponse{
@@ -121,6 +121,7 @@ func (h *Handler) CreateUser(ctx context.Context, req *idm.CreateUserRequest, re
 				fmt.Sprintf("User [%s] has been updated", out.Login),
 				log.GetAuditId(common.AUDIT_USER_UPDATE),
 				out.ZapUuid(),
+				log.GetAuditContext(common.AUDIT_CONTEXT_TARGET_USER, out.Login),
 			)
 		}
 	} else {
@@ -135,6 +136,7 @@ func (h *Handler) CreateUser(ctx context.Context, req *idm.CreateUserRequest, re
 				fmt.Sprintf("User [%s] has been created in %s", out.Login, out.GroupPath),
 				log.GetAuditId(common.AUDIT_USER_CREATE),
 				out.ZapUuid(),
+				log.GetAuditContext(common.AUDIT_CONTEXT_TARGET_USER, out.Login),
 			)
 		}
 	}
@@ -185,6 +187,7 @@ func (h *Handler) DeleteUser(ctx context.Context, req *idm.DeleteUserRequest, re
 				fmt.Sprintf("User %s has been deleted from %s", deleted.Login, deleted.GroupPath),
 				log.GetAuditId(common.AUDIT_USER_DELETE),
 				deleted.ZapUuid(),
+				log.GetAuditContext(common.AUDIT_CONTEXT_TARGET_USER, deleted.Login),
 			)
 		}
 	}
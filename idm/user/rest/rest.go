@@ -33,7 +33,9 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth/claim"
 	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/auth"
 	"github.com/pydio/cells/common/proto/idm"
 	"github.com/pydio/cells/common/proto/rest"
 	"github.com/pydio/cells/common/service"
@@ -310,6 +312,9 @@ func (s *UserHandler) PutUser(req *restful.Request, rsp *restful.Response) {
 			return
 		}
 	}
+	if update != nil && !update.IsGroup && inputUser.Password != "" {
+		s.revokeOtherSessions(ctx, update.Uuid)
+	}
 	if update != nil {
 		log.Auditer(ctx).Info(
 			fmt.Sprintf("Update user %s", update.Uuid),
@@ -330,6 +335,21 @@ func (s *UserHandler) PutUser(req *restful.Request, rsp *restful.Response) {
 
 }
 
+// revokeOtherSessions logs out the sessions of a user after a password change. When users change their
+// own password, the session sending the request is kept.
+func (s *UserHandler) revokeOtherSessions(ctx context.Context, userUuid string) {
+	revokeRequest := &auth.RevokeSessionsRequest{UserUuid: userUuid}
+	if claims, ok := ctx.Value(claim.ContextKey).(claim.Claims); ok {
+		if subject, e := claims.DecodeSubject(); e == nil && subject.UserId == userUuid {
+			revokeRequest.ExceptUuid = auth.SessionUuid(subject.UserId, subject.ConnId, claims.ClientApp)
+		}
+	}
+	revokerClient := auth.NewAuthTokenRevokerClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_AUTH, defaults.NewClient())
+	if _, e := revokerClient.RevokeSessions(ctx, revokeRequest); e != nil {
+		log.Logger(ctx).Error("cannot revoke sessions after password change", zap.Error(e))
+	}
+}
+
 // PutRoles updates an existing user with the passed list of roles.
 func (s *UserHandler) PutRoles(req *restful.Request, rsp *restful.Response) {
 
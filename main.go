@@ -34,10 +34,13 @@ import (
 	_ "github.com/pydio/cells/broker/activity/grpc"
 	_ "github.com/pydio/cells/broker/activity/rest"
 	_ "github.com/pydio/cells/broker/chat/grpc"
+	_ "github.com/pydio/cells/broker/chat/rest"
 	_ "github.com/pydio/cells/broker/log/grpc"
 	_ "github.com/pydio/cells/broker/log/rest"
 	_ "github.com/pydio/cells/broker/mailer/grpc"
 	_ "github.com/pydio/cells/broker/mailer/rest"
+	_ "github.com/pydio/cells/broker/webhook/grpc"
+	_ "github.com/pydio/cells/broker/webhook/rest"
 	_ "github.com/pydio/cells/frontend/front-srv/rest"
 	_ "github.com/pydio/cells/frontend/front-srv/web"
 
@@ -60,6 +63,7 @@ import (
 	_ "github.com/pydio/cells/gateway/dav"
 	_ "github.com/pydio/cells/gateway/micro"
 	_ "github.com/pydio/cells/gateway/proxy"
+	_ "github.com/pydio/cells/gateway/scim"
 	_ "github.com/pydio/cells/gateway/websocket/api"
 	_ "github.com/pydio/cells/gateway/wopi"
 
@@ -90,6 +94,8 @@ import (
 
 	// All Actions for scheduler
 	_ "github.com/pydio/cells/broker/activity/actions"
+	_ "github.com/pydio/cells/broker/chat/actions"
+	_ "github.com/pydio/cells/scheduler/actions/antivirus"
 	_ "github.com/pydio/cells/scheduler/actions/archive"
 	_ "github.com/pydio/cells/scheduler/actions/cmd"
 	_ "github.com/pydio/cells/scheduler/actions/images"
@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package antivirus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/micro/go-micro/client"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/antivirus"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/views"
+	"github.com/pydio/cells/scheduler/actions"
+)
+
+var (
+	rescanActionName = "actions.antivirus.scan"
+)
+
+// RescanAction scans the input node again if it was not yet scanned with the current signatures database.
+// Set the "force" parameter to "true" to scan all files whatever their previous status.
+type RescanAction struct {
+	Router  views.Handler
+	Config  *antivirus.Config
+	Scanner antivirus.Scanner
+	force   bool
+	engine  string
+}
+
+// GetName returns this action unique identifier
+func (r *RescanAction) GetName() string {
+	return rescanActionName
+}
+
+// Init passes parameters to the action
+func (r *RescanAction) Init(job *jobs.Job, cl client.Client, action *jobs.Action) error {
+	r.Router = views.NewStandardRouter(views.RouterOptions{AdminView: true, WatchRegistry: false})
+	r.Config = antivirus.LoadConfig()
+	r.Scanner = r.Config.Scanner()
+	r.force = action.Parameters["force"] == "true"
+	return nil
+}
+
+// Run the actual action code
+func (r *RescanAction) Run(ctx context.Context, channels *actions.RunnableChannels, input jobs.ActionMessage) (jobs.ActionMessage, error) {
+
+	if !r.Config.Enabled {
+		return input.WithIgnore(), nil
+	}
+	if len(input.Nodes) == 0 || !input.Nodes[0].IsLeaf() || input.Nodes[0].Size == -1 || input.Nodes[0].Etag == common.NODE_FLAG_ETAG_TEMPORARY {
+		return input.WithIgnore(), nil
+	}
+	// Search results do not carry the metadata
+	resp, e := r.Router.ReadNode(ctx, &tree.ReadNodeRequest{Node: proto.Clone(input.Nodes[0]).(*tree.Node)})
+	if e != nil {
+		return input.WithError(e), e
+	}
+	node := resp.Node
+	if r.Config.MaxSize > 0 && node.Size > r.Config.MaxSize {
+		return input.WithIgnore(), nil
+	}
+
+	if r.engine == "" {
+		v, e := r.Scanner.Version(ctx)
+		if e != nil {
+			return input.WithError(e), e
+		}
+		r.engine = v
+	}
+	if previous := antivirus.GetStatus(node); previous != nil && previous.Engine == r.engine && !r.force {
+		return input.WithIgnore(), nil
+	}
+
+	reader, e := r.Router.GetObject(ctx, proto.Clone(node).(*tree.Node), &views.GetRequestData{Length: -1})
+	if e != nil {
+		return input.WithError(e), e
+	}
+	result, e := r.Scanner.Scan(ctx, reader)
+	reader.Close()
+	if e != nil {
+		log.Logger(ctx).Error("Antivirus scan failed", node.ZapPath(), zap.Error(e))
+		return input.WithError(e), e
+	}
+
+	status := antivirus.NewStatus(result, r.engine, r.Config.Action)
+	output := input
+	if result.Infected {
+		antivirus.ReportInfected(ctx, node, status)
+		if r.Config.Action == antivirus.ActionReject {
+			if _, e := r.Router.DeleteNode(ctx, &tree.DeleteNodeRequest{Node: proto.Clone(node).(*tree.Node)}); e != nil {
+				return input.WithError(e), e
+			}
+			output.AppendOutput(&jobs.ActionOutput{
+				Success:    true,
+				StringBody: fmt.Sprintf("Deleted infected file %s (%s)", node.Path, status.Signature),
+			})
+			return output, nil
+		}
+	}
+
+	if e := antivirus.StoreStatus(ctx, node, status); e != nil {
+		return input.WithError(e), e
+	}
+	output.Nodes[0] = node
+	if result.Infected {
+		output.AppendOutput(&jobs.ActionOutput{
+			Success:    true,
+			StringBody: fmt.Sprintf("Quarantined infected file %s (%s)", node.Path, status.Signature),
+		})
+	} else {
+		output.AppendOutput(&jobs.ActionOutput{
+			Success:    true,
+			StringBody: "File is clean",
+		})
+	}
+	return output, nil
+}
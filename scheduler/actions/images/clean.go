@@ -23,11 +23,14 @@ package images
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"go.uber.org/zap"
 
 	"github.com/micro/go-micro/client"
+	"github.com/pydio/minio-go"
+
 	"github.com/pydio/cells/common"
 	"github.com/pydio/cells/common/log"
 	"github.com/pydio/cells/common/proto/jobs"
@@ -39,8 +42,12 @@ var (
 	cleanThumbTaskName = "actions.images.clean"
 )
 
+// CleanThumbsTask removes the files stored for a node in the thumbnails store (thumbnails in all sizes,
+// densities and formats, previews). With the "obsolete" parameter, only files generated from another
+// version of the node content are removed.
 type CleanThumbsTask struct {
-	Client client.Client
+	Client   client.Client
+	Obsolete bool
 }
 
 // GetName returns this action unique identifier.
@@ -51,6 +58,9 @@ func (c *CleanThumbsTask) GetName() string {
 // Init passes parameters to the action.
 func (c *CleanThumbsTask) Init(job *jobs.Job, cl client.Client, action *jobs.Action) error {
 	c.Client = cl
+	if action.Parameters != nil {
+		c.Obsolete, _ = strconv.ParseBool(action.Parameters["obsolete"])
+	}
 	return nil
 }
 
@@ -76,7 +86,8 @@ func (c *CleanThumbsTask) Run(ctx context.Context, channels *actions.RunnableCha
 		log.Logger(ctx).Debug("Cannot get ThumbStoreClient", zap.Error(e), zap.Any("context", ctx))
 		return input.WithError(e), e
 	}
-	nodeUuid := input.Nodes[0].Uuid
+	node := input.Nodes[0]
+	nodeUuid := node.Uuid
 	// List all thumbs starting with node Uuid
 	listRes, err := thumbsClient.ListObjects(thumbsBucket, nodeUuid+"-", "", "", 0)
 	if err != nil {
@@ -84,7 +95,16 @@ func (c *CleanThumbsTask) Run(ctx context.Context, channels *actions.RunnableCha
 		return input.WithError(err), err
 	}
 	logs := []string{"Removing thumbs associated to node " + nodeUuid}
+	if c.Obsolete {
+		logs = []string{"Removing obsolete thumbs associated to node " + nodeUuid}
+	}
 	for _, oi := range listRes.Contents {
+		if c.Obsolete {
+			stat, e := thumbsClient.StatObject(thumbsBucket, oi.Key, minio.StatObjectOptions{})
+			if e != nil || !isObsoleteThumb(stat, node.Etag) {
+				continue
+			}
+		}
 		err := thumbsClient.RemoveObject(thumbsBucket, oi.Key)
 		if err != nil {
 			log.Logger(ctx).Debug("Cannot get ThumbStoreClient", zap.Error(err))
@@ -99,3 +119,9 @@ func (c *CleanThumbsTask) Run(ctx context.Context, channels *actions.RunnableCha
 	log.Logger(ctx).Debug("Thumbs Clean Output", zap.Any("logs", logs))
 	return output, nil
 }
+
+// isObsoleteThumb checks if a stored file was generated from another content than the current one.
+func isObsoleteThumb(oi minio.ObjectInfo, currentEtag string) bool {
+	original := oi.Metadata.Get("X-Amz-Meta-Original-Etag")
+	return original != "" && original != currentEtag
+}
@@ -21,10 +21,13 @@
 package images
 
 import (
+	"net/http"
 	"testing"
 
-	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/minio-go"
 	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/jobs"
 )
 
 func TestCleanThumbsTask_GetName(t *testing.T) {
@@ -45,3 +48,20 @@ func TestCleanThumbsTask_Init(t *testing.T) {
 
 	})
 }
+
+func TestCleanThumbsTask_Obsolete(t *testing.T) {
+
+	Convey("Test obsolete thumbs detection", t, func() {
+		action := &CleanThumbsTask{}
+		e := action.Init(&jobs.Job{}, nil, &jobs.Action{Parameters: map[string]string{"obsolete": "true"}})
+		So(e, ShouldBeNil)
+		So(action.Obsolete, ShouldBeTrue)
+
+		oi := minio.ObjectInfo{Metadata: http.Header{}}
+		So(isObsoleteThumb(oi, "etag"), ShouldBeFalse)
+		oi.Metadata.Set("X-Amz-Meta-Original-Etag", "etag")
+		So(isObsoleteThumb(oi, "etag"), ShouldBeFalse)
+		So(isObsoleteThumb(oi, "new-etag"), ShouldBeTrue)
+	})
+
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package images
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/micro/go-micro/errors"
+
+	"github.com/pydio/cells/common"
+)
+
+// Converter transforms a document into other files (a PDF rendition, page images...) written in an output folder.
+type Converter interface {
+	// Accepts checks if this converter handles files with the given extension.
+	Accepts(extension string) bool
+	// Available checks if the converter can currently run, e.g. if its binary is installed.
+	Available() bool
+	// Convert processes inputFile and returns the files produced in outputDir.
+	Convert(ctx context.Context, inputFile string, outputDir string) ([]string, error)
+}
+
+// CommandConverter runs an external command. As for the shell action, its parameters are split on spaces and
+// may contain the PYDIO_INPUT_FILE, PYDIO_OUTPUT_DIR and PYDIO_MAX_PAGES placeholders.
+type CommandConverter struct {
+	Extensions []string
+	Cmd        string
+	Parameters []string
+	MaxPages   int
+	Timeout    time.Duration
+}
+
+// NewCommandConverter creates a converter from a comma-separated list of extensions and a command line.
+func NewCommandConverter(extensions string, cmd string, parameters string) *CommandConverter {
+	c := &CommandConverter{
+		Cmd:        cmd,
+		Parameters: strings.Fields(parameters),
+		Timeout:    2 * time.Minute,
+	}
+	for _, ext := range strings.Split(extensions, ",") {
+		if ext = strings.ToLower(strings.TrimSpace(ext)); ext != "" {
+			c.Extensions = append(c.Extensions, ext)
+		}
+	}
+	return c
+}
+
+// Accepts checks the extension against the configured list.
+func (c *CommandConverter) Accepts(extension string) bool {
+	extension = strings.ToLower(strings.TrimPrefix(extension, "."))
+	for _, e := range c.Extensions {
+		if e == extension {
+			return true
+		}
+	}
+	return false
+}
+
+// Available checks that the command can be found.
+func (c *CommandConverter) Available() bool {
+	if c.Cmd == "" {
+		return false
+	}
+	_, e := exec.LookPath(c.Cmd)
+	return e == nil
+}
+
+// Convert runs the command and lists the files it created in outputDir, sorted by name.
+func (c *CommandConverter) Convert(ctx context.Context, inputFile string, outputDir string) ([]string, error) {
+
+	replacer := strings.NewReplacer(
+		"PYDIO_INPUT_FILE", inputFile,
+		"PYDIO_OUTPUT_DIR", outputDir,
+		"PYDIO_MAX_PAGES", strconv.Itoa(c.MaxPages),
+	)
+	params := make([]string, len(c.Parameters))
+	for i, p := range c.Parameters {
+		params[i] = replacer.Replace(p)
+	}
+
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+	cmd := exec.CommandContext(ctx, c.Cmd, params...)
+	// Some converters (e.g. LibreOffice) require a writable home folder
+	cmd.Dir = outputDir
+	cmd.Env = append(os.Environ(), "HOME="+outputDir)
+	if out, e := cmd.CombinedOutput(); e != nil {
+		return nil, errors.InternalServerError(common.SERVICE_JOBS, "Conversion with %s failed: %s (%s)", c.Cmd, e.Error(), strings.TrimSpace(string(out)))
+	}
+
+	infos, e := ioutil.ReadDir(outputDir)
+	if e != nil {
+		return nil, e
+	}
+	var files []string
+	for _, info := range infos {
+		if !info.IsDir() {
+			files = append(files, filepath.Join(outputDir, info.Name()))
+		}
+	}
+	sortPageFiles(files)
+	return files, nil
+}
+
+// sortPageFiles sorts files so that page-2 comes before page-10, whatever the zero-padding used by the converter.
+func sortPageFiles(files []string) {
+	sort.Slice(files, func(i, j int) bool {
+		if len(files[i]) != len(files[j]) {
+			return len(files[i]) < len(files[j])
+		}
+		return files[i] < files[j]
+	})
+}
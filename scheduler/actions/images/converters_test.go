@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package images
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCommandConverter(t *testing.T) {
+
+	Convey("Test converter configuration", t, func() {
+		c := NewCommandConverter("doc, DOCX,,odt", "cp", "PYDIO_INPUT_FILE PYDIO_OUTPUT_DIR/page-1.jpg")
+		So(c.Extensions, ShouldResemble, []string{"doc", "docx", "odt"})
+		So(c.Accepts("docx"), ShouldBeTrue)
+		So(c.Accepts(".ODT"), ShouldBeTrue)
+		So(c.Accepts("pdf"), ShouldBeFalse)
+		So(c.Available(), ShouldBeTrue)
+		So(NewCommandConverter("doc", "not-an-installed-converter", "").Available(), ShouldBeFalse)
+		So(NewCommandConverter("doc", "", "").Available(), ShouldBeFalse)
+	})
+
+	Convey("Test converter run", t, func() {
+		dir, _ := ioutil.TempDir("", "converter-test")
+		defer os.RemoveAll(dir)
+		input := filepath.Join(dir, "input.doc")
+		ioutil.WriteFile(input, []byte("content"), 0600)
+		outDir := filepath.Join(dir, "out")
+		os.Mkdir(outDir, 0700)
+
+		c := NewCommandConverter("doc", "cp", "PYDIO_INPUT_FILE PYDIO_OUTPUT_DIR/page-1.jpg")
+		files, e := c.Convert(context.Background(), input, outDir)
+		So(e, ShouldBeNil)
+		So(files, ShouldResemble, []string{filepath.Join(outDir, "page-1.jpg")})
+
+		_, e = NewCommandConverter("doc", "false", "").Convert(context.Background(), input, outDir)
+		So(e, ShouldNotBeNil)
+	})
+
+	Convey("Test pages sorting", t, func() {
+		files := []string{"page-10.jpg", "page-2.jpg", "page-1.jpg"}
+		sortPageFiles(files)
+		So(files, ShouldResemble, []string{"page-1.jpg", "page-2.jpg", "page-10.jpg"})
+	})
+
+}
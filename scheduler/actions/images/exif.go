@@ -43,7 +43,7 @@ import (
 
 const (
 	METADATA_EXIF               = "ImageExif"
-	METADATA_GEOLOCATION        = "GeoLocation"
+	METADATA_GEOLOCATION        = common.META_NAMESPACE_GEOLOCATION
 	METADATA_COMPAT_ORIENTATION = "image_exif_orientation"
 )
 
@@ -96,13 +96,10 @@ func (e *ExifProcessor) Run(ctx context.Context, channels *actions.RunnableChann
 			node.SetMeta(METADATA_COMPAT_ORIENTATION, t)
 		}
 	}
-	lat, long, err := exifData.LatLong()
-	if err == nil {
-		geoLocation := map[string]float64{
-			"lat": lat,
-			"lon": long,
+	if lat, long, err := exifData.LatLong(); err == nil {
+		if point := (&tree.GeoPoint{Lat: lat, Lon: long}); point.IsValid() {
+			node.SetGeoPoint(point)
 		}
-		node.SetMeta(METADATA_GEOLOCATION, geoLocation)
 	}
 
 	e.metaClient.UpdateNode(ctx, &tree.UpdateNodeRequest{From: node, To: node})
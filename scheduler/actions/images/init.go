@@ -36,6 +36,14 @@ func init() {
 		return &ExifProcessor{}
 	})
 
+	manager.Register(mediaTaskName, func() actions.ConcreteAction {
+		return &MediaProcessor{}
+	})
+
+	manager.Register(previewActionName, func() actions.ConcreteAction {
+		return &PreviewGenerator{}
+	})
+
 	manager.Register(cleanThumbTaskName, func() actions.ConcreteAction {
 		return &CleanThumbsTask{}
 	})
@@ -0,0 +1,230 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package images
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/errors"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/cells/common/proto/object"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/views"
+	"github.com/pydio/cells/scheduler/actions"
+	"github.com/pydio/cells/scheduler/actions/images/media"
+)
+
+const (
+	// METADATA_MEDIA stores the media.Info of audio and video files
+	METADATA_MEDIA = "MediaInfo"
+)
+
+var (
+	mediaTaskName = "actions.images.media"
+
+	// DefaultMediaMaxDownloadSize is used when the maxDownloadSize parameter is not set
+	DefaultMediaMaxDownloadSize int64 = 512 * 1024 * 1024
+
+	errMediaTooLarge = errors.New(common.SERVICE_JOBS, "file is too large to be downloaded", 413)
+)
+
+// MediaProcessor extracts technical metadata and tags from audio and video files.
+type MediaProcessor struct {
+	Router views.Handler
+	// MaxDownloadSize limits the size of the files copied locally when they cannot be read by ranges
+	MaxDownloadSize int64
+	metaClient      tree.NodeReceiverClient
+}
+
+// GetName returns this action unique identifier
+func (m *MediaProcessor) GetName() string {
+	return mediaTaskName
+}
+
+// Init passes parameters to the action
+func (m *MediaProcessor) Init(job *jobs.Job, cl client.Client, action *jobs.Action) error {
+	m.Router = views.NewStandardRouter(views.RouterOptions{AdminView: true, WatchRegistry: false})
+	m.MaxDownloadSize = DefaultMediaMaxDownloadSize
+	if size, e := strconv.ParseInt(action.Parameters["maxDownloadSize"], 10, 64); e == nil && size > 0 {
+		m.MaxDownloadSize = size
+	}
+	m.metaClient = tree.NewNodeReceiverClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_META, cl)
+	return nil
+}
+
+// Run the actual action code
+func (m *MediaProcessor) Run(ctx context.Context, channels *actions.RunnableChannels, input jobs.ActionMessage) (jobs.ActionMessage, error) {
+
+	if len(input.Nodes) == 0 || input.Nodes[0].Size == -1 || input.Nodes[0].Etag == common.NODE_FLAG_ETAG_TEMPORARY {
+		return input.WithIgnore(), nil
+	}
+	node := input.Nodes[0]
+	info, err := m.ExtractMedia(ctx, node)
+	if err == media.ErrUnsupportedFormat {
+		log.Logger(ctx).Debug("No media metadata extracted", node.ZapPath())
+		return input.WithIgnore(), nil
+	} else if err == errMediaTooLarge {
+		log.Logger(ctx).Info("Skipping media metadata extraction on large encrypted file", node.ZapPath(), zap.Int64("size", node.Size))
+		return input.WithIgnore(), nil
+	} else if err != nil {
+		log.Logger(ctx).Error("Could not extract media metadata", zap.Error(err), node.ZapPath())
+		return input.WithError(err), err
+	}
+
+	output := input
+	node.SetMeta(METADATA_MEDIA, info)
+	if info.Location != nil {
+		node.SetGeoPoint(&tree.GeoPoint{Lat: info.Location.Lat, Lon: info.Location.Lon})
+	}
+
+	if _, err := m.metaClient.UpdateNode(ctx, &tree.UpdateNodeRequest{From: node, To: node}); err != nil {
+		return input.WithError(err), err
+	}
+
+	output.Nodes[0] = node
+	output.AppendOutput(&jobs.ActionOutput{
+		Success:    true,
+		StringBody: "Successfully Extracted media metadata",
+	})
+
+	return output, nil
+}
+
+// ExtractMedia reads the node content and parses its container metadata. Content is read by ranges, except on
+// encrypted datasources that do not support them: files are then copied locally, up to MaxDownloadSize.
+func (m *MediaProcessor) ExtractMedia(ctx context.Context, node *tree.Node) (*media.Info, error) {
+
+	if !node.HasSource() {
+		return nil, errors.InternalServerError(common.SERVICE_JOBS, "Node does not have enough metadata")
+	}
+
+	localPath := getNodeLocalPath(node)
+	if localPath == "" {
+		if !encryptedSource(node.GetStringMeta(common.META_NAMESPACE_DATASOURCE_NAME)) {
+			return media.Probe(newNodeReaderAt(ctx, m.Router, node), node.Size)
+		}
+		if node.Size > m.MaxDownloadSize {
+			return nil, errMediaTooLarge
+		}
+		// Metadata may be stored at the end of the file, content is copied locally to be read at random
+		workDir, e := ioutil.TempDir("", "pydio-media-")
+		if e != nil {
+			return nil, e
+		}
+		defer os.RemoveAll(workDir)
+		localPath = filepath.Join(workDir, "input"+path.Ext(node.Path))
+		if e := downloadNode(ctx, m.Router, node, localPath); e != nil {
+			return nil, e
+		}
+	}
+
+	file, e := os.Open(localPath)
+	if e != nil {
+		return nil, e
+	}
+	defer file.Close()
+	stat, e := file.Stat()
+	if e != nil {
+		return nil, e
+	}
+	return media.Probe(file, stat.Size())
+}
+
+// encryptedSource checks if the datasource encrypts its objects.
+func encryptedSource(dsName string) bool {
+	var ds *object.DataSource
+	if e := config.Get("services", common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DATA_SYNC_+dsName).Scan(&ds); e != nil || ds == nil {
+		return false
+	}
+	return ds.EncryptionMode != object.EncryptionMode_CLEAR
+}
+
+// nodeReaderAt reads a node content by ranges through the router. Parsers issue many small reads around
+// the same offsets, so each request loads at least a full block that is kept for the next reads.
+type nodeReaderAt struct {
+	ctx    context.Context
+	router views.Handler
+	node   *tree.Node
+
+	blockOffset int64
+	block       []byte
+}
+
+const readAtBlockSize = 64 * 1024
+
+func newNodeReaderAt(ctx context.Context, router views.Handler, node *tree.Node) *nodeReaderAt {
+	return &nodeReaderAt{ctx: ctx, router: router, node: node}
+}
+
+// ReadAt implements io.ReaderAt.
+func (r *nodeReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.BadRequest(common.SERVICE_JOBS, "negative offset")
+	}
+	if off >= r.node.Size {
+		return 0, io.EOF
+	}
+	end := off + int64(len(p))
+	if end > r.node.Size {
+		end = r.node.Size
+	}
+	if off < r.blockOffset || end > r.blockOffset+int64(len(r.block)) {
+		length := end - off
+		if length < readAtBlockSize {
+			length = readAtBlockSize
+		}
+		if off+length > r.node.Size {
+			length = r.node.Size - off
+		}
+		reader, e := r.router.GetObject(r.ctx, proto.Clone(r.node).(*tree.Node), &views.GetRequestData{StartOffset: off, Length: length})
+		if e != nil {
+			return 0, e
+		}
+		block := make([]byte, length)
+		n, e := io.ReadFull(reader, block)
+		reader.Close()
+		if e != nil && e != io.ErrUnexpectedEOF {
+			return 0, e
+		}
+		r.blockOffset, r.block = off, block[:n]
+		if off+int64(n) < end {
+			end = off + int64(n)
+		}
+	}
+	n := copy(p, r.block[off-r.blockOffset:end-r.blockOffset])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package media
+
+import (
+	"encoding/binary"
+	"io"
+	"strings"
+)
+
+// Vorbis comment names that do not match the tag keys used by Info
+var vorbisComments = map[string]string{
+	"TRACKNUMBER":  "track",
+	"DESCRIPTION":  "comment",
+	"ALBUMARTIST":  "albumartist",
+	"ALBUM ARTIST": "albumartist",
+}
+
+// probeFLAC reads the STREAMINFO and VORBIS_COMMENT blocks of a FLAC stream starting at offset.
+func probeFLAC(r io.ReaderAt, size int64, offset int64) (*Info, error) {
+	info := &Info{Format: "flac", AudioCodec: "flac"}
+	offset += 4
+	for {
+		header, e := readAt(r, offset, 4)
+		if e != nil {
+			return nil, e
+		}
+		last := header[0]&0x80 != 0
+		blockType := header[0] & 0x7F
+		length := int64(header[1])<<16 | int64(header[2])<<8 | int64(header[3])
+		offset += 4
+		switch blockType {
+		case 0:
+			data, e := readAt(r, offset, length)
+			if e != nil {
+				return nil, e
+			}
+			if len(data) >= 18 {
+				// 20 bits sample rate, 3 bits channels-1, 5 bits bits per sample-1, 36 bits total samples
+				v := binary.BigEndian.Uint64(data[10:18])
+				info.SampleRate = int(v >> 44)
+				info.Channels = int((v>>41)&0x07) + 1
+				samples := v & 0xFFFFFFFFF
+				if info.SampleRate > 0 {
+					info.Duration = float64(samples) / float64(info.SampleRate)
+				}
+			}
+		case 4:
+			data, e := readAt(r, offset, length)
+			if e != nil {
+				return nil, e
+			}
+			readVorbisComments(info, data)
+		}
+		offset += length
+		if last || offset >= size {
+			break
+		}
+	}
+	return info, nil
+}
+
+// readVorbisComments reads a little-endian list of KEY=value strings, after the vendor string.
+func readVorbisComments(info *Info, data []byte) {
+	next := func() (string, bool) {
+		if len(data) < 4 {
+			return "", false
+		}
+		l := int(binary.LittleEndian.Uint32(data))
+		if l < 0 || 4+l > len(data) {
+			return "", false
+		}
+		s := string(data[4 : 4+l])
+		data = data[4+l:]
+		return s, true
+	}
+	if _, ok := next(); !ok || len(data) < 4 {
+		return
+	}
+	count := int(binary.LittleEndian.Uint32(data))
+	data = data[4:]
+	for i := 0; i < count; i++ {
+		comment, ok := next()
+		if !ok {
+			return
+		}
+		parts := strings.SplitN(comment, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		key := strings.ToUpper(parts[0])
+		if mapped, ok := vorbisComments[key]; ok {
+			info.setTag(mapped, parts[1])
+		} else {
+			info.setTag(strings.ToLower(key), parts[1])
+		}
+	}
+}
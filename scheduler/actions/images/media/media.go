@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package media reads technical metadata and tags from audio and video containers (MP4/MOV, Matroska/WebM,
+// MP3 and FLAC) without relying on external tools.
+package media
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxElementSize protects against corrupted files declaring huge metadata blocks.
+const maxElementSize = 16 * 1024 * 1024
+
+var (
+	// ErrUnsupportedFormat is returned by Probe when the content is not recognized.
+	ErrUnsupportedFormat = errors.New("unsupported media format")
+
+	iso6709 = regexp.MustCompile(`^([+-]\d+(?:\.\d+)?)([+-]\d+(?:\.\d+)?)`)
+)
+
+// Location is a GPS position.
+type Location struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+// Info holds the metadata extracted from a media file.
+type Info struct {
+	// Format of the container: mp4, mov, mkv, webm, mp3 or flac
+	Format string `json:"format"`
+	// Duration in seconds
+	Duration float64 `json:"duration,omitempty"`
+	// Bitrate is the overall bitrate in bits per second
+	Bitrate    int64  `json:"bitrate,omitempty"`
+	VideoCodec string `json:"videoCodec,omitempty"`
+	Width      int    `json:"width,omitempty"`
+	Height     int    `json:"height,omitempty"`
+	AudioCodec string `json:"audioCodec,omitempty"`
+	SampleRate int    `json:"sampleRate,omitempty"`
+	Channels   int    `json:"channels,omitempty"`
+	// Created is the recording date, if found in the container
+	Created  *time.Time `json:"created,omitempty"`
+	Location *Location  `json:"location,omitempty"`
+	// Tags are the textual tags (ID3, Vorbis comments, iTunes or Matroska tags) with lowercase keys
+	// such as title, artist, album, date, genre, track or comment.
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// HasVideo checks if a video stream was found.
+func (i *Info) HasVideo() bool {
+	return i.VideoCodec != "" || i.Width > 0
+}
+
+func (i *Info) setTag(key string, value string) {
+	value = strings.TrimSpace(strings.TrimRight(value, "\x00"))
+	if key == "" || value == "" {
+		return
+	}
+	if i.Tags == nil {
+		i.Tags = make(map[string]string)
+	}
+	if _, exists := i.Tags[key]; !exists {
+		i.Tags[key] = value
+	}
+}
+
+// finish computes values derived from others.
+func (i *Info) finish(size int64) {
+	if i.Bitrate == 0 && i.Duration > 0 && size > 0 {
+		i.Bitrate = int64(float64(size*8) / i.Duration)
+	}
+}
+
+// Probe detects the container format of r and reads its metadata.
+func Probe(r io.ReaderAt, size int64) (*Info, error) {
+	header := make([]byte, 12)
+	n, e := r.ReadAt(header, 0)
+	if n < len(header) {
+		if e == nil || e == io.EOF {
+			e = ErrUnsupportedFormat
+		}
+		return nil, e
+	}
+	var info *Info
+	switch {
+	case string(header[4:8]) == "ftyp":
+		info, e = probeMP4(r, size)
+	case binary.BigEndian.Uint32(header) == ebmlHeaderID:
+		info, e = probeMatroska(r, size)
+	case string(header[:4]) == "fLaC":
+		info, e = probeFLAC(r, size, 0)
+	case string(header[:3]) == "ID3":
+		// FLAC files may also start with an ID3 tag
+		tagSize := int64(id3Size(header)) + 10
+		marker := make([]byte, 4)
+		if _, err := r.ReadAt(marker, tagSize); err == nil && string(marker) == "fLaC" {
+			info, e = probeFLAC(r, size, tagSize)
+		} else {
+			info, e = probeMP3(r, size)
+		}
+	case header[0] == 0xFF && header[1]&0xE0 == 0xE0:
+		info, e = probeMP3(r, size)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if e != nil {
+		return nil, e
+	}
+	info.finish(size)
+	return info, nil
+}
+
+// readAt reads exactly n bytes at the given offset.
+func readAt(r io.ReaderAt, offset int64, n int64) ([]byte, error) {
+	if n < 0 || n > maxElementSize {
+		return nil, fmt.Errorf("invalid element size %d at offset %d", n, offset)
+	}
+	buf := make([]byte, n)
+	read, e := r.ReadAt(buf, offset)
+	if int64(read) == n {
+		return buf, nil
+	}
+	if e == nil || e == io.EOF {
+		e = io.ErrUnexpectedEOF
+	}
+	return nil, e
+}
+
+// parseISO6709 reads a location like +48.8577+002.2950+035.000/
+func parseISO6709(s string) *Location {
+	m := iso6709.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return nil
+	}
+	lat, e1 := strconv.ParseFloat(m[1], 64)
+	lon, e2 := strconv.ParseFloat(m[2], 64)
+	if e1 != nil || e2 != nil || lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+		return nil
+	}
+	return &Location{Lat: lat, Lon: lon}
+}
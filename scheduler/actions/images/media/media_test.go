@@ -0,0 +1,364 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func be16(v int) []byte {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, uint16(v))
+	return b
+}
+
+func be32(v int) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, uint32(v))
+	return b
+}
+
+func le32(v int) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, uint32(v))
+	return b
+}
+
+func concat(parts ...[]byte) []byte {
+	return bytes.Join(parts, nil)
+}
+
+func box(typ string, payload ...[]byte) []byte {
+	data := concat(payload...)
+	return concat(be32(len(data)+8), []byte(typ), data)
+}
+
+func ebml(id []byte, payload ...[]byte) []byte {
+	data := concat(payload...)
+	// 8 bytes size
+	size := make([]byte, 8)
+	binary.BigEndian.PutUint64(size, uint64(len(data)))
+	size[0] = 0x01
+	return concat(id, size, data)
+}
+
+func testMP4() []byte {
+	mvhd := box("mvhd", make([]byte, 4), be32(0), be32(0), be32(1000), be32(12500), make([]byte, 80))
+	tkhd := concat(make([]byte, 76), be32(1920<<16), be32(1080<<16))
+	videoEntry := concat(be32(86), []byte("avc1"), make([]byte, 8), make([]byte, 16), be16(1920), be16(1080), make([]byte, 50))
+	videoTrak := box("trak",
+		box("tkhd", tkhd),
+		box("mdia",
+			box("hdlr", make([]byte, 8), []byte("vide"), make([]byte, 12)),
+			box("minf", box("stbl", box("stsd", make([]byte, 4), be32(1), videoEntry))),
+		),
+	)
+	audioEntry := concat(be32(36), []byte("mp4a"), make([]byte, 8), make([]byte, 8), be16(2), be16(16), make([]byte, 4), be32(48000<<16))
+	audioTrak := box("trak",
+		box("tkhd", make([]byte, 84)),
+		box("mdia",
+			box("hdlr", make([]byte, 8), []byte("soun"), make([]byte, 12)),
+			box("minf", box("stbl", box("stsd", make([]byte, 4), be32(1), audioEntry))),
+		),
+	)
+	location := "+48.8577+002.2950+035.000/"
+	udta := box("udta",
+		box("\xa9xyz", be16(len(location)), be16(0x15c7), []byte(location)),
+		box("meta", make([]byte, 4),
+			box("hdlr", make([]byte, 8), []byte("mdir"), make([]byte, 12)),
+			box("ilst",
+				box("\xa9nam", box("data", be32(1), be32(0), []byte("Holidays"))),
+				box("trkn", box("data", be32(0), be32(0), be16(0), be16(3), be16(10), be16(0))),
+			),
+		),
+	)
+	return concat(
+		box("ftyp", []byte("isom"), be32(512), []byte("isomavc1")),
+		box("mdat", make([]byte, 100000)),
+		box("moov", mvhd, videoTrak, audioTrak, udta),
+	)
+}
+
+func testQuickTime() []byte {
+	mvhd := box("mvhd", make([]byte, 4), be32(0), be32(0), be32(600), be32(1200), make([]byte, 80))
+	key := "com.apple.quicktime.location.ISO6709"
+	date := "com.apple.quicktime.creationdate"
+	meta := box("meta",
+		box("hdlr", make([]byte, 8), []byte("mdta"), make([]byte, 12)),
+		box("keys", make([]byte, 4), be32(2),
+			concat(be32(len(key)+8), []byte("mdta"), []byte(key)),
+			concat(be32(len(date)+8), []byte("mdta"), []byte(date)),
+		),
+		box("ilst",
+			concat(box(string(be32(1)), box("data", be32(1), be32(0), []byte("-33.8688+151.2093+010.000/")))),
+			concat(box(string(be32(2)), box("data", be32(1), be32(0), []byte("2018-07-14T10:30:00+0200")))),
+		),
+	)
+	return concat(
+		box("ftyp", []byte("qt  "), be32(0), []byte("qt  ")),
+		box("moov", mvhd, meta),
+	)
+}
+
+func testMatroska() []byte {
+	data, _, _ := testMatroskaLayout()
+	return data
+}
+
+// testMatroskaLayout builds a file with Tags stored after the Clusters, and returns the range of the Clusters data.
+func testMatroskaLayout() ([]byte, int, int) {
+	duration := make([]byte, 8)
+	binary.BigEndian.PutUint64(duration, math.Float64bits(5000))
+	rate := make([]byte, 4)
+	binary.BigEndian.PutUint32(rate, math.Float32bits(48000))
+	header := ebml([]byte{0x1A, 0x45, 0xDF, 0xA3}, ebml([]byte{0x42, 0x82}, []byte("webm")))
+	info := ebml([]byte{0x15, 0x49, 0xA9, 0x66},
+		ebml([]byte{0x2A, 0xD7, 0xB1}, []byte{0x0F, 0x42, 0x40}),
+		ebml([]byte{0x44, 0x89}, duration),
+		ebml([]byte{0x7B, 0xA9}, []byte("A movie")),
+	)
+	tracks := ebml([]byte{0x16, 0x54, 0xAE, 0x6B},
+		ebml([]byte{0xAE},
+			ebml([]byte{0x83}, []byte{1}),
+			ebml([]byte{0x86}, []byte("V_VP9")),
+			ebml([]byte{0xE0}, ebml([]byte{0xB0}, be16(640)), ebml([]byte{0xBA}, be16(360))),
+		),
+		ebml([]byte{0xAE},
+			ebml([]byte{0x83}, []byte{2}),
+			ebml([]byte{0x86}, []byte("A_OPUS")),
+			ebml([]byte{0xE1}, ebml([]byte{0xB5}, rate), ebml([]byte{0x9F}, []byte{2})),
+		),
+	)
+	clusters := concat(
+		ebml([]byte{0x1F, 0x43, 0xB6, 0x75}, make([]byte, 5000)),
+		ebml([]byte{0x1F, 0x43, 0xB6, 0x75}, make([]byte, 5000)),
+	)
+	tags := ebml([]byte{0x12, 0x54, 0xC3, 0x67},
+		ebml([]byte{0x73, 0x73},
+			ebml([]byte{0x67, 0xC8}, ebml([]byte{0x45, 0xA3}, []byte("ARTIST")), ebml([]byte{0x44, 0x87}, []byte("Someone"))),
+			ebml([]byte{0x67, 0xC8}, ebml([]byte{0x45, 0xA3}, []byte("LOCATION")), ebml([]byte{0x44, 0x87}, []byte("+45.1885+005.7245/"))),
+		),
+	)
+	seekHead := func(tagsPosition int) []byte {
+		position := make([]byte, 8)
+		binary.BigEndian.PutUint64(position, uint64(tagsPosition))
+		return ebml([]byte{0x11, 0x4D, 0x9B, 0x74},
+			ebml([]byte{0x4D, 0xBB}, ebml([]byte{0x53, 0xAB}, []byte{0x12, 0x54, 0xC3, 0x67}), ebml([]byte{0x53, 0xAC}, position)),
+		)
+	}
+	beforeClusters := len(seekHead(0)) + len(info) + len(tracks)
+	// Segment of unknown size
+	segmentHeader := []byte{0x18, 0x53, 0x80, 0x67, 0x01, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+	segment := concat(segmentHeader, seekHead(beforeClusters+len(clusters)), info, tracks, clusters, tags)
+	clustersStart := len(header) + len(segmentHeader) + beforeClusters
+	return concat(header, segment), clustersStart, clustersStart + len(clusters)
+}
+
+// guardedReader fails reads that reach the media data, apart from the header of the first Cluster.
+type guardedReader struct {
+	*bytes.Reader
+	from, to int64
+}
+
+func (g *guardedReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < g.to && off+int64(len(p)) > g.from {
+		return 0, fmt.Errorf("unexpected read of %d bytes at %d", len(p), off)
+	}
+	return g.Reader.ReadAt(p, off)
+}
+
+func id3Frame(id string, payload ...[]byte) []byte {
+	data := concat(payload...)
+	return concat([]byte(id), be32(len(data)), []byte{0, 0}, data)
+}
+
+func utf16Text(s string) []byte {
+	buf := []byte{0xFF, 0xFE}
+	for _, u := range utf16.Encode([]rune(s)) {
+		buf = append(buf, byte(u), byte(u>>8))
+	}
+	return buf
+}
+
+func testMP3(frames int, xing bool) []byte {
+	tag := concat(
+		id3Frame("TIT2", []byte{3}, []byte("Song")),
+		id3Frame("TPE1", []byte{1}, utf16Text("Björk")),
+		id3Frame("TCON", []byte{0}, []byte("(17)")),
+		id3Frame("COMM", []byte{0}, []byte("eng"), []byte("desc\x00"), []byte("Nice one")),
+		make([]byte, 64),
+	)
+	size := len(tag)
+	header := concat([]byte("ID3"), []byte{3, 0, 0}, []byte{byte(size >> 21 & 0x7F), byte(size >> 14 & 0x7F), byte(size >> 7 & 0x7F), byte(size & 0x7F)})
+	// MPEG-1 Layer III, 128kbps, 44100Hz, stereo: 417 bytes frames
+	frame := make([]byte, 417)
+	copy(frame, []byte{0xFF, 0xFB, 0x90, 0x00})
+	var audio [][]byte
+	for i := 0; i < frames; i++ {
+		f := append([]byte{}, frame...)
+		if i == 0 && xing {
+			copy(f[36:], concat([]byte("Xing"), be32(1), be32(1000)))
+		}
+		audio = append(audio, f)
+	}
+	return concat(header, tag, concat(audio...))
+}
+
+func testFLAC() []byte {
+	streamInfo := make([]byte, 34)
+	// 44100Hz, 2 channels, 16 bits, 441000 samples
+	v := uint64(44100)<<44 | uint64(1)<<41 | uint64(15)<<36 | 441000
+	binary.BigEndian.PutUint64(streamInfo[10:18], v)
+	comments := concat(le32(6), []byte("vendor"), le32(2),
+		le32(11), []byte("TITLE=Track"),
+		le32(13), []byte("TRACKNUMBER=4"),
+	)
+	return concat(
+		[]byte("fLaC"),
+		[]byte{0x00, 0, 0, 34}, streamInfo,
+		[]byte{0x84, 0, 0, byte(len(comments))}, comments,
+		make([]byte, 20000),
+	)
+}
+
+func probe(data []byte) (*Info, error) {
+	return Probe(bytes.NewReader(data), int64(len(data)))
+}
+
+func TestProbe(t *testing.T) {
+
+	Convey("Test MP4 metadata", t, func() {
+		info, e := probe(testMP4())
+		So(e, ShouldBeNil)
+		So(info.Format, ShouldEqual, "mp4")
+		So(info.Duration, ShouldEqual, 12.5)
+		So(info.VideoCodec, ShouldEqual, "h264")
+		So(info.Width, ShouldEqual, 1920)
+		So(info.Height, ShouldEqual, 1080)
+		So(info.AudioCodec, ShouldEqual, "aac")
+		So(info.SampleRate, ShouldEqual, 48000)
+		So(info.Channels, ShouldEqual, 2)
+		So(info.Bitrate, ShouldBeGreaterThan, 0)
+		So(info.Location, ShouldResemble, &Location{Lat: 48.8577, Lon: 2.295})
+		So(info.Tags, ShouldResemble, map[string]string{"title": "Holidays", "track": "3"})
+		So(info.HasVideo(), ShouldBeTrue)
+	})
+
+	Convey("Test QuickTime metadata keys", t, func() {
+		info, e := probe(testQuickTime())
+		So(e, ShouldBeNil)
+		So(info.Format, ShouldEqual, "mov")
+		So(info.Duration, ShouldEqual, 2)
+		So(info.Location, ShouldResemble, &Location{Lat: -33.8688, Lon: 151.2093})
+		So(info.Created, ShouldNotBeNil)
+		So(info.Created.UTC(), ShouldResemble, time.Date(2018, 7, 14, 8, 30, 0, 0, time.UTC))
+	})
+
+	Convey("Test Matroska metadata", t, func() {
+		info, e := probe(testMatroska())
+		So(e, ShouldBeNil)
+		So(info.Format, ShouldEqual, "webm")
+		So(info.Duration, ShouldEqual, 5)
+		So(info.VideoCodec, ShouldEqual, "vp9")
+		So(info.Width, ShouldEqual, 640)
+		So(info.Height, ShouldEqual, 360)
+		So(info.AudioCodec, ShouldEqual, "opus")
+		So(info.SampleRate, ShouldEqual, 48000)
+		So(info.Channels, ShouldEqual, 2)
+		So(info.Tags, ShouldResemble, map[string]string{"title": "A movie", "artist": "Someone"})
+		So(info.Location, ShouldResemble, &Location{Lat: 45.1885, Lon: 5.7245})
+	})
+
+	Convey("Test Matroska probe stops at the first Cluster and seeks to Tags", t, func() {
+		data, clustersStart, clustersEnd := testMatroskaLayout()
+		// Only the 12 bytes header of the first Cluster may be read
+		r := &guardedReader{Reader: bytes.NewReader(data), from: int64(clustersStart + 12), to: int64(clustersEnd)}
+		info, e := Probe(r, int64(len(data)))
+		So(e, ShouldBeNil)
+		So(info.VideoCodec, ShouldEqual, "vp9")
+		So(info.Tags, ShouldResemble, map[string]string{"title": "A movie", "artist": "Someone"})
+		So(info.Location, ShouldResemble, &Location{Lat: 45.1885, Lon: 5.7245})
+	})
+
+	Convey("Test MP3 with constant bitrate", t, func() {
+		info, e := probe(testMP3(300, false))
+		So(e, ShouldBeNil)
+		So(info.Format, ShouldEqual, "mp3")
+		So(info.AudioCodec, ShouldEqual, "mp3")
+		So(info.SampleRate, ShouldEqual, 44100)
+		So(info.Channels, ShouldEqual, 2)
+		So(info.Bitrate, ShouldEqual, 128000)
+		So(info.Duration, ShouldAlmostEqual, 300*417*8/128000.0, 0.01)
+		So(info.HasVideo(), ShouldBeFalse)
+		So(info.Tags, ShouldResemble, map[string]string{
+			"title":   "Song",
+			"artist":  "Björk",
+			"genre":   "Rock",
+			"comment": "Nice one",
+		})
+	})
+
+	Convey("Test MP3 with Xing header", t, func() {
+		info, e := probe(testMP3(10, true))
+		So(e, ShouldBeNil)
+		So(info.Duration, ShouldAlmostEqual, 1000*1152/44100.0, 0.001)
+	})
+
+	Convey("Test FLAC metadata", t, func() {
+		info, e := probe(testFLAC())
+		So(e, ShouldBeNil)
+		So(info.Format, ShouldEqual, "flac")
+		So(info.SampleRate, ShouldEqual, 44100)
+		So(info.Channels, ShouldEqual, 2)
+		So(info.Duration, ShouldEqual, 10)
+		So(info.Tags, ShouldResemble, map[string]string{"title": "Track", "track": "4"})
+	})
+
+	Convey("Test unsupported content", t, func() {
+		_, e := probe([]byte("just some text content"))
+		So(e, ShouldEqual, ErrUnsupportedFormat)
+		_, e = probe([]byte("tiny"))
+		So(e, ShouldNotBeNil)
+	})
+
+	Convey("Test truncated content does not panic", t, func() {
+		for _, data := range [][]byte{testMP4(), testQuickTime(), testMatroska(), testMP3(3, true), testFLAC()} {
+			for _, l := range []int{13, 40, 100, 200, len(data) / 2} {
+				So(func() { probe(data[:l]) }, ShouldNotPanic)
+			}
+		}
+	})
+
+	Convey("Test ISO 6709 locations", t, func() {
+		So(parseISO6709("+40.6894-074.0447/"), ShouldResemble, &Location{Lat: 40.6894, Lon: -74.0447})
+		So(parseISO6709("+95.0000+000.0000/"), ShouldBeNil)
+		So(parseISO6709("garbage"), ShouldBeNil)
+	})
+
+}
@@ -0,0 +1,467 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package media
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"strings"
+	"time"
+)
+
+const (
+	ebmlHeaderID = 0x1A45DFA3
+
+	mkvDocType         = 0x4282
+	mkvSegment         = 0x18538067
+	mkvSeekHead        = 0x114D9B74
+	mkvSeek            = 0x4DBB
+	mkvSeekID          = 0x53AB
+	mkvSeekPosition    = 0x53AC
+	mkvInfo            = 0x1549A966
+	mkvTimecodeScale   = 0x2AD7B1
+	mkvDuration        = 0x4489
+	mkvDateUTC         = 0x4461
+	mkvTitle           = 0x7BA9
+	mkvTracks          = 0x1654AE6B
+	mkvTrackEntry      = 0xAE
+	mkvTrackType       = 0x83
+	mkvCodecID         = 0x86
+	mkvVideo           = 0xE0
+	mkvPixelWidth      = 0xB0
+	mkvPixelHeight     = 0xBA
+	mkvAudio           = 0xE1
+	mkvSamplingFreq    = 0xB5
+	mkvChannels        = 0x9F
+	mkvTags            = 0x1254C367
+	mkvTag             = 0x7373
+	mkvSimpleTag       = 0x67C8
+	mkvTagName         = 0x45A3
+	mkvTagString       = 0x4487
+	mkvCluster         = 0x1F43B675
+	mkvTrackTypeVideo  = 1
+	mkvTrackTypeAudio  = 2
+	mkvMaxHeaderLength = 12
+)
+
+var (
+	mkvEpoch   = time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)
+	errMkvStop = errors.New("stop")
+
+	mkvCodecs = map[string]string{
+		"V_MPEG4/ISO/AVC":  "h264",
+		"V_MPEGH/ISO/HEVC": "hevc",
+		"V_MPEG4/ISO/SP":   "mpeg4",
+		"V_MPEG4/ISO/ASP":  "mpeg4",
+		"V_MPEG2":          "mpeg2",
+		"V_VP8":            "vp8",
+		"V_VP9":            "vp9",
+		"V_AV1":            "av1",
+		"V_THEORA":         "theora",
+		"V_MJPEG":          "mjpeg",
+		"A_OPUS":           "opus",
+		"A_VORBIS":         "vorbis",
+		"A_MPEG/L3":        "mp3",
+		"A_FLAC":           "flac",
+		"A_AC3":            "ac3",
+		"A_EAC3":           "eac3",
+		"A_DTS":            "dts",
+		"A_TRUEHD":         "truehd",
+		"A_PCM/INT/LIT":    "pcm",
+	}
+
+	mkvTagNames = map[string]string{
+		"DATE_RECORDED": "date",
+		"DATE_RELEASED": "date",
+		"PART_NUMBER":   "track",
+	}
+)
+
+type mkvParser struct {
+	r    io.ReaderAt
+	size int64
+	info *Info
+
+	// segmentStart is the offset of the Segment data, that SeekHead positions are relative to.
+	// seeks holds the offsets of the top-level elements found in SeekHeads, and parsed the elements already read.
+	segmentStart int64
+	seeks        map[uint32][]int64
+	parsed       map[int64]bool
+
+	timecodeScale uint64
+	duration      float64
+}
+
+type mkvTrack struct {
+	trackType  uint64
+	codec      string
+	width      int
+	height     int
+	sampleRate int
+	channels   int
+}
+
+// probeMatroska reads a Matroska or WebM file.
+func probeMatroska(r io.ReaderAt, size int64) (*Info, error) {
+	p := &mkvParser{
+		r:             r,
+		size:          size,
+		info:          &Info{Format: "mkv"},
+		timecodeScale: 1000000,
+		seeks:         make(map[uint32][]int64),
+		parsed:        make(map[int64]bool),
+	}
+	e := p.walk(0, size, func(id uint32, start int64, end int64) error {
+		switch id {
+		case ebmlHeaderID:
+			return p.walk(start, end, func(id uint32, start int64, end int64) error {
+				if id == mkvDocType {
+					if docType, e := p.readString(start, end); e == nil && docType == "webm" {
+						p.info.Format = "webm"
+					}
+				}
+				return nil
+			})
+		case mkvSegment:
+			p.segmentStart = start
+			if e := p.walk(start, end, p.segment); e != nil && e != errMkvStop {
+				return e
+			}
+			if e := p.followSeeks(end); e != nil && e != errMkvStop {
+				return e
+			}
+			return errMkvStop
+		}
+		return nil
+	})
+	if e != nil && e != errMkvStop {
+		return nil, e
+	}
+	if p.duration > 0 {
+		p.info.Duration = p.duration * float64(p.timecodeScale) / 1e9
+	}
+	return p.info, nil
+}
+
+// walk iterates over the elements found between start and end. Elements of unknown size extend to end.
+func (p *mkvParser) walk(start int64, end int64, fn func(id uint32, start int64, end int64) error) error {
+	for offset := start; offset < end; {
+		l := end - offset
+		if l > mkvMaxHeaderLength {
+			l = mkvMaxHeaderLength
+		}
+		header, e := readAt(p.r, offset, l)
+		if e != nil {
+			return e
+		}
+		id, idLength := readVint(header, true)
+		if idLength == 0 {
+			return nil
+		}
+		size, sizeLength := readVint(header[idLength:], false)
+		if sizeLength == 0 {
+			return nil
+		}
+		dataStart := offset + int64(idLength+sizeLength)
+		dataEnd := end
+		if size != unknownVintSize(sizeLength) && dataStart+int64(size) <= end {
+			dataEnd = dataStart + int64(size)
+		} else if id == mkvCluster {
+			// Clusters of unknown size cannot be skipped without parsing their content
+			return errMkvStop
+		}
+		if e := fn(uint32(id), dataStart, dataEnd); e != nil {
+			return e
+		}
+		offset = dataEnd
+	}
+	return nil
+}
+
+// segment reads the top-level elements of the Segment until the first Cluster. Elements stored after
+// the Clusters, typically Tags, are only reached through the SeekHead, so that media data is never scanned.
+func (p *mkvParser) segment(id uint32, start int64, end int64) error {
+	if p.parsed[start] {
+		return nil
+	}
+	p.parsed[start] = true
+	switch id {
+	case mkvCluster:
+		return errMkvStop
+	case mkvSeekHead:
+		return p.walk(start, end, func(id uint32, start int64, end int64) error {
+			if id == mkvSeek {
+				return p.seek(start, end)
+			}
+			return nil
+		})
+	case mkvInfo:
+		return p.walk(start, end, func(id uint32, start int64, end int64) error {
+			switch id {
+			case mkvTimecodeScale:
+				if v, e := p.readUint(start, end); e == nil && v > 0 {
+					p.timecodeScale = v
+				}
+			case mkvDuration:
+				p.duration, _ = p.readFloat(start, end)
+			case mkvDateUTC:
+				if v, e := p.readUint(start, end); e == nil {
+					t := mkvEpoch.Add(time.Duration(int64(v)))
+					p.info.Created = &t
+				}
+			case mkvTitle:
+				if s, e := p.readString(start, end); e == nil {
+					p.info.setTag("title", s)
+				}
+			}
+			return nil
+		})
+	case mkvTracks:
+		return p.walk(start, end, func(id uint32, start int64, end int64) error {
+			if id != mkvTrackEntry {
+				return nil
+			}
+			track := &mkvTrack{}
+			if e := p.walk(start, end, func(id uint32, start int64, end int64) error {
+				return p.trackEntry(track, id, start, end)
+			}); e != nil {
+				return e
+			}
+			p.addTrack(track)
+			return nil
+		})
+	case mkvTags:
+		return p.walk(start, end, func(id uint32, start int64, end int64) error {
+			if id != mkvTag {
+				return nil
+			}
+			return p.walk(start, end, func(id uint32, start int64, end int64) error {
+				if id == mkvSimpleTag {
+					return p.simpleTag(start, end)
+				}
+				return nil
+			})
+		})
+	}
+	return nil
+}
+
+// seek records the position of a top-level element listed in a SeekHead.
+func (p *mkvParser) seek(start int64, end int64) error {
+	var target uint32
+	var position uint64
+	var hasPosition bool
+	e := p.walk(start, end, func(id uint32, start int64, end int64) error {
+		switch id {
+		case mkvSeekID:
+			if v, e := p.readUint(start, end); e == nil {
+				target = uint32(v)
+			}
+		case mkvSeekPosition:
+			if v, e := p.readUint(start, end); e == nil {
+				position, hasPosition = v, true
+			}
+		}
+		return nil
+	})
+	if e != nil || !hasPosition {
+		return e
+	}
+	switch target {
+	case mkvSeekHead, mkvInfo, mkvTracks, mkvTags:
+		p.seeks[target] = append(p.seeks[target], p.segmentStart+int64(position))
+	}
+	return nil
+}
+
+// followSeeks reads the elements referenced by the SeekHeads that were not met before the first Cluster.
+// Further SeekHeads are read first, as they may list more elements.
+func (p *mkvParser) followSeeks(end int64) error {
+	for _, target := range []uint32{mkvSeekHead, mkvInfo, mkvTracks, mkvTags} {
+		for i := 0; i < len(p.seeks[target]); i++ {
+			offset := p.seeks[target][i]
+			if offset <= p.segmentStart || offset >= end {
+				continue
+			}
+			e := p.walk(offset, end, func(id uint32, start int64, elementEnd int64) error {
+				if id != target {
+					return errMkvStop
+				}
+				if e := p.segment(id, start, elementEnd); e != nil {
+					return e
+				}
+				return errMkvStop
+			})
+			if e != nil && e != errMkvStop {
+				return e
+			}
+		}
+	}
+	return nil
+}
+
+func (p *mkvParser) trackEntry(track *mkvTrack, id uint32, start int64, end int64) error {
+	switch id {
+	case mkvTrackType:
+		track.trackType, _ = p.readUint(start, end)
+	case mkvCodecID:
+		codecID, _ := p.readString(start, end)
+		if codec, ok := mkvCodecs[codecID]; ok {
+			track.codec = codec
+		} else if strings.HasPrefix(codecID, "A_AAC") {
+			track.codec = "aac"
+		} else if len(codecID) > 2 {
+			track.codec = strings.ToLower(codecID[2:])
+		}
+	case mkvVideo, mkvAudio:
+		return p.walk(start, end, func(id uint32, start int64, end int64) error {
+			return p.trackEntry(track, id, start, end)
+		})
+	case mkvPixelWidth:
+		v, _ := p.readUint(start, end)
+		track.width = int(v)
+	case mkvPixelHeight:
+		v, _ := p.readUint(start, end)
+		track.height = int(v)
+	case mkvSamplingFreq:
+		v, _ := p.readFloat(start, end)
+		track.sampleRate = int(v)
+	case mkvChannels:
+		v, _ := p.readUint(start, end)
+		track.channels = int(v)
+	}
+	return nil
+}
+
+func (p *mkvParser) addTrack(track *mkvTrack) {
+	switch track.trackType {
+	case mkvTrackTypeVideo:
+		if p.info.VideoCodec == "" {
+			p.info.VideoCodec = track.codec
+			p.info.Width, p.info.Height = track.width, track.height
+		}
+	case mkvTrackTypeAudio:
+		if p.info.AudioCodec == "" {
+			p.info.AudioCodec = track.codec
+			p.info.SampleRate, p.info.Channels = track.sampleRate, track.channels
+			if p.info.Channels == 0 {
+				// Default value of the specification
+				p.info.Channels = 1
+			}
+		}
+	}
+}
+
+func (p *mkvParser) simpleTag(start int64, end int64) error {
+	var name, value string
+	e := p.walk(start, end, func(id uint32, start int64, end int64) error {
+		switch id {
+		case mkvTagName:
+			name, _ = p.readString(start, end)
+		case mkvTagString:
+			value, _ = p.readString(start, end)
+		}
+		return nil
+	})
+	if e != nil || name == "" {
+		return e
+	}
+	name = strings.ToUpper(name)
+	switch name {
+	case "LOCATION", "COM.APPLE.QUICKTIME.LOCATION.ISO6709":
+		if loc := parseISO6709(value); loc != nil {
+			p.info.Location = loc
+		}
+		return nil
+	}
+	if mapped, ok := mkvTagNames[name]; ok {
+		p.info.setTag(mapped, value)
+	} else {
+		p.info.setTag(strings.ToLower(name), value)
+	}
+	return nil
+}
+
+func (p *mkvParser) readUint(start int64, end int64) (uint64, error) {
+	if end-start > 8 {
+		return 0, errors.New("invalid integer element")
+	}
+	data, e := readAt(p.r, start, end-start)
+	if e != nil {
+		return 0, e
+	}
+	var v uint64
+	for _, b := range data {
+		v = v<<8 | uint64(b)
+	}
+	return v, nil
+}
+
+func (p *mkvParser) readFloat(start int64, end int64) (float64, error) {
+	data, e := readAt(p.r, start, end-start)
+	if e != nil {
+		return 0, e
+	}
+	switch len(data) {
+	case 4:
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(data))), nil
+	case 8:
+		return math.Float64frombits(binary.BigEndian.Uint64(data)), nil
+	}
+	return 0, errors.New("invalid float element")
+}
+
+func (p *mkvParser) readString(start int64, end int64) (string, error) {
+	data, e := readAt(p.r, start, end-start)
+	if e != nil {
+		return "", e
+	}
+	return strings.TrimRight(string(data), "\x00"), nil
+}
+
+// readVint decodes an EBML variable length integer. IDs keep their length marker.
+func readVint(data []byte, keepMarker bool) (uint64, int) {
+	if len(data) == 0 || data[0] == 0 {
+		return 0, 0
+	}
+	length := 1
+	for mask := byte(0x80); data[0]&mask == 0; mask >>= 1 {
+		length++
+	}
+	if length > len(data) || (keepMarker && length > 4) {
+		return 0, 0
+	}
+	v := uint64(data[0])
+	if !keepMarker {
+		v &= uint64(0xFF >> uint(length))
+	}
+	for _, b := range data[1:length] {
+		v = v<<8 | uint64(b)
+	}
+	return v, length
+}
+
+// unknownVintSize is the reserved value (all bits set) meaning that an element size is unknown.
+func unknownVintSize(length int) uint64 {
+	return 1<<uint(7*length) - 1
+}
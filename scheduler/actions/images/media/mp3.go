@@ -0,0 +1,359 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package media
+
+import (
+	"encoding/binary"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+var (
+	mp3Bitrates = map[bool][16]int{
+		// MPEG-1 Layer III
+		true: {0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0},
+		// MPEG-2 and 2.5 Layer III
+		false: {0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0},
+	}
+	mp3SampleRates = map[byte][3]int{
+		3: {44100, 48000, 32000}, // MPEG-1
+		2: {22050, 24000, 16000}, // MPEG-2
+		0: {11025, 12000, 8000},  // MPEG-2.5
+	}
+
+	// ID3v2.3/2.4 frames, then ID3v2.2 frames
+	id3Frames = map[string]string{
+		"TIT2": "title",
+		"TPE1": "artist",
+		"TPE2": "albumartist",
+		"TALB": "album",
+		"TYER": "date",
+		"TDRC": "date",
+		"TCON": "genre",
+		"TRCK": "track",
+		"TCOM": "composer",
+		"TSSE": "encoder",
+		"COMM": "comment",
+		"TT2":  "title",
+		"TP1":  "artist",
+		"TP2":  "albumartist",
+		"TAL":  "album",
+		"TYE":  "date",
+		"TCO":  "genre",
+		"TRK":  "track",
+		"TCM":  "composer",
+		"COM":  "comment",
+	}
+
+	id3NumericGenre = regexp.MustCompile(`^\((\d+)\)$`)
+)
+
+// mp3Frame is the decoded header of an MPEG audio frame.
+type mp3Frame struct {
+	mpeg1      bool
+	bitrate    int
+	sampleRate int
+	channels   int
+	size       int
+}
+
+// probeMP3 reads the ID3 tags and the first MPEG frames of an MP3 file.
+func probeMP3(r io.ReaderAt, size int64) (*Info, error) {
+	info := &Info{Format: "mp3", AudioCodec: "mp3"}
+
+	audioStart := int64(0)
+	if header, e := readAt(r, 0, 10); e == nil && string(header[:3]) == "ID3" {
+		audioStart = int64(id3Size(header)) + 10
+		if header[5]&0x10 != 0 {
+			// Footer present
+			audioStart += 10
+		}
+		if body, e := readAt(r, 10, audioStart-10); e == nil {
+			readID3v2(info, header[3], header[5], body)
+		}
+	}
+	audioEnd := size
+	if tag, e := readAt(r, size-128, 128); e == nil && string(tag[:3]) == "TAG" {
+		audioEnd -= 128
+		readID3v1(info, tag)
+	}
+
+	// Look for the first frame, skipping padding after the tag
+	buf, e := readAt(r, audioStart, minInt64(64*1024, audioEnd-audioStart))
+	if e != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	for i := 0; i+4 <= len(buf); i++ {
+		frame, ok := parseMP3Frame(buf[i:])
+		if !ok {
+			continue
+		}
+		// Check the next frame to avoid false sync
+		if next := i + frame.size; next+4 <= len(buf) {
+			if _, nextOk := parseMP3Frame(buf[next:]); !nextOk {
+				continue
+			}
+		}
+		info.SampleRate = frame.sampleRate
+		info.Channels = frame.channels
+		samplesPerFrame := 1152
+		if !frame.mpeg1 {
+			samplesPerFrame = 576
+		}
+		if frames := vbrFrames(buf[i:], frame); frames > 0 {
+			info.Duration = float64(frames) * float64(samplesPerFrame) / float64(frame.sampleRate)
+			info.Bitrate = int64(float64(audioEnd-audioStart-int64(i)) * 8 / info.Duration)
+		} else {
+			// Constant bitrate
+			info.Bitrate = int64(frame.bitrate)
+			info.Duration = float64(audioEnd-audioStart-int64(i)) * 8 / float64(frame.bitrate)
+		}
+		return info, nil
+	}
+	if len(info.Tags) > 0 {
+		return info, nil
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+// parseMP3Frame decodes a Layer III frame header.
+func parseMP3Frame(data []byte) (*mp3Frame, bool) {
+	if len(data) < 4 || data[0] != 0xFF || data[1]&0xE0 != 0xE0 {
+		return nil, false
+	}
+	version := (data[1] >> 3) & 0x03
+	layer := (data[1] >> 1) & 0x03
+	bitrateIndex := data[2] >> 4
+	rateIndex := (data[2] >> 2) & 0x03
+	if version == 1 || layer != 1 || bitrateIndex == 0 || bitrateIndex == 15 || rateIndex == 3 {
+		return nil, false
+	}
+	f := &mp3Frame{mpeg1: version == 3, channels: 2}
+	f.bitrate = mp3Bitrates[f.mpeg1][bitrateIndex] * 1000
+	f.sampleRate = mp3SampleRates[version][rateIndex]
+	if data[3]>>6 == 3 {
+		f.channels = 1
+	}
+	padding := int((data[2] >> 1) & 0x01)
+	if f.mpeg1 {
+		f.size = 144*f.bitrate/f.sampleRate + padding
+	} else {
+		f.size = 72*f.bitrate/f.sampleRate + padding
+	}
+	return f, f.size > 4
+}
+
+// vbrFrames reads the number of frames from a Xing/Info or VBRI header stored in the first frame.
+func vbrFrames(data []byte, f *mp3Frame) int {
+	var sideInfo int
+	switch {
+	case f.mpeg1 && f.channels == 2:
+		sideInfo = 32
+	case f.mpeg1, f.channels == 2:
+		sideInfo = 17
+	default:
+		sideInfo = 9
+	}
+	if x := 4 + sideInfo; len(data) >= x+12 {
+		tag := string(data[x : x+4])
+		if (tag == "Xing" || tag == "Info") && binary.BigEndian.Uint32(data[x+4:])&0x01 != 0 {
+			return int(binary.BigEndian.Uint32(data[x+8:]))
+		}
+	}
+	if v := 36; len(data) >= v+18 && string(data[v:v+4]) == "VBRI" {
+		return int(binary.BigEndian.Uint32(data[v+14:]))
+	}
+	return 0
+}
+
+// id3Size reads the syncsafe size of an ID3v2 tag header.
+func id3Size(header []byte) int {
+	return syncsafe(header[6:10])
+}
+
+func syncsafe(b []byte) int {
+	return int(b[0]&0x7F)<<21 | int(b[1]&0x7F)<<14 | int(b[2]&0x7F)<<7 | int(b[3]&0x7F)
+}
+
+func readID3v2(info *Info, version byte, flags byte, body []byte) {
+	if flags&0x80 != 0 {
+		// Unsynchronisation is not supported
+		return
+	}
+	offset := 0
+	if flags&0x40 != 0 && version >= 3 && len(body) >= 4 {
+		// Skip extended header
+		if version == 4 {
+			offset = syncsafe(body[:4])
+		} else {
+			offset = int(binary.BigEndian.Uint32(body[:4])) + 4
+		}
+	}
+	idLength, headerLength := 4, 10
+	if version == 2 {
+		idLength, headerLength = 3, 6
+	}
+	for offset+headerLength <= len(body) {
+		header := body[offset : offset+headerLength]
+		if header[0] == 0 {
+			// Padding
+			return
+		}
+		id := string(header[:idLength])
+		var size int
+		switch version {
+		case 2:
+			size = int(header[3])<<16 | int(header[4])<<8 | int(header[5])
+		case 3:
+			size = int(binary.BigEndian.Uint32(header[4:8]))
+		default:
+			size = syncsafe(header[4:8])
+		}
+		offset += headerLength
+		if size <= 0 || offset+size > len(body) {
+			return
+		}
+		if key, ok := id3Frames[id]; ok {
+			frame := body[offset : offset+size]
+			if key == "comment" {
+				readID3Comment(info, frame)
+			} else {
+				value := decodeID3Text(frame[0], frame[1:])
+				if key == "genre" {
+					value = id3Genre(value)
+				}
+				info.setTag(key, value)
+			}
+		}
+		offset += size
+	}
+}
+
+// readID3Comment reads a COMM frame: encoding, language, short description and text.
+func readID3Comment(info *Info, frame []byte) {
+	if len(frame) < 5 {
+		return
+	}
+	encoding := frame[0]
+	rest := frame[4:]
+	// Skip the description, terminated by a null character of the encoding size
+	terminator := []byte{0}
+	if encoding == 1 || encoding == 2 {
+		terminator = []byte{0, 0}
+	}
+	for i := 0; i+len(terminator) <= len(rest); i += len(terminator) {
+		if string(rest[i:i+len(terminator)]) == string(terminator) {
+			info.setTag("comment", decodeID3Text(encoding, rest[i+len(terminator):]))
+			return
+		}
+	}
+}
+
+// decodeID3Text decodes a text value, keeping the first one if several are null-separated.
+func decodeID3Text(encoding byte, data []byte) string {
+	switch encoding {
+	case 0:
+		runes := make([]rune, 0, len(data))
+		for _, b := range data {
+			if b == 0 {
+				break
+			}
+			runes = append(runes, rune(b))
+		}
+		return string(runes)
+	case 1, 2:
+		bigEndian := encoding == 2
+		if len(data) >= 2 && data[0] == 0xFF && data[1] == 0xFE {
+			bigEndian, data = false, data[2:]
+		} else if len(data) >= 2 && data[0] == 0xFE && data[1] == 0xFF {
+			bigEndian, data = true, data[2:]
+		}
+		var units []uint16
+		for i := 0; i+1 < len(data); i += 2 {
+			var u uint16
+			if bigEndian {
+				u = binary.BigEndian.Uint16(data[i:])
+			} else {
+				u = binary.LittleEndian.Uint16(data[i:])
+			}
+			if u == 0 {
+				break
+			}
+			units = append(units, u)
+		}
+		return string(utf16.Decode(units))
+	default:
+		if i := strings.IndexByte(string(data), 0); i >= 0 {
+			data = data[:i]
+		}
+		return string(data)
+	}
+}
+
+// id3Genre resolves genres referenced by their ID3v1 number, e.g. (17).
+func id3Genre(value string) string {
+	if m := id3NumericGenre.FindStringSubmatch(value); m != nil {
+		if index, e := strconv.Atoi(m[1]); e == nil && index < len(id3v1Genres) {
+			return id3v1Genres[index]
+		}
+	}
+	return value
+}
+
+func readID3v1(info *Info, tag []byte) {
+	field := func(from int, to int) string {
+		return decodeID3Text(0, tag[from:to])
+	}
+	info.setTag("title", field(3, 33))
+	info.setTag("artist", field(33, 63))
+	info.setTag("album", field(63, 93))
+	info.setTag("date", field(93, 97))
+	info.setTag("comment", field(97, 127))
+	// ID3v1.1 stores the track number in the last byte of the comment
+	if tag[125] == 0 && tag[126] != 0 {
+		info.setTag("track", strconv.Itoa(int(tag[126])))
+	}
+	if int(tag[127]) < len(id3v1Genres) {
+		info.setTag("genre", id3v1Genres[tag[127]])
+	}
+}
+
+func minInt64(a int64, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// id3v1Genres are the standard genres referenced by number.
+var id3v1Genres = []string{
+	"Blues", "Classic Rock", "Country", "Dance", "Disco", "Funk", "Grunge", "Hip-Hop", "Jazz", "Metal",
+	"New Age", "Oldies", "Other", "Pop", "R&B", "Rap", "Reggae", "Rock", "Techno", "Industrial",
+	"Alternative", "Ska", "Death Metal", "Pranks", "Soundtrack", "Euro-Techno", "Ambient", "Trip-Hop", "Vocal", "Jazz+Funk",
+	"Fusion", "Trance", "Classical", "Instrumental", "Acid", "House", "Game", "Sound Clip", "Gospel", "Noise",
+	"AlternRock", "Bass", "Soul", "Punk", "Space", "Meditative", "Instrumental Pop", "Instrumental Rock", "Ethnic", "Gothic",
+	"Darkwave", "Techno-Industrial", "Electronic", "Pop-Folk", "Eurodance", "Dream", "Southern Rock", "Comedy", "Cult", "Gangsta",
+	"Top 40", "Christian Rap", "Pop/Funk", "Jungle", "Native American", "Cabaret", "New Wave", "Psychadelic", "Rave", "Showtunes",
+	"Trailer", "Lo-Fi", "Tribal", "Acid Punk", "Acid Jazz", "Polka", "Retro", "Musical", "Rock & Roll", "Hard Rock",
+}
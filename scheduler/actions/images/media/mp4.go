@@ -0,0 +1,393 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package media
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	mp4Epoch = time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mp4Codecs = map[string]string{
+		"avc1": "h264",
+		"avc3": "h264",
+		"hvc1": "hevc",
+		"hev1": "hevc",
+		"mp4v": "mpeg4",
+		"vp09": "vp9",
+		"av01": "av1",
+		"jpeg": "mjpeg",
+		"apcn": "prores",
+		"apch": "prores",
+		"mp4a": "aac",
+		"ac-3": "ac3",
+		"ec-3": "eac3",
+		"Opus": "opus",
+		"fLaC": "flac",
+		"alac": "alac",
+		".mp3": "mp3",
+		"samr": "amr",
+	}
+
+	// iTunes-style tags of the ilst box
+	mp4Tags = map[string]string{
+		"\xa9nam": "title",
+		"\xa9ART": "artist",
+		"aART":    "albumartist",
+		"\xa9alb": "album",
+		"\xa9day": "date",
+		"\xa9gen": "genre",
+		"\xa9cmt": "comment",
+		"\xa9wrt": "composer",
+		"\xa9too": "encoder",
+		"desc":    "description",
+	}
+)
+
+type mp4Parser struct {
+	r    io.ReaderAt
+	info *Info
+	// keys of a QuickTime metadata box, referenced by index in its ilst
+	keys []string
+}
+
+type mp4Track struct {
+	handler    string
+	codec      string
+	width      int
+	height     int
+	sampleRate int
+	channels   int
+}
+
+// probeMP4 reads an ISO base media file (MP4, M4A, MOV...).
+func probeMP4(r io.ReaderAt, size int64) (*Info, error) {
+	p := &mp4Parser{r: r, info: &Info{Format: "mp4"}}
+	ftyp, e := readAt(r, 8, 4)
+	if e != nil {
+		return nil, e
+	}
+	if string(ftyp) == "qt  " {
+		p.info.Format = "mov"
+	}
+	e = p.walk(0, size, func(typ string, start, end int64) error {
+		if typ == "moov" {
+			return p.moov(start, end)
+		}
+		return nil
+	})
+	if e != nil {
+		return nil, e
+	}
+	return p.info, nil
+}
+
+// walk iterates over the boxes found between start and end.
+func (p *mp4Parser) walk(start int64, end int64, fn func(typ string, start int64, end int64) error) error {
+	for offset := start; offset+8 <= end; {
+		header, e := readAt(p.r, offset, 8)
+		if e != nil {
+			return e
+		}
+		boxSize := int64(binary.BigEndian.Uint32(header))
+		typ := string(header[4:8])
+		headerSize := int64(8)
+		switch boxSize {
+		case 0:
+			boxSize = end - offset
+		case 1:
+			large, e := readAt(p.r, offset+8, 8)
+			if e != nil {
+				return e
+			}
+			boxSize = int64(binary.BigEndian.Uint64(large))
+			headerSize = 16
+		}
+		if boxSize < headerSize || offset+boxSize > end {
+			// Truncated or corrupted box, keep what was found so far
+			return nil
+		}
+		if e := fn(typ, offset+headerSize, offset+boxSize); e != nil {
+			return e
+		}
+		offset += boxSize
+	}
+	return nil
+}
+
+func (p *mp4Parser) moov(start int64, end int64) error {
+	return p.walk(start, end, func(typ string, start int64, end int64) error {
+		switch typ {
+		case "mvhd":
+			timescale, duration, created, e := p.header(start, end)
+			if e != nil {
+				return e
+			}
+			if timescale > 0 {
+				p.info.Duration = float64(duration) / float64(timescale)
+			}
+			if created > 0 && p.info.Created == nil {
+				t := mp4Epoch.Add(time.Duration(created) * time.Second)
+				p.info.Created = &t
+			}
+		case "trak":
+			track := &mp4Track{}
+			if e := p.trak(start, end, track); e != nil {
+				return e
+			}
+			p.addTrack(track)
+		case "udta":
+			return p.udta(start, end)
+		case "meta":
+			return p.meta(start, end)
+		}
+		return nil
+	})
+}
+
+// header reads a mvhd or mdhd box.
+func (p *mp4Parser) header(start int64, end int64) (timescale uint32, duration uint64, created uint64, e error) {
+	data, e := readAt(p.r, start, end-start)
+	if e != nil {
+		return
+	}
+	if len(data) >= 32 && data[0] == 1 {
+		created = binary.BigEndian.Uint64(data[4:12])
+		timescale = binary.BigEndian.Uint32(data[20:24])
+		duration = binary.BigEndian.Uint64(data[24:32])
+	} else if len(data) >= 20 {
+		created = uint64(binary.BigEndian.Uint32(data[4:8]))
+		timescale = binary.BigEndian.Uint32(data[12:16])
+		duration = uint64(binary.BigEndian.Uint32(data[16:20]))
+	}
+	return
+}
+
+func (p *mp4Parser) trak(start int64, end int64, track *mp4Track) error {
+	return p.walk(start, end, func(typ string, start int64, end int64) error {
+		switch typ {
+		case "tkhd":
+			data, e := readAt(p.r, start, end-start)
+			if e != nil || len(data) == 0 {
+				return e
+			}
+			// Width and height are 16.16 fixed-point values at the end of the box
+			offset := 76
+			if data[0] == 1 {
+				offset = 88
+			}
+			if len(data) >= offset+8 {
+				track.width = int(binary.BigEndian.Uint32(data[offset:]) >> 16)
+				track.height = int(binary.BigEndian.Uint32(data[offset+4:]) >> 16)
+			}
+		case "mdia", "minf", "stbl":
+			return p.trak(start, end, track)
+		case "hdlr":
+			data, e := readAt(p.r, start, end-start)
+			if e != nil {
+				return e
+			}
+			if len(data) >= 12 {
+				track.handler = string(data[8:12])
+			}
+		case "stsd":
+			return p.stsd(start, end, track)
+		}
+		return nil
+	})
+}
+
+// stsd reads the first sample description of a track.
+func (p *mp4Parser) stsd(start int64, end int64, track *mp4Track) error {
+	data, e := readAt(p.r, start, end-start)
+	if e != nil {
+		return e
+	}
+	if len(data) < 16 {
+		return nil
+	}
+	entry := data[8:]
+	format := string(entry[4:8])
+	if codec, ok := mp4Codecs[format]; ok {
+		track.codec = codec
+	} else {
+		track.codec = strings.ToLower(strings.TrimSpace(format))
+	}
+	switch track.handler {
+	case "vide":
+		if len(entry) >= 36 && track.width == 0 {
+			track.width = int(binary.BigEndian.Uint16(entry[32:34]))
+			track.height = int(binary.BigEndian.Uint16(entry[34:36]))
+		}
+	case "soun":
+		if len(entry) < 36 {
+			return nil
+		}
+		track.channels = int(binary.BigEndian.Uint16(entry[24:26]))
+		track.sampleRate = int(binary.BigEndian.Uint32(entry[32:36]) >> 16)
+		// QuickTime sound description version 2 stores these values as 64 bits float and 32 bits int
+		if binary.BigEndian.Uint16(entry[16:18]) == 2 && len(entry) >= 52 {
+			track.sampleRate = int(math.Float64frombits(binary.BigEndian.Uint64(entry[40:48])))
+			track.channels = int(binary.BigEndian.Uint32(entry[48:52]))
+		}
+	}
+	return nil
+}
+
+func (p *mp4Parser) addTrack(track *mp4Track) {
+	switch track.handler {
+	case "vide":
+		if p.info.VideoCodec == "" {
+			p.info.VideoCodec = track.codec
+			p.info.Width, p.info.Height = track.width, track.height
+		}
+	case "soun":
+		if p.info.AudioCodec == "" {
+			p.info.AudioCodec = track.codec
+			p.info.SampleRate, p.info.Channels = track.sampleRate, track.channels
+		}
+	}
+}
+
+// udta reads QuickTime user data, where the location and some tags are stored as international strings.
+func (p *mp4Parser) udta(start int64, end int64) error {
+	return p.walk(start, end, func(typ string, start int64, end int64) error {
+		if typ == "meta" {
+			return p.meta(start, end)
+		}
+		if typ != "\xa9xyz" && mp4Tags[typ] == "" {
+			return nil
+		}
+		data, e := readAt(p.r, start, end-start)
+		if e != nil || len(data) < 4 {
+			return e
+		}
+		l := int(binary.BigEndian.Uint16(data[:2]))
+		if l > len(data)-4 {
+			return nil
+		}
+		value := string(data[4 : 4+l])
+		if typ == "\xa9xyz" {
+			if loc := parseISO6709(value); loc != nil && p.info.Location == nil {
+				p.info.Location = loc
+			}
+		} else {
+			p.info.setTag(mp4Tags[typ], value)
+		}
+		return nil
+	})
+}
+
+// meta reads an iTunes or QuickTime metadata box.
+func (p *mp4Parser) meta(start int64, end int64) error {
+	peek, e := readAt(p.r, start, 8)
+	if e != nil {
+		return nil
+	}
+	// MP4 meta is a full box, QuickTime meta directly starts with its children
+	if string(peek[4:8]) != "hdlr" {
+		start += 4
+	}
+	return p.walk(start, end, func(typ string, start int64, end int64) error {
+		switch typ {
+		case "keys":
+			data, e := readAt(p.r, start, end-start)
+			if e != nil || len(data) < 8 {
+				return e
+			}
+			p.keys = nil
+			for offset := 8; offset+8 <= len(data); {
+				l := int(binary.BigEndian.Uint32(data[offset:]))
+				if l < 8 || offset+l > len(data) {
+					break
+				}
+				p.keys = append(p.keys, string(data[offset+8:offset+l]))
+				offset += l
+			}
+		case "ilst":
+			return p.ilst(start, end)
+		}
+		return nil
+	})
+}
+
+func (p *mp4Parser) ilst(start int64, end int64) error {
+	return p.walk(start, end, func(typ string, start int64, end int64) error {
+		name := typ
+		if p.keys != nil {
+			if index := int(binary.BigEndian.Uint32([]byte(typ))); index > 0 && index <= len(p.keys) {
+				name = p.keys[index-1]
+			}
+		}
+		return p.walk(start, end, func(dataType string, start int64, end int64) error {
+			if dataType != "data" {
+				return nil
+			}
+			data, e := readAt(p.r, start, end-start)
+			if e != nil || len(data) < 8 {
+				return e
+			}
+			p.ilstValue(name, binary.BigEndian.Uint32(data[:4])&0xFFFFFF, data[8:])
+			return nil
+		})
+	})
+}
+
+func (p *mp4Parser) ilstValue(name string, valueType uint32, value []byte) {
+	if name == "trkn" {
+		if len(value) >= 4 {
+			if track := binary.BigEndian.Uint16(value[2:4]); track > 0 {
+				p.info.setTag("track", strconv.Itoa(int(track)))
+			}
+		}
+		return
+	}
+	// Only text values are kept
+	if valueType != 1 {
+		return
+	}
+	text := string(value)
+	if strings.HasPrefix(name, "com.apple.quicktime.") {
+		key := strings.TrimPrefix(name, "com.apple.quicktime.")
+		switch key {
+		case "location.ISO6709":
+			if loc := parseISO6709(text); loc != nil {
+				p.info.Location = loc
+			}
+		case "creationdate":
+			if t, e := time.Parse("2006-01-02T15:04:05-0700", text); e == nil {
+				p.info.Created = &t
+			}
+		default:
+			p.info.setTag(strings.ToLower(key), text)
+		}
+		return
+	}
+	if tag, ok := mp4Tags[name]; ok {
+		p.info.setTag(tag, text)
+	}
+}
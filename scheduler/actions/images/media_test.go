@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package images
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pborman/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/views"
+	"github.com/pydio/cells/scheduler/actions"
+	"github.com/pydio/cells/scheduler/actions/images/media"
+)
+
+func TestMediaProcessor_GetName(t *testing.T) {
+	Convey("Test GetName", t, func() {
+		metaAction := &MediaProcessor{}
+		So(metaAction.GetName(), ShouldEqual, mediaTaskName)
+	})
+}
+
+func TestMediaProcessor_Run(t *testing.T) {
+
+	run := func(ext string, data []byte) (jobs.ActionMessage, error) {
+		action := &MediaProcessor{}
+		e := action.Init(&jobs.Job{}, nil, &jobs.Action{})
+		So(e, ShouldBeNil)
+		action.metaClient = views.NewHandlerMock()
+
+		tmpDir := os.TempDir()
+		uuidNode := uuid.NewUUID().String()
+		target := filepath.Join(tmpDir, uuidNode+ext)
+		So(ioutil.WriteFile(target, data, 0755), ShouldBeNil)
+		defer os.Remove(target)
+
+		node := &tree.Node{
+			Path: "path/to/local/" + uuidNode + ext,
+			Type: tree.NodeType_LEAF,
+			Uuid: uuidNode,
+		}
+		node.SetMeta("name", uuidNode+ext)
+		node.SetMeta(common.META_NAMESPACE_DATASOURCE_NAME, "dsname")
+		node.SetMeta(common.META_NAMESPACE_NODE_TEST_LOCAL_FOLDER, tmpDir)
+
+		return action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{
+			Nodes: []*tree.Node{node},
+		})
+	}
+
+	Convey("Test audio file", t, func() {
+		// FLAC stream of 10 seconds at 44100Hz, stereo
+		flac := make([]byte, 4+4+34)
+		copy(flac, "fLaC")
+		flac[4] = 0x80
+		flac[7] = 34
+		binary.BigEndian.PutUint64(flac[18:26], uint64(44100)<<44|uint64(1)<<41|uint64(15)<<36|441000)
+
+		output, e := run(".flac", flac)
+		So(e, ShouldBeNil)
+		So(output.Nodes, ShouldHaveLength, 1)
+		info := &media.Info{}
+		So(output.Nodes[0].GetMeta(METADATA_MEDIA, info), ShouldBeNil)
+		So(info.Format, ShouldEqual, "flac")
+		So(info.Duration, ShouldEqual, 10)
+		So(info.Channels, ShouldEqual, 2)
+	})
+
+	Convey("Test unsupported file is ignored", t, func() {
+		output, e := run(".mp4", []byte("this is not a movie"))
+		So(e, ShouldBeNil)
+		So(output.Nodes[0].GetStringMeta(METADATA_MEDIA), ShouldBeEmpty)
+	})
+
+}
+
+// rangeHandlerMock serves ranges of a fixed content and counts the requests.
+type rangeHandlerMock struct {
+	*views.HandlerMock
+	data     []byte
+	requests int
+}
+
+func (r *rangeHandlerMock) GetObject(ctx context.Context, node *tree.Node, requestData *views.GetRequestData) (io.ReadCloser, error) {
+	r.requests++
+	end := requestData.StartOffset + requestData.Length
+	if requestData.Length < 0 || end > int64(len(r.data)) {
+		end = int64(len(r.data))
+	}
+	return ioutil.NopCloser(bytes.NewReader(r.data[requestData.StartOffset:end])), nil
+}
+
+func TestNodeReaderAt(t *testing.T) {
+
+	Convey("Test reading a node by ranges", t, func() {
+		data := make([]byte, 3*readAtBlockSize)
+		for i := range data {
+			data[i] = byte(i % 251)
+		}
+		mock := &rangeHandlerMock{HandlerMock: views.NewHandlerMock(), data: data}
+		r := newNodeReaderAt(context.Background(), mock, &tree.Node{Path: "movie.mp4", Size: int64(len(data))})
+
+		buf := make([]byte, 16)
+		n, e := r.ReadAt(buf, 10)
+		So(e, ShouldBeNil)
+		So(n, ShouldEqual, 16)
+		So(buf, ShouldResemble, data[10:26])
+		// Served from the loaded block
+		r.ReadAt(buf, 1000)
+		So(buf, ShouldResemble, data[1000:1016])
+		So(mock.requests, ShouldEqual, 1)
+
+		// Reading at the end of the file
+		n, e = r.ReadAt(buf, int64(len(data))-10)
+		So(e, ShouldEqual, io.EOF)
+		So(n, ShouldEqual, 10)
+		So(buf[:10], ShouldResemble, data[len(data)-10:])
+		So(mock.requests, ShouldEqual, 2)
+		_, e = r.ReadAt(buf, int64(len(data)))
+		So(e, ShouldEqual, io.EOF)
+
+		// Reading larger than a block
+		large := make([]byte, 2*readAtBlockSize)
+		n, e = r.ReadAt(large, 5)
+		So(e, ShouldBeNil)
+		So(n, ShouldEqual, len(large))
+		So(large, ShouldResemble, data[5:5+len(large)])
+	})
+
+}
@@ -0,0 +1,378 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package images
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/disintegration/imaging"
+	"github.com/golang/protobuf/proto"
+	"github.com/micro/go-micro/client"
+	"github.com/pydio/minio-go"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/views"
+	"github.com/pydio/cells/scheduler/actions"
+)
+
+const (
+	METADATA_PREVIEWS = "DocumentPreviews"
+
+	defaultOfficeExtensions = "doc,docx,odt,rtf,xls,xlsx,ods,ppt,pptx,odp"
+	defaultOfficeParameters = "--headless --convert-to pdf --outdir PYDIO_OUTPUT_DIR PYDIO_INPUT_FILE"
+	defaultPdfParameters    = "-jpeg -r 72 -l PYDIO_MAX_PAGES PYDIO_INPUT_FILE PYDIO_OUTPUT_DIR/page"
+	defaultVideoExtensions  = "mp4,mov,avi,mkv,webm"
+	defaultVideoParameters  = "-loglevel error -ss 1 -i PYDIO_INPUT_FILE -frames:v 1 PYDIO_OUTPUT_DIR/page-1.jpg"
+)
+
+var (
+	previewActionName = "actions.images.preview"
+)
+
+// PreviewPage describes a page image stored in the thumbnails store.
+type PreviewPage struct {
+	Page   int    `json:"page"`
+	Format string `json:"format"`
+	Size   int    `json:"size"`
+}
+
+// PreviewsMeta is stored in the METADATA_PREVIEWS namespace of the node. Pages are stored as
+// <uuid>-page-<n>.jpg and the PDF rendition as <uuid>-preview.pdf in the thumbnails store, which only
+// serves them to users who can read the original node.
+type PreviewsMeta struct {
+	Processing bool          `json:"processing"`
+	Pdf        bool          `json:"pdf"`
+	Pages      []PreviewPage `json:"pages"`
+}
+
+// PreviewGenerator produces page images and a PDF rendition of office documents, PDFs and videos, using
+// external converters.
+type PreviewGenerator struct {
+	Router     views.Handler
+	Client     client.Client
+	metaClient tree.NodeReceiverClient
+
+	// Office converts documents to PDF
+	Office Converter
+	// Pages renders the pages of a PDF to images
+	Pages Converter
+	// Video extracts a frame of a video
+	Video Converter
+
+	MaxPages int
+	PageSize int
+	// MaxSize is the size in bytes above which documents are ignored, 0 for no limit
+	MaxSize int64
+}
+
+// GetName returns this action unique identifier.
+func (p *PreviewGenerator) GetName() string {
+	return previewActionName
+}
+
+// Init passes parameters to the action.
+func (p *PreviewGenerator) Init(job *jobs.Job, cl client.Client, action *jobs.Action) error {
+	p.Router = views.NewStandardRouter(views.RouterOptions{AdminView: true, WatchRegistry: false})
+	p.Client = cl
+	p.metaClient = tree.NewNodeReceiverClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_META, cl)
+
+	params := action.Parameters
+	if params == nil {
+		params = map[string]string{}
+	}
+	param := func(name string, defaultValue string) string {
+		if v, ok := params[name]; ok {
+			return v
+		}
+		return defaultValue
+	}
+	p.MaxPages, _ = strconv.Atoi(param("MaxPages", "5"))
+	p.PageSize, _ = strconv.Atoi(param("PageSize", "512"))
+	p.MaxSize, _ = strconv.ParseInt(param("MaxSize", "209715200"), 10, 64)
+	timeout, _ := strconv.Atoi(param("Timeout", "120"))
+
+	office := NewCommandConverter(param("officeExtensions", defaultOfficeExtensions), param("officeCmd", "soffice"), param("officeParameters", defaultOfficeParameters))
+	pages := NewCommandConverter("pdf", param("pdfCmd", "pdftoppm"), param("pdfParameters", defaultPdfParameters))
+	video := NewCommandConverter(param("videoExtensions", defaultVideoExtensions), param("videoCmd", "ffmpeg"), param("videoParameters", defaultVideoParameters))
+	for _, c := range []*CommandConverter{office, pages, video} {
+		c.MaxPages = p.MaxPages
+		c.Timeout = time.Duration(timeout) * time.Second
+	}
+	p.Office, p.Pages, p.Video = office, pages, video
+	return nil
+}
+
+// Run the actual action code.
+func (p *PreviewGenerator) Run(ctx context.Context, channels *actions.RunnableChannels, input jobs.ActionMessage) (jobs.ActionMessage, error) {
+
+	if len(input.Nodes) == 0 || input.Nodes[0].Size == -1 || input.Nodes[0].Etag == common.NODE_FLAG_ETAG_TEMPORARY {
+		return input.WithIgnore(), nil
+	}
+	node := input.Nodes[0]
+	ext := strings.TrimPrefix(strings.ToLower(path.Ext(node.Path)), ".")
+
+	var steps []Converter
+	switch {
+	case p.Office != nil && p.Office.Accepts(ext):
+		steps = []Converter{p.Office, p.Pages}
+	case p.Pages != nil && p.Pages.Accepts(ext):
+		steps = []Converter{p.Pages}
+	case p.Video != nil && p.Video.Accepts(ext):
+		steps = []Converter{p.Video}
+	default:
+		return input.WithIgnore(), nil
+	}
+	for _, s := range steps {
+		if s == nil || !s.Available() {
+			log.Logger(ctx).Debug("[PREVIEW] Converter is not available, ignoring", zap.String("ext", ext))
+			return input.WithIgnore(), nil
+		}
+	}
+
+	if p.MaxSize > 0 && node.Size > p.MaxSize {
+		log.Logger(ctx).Debug("[PREVIEW] Document is too large, ignoring", node.ZapPath(), zap.Int64("size", node.Size))
+		return input.WithIgnore(), nil
+	}
+
+	if p.upToDate(ctx, node) {
+		log.Logger(ctx).Debug("[PREVIEW] Preview already exists in store", node.ZapPath())
+		return input.WithIgnore(), nil
+	}
+
+	if err := p.generate(ctx, node, ext, steps); err != nil {
+		p.removeStale(ctx, node, &PreviewsMeta{})
+		node.SetMeta(METADATA_PREVIEWS, &PreviewsMeta{})
+		p.metaClient.UpdateNode(ctx, &tree.UpdateNodeRequest{From: node, To: node})
+		return input.WithError(err), err
+	}
+
+	output := input
+	output.Nodes[0] = node
+	output.AppendOutput(&jobs.ActionOutput{
+		Success:    true,
+		StringBody: "Created previews for document",
+	})
+	return output, nil
+}
+
+func (p *PreviewGenerator) generate(ctx context.Context, node *tree.Node, ext string, steps []Converter) error {
+
+	node.SetMeta(METADATA_PREVIEWS, &PreviewsMeta{Processing: true})
+	if _, e := p.metaClient.UpdateNode(ctx, &tree.UpdateNodeRequest{From: node, To: node}); e != nil {
+		return e
+	}
+
+	workDir, e := ioutil.TempDir("", "pydio-preview-")
+	if e != nil {
+		return e
+	}
+	defer os.RemoveAll(workDir)
+
+	// Converters rely on the extension to detect the input format
+	inputFile := filepath.Join(workDir, "input."+ext)
+	if e := downloadNode(ctx, p.Router, node, inputFile); e != nil {
+		return e
+	}
+
+	meta := &PreviewsMeta{}
+	for i, step := range steps {
+		outDir := filepath.Join(workDir, fmt.Sprintf("step-%d", i))
+		if e := os.Mkdir(outDir, 0700); e != nil {
+			return e
+		}
+		files, e := step.Convert(ctx, inputFile, outDir)
+		if e != nil {
+			return e
+		}
+		if step == p.Office {
+			pdf := firstWithExtension(files, ".pdf")
+			if pdf == "" {
+				return fmt.Errorf("no PDF produced for %s", node.Path)
+			}
+			data, e := ioutil.ReadFile(pdf)
+			if e != nil {
+				return e
+			}
+			if e := p.store(ctx, node, node.Uuid+"-preview.pdf", "application/pdf", data); e != nil {
+				return e
+			}
+			meta.Pdf = true
+			inputFile = pdf
+			continue
+		}
+		pages, e := p.storePages(ctx, node, files)
+		if e != nil {
+			return e
+		}
+		meta.Pages = pages
+	}
+
+	p.removeStale(ctx, node, meta)
+	log.Logger(ctx).Debug("[PREVIEW] Updating meta after previews generation", zap.Any("meta", meta))
+	node.SetMeta(METADATA_PREVIEWS, meta)
+	_, e = p.metaClient.UpdateNode(ctx, &tree.UpdateNodeRequest{From: node, To: node})
+	return e
+}
+
+// storePages resizes the images produced by a converter and stores them as pages.
+func (p *PreviewGenerator) storePages(ctx context.Context, node *tree.Node, files []string) (pages []PreviewPage, err error) {
+	for _, file := range files {
+		if len(pages) >= p.MaxPages {
+			break
+		}
+		img, e := imaging.Open(file)
+		if e != nil {
+			// Not an image
+			continue
+		}
+		if img.Bounds().Dx() > p.PageSize {
+			img = imaging.Resize(img, p.PageSize, 0, imaging.Lanczos)
+		}
+		buffer := &bytes.Buffer{}
+		if e := imaging.Encode(buffer, img, imaging.JPEG); e != nil {
+			return nil, e
+		}
+		page := len(pages) + 1
+		if e := p.store(ctx, node, fmt.Sprintf("%s-page-%d.jpg", node.Uuid, page), "image/jpeg", buffer.Bytes()); e != nil {
+			return nil, e
+		}
+		pages = append(pages, PreviewPage{Page: page, Format: "jpg", Size: p.PageSize})
+	}
+	return
+}
+
+// downloadNode copies the content of a node to a local file.
+func downloadNode(ctx context.Context, router views.Handler, node *tree.Node, target string) error {
+	var reader io.ReadCloser
+	var err error
+	if localPath := getNodeLocalPath(node); len(localPath) > 0 {
+		reader, err = os.Open(localPath)
+	} else {
+		routerNode := proto.Clone(node).(*tree.Node)
+		reader, err = router.GetObject(ctx, routerNode, &views.GetRequestData{Length: -1})
+	}
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	file, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(file, reader)
+	return err
+}
+
+// upToDate checks if the first page was already generated for the current version of the node.
+func (p *PreviewGenerator) upToDate(ctx context.Context, node *tree.Node) bool {
+	if getNodeLocalPath(node) != "" {
+		return false
+	}
+	thumbsClient, thumbsBucket, e := views.GetGenericStoreClient(ctx, common.PYDIO_THUMBSTORE_NAMESPACE, p.Client)
+	if e != nil {
+		return false
+	}
+	oi, e := thumbsClient.StatObject(thumbsBucket, node.Uuid+"-page-1.jpg", minio.StatObjectOptions{})
+	if e != nil {
+		return false
+	}
+	original := oi.Metadata.Get("X-Amz-Meta-Original-Etag")
+	return len(original) > 0 && original == node.Etag
+}
+
+// store writes a file in the thumbnails store, or in the local test folder.
+func (p *PreviewGenerator) store(ctx context.Context, node *tree.Node, objectName string, contentType string, data []byte) error {
+	if localFolder := node.GetStringMeta(common.META_NAMESPACE_NODE_TEST_LOCAL_FOLDER); localFolder != "" {
+		return ioutil.WriteFile(filepath.Join(localFolder, objectName), data, 0755)
+	}
+	thumbsClient, thumbsBucket, e := views.GetGenericStoreClient(ctx, common.PYDIO_THUMBSTORE_NAMESPACE, p.Client)
+	if e != nil {
+		log.Logger(ctx).Error("Cannot find client for thumbstore", zap.Error(e))
+		return e
+	}
+	if meta, mOk := views.MinioMetaFromContext(ctx); mOk {
+		thumbsClient.PrepareMetadata(meta)
+		defer thumbsClient.ClearMetadata()
+	}
+	options := minio.PutObjectOptions{
+		UserMetadata: map[string]string{"X-Amz-Meta-Original-Etag": node.Etag},
+		ContentType:  contentType,
+	}
+	_, e = thumbsClient.PutObjectWithContext(ctx, thumbsBucket, objectName, bytes.NewReader(data), int64(len(data)), options)
+	return e
+}
+
+// removeStale deletes the files left by a previous rendition that are not part of the given one, like the
+// pages beyond the new pages count.
+func (p *PreviewGenerator) removeStale(ctx context.Context, node *tree.Node, meta *PreviewsMeta) {
+	if !meta.Pdf {
+		p.remove(ctx, node, node.Uuid+"-preview.pdf")
+	}
+	for page := len(meta.Pages) + 1; ; page++ {
+		if !p.remove(ctx, node, fmt.Sprintf("%s-page-%d.jpg", node.Uuid, page)) {
+			break
+		}
+	}
+}
+
+// remove deletes a file from the thumbnails store, or from the local test folder. It returns false if the
+// file did not exist.
+func (p *PreviewGenerator) remove(ctx context.Context, node *tree.Node, objectName string) bool {
+	if localFolder := node.GetStringMeta(common.META_NAMESPACE_NODE_TEST_LOCAL_FOLDER); localFolder != "" {
+		return os.Remove(filepath.Join(localFolder, objectName)) == nil
+	}
+	thumbsClient, thumbsBucket, e := views.GetGenericStoreClient(ctx, common.PYDIO_THUMBSTORE_NAMESPACE, p.Client)
+	if e != nil {
+		return false
+	}
+	if _, e := thumbsClient.StatObject(thumbsBucket, objectName, minio.StatObjectOptions{}); e != nil {
+		return false
+	}
+	if e := thumbsClient.RemoveObject(thumbsBucket, objectName); e != nil {
+		log.Logger(ctx).Error("Cannot remove stale preview", zap.String("object", objectName), zap.Error(e))
+		return false
+	}
+	return true
+}
+
+func firstWithExtension(files []string, ext string) string {
+	for _, f := range files {
+		if strings.EqualFold(filepath.Ext(f), ext) {
+			return f
+		}
+	}
+	return ""
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package images
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pborman/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/views"
+	"github.com/pydio/cells/scheduler/actions"
+)
+
+func TestPreviewGenerator_Init(t *testing.T) {
+
+	Convey("Test Init", t, func() {
+		action := &PreviewGenerator{}
+		So(action.GetName(), ShouldEqual, previewActionName)
+		e := action.Init(&jobs.Job{}, nil, &jobs.Action{Parameters: map[string]string{"MaxPages": "3", "pdfCmd": "mutool"}})
+		So(e, ShouldBeNil)
+		So(action.MaxPages, ShouldEqual, 3)
+		So(action.PageSize, ShouldEqual, 512)
+		So(action.MaxSize, ShouldEqual, 200*1024*1024)
+		So(action.Office.Accepts("docx"), ShouldBeTrue)
+		So(action.Pages.(*CommandConverter).Cmd, ShouldEqual, "mutool")
+		So(action.Video.Accepts("mp4"), ShouldBeTrue)
+	})
+
+}
+
+func TestPreviewGenerator_Run(t *testing.T) {
+
+	Convey("Test previews generation with fake converters", t, func() {
+
+		action := &PreviewGenerator{}
+		action.Init(&jobs.Job{}, nil, &jobs.Action{Parameters: map[string]string{"PageSize": "256"}})
+		mock := views.NewHandlerMock()
+		action.metaClient = mock
+		// Office conversion and pages rendering simply copy the input, a JPEG image
+		action.Office = NewCommandConverter("docx", "cp", "PYDIO_INPUT_FILE PYDIO_OUTPUT_DIR/input.pdf")
+		action.Pages = NewCommandConverter("pdf", "cp", "PYDIO_INPUT_FILE PYDIO_OUTPUT_DIR/page-1.jpg")
+
+		tmpDir, _ := ioutil.TempDir("", "preview-test")
+		defer os.RemoveAll(tmpDir)
+		uuidNode := uuid.NewUUID().String()
+		testDir := filepath.Join(os.Getenv("GOPATH"), "src", "github.com", "pydio", "cells", "scheduler", "actions", "images", "testdata")
+		data, err := ioutil.ReadFile(filepath.Join(testDir, "photo-hires.jpg"))
+		So(err, ShouldBeNil)
+		So(ioutil.WriteFile(filepath.Join(tmpDir, uuidNode+".docx"), data, 0755), ShouldBeNil)
+
+		node := &tree.Node{
+			Path: "path/to/local/" + uuidNode + ".docx",
+			Type: tree.NodeType_LEAF,
+			Uuid: uuidNode,
+		}
+		node.SetMeta("name", uuidNode+".docx")
+		node.SetMeta(common.META_NAMESPACE_NODE_TEST_LOCAL_FOLDER, tmpDir)
+
+		// Pages left by a previous rendition with more pages
+		So(ioutil.WriteFile(filepath.Join(tmpDir, uuidNode+"-page-2.jpg"), data, 0755), ShouldBeNil)
+		So(ioutil.WriteFile(filepath.Join(tmpDir, uuidNode+"-page-3.jpg"), data, 0755), ShouldBeNil)
+
+		status := make(chan string, 10)
+		progress := make(chan float32, 10)
+		_, e := action.Run(context.Background(), &actions.RunnableChannels{StatusMsg: status, Progress: progress}, jobs.ActionMessage{
+			Nodes: []*tree.Node{node},
+		})
+		So(e, ShouldBeNil)
+
+		_, e = os.Stat(filepath.Join(tmpDir, uuidNode+"-preview.pdf"))
+		So(e, ShouldBeNil)
+		_, e = os.Stat(filepath.Join(tmpDir, uuidNode+"-page-1.jpg"))
+		So(e, ShouldBeNil)
+		_, e = os.Stat(filepath.Join(tmpDir, uuidNode+"-page-2.jpg"))
+		So(os.IsNotExist(e), ShouldBeTrue)
+		_, e = os.Stat(filepath.Join(tmpDir, uuidNode+"-page-3.jpg"))
+		So(os.IsNotExist(e), ShouldBeTrue)
+
+		meta := &PreviewsMeta{}
+		So(mock.Nodes["to"].GetMeta(METADATA_PREVIEWS, meta), ShouldBeNil)
+		So(meta.Processing, ShouldBeFalse)
+		So(meta.Pdf, ShouldBeTrue)
+		So(meta.Pages, ShouldResemble, []PreviewPage{{Page: 1, Format: "jpg", Size: 256}})
+
+		// Unsupported extensions are ignored
+		out, e := action.Run(context.Background(), &actions.RunnableChannels{StatusMsg: status, Progress: progress}, jobs.ActionMessage{
+			Nodes: []*tree.Node{{Path: "file.txt", Uuid: "uuid"}},
+		})
+		So(e, ShouldBeNil)
+		So(out.OutputChain[len(out.OutputChain)-1].Ignored, ShouldBeTrue)
+
+		// Documents above MaxSize are ignored
+		action.MaxSize = 10
+		large := &tree.Node{Path: "path/to/local/large.docx", Type: tree.NodeType_LEAF, Uuid: "large", Size: 11}
+		out, e = action.Run(context.Background(), &actions.RunnableChannels{StatusMsg: status, Progress: progress}, jobs.ActionMessage{
+			Nodes: []*tree.Node{large},
+		})
+		So(e, ShouldBeNil)
+		So(out.OutputChain[len(out.OutputChain)-1].Ignored, ShouldBeTrue)
+	})
+
+}
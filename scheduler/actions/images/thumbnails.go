@@ -57,16 +57,31 @@ const (
 	METADATA_COMPAT_IMAGE_WIDTH               = "image_width"
 	METADATA_COMPAT_IMAGE_HEIGHT              = "image_height"
 	METADATA_COMPAT_IMAGE_READABLE_DIMENSIONS = "readable_dimension"
+
+	defaultWebpParameters = "-quiet -q 80 PYDIO_INPUT_FILE -o PYDIO_OUTPUT_DIR/thumb.webp"
+	defaultAvifParameters = "PYDIO_INPUT_FILE PYDIO_OUTPUT_DIR/thumb.avif"
 )
 
 var (
 	thumbnailsActionName = "actions.images.thumbnails"
+
+	thumbContentTypes = map[string]string{
+		"jpg":  "image/jpeg",
+		"webp": "image/webp",
+		"avif": "image/avif",
+	}
 )
 
 type ThumbnailData struct {
 	Format string `json:"format"`
 	Size   int    `json:"size"`
 	Url    string `json:"url"`
+	// Density is the pixel ratio of HiDPI variants (2x, 3x), empty for standard thumbnails
+	Density int `json:"density,omitempty"`
+	// Crop is set for fixed-aspect tiles
+	Crop bool `json:"crop,omitempty"`
+	// Name of the object in the thumbnails store
+	Name string `json:"name,omitempty"`
 }
 
 type ThumbnailsMeta struct {
@@ -74,9 +89,36 @@ type ThumbnailsMeta struct {
 	Thumbnails []ThumbnailData `json:"thumbnails"`
 }
 
+// thumbVariant describes one file produced for a given node.
+type thumbVariant struct {
+	size    int
+	density int
+	format  string
+	crop    bool
+}
+
+// objectName builds the name of the variant in the thumbnails store. Standard JPEG thumbnails keep
+// the historical <uuid>-<size>.jpg name.
+func (v thumbVariant) objectName(nodeUuid string) string {
+	name := fmt.Sprintf("%s-%d", nodeUuid, v.size)
+	if v.crop {
+		name = fmt.Sprintf("%s-tile-%d", nodeUuid, v.size)
+	}
+	if v.density > 1 {
+		name += fmt.Sprintf("@%dx", v.density)
+	}
+	return name + "." + v.format
+}
+
 type ThumbnailExtractor struct {
 	Router     views.Handler
 	thumbSizes []int
+	tileSizes  []int
+	tileAspect [2]int
+	densities  []int
+	formats    []string
+	// encoders produce formats that cannot be encoded natively (webp, avif)
+	encoders   map[string]Converter
 	metaClient tree.NodeReceiverClient
 	Client     client.Client
 }
@@ -88,23 +130,54 @@ func (t *ThumbnailExtractor) GetName() string {
 
 // Init passes parameters to the action.
 func (t *ThumbnailExtractor) Init(job *jobs.Job, cl client.Client, action *jobs.Action) error {
-	// Todo : get sizes from parameters
 	t.Router = views.NewStandardRouter(views.RouterOptions{
 		AdminView:     true,
 		WatchRegistry: false,
 	})
 
-	if action.Parameters != nil {
-		t.thumbSizes = []int{}
-		if params, ok := action.Parameters["ThumbSizes"]; ok {
-			for _, s := range strings.Split(params, ",") {
-				parsed, _ := strconv.ParseInt(s, 10, 32)
-				t.thumbSizes = append(t.thumbSizes, int(parsed))
-			}
-		}
+	params := action.Parameters
+	if params != nil {
+		t.thumbSizes = parseIntList(params["ThumbSizes"])
 	} else {
+		params = map[string]string{}
 		t.thumbSizes = []int{512}
 	}
+	param := func(name string, defaultValue string) string {
+		if v, ok := params[name]; ok {
+			return v
+		}
+		return defaultValue
+	}
+
+	t.tileSizes = parseIntList(param("TileSizes", ""))
+	t.tileAspect = [2]int{1, 1}
+	if parts := strings.Split(param("TileAspect", "1:1"), ":"); len(parts) == 2 {
+		w, _ := strconv.Atoi(strings.TrimSpace(parts[0]))
+		h, _ := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if w > 0 && h > 0 {
+			t.tileAspect = [2]int{w, h}
+		}
+	}
+	t.densities = []int{}
+	for _, d := range parseIntList(param("Densities", "1")) {
+		if d >= 1 {
+			t.densities = append(t.densities, d)
+		}
+	}
+	if len(t.densities) == 0 {
+		t.densities = []int{1}
+	}
+	t.formats = []string{}
+	for _, f := range strings.Split(param("Formats", "jpg"), ",") {
+		if f = strings.ToLower(strings.TrimSpace(f)); f != "" {
+			t.formats = append(t.formats, f)
+		}
+	}
+	t.encoders = map[string]Converter{
+		"webp": NewCommandConverter("png", param("webpCmd", "cwebp"), param("webpParameters", defaultWebpParameters)),
+		"avif": NewCommandConverter("png", param("avifCmd", "avifenc"), param("avifParameters", defaultAvifParameters)),
+	}
+
 	t.metaClient = tree.NewNodeReceiverClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_META, cl)
 	t.Client = cl
 	return nil
@@ -145,6 +218,36 @@ func displayMemStat(ctx context.Context, position string) {
 
 }
 
+// variants lists the files to produce for an image of the given width. HiDPI variants are
+// skipped when they would upscale the original.
+func (t *ThumbnailExtractor) variants(width int, sizes ...int) (vv []thumbVariant) {
+	var formats []string
+	for _, f := range t.formats {
+		if f == "jpg" {
+			formats = append(formats, f)
+		} else if enc, ok := t.encoders[f]; ok && enc.Available() {
+			formats = append(formats, f)
+		}
+	}
+	add := func(size int, crop bool) {
+		for _, d := range t.densities {
+			if d > 1 && size*d > width {
+				continue
+			}
+			for _, f := range formats {
+				vv = append(vv, thumbVariant{size: size, density: d, format: f, crop: crop})
+			}
+		}
+	}
+	for _, s := range sizes {
+		add(s, false)
+	}
+	for _, s := range t.tileSizes {
+		add(s, true)
+	}
+	return
+}
+
 func (t *ThumbnailExtractor) resize(ctx context.Context, node *tree.Node, sizes ...int) error {
 	displayMemStat(ctx, "START RESIZE")
 	// Open the test image.
@@ -166,19 +269,21 @@ func (t *ThumbnailExtractor) resize(ctx context.Context, node *tree.Node, sizes
 	if err != nil {
 		return err
 	}
-	defer reader.Close()
-
+	// Content is streamed to the decoder, keeping its beginning where the EXIF orientation is found
+	header := newHeaderBuffer(maxExifHeaderSize)
 	displayMemStat(ctx, "BEFORE DECODE")
-	src, err := imaging.Decode(reader)
+	src, err := imaging.Decode(io.TeeReader(reader, header))
+	reader.Close()
 	if err != nil {
 		return errors.InternalServerError(common.SERVICE_JOBS, "Error during decode :"+err.Error())
 	}
+	src = applyOrientation(src, readOrientation(header.Bytes()))
 	displayMemStat(ctx, "AFTER DECODE")
 
 	// Extract dimensions
 	bounds := src.Bounds()
-	width := bounds.Max.X
-	height := bounds.Max.Y
+	width := bounds.Dx()
+	height := bounds.Dy()
 	// Send update event right now
 	node.SetMeta(METADATA_IMAGE_DIMENSIONS, struct {
 		Width  int
@@ -202,23 +307,27 @@ func (t *ThumbnailExtractor) resize(ctx context.Context, node *tree.Node, sizes
 	log.Logger(ctx).Debug("Thumbnails - Extracted dimension and saved in metadata", zap.Any("dimension", bounds))
 	meta := &ThumbnailsMeta{}
 
-	for _, size := range sizes {
+	for _, variant := range t.variants(width, sizes...) {
 
 		displayMemStat(ctx, "BEFORE WRITE SIZE FROM SRC")
-		updateMeta, err := t.writeSizeFromSrc(ctx, src, node, size)
-		if err != nil {
+		if err := t.writeVariantFromSrc(ctx, src, node, variant); err != nil {
 			return err
 		}
 		displayMemStat(ctx, "AFTER WRITE SIZE FROM SRC")
-		if updateMeta {
-			meta.Thumbnails = append(meta.Thumbnails, ThumbnailData{
-				Format: "jpg",
-				Size:   size,
-			})
+		// Variants that were already up-to-date are listed as well
+		data := ThumbnailData{
+			Format: variant.format,
+			Size:   variant.size,
+			Crop:   variant.crop,
+			Name:   variant.objectName(node.Uuid),
 		}
+		if variant.density > 1 {
+			data.Density = variant.density
+		}
+		meta.Thumbnails = append(meta.Thumbnails, data)
 	}
 
-	if (meta != &ThumbnailsMeta{}) {
+	if len(meta.Thumbnails) > 0 {
 		node.SetMeta(METADATA_THUMBNAILS, meta)
 	} else {
 		node.SetMeta(METADATA_THUMBNAILS, nil)
@@ -230,14 +339,14 @@ func (t *ThumbnailExtractor) resize(ctx context.Context, node *tree.Node, sizes
 	return err
 }
 
-func (t *ThumbnailExtractor) writeSizeFromSrc(ctx context.Context, img image.Image, node *tree.Node, targetSize int) (bool, error) {
+func (t *ThumbnailExtractor) writeVariantFromSrc(ctx context.Context, img image.Image, node *tree.Node, variant thumbVariant) error {
 
 	localTest := false
 	localFolder := ""
 
 	var thumbsClient *minio.Core
 	var thumbsBucket string
-	objectName := fmt.Sprintf("%s-%d.jpg", node.Uuid, targetSize)
+	objectName := variant.objectName(node.Uuid)
 
 	if localFolder = node.GetStringMeta(common.META_NAMESPACE_NODE_TEST_LOCAL_FOLDER); localFolder != "" {
 		localTest = true
@@ -249,7 +358,7 @@ func (t *ThumbnailExtractor) writeSizeFromSrc(ctx context.Context, img image.Ima
 		thumbsClient, thumbsBucket, e = views.GetGenericStoreClient(ctx, common.PYDIO_THUMBSTORE_NAMESPACE, t.Client)
 		if e != nil {
 			log.Logger(ctx).Error("Cannot find client for thumbstore", zap.Error(e))
-			return false, e
+			return e
 		}
 
 		if meta, mOk := views.MinioMetaFromContext(ctx); mOk {
@@ -265,66 +374,96 @@ func (t *ThumbnailExtractor) writeSizeFromSrc(ctx context.Context, img image.Ima
 			if len(foundOriginal) > 0 && foundOriginal == node.Etag {
 				// No update necessary
 				log.Logger(ctx).Debug("Ignoring Resize: thumb already exists in store", zap.Any("original", oi))
-				return false, nil
+				return nil
 			}
 		}
 
 	}
 
-	log.Logger(ctx).Debug("WriteSizeFromSrc", zap.String("nodeUuid", node.Uuid))
-	// Resize the cropped image to width = 256px preserving the aspect ratio.
-	dst := imaging.Resize(img, targetSize, 0, imaging.Lanczos)
+	log.Logger(ctx).Debug("WriteVariantFromSrc", zap.String("nodeUuid", node.Uuid), zap.String("name", objectName))
+	targetWidth := variant.size * variant.density
+	var dst *image.NRGBA
+	if variant.crop {
+		// Tiles are cropped around the most detailed area of the picture
+		dst = imaging.Resize(smartCrop(img, t.tileAspect[0], t.tileAspect[1]), targetWidth, targetWidth*t.tileAspect[1]/t.tileAspect[0], imaging.Lanczos)
+	} else {
+		// Resize preserving the aspect ratio.
+		dst = imaging.Resize(img, targetWidth, 0, imaging.Lanczos)
+	}
 	ol := imaging.New(dst.Bounds().Dx(), dst.Bounds().Dy(), colornames.Lightgrey)
 	ol = imaging.Overlay(ol, dst, image.Pt(0, 0), 1.0)
 
-	var out io.WriteCloser
-
-	if !localTest {
-		// We have to compute the size first
-		var reader io.ReadCloser
-
-		reader, out = io.Pipe()
-		defer out.Close()
-
-		go func() {
-			defer reader.Close()
-			displayMemStat(ctx, "BEGIN GOFUNC")
-			requestMeta := map[string]string{"X-Amz-Meta-Original-Etag": node.Etag}
-			options := minio.PutObjectOptions{
-				UserMetadata: requestMeta,
-				ContentType:  "image/jpeg",
-			}
-			log.Logger(ctx).Debug("Writing thumbnail to thumbs bucket", zap.Any("image size", targetSize), zap.Any("options", options))
-			displayMemStat(ctx, "BEFORE PUT OBJECT WITH CONTEXT")
-			allData, err := ioutil.ReadAll(reader)
-			putReader := bytes.NewReader(allData)
-			written, err := thumbsClient.PutObjectWithContext(ctx, thumbsBucket, objectName, putReader, int64(len(allData)), options)
-			if err != nil {
-				log.Logger(ctx).Error("Error while calling PutObject", zap.Error(err), zap.Any("client", thumbsClient))
-			} else {
-				log.Logger(ctx).Info("Finished putting thumb for size", zap.Int64("written", written), zap.Int("size ", targetSize))
-			}
-			displayMemStat(ctx, "SHOULD EXIT GO FUNC")
-		}()
+	displayMemStat(ctx, "BEFORE ENCODE")
+	encoded, err := t.encode(ctx, ol, variant.format)
+	displayMemStat(ctx, "AFTER ENCODE")
+	if err != nil {
+		return err
+	}
 
-	} else {
+	if localTest {
+		return ioutil.WriteFile(filepath.Join(localFolder, objectName), encoded, 0755)
+	}
 
-		var e error
-		out, e = os.OpenFile(filepath.Join(localFolder, objectName), os.O_CREATE|os.O_WRONLY, 0755)
-		if e != nil {
-			return false, e
-		}
-		defer out.Close()
+	options := minio.PutObjectOptions{
+		UserMetadata: map[string]string{"X-Amz-Meta-Original-Etag": node.Etag},
+		ContentType:  thumbContentTypes[variant.format],
 	}
+	log.Logger(ctx).Debug("Writing thumbnail to thumbs bucket", zap.Any("variant", objectName), zap.Any("options", options))
+	written, err := thumbsClient.PutObjectWithContext(ctx, thumbsBucket, objectName, bytes.NewReader(encoded), int64(len(encoded)), options)
+	if err != nil {
+		log.Logger(ctx).Error("Error while calling PutObject", zap.Error(err), zap.Any("client", thumbsClient))
+		return err
+	}
+	log.Logger(ctx).Info("Finished putting thumb for size", zap.Int64("written", written), zap.String("name", objectName))
 
-	displayMemStat(ctx, "BEFORE ENCODE")
-	err := imaging.Encode(out, ol, imaging.JPEG)
-	displayMemStat(ctx, "AFTER ENCODE")
+	return nil
 
-	log.Logger(ctx).Debug("WriteSizeFromSrc: END", zap.String("nodeUuid", node.Uuid))
+}
 
-	return true, err
+// encode writes the image in the given format. JPEG is encoded natively, other formats are passed
+// to an external encoder through a temporary PNG file.
+func (t *ThumbnailExtractor) encode(ctx context.Context, img image.Image, format string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if format == "jpg" {
+		err := imaging.Encode(buf, img, imaging.JPEG)
+		return buf.Bytes(), err
+	}
+	encoder, ok := t.encoders[format]
+	if !ok {
+		return nil, errors.BadRequest(common.SERVICE_JOBS, "Unsupported thumbnail format %s", format)
+	}
+	workDir, e := ioutil.TempDir("", "pydio-thumb-")
+	if e != nil {
+		return nil, e
+	}
+	defer os.RemoveAll(workDir)
+	outDir := filepath.Join(workDir, "out")
+	if e := os.Mkdir(outDir, 0700); e != nil {
+		return nil, e
+	}
+	inputFile := filepath.Join(workDir, "thumb.png")
+	if e := imaging.Save(img, inputFile); e != nil {
+		return nil, e
+	}
+	files, e := encoder.Convert(ctx, inputFile, outDir)
+	if e != nil {
+		return nil, e
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no %s file produced by encoder", format)
+	}
+	return ioutil.ReadFile(files[0])
+}
 
+// parseIntList parses a comma-separated list of integers, ignoring invalid values.
+func parseIntList(s string) []int {
+	list := []int{}
+	for _, part := range strings.Split(s, ",") {
+		if parsed, e := strconv.ParseInt(strings.TrimSpace(part), 10, 32); e == nil && parsed > 0 {
+			list = append(list, int(parsed))
+		}
+	}
+	return list
 }
 
 func getNodeLocalPath(node *tree.Node) string {
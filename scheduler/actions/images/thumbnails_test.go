@@ -126,3 +126,51 @@ func TestThumbnailExtractor_Run(t *testing.T) {
 	})
 
 }
+
+func TestThumbnailExtractor_Variants(t *testing.T) {
+
+	Convey("Test variants parameters", t, func() {
+		action := &ThumbnailExtractor{}
+		e := action.Init(&jobs.Job{}, nil, &jobs.Action{
+			Parameters: map[string]string{
+				"ThumbSizes": "256,512",
+				"Densities":  "1,2,3",
+				"Formats":    "jpg,webp",
+				"webpCmd":    "pydio-missing-webp-encoder",
+				"TileSizes":  "128",
+				"TileAspect": "4:3",
+			},
+		})
+		So(e, ShouldBeNil)
+		So(action.densities, ShouldResemble, []int{1, 2, 3})
+		So(action.tileSizes, ShouldResemble, []int{128})
+		So(action.tileAspect, ShouldResemble, [2]int{4, 3})
+
+		// WebP encoder is not available, 3x variant of 512 would upscale a 1200px image
+		variants := action.variants(1200, action.thumbSizes...)
+		var names []string
+		for _, v := range variants {
+			names = append(names, v.objectName("uuid"))
+		}
+		So(names, ShouldResemble, []string{
+			"uuid-256.jpg",
+			"uuid-256@2x.jpg",
+			"uuid-256@3x.jpg",
+			"uuid-512.jpg",
+			"uuid-512@2x.jpg",
+			"uuid-tile-128.jpg",
+			"uuid-tile-128@2x.jpg",
+			"uuid-tile-128@3x.jpg",
+		})
+	})
+
+	Convey("Test default variants", t, func() {
+		action := &ThumbnailExtractor{}
+		e := action.Init(&jobs.Job{}, nil, &jobs.Action{})
+		So(e, ShouldBeNil)
+		So(action.densities, ShouldResemble, []int{1})
+		So(action.formats, ShouldResemble, []string{"jpg"})
+		So(action.variants(100, action.thumbSizes...), ShouldResemble, []thumbVariant{{size: 512, density: 1, format: "jpg"}})
+	})
+
+}
@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package images
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/disintegration/imaging"
+	"github.com/rwcarlsen/goexif/exif"
+)
+
+// maxExifHeaderSize is the length of the content kept to read the EXIF data, that is stored in a JPEG APP1
+// segment at the beginning of the file and cannot exceed 64KB.
+const maxExifHeaderSize = 128 * 1024
+
+// headerBuffer keeps the first bytes written to it and discards the rest.
+type headerBuffer struct {
+	bytes.Buffer
+	max int
+}
+
+func newHeaderBuffer(max int) *headerBuffer {
+	return &headerBuffer{max: max}
+}
+
+// Write implements io.Writer, it never fails so that it can be used in an io.TeeReader.
+func (h *headerBuffer) Write(p []byte) (int, error) {
+	if remaining := h.max - h.Len(); remaining > 0 {
+		if len(p) > remaining {
+			h.Buffer.Write(p[:remaining])
+		} else {
+			h.Buffer.Write(p)
+		}
+	}
+	return len(p), nil
+}
+
+// readOrientation returns the EXIF orientation of an image, or 1 (normal) if not found.
+func readOrientation(data []byte) int {
+	x, e := exif.Decode(bytes.NewReader(data))
+	if e != nil {
+		return 1
+	}
+	tag, e := x.Get(exif.Orientation)
+	if e != nil {
+		return 1
+	}
+	o, e := tag.Int(0)
+	if e != nil || o < 1 || o > 8 {
+		return 1
+	}
+	return o
+}
+
+// applyOrientation transforms an image so that it is displayed upright, given its EXIF orientation.
+func applyOrientation(img image.Image, orientation int) image.Image {
+	switch orientation {
+	case 2:
+		return imaging.FlipH(img)
+	case 3:
+		return imaging.Rotate180(img)
+	case 4:
+		return imaging.FlipV(img)
+	case 5:
+		return imaging.Transpose(img)
+	case 6:
+		return imaging.Rotate270(img)
+	case 7:
+		return imaging.Transverse(img)
+	case 8:
+		return imaging.Rotate90(img)
+	}
+	return img
+}
+
+// smartCrop crops the image to the given aspect ratio, keeping the most detailed area. The detail of each
+// candidate window is measured by the luminance gradients computed on a downscaled copy of the image.
+func smartCrop(img image.Image, aspectW int, aspectH int) image.Image {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	if w == 0 || h == 0 || aspectW <= 0 || aspectH <= 0 {
+		return img
+	}
+	cropW, cropH := w, w*aspectH/aspectW
+	if cropH > h {
+		cropW, cropH = h*aspectW/aspectH, h
+	}
+	if cropW == w && cropH == h {
+		return img
+	}
+
+	// Work on a small copy to compute energy
+	const sampleSize = 128
+	scale := float64(sampleSize) / math.Max(float64(w), float64(h))
+	if scale > 1 {
+		scale = 1
+	}
+	small := imaging.Resize(img, int(math.Max(1, float64(w)*scale)), int(math.Max(1, float64(h)*scale)), imaging.Box)
+	energy := edgeEnergy(small)
+	sw, sh := small.Bounds().Dx(), small.Bounds().Dy()
+	winW, winH := int(float64(cropW)*scale), int(float64(cropH)*scale)
+	if winW < 1 {
+		winW = 1
+	} else if winW > sw {
+		winW = sw
+	}
+	if winH < 1 {
+		winH = 1
+	} else if winH > sh {
+		winH = sh
+	}
+
+	// On equal energy, prefer the window closest to the center
+	centerX, centerY := (sw-winW)/2, (sh-winH)/2
+	distance := func(x, y int) int {
+		return (x-centerX)*(x-centerX) + (y-centerY)*(y-centerY)
+	}
+	bestX, bestY, best := 0, 0, -1.0
+	for y := 0; y <= sh-winH; y++ {
+		for x := 0; x <= sw-winW; x++ {
+			s := windowSum(energy, sw, x, y, winW, winH)
+			if s > best || (s == best && distance(x, y) < distance(bestX, bestY)) {
+				best, bestX, bestY = s, x, y
+			}
+		}
+	}
+
+	x0 := int(float64(bestX) / scale)
+	y0 := int(float64(bestY) / scale)
+	if x0+cropW > w {
+		x0 = w - cropW
+	}
+	if y0+cropH > h {
+		y0 = h - cropH
+	}
+	return imaging.Crop(img, image.Rect(bounds.Min.X+x0, bounds.Min.Y+y0, bounds.Min.X+x0+cropW, bounds.Min.Y+y0+cropH))
+}
+
+// edgeEnergy computes the gradient magnitude of the luminance for each pixel.
+func edgeEnergy(img *image.NRGBA) []float64 {
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	lum := make([]float64, w*h)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.GrayModel.Convert(img.At(x, y)).(color.Gray)
+			lum[y*w+x] = float64(c.Y)
+		}
+	}
+	energy := make([]float64, w*h)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy float64
+			if x > 0 && x < w-1 {
+				dx = lum[y*w+x+1] - lum[y*w+x-1]
+			}
+			if y > 0 && y < h-1 {
+				dy = lum[(y+1)*w+x] - lum[(y-1)*w+x]
+			}
+			energy[y*w+x] = math.Abs(dx) + math.Abs(dy)
+		}
+	}
+	return energy
+}
+
+func windowSum(energy []float64, stride int, x0 int, y0 int, w int, h int) (sum float64) {
+	for y := y0; y < y0+h; y++ {
+		for x := x0; x < x0+w; x++ {
+			sum += energy[y*stride+x]
+		}
+	}
+	return
+}
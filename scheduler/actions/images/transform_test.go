@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package images
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/disintegration/imaging"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestApplyOrientation(t *testing.T) {
+
+	Convey("Test EXIF orientations", t, func() {
+		// 2x1 image with a red pixel on the left
+		img := imaging.New(2, 1, color.White)
+		img.Set(0, 0, color.NRGBA{R: 255, A: 255})
+
+		So(applyOrientation(img, 1), ShouldEqual, img)
+
+		flipped := applyOrientation(img, 2)
+		So(flipped.Bounds().Dx(), ShouldEqual, 2)
+		So(flipped.At(1, 0), ShouldResemble, color.NRGBA{R: 255, A: 255})
+
+		// Orientation 6 is rotated 90° CW for display: width and height are swapped
+		rotated := applyOrientation(img, 6)
+		So(rotated.Bounds().Dx(), ShouldEqual, 1)
+		So(rotated.Bounds().Dy(), ShouldEqual, 2)
+		So(rotated.At(0, 0), ShouldResemble, color.NRGBA{R: 255, A: 255})
+
+		rotated = applyOrientation(img, 8)
+		So(rotated.Bounds().Dy(), ShouldEqual, 2)
+		So(rotated.At(0, 1), ShouldResemble, color.NRGBA{R: 255, A: 255})
+	})
+
+	Convey("Test missing EXIF data", t, func() {
+		So(readOrientation([]byte("not an image")), ShouldEqual, 1)
+	})
+
+	Convey("Test header buffer keeps the beginning of the content", t, func() {
+		h := newHeaderBuffer(4)
+		n, e := h.Write([]byte("abc"))
+		So(e, ShouldBeNil)
+		So(n, ShouldEqual, 3)
+		n, _ = h.Write([]byte("defgh"))
+		So(n, ShouldEqual, 5)
+		h.Write([]byte("ijk"))
+		So(h.String(), ShouldEqual, "abcd")
+	})
+
+}
+
+func TestSmartCrop(t *testing.T) {
+
+	Convey("Test crop follows the detailed area", t, func() {
+		// Plain landscape image with a checkerboard on the right side
+		img := imaging.New(300, 100, color.White)
+		for y := 0; y < 100; y++ {
+			for x := 220; x < 300; x++ {
+				if (x/5+y/5)%2 == 0 {
+					img.Set(x, y, color.Black)
+				}
+			}
+		}
+		cropped := smartCrop(img, 1, 1)
+		So(cropped.Bounds().Dx(), ShouldEqual, 100)
+		So(cropped.Bounds().Dy(), ShouldEqual, 100)
+		// Checkerboard must be fully visible in the crop
+		So(cropped, ShouldResemble, imaging.Crop(img, image.Rect(200, 0, 300, 100)))
+	})
+
+	Convey("Test crop is centered on uniform images", t, func() {
+		img := imaging.New(100, 300, color.White)
+		img.Set(50, 150, color.Black)
+		for y := 0; y < 300; y++ {
+			img.Set(0, y, color.NRGBA{B: 255, A: 255})
+		}
+		cropped := smartCrop(img, 1, 1)
+		So(cropped.Bounds(), ShouldResemble, image.Rect(0, 0, 100, 100))
+		So(cropped.At(50, 50), ShouldResemble, color.NRGBA{A: 255})
+	})
+
+	Convey("Test image already at ratio", t, func() {
+		img := imaging.New(40, 30, color.White)
+		So(smartCrop(img, 4, 3), ShouldEqual, img)
+	})
+
+}
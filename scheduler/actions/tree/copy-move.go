@@ -36,7 +36,9 @@ import (
 	"github.com/pydio/cells/common/log"
 	"github.com/pydio/cells/common/proto/jobs"
 	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/retention"
 	"github.com/pydio/cells/common/views"
+	"github.com/pydio/cells/common/views/recycle"
 	"github.com/pydio/cells/scheduler/actions"
 )
 
@@ -46,6 +48,8 @@ type CopyMoveAction struct {
 	Recursive         bool
 	TargetPlaceholder string
 	CreateFolder      bool
+	MetaClient        tree.NodeReceiverClient
+	TreeClient        tree.NodeProviderClient
 }
 
 var (
@@ -63,6 +67,12 @@ func (c *CopyMoveAction) Init(job *jobs.Job, cl client.Client, action *jobs.Acti
 	if c.Client == nil {
 		c.Client = views.NewStandardRouter(views.RouterOptions{AdminView: true})
 	}
+	if c.MetaClient == nil && cl != nil {
+		c.MetaClient = tree.NewNodeReceiverClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_META, cl)
+	}
+	if c.TreeClient == nil && cl != nil {
+		c.TreeClient = tree.NewNodeProviderClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_TREE, cl)
+	}
 
 	if action.Parameters == nil {
 		return errors.InternalServerError(common.SERVICE_JOBS, "Could not find parameters for CopyMove action")
@@ -112,6 +122,18 @@ func (c *CopyMoveAction) Run(ctx context.Context, channels *actions.RunnableChan
 	}
 	sourceNode = readR.Node
 	output := input
+
+	if c.Move && c.TreeClient != nil {
+		// Refuse the whole move before copying anything if the source or one of its children is retained
+		rule, e := retention.NewResolver().Protecting(ctx, c.TreeClient, sourceNode, true)
+		if e != nil {
+			return input.WithError(e), e
+		}
+		if rule != nil {
+			e = rule.Error(common.SERVICE_JOBS, "move")
+			return input.WithError(e), e
+		}
+	}
 	childrenMoved := 0
 
 	session := uuid.NewUUID().String()
@@ -237,7 +259,11 @@ func (c *CopyMoveAction) Run(ctx context.Context, channels *actions.RunnableChan
 
 	// Now Copy/Move initial node
 	if sourceNode.IsLeaf() {
-		_, e := c.Client.CopyObject(ctx, sourceNode, targetNode, &views.CopyRequestData{})
+		meta := make(map[string]string, 1)
+		if c.Move {
+			meta["X-Amz-Metadata-Directive"] = "COPY"
+		}
+		_, e := c.Client.CopyObject(ctx, sourceNode, targetNode, &views.CopyRequestData{Metadata: meta})
 		if e != nil {
 			return output.WithError(e), e
 		}
@@ -284,6 +310,10 @@ func (c *CopyMoveAction) Run(ctx context.Context, channels *actions.RunnableChan
 
 	}
 
+	if c.Move && c.MetaClient != nil && recycle.InBin(targetNode.Path) && !recycle.InBin(sourceNode.Path) {
+		c.recordDeletion(ctx, sourceNode, targetNode)
+	}
+
 	output.AppendOutput(&jobs.ActionOutput{
 		Success: true,
 	})
@@ -291,3 +321,17 @@ func (c *CopyMoveAction) Run(ctx context.Context, channels *actions.RunnableChan
 	log.Logger(ctx).Info("Should now exit copy/move action")
 	return output, nil
 }
+
+// recordDeletion stores the original location of a node moved to a recycle bin, for later restoration.
+func (c *CopyMoveAction) recordDeletion(ctx context.Context, sourceNode *tree.Node, targetNode *tree.Node) {
+	nodeUuid := sourceNode.Uuid
+	if resp, e := c.Client.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Path: targetNode.Path}}); e == nil && resp.Node.Uuid != "" {
+		nodeUuid = resp.Node.Uuid
+	}
+	if nodeUuid == "" {
+		return
+	}
+	if e := recycle.RecordDeletion(ctx, c.MetaClient, nodeUuid, sourceNode.Path); e != nil {
+		log.Logger(ctx).Error("Cannot record original location of node moved to recycle bin", zap.String("path", sourceNode.Path), zap.Error(e))
+	}
+}
@@ -39,6 +39,10 @@ func init() {
 		return &MetaAction{}
 	})
 
+	manager.Register(recyclePurgeActionName, func() actions.ConcreteAction {
+		return &RecyclePurgeAction{}
+	})
+
 	manager.Register(snapshotActionName, func() actions.ConcreteAction {
 		return &SnapshotAction{}
 	})
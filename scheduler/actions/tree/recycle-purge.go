@@ -0,0 +1,161 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package tree
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/micro/go-micro/client"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/views/recycle"
+	"github.com/pydio/cells/scheduler/actions"
+)
+
+// RecyclePurgeAction deletes the recycle bins entries that are older than the retention period
+// of their workspace. Entries that are shared (or contain a shared node) are kept. Deletions go through
+// the standard router, so that the versions of the purged files are handled by the versioning policies.
+type RecyclePurgeAction struct {
+	Locator   *recycle.Locator
+	Retention int
+}
+
+var (
+	recyclePurgeActionName = "actions.tree.recycle-purge"
+)
+
+// GetName returns this action unique identifier
+func (c *RecyclePurgeAction) GetName() string {
+	return recyclePurgeActionName
+}
+
+// Init passes parameters to the action
+func (c *RecyclePurgeAction) Init(job *jobs.Job, cl client.Client, action *jobs.Action) error {
+
+	if c.Locator == nil {
+		c.Locator = recycle.NewLocator()
+	}
+	if r, ok := action.Parameters["retention"]; ok {
+		c.Retention, _ = strconv.Atoi(r)
+	}
+
+	return nil
+}
+
+// Run the actual action code
+func (c *RecyclePurgeAction) Run(ctx context.Context, channels *actions.RunnableChannels, input jobs.ActionMessage) (jobs.ActionMessage, error) {
+
+	bins, err := c.Locator.Bins(ctx)
+	if err != nil {
+		return input.WithError(err), err
+	}
+
+	output := input
+	purged := 0
+	for _, bin := range bins {
+		retention := recycle.Retention(bin.Workspace, c.Retention)
+		if retention <= 0 {
+			continue
+		}
+		limit := time.Now().Add(-time.Duration(retention) * 24 * time.Hour)
+		entries, e := c.Locator.Entries(ctx, bin)
+		if e != nil {
+			log.Logger(ctx).Error("Cannot list recycle bin", zap.String("path", bin.Node.Path), zap.Error(e))
+			continue
+		}
+		for _, entry := range entries {
+			if !recycle.DeletedAt(entry).Before(limit) {
+				continue
+			}
+			channels.StatusMsg <- "Purging " + entry.Path
+			if done, e := c.purge(ctx, entry); e != nil {
+				log.Logger(ctx).Error("Cannot purge recycle bin entry", zap.String("path", entry.Path), zap.Error(e))
+				continue
+			} else if !done {
+				continue
+			}
+			purged++
+			output.AppendOutput(&jobs.ActionOutput{
+				StringBody: "Purged " + entry.Path,
+			})
+		}
+	}
+
+	output.AppendOutput(&jobs.ActionOutput{
+		Success:    true,
+		StringBody: fmt.Sprintf("Purged %d entries from recycle bins", purged),
+	})
+	return output, nil
+}
+
+// purge deletes an entry and all its children, unless one of them is shared.
+func (c *RecyclePurgeAction) purge(ctx context.Context, entry *tree.Node) (bool, error) {
+
+	router := c.Locator.Router
+	nodes := []*tree.Node{entry}
+	if !entry.IsLeaf() {
+		stream, e := router.ListNodes(ctx, &tree.ListNodesRequest{Node: entry, Recursive: true})
+		if e != nil {
+			return false, e
+		}
+		for {
+			resp, er := stream.Recv()
+			if er != nil {
+				break
+			}
+			if resp != nil {
+				nodes = append(nodes, resp.Node)
+			}
+		}
+		stream.Close()
+	}
+
+	if shared, e := c.Locator.Shared(ctx, nodes...); e != nil {
+		return false, e
+	} else if shared {
+		log.Logger(ctx).Info("Keeping recycle bin entry as it is shared", zap.String("path", entry.Path))
+		return false, nil
+	}
+
+	// Delete deepest nodes first, the entry itself last
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return len(nodes[i].Path) > len(nodes[j].Path)
+	})
+	for _, n := range nodes {
+		if n == entry && !n.IsLeaf() {
+			// Folder may already be gone with its last child
+			if _, e := router.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Path: n.Path}}); e != nil {
+				continue
+			}
+		}
+		if _, e := router.DeleteNode(ctx, &tree.DeleteNodeRequest{Node: n}); e != nil {
+			return false, e
+		}
+	}
+	return true, nil
+}
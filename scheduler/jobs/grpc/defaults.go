@@ -37,7 +37,19 @@ func getDefaultJobs() []*jobs.Job {
 	})
 
 	searchQueryExif, _ := ptypes.MarshalAny(&tree.Query{
-		Extension: "jpg",
+		Extension: "jpg,jpeg,tif,tiff",
+	})
+
+	searchQueryMedia, _ := ptypes.MarshalAny(&tree.Query{
+		Extension: "mp4,m4a,m4v,mov,mkv,webm,mp3,flac",
+	})
+
+	searchQueryPreviews, _ := ptypes.MarshalAny(&tree.Query{
+		Extension: "pdf,doc,docx,odt,rtf,xls,xlsx,ods,ppt,pptx,odp,mp4,mov,avi,mkv,webm",
+	})
+
+	searchQueryFiles, _ := ptypes.MarshalAny(&tree.Query{
+		Type: tree.NodeType_LEAF,
 	})
 
 	thumbnailsJob := &jobs.Job{
@@ -52,13 +64,26 @@ func getDefaultJobs() []*jobs.Job {
 		},
 		Actions: []*jobs.Action{
 			{
-				ID:         "actions.images.thumbnails",
-				Parameters: map[string]string{"ThumbSizes": "256,512"},
+				ID: "actions.images.thumbnails",
+				Parameters: map[string]string{
+					"ThumbSizes": "256,512",
+					"Densities":  "1,2,3",
+					"Formats":    "jpg,webp",
+					"TileSizes":  "256",
+					"TileAspect": "1:1",
+				},
 				NodesFilter: &jobs.NodesSelector{
 					Query: &service.Query{
 						SubQueries: []*any.Any{searchQuery},
 					},
 				},
+				ChainedActions: []*jobs.Action{
+					{
+						// Remove thumbnails generated for a previous version of the content
+						ID:         "actions.images.clean",
+						Parameters: map[string]string{"obsolete": "true"},
+					},
+				},
 			},
 			{
 				ID: "actions.images.exif",
@@ -68,6 +93,23 @@ func getDefaultJobs() []*jobs.Job {
 					},
 				},
 			},
+			{
+				ID: "actions.images.media",
+				NodesFilter: &jobs.NodesSelector{
+					Query: &service.Query{
+						SubQueries: []*any.Any{searchQueryMedia},
+					},
+				},
+			},
+			{
+				ID:         "actions.images.preview",
+				Parameters: map[string]string{"MaxPages": "5", "PageSize": "512"},
+				NodesFilter: &jobs.NodesSelector{
+					Query: &service.Query{
+						SubQueries: []*any.Any{searchQueryPreviews},
+					},
+				},
+			},
 		},
 	}
 
@@ -108,6 +150,47 @@ func getDefaultJobs() []*jobs.Job {
 		},
 	}
 
+	// Rescan walks the whole tree: it is shipped inactive, administrators enable it once the antivirus is configured
+	antivirusRescanJob := &jobs.Job{
+		ID:             "antivirus-rescan-job",
+		Owner:          common.PYDIO_SYSTEM_USERNAME,
+		Label:          "Jobs.Default.AntivirusRescan",
+		Inactive:       true,
+		MaxConcurrency: 2,
+		Schedule: &jobs.Schedule{
+			Iso8601Schedule: "R/2012-06-04T03:00:00.000000+00:00/P1D", // every day
+		},
+		Actions: []*jobs.Action{
+			{
+				ID:         "actions.antivirus.scan",
+				Parameters: map[string]string{},
+				NodesSelector: &jobs.NodesSelector{
+					Query: &service.Query{
+						SubQueries: []*any.Any{searchQueryFiles},
+					},
+				},
+			},
+		},
+	}
+
+	recyclePurgeJob := &jobs.Job{
+		ID:             "recycle-purge-job",
+		Owner:          common.PYDIO_SYSTEM_USERNAME,
+		Label:          "Jobs.Default.RecyclePurge",
+		Inactive:       false,
+		MaxConcurrency: 1,
+		Schedule: &jobs.Schedule{
+			Iso8601Schedule: "R/2012-06-04T04:00:00.000000+00:00/P1D", // every day
+		},
+		Actions: []*jobs.Action{
+			{
+				ID: "actions.tree.recycle-purge",
+				// Default retention in days, 0 keeps entries unless the workspace defines a retention
+				Parameters: map[string]string{"retention": "0"},
+			},
+		},
+	}
+
 	fakeLongJob := &jobs.Job{
 		ID:             "fake-long-job",
 		Owner:          common.PYDIO_SYSTEM_USERNAME,
@@ -163,6 +246,8 @@ func getDefaultJobs() []*jobs.Job {
 		thumbnailsJob,
 		cleanThumbsJob,
 		stuckTasksJob,
+		antivirusRescanJob,
+		recyclePurgeJob,
 		// Testing Jobs
 		fakeLongJob,
 		fakeRPCJob,
@@ -59,6 +59,10 @@ func init() {
 				}),
 				micro.AfterStart(func() error {
 					for _, j := range getDefaultJobs() {
+						// Keep jobs enabled or disabled by administrators in that state
+						if existing, e := store.GetJob(j.ID, proto.TaskStatus_Unknown); e == nil {
+							j.Inactive = existing.Inactive
+						}
 						handler.PutJob(m.Options().Context, &proto.PutJobRequest{Job: j}, &proto.PutJobResponse{})
 					}
 					// Clean tasks stuck in "Running" status
@@ -26,6 +26,7 @@ import (
 
 	"github.com/emicklei/go-restful"
 	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/errors"
 	"go.uber.org/zap"
 
 	"github.com/pydio/cells/common"
@@ -128,6 +129,11 @@ func (s *JobsHandler) UserControlJob(req *restful.Request, rsp *restful.Response
 		if response, err := cli.DeleteTasks(ctx, delRequest); err != nil {
 			service.RestError500(req, rsp, err)
 		} else {
+			log.Auditer(ctx).Info(
+				fmt.Sprintf("Deleted task %s of job %s", cmd.TaskId, cmd.JobId),
+				log.GetAuditId(common.AUDIT_TASK_DELETE),
+				log.GetAuditContext(common.AUDIT_CONTEXT_JOB_ID, cmd.JobId),
+			)
 			rsp.WriteEntity(&jobs.CtrlCommandResponse{Msg: fmt.Sprintf("Deleted %v tasks", len(response.Deleted))})
 		}
 
@@ -137,6 +143,11 @@ func (s *JobsHandler) UserControlJob(req *restful.Request, rsp *restful.Response
 			JobID:  cmd.JobId,
 			RunNow: true,
 		}))
+		log.Auditer(ctx).Info(
+			fmt.Sprintf("Triggered job %s", cmd.JobId),
+			log.GetAuditId(common.AUDIT_JOB_CONTROL),
+			log.GetAuditContext(common.AUDIT_CONTEXT_JOB_ID, cmd.JobId),
+		)
 
 	} else if cmd.Cmd == jobs.Command_Active || cmd.Cmd == jobs.Command_Inactive {
 
@@ -152,6 +163,11 @@ func (s *JobsHandler) UserControlJob(req *restful.Request, rsp *restful.Response
 			if _, err := cli.PutJob(ctx, &jobs.PutJobRequest{Job: job}); err != nil {
 				service.RestError500(req, rsp, err)
 			} else {
+				log.Auditer(ctx).Info(
+					fmt.Sprintf("Set job %s %s", cmd.JobId, cmd.Cmd.String()),
+					log.GetAuditId(common.AUDIT_JOB_CONTROL),
+					log.GetAuditContext(common.AUDIT_CONTEXT_JOB_ID, cmd.JobId),
+				)
 				rsp.WriteEntity(&jobs.CtrlCommandResponse{Msg: "Updated Job State"})
 			}
 
@@ -162,6 +178,11 @@ func (s *JobsHandler) UserControlJob(req *restful.Request, rsp *restful.Response
 	} else {
 		cli := jobs.NewTaskServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_TASKS, defaults.NewClient())
 		if response, err := cli.Control(ctx, &cmd); err == nil {
+			log.Auditer(ctx).Info(
+				fmt.Sprintf("Sent %s to task %s of job %s", cmd.Cmd.String(), cmd.TaskId, cmd.JobId),
+				log.GetAuditId(common.AUDIT_JOB_CONTROL),
+				log.GetAuditContext(common.AUDIT_CONTEXT_JOB_ID, cmd.JobId),
+			)
 			rsp.WriteEntity(response)
 		} else {
 			service.RestError500(req, rsp, err)
@@ -178,12 +199,18 @@ func (s *JobsHandler) UserDeleteTasks(req *restful.Request, rsp *restful.Respons
 		return
 	}
 
+	ctx := req.Request.Context()
 	cli := jobs.NewJobServiceClient(registry.GetClient(common.SERVICE_JOBS))
-	response, e := cli.DeleteTasks(req.Request.Context(), &request)
+	response, e := cli.DeleteTasks(ctx, &request)
 	if e != nil {
 		service.RestError500(req, rsp, e)
 		return
 	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Deleted %d task(s) of job %s", len(response.Deleted), request.JobId),
+		log.GetAuditId(common.AUDIT_TASK_DELETE),
+		log.GetAuditContext(common.AUDIT_CONTEXT_JOB_ID, request.JobId),
+	)
 
 	rsp.WriteEntity(response)
 
@@ -239,6 +266,20 @@ func (s *JobsHandler) UserCreateJob(req *restful.Request, rsp *restful.Response)
 		}
 		jobUuid, err = dircopy(ctx, nodes, target, move, languages...)
 		break
+	case "restore":
+		var nodes []string
+		params, ok := jsonParams["nodes"].([]interface{})
+		if !ok {
+			service.RestErrorDetect(req, rsp, errors.BadRequest(common.SERVICE_JOBS, "please provide the nodes to restore"))
+			return
+		}
+		for _, i := range params {
+			if n, ok := i.(string); ok {
+				nodes = append(nodes, n)
+			}
+		}
+		jobUuid, err = restore(ctx, nodes, languages...)
+		break
 	case "datasource-resync":
 		dsName := jsonParams["dsName"].(string)
 		jobUuid, err = syncDatasource(ctx, dsName, languages...)
@@ -250,6 +291,11 @@ func (s *JobsHandler) UserCreateJob(req *restful.Request, rsp *restful.Response)
 		return
 	}
 
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Created %s job %s", request.JobName, jobUuid),
+		log.GetAuditId(common.AUDIT_JOB_CREATE),
+		log.GetAuditContext(common.AUDIT_CONTEXT_JOB_ID, jobUuid),
+	)
 	response := &rest.UserJobResponse{
 		JobUuid: jobUuid,
 	}
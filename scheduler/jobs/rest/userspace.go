@@ -22,9 +22,12 @@ package rest
 
 import (
 	"context"
+	"path"
 	"path/filepath"
+	"strings"
 
 	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/errors"
 	"github.com/pborman/uuid"
 	"go.uber.org/zap"
 
@@ -34,7 +37,9 @@ import (
 	"github.com/pydio/cells/common/proto/jobs"
 	"github.com/pydio/cells/common/proto/tree"
 	"github.com/pydio/cells/common/registry"
+	"github.com/pydio/cells/common/utils"
 	"github.com/pydio/cells/common/views"
+	"github.com/pydio/cells/common/views/recycle"
 	"github.com/pydio/cells/scheduler/lang"
 )
 
@@ -238,6 +243,112 @@ func dircopy(ctx context.Context, selectedPathes []string, targetNodePath string
 	return jobUuid, err
 }
 
+func restore(ctx context.Context, selectedPathes []string, languages ...string) (string, error) {
+
+	T := lang.Bundle().GetTranslationFunc(languages...)
+	jobUuid := uuid.NewUUID().String()
+	claims := ctx.Value(claim.ContextKey).(claim.Claims)
+	userName := claims.Name
+	adminRouter := views.NewStandardRouter(views.RouterOptions{AdminView: true})
+
+	err := getRouter().WrapCallback(func(inputFilter views.NodeFilter, outputFilter views.NodeFilter) error {
+
+		var actions []*jobs.Action
+		for _, p := range selectedPathes {
+			_, node, nodeErr := inputFilter(ctx, &tree.Node{Path: p}, "sel")
+			if nodeErr != nil {
+				return nodeErr
+			}
+			binPath, inBin := recycle.BinPath(node.Path)
+			if !inBin || strings.Trim(node.Path, "/") == binPath {
+				return errors.BadRequest(common.SERVICE_JOBS, "node %s is not in a recycle bin", p)
+			}
+			resp, e := adminRouter.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Path: node.Path}})
+			if e != nil {
+				return e
+			}
+			target, ok := recycle.RestorePath(resp.Node)
+			if !ok {
+				return errors.NotFound(common.SERVICE_JOBS, "cannot find original location of %s", p)
+			}
+			// Original location must belong to the same workspace as the recycle bin
+			if !strings.HasPrefix(strings.Trim(target, "/"), path.Dir(binPath)+"/") || recycle.InBin(target) {
+				return errors.Forbidden(common.SERVICE_JOBS, "cannot restore %s outside of its workspace", p)
+			}
+			if _, e := adminRouter.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Path: target}}); e == nil {
+				return errors.Conflict(common.SERVICE_JOBS, "a node already exists at the original location of %s", p)
+			}
+			// The move runs with admin rights, check the user can write at the original location
+			if e := checkWritable(ctx, target); e != nil {
+				return e
+			}
+			actions = append(actions, &jobs.Action{
+				ID: "actions.tree.copymove",
+				Parameters: map[string]string{
+					"type":      "move",
+					"target":    target,
+					"recursive": "true",
+					"create":    "true",
+				},
+				NodesSelector: &jobs.NodesSelector{
+					Collect: true,
+					Pathes:  []string{node.Path},
+				},
+			})
+		}
+
+		log.Logger(ctx).Debug("Creating restore job", zap.Any("actions", actions))
+
+		job := &jobs.Job{
+			ID:             "restore-" + jobUuid,
+			Owner:          userName,
+			Label:          T("Jobs.User.Restore"),
+			Inactive:       false,
+			Languages:      languages,
+			MaxConcurrency: 1,
+			AutoStart:      true,
+			AutoClean:      true,
+			Actions:        actions,
+		}
+
+		cli := jobs.NewJobServiceClient(registry.GetClient(common.SERVICE_JOBS))
+		_, er := cli.PutJob(ctx, &jobs.PutJobRequest{Job: job})
+		return er
+
+	})
+
+	return jobUuid, err
+}
+
+// checkWritable verifies that the user found in context can write at the given location, resolved
+// to its closest existing parent.
+func checkWritable(ctx context.Context, target string) error {
+
+	accessList, err := utils.AccessListFromContextClaims(ctx)
+	if err != nil {
+		return err
+	}
+	// Update Access List with resolved virtual nodes
+	virtualManager := views.GetVirtualNodesManager()
+	cPool := views.NewClientsPool(false)
+	for _, vNode := range virtualManager.ListNodes() {
+		if aclNodeMask, has := accessList.GetNodesBitmasks()[vNode.Uuid]; has {
+			if resolvedRoot, err := virtualManager.ResolveInContext(ctx, vNode, cPool, false); err == nil {
+				accessList.GetNodesBitmasks()[resolvedRoot.Uuid] = aclNodeMask
+			}
+		}
+	}
+	parents, err := utils.BuildAncestorsListOrParent(ctx, cPool.GetTreeClient(), &tree.Node{Path: target})
+	if err != nil {
+		return err
+	}
+	if !accessList.CanWrite(ctx, parents...) {
+		return errors.Forbidden(common.SERVICE_JOBS, "original location of %s is not writeable", path.Base(target))
+	}
+	return nil
+
+}
+
 func syncDatasource(ctx context.Context, dsName string, languages ...string) (string, error) {
 
 	T := lang.Bundle().GetTranslationFunc(languages...)